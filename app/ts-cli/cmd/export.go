@@ -0,0 +1,65 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/openGemini/openGemini/app/ts-cli/geminicli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&options.Host, "host", DEFAULT_HOST, "ts-sql host to connect to.")
+	exportCmd.Flags().IntVar(&options.Port, "port", DEFAULT_PORT, "ts-sql tcp port to connect to.")
+	exportCmd.Flags().StringVarP(&options.Username, "username", "u", "", "Username to connect to openGemini.")
+	exportCmd.Flags().StringVarP(&options.Password, "password", "p", "", "Password to connect to openGemini.")
+	exportCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to openGemini.")
+	exportCmd.Flags().BoolVar(&options.IgnoreSsl, "unsafeSsl", true, "Ignore ssl verification when connecting openGemini by https.")
+	exportCmd.Flags().StringVar(&options.Database, "database", "", "Database to export.")
+	exportCmd.Flags().StringVar(&options.RetentionPolicy, "retention-policy", "", "Retention policy to export, the default retention policy of the database if empty.")
+	exportCmd.Flags().StringVar(&options.Measurement, "measurement", "", "Measurement to export, all the measurements of the database if empty.")
+	exportCmd.Flags().StringVar(&options.Start, "start", "", "Start time of the data to export in RFC3339 format, inclusive.")
+	exportCmd.Flags().StringVar(&options.End, "end", "", "End time of the data to export in RFC3339 format, exclusive, now if empty.")
+	exportCmd.Flags().StringVar(&options.Format, "format", geminicli.ExportFormatLineProtocol, "Format of the exported files: lp, csv or parquet.")
+	exportCmd.Flags().StringVar(&options.Out, "out", "", "Directory to write the exported files to.")
+	exportCmd.Flags().BoolVar(&options.Compress, "compress", false, "Compress the exported lp or csv files with gzip.")
+	exportCmd.Flags().DurationVar(&options.Window, "window", geminicli.DefaultExportWindow, "Time range of the data exported into one file.")
+	exportCmd.Flags().IntVar(&options.ChunkSize, "chunk-size", geminicli.DefaultExportChunkSize, "Maximum number of rows in a chunk of the query responses.")
+	for _, flag := range []string{"database", "start", "out"} {
+		if err := exportCmd.MarkFlagRequired(flag); err != nil {
+			return
+		}
+	}
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data from openGemini",
+	Long: `Export data from openGemini as line protocol, CSV or Parquet files.
+Every measurement is exported window by window into separate files, the windows which
+have been exported are skipped, so an interrupted export is resumed by running it again.`,
+	Example: `
+$ ts-cli export --database=db0 --start=2024-01-01T00:00:00Z --end=2024-01-02T00:00:00Z --out=/tmp/export
+$ ts-cli export --database=db0 --measurement=cpu --start=2024-01-01T00:00:00Z --format=csv --compress --out=/tmp/export`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		exporter := geminicli.NewExporter()
+		return exporter.Export(&options)
+	},
+}
@@ -28,6 +28,12 @@ func init() {
 	importCmd.Flags().BoolVar(&options.Ssl, "ssl", false, "Use https for connecting to openGemini.")
 	importCmd.Flags().StringVar(&options.Precision, "precision", "ns", "Specify the format of the timestamp: rfc3339, h, m, s, ms, u or ns.")
 	importCmd.Flags().StringVar(&options.Path, "path", "", "Path to the file to import.")
+	importCmd.Flags().StringVar(&options.Format, "format", geminicli.ImportFormatLineProtocol, "Format of the file to import: lp or parquet.")
+	importCmd.Flags().StringVar(&options.Database, "database", "", "Database to import the parquet file into.")
+	importCmd.Flags().StringVar(&options.RetentionPolicy, "retention-policy", "", "Retention policy to import the parquet file into, the default retention policy of the database if empty.")
+	importCmd.Flags().StringVar(&options.Measurement, "measurement", "", "Measurement to import the parquet file into.")
+	importCmd.Flags().StringSliceVar(&options.Tags, "tags", nil, "Columns of the parquet file imported as tags, the series column of the exported files is always imported as tags.")
+	importCmd.Flags().StringVar(&options.TimeColumn, "time-column", "time", "Timestamp or int64 nanosecond column of the parquet file imported as the time.")
 	err := importCmd.MarkFlagRequired("path")
 	if err != nil {
 		return
@@ -37,9 +43,10 @@ func init() {
 var importCmd = &cobra.Command{
 	Use:   "import",
 	Short: "Import data to openGemini",
-	Long:  `Import line protocol text file to openGemini`,
+	Long:  `Import line protocol text file or Parquet file to openGemini`,
 	Example: `
-$ ts-cli import --path=line_protocol_file.txt --host=127.0.0.1 --port=8086 --precision=s`,
+$ ts-cli import --path=line_protocol_file.txt --host=127.0.0.1 --port=8086 --precision=s
+$ ts-cli import --path=cpu.parquet --format=parquet --database=db0 --measurement=cpu --tags=host,region`,
 	CompletionOptions: cobra.CompletionOptions{
 		DisableDefaultCmd:   true,
 		DisableDescriptions: true,
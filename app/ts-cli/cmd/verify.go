@@ -0,0 +1,52 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/openGemini/openGemini/app/ts-cli/verifier"
+	"github.com/spf13/cobra"
+)
+
+var verifyOptions = verifier.Options{}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+	verifyCmd.Flags().StringVar(&verifyOptions.DataDir, "data-dir", "", "Data directory of ts-store, or the directory of a database, retention policy or shard below it.")
+	verifyCmd.Flags().StringVar(&verifyOptions.QuarantineDir, "quarantine-dir", "", "Directory to move the corrupted files into, the files are only reported if empty.")
+	verifyCmd.Flags().StringVar(&verifyOptions.Report, "report", "", "File to write the JSON report to, stdout if empty.")
+	if err := verifyCmd.MarkFlagRequired("data-dir"); err != nil {
+		return
+	}
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the TSSP files of openGemini",
+	Long: `Verify the trailer, the chunk metas, the column crc and the primary key index of the TSSP files
+of every shard in the data directory. ts-store must be stopped while the files are verified.
+The corrupted files can be moved into the quarantine directory, so that the rest of the shard stays queryable.`,
+	Example: `
+$ ts-cli verify --data-dir=/opt/openGemini/data/data
+$ ts-cli verify --data-dir=/opt/openGemini/data/data/db0 --quarantine-dir=/opt/openGemini/quarantine --report=/tmp/verify.json`,
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd:   true,
+		DisableDescriptions: true,
+		DisableNoDescFlag:   true,
+	},
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return verifier.NewVerifier(&verifyOptions).Verify()
+	},
+}
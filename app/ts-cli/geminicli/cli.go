@@ -62,8 +62,21 @@ type CommandLineConfig struct {
 	Precision  string
 
 	// import cmd options
-	Import bool
-	Path   string
+	Import     bool
+	Path       string
+	Tags       []string
+	TimeColumn string
+
+	// export cmd options
+	RetentionPolicy string
+	Measurement     string
+	Start           string
+	End             string
+	Format          string
+	Out             string
+	Compress        bool
+	Window          time.Duration
+	ChunkSize       int
 }
 
 type HttpClient interface {
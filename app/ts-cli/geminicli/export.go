@@ -0,0 +1,823 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	ExportFormatLineProtocol = "lp"
+	ExportFormatCSV          = "csv"
+	ExportFormatParquet      = "parquet"
+
+	DefaultExportWindow    = 24 * time.Hour
+	DefaultExportChunkSize = 10000
+
+	exportTmpSuffix = ".tmp"
+)
+
+// Exporter is the exporter used for exporting data
+type Exporter struct {
+	client          HttpClient
+	clientCreator   HttpClientCreator
+	database        string
+	retentionPolicy string
+	format          string
+	out             string
+	compress        bool
+	window          time.Duration
+	chunkSize       int
+
+	totalPoints  int
+	totalFiles   int
+	skippedFiles int
+
+	stderrLogger *log.Logger
+	stdoutLogger *log.Logger
+}
+
+// measurementSchema is the tag keys and the field keys of a measurement, the keys are sorted.
+type measurementSchema struct {
+	tags   []string
+	fields []string
+	types  map[string]int
+}
+
+// NewExporter will return an initialized Exporter struct
+func NewExporter() *Exporter {
+	return &Exporter{
+		clientCreator: newExportClient,
+		stdoutLogger:  log.New(os.Stdout, "", log.LstdFlags),
+		stderrLogger:  log.New(os.Stderr, "", log.LstdFlags),
+	}
+}
+
+// exportClient is the client of the exporter, which reads the chunked responses of the queries as a stream.
+type exportClient struct {
+	*client.Client
+	config     client.Config
+	httpClient *http.Client
+}
+
+func newExportClient(c client.Config) (HttpClient, error) {
+	cli, err := client.NewClient(c)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := new(tls.Config)
+	if c.TLS != nil {
+		tlsConfig = c.TLS.Clone()
+	}
+	tlsConfig.InsecureSkipVerify = c.UnsafeSsl
+	tr := &http.Transport{
+		Proxy:           c.Proxy,
+		TLSClientConfig: tlsConfig,
+	}
+	if c.UnixSocket != "" {
+		tr.DisableCompression = true
+		tr.DialContext = func(_ context.Context, _, _ string) (net.Conn, error) {
+			return net.Dial("unix", c.UnixSocket)
+		}
+	}
+	if c.UserAgent == "" {
+		c.UserAgent = "InfluxDBClient"
+	}
+	return &exportClient{Client: cli, config: c, httpClient: &http.Client{Timeout: c.Timeout, Transport: tr}}, nil
+}
+
+func (c *exportClient) QueryChunked(ctx context.Context, q client.Query, fn func(*client.Response) error) error {
+	u := c.config.URL
+	u.Path = path.Join(u.Path, "query")
+	values := u.Query()
+	values.Set("q", q.Command)
+	values.Set("db", q.Database)
+	if q.RetentionPolicy != "" {
+		values.Set("rp", q.RetentionPolicy)
+	}
+	values.Set("chunked", "true")
+	if q.ChunkSize > 0 {
+		values.Set("chunk_size", strconv.Itoa(q.ChunkSize))
+	}
+	if c.config.Precision != "" {
+		values.Set("epoch", c.config.Precision)
+	}
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	cr := client.NewChunkedResponse(resp.Body)
+	for {
+		r, err := cr.NextResponse()
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			break
+		}
+		if err = r.Error(); err != nil {
+			return err
+		}
+		if err = fn(r); err != nil {
+			return err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received status code %d from server", resp.StatusCode)
+	}
+	return nil
+}
+
+// Export exports the data of the measurements in [start, end). The data of each measurement is exported window by window,
+// and every window is written to its own file, which is renamed from a temporary file once it is complete. Running the
+// same export again skips the windows which have been exported, so an interrupted export can be resumed.
+func (exp *Exporter) Export(clc *CommandLineConfig) error {
+	start, end, err := exp.parseOptions(clc)
+	if err != nil {
+		return err
+	}
+
+	// the time of the points is always queried in nanoseconds
+	clc.Precision = "ns"
+	config, err := parseClientConfig(clc)
+	if err != nil {
+		return err
+	}
+	cli, err := exp.clientCreator(*config)
+	if err != nil {
+		return fmt.Errorf("could not create client %s", err)
+	}
+	exp.client = cli
+	if _, _, err = exp.client.Ping(); err != nil {
+		return err
+	}
+
+	if exp.retentionPolicy == "" {
+		if exp.retentionPolicy, err = exp.defaultRetentionPolicy(); err != nil {
+			return err
+		}
+	}
+
+	measurements := []string{clc.Measurement}
+	if clc.Measurement == "" {
+		if measurements, err = exp.measurements(); err != nil {
+			return err
+		}
+	}
+
+	for _, mst := range measurements {
+		schema, err := exp.measurementSchema(mst)
+		if err != nil {
+			return err
+		}
+		if len(schema.fields) == 0 {
+			exp.stdoutLogger.Printf("Measurement %s has no fields, skipped\n", mst)
+			continue
+		}
+		for wStart := start; wStart.Before(end); wStart = wStart.Add(exp.window) {
+			wEnd := wStart.Add(exp.window)
+			if wEnd.After(end) {
+				wEnd = end
+			}
+			if err = exp.exportWindow(mst, schema, wStart, wEnd); err != nil {
+				return fmt.Errorf("export measurement %s from %s to %s failed: %s", mst,
+					wStart.Format(time.RFC3339Nano), wEnd.Format(time.RFC3339Nano), err)
+			}
+		}
+	}
+
+	exp.stdoutLogger.Printf("Exported %d points into %d files, %d files skipped\n", exp.totalPoints, exp.totalFiles, exp.skippedFiles)
+	return nil
+}
+
+func (exp *Exporter) parseOptions(clc *CommandLineConfig) (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+	if clc.Database == "" {
+		return start, end, fmt.Errorf("execute -export cmd, -database is required")
+	}
+	if clc.Out == "" {
+		return start, end, fmt.Errorf("execute -export cmd, -out is required")
+	}
+	if clc.Start == "" {
+		return start, end, fmt.Errorf("execute -export cmd, -start is required")
+	}
+	if start, err = time.Parse(time.RFC3339Nano, clc.Start); err != nil {
+		return start, end, fmt.Errorf("invalid start time %q: %s", clc.Start, err)
+	}
+	end = time.Now()
+	if clc.End != "" {
+		if end, err = time.Parse(time.RFC3339Nano, clc.End); err != nil {
+			return start, end, fmt.Errorf("invalid end time %q: %s", clc.End, err)
+		}
+	}
+	if !start.Before(end) {
+		return start, end, fmt.Errorf("start time %s must be before end time %s", clc.Start, end.Format(time.RFC3339Nano))
+	}
+
+	switch clc.Format {
+	case "":
+		clc.Format = ExportFormatLineProtocol
+	case ExportFormatLineProtocol, ExportFormatCSV, ExportFormatParquet:
+	default:
+		return start, end, fmt.Errorf("unknown format %q. format must be lp, csv or parquet", clc.Format)
+	}
+	if clc.Compress && clc.Format == ExportFormatParquet {
+		return start, end, fmt.Errorf("compress is not supported by the parquet format, which is always compressed")
+	}
+	if clc.Window < 0 || clc.ChunkSize < 0 {
+		return start, end, fmt.Errorf("window and chunk size must not be negative")
+	}
+
+	exp.database = clc.Database
+	exp.retentionPolicy = clc.RetentionPolicy
+	exp.format = clc.Format
+	exp.out = clc.Out
+	exp.compress = clc.Compress
+	exp.window = clc.Window
+	if exp.window == 0 {
+		exp.window = DefaultExportWindow
+	}
+	exp.chunkSize = clc.ChunkSize
+	if exp.chunkSize == 0 {
+		exp.chunkSize = DefaultExportChunkSize
+	}
+	return start, end, nil
+}
+
+// ChunkedQueryClient is implemented by the clients which read the chunks of a chunked response as a stream,
+// fn is called with every chunk as soon as it is received.
+type ChunkedQueryClient interface {
+	QueryChunked(ctx context.Context, q client.Query, fn func(*client.Response) error) error
+}
+
+// queryRows executes the command and calls fn with the series of the response. If the client streams the chunked
+// responses, fn is called chunk by chunk, and the response is never held in memory as a whole.
+func (exp *Exporter) queryRows(command string, fn func(row *models.Row) error) error {
+	q := client.Query{
+		Command:         command,
+		Database:        exp.database,
+		RetentionPolicy: exp.retentionPolicy,
+		Chunked:         true,
+		ChunkSize:       exp.chunkSize,
+	}
+	handle := func(response *client.Response) error {
+		if err := response.Error(); err != nil {
+			return err
+		}
+		for i := range response.Results {
+			for j := range response.Results[i].Series {
+				if err := fn(&response.Results[i].Series[j]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if cc, ok := exp.client.(ChunkedQueryClient); ok {
+		return cc.QueryChunked(context.TODO(), q, handle)
+	}
+	response, err := exp.client.QueryContext(context.TODO(), q)
+	if err != nil {
+		return err
+	}
+	return handle(response)
+}
+
+func (exp *Exporter) query(command string) ([]models.Row, error) {
+	var rows []models.Row
+	err := exp.queryRows(command, func(row *models.Row) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	return rows, err
+}
+
+func (exp *Exporter) defaultRetentionPolicy() (string, error) {
+	rows, err := exp.query("SHOW RETENTION POLICIES ON " + influxql.QuoteIdent(exp.database))
+	if err != nil {
+		return "", err
+	}
+	for _, row := range rows {
+		nameIdx, defaultIdx := columnIndex(row.Columns, "name"), columnIndex(row.Columns, "default")
+		if nameIdx < 0 || defaultIdx < 0 {
+			continue
+		}
+		for _, values := range row.Values {
+			if isDefault, ok := values[defaultIdx].(bool); ok && isDefault {
+				return fmt.Sprint(values[nameIdx]), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("database %s has no default retention policy", exp.database)
+}
+
+func (exp *Exporter) measurements() ([]string, error) {
+	rows, err := exp.query("SHOW MEASUREMENTS ON " + influxql.QuoteIdent(exp.database))
+	if err != nil {
+		return nil, err
+	}
+	var measurements []string
+	for _, row := range rows {
+		for _, values := range row.Values {
+			measurements = append(measurements, fmt.Sprint(values[0]))
+		}
+	}
+	return measurements, nil
+}
+
+func (exp *Exporter) measurementSchema(mst string) (*measurementSchema, error) {
+	source := influxql.QuoteIdent(exp.retentionPolicy, mst)
+	schema := &measurementSchema{types: make(map[string]int)}
+
+	rows, err := exp.query("SHOW TAG KEYS FROM " + source)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for _, values := range row.Values {
+			schema.tags = append(schema.tags, fmt.Sprint(values[0]))
+		}
+	}
+
+	if rows, err = exp.query("SHOW FIELD KEYS FROM " + source); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for _, values := range row.Values {
+			if len(values) < 2 {
+				continue
+			}
+			field := fmt.Sprint(values[0])
+			switch values[1] {
+			case "float":
+				schema.types[field] = influx.Field_Type_Float
+			case "integer":
+				schema.types[field] = influx.Field_Type_Int
+			case "string":
+				schema.types[field] = influx.Field_Type_String
+			case "boolean":
+				schema.types[field] = influx.Field_Type_Boolean
+			default:
+				return nil, fmt.Errorf("unsupported type %v of field %s", values[1], field)
+			}
+			schema.fields = append(schema.fields, field)
+		}
+	}
+	sort.Strings(schema.tags)
+	sort.Strings(schema.fields)
+	return schema, nil
+}
+
+// exportFile returns the file the data of the measurement in the window is exported to.
+func (exp *Exporter) exportFile(mst string, start, end time.Time) string {
+	name := fmt.Sprintf("%d_%d.%s", start.UnixNano(), end.UnixNano(), exp.format)
+	if exp.compress {
+		name += ".gz"
+	}
+	return filepath.Join(exp.out, url.PathEscape(exp.database), url.PathEscape(exp.retentionPolicy), url.PathEscape(mst), name)
+}
+
+func (exp *Exporter) exportWindow(mst string, schema *measurementSchema, start, end time.Time) error {
+	file := exp.exportFile(mst, start, end)
+	if _, err := os.Stat(file); err == nil {
+		exp.skippedFiles++
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0750); err != nil {
+		return err
+	}
+
+	var w exportWriter
+	var err error
+	if exp.format == ExportFormatParquet {
+		w, err = exp.newParquetWriter(file, mst, schema)
+	} else {
+		w, err = exp.newTextWriter(file, mst, schema)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the rows of every chunk are written as soon as the chunk is received, the window is never held in memory
+	fields := make([]string, 0, len(schema.fields))
+	for _, field := range schema.fields {
+		fields = append(fields, influxql.QuoteIdent(field))
+	}
+	err = exp.queryRows(fmt.Sprintf("SELECT %s FROM %s WHERE time >= %d AND time < %d GROUP BY *",
+		strings.Join(fields, ","), influxql.QuoteIdent(exp.retentionPolicy, mst), start.UnixNano(), end.UnixNano()), w.writeRow)
+	if err == nil {
+		err = w.commit()
+	}
+	if err != nil {
+		w.abort()
+		return err
+	}
+
+	exp.totalPoints += w.points()
+	exp.totalFiles++
+	exp.stdoutLogger.Printf("Exported %d points into %s\n", w.points(), file)
+	return nil
+}
+
+// exportWriter writes the series of a window to its file as they are received. The file is written to a temporary
+// file, which is renamed to the file by commit, so that an incomplete window is never regarded as exported.
+type exportWriter interface {
+	writeRow(row *models.Row) error
+	commit() error
+	// abort removes the temporary file
+	abort()
+	points() int
+}
+
+// textExportWriter writes the rows in the line protocol or the csv format.
+type textExportWriter struct {
+	tmp  string
+	file string
+	f    *os.File
+	bw   *bufio.Writer
+	gw   *gzip.Writer
+	w    io.Writer
+
+	mst    string
+	schema *measurementSchema
+	cw     *csv.Writer
+	record []string
+	n      int
+}
+
+func (exp *Exporter) newTextWriter(file, mst string, schema *measurementSchema) (*textExportWriter, error) {
+	tmp := file + exportTmpSuffix
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+	w := &textExportWriter{tmp: tmp, file: file, f: f, bw: bufio.NewWriter(f), mst: mst, schema: schema}
+	w.w = w.bw
+	if exp.compress {
+		w.gw = gzip.NewWriter(w.bw)
+		w.w = w.gw
+	}
+
+	if exp.format == ExportFormatCSV {
+		w.cw = csv.NewWriter(w.w)
+		header := append(append([]string{"time"}, schema.tags...), schema.fields...)
+		w.record = make([]string, len(header))
+		err = w.cw.Write(header)
+	} else {
+		// the header the import command reads
+		_, err = fmt.Fprintf(w.w, "# DML\n# CONTEXT-DATABASE: %s\n# CONTEXT-RETENTION-POLICY: %s\n", exp.database, exp.retentionPolicy)
+	}
+	if err != nil {
+		w.abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *textExportWriter) writeRow(row *models.Row) error {
+	var n int
+	var err error
+	if w.cw != nil {
+		n, err = writeCSV(w.cw, w.schema, w.record, row)
+	} else {
+		n, err = writeLineProtocol(w.w, w.mst, w.schema, row)
+	}
+	w.n += n
+	return err
+}
+
+func (w *textExportWriter) commit() error {
+	if w.cw != nil {
+		w.cw.Flush()
+		if err := w.cw.Error(); err != nil {
+			return err
+		}
+	}
+	if w.gw != nil {
+		if err := w.gw.Close(); err != nil {
+			return err
+		}
+	}
+	if err := w.bw.Flush(); err != nil {
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		return err
+	}
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.tmp, w.file)
+}
+
+func (w *textExportWriter) abort() {
+	_ = w.f.Close()
+	_ = os.Remove(w.tmp)
+}
+
+func (w *textExportWriter) points() int {
+	return w.n
+}
+
+// writeLineProtocol writes the values of a series in the format the import command reads.
+func writeLineProtocol(w io.Writer, mst string, schema *measurementSchema, row *models.Row) (int, error) {
+	var points int
+	tags := models.NewTags(row.Tags)
+	for _, values := range row.Values {
+		tm, fields, err := rowValues(row.Columns, values, schema)
+		if err != nil {
+			return points, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		p, err := models.NewPoint(mst, tags, fields, time.Unix(0, tm))
+		if err != nil {
+			return points, err
+		}
+		if _, err = io.WriteString(w, p.String()+"\n"); err != nil {
+			return points, err
+		}
+		points++
+	}
+	return points, nil
+}
+
+// writeCSV writes the values of a series as the records of time, the tag keys and the field keys.
+func writeCSV(cw *csv.Writer, schema *measurementSchema, record []string, row *models.Row) (int, error) {
+	var points int
+	for i, tag := range schema.tags {
+		record[i+1] = row.Tags[tag]
+	}
+	for _, values := range row.Values {
+		tm, fields, err := rowValues(row.Columns, values, schema)
+		if err != nil {
+			return points, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		record[0] = time.Unix(0, tm).UTC().Format(time.RFC3339Nano)
+		for i, field := range schema.fields {
+			record[i+1+len(schema.tags)] = formatFieldValue(fields[field])
+		}
+		if err = cw.Write(record); err != nil {
+			return points, err
+		}
+		points++
+	}
+	return points, nil
+}
+
+// parquetExportWriter writes every series received as a record of the parquet file.
+type parquetExportWriter struct {
+	file      string
+	w         *parquet.Writer
+	schema    *measurementSchema
+	recSchema []record.Field
+	n         int
+}
+
+func (exp *Exporter) newParquetWriter(file, mst string, schema *measurementSchema) (*parquetExportWriter, error) {
+	recSchema := make([]record.Field, 0, len(schema.tags)+len(schema.fields)+1)
+	schemas := make(map[string]uint8, cap(recSchema))
+	for _, tag := range schema.tags {
+		recSchema = append(recSchema, record.Field{Name: tag, Type: influx.Field_Type_String})
+	}
+	for _, field := range schema.fields {
+		recSchema = append(recSchema, record.Field{Name: field, Type: schema.types[field]})
+	}
+	recSchema = append(recSchema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+	for _, f := range recSchema {
+		schemas[f.Name] = uint8(f.Type)
+	}
+
+	w, err := parquet.NewWriter(file, "", parquet.MetaData{Mst: mst, Schemas: schemas})
+	if err != nil {
+		return nil, err
+	}
+	return &parquetExportWriter{file: file, w: w, schema: schema, recSchema: recSchema}, nil
+}
+
+func (w *parquetExportWriter) writeRow(row *models.Row) error {
+	schema := w.schema
+	rec := record.NewRecordBuilder(w.recSchema)
+	for _, values := range row.Values {
+		tm, fields, err := rowValues(row.Columns, values, schema)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		for i, tag := range schema.tags {
+			if v, ok := row.Tags[tag]; ok && v != "" {
+				rec.ColVals[i].AppendString(v)
+			} else {
+				rec.ColVals[i].AppendStringNull()
+			}
+		}
+		for i, field := range schema.fields {
+			appendFieldValue(&rec.ColVals[i+len(schema.tags)], schema.types[field], fields[field])
+		}
+		rec.ColVals[len(w.recSchema)-1].AppendInteger(tm)
+		w.n++
+	}
+	if rec.RowNums() == 0 {
+		return nil
+	}
+	return w.w.WriteRecord(parquetSeries(schema.tags, row.Tags), rec)
+}
+
+func (w *parquetExportWriter) commit() error {
+	// the temporary file of the parquet writer is renamed by WriteStop
+	err := w.w.WriteStop()
+	w.w.Close()
+	return err
+}
+
+func (w *parquetExportWriter) abort() {
+	w.w.Close()
+	_ = os.Remove(w.file + exportTmpSuffix)
+}
+
+func (w *parquetExportWriter) points() int {
+	return w.n
+}
+
+// parquetSeries returns the series of the tags in the format of the files converted from TSSP files, k1=v1,k2=v2.
+func parquetSeries(keys []string, tags map[string]string) string {
+	var sb strings.Builder
+	for _, key := range keys {
+		if v := tags[key]; v != "" {
+			if sb.Len() > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(key)
+			sb.WriteByte('=')
+			sb.WriteString(v)
+		}
+	}
+	return sb.String()
+}
+
+// rowValues returns the time and the non-null fields of the values of a row.
+func rowValues(columns []string, values []interface{}, schema *measurementSchema) (int64, models.Fields, error) {
+	var tm int64
+	fields := make(models.Fields, len(columns))
+	for i, column := range columns {
+		if i >= len(values) || values[i] == nil {
+			continue
+		}
+		if column == "time" {
+			n, ok := values[i].(json.Number)
+			if !ok {
+				return 0, nil, fmt.Errorf("invalid time %v", values[i])
+			}
+			t, err := n.Int64()
+			if err != nil {
+				return 0, nil, fmt.Errorf("invalid time %v: %s", values[i], err)
+			}
+			tm = t
+			continue
+		}
+		typ, ok := schema.types[column]
+		if !ok {
+			continue
+		}
+		v, err := fieldValue(typ, values[i])
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid value of field %s: %s", column, err)
+		}
+		fields[column] = v
+	}
+	return tm, fields, nil
+}
+
+func fieldValue(typ int, v interface{}) (interface{}, error) {
+	switch typ {
+	case influx.Field_Type_Float:
+		if n, ok := v.(json.Number); ok {
+			return n.Float64()
+		}
+	case influx.Field_Type_Int:
+		if n, ok := v.(json.Number); ok {
+			return n.Int64()
+		}
+	case influx.Field_Type_String:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case influx.Field_Type_Boolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected value %v", v)
+}
+
+func formatFieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func appendFieldValue(cv *record.ColVal, typ int, v interface{}) {
+	switch typ {
+	case influx.Field_Type_Float:
+		if f, ok := v.(float64); ok {
+			cv.AppendFloat(f)
+		} else {
+			cv.AppendFloatNull()
+		}
+	case influx.Field_Type_Int:
+		if i, ok := v.(int64); ok {
+			cv.AppendInteger(i)
+		} else {
+			cv.AppendIntegerNull()
+		}
+	case influx.Field_Type_String:
+		if s, ok := v.(string); ok {
+			cv.AppendString(s)
+		} else {
+			cv.AppendStringNull()
+		}
+	case influx.Field_Type_Boolean:
+		if b, ok := v.(bool); ok {
+			cv.AppendBoolean(b)
+		} else {
+			cv.AppendBooleanNull()
+		}
+	}
+}
+
+func columnIndex(columns []string, name string) int {
+	for i, column := range columns {
+		if column == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geminicli
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exportMockClient struct {
+	mockClient
+	queries []string
+}
+
+func (m *exportMockClient) QueryContext(ctx context.Context, query client.Query) (*client.Response, error) {
+	m.queries = append(m.queries, query.Command)
+	var rows []models.Row
+	switch {
+	case strings.HasPrefix(query.Command, "SHOW RETENTION POLICIES"):
+		rows = []models.Row{{Columns: []string{"name", "duration", "default"}, Values: [][]interface{}{
+			{"rp0", "0s", false}, {"autogen", "0s", true},
+		}}}
+	case strings.HasPrefix(query.Command, "SHOW MEASUREMENTS"):
+		rows = []models.Row{{Columns: []string{"name"}, Values: [][]interface{}{{"cpu"}}}}
+	case strings.HasPrefix(query.Command, "SHOW TAG KEYS"):
+		rows = []models.Row{{Columns: []string{"tagKey"}, Values: [][]interface{}{{"host"}}}}
+	case strings.HasPrefix(query.Command, "SHOW FIELD KEYS"):
+		rows = []models.Row{{Columns: []string{"fieldKey", "fieldType"}, Values: [][]interface{}{
+			{"idle", "float"}, {"count", "integer"}, {"msg", "string"}, {"ok", "boolean"},
+		}}}
+	case strings.Contains(query.Command, "time >= 0 AND"):
+		rows = []models.Row{
+			{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "count", "idle", "msg", "ok"}, Values: [][]interface{}{
+				{json.Number("1"), json.Number("3"), json.Number("0.5"), "x y", true},
+				{json.Number("2"), nil, json.Number("1.5"), nil, nil},
+			}},
+			{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: []string{"time", "count", "idle", "msg", "ok"}, Values: [][]interface{}{
+				{json.Number("3"), json.Number("4"), nil, nil, false},
+			}},
+		}
+	}
+	return &client.Response{Results: []client.Result{{Series: rows}}}, nil
+}
+
+func newTestExporter(t *testing.T) (*Exporter, *exportMockClient) {
+	mc := &exportMockClient{}
+	exp := NewExporter()
+	exp.clientCreator = func(config client.Config) (HttpClient, error) {
+		return mc, nil
+	}
+	exp.stdoutLogger.SetOutput(io.Discard)
+	return exp, mc
+}
+
+func readExportFile(t *testing.T, file string, compressed bool) string {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	var r io.Reader = f
+	if compressed {
+		gr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		r = gr
+	}
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestExporter_Export_Check(t *testing.T) {
+	exp, _ := newTestExporter(t)
+	for _, tc := range []struct {
+		config CommandLineConfig
+		err    string
+	}{
+		{CommandLineConfig{Out: "x", Start: "1970-01-01T00:00:00Z"}, "execute -export cmd, -database is required"},
+		{CommandLineConfig{Database: "db0", Start: "1970-01-01T00:00:00Z"}, "execute -export cmd, -out is required"},
+		{CommandLineConfig{Database: "db0", Out: "x"}, "execute -export cmd, -start is required"},
+		{CommandLineConfig{Database: "db0", Out: "x", Start: "2024-01-01T00:00:00Z", End: "2023-01-01T00:00:00Z"},
+			"start time 2024-01-01T00:00:00Z must be before end time 2023-01-01T00:00:00Z"},
+		{CommandLineConfig{Database: "db0", Out: "x", Start: "1970-01-01T00:00:00Z", Format: "json"},
+			`unknown format "json". format must be lp, csv or parquet`},
+		{CommandLineConfig{Database: "db0", Out: "x", Start: "1970-01-01T00:00:00Z", Format: "parquet", Compress: true},
+			"compress is not supported by the parquet format, which is always compressed"},
+	} {
+		err := exp.Export(&tc.config)
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
+func TestExporter_Export_LineProtocol(t *testing.T) {
+	exp, mc := newTestExporter(t)
+	out := t.TempDir()
+	config := CommandLineConfig{
+		Database: "db0",
+		Start:    "1970-01-01T00:00:00Z",
+		End:      "1970-01-01T02:00:00Z",
+		Out:      out,
+		Compress: true,
+		Window:   time.Hour,
+	}
+	require.NoError(t, exp.Export(&config))
+
+	file := filepath.Join(out, "db0", "autogen", "cpu", "0_3600000000000.lp.gz")
+	assert.Equal(t, `# DML
+# CONTEXT-DATABASE: db0
+# CONTEXT-RETENTION-POLICY: autogen
+cpu,host=a count=3i,idle=0.5,msg="x y",ok=true 1
+cpu,host=a idle=1.5 2
+cpu,host=b count=4i,ok=false 3
+`, readExportFile(t, file, true))
+	assert.Equal(t, `SELECT count,idle,msg,ok FROM "autogen".cpu WHERE time >= 0 AND time < 3600000000000 GROUP BY *`, mc.queries[4])
+	assert.Equal(t, 3, exp.totalPoints)
+	assert.Equal(t, 2, exp.totalFiles)
+
+	// the windows exported are skipped
+	exp, mc = newTestExporter(t)
+	require.NoError(t, exp.Export(&config))
+	assert.Equal(t, 2, exp.skippedFiles)
+	for _, query := range mc.queries {
+		assert.False(t, strings.HasPrefix(query, "SELECT"), query)
+	}
+}
+
+func TestExporter_Export_CSV(t *testing.T) {
+	exp, _ := newTestExporter(t)
+	out := t.TempDir()
+	require.NoError(t, exp.Export(&CommandLineConfig{
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Measurement:     "cpu",
+		Start:           "1970-01-01T00:00:00Z",
+		End:             "1970-01-01T01:00:00Z",
+		Out:             out,
+		Format:          ExportFormatCSV,
+	}))
+
+	file := filepath.Join(out, "db0", "rp0", "cpu", "0_3600000000000.csv")
+	assert.Equal(t, `time,host,count,idle,msg,ok
+1970-01-01T00:00:00.000000001Z,a,3,0.5,x y,true
+1970-01-01T00:00:00.000000002Z,a,,1.5,,
+1970-01-01T00:00:00.000000003Z,b,4,,,false
+`, readExportFile(t, file, false))
+}
+
+func TestExporter_Export_Parquet(t *testing.T) {
+	exp, _ := newTestExporter(t)
+	out := t.TempDir()
+	require.NoError(t, exp.Export(&CommandLineConfig{
+		Database: "db0",
+		Start:    "1970-01-01T00:00:00Z",
+		End:      "1970-01-01T01:00:00Z",
+		Out:      out,
+		Format:   ExportFormatParquet,
+	}))
+
+	info, err := os.Stat(filepath.Join(out, "db0", "autogen", "cpu", "0_3600000000000.parquet"))
+	require.NoError(t, err)
+	assert.True(t, info.Size() > 0)
+	assert.Equal(t, 3, exp.totalPoints)
+}
+
+func newChunkedServer(t *testing.T, chunks map[string][]string) *CommandLineConfig {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		assert.Equal(t, "true", r.URL.Query().Get("chunked"))
+		assert.Equal(t, "ns", r.URL.Query().Get("epoch"))
+		q := r.URL.Query().Get("q")
+		for prefix, lines := range chunks {
+			if !strings.HasPrefix(q, prefix) {
+				continue
+			}
+			for _, line := range lines {
+				_, _ = io.WriteString(w, line+"\n")
+				w.(http.Flusher).Flush()
+			}
+			return
+		}
+		_, _ = io.WriteString(w, `{"results":[{"statement_id":0}]}`+"\n")
+	}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	return &CommandLineConfig{Host: u.Hostname(), Port: port, Database: "db0", RetentionPolicy: "autogen",
+		Measurement: "cpu", Start: "1970-01-01T00:00:00Z", End: "1970-01-01T01:00:00Z", Out: t.TempDir()}
+}
+
+func TestExporter_Export_Chunked(t *testing.T) {
+	config := newChunkedServer(t, map[string][]string{
+		"SHOW TAG KEYS":   {`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["tagKey"],"values":[["host"]]}]}]}`},
+		"SHOW FIELD KEYS": {`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["fieldKey","fieldType"],"values":[["idle","float"]]}]}]}`},
+		"SELECT": {
+			`{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","idle"],"values":[[1,0.5]],"partial":true}],"partial":true}]}`,
+			`{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","idle"],"values":[[2,1.5]]}],"partial":true}]}`,
+			`{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"b"},"columns":["time","idle"],"values":[[3,2.5]]}]}]}`,
+		},
+	})
+	exp := NewExporter()
+	exp.stdoutLogger.SetOutput(io.Discard)
+	require.NoError(t, exp.Export(config))
+
+	file := filepath.Join(config.Out, "db0", "autogen", "cpu", "0_3600000000000.lp")
+	assert.Equal(t, `# DML
+# CONTEXT-DATABASE: db0
+# CONTEXT-RETENTION-POLICY: autogen
+cpu,host=a idle=0.5 1
+cpu,host=a idle=1.5 2
+cpu,host=b idle=2.5 3
+`, readExportFile(t, file, false))
+	assert.Equal(t, 3, exp.totalPoints)
+}
+
+func TestExporter_Export_ChunkedError(t *testing.T) {
+	config := newChunkedServer(t, map[string][]string{
+		"SHOW FIELD KEYS": {`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["fieldKey","fieldType"],"values":[["idle","float"]]}]}]}`},
+		"SELECT": {
+			`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","idle"],"values":[[1,0.5]]}],"partial":true}]}`,
+			`{"results":[{"statement_id":0,"error":"query interrupted"}]}`,
+		},
+	})
+	exp := NewExporter()
+	exp.stdoutLogger.SetOutput(io.Discard)
+	err := exp.Export(config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query interrupted")
+
+	// neither the file nor the temporary file of the window is left
+	entries, err := os.ReadDir(filepath.Join(config.Out, "db0", "autogen", "cpu"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
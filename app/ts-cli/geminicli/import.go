@@ -28,10 +28,16 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb/client"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 )
 
 const (
 	batchSize = 5000
+
+	ImportFormatLineProtocol = "lp"
+	ImportFormatParquet      = "parquet"
 )
 
 // Importer is the importer used for importing data
@@ -128,6 +134,20 @@ func (ipt *Importer) Import(clc *CommandLineConfig) error {
 	if clc.Path == "" {
 		return fmt.Errorf("execute -import cmd, -path is required")
 	}
+	switch clc.Format {
+	case "", ImportFormatLineProtocol:
+	case ImportFormatParquet:
+		if clc.Database == "" {
+			return fmt.Errorf("execute -import cmd, -database is required by the parquet format")
+		}
+		if clc.Measurement == "" {
+			return fmt.Errorf("execute -import cmd, -measurement is required by the parquet format")
+		}
+		// the time of the parquet files is always in nanoseconds
+		ipt.precision = "ns"
+	default:
+		return fmt.Errorf("unknown format %q. format must be lp or parquet", clc.Format)
+	}
 
 	// Create a new client and ping it.
 	cli, err := ipt.clientCreator(*config)
@@ -147,6 +167,13 @@ func (ipt *Importer) Import(clc *CommandLineConfig) error {
 		}
 	}()
 
+	if clc.Format == ImportFormatParquet {
+		if err = ipt.processParquet(clc); err != nil {
+			return err
+		}
+		return ipt.insertError()
+	}
+
 	f, err := os.Open(clc.Path)
 	if err != nil {
 		return fmt.Errorf("fail to open file %s, %s", clc.Path, err)
@@ -171,6 +198,10 @@ func (ipt *Importer) Import(clc *CommandLineConfig) error {
 		return fmt.Errorf("reading standard input: %s", err)
 	}
 
+	return ipt.insertError()
+}
+
+func (ipt *Importer) insertError() error {
 	if ipt.failedInserts > 0 {
 		plural := " was"
 		if ipt.failedInserts > 1 {
@@ -237,6 +268,65 @@ func (ipt *Importer) processDML(scanner *bufio.Reader) error {
 	}
 }
 
+// processParquet writes the rows of the parquet file into the measurement as line protocol.
+func (ipt *Importer) processParquet(clc *CommandLineConfig) error {
+	reader, err := parquet.OpenReader(clc.Path, parquet.ReaderOptions{TagColumns: clc.Tags, TimeColumn: clc.TimeColumn})
+	if err != nil {
+		return fmt.Errorf("fail to open file %s, %s", clc.Path, err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	ipt.database = clc.Database
+	ipt.retentionPolicy = clc.RetentionPolicy
+	ipt.startTime = time.Now()
+	var rows []influx.Row
+	for {
+		rec, err := reader.Read()
+		if err == io.EOF {
+			ipt.batchWrite()
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("reading file %s: %s", clc.Path, err)
+		}
+
+		rows = parquet.RecordToRows(rows[:0], clc.Measurement, rec)
+		for i := range rows {
+			line, err := rowToLine(&rows[i])
+			if err != nil {
+				return err
+			}
+			ipt.batchAccumulator(line)
+		}
+	}
+}
+
+func rowToLine(row *influx.Row) (string, error) {
+	tags := make(map[string]string, len(row.Tags))
+	for _, tag := range row.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	fields := make(models.Fields, len(row.Fields))
+	for _, field := range row.Fields {
+		switch field.Type {
+		case influx.Field_Type_Float:
+			fields[field.Key] = field.NumValue
+		case influx.Field_Type_Int:
+			fields[field.Key] = int64(field.NumValue)
+		case influx.Field_Type_Boolean:
+			fields[field.Key] = field.NumValue == 1
+		case influx.Field_Type_String:
+			fields[field.Key] = field.StrValue
+		}
+	}
+	pt, err := models.NewPoint(row.Name, models.NewTags(tags), fields, time.Unix(0, row.Timestamp))
+	if err != nil {
+		return "", err
+	}
+	return pt.String(), nil
+}
+
 func (ipt *Importer) execute(command string) {
 	response, err := ipt.client.QueryContext(context.TODO(), client.Query{Command: command, Database: ipt.database})
 	if err != nil {
@@ -16,6 +16,7 @@ package geminicli
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/influxdata/influxdb/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseConnectionString(t *testing.T) {
@@ -167,3 +169,55 @@ h2o_feet,location=coyote_creek water_level=8.005,level\ description="between 6 a
 		assert.NoError(t, err)
 	})
 }
+
+type importParquetMockClient struct {
+	mockClient
+	database string
+	lines    []string
+}
+
+func (m *importParquetMockClient) WriteLineProtocol(data, database, retentionPolicy, precision, writeConsistency string) (*client.Response, error) {
+	m.database = database
+	m.lines = append(m.lines, strings.Split(data, "\n")...)
+	return &client.Response{}, nil
+}
+
+func TestImporter_Import_Parquet(t *testing.T) {
+	// the parquet files exported are imported back
+	exp, _ := newTestExporter(t)
+	out := t.TempDir()
+	require.NoError(t, exp.Export(&CommandLineConfig{
+		Database: "db0",
+		Start:    "1970-01-01T00:00:00Z",
+		End:      "1970-01-01T01:00:00Z",
+		Out:      out,
+		Format:   ExportFormatParquet,
+	}))
+
+	mc := &importParquetMockClient{}
+	testImporter := NewImporter()
+	testImporter.clientCreator = func(config client.Config) (HttpClient, error) {
+		return mc, nil
+	}
+	testImporter.stdoutLogger.SetOutput(io.Discard)
+	config := CommandLineConfig{
+		Path:        filepath.Join(out, "db0", "autogen", "cpu", "0_3600000000000.parquet"),
+		Format:      ImportFormatParquet,
+		Database:    "db1",
+		Measurement: "cpu",
+		Precision:   "s",
+	}
+	require.NoError(t, testImporter.Import(&config))
+	assert.Equal(t, "db1", mc.database)
+	assert.Equal(t, "ns", testImporter.precision)
+	assert.Equal(t, []string{
+		`cpu,host=a count=3i,idle=0.5,msg="x y",ok=true 1`,
+		`cpu,host=a idle=1.5 2`,
+		`cpu,host=b count=4i,ok=false 3`,
+	}, mc.lines)
+
+	config.Measurement = ""
+	assert.EqualError(t, testImporter.Import(&config), "execute -import cmd, -measurement is required by the parquet format")
+	config.Format = "csv"
+	assert.EqualError(t, testImporter.Import(&config), `unknown format "csv". format must be lp or parquet`)
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+const (
+	tsspFileSuffix = ".tssp"
+	unorderedDir   = "out-of-order"
+)
+
+func init() {
+	fileops.SetBackgroundReadLimiter(800 * 1024 * 1024)
+}
+
+type Options struct {
+	// DataDir is the data directory of ts-store, or any directory below it, such as the directory of a shard
+	DataDir string
+	// QuarantineDir is the directory the corrupted files are moved into, with the same relative path as in DataDir
+	QuarantineDir string
+	// Report is the file the JSON report is written to, stdout if empty
+	Report string
+}
+
+// Verifier checks the TSSP files of the shards in the data directory while ts-store is stopped
+type Verifier struct {
+	opt    *Options
+	report *immutable.VerifyReport
+}
+
+func NewVerifier(opt *Options) *Verifier {
+	return &Verifier{
+		opt:    opt,
+		report: &immutable.VerifyReport{},
+	}
+}
+
+// Verify verifies all the shards and writes the report, an error is returned if any corrupted file is found
+func (v *Verifier) Verify() error {
+	if _, err := os.Stat(v.opt.DataDir); err != nil {
+		return err
+	}
+	if err := v.walk(); err != nil {
+		return err
+	}
+	if err := v.writeReport(); err != nil {
+		return err
+	}
+	if v.report.Corrupted > 0 {
+		return fmt.Errorf("found %d corrupted files in %d files, %d files are quarantined",
+			v.report.Corrupted, v.report.Files, v.report.Quarantined)
+	}
+	return nil
+}
+
+func (v *Verifier) Report() *immutable.VerifyReport {
+	return v.report
+}
+
+func (v *Verifier) walk() error {
+	return filepath.WalkDir(v.opt.DataDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		switch d.Name() {
+		case immutable.TsspDirName:
+			v.verifyShard(path, false)
+		case immutable.ColumnStoreDirName:
+			v.verifyShard(path, true)
+		default:
+			return nil
+		}
+		return filepath.SkipDir
+	})
+}
+
+// verifyShard verifies the files of the measurements in dir, which is the tssp or columnstore directory of a shard
+func (v *Verifier) verifyShard(dir string, cs bool) {
+	shard := &immutable.ShardVerifyReport{Path: dir}
+	msts, err := os.ReadDir(dir)
+	if err != nil {
+		shard.Files = append(shard.Files, &immutable.FileVerifyReport{Path: dir, Errors: []string{err.Error()}})
+		v.report.Add(shard)
+		return
+	}
+
+	for _, mst := range msts {
+		if !mst.IsDir() {
+			continue
+		}
+		mstDir := filepath.Join(dir, mst.Name())
+		v.verifyFiles(shard, mstDir, mst.Name(), true, cs)
+		if !cs {
+			v.verifyFiles(shard, filepath.Join(mstDir, unorderedDir), mst.Name(), false, cs)
+		}
+	}
+	v.report.Add(shard)
+}
+
+func (v *Verifier) verifyFiles(shard *immutable.ShardVerifyReport, dir, mst string, isOrder, cs bool) {
+	items, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			shard.Files = append(shard.Files, &immutable.FileVerifyReport{Path: dir, Measurement: mst, Errors: []string{err.Error()}})
+		}
+		return
+	}
+
+	for _, item := range items {
+		if item.IsDir() || filepath.Ext(item.Name()) != tsspFileSuffix {
+			continue
+		}
+		file := filepath.Join(dir, item.Name())
+		report := immutable.VerifyTSSPFileByPath(file, isOrder)
+		report.Measurement = mst
+		if cs {
+			v.verifyPKFile(report)
+		}
+		if report.Corrupted() && v.opt.QuarantineDir != "" {
+			v.quarantine(report, cs)
+		}
+		shard.Files = append(shard.Files, report)
+	}
+}
+
+func (v *Verifier) verifyPKFile(report *immutable.FileVerifyReport) {
+	pkFile := pkFileName(report.Path)
+	if _, err := os.Stat(pkFile); err != nil {
+		if !os.IsNotExist(err) {
+			report.Errors = append(report.Errors, err.Error())
+		}
+		return
+	}
+	if err := colstore.VerifyPrimaryKeyFile(pkFile); err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("verify primary key file failed: %v", err))
+	}
+}
+
+func (v *Verifier) quarantine(report *immutable.FileVerifyReport, cs bool) {
+	rel, err := filepath.Rel(v.opt.DataDir, report.Path)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("quarantine file failed: %v", err))
+		return
+	}
+	dst := filepath.Join(v.opt.QuarantineDir, rel)
+	if err = os.MkdirAll(filepath.Dir(dst), 0750); err == nil {
+		err = os.Rename(report.Path, dst)
+	}
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("quarantine file failed: %v", err))
+		return
+	}
+	report.Quarantined = dst
+
+	if !cs {
+		return
+	}
+	pkFile := pkFileName(report.Path)
+	if _, err = os.Stat(pkFile); err != nil {
+		return
+	}
+	if err = os.Rename(pkFile, pkFileName(dst)); err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("quarantine primary key file failed: %v", err))
+	}
+}
+
+func (v *Verifier) writeReport() error {
+	data, err := json.MarshalIndent(v.report, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if v.opt.Report == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(v.opt.Report, data, 0640)
+}
+
+func pkFileName(tsspFile string) string {
+	return strings.TrimSuffix(tsspFile, tsspFileSuffix) + colstore.IndexFileSuffix
+}
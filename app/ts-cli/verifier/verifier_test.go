@@ -0,0 +1,96 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/app/ts-cli/verifier"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func buildFile(t *testing.T, dir string, seq uint64) string {
+	lockPath := ""
+	fileName := immutable.NewTSSPFileName(seq, 0, 0, 0, true, &lockPath)
+	builder := immutable.NewMsBuilder(dir, "mst", &lockPath, immutable.GetTsStoreConfig(), 0, fileName, 1, nil, 2, config.TSSTORE, nil, 0)
+
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Int, Name: "int"},
+		record.Field{Type: influx.Field_Type_Float, Name: "float"},
+		record.Field{Type: influx.Field_Type_Int, Name: record.TimeField},
+	}
+	rec := record.NewRecordBuilder(schema)
+	rec.Column(0).AppendIntegers(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	rec.Column(1).AppendFloats(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	rec.AppendTime(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	require.NoError(t, builder.WriteData(1, rec))
+
+	file, err := builder.NewTSSPFile(false)
+	require.NoError(t, err)
+	path := file.Path()
+	require.NoError(t, file.Close())
+	return path
+}
+
+func TestVerifier(t *testing.T) {
+	dataDir := t.TempDir()
+	shardDir := filepath.Join(dataDir, "db0", "0", "rp0", "1_0_100_1", immutable.TsspDirName)
+	good := buildFile(t, shardDir, 1)
+	bad := buildFile(t, shardDir, 2)
+	require.NoError(t, os.Truncate(bad, 16))
+
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+	quarantineDir := t.TempDir()
+	v := verifier.NewVerifier(&verifier.Options{
+		DataDir:       dataDir,
+		QuarantineDir: quarantineDir,
+		Report:        reportFile,
+	})
+	require.EqualError(t, v.Verify(), "found 1 corrupted files in 2 files, 1 files are quarantined")
+
+	rel, err := filepath.Rel(dataDir, bad)
+	require.NoError(t, err)
+	require.NoFileExists(t, bad)
+	require.FileExists(t, filepath.Join(quarantineDir, rel))
+	require.FileExists(t, good)
+
+	data, err := os.ReadFile(reportFile)
+	require.NoError(t, err)
+	report := &immutable.VerifyReport{}
+	require.NoError(t, json.Unmarshal(data, report))
+	require.Equal(t, 2, report.Files)
+	require.Equal(t, 1, report.Corrupted)
+	require.Equal(t, 1, len(report.Shards))
+	require.Equal(t, shardDir, report.Shards[0].Path)
+	for _, f := range report.Shards[0].Files {
+		require.Equal(t, "mst", f.Measurement)
+		require.Equal(t, f.Path == bad, f.Corrupted())
+	}
+
+	// the corrupted file has been moved out of the data directory
+	v = verifier.NewVerifier(&verifier.Options{DataDir: dataDir, Report: reportFile})
+	require.NoError(t, v.Verify())
+	require.Equal(t, 1, v.Report().Files)
+
+	v = verifier.NewVerifier(&verifier.Options{DataDir: filepath.Join(dataDir, "not_exist")})
+	require.Error(t, v.Verify())
+}
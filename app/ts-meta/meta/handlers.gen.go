@@ -69,6 +69,8 @@ func New(typ uint8) RPCHandler {
 		return &SendSysCtrlToMeta{}
 	case message.ShowClusterRequestMessage:
 		return &ShowCluster{}
+	case message.GetRuleLeaseRequestMessage:
+		return &GetRuleLease{}
 	default:
 		return nil
 	}
@@ -472,3 +474,22 @@ func (h *ShowCluster) SetRequestMsg(data transport.Codec) error {
 func (h *ShowCluster) Instance() RPCHandler {
 	return &ShowCluster{}
 }
+
+type GetRuleLease struct {
+	BaseHandler
+
+	req *message.GetRuleLeaseRequest
+}
+
+func (h *GetRuleLease) SetRequestMsg(data transport.Codec) error {
+	msg, ok := data.(*message.GetRuleLeaseRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*message.GetRuleLeaseRequest", data)
+	}
+	h.req = msg
+	return nil
+}
+
+func (h *GetRuleLease) Instance() RPCHandler {
+	return &GetRuleLease{}
+}
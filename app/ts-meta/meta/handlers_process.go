@@ -452,6 +452,18 @@ func (h *GetContinuousQueryLease) Process() (transport.Codec, error) {
 	return rsp, nil
 }
 
+func (h *GetRuleLease) Process() (transport.Codec, error) {
+	rsp := &message.GetRuleLeaseResponse{}
+	owner, err := h.store.getRuleLease(h.req.Host)
+	if err != nil {
+		rsp.Err = err.Error()
+		return rsp, nil
+	}
+	rsp.Owner = owner == h.req.Host
+	rsp.Host = owner
+	return rsp, nil
+}
+
 func (h *VerifyDataNodeStatus) Process() (transport.Codec, error) {
 	rsp := &message.VerifyDataNodeStatusResponse{}
 	err := h.store.verifyDataNodeStatus(h.req.NodeID)
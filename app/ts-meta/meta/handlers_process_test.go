@@ -219,6 +219,27 @@ func TestGetCqLeaseProcess(t *testing.T) {
 	}
 }
 
+func TestGetRuleLeaseProcess(t *testing.T) {
+	mockStore := NewMockRPCStore()
+	msg := message.NewMetaMessage(message.GetRuleLeaseRequestMessage, &message.GetRuleLeaseRequest{
+		Host: "localhost:8086",
+	})
+	h := New(msg.Type())
+	h.InitHandler(mockStore, nil, nil)
+	var err error
+	if err = h.SetRequestMsg(msg.Data()); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := h.Process()
+	if err != nil {
+		t.Fatal("TestGetRuleLeaseProcess fail", err)
+	}
+	if leaseRsp := rsp.(*message.GetRuleLeaseResponse); !leaseRsp.Owner || leaseRsp.Host != "localhost:8086" {
+		t.Fatal("TestGetRuleLeaseProcess fail, the lease is not granted")
+	}
+}
+
 func TestVerifyDataNodeStatusProcess(t *testing.T) {
 	mockStore := NewMockRPCStore()
 	msg := message.NewMetaMessage(message.VerifyDataNodeStatusRequestMessage, &message.VerifyDataNodeStatusRequest{
@@ -1112,6 +1112,57 @@ func (o *GetContinuousQueryLeaseResponse) Instance() transport.Codec {
 	return &GetContinuousQueryLeaseResponse{}
 }
 
+func (o *GetRuleLeaseRequest) Marshal(buf []byte) ([]byte, error) {
+	buf = codec.AppendString(buf, o.Host)
+	return buf, nil
+}
+
+func (o *GetRuleLeaseRequest) Unmarshal(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	o.Host = dec.String()
+	return nil
+}
+
+func (o *GetRuleLeaseRequest) Size() int {
+	return codec.SizeOfString(o.Host)
+}
+
+func (o *GetRuleLeaseRequest) Instance() transport.Codec {
+	return &GetRuleLeaseRequest{}
+}
+
+func (o *GetRuleLeaseResponse) Marshal(buf []byte) ([]byte, error) {
+	buf = codec.AppendBool(buf, o.Owner)
+	buf = codec.AppendString(buf, o.Err)
+	buf = codec.AppendString(buf, o.Host)
+	return buf, nil
+}
+
+func (o *GetRuleLeaseResponse) Unmarshal(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	o.Owner = dec.Bool()
+	o.Err = dec.String()
+	o.Host = dec.String()
+	return nil
+}
+
+func (o *GetRuleLeaseResponse) Size() int {
+	size := codec.SizeOfBool()
+	size += codec.SizeOfString(o.Err)
+	size += codec.SizeOfString(o.Host)
+	return size
+}
+
+func (o *GetRuleLeaseResponse) Instance() transport.Codec {
+	return &GetRuleLeaseResponse{}
+}
+
 func (req *VerifyDataNodeStatusRequest) Marshal(buf []byte) ([]byte, error) {
 	buf = codec.AppendUint64(buf, req.NodeID)
 	return buf, nil
@@ -258,6 +258,16 @@ type GetContinuousQueryLeaseResponse struct {
 	Err     string
 }
 
+type GetRuleLeaseRequest struct {
+	Host string
+}
+
+type GetRuleLeaseResponse struct {
+	Owner bool
+	Err   string
+	Host  string // the sql host holding the lease
+}
+
 type VerifyDataNodeStatusRequest struct {
 	NodeID uint64 // datanode node id
 }
@@ -202,6 +202,10 @@ func (s *MockRPCStore) getContinuousQueryLease(host string) ([]string, error) {
 	return nil, nil
 }
 
+func (s *MockRPCStore) getRuleLease(host string) (string, error) {
+	return host, nil
+}
+
 func (s *MockRPCStore) verifyDataNodeStatus(nodeID uint64) error {
 	return nil
 }
@@ -0,0 +1,51 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"time"
+
+	"github.com/hashicorp/raft"
+	"go.uber.org/zap"
+)
+
+// getRuleLease grants the lease of the prometheus rules to the sql host, if no other sql host holds it,
+// and returns the sql host holding the lease.
+// Only one ts-sql evaluates the rules, the lease is renewed by every request of the owner and
+// taken over by another sql host when the owner has not renewed it for heartbeatToleranceDuration.
+// The lease is kept in the memory of the leader only, after a leader change it is granted to the
+// first sql host renewing it, which is usually the previous owner as it renews the lease every second.
+func (s *Store) getRuleLease(host string) (string, error) {
+	if !s.IsLeader() {
+		return "", raft.ErrNotLeader
+	}
+
+	s.ruleLock.Lock()
+	defer s.ruleLock.Unlock()
+
+	now := time.Now()
+	lease := s.ruleLease
+	if lease != nil && lease.Host != host && now.Sub(lease.LastHeartbeatTime) <= heartbeatToleranceDuration {
+		return lease.Host, nil
+	}
+	if lease == nil || lease.Host != host {
+		s.Logger.Info("grant the rule lease", zap.String("sql host", host))
+	}
+	s.ruleLease = &HeartbeatInfo{
+		Host:              host,
+		LastHeartbeatTime: now,
+	}
+	return host, nil
+}
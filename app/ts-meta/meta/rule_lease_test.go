@@ -0,0 +1,60 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_getRuleLease(t *testing.T) {
+	s := &Store{
+		raft:   &MockRaftForCQ{isLeader: false},
+		Logger: logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop()),
+	}
+	host1 := "127.0.0.1:8086"
+	host2 := "127.0.0.2:8086"
+
+	_, err := s.getRuleLease(host1)
+	require.Equal(t, raft.ErrNotLeader, err)
+
+	s.raft = &MockRaftForCQ{isLeader: true}
+	owner, err := s.getRuleLease(host1)
+	require.NoError(t, err)
+	require.Equal(t, host1, owner)
+
+	// only one sql host holds the lease
+	owner, err = s.getRuleLease(host2)
+	require.NoError(t, err)
+	require.Equal(t, host1, owner)
+	owner, err = s.getRuleLease(host1)
+	require.NoError(t, err)
+	require.Equal(t, host1, owner)
+
+	// the lease not renewed is taken over
+	s.ruleLease.LastHeartbeatTime = time.Now().Add(-2 * heartbeatToleranceDuration)
+	owner, err = s.getRuleLease(host2)
+	require.NoError(t, err)
+	require.Equal(t, host2, owner)
+	owner, err = s.getRuleLease(host1)
+	require.NoError(t, err)
+	require.Equal(t, host2, owner)
+}
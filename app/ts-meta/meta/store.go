@@ -422,7 +422,12 @@ type Store struct {
 	heartbeatInfoList *list.List              // the latest heartbeat information for each ts-sql
 	cqLease           map[string]*cqLeaseInfo // sql host to cq lease.
 	sqlHosts          []string                // sorted hostname ["127.0.0.1:8086", "127.0.0.2:8086", "127.0.0.3:8086"]
-	UseIncSyncData    bool
+
+	// for prometheus rules
+	ruleLock  sync.Mutex
+	ruleLease *HeartbeatInfo // the only ts-sql evaluating the rules
+
+	UseIncSyncData bool
 }
 
 // NewStore will create a new metaStore with the passed in config
@@ -119,6 +119,12 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_UpdateUserCommand:                applyUpdateUser,
 	proto2.Command_SetPrivilegeCommand:              applySetPrivilege,
 	proto2.Command_SetAdminPrivilegeCommand:         applySetAdminPrivilege,
+	proto2.Command_CreateRoleCommand:                applyCreateRole,
+	proto2.Command_DropRoleCommand:                  applyDropRole,
+	proto2.Command_SetMeasurementPrivilegeCommand:   applySetMeasurementPrivilege,
+	proto2.Command_RevokePrivilegeCommand:           applyRevokePrivilege,
+	proto2.Command_SetUserRoleCommand:               applySetUserRole,
+	proto2.Command_UpdatePromMetadataCommand:        applyUpdatePromMetadata,
 	proto2.Command_SetDataCommand:                   applySetData,
 	proto2.Command_CreateMetaNodeCommand:            applyCreateMetaNode,
 	proto2.Command_DeleteMetaNodeCommand:            applyDeleteMetaNode,
@@ -227,6 +233,30 @@ func applySetAdminPrivilege(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applySetAdminPrivilegeCommand(cmd)
 }
 
+func applyCreateRole(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateRoleCommand(cmd)
+}
+
+func applyDropRole(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropRoleCommand(cmd)
+}
+
+func applySetMeasurementPrivilege(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applySetMeasurementPrivilegeCommand(cmd)
+}
+
+func applyRevokePrivilege(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyRevokePrivilegeCommand(cmd)
+}
+
+func applySetUserRole(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applySetUserRoleCommand(cmd)
+}
+
+func applyUpdatePromMetadata(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdatePromMetadataCommand(cmd)
+}
+
 func applySetData(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applySetDataCommand(cmd)
 }
@@ -639,6 +669,30 @@ func (fsm *storeFSM) applySetAdminPrivilegeCommand(cmd *proto2.Command) interfac
 	return meta2.ApplySetAdminPrivilege(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applyCreateRoleCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateRole(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyDropRoleCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropRole(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applySetMeasurementPrivilegeCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplySetMeasurementPrivilege(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyRevokePrivilegeCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyRevokePrivilege(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applySetUserRoleCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplySetUserRole(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyUpdatePromMetadataCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyUpdatePromMetadata(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applySetDataCommand(cmd *proto2.Command) interface{} {
 	ext, _ := proto.GetExtension(cmd, proto2.E_SetDataCommand_Command)
 	v := ext.(*proto2.SetDataCommand)
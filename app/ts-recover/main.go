@@ -29,9 +29,40 @@ import (
 
 const TsRecover = "ts-recover"
 
+const recoverRunUsage = `Recovers the data of the node from a backup, the node must be stopped.
+
+Usage: %s run [flags]
+
+    -config <path>
+            Set the path to the configuration file.
+    -recoverMode <mode>
+            1: recover from the full backup and the incremental backup, 2: recover from the full backup.
+    -fullBackupDataPath <path>
+            Path of the full backup.
+    -incBackupDataPath <path>
+            Path of the incremental backup, required by the recover mode 1.
+
+Selective recover, only the selected data is restored and the other databases are kept:
+
+    -database <name>
+            Database of the backup to restore.
+    -retentionPolicy <name>
+            Retention policy of the database to restore, all by default.
+    -startTime <RFC3339 time>, -endTime <RFC3339 time>
+            Restore the shard groups overlapping the time range only.
+    -targetDatabase <name>
+            Restore the database under a new name.
+    -targetRetentionPolicy <name>
+            Restore the retention policy under a new name, requires -retentionPolicy.
+
+    The target database must not exist: restoring into or merging with an existing database is not
+    supported. The raft log of every meta node must end at its latest snapshot, take a snapshot with
+    POST /userSnapshot on every meta node right before stopping the cluster.
+`
+
 var (
 	recoverUsage = fmt.Sprintf(app.MainUsage, TsRecover, TsRecover)
-	runUsage     = fmt.Sprintf(app.RunUsage, TsRecover, TsRecover)
+	runUsage     = fmt.Sprintf(recoverRunUsage, TsRecover)
 )
 
 func main() {
@@ -82,6 +113,12 @@ func ParseFlags(usage func(), args ...string) (recover.RecoverConfig, error) {
 	fs.StringVar(&options.RecoverMode, "recoverMode", "1", "")
 	fs.StringVar(&options.FullBackupDataPath, "fullBackupDataPath", "", "")
 	fs.StringVar(&options.IncBackupDataPath, "incBackupDataPath", "", "")
+	fs.StringVar(&options.Database, "database", "", "")
+	fs.StringVar(&options.RetentionPolicy, "retentionPolicy", "", "")
+	fs.StringVar(&options.StartTime, "startTime", "", "")
+	fs.StringVar(&options.EndTime, "endTime", "", "")
+	fs.StringVar(&options.TargetDatabase, "targetDatabase", "", "")
+	fs.StringVar(&options.TargetRetentionPolicy, "targetRetentionPolicy", "", "")
 	if err := fs.Parse(args); err != nil {
 		return recover.RecoverConfig{}, err
 	}
@@ -35,6 +35,21 @@ type RecoverConfig struct {
 	ConfigPath         string
 	FullBackupDataPath string
 	IncBackupDataPath  string
+
+	// filters of a selective recover, only the selected data is restored and the others are kept
+	Database        string
+	RetentionPolicy string
+	StartTime       string
+	EndTime         string
+
+	// mapping of a selective recover, the data is restored into the target database or retention policy
+	TargetDatabase        string
+	TargetRetentionPolicy string
+}
+
+func (rc *RecoverConfig) isSelective() bool {
+	return rc.Database != "" || rc.RetentionPolicy != "" || rc.StartTime != "" || rc.EndTime != "" ||
+		rc.TargetDatabase != "" || rc.TargetRetentionPolicy != ""
 }
 
 type RecoverFunc func(rc *RecoverConfig, path string) error
@@ -46,6 +61,18 @@ func BackupRecover(opt *RecoverConfig, tsRecover *config.TsRecover) error {
 	if opt.RecoverMode == "1" && opt.IncBackupDataPath == "" {
 		return fmt.Errorf("`missing required parameter: incBackupDataPath")
 	}
+	if opt.isSelective() {
+		if opt.Database == "" {
+			return fmt.Errorf("`missing required parameter: database")
+		}
+		if opt.TargetRetentionPolicy != "" && opt.RetentionPolicy == "" {
+			return fmt.Errorf("`missing required parameter: retentionPolicy")
+		}
+		if opt.RecoverMode != FullAndIncRecoverMode && opt.RecoverMode != FullRecoverMode {
+			return fmt.Errorf("invalid recovermode")
+		}
+		return recoverSelective(tsRecover, opt)
+	}
 	var err error
 	switch opt.RecoverMode {
 	case FullAndIncRecoverMode:
@@ -0,0 +1,572 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recover
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/crc64"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+	raftboltdb "github.com/openGemini/openGemini/lib/util/lifted/hashicorp/raft-boltdb"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	raftSnapshotDir   = "snapshots"
+	raftSnapshotMeta  = "meta.json"
+	raftSnapshotState = "state.bin"
+	raftLogFile       = "raft.db"
+)
+
+// snapshotMeta is the content of the meta.json of a raft file snapshot.
+type snapshotMeta struct {
+	raft.SnapshotMeta
+	CRC []byte
+}
+
+// selectiveRecover restores a single database, optionally a single retention policy and the shard groups
+// overlapping a time range, from the backup into the current node. The database can be restored under a
+// new name, so the restore never overwrites the meta data or the data files of the other databases.
+type selectiveRecover struct {
+	rc       *RecoverConfig
+	dataPath string
+	start    time.Time
+	end      time.Time
+
+	// shards and indexes map the ids in the backup to the ids reallocated in the current meta data
+	shards  map[uint64]uint64
+	indexes map[uint64]uint64
+}
+
+func newSelectiveRecover(tsRecover *config.TsRecover, rc *RecoverConfig) (*selectiveRecover, error) {
+	s := &selectiveRecover{
+		rc:       rc,
+		dataPath: filepath.Join(tsRecover.Data.DataDir, config.DataDirectory),
+		shards:   make(map[uint64]uint64),
+		indexes:  make(map[uint64]uint64),
+	}
+	var err error
+	if rc.StartTime != "" {
+		if s.start, err = time.Parse(time.RFC3339, rc.StartTime); err != nil {
+			return nil, fmt.Errorf("invalid startTime: %s", err)
+		}
+	}
+	if rc.EndTime != "" {
+		if s.end, err = time.Parse(time.RFC3339, rc.EndTime); err != nil {
+			return nil, fmt.Errorf("invalid endTime: %s", err)
+		}
+	}
+	if !s.start.IsZero() && !s.end.IsZero() && s.end.Before(s.start) {
+		return nil, fmt.Errorf("endTime must not be before startTime")
+	}
+	return s, nil
+}
+
+func (s *selectiveRecover) targetDatabase() string {
+	if s.rc.TargetDatabase != "" {
+		return s.rc.TargetDatabase
+	}
+	return s.rc.Database
+}
+
+func (s *selectiveRecover) targetRetentionPolicy(rp string) string {
+	if s.rc.TargetRetentionPolicy != "" && rp == s.rc.RetentionPolicy {
+		return s.rc.TargetRetentionPolicy
+	}
+	return rp
+}
+
+func recoverSelective(tsRecover *config.TsRecover, rc *RecoverConfig) error {
+	s, err := newSelectiveRecover(tsRecover, rc)
+	if err != nil {
+		return err
+	}
+	isInc := rc.RecoverMode == FullAndIncRecoverMode
+	if err = s.recoverMeta(tsRecover, isInc); err != nil {
+		return err
+	}
+
+	fullBackupDataPath := filepath.Join(rc.FullBackupDataPath, backup.DataBackupDir)
+	if err = s.recoverData(fullBackupDataPath, backup.FullBackupLog, isInc); err != nil {
+		return err
+	}
+	if isInc {
+		return s.recoverData(filepath.Join(rc.IncBackupDataPath, backup.DataBackupDir), backup.IncBackupLog, true)
+	}
+	return nil
+}
+
+// recoverMeta adds the database of the backup to the meta data of every meta node, the meta data is
+// rewritten in the latest raft snapshot of the meta node. The raft log of every meta node must end at its
+// latest snapshot, otherwise the restore is refused before any meta data is changed.
+func (s *selectiveRecover) recoverMeta(tsRecover *config.TsRecover, isInc bool) error {
+	backupPath := s.rc.FullBackupDataPath
+	if isInc {
+		backupPath = s.rc.IncBackupDataPath
+	}
+	backupMetaPath := filepath.Join(backupPath, backup.MetaBackupDir)
+	backupLog := &backup.MetaBackupLogInfo{}
+	if err := backup.ReadBackupLogFile(filepath.Join(backupMetaPath, backup.BackupLogPath, backup.MetaBackupLog), backupLog); err != nil {
+		return fmt.Errorf("read meta backup log: %s", err)
+	}
+
+	var metaPaths []string
+	if len(backupLog.MetaIds) == 1 || backupLog.IsNode {
+		metaPaths = append(metaPaths, tsRecover.Data.MetaDir)
+	} else {
+		metaPath, _ := filepath.Split(tsRecover.Data.MetaDir)
+		for _, id := range backupLog.MetaIds {
+			metaPaths = append(metaPaths, filepath.Join(metaPath, id))
+		}
+	}
+
+	snapshots := make([]string, len(metaPaths))
+	datas := make([]*meta2.Data, len(metaPaths))
+	for i, metaPath := range metaPaths {
+		var err error
+		if snapshots[i], datas[i], err = readMetaSnapshot(metaPath); err != nil {
+			return err
+		}
+		if err = checkRaftLog(metaPath, snapshots[i]); err != nil {
+			return err
+		}
+	}
+	// the ids must be the same on all the meta nodes
+	maxIDs := &meta2.Data{}
+	for _, data := range datas {
+		maxIDs.MaxShardGroupID = max(maxIDs.MaxShardGroupID, data.MaxShardGroupID)
+		maxIDs.MaxShardID = max(maxIDs.MaxShardID, data.MaxShardID)
+		maxIDs.MaxIndexGroupID = max(maxIDs.MaxIndexGroupID, data.MaxIndexGroupID)
+		maxIDs.MaxIndexID = max(maxIDs.MaxIndexID, data.MaxIndexID)
+	}
+
+	for i, data := range datas {
+		_, bak, err := readMetaSnapshot(backupMetaPath)
+		if err != nil {
+			return err
+		}
+		data.MaxShardGroupID, data.MaxShardID = maxIDs.MaxShardGroupID, maxIDs.MaxShardID
+		data.MaxIndexGroupID, data.MaxIndexID = maxIDs.MaxIndexGroupID, maxIDs.MaxIndexID
+		if err = s.restoreDatabase(data, bak); err != nil {
+			return err
+		}
+		if err = writeMetaSnapshot(snapshots[i], data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreDatabase moves the database selected from the backup meta data to the current meta data. The ids
+// of the shard groups, shards, index groups and indexes are reallocated to avoid conflicts.
+func (s *selectiveRecover) restoreDatabase(cur, bak *meta2.Data) error {
+	dbi := bak.Database(s.rc.Database)
+	if dbi == nil {
+		return fmt.Errorf("database %s not found in backup", s.rc.Database)
+	}
+	target := s.targetDatabase()
+	if cur.Database(target) != nil {
+		// merging into an existing database is not supported, the restored shards would be placed on the pts
+		// of the backup, which are not the pts of the existing database
+		return fmt.Errorf("database %s already exists, restore it into a new database with -targetDatabase", target)
+	}
+	renamed := target != dbi.Name
+
+	if s.rc.RetentionPolicy != "" {
+		rpi, ok := dbi.RetentionPolicies[s.rc.RetentionPolicy]
+		if !ok {
+			return fmt.Errorf("retention policy %s not found in database %s", s.rc.RetentionPolicy, s.rc.Database)
+		}
+		dbi.RetentionPolicies = map[string]*meta2.RetentionPolicyInfo{s.rc.RetentionPolicy: rpi}
+	}
+
+	rpNames := make([]string, 0, len(dbi.RetentionPolicies))
+	for name := range dbi.RetentionPolicies {
+		rpNames = append(rpNames, name)
+	}
+	sort.Strings(rpNames)
+	rps := make(map[string]*meta2.RetentionPolicyInfo, len(rpNames))
+	for _, name := range rpNames {
+		rpi := dbi.RetentionPolicies[name]
+		s.restoreRetentionPolicy(cur, rpi)
+		rpi.Name = s.targetRetentionPolicy(name)
+		if renamed || rpi.Name != name {
+			// the destinations of the subscriptions belong to the original retention policy
+			rpi.Subscriptions = nil
+		}
+		rps[rpi.Name] = rpi
+	}
+	dbi.RetentionPolicies = rps
+	if _, ok := rps[s.targetRetentionPolicy(dbi.DefaultRetentionPolicy)]; ok {
+		dbi.DefaultRetentionPolicy = s.targetRetentionPolicy(dbi.DefaultRetentionPolicy)
+	} else if len(rpNames) == 1 {
+		dbi.DefaultRetentionPolicy = s.targetRetentionPolicy(rpNames[0])
+	}
+	if renamed {
+		// the continuous queries still write into the original database
+		dbi.ContinuousQueries = nil
+	}
+	dbi.Name = target
+
+	if cur.Databases == nil {
+		cur.Databases = make(map[string]*meta2.DatabaseInfo)
+	}
+	cur.Databases[target] = dbi
+	if pts, ok := bak.PtView[s.rc.Database]; ok {
+		if cur.PtView == nil {
+			cur.PtView = make(map[string]meta2.DBPtInfos)
+		}
+		cur.PtView[target] = pts
+	}
+	if groups, ok := bak.ReplicaGroups[s.rc.Database]; ok {
+		if cur.ReplicaGroups == nil {
+			cur.ReplicaGroups = make(map[string][]meta2.ReplicaGroup)
+		}
+		cur.ReplicaGroups[target] = groups
+	}
+	return nil
+}
+
+func (s *selectiveRecover) restoreRetentionPolicy(cur *meta2.Data, rpi *meta2.RetentionPolicyInfo) {
+	usedIndexes := make(map[uint64]struct{})
+	shardGroups := rpi.ShardGroups[:0]
+	for _, sg := range rpi.ShardGroups {
+		if sg.Deleted() || !s.overlaps(sg.StartTime, sg.EndTime) {
+			continue
+		}
+		cur.MaxShardGroupID++
+		sg.ID = cur.MaxShardGroupID
+		for i := range sg.Shards {
+			cur.MaxShardID++
+			s.shards[sg.Shards[i].ID] = cur.MaxShardID
+			sg.Shards[i].ID = cur.MaxShardID
+			usedIndexes[sg.Shards[i].IndexID] = struct{}{}
+		}
+		shardGroups = append(shardGroups, sg)
+	}
+	rpi.ShardGroups = shardGroups
+
+	indexGroups := rpi.IndexGroups[:0]
+	for _, ig := range rpi.IndexGroups {
+		used := false
+		for i := range ig.Indexes {
+			if _, ok := usedIndexes[ig.Indexes[i].ID]; ok {
+				used = true
+			}
+		}
+		if !used {
+			continue
+		}
+		cur.MaxIndexGroupID++
+		ig.ID = cur.MaxIndexGroupID
+		for i := range ig.Indexes {
+			cur.MaxIndexID++
+			s.indexes[ig.Indexes[i].ID] = cur.MaxIndexID
+			ig.Indexes[i].ID = cur.MaxIndexID
+		}
+		indexGroups = append(indexGroups, ig)
+	}
+	rpi.IndexGroups = indexGroups
+
+	for i := range rpi.ShardGroups {
+		for j := range rpi.ShardGroups[i].Shards {
+			sh := &rpi.ShardGroups[i].Shards[j]
+			sh.IndexID = s.indexes[sh.IndexID]
+		}
+	}
+}
+
+func (s *selectiveRecover) overlaps(start, end time.Time) bool {
+	return (s.end.IsZero() || !start.After(s.end)) && (s.start.IsZero() || end.After(s.start))
+}
+
+// recoverData copies the files of the shards and the indexes restored from the backup data directory,
+// the files of the shards are listed by the backup logs named logName.
+func (s *selectiveRecover) recoverData(backupDataPath, logName string, isInc bool) error {
+	nodeBackupPath := filepath.Join(backupDataPath, s.dataPath)
+	if _, err := os.Stat(nodeBackupPath); err != nil {
+		return err
+	}
+	return filepath.WalkDir(nodeBackupPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() != config.IndexFileDirectory {
+				return nil
+			}
+			if err = s.copyIndexes(backupDataPath, p); err != nil {
+				return err
+			}
+			return filepath.SkipDir
+		}
+		if d.Name() != logName {
+			return nil
+		}
+
+		if logName == backup.IncBackupLog {
+			incBackupLog := &backup.IncBackupLogInfo{}
+			if err = backup.ReadBackupLogFile(p, incBackupLog); err != nil {
+				return err
+			}
+			return s.copyFiles(backupDataPath, incBackupLog.AddFileListMap, nil)
+		}
+
+		backupLog := &backup.BackupLogInfo{}
+		if err = backup.ReadBackupLogFile(p, backupLog); err != nil {
+			return err
+		}
+		incBackupLog := &backup.IncBackupLogInfo{}
+		if isInc {
+			// the files deleted since the full backup are not restored
+			incPath := strings.Replace(p, s.rc.FullBackupDataPath, s.rc.IncBackupDataPath, 1)
+			incPath = filepath.Join(filepath.Dir(incPath), backup.IncBackupLog)
+			if _, err = fileops.Stat(incPath); err == nil {
+				if err = backup.ReadBackupLogFile(incPath, incBackupLog); err != nil {
+					return err
+				}
+			}
+		}
+		return s.copyFiles(backupDataPath, backupLog.FileListMap, incBackupLog.DelFileListMap)
+	})
+}
+
+func (s *selectiveRecover) copyFiles(backupDataPath string, listMap, delListMap map[string][][]string) error {
+	for name, fileList := range listMap {
+		deleted := make(map[string]bool)
+		for _, files := range delListMap[name] {
+			deleted[files[0]] = true
+		}
+		for _, files := range fileList {
+			if deleted[files[0]] {
+				continue
+			}
+			srcPath := filepath.Join(backupDataPath, files[0])
+			for _, f := range files {
+				dst, ok := s.rewritePath(f)
+				if !ok {
+					continue
+				}
+				if err := backup.FileCopy(srcPath, dst); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *selectiveRecover) copyIndexes(backupDataPath, indexPath string) error {
+	fds, err := fileops.ReadDir(indexPath)
+	if err != nil {
+		return err
+	}
+	for _, fd := range fds {
+		if !fd.IsDir() {
+			continue
+		}
+		srcPath := filepath.Join(indexPath, fd.Name())
+		dst, ok := s.rewritePath(strings.TrimPrefix(srcPath, backupDataPath))
+		if !ok {
+			continue
+		}
+		if err = backup.FolderCopy(srcPath, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewritePath maps the path of a shard or an index file in the data directory to the restored database,
+// the path is in the form of database/pt/rp/shard_start_end_index/... or database/pt/rp/index/index_start_end/...
+func (s *selectiveRecover) rewritePath(p string) (string, bool) {
+	rel, err := filepath.Rel(s.dataPath, p)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	parts := strings.SplitN(filepath.ToSlash(rel), "/", 6)
+	if len(parts) < 4 || parts[0] != s.rc.Database {
+		return "", false
+	}
+	db, pt, rp := s.targetDatabase(), parts[1], parts[2]
+	if s.rc.RetentionPolicy != "" && rp != s.rc.RetentionPolicy {
+		return "", false
+	}
+	rp = s.targetRetentionPolicy(rp)
+
+	if parts[3] == config.IndexFileDirectory {
+		if len(parts) < 5 {
+			return "", false
+		}
+		name, ok := rewriteDirName(parts[4], s.indexes, 0)
+		if !ok {
+			return "", false
+		}
+		return filepath.Join(append([]string{s.dataPath, db, pt, rp, config.IndexFileDirectory, name}, parts[5:]...)...), true
+	}
+
+	name, ok := rewriteDirName(parts[3], s.shards, 0)
+	if !ok {
+		return "", false
+	}
+	// the index id is the last part of the name of a shard directory
+	if name, ok = rewriteDirName(name, s.indexes, 3); !ok {
+		return "", false
+	}
+	return filepath.Join(append([]string{s.dataPath, db, pt, rp, name}, parts[4:]...)...), true
+}
+
+// rewriteDirName replaces the id at the position idx of a directory name separated by '_'.
+func rewriteDirName(name string, ids map[uint64]uint64, idx int) (string, bool) {
+	parts := strings.Split(name, "_")
+	if len(parts) <= idx {
+		return "", false
+	}
+	id, err := strconv.ParseUint(parts[idx], 10, 64)
+	if err != nil {
+		return "", false
+	}
+	newID, ok := ids[id]
+	if !ok {
+		return "", false
+	}
+	parts[idx] = strconv.FormatUint(newID, 10)
+	return strings.Join(parts, "_"), true
+}
+
+// readMetaSnapshot returns the latest raft snapshot in the meta directory and the meta data it holds.
+func readMetaSnapshot(metaPath string) (string, *meta2.Data, error) {
+	snapshotPath := filepath.Join(metaPath, raftSnapshotDir)
+	fds, err := fileops.ReadDir(snapshotPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("read meta snapshots: %s", err)
+	}
+
+	var latest string
+	var latestMeta *snapshotMeta
+	for _, fd := range fds {
+		if !fd.IsDir() || strings.HasSuffix(fd.Name(), ".tmp") {
+			continue
+		}
+		sm := &snapshotMeta{}
+		if err = readSnapshotMeta(filepath.Join(snapshotPath, fd.Name()), sm); err != nil {
+			continue
+		}
+		if latestMeta == nil || sm.Term > latestMeta.Term ||
+			(sm.Term == latestMeta.Term && (sm.Index > latestMeta.Index || (sm.Index == latestMeta.Index && sm.ID > latestMeta.ID))) {
+			latest, latestMeta = filepath.Join(snapshotPath, fd.Name()), sm
+		}
+	}
+	if latestMeta == nil {
+		return "", nil, fmt.Errorf("no meta snapshot found in %s", metaPath)
+	}
+
+	buf, err := os.ReadFile(filepath.Join(latest, raftSnapshotState))
+	if err != nil {
+		return "", nil, err
+	}
+	data := &meta2.Data{}
+	if err = data.UnmarshalBinary(buf); err != nil {
+		return "", nil, err
+	}
+	return latest, data, nil
+}
+
+// checkRaftLog refuses a restore if the raft log of the meta node holds entries after the snapshot. The entries
+// are replayed over the snapshot when the meta node starts, and the ids of the shards and the shard groups they
+// allocate would collide with the ids reallocated for the restored database.
+func checkRaftLog(metaPath, snapshot string) error {
+	sm := &snapshotMeta{}
+	if err := readSnapshotMeta(snapshot, sm); err != nil {
+		return err
+	}
+	dbPath := filepath.Join(metaPath, raftLogFile)
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil
+	}
+	store, err := raftboltdb.New(raftboltdb.Options{
+		Path:        dbPath,
+		BoltOptions: &bbolt.Options{ReadOnly: true, Timeout: time.Second},
+	})
+	if err != nil {
+		return fmt.Errorf("open raft log %s, the meta node must be stopped: %s", dbPath, err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+	last, err := store.LastIndex()
+	if err != nil {
+		return err
+	}
+	if last > sm.Index {
+		return fmt.Errorf("the raft log of %s has entries after the latest snapshot (index %d > %d), "+
+			"take a snapshot of every meta node with POST /userSnapshot before stopping it", metaPath, last, sm.Index)
+	}
+	return nil
+}
+
+func readSnapshotMeta(path string, sm *snapshotMeta) error {
+	buf, err := os.ReadFile(filepath.Join(path, raftSnapshotMeta))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, sm)
+}
+
+// writeMetaSnapshot replaces the meta data of the raft snapshot, the size and the checksum in the
+// meta.json of the snapshot are updated so that the meta node is able to restore it.
+func writeMetaSnapshot(path string, data *meta2.Data) error {
+	sm := &snapshotMeta{}
+	if err := readSnapshotMeta(path, sm); err != nil {
+		return err
+	}
+	buf, err := data.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	h := crc64.New(crc64.MakeTable(crc64.ECMA))
+	_, _ = h.Write(buf)
+	sm.Size = int64(len(buf))
+	sm.CRC = h.Sum(nil)
+	metaBuf, err := json.Marshal(sm)
+	if err != nil {
+		return err
+	}
+
+	if err = writeFileAtomic(filepath.Join(path, raftSnapshotState), buf); err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(path, raftSnapshotMeta), metaBuf)
+}
+
+func writeFileAtomic(path string, buf []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf, 0640); err != nil {
+		return err
+	}
+	return fileops.RenameFile(tmp, path)
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recover
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/config"
+	raftboltdb "github.com/openGemini/openGemini/lib/util/lifted/hashicorp/raft-boltdb"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMetaSnapshot(t *testing.T, metaPath string, index uint64, data *meta2.Data) {
+	store, err := raft.NewFileSnapshotStore(metaPath, 2, io.Discard)
+	require.NoError(t, err)
+	sink, err := store.Create(raft.SnapshotVersionMax, index, 1, raft.Configuration{}, 1, nil)
+	require.NoError(t, err)
+	buf, err := data.MarshalBinary()
+	require.NoError(t, err)
+	_, err = sink.Write(buf)
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+}
+
+func shardDirName(id uint64, start, end time.Time, indexID uint64) string {
+	return fmt.Sprintf("%d_%d_%d_%d", id, meta2.MarshalTime(start), meta2.MarshalTime(end), indexID)
+}
+
+func createSelectiveBackup(t *testing.T, backupPath, dataPath string) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rp := meta2.NewRetentionPolicyInfo("autogen")
+	rp.Subscriptions = []meta2.SubscriptionInfo{{Name: "sub0", Mode: "ALL", Destinations: []string{"http://127.0.0.1:8086"}}}
+	bak := &meta2.Data{
+		Databases: map[string]*meta2.DatabaseInfo{"db0": {
+			Name:                   "db0",
+			DefaultRetentionPolicy: "autogen",
+			RetentionPolicies:      map[string]*meta2.RetentionPolicyInfo{"autogen": rp},
+			ContinuousQueries:      map[string]*meta2.ContinuousQueryInfo{"cq0": {Name: "cq0", Query: "SELECT 1"}},
+		}},
+		PtView:          map[string]meta2.DBPtInfos{"db0": {{PtId: 0, Owner: meta2.PtOwner{NodeID: 1}, Status: meta2.Online}}},
+		MaxShardGroupID: 2,
+		MaxShardID:      2,
+		MaxIndexGroupID: 2,
+		MaxIndexID:      2,
+	}
+	var fileList [][]string
+	for i := uint64(1); i <= 2; i++ {
+		start, end := day.Add(time.Duration(i-1)*24*time.Hour), day.Add(time.Duration(i)*24*time.Hour)
+		rp.ShardGroups = append(rp.ShardGroups, meta2.ShardGroupInfo{ID: i, StartTime: start, EndTime: end,
+			Shards: []meta2.ShardInfo{{ID: i, Owners: []uint32{0}, IndexID: i}}})
+		rp.IndexGroups = append(rp.IndexGroups, meta2.IndexGroupInfo{ID: i, StartTime: start, EndTime: end,
+			Indexes: []meta2.IndexInfo{{ID: i, Owners: []uint32{0}}}})
+
+		shardPath := filepath.Join(dataPath, "db0", "0", "autogen", shardDirName(i, start, end, i))
+		file := filepath.Join(shardPath, "tssp", "cpu_0000", "00000001-0000-00000000.tssp")
+		fileList = [][]string{{file}}
+		CreateFile(filepath.Join(backupPath, backup.DataBackupDir, file), fmt.Sprintf("shard%d", i))
+		content, err := json.Marshal(&backup.BackupLogInfo{FileListMap: map[string][][]string{"cpu_0000": fileList}})
+		require.NoError(t, err)
+		require.NoError(t, backup.WriteBackupLogFile(content, filepath.Join(backupPath, backup.DataBackupDir, shardPath), backup.FullBackupLog))
+
+		indexPath := filepath.Join(dataPath, "db0", "0", "autogen", config.IndexFileDirectory,
+			fmt.Sprintf("%d_%d_%d", i, meta2.MarshalTime(start), meta2.MarshalTime(end)))
+		CreateFile(filepath.Join(backupPath, backup.DataBackupDir, indexPath, "mergeset", "item"), fmt.Sprintf("index%d", i))
+	}
+
+	metaBackupPath := filepath.Join(backupPath, backup.MetaBackupDir)
+	createMetaSnapshot(t, metaBackupPath, 10, bak)
+	require.NoError(t, backup.WriteBackupLogFile([]byte(`{"metaIds":["1"],"isNode":true}`), metaBackupPath, backup.MetaBackupLog))
+}
+
+func TestRecoverSelective(t *testing.T) {
+	dir := t.TempDir()
+	backupPath := filepath.Join(dir, "backup")
+	tsRecover := &config.TsRecover{
+		Data: config.Store{
+			DataDir: filepath.Join(dir, "node"),
+			MetaDir: filepath.Join(dir, "node", "meta"),
+		},
+	}
+	dataPath := filepath.Join(tsRecover.Data.DataDir, config.DataDirectory)
+	createSelectiveBackup(t, backupPath, dataPath)
+	cur := &meta2.Data{
+		Databases:       map[string]*meta2.DatabaseInfo{"live": {Name: "live"}},
+		MaxShardGroupID: 5,
+		MaxShardID:      5,
+		MaxIndexGroupID: 5,
+		MaxIndexID:      5,
+	}
+	createMetaSnapshot(t, tsRecover.Data.MetaDir, 20, cur)
+
+	rc := &RecoverConfig{
+		RecoverMode:        FullRecoverMode,
+		FullBackupDataPath: backupPath,
+		Database:           "db0",
+		StartTime:          "2024-01-02T00:00:00Z",
+		TargetDatabase:     "db1",
+	}
+	require.NoError(t, BackupRecover(rc, tsRecover))
+
+	// the snapshot passes the checksum of raft
+	store, err := raft.NewFileSnapshotStore(tsRecover.Data.MetaDir, 2, io.Discard)
+	require.NoError(t, err)
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(snapshots))
+	_, r, err := store.Open(snapshots[0].ID)
+	require.NoError(t, err)
+	buf, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	data := &meta2.Data{}
+	require.NoError(t, data.UnmarshalBinary(buf))
+
+	require.NotNil(t, data.Database("live"))
+	require.Nil(t, data.Database("db0"))
+	dbi := data.Database("db1")
+	require.NotNil(t, dbi)
+	assert.Empty(t, dbi.ContinuousQueries)
+	rp := dbi.RetentionPolicies["autogen"]
+	require.NotNil(t, rp)
+	assert.Empty(t, rp.Subscriptions)
+	require.Equal(t, 1, len(rp.ShardGroups))
+	assert.Equal(t, uint64(6), rp.ShardGroups[0].ID)
+	assert.Equal(t, uint64(6), rp.ShardGroups[0].Shards[0].ID)
+	assert.Equal(t, uint64(6), rp.ShardGroups[0].Shards[0].IndexID)
+	require.Equal(t, 1, len(rp.IndexGroups))
+	assert.Equal(t, uint64(6), rp.IndexGroups[0].Indexes[0].ID)
+	assert.Equal(t, uint64(6), data.MaxShardID)
+	assert.Equal(t, 1, len(data.PtView["db1"]))
+
+	sg := rp.ShardGroups[0]
+	content, err := os.ReadFile(filepath.Join(dataPath, "db1", "0", "autogen", shardDirName(6, sg.StartTime, sg.EndTime, 6),
+		"tssp", "cpu_0000", "00000001-0000-00000000.tssp"))
+	require.NoError(t, err)
+	assert.Equal(t, "shard2", string(content))
+	content, err = os.ReadFile(filepath.Join(dataPath, "db1", "0", "autogen", config.IndexFileDirectory,
+		fmt.Sprintf("6_%d_%d", meta2.MarshalTime(sg.StartTime), meta2.MarshalTime(sg.EndTime)), "mergeset", "item"))
+	require.NoError(t, err)
+	assert.Equal(t, "index2", string(content))
+	_, err = os.Stat(filepath.Join(dataPath, "db0"))
+	assert.True(t, os.IsNotExist(err))
+
+	// the target database exists now
+	err = BackupRecover(rc, tsRecover)
+	assert.EqualError(t, err, "database db1 already exists, restore it into a new database with -targetDatabase")
+}
+
+func createRaftLog(t *testing.T, metaPath string, lastIndex uint64) {
+	store, err := raftboltdb.NewBoltStore(filepath.Join(metaPath, raftLogFile))
+	require.NoError(t, err)
+	defer store.Close()
+	require.NoError(t, store.StoreLogs([]*raft.Log{{Index: lastIndex - 1, Term: 1}, {Index: lastIndex, Term: 1}}))
+}
+
+func TestRecoverSelective_RaftLog(t *testing.T) {
+	dir := t.TempDir()
+	backupPath := filepath.Join(dir, "backup")
+	tsRecover := &config.TsRecover{
+		Data: config.Store{
+			DataDir: filepath.Join(dir, "node"),
+			MetaDir: filepath.Join(dir, "node", "meta"),
+		},
+	}
+	createSelectiveBackup(t, backupPath, filepath.Join(tsRecover.Data.DataDir, config.DataDirectory))
+	createMetaSnapshot(t, tsRecover.Data.MetaDir, 20, &meta2.Data{MaxShardID: 5})
+	rc := &RecoverConfig{RecoverMode: FullRecoverMode, FullBackupDataPath: backupPath, Database: "db0"}
+
+	// the entries after the snapshot would allocate the ids of the restored shards again
+	createRaftLog(t, tsRecover.Data.MetaDir, 21)
+	err := BackupRecover(rc, tsRecover)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has entries after the latest snapshot (index 21 > 20)")
+	_, data, err := readMetaSnapshot(tsRecover.Data.MetaDir)
+	require.NoError(t, err)
+	assert.Nil(t, data.Database("db0"))
+
+	// the log ends at the snapshot
+	require.NoError(t, os.Remove(filepath.Join(tsRecover.Data.MetaDir, raftLogFile)))
+	createRaftLog(t, tsRecover.Data.MetaDir, 20)
+	require.NoError(t, BackupRecover(rc, tsRecover))
+	_, data, err = readMetaSnapshot(tsRecover.Data.MetaDir)
+	require.NoError(t, err)
+	assert.NotNil(t, data.Database("db0"))
+}
+
+func TestRecoverSelectiveConfig(t *testing.T) {
+	tsRecover := &config.TsRecover{}
+	for _, rc := range []*RecoverConfig{
+		{RecoverMode: FullRecoverMode, FullBackupDataPath: "/", RetentionPolicy: "autogen"},
+		{RecoverMode: FullRecoverMode, FullBackupDataPath: "/", Database: "db0", TargetRetentionPolicy: "rp1"},
+		{RecoverMode: "3", FullBackupDataPath: "/", Database: "db0"},
+		{RecoverMode: FullRecoverMode, FullBackupDataPath: "/", Database: "db0", StartTime: "yesterday"},
+		{RecoverMode: FullRecoverMode, FullBackupDataPath: "/", Database: "db0",
+			StartTime: "2024-01-02T00:00:00Z", EndTime: "2024-01-01T00:00:00Z"},
+	} {
+		assert.Error(t, BackupRecover(rc, tsRecover))
+	}
+}
+
+func TestRewritePath(t *testing.T) {
+	s := &selectiveRecover{
+		rc:       &RecoverConfig{Database: "db0", RetentionPolicy: "rp0", TargetRetentionPolicy: "rp1"},
+		dataPath: "/data",
+		shards:   map[uint64]uint64{1: 11},
+		indexes:  map[uint64]uint64{2: 12},
+	}
+	for p, expected := range map[string]string{
+		"/data/db0/0/rp0/1_0_100_2/tssp/cpu/1.tssp": "/data/db0/0/rp1/11_0_100_12/tssp/cpu/1.tssp",
+		"/data/db0/0/rp0/index/2_0_100":             "/data/db0/0/rp1/index/12_0_100",
+		"/data/db0/0/rp0/3_0_100_2/tssp/cpu/1.tssp": "",
+		"/data/db0/0/autogen/1_0_100_2/tssp/1.tssp": "",
+		"/data/db1/0/rp0/1_0_100_2/tssp/1.tssp":     "",
+		"/other/db0/0/rp0/1_0_100_2/tssp/1.tssp":    "",
+	} {
+		dst, ok := s.rewritePath(p)
+		assert.Equal(t, expected != "", ok, p)
+		assert.Equal(t, expected, dst, p)
+	}
+}
@@ -34,6 +34,8 @@ import (
 	"github.com/openGemini/openGemini/lib/machine"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/querycache"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/sysconfig"
@@ -49,6 +51,8 @@ import (
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/openGemini/openGemini/services/castor"
 	"github.com/openGemini/openGemini/services/continuousquery"
+	"github.com/openGemini/openGemini/services/mqtt"
+	"github.com/openGemini/openGemini/services/rule"
 	"github.com/openGemini/openGemini/services/sherlock"
 	"github.com/openGemini/openGemini/services/writer"
 	gopscpu "github.com/shirou/gopsutil/v3/cpu"
@@ -89,8 +93,14 @@ type Server struct {
 
 	cqService *continuousquery.Service
 
+	ruleService *rule.Service
+
 	writerService *writer.Service
 
+	mqttService *mqtt.Service
+
+	resultCache *querycache.Cache
+
 	ctx          context.Context
 	ctxCancel    context.CancelFunc
 	serfInstance *serf.Serf
@@ -146,6 +156,12 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 	go s.PointsWriter.ApplyTimeRangeLimit(c.Coordinator.TimeRangeLimit)
 	coordinator.SetTagLimit(c.Coordinator.TagLimit)
 
+	if c.QueryResultCache.Enabled {
+		s.resultCache = querycache.NewCache(c.QueryResultCache)
+		s.PointsWriter.AddRowsObserver(s.resultCache)
+		s.httpService.Handler.ResultCache = s.resultCache
+	}
+
 	if s.config.Subscriber.Enabled {
 		s.SubscriberManager = coordinator.NewSubscriberManager(s.config.Subscriber, s.MetaClient, s.httpService.Handler.Logger)
 	}
@@ -191,6 +207,15 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 		}
 		s.writerService.WithAuthorizer(a)
 	}
+	if c.MQTT.Enabled {
+		s.mqttService, err = mqtt.NewService(c.MQTT)
+		if err != nil {
+			return nil, err
+		}
+		s.mqttService.WithLogger(s.Logger)
+		s.mqttService.WithWriter(s.PointsWriter)
+		s.mqttService.WithAuthorizer(s.MetaClient)
+	}
 	return s, nil
 }
 
@@ -201,6 +226,14 @@ func newServer(info app.ServerInfo, logger *Logger.Logger, c *config.TSSql, meta
 		hostname := config.CombineDomain(c.HTTP.Domain, c.HTTP.BindAddress)
 		cqService = continuousquery.NewService(hostname, time.Duration(c.ContinuousQuery.RunInterval), c.ContinuousQuery.MaxProcessCQNumber)
 		cqService.WithLogger(logger)
+		cqService.WithConfig(c.ContinuousQuery)
+	}
+
+	// new prometheus rule service
+	var ruleService *rule.Service
+	if c.Rule.Enabled {
+		ruleService = rule.NewService(config.CombineDomain(c.HTTP.Domain, c.HTTP.BindAddress), c.Rule)
+		ruleService.WithLogger(logger)
 	}
 
 	s := &Server{
@@ -212,7 +245,8 @@ func newServer(info app.ServerInfo, logger *Logger.Logger, c *config.TSSql, meta
 		metaUseTLS:    false,
 		config:        c,
 
-		cqService: cqService,
+		cqService:   cqService,
+		ruleService: ruleService,
 	}
 	if c.Meta.UseIncSyncData {
 		s.MetaClient.EnableUseSnapshotV2(c.Meta.RetentionAutoCreate, c.Meta.ExpandShardsEnable)
@@ -233,6 +267,9 @@ func (s *Server) initArrowFlightService(c *config.TSSql) error {
 	s.arrowFlightService.StatisticsPusher = s.statisticsPusher
 	s.RecordWriter = coordinator.NewRecordWriter(time.Duration(c.Coordinator.ShardWriterTimeout), int(c.Meta.PtNumPerNode), c.HTTP.FlightChFactor)
 	s.RecordWriter.StorageEngine = services.GetStorageEngine()
+	if s.resultCache != nil {
+		s.RecordWriter.AddRecordObserver(s.resultCache)
+	}
 	return nil
 }
 
@@ -242,7 +279,7 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 	metaExecutor.SetTimeOut(time.Duration(c.Coordinator.MetaExecutorWriteTimeout))
 
 	s.QueryExecutor = query.NewExecutor(cpu.GetCpuNum())
-	s.QueryExecutor.StatementExecutor = &coordinator2.StatementExecutor{
+	statementExecutor := &coordinator2.StatementExecutor{
 		MetaClient:  s.MetaClient,
 		TaskManager: s.QueryExecutor.TaskManager,
 		NetStorage:  s.TSDBStore,
@@ -260,16 +297,23 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 		StmtExecLogger:          Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
 		Hostname:                config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
 		SqlConfigs:              c.ShowConfigs(),
+		ResultCache:             s.resultCache,
 	}
+	s.QueryExecutor.StatementExecutor = statementExecutor
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
 	s.QueryExecutor.TaskManager.MaxConcurrentQueries = c.Coordinator.MaxConcurrentQueries
+	s.QueryExecutor.TaskManager.ResourceGroups = resourcegroup.NewManager(c.Coordinator.ResourceGroups)
 	s.QueryExecutor.TaskManager.Register = s.MetaClient
 	s.QueryExecutor.TaskManager.Host = config.CombineDomain(c.HTTP.Domain, c.HTTP.BindAddress)
 
 	s.httpService.Handler.QueryExecutor = s.QueryExecutor
 	if s.cqService != nil {
 		s.cqService.QueryExecutor = s.QueryExecutor
+		statementExecutor.ContinuousQueryBackfiller = s.cqService
+	}
+	if s.ruleService != nil {
+		s.ruleService.QueryExecutor = s.QueryExecutor
 	}
 }
 
@@ -289,7 +333,9 @@ func (s *Server) Open() error {
 	s.PointsWriter.MetaClient = s.MetaClient
 	s.httpService.Handler.MetaClient = s.MetaClient
 	s.httpService.Handler.SQLConfig = s.config
-	s.httpService.Handler.RecordWriter = s.RecordWriter
+	if s.RecordWriter != nil {
+		s.httpService.Handler.RecordWriter = s.RecordWriter
+	}
 	if s.config.Gossip.Enabled && s.config.Meta.UseIncSyncData {
 		conf := s.config.Gossip.BuildSerf(s.config.Logging, config.AppSql, strconv.Itoa(int(s.MetaClient.NodeID())), nil)
 		var err error
@@ -306,6 +352,10 @@ func (s *Server) Open() error {
 	// try to open continuous query service
 	if s.cqService != nil {
 		s.cqService.MetaClient = s.MetaClient
+		s.cqService.PointsWriter = s.PointsWriter
+		if s.config.ContinuousQuery.LateDataEnabled {
+			s.PointsWriter.AddRowsObserver(s.cqService)
+		}
 		if err := s.cqService.Open(); err != nil {
 			return err
 		}
@@ -319,6 +369,16 @@ func (s *Server) Open() error {
 		go s.SubscriberManager.Update()
 	}
 
+	// try to open prometheus rule service
+	if s.ruleService != nil {
+		s.ruleService.MetaClient = s.MetaClient
+		s.ruleService.PointsWriter = s.PointsWriter
+		if err := s.ruleService.Open(); err != nil {
+			return err
+		}
+		s.httpService.Handler.RuleManager = s.ruleService
+	}
+
 	if err := s.castorService.Open(); err != nil {
 		return err
 	}
@@ -337,6 +397,7 @@ func (s *Server) Open() error {
 
 		s.arrowFlightService.MetaClient = s.MetaClient
 		s.arrowFlightService.RecordWriter = s.RecordWriter
+		s.arrowFlightService.QueryExecutor = s.QueryExecutor
 		if err := s.arrowFlightService.Open(); err != nil {
 			return err
 		}
@@ -350,6 +411,11 @@ func (s *Server) Open() error {
 			return err
 		}
 	}
+	if s.mqttService != nil {
+		if err := s.mqttService.Open(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -393,6 +459,10 @@ func (s *Server) Close() error {
 		util.MustClose(s.writerService)
 	}
 
+	if s.mqttService != nil {
+		util.MustClose(s.mqttService)
+	}
+
 	if s.httpService != nil {
 		util.MustClose(s.httpService)
 	}
@@ -429,6 +499,10 @@ func (s *Server) Close() error {
 		util.MustClose(s.cqService)
 	}
 
+	if s.ruleService != nil {
+		util.MustClose(s.ruleService)
+	}
+
 	return nil
 }
 
@@ -489,6 +563,8 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitExecutorStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
 	stat.NewLogKeeperStatistics().Init(globalTags)
+	stat.NewSubscriberStatistics().Init(globalTags)
+	stat.NewQueryCacheStatistics().Init(globalTags)
 
 	s.statisticsPusher.Register(
 		stat.CollectHandlerStatistics,
@@ -498,6 +574,8 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectExecutorStatistics,
 		stat.NewErrnoStat().Collect,
 		stat.NewLogKeeperStatistics().Collect,
+		stat.NewSubscriberStatistics().Collect,
+		stat.NewQueryCacheStatistics().Collect,
 	)
 
 	s.statisticsPusher.RegisterOps(stat.CollectOpsHandlerStatistics)
@@ -506,6 +584,8 @@ func (s *Server) initStatisticsPusher() {
 	s.statisticsPusher.RegisterOps(stat.CollectOpsRuntimeStatistics)
 	s.statisticsPusher.RegisterOps(stat.CollectExecutorStatisticsOps)
 	s.statisticsPusher.RegisterOps(stat.NewErrnoStat().CollectOps)
+	s.statisticsPusher.RegisterOps(stat.NewSubscriberStatistics().CollectOps)
+	s.statisticsPusher.RegisterOps(stat.NewQueryCacheStatistics().CollectOps)
 
 	s.statisticsPusher.Start()
 }
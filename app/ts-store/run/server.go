@@ -101,6 +101,7 @@ func NewServer(c config.Config, info app.ServerInfo, logger *Logger.Logger) (app
 	immutable.SetChunkMetaCompressMode(conf.Data.ChunkMetaCompressMode)
 	config.SetStoreConfig(conf.Data)
 	config.SetIndexConfig(conf.Index)
+	config.SetObjectStorage(conf.ObjectStorage)
 	compress.Init()
 
 	s.config = conf
@@ -317,6 +318,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.NewStreamWindowStatistics().Collect,
 		stat.NewRecordStatistics().Collect,
 		stat.NewHitRatioStatistics().Collect,
+		stat.NewWalStatistics().Collect,
 		stat.CollectStoreQueryStatistics,
 		stat.CollectSpdyStatistics,
 		stat.NewOOOTimeDistribution().Collect,
@@ -332,6 +334,7 @@ func (s *Server) initStatisticsPusher() {
 	s.statisticsPusher.RegisterOps(stat.NewErrnoStat().CollectOps)
 	s.statisticsPusher.RegisterOps(s.storage.GetEngine().StatisticsOps)
 	s.statisticsPusher.RegisterOps(stat.CollectOpsStoreQueryStatistics)
+	s.statisticsPusher.RegisterOps(stat.NewWalStatistics().CollectOps)
 	s.statisticsPusher.Start()
 }
 
@@ -357,6 +360,7 @@ func (s *Server) initStatistics() {
 	stat.NewStreamWindowStatistics().Init(globalTags)
 	stat.NewRecordStatistics().Init(globalTags)
 	stat.NewHitRatioStatistics().Init(globalTags)
+	stat.NewWalStatistics().Init(globalTags)
 	stat.InitDatabaseStatistics(globalTags)
 	stat.InitStoreQueryStatistics(globalTags)
 	stat.InitSpdyStatistics(globalTags)
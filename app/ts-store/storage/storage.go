@@ -30,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/engine/index/clv"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -51,6 +52,7 @@ import (
 	"github.com/openGemini/openGemini/services/downsample"
 	"github.com/openGemini/openGemini/services/hierarchical"
 	"github.com/openGemini/openGemini/services/retention"
+	"github.com/openGemini/openGemini/services/scrub"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	"go.uber.org/zap"
 )
@@ -145,6 +147,16 @@ func (s *Storage) appendHierarchicalService(c config.HierarchicalConfig) {
 	s.Services = append(s.Services, srv)
 }
 
+func (s *Storage) appendScrubService(c config.ScrubConfig) {
+	if !c.Enabled {
+		return
+	}
+
+	srv := scrub.NewService(c)
+	srv.Engine = s.engine
+	s.Services = append(s.Services, srv)
+}
+
 func (s *Storage) appendDownSamplePolicyService(c retention2.Config) {
 	if !c.Enabled {
 		return
@@ -287,6 +299,7 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	s.appendRetentionPolicyService(conf.Retention)
 	s.appendDownSamplePolicyService(conf.DownSample)
 	s.appendHierarchicalService(conf.HierarchicalStore)
+	s.appendScrubService(conf.Scrub)
 	s.appendAnalysisService(conf.Analysis)
 	s.appendProactiveMgrService(conf.Data)
 
@@ -525,6 +538,10 @@ func (s *Storage) RowCount(db string, ptId uint32, shardIDS []uint64, schema hyb
 	return rowCount, err
 }
 
+func (s *Storage) CollectStatistics(db string, ptId uint32, shardIDs []uint64, mst string, tagKeys []string) (map[uint64]*optimizer.Statistics, error) {
+	return s.engine.CollectStatistics(db, ptId, shardIDs, mst, tagKeys)
+}
+
 func (s *Storage) TagValues(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (netstorage.TablesTagSets, error) {
 
 	return s.engine.TagValues(db, ptIDs, tagKeys, condition, tr)
@@ -88,6 +88,10 @@ func TestBaseHandler_SetMessage(t *testing.T) {
 			typ: netstorage.ShowTagKeysRequestMessage,
 			msg: &netstorage.ShowTagKeysRequest{},
 		},
+		{
+			typ: netstorage.StatisticsRequestMessage,
+			msg: &netstorage.StatisticsRequest{},
+		},
 	}
 
 	for _, item := range items {
@@ -51,6 +51,8 @@ func NewHandler(typ uint8) RPCHandler {
 		return &ShowTagKeys{}
 	case netstorage.RaftMessagesRequestMessage:
 		return &RaftMessages{}
+	case netstorage.StatisticsRequestMessage:
+		return &Statistics{}
 	default:
 		return nil
 	}
@@ -259,3 +261,20 @@ func (h *RaftMessages) SetMessage(msg codec.BinaryCodec) error {
 	h.req = req
 	return nil
 }
+
+type Statistics struct {
+	BaseHandler
+
+	req *netstorage.StatisticsRequest
+	rsp *netstorage.StatisticsResponse
+}
+
+func (h *Statistics) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.StatisticsResponse{}
+	req, ok := msg.(*netstorage.StatisticsRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.StatisticsRequest", msg)
+	}
+	h.req = req
+	return nil
+}
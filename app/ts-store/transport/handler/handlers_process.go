@@ -262,3 +262,13 @@ func (h *RaftMessages) Process() (codec.BinaryCodec, error) {
 	}
 	return h.rsp, nil
 }
+
+func (h *Statistics) Process() (codec.BinaryCodec, error) {
+	stats, err := h.store.CollectStatistics(h.req.Db, h.req.PtID, h.req.ShardIDs, h.req.Measurement, h.req.TagKeys)
+	if err != nil {
+		h.rsp.Err = netstorage.MarshalError(err)
+		return h.rsp, nil
+	}
+	h.rsp.Statistics = stats
+	return h.rsp, nil
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/openGemini/openGemini/app/ts-store/storage"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	internal "github.com/openGemini/openGemini/lib/netstorage/data"
@@ -170,6 +171,22 @@ func (e *MockEngine) DbPTRef(db string, ptId uint32) error {
 
 func (e *MockEngine) DbPTUnref(db string, ptId uint32) {}
 
+func (e *MockEngine) CollectStatistics(db string, _ uint32, shardIDs []uint64, _ string, tagKeys []string) (map[uint64]*optimizer.Statistics, error) {
+	if db == "test_return_error" {
+		return nil, errno.NewError(errno.ErrShardClosed, shardIDs[0])
+	}
+	ret := make(map[uint64]*optimizer.Statistics, len(shardIDs))
+	for _, id := range shardIDs {
+		stats := optimizer.NewStatistics()
+		stats.ShardN = 1
+		for _, key := range tagKeys {
+			stats.TagValueN[key] = 1
+		}
+		ret[id] = stats
+	}
+	return ret, nil
+}
+
 type MockShowTagValuesPlan struct {
 	ExecuteFn func(tagKeys map[string][][]byte, condition influxql.Expr, tr util.TimeRange, limit int) (netstorage.TablesTagSets, error)
 	StopFn    func()
@@ -260,3 +277,36 @@ func TestProcessRaftMessages(t *testing.T) {
 	}
 	assert.Empty(t, response.GetErrMsg())
 }
+
+func TestProcessStatistics(t *testing.T) {
+	s := &storage.Storage{}
+	s.SetEngine(&MockEngine{})
+
+	for _, db := range []string{"db0", "test_return_error"} {
+		h := NewHandler(netstorage.StatisticsRequestMessage)
+		if err := h.SetMessage(&netstorage.StatisticsRequest{
+			Db:          db,
+			PtID:        1,
+			ShardIDs:    []uint64{1, 2},
+			Measurement: "mst",
+			TagKeys:     []string{"host"},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		h.SetStore(s)
+
+		rsp, err := h.Process()
+		assert.NoError(t, err)
+		response, ok := rsp.(*netstorage.StatisticsResponse)
+		if !ok {
+			t.Fatal("response type is invalid")
+		}
+		if db == "test_return_error" {
+			assert.True(t, errno.Equal(response.Error(), errno.ErrShardClosed))
+			continue
+		}
+		assert.NoError(t, response.Error())
+		assert.Equal(t, 2, len(response.Statistics))
+		assert.Equal(t, int64(1), response.Statistics[2].TagValueN["host"])
+	}
+}
@@ -94,9 +94,22 @@ type PointsWriter struct {
 
 	TSDBStore TSDBStore
 
+	// rowsObservers are notified of the rows to write, they are used to find the late data of the continuous queries
+	// and to invalidate the cached query results
+	rowsObservers []RowsObserver
+
 	logger *logger.Logger
 }
 
+type RowsObserver interface {
+	ObserveRows(database string, rows []influx.Row)
+}
+
+// AddRowsObserver adds an observer of the rows to write, it must be called before the writes start.
+func (w *PointsWriter) AddRowsObserver(observer RowsObserver) {
+	w.rowsObservers = append(w.rowsObservers, observer)
+}
+
 // NewPointsWriter returns a new instance of PointsWriter for a node.
 func NewPointsWriter(timeout time.Duration) *PointsWriter {
 	return &PointsWriter{
@@ -229,6 +242,10 @@ func (w *PointsWriter) RetryWritePointRows(database, retentionPolicy string, row
 	var err error
 	start := time.Now()
 
+	for _, observer := range w.rowsObservers {
+		observer.ObserveRows(database, rows)
+	}
+
 	for {
 		err = w.writePointRows(database, retentionPolicy, rows)
 		if err == nil {
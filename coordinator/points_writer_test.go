@@ -419,6 +419,36 @@ func TestPointsWriter_WritePointRows(t *testing.T) {
 	}
 }
 
+type mockRowsObserver struct {
+	database string
+	rows     int
+}
+
+func (o *mockRowsObserver) ObserveRows(database string, rows []influx.Row) {
+	o.database = database
+	o.rows += len(rows)
+}
+
+func TestPointsWriter_RowsObserver(t *testing.T) {
+	pw := NewPointsWriter(time.Second)
+	pw.MetaClient = NewMockMetaClient()
+	pw.TSDBStore = NewMockNetStore()
+	observer := &mockRowsObserver{}
+	other := &mockRowsObserver{}
+	pw.AddRowsObserver(observer)
+	pw.AddRowsObserver(other)
+	rows := make([]influx.Row, 10)
+	err := pw.RetryWritePointRows("db0", "rp0", generateRows(10, rows))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range []*mockRowsObserver{observer, other} {
+		if o.database != "db0" || o.rows != 10 {
+			t.Fatalf("unexpected observed rows: %s %d", o.database, o.rows)
+		}
+	}
+}
+
 func TestPointsWriter_WritePointRowsWithShardLists1(t *testing.T) {
 	pw := NewPointsWriter(time.Second)
 	pw.MetaClient = NewMockMetaClientWithShardLists()
@@ -43,6 +43,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"go.uber.org/zap"
 )
 
@@ -73,6 +74,8 @@ type RecMsg struct {
 	Measurement     string
 	Rec             interface{}
 	MsgType         record.RecordType
+	// Done receives the result of the write if it is not nil, the sender waits for it
+	Done chan error
 }
 
 // RecordWriter handles writes the local data node.
@@ -95,6 +98,19 @@ type RecordWriter struct {
 	StorageEngine interface {
 		WriteRec(db, rp, mst string, ptId uint32, shardID uint64, rec *record.Record, binaryRec []byte) error
 	}
+
+	// recordObservers are notified of the time range of the records to write, they are used to invalidate
+	// the cached query results
+	recordObservers []RecordObserver
+}
+
+type RecordObserver interface {
+	ObserveRecord(database, measurement string, minTime, maxTime int64)
+}
+
+// AddRecordObserver adds an observer of the records to write, it must be called before the writes start.
+func (w *RecordWriter) AddRecordObserver(observer RecordObserver) {
+	w.recordObservers = append(w.recordObservers, observer)
 }
 
 func NewRecordWriter(timeout time.Duration, ptNum, recMsgChFactor int) *RecordWriter {
@@ -121,6 +137,26 @@ func (w *RecordWriter) RetryWriteRecord(database, retentionPolicy, measurement s
 	return nil
 }
 
+// RetryWriteNativeRecord writes the record of the column store measurement, the time column of the record must be the last.
+// It returns after the record is written, so the record can be reused by the caller and the write error is returned.
+func (w *RecordWriter) RetryWriteNativeRecord(database, retentionPolicy, measurement string, rec *record.Record) error {
+	done := make(chan error, 1)
+	w.recMsgCh <- &RecMsg{
+		Database:        database,
+		RetentionPolicy: retentionPolicy,
+		Measurement:     measurement,
+		Rec:             rec,
+		MsgType:         record.ColumnStoreRecord,
+		Done:            done,
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-w.ctx.Done():
+		return errno.NewError(errno.RecordWriterFatalErr)
+	}
+}
+
 func (w *RecordWriter) RetryWriteLogRecord(bulk *record.BulkRecords) error {
 	w.recMsgCh <- &RecMsg{
 		TotalLen:        bulk.TotalLen,
@@ -297,9 +333,13 @@ func (w *RecordWriter) processRecord(msg *RecMsg, ptIdx int) {
 	var rowNums int64
 	defer func() {
 		if err := recover(); err != nil {
+			writeErr = errno.NewError(errno.RecoverPanic, err)
 			fmt.Println("processRecord panic", zap.String("db", msg.Database), zap.String("rp", msg.RetentionPolicy), zap.String("mst", msg.Measurement),
 				zap.String("record writer raise stack:", string(debug.Stack())),
-				zap.Error(errno.NewError(errno.RecoverPanic, err)))
+				zap.Error(writeErr))
+		}
+		if msg.Done != nil {
+			msg.Done <- writeErr
 		}
 		if writeErr != nil && !IsKeepWritingErr(writeErr) {
 			w.logger.Error("processRecord err", zap.String("db", msg.Database), zap.String("rp", msg.RetentionPolicy), zap.String("mst", msg.Measurement), zap.Error(writeErr))
@@ -327,7 +367,11 @@ func (w *RecordWriter) processRecord(msg *RecMsg, ptIdx int) {
 		writeErr = w.writeRecord(msg.Database, msg.RetentionPolicy, msg.Measurement, m, ptIdx)
 		rowNums = m.NumRows()
 	case *record.Record:
-		writeErr = w.writeLogRecord(msg.Database, msg.RetentionPolicy, msg.Measurement, msg.TotalLen, m, ptIdx)
+		if msg.MsgType == record.ColumnStoreRecord {
+			writeErr = w.writeNativeRecord(msg.Database, msg.RetentionPolicy, msg.Measurement, m, ptIdx)
+		} else {
+			writeErr = w.writeLogRecord(msg.Database, msg.RetentionPolicy, msg.Measurement, msg.TotalLen, m, ptIdx)
+		}
 		rowNums = int64(m.RowNums())
 	default:
 		break
@@ -401,7 +445,21 @@ func (w *RecordWriter) writeLogRecord(db, rp, mst string, totalLen int64, rec *r
 	if err := record.AppendSeqIdSchema(rec); err != nil {
 		return err
 	}
+	return w.writeSortedRecord(db, rp, mst, totalLen, rec, ptIdx)
+}
 
+func (w *RecordWriter) writeNativeRecord(db, rp, mst string, rec *record.Record, ptIdx int) error {
+	colNum, rowNum := rec.ColNums(), rec.RowNums()
+	if colNum == 0 || rowNum == 0 {
+		return nil
+	}
+
+	sort.Sort(rec)
+	return w.writeSortedRecord(db, rp, mst, 0, rec, ptIdx)
+}
+
+// writeSortedRecord writes the record sorted by time to the shards of the column store measurement.
+func (w *RecordWriter) writeSortedRecord(db, rp, mst string, totalLen int64, rec *record.Record, ptIdx int) error {
 	ctx := getWriteRecCtx()
 	defer putWriteRecCtx(ctx)
 
@@ -441,6 +499,13 @@ func (w *RecordWriter) writeLogRecord(db, rp, mst string, totalLen int64, rec *r
 }
 
 func (w *RecordWriter) splitAndWriteByShard(sgis []*meta.ShardGroupInfo, db, rp, mst string, totalLen int64, rec *record.Record, ptIdx int, engineType config.EngineType) error {
+	if len(w.recordObservers) > 0 && rec.RowNums() > 0 {
+		originName := influx.GetOriginMstName(mst)
+		for _, observer := range w.recordObservers {
+			observer.ObserveRecord(db, originName, rec.Time(0), rec.Time(rec.RowNums()-1))
+		}
+	}
+
 	start := 0
 	var subRec *record.Record
 	var err error
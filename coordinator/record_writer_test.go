@@ -446,6 +446,57 @@ func TestWriteLogRecord(t *testing.T) {
 	}
 }
 
+func TestWriteNativeRecord(t *testing.T) {
+	var err error
+	db, rp, mst := "db0", "rp0", "rtt"
+
+	// get the cur nodeId
+	startClient(t)
+
+	rw := NewRecordWriter(10*time.Second, 1, 2)
+	defer func() {
+		if err = rw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Int, Name: "int"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	rec := record.NewRecord(schema, true)
+	rec.ColVals[0].AppendIntegers([]int64{1, 2, 3}...)
+	unixNano := time.Now().UnixNano() + 30*time.Minute.Nanoseconds()
+	rec.AppendTime([]int64{unixNano + 2, unixNano, unixNano + 1}...)
+
+	streamDistribution = diffDis
+	engineType = config.COLUMNSTORE
+	rw.MetaClient = NewMockMetaClient()
+	rw.StorageEngine = NewMockStorageEngine()
+	if err = rw.Open(); err != nil {
+		t.Fatal(err)
+	}
+	if err = rw.RetryWriteNativeRecord(db, rp, mst, rec); err != nil {
+		t.Fatal(err)
+	}
+
+	// the write error is returned to the caller
+	conflict := record.NewRecord(record.Schemas{
+		record.Field{Type: influx.Field_Type_Float, Name: "int"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}, true)
+	conflict.ColVals[0].AppendFloats(1.5)
+	conflict.AppendTime(unixNano)
+	err = rw.RetryWriteNativeRecord(db, rp, mst, conflict)
+	assert.True(t, errno.Equal(err, errno.ColumnStoreFieldTypeErr), err)
+
+	helper := newRecordWriterHelper(&MockRWMetaClient{DatabaseErr: io.EOF}, 0)
+	rw.recWriterHelpers = append(rw.recWriterHelpers, helper)
+	empty := record.NewRecord(schema, false)
+	assert.NoError(t, rw.writeNativeRecord(db, rp, mst, empty, len(rw.recWriterHelpers)-1))
+	assert.Equal(t, io.EOF, rw.writeNativeRecord(db, rp, mst, rec, len(rw.recWriterHelpers)-1))
+}
+
 func TestSplitAndWriteByShardErr(t *testing.T) {
 	var err error
 	db, rp, mst := "db0", "rp0", "rtt"
@@ -466,6 +517,35 @@ func TestSplitAndWriteByShardErr(t *testing.T) {
 	assert.Equal(t, errno.Equal(err, errno.ArrowFlightGetShardGroupErr), true)
 }
 
+type mockRecordObserver struct {
+	database, measurement string
+	minTime, maxTime      int64
+}
+
+func (o *mockRecordObserver) ObserveRecord(database, measurement string, minTime, maxTime int64) {
+	o.database, o.measurement, o.minTime, o.maxTime = database, measurement, minTime, maxTime
+}
+
+func TestSplitAndWriteByShardObserver(t *testing.T) {
+	rw := NewRecordWriter(10*time.Second, 1, 2)
+	observer := &mockRecordObserver{}
+	rw.AddRecordObserver(observer)
+
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Int, Name: "int"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	rec := record.NewRecord(schema, false)
+	rec.ColVals[0].AppendIntegers(1, 2, 3)
+	rec.AppendTime(10, 20, 30)
+
+	// the observers are notified before the record is written
+	sgi := []*meta.ShardGroupInfo{{StartTime: time.Unix(0, 100), EndTime: time.Unix(0, 200)}}
+	err := rw.splitAndWriteByShard(sgi, "db0", "rp0", "rtt_0000", 0, rec, 0, config.COLUMNSTORE)
+	assert.True(t, errno.Equal(err, errno.ArrowFlightGetShardGroupErr))
+	assert.Equal(t, mockRecordObserver{database: "db0", measurement: "rtt", minTime: 10, maxTime: 30}, *observer)
+}
+
 func MockArrowRecord1() arrow.Record {
 	schema := arrow.NewSchema([]arrow.Field{{Name: "time", Type: arrow.PrimitiveTypes.Int64}}, nil)
 	return array.NewRecordBuilder(memory.DefaultAllocator, schema).NewRecord()
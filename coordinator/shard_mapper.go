@@ -715,7 +715,11 @@ func (csm *ClusterShardMapping) makeRemoteQuery(ctx context.Context, src influxq
 }
 
 func (csm *ClusterShardMapping) LogicalPlanCost(m *influxql.Measurement, opt query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
-	return hybridqp.LogicalPlanCost{}, nil
+	stats, err := csm.Statistics(m, nil)
+	if err != nil {
+		return hybridqp.LogicalPlanCost{}, err
+	}
+	return stats.PlanCost(), nil
 }
 
 // Close clears out the list of mapped shards.
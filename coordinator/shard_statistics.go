@@ -0,0 +1,114 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"sync"
+
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+var statisticsCache = optimizer.NewStatisticsCache(optimizer.DefaultStatisticsTTL, optimizer.DefaultStatisticsCacheSize)
+
+// Statistics collects the statistics of the shards mapped for the measurement.
+// The statistics of each shard are cached for a while, only the shards which are missing in the cache
+// or which are missing some of the tag keys are requested from the ts-store owning them.
+// The requests of all the pts are sent in parallel, so the planning waits for the slowest ts-store only.
+func (csm *ClusterShardMapping) Statistics(m *influxql.Measurement, tagKeys []string) (*optimizer.Statistics, error) {
+	stats := optimizer.NewStatistics()
+	shardInfosByPt := csm.ShardMap[Source{Database: m.Database, RetentionPolicy: m.RetentionPolicy}]
+	if len(shardInfosByPt) == 0 || m.IsRWSplit() {
+		// the shards of a read write split measurement are read by the read nodes from the object storage,
+		// the owners may not have them locally
+		return stats, nil
+	}
+
+	measurements, err := csm.MetaClient.GetMeasurements(m)
+	if err != nil {
+		return nil, err
+	}
+	ptView, err := csm.MetaClient.DBPtView(m.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []statisticsRequest
+	for _, mst := range measurements {
+		for ptID, shardInfos := range shardInfosByPt {
+			var missing []uint64
+			for i := range shardInfos {
+				cached, ok := statisticsCache.Get(optimizer.StatisticsKey{ShardID: shardInfos[i].ID, Measurement: mst.Name})
+				if ok && cached.HasTagKeys(tagKeys) {
+					stats.Merge(cached)
+					continue
+				}
+				missing = append(missing, shardInfos[i].ID)
+			}
+			if len(missing) == 0 {
+				continue
+			}
+
+			if int(ptID) >= len(ptView) {
+				return nil, errno.NewError(errno.PtNotFound)
+			}
+			requests = append(requests, statisticsRequest{
+				nodeID:      ptView[ptID].Owner.NodeID,
+				ptID:        ptID,
+				measurement: mst.Name,
+				shardIDs:    missing,
+			})
+		}
+	}
+
+	var mu sync.Mutex
+	var errs error
+	once := sync.Once{}
+	wg := sync.WaitGroup{}
+	for _, req := range requests {
+		wg.Add(1)
+		go func(req statisticsRequest) {
+			defer wg.Done()
+			ret, err := csm.NetStore.CollectStatistics(req.nodeID, m.Database, req.ptID, req.shardIDs, req.measurement, tagKeys)
+			if err != nil {
+				once.Do(func() {
+					errs = err
+				})
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for shardID, s := range ret {
+				statisticsCache.Put(optimizer.StatisticsKey{ShardID: shardID, Measurement: req.measurement}, s)
+				stats.Merge(s)
+			}
+		}(req)
+	}
+	wg.Wait()
+	if errs != nil {
+		return nil, errs
+	}
+	return stats, nil
+}
+
+// statisticsRequest asks the owner of a pt for the statistics of the shards missing in the cache
+type statisticsRequest struct {
+	nodeID      uint64
+	ptID        uint32
+	measurement string
+	shardIDs    []uint64
+}
@@ -0,0 +1,139 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type statisticsMetaClient struct {
+	metaclient.MetaClient
+}
+
+func (c *statisticsMetaClient) GetMeasurements(m *influxql.Measurement) ([]*meta2.MeasurementInfo, error) {
+	return []*meta2.MeasurementInfo{{Name: m.Name + "_0000"}}, nil
+}
+
+func (c *statisticsMetaClient) DBPtView(database string) (meta2.DBPtInfos, error) {
+	return meta2.DBPtInfos{
+		{PtId: 0, Owner: meta2.PtOwner{NodeID: 1}},
+		{PtId: 1, Owner: meta2.PtOwner{NodeID: 2}},
+	}, nil
+}
+
+type statisticsNetStorage struct {
+	netstorage.Storage
+	requests atomic.Int32
+	// the requests wait for each other when it is set, they only succeed if they are sent in parallel
+	barrier *sync.WaitGroup
+}
+
+func (s *statisticsNetStorage) CollectStatistics(nodeID uint64, db string, ptID uint32, shardIDs []uint64, mst string, tagKeys []string) (map[uint64]*optimizer.Statistics, error) {
+	s.requests.Add(1)
+	if s.barrier != nil {
+		s.barrier.Done()
+		done := make(chan struct{})
+		go func() {
+			s.barrier.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			return nil, fmt.Errorf("the requests are not sent in parallel")
+		}
+	}
+	if db == "db_error" {
+		return nil, fmt.Errorf("collect statistics failed")
+	}
+	ret := make(map[uint64]*optimizer.Statistics, len(shardIDs))
+	for _, id := range shardIDs {
+		stats := optimizer.NewStatistics()
+		stats.ShardN = 1
+		stats.SeriesN = int64(nodeID * 10)
+		stats.RowN = 100
+		for _, key := range tagKeys {
+			stats.TagValueN[key] = 5
+		}
+		ret[id] = stats
+	}
+	return ret, nil
+}
+
+func TestClusterShardMapping_Statistics(t *testing.T) {
+	store := &statisticsNetStorage{}
+	csm := &ClusterShardMapping{
+		MetaClient: &statisticsMetaClient{},
+		NetStore:   store,
+		ShardMap: map[Source]map[uint32][]executor.ShardInfo{
+			{Database: "db_stats", RetentionPolicy: "rp0"}: {
+				0: {{ID: 1001}, {ID: 1002}},
+				1: {{ID: 1003}},
+			},
+			{Database: "db_error", RetentionPolicy: "rp0"}: {
+				0: {{ID: 1004}},
+			},
+		},
+	}
+
+	// one request per pt, they are sent in parallel
+	store.barrier = &sync.WaitGroup{}
+	store.barrier.Add(2)
+	m := &influxql.Measurement{Database: "db_stats", RetentionPolicy: "rp0", Name: "cpu"}
+	stats, err := csm.Statistics(m, []string{"host"})
+	require.NoError(t, err)
+	store.barrier = nil
+	assert.Equal(t, int64(3), stats.ShardN)
+	assert.Equal(t, int64(40), stats.SeriesN)
+	assert.Equal(t, int64(300), stats.RowN)
+	assert.Equal(t, int64(5), stats.TagValueN["host"])
+	assert.Equal(t, 2, int(store.requests.Load()))
+
+	// served by the cache
+	_, err = csm.Statistics(m, []string{"host"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, int(store.requests.Load()))
+
+	// a tag key missing in the cache
+	stats, err = csm.Statistics(m, []string{"region"})
+	require.NoError(t, err)
+	assert.Equal(t, 4, int(store.requests.Load()))
+	assert.Equal(t, int64(5), stats.TagValueN["region"])
+
+	cost, err := csm.LogicalPlanCost(m, query.ProcessorOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), cost.NumSeries)
+
+	stats, err = csm.Statistics(&influxql.Measurement{Database: "db_none", RetentionPolicy: "rp0", Name: "cpu"}, nil)
+	require.NoError(t, err)
+	assert.True(t, stats.IsEmpty())
+
+	_, err = csm.Statistics(&influxql.Measurement{Database: "db_error", RetentionPolicy: "rp0", Name: "cpu"}, nil)
+	assert.Error(t, err)
+}
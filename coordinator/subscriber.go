@@ -17,10 +17,15 @@ package coordinator
 import (
 	"bytes"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,6 +33,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/crypto"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"go.uber.org/zap"
 )
@@ -65,12 +71,33 @@ func (c *HTTPClient) Send(db, rp string, lineProtocol []byte) error {
 		if err != nil {
 			return err
 		}
-		err = fmt.Errorf(string(body))
-		return err
+		return &SendError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 	return nil
 }
 
+// SendError is the error response of the destination to the write request.
+type SendError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *SendError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Body)
+}
+
+// retryableSendError returns true if the destination may accept the write request later, such as for the network
+// errors and the server errors. The request rejected for a bad request, the authorization or an unknown database
+// is never accepted.
+func retryableSendError(err error) bool {
+	var sendErr *SendError
+	if !errors.As(err, &sendErr) {
+		return true
+	}
+	code := sendErr.StatusCode
+	return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+}
+
 func (c *HTTPClient) Destination() string {
 	return c.url.String()
 }
@@ -100,6 +127,144 @@ func NewHTTPSClient(url *url.URL, timeout time.Duration, skipVerify bool, certs
 	return &HTTPClient{client: c, url: url}, nil
 }
 
+// DurableClient queues the line protocol on the disk and delivers it to the destination in order.
+// The delivery is retried with exponential backoff until the destination accepts it,
+// so every line protocol queued is delivered at least once unless it is dropped by the size or the age limit of the queue,
+// or it is rejected by the destination permanently.
+// In the ANY mode, the line protocol queued is handed to the other destinations while the destination is down.
+type DurableClient struct {
+	client           Client
+	queue            *SubscriberQueue
+	stat             *statistics.SubscriberStatItem
+	retryInterval    time.Duration
+	retryMaxInterval time.Duration
+	logger           *logger.Logger
+
+	// peers are the durable clients of the other destinations of the subscription in the ANY mode
+	peers []*DurableClient
+	// down is 1 if the last delivery to the destination failed
+	down int32
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewDurableClient opens the queue of the destination, the delivery is started by Start.
+func NewDurableClient(client Client, dir string, c config.Subscriber, stat *statistics.SubscriberStatItem, logger *logger.Logger) (*DurableClient, error) {
+	queue, err := OpenSubscriberQueue(dir, int64(c.QueueMaxSize), time.Duration(c.QueueMaxAge), time.Duration(c.QueueSyncInterval), stat)
+	if err != nil {
+		return nil, err
+	}
+	return &DurableClient{
+		client:           client,
+		queue:            queue,
+		stat:             stat,
+		retryInterval:    time.Duration(c.RetryInterval),
+		retryMaxInterval: time.Duration(c.RetryMaxInterval),
+		logger:           logger,
+		closing:          make(chan struct{}),
+	}, nil
+}
+
+// Start starts the delivery of the line protocol queued.
+func (c *DurableClient) Start() {
+	c.wg.Add(1)
+	go c.run()
+}
+
+func (c *DurableClient) Send(db, rp string, lineProtocol []byte) error {
+	return c.queue.Append(db, rp, lineProtocol)
+}
+
+func (c *DurableClient) Destination() string {
+	return c.client.Destination()
+}
+
+func (c *DurableClient) run() {
+	defer c.wg.Done()
+	backoff := c.retryInterval
+	var retryAt time.Time
+	for {
+		db, rp, lineProtocol, ts, err := c.queue.Peek()
+		if err == errSubscriberQueueEmpty {
+			atomic.StoreInt64(&c.stat.Lag, 0)
+			select {
+			case <-c.queue.Notify():
+				continue
+			case <-c.closing:
+				return
+			}
+		} else if err != nil {
+			c.logger.Error("failed to read subscriber queue", zap.String("dest", c.Destination()), zap.Error(err))
+			return
+		}
+		atomic.StoreInt64(&c.stat.Lag, time.Since(ts).Nanoseconds())
+
+		if wait := time.Until(retryAt); wait > 0 {
+			if c.handOff(db, rp, lineProtocol) {
+				c.advance()
+				continue
+			}
+			select {
+			case <-time.After(wait):
+			case <-c.closing:
+				return
+			}
+		}
+
+		err = c.client.Send(db, rp, lineProtocol)
+		if err != nil && retryableSendError(err) {
+			atomic.StoreInt32(&c.down, 1)
+			atomic.AddInt64(&c.stat.Retries, 1)
+			c.logger.Warn("failed to forward write request, retry later", zap.String("dest", c.Destination()),
+				zap.String("db", db), zap.String("rp", rp), zap.Duration("backoff", backoff), zap.Error(err))
+			retryAt = time.Now().Add(backoff)
+			if backoff *= 2; backoff > c.retryMaxInterval {
+				backoff = c.retryMaxInterval
+			}
+			continue
+		}
+		atomic.StoreInt32(&c.down, 0)
+		backoff, retryAt = c.retryInterval, time.Time{}
+		if err != nil {
+			atomic.AddInt64(&c.stat.DroppedReq, 1)
+			c.logger.Error("write request rejected by the destination, drop it", zap.String("dest", c.Destination()),
+				zap.String("db", db), zap.String("rp", rp), zap.Error(err))
+		} else {
+			atomic.AddInt64(&c.stat.DeliveredReq, 1)
+		}
+		c.advance()
+	}
+}
+
+func (c *DurableClient) advance() {
+	if err := c.queue.Advance(); err != nil {
+		c.logger.Error("failed to advance subscriber queue", zap.String("dest", c.Destination()), zap.Error(err))
+	}
+}
+
+// handOff queues the line protocol to a destination which is not down, false is returned if there is none.
+func (c *DurableClient) handOff(db, rp string, lineProtocol []byte) bool {
+	for _, p := range c.peers {
+		if p == c || atomic.LoadInt32(&p.down) == 1 {
+			continue
+		}
+		if err := p.queue.Append(db, rp, lineProtocol); err != nil {
+			continue
+		}
+		atomic.AddInt64(&c.stat.HandedOffReq, 1)
+		return true
+	}
+	return false
+}
+
+// Close stops the delivery, the line protocol not delivered is kept in the queue.
+func (c *DurableClient) Close() error {
+	close(c.closing)
+	c.wg.Wait()
+	return c.queue.Close()
+}
+
 type WriteRequest struct {
 	Client       int
 	LineProtocol []byte
@@ -108,31 +273,45 @@ type WriteRequest struct {
 type BaseWriter struct {
 	ch      chan *WriteRequest
 	clients []Client
+	stats   []*statistics.SubscriberStatItem
 	db      string
 	rp      string
 	name    string
 	logger  *logger.Logger
+	wg      sync.WaitGroup
 }
 
 func NewBaseWriter(db, rp, name string, clients []Client, logger *logger.Logger) BaseWriter {
-	return BaseWriter{db: db, rp: rp, name: name, clients: clients, logger: logger}
+	stats := make([]*statistics.SubscriberStatItem, len(clients))
+	for i, c := range clients {
+		stats[i] = statistics.NewSubscriberStatistics().Item(db, rp, name, c.Destination())
+	}
+	return BaseWriter{db: db, rp: rp, name: name, clients: clients, stats: stats, logger: logger}
 }
 
 func (w *BaseWriter) Send(wr *WriteRequest) {
+	atomic.AddInt64(&w.stats[wr.Client].WriteReq, 1)
 	select {
 	case w.ch <- wr:
 	default:
+		atomic.AddInt64(&w.stats[wr.Client].DroppedReq, 1)
 		w.logger.Error("failed to send write request to write buffer", zap.String("dest", w.clients[wr.Client].Destination()),
 			zap.String("db", w.db), zap.String("rp", w.rp))
 	}
 }
 
 func (w *BaseWriter) Run() {
+	defer w.wg.Done()
 	for wr := range w.ch {
-		err := w.clients[wr.Client].Send(w.db, w.rp, wr.LineProtocol)
+		c := w.clients[wr.Client]
+		err := c.Send(w.db, w.rp, wr.LineProtocol)
 		if err != nil {
-			w.logger.Error("failed to forward write request", zap.String("dest", w.clients[wr.Client].Destination()),
+			atomic.AddInt64(&w.stats[wr.Client].DroppedReq, 1)
+			w.logger.Error("failed to forward write request", zap.String("dest", c.Destination()),
 				zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
+		} else if _, ok := c.(*DurableClient); !ok {
+			// the durable client counts the requests delivered from its queue
+			atomic.AddInt64(&w.stats[wr.Client].DeliveredReq, 1)
 		}
 	}
 }
@@ -147,6 +326,7 @@ func (w *BaseWriter) Clients() []Client {
 
 func (w *BaseWriter) Start(concurrency, buffersize int) {
 	w.ch = make(chan *WriteRequest, buffersize)
+	w.wg.Add(concurrency)
 	for i := 0; i < concurrency; i++ {
 		go w.Run()
 	}
@@ -154,6 +334,23 @@ func (w *BaseWriter) Start(concurrency, buffersize int) {
 
 func (w *BaseWriter) Stop() {
 	close(w.ch)
+	durable := false
+	for _, c := range w.clients {
+		_, ok := c.(*DurableClient)
+		durable = durable || ok
+	}
+	if !durable {
+		return
+	}
+	// the write requests in the buffer are queued before the durable clients are closed
+	w.wg.Wait()
+	for _, c := range w.clients {
+		if dc, ok := c.(*DurableClient); ok {
+			if err := dc.Close(); err != nil {
+				w.logger.Error("failed to close subscriber queue", zap.String("dest", dc.Destination()), zap.Error(err))
+			}
+		}
+	}
 }
 
 type SubscriberWriter interface {
@@ -207,6 +404,9 @@ type SubscriberManager struct {
 }
 
 func (s *SubscriberManager) NewSubscriberWriter(db, rp, name, mode string, destinations []string) (SubscriberWriter, error) {
+	if mode != "ALL" && mode != "ANY" {
+		return nil, fmt.Errorf("unknown subscription mode %s", mode)
+	}
 	clients := make([]Client, 0, len(destinations))
 	for _, dest := range destinations {
 		u, err := url.Parse(dest)
@@ -227,13 +427,74 @@ func (s *SubscriberManager) NewSubscriberWriter(db, rp, name, mode string, desti
 		}
 		clients = append(clients, c)
 	}
-	switch mode {
-	case "ALL":
+
+	if s.config.QueueDir != "" {
+		for i, c := range clients {
+			dest := c.Destination()
+			stat := statistics.NewSubscriberStatistics().Item(db, rp, name, dest)
+			dc, err := NewDurableClient(c, s.queueDir(db, rp, name, dest), s.config, stat, s.Logger)
+			if err != nil {
+				closeDurableClients(clients[:i])
+				return nil, fmt.Errorf("fail to open subscriber queue of %s: %s", dest, err)
+			}
+			clients[i] = dc
+		}
+		startDurableClients(clients, mode == "ANY")
+	}
+
+	if mode == "ALL" {
 		return &AllWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}, nil
-	case "ANY":
-		return &RoundRobinWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}, nil
 	}
-	return nil, fmt.Errorf("unknown subscription mode %s", mode)
+	return &RoundRobinWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}, nil
+}
+
+// queueDir returns the directory of the queue of the destination of the subscription.
+func (s *SubscriberManager) queueDir(db, rp, name, dest string) string {
+	return filepath.Join(s.subscriptionDir(db, rp, name), hashedDirName(dest))
+}
+
+// subscriptionDir returns the directory holding the queues of the subscription.
+// The subscription name is hashed like the destination, it is not validated as strictly as the db and rp names.
+func (s *SubscriberManager) subscriptionDir(db, rp, name string) string {
+	return filepath.Join(s.config.QueueDir, db, rp, hashedDirName(name))
+}
+
+func hashedDirName(name string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// underDir reports whether path is a strict descendant of dir.
+func underDir(dir, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+// startDurableClients starts the delivery of the durable clients, they hand the line protocol over to each other in the ANY mode.
+func startDurableClients(clients []Client, handOff bool) {
+	var peers []*DurableClient
+	if handOff {
+		for _, c := range clients {
+			peers = append(peers, c.(*DurableClient))
+		}
+	}
+	for _, c := range clients {
+		dc := c.(*DurableClient)
+		dc.peers = peers
+		dc.Start()
+	}
+}
+
+func closeDurableClients(clients []Client) {
+	for _, c := range clients {
+		if dc, ok := c.(*DurableClient); ok {
+			_ = dc.Close()
+		}
+	}
 }
 
 func (s *SubscriberManager) InitWriters() {
@@ -324,6 +585,7 @@ func (s *SubscriberManager) UpdateWriters() {
 					position++
 				} else {
 					writers[i].Stop()
+					s.removeSubscription(dbi.Name, rpi.Name, writers[i].Name())
 					s.Logger.Info("remove subscriber writer", zap.String("db", dbi.Name), zap.String("rp", rpi.Name), zap.String("sub", writers[i].Name()))
 				}
 			}
@@ -332,6 +594,23 @@ func (s *SubscriberManager) UpdateWriters() {
 	})
 }
 
+// removeSubscription removes the statistics and the queues of the subscription dropped.
+func (s *SubscriberManager) removeSubscription(db, rp, name string) {
+	statistics.NewSubscriberStatistics().Remove(db, rp, name)
+	if s.config.QueueDir == "" {
+		return
+	}
+	dir := s.subscriptionDir(db, rp, name)
+	if !underDir(s.config.QueueDir, dir) {
+		s.Logger.Error("refuse to remove subscriber queue out of the queue dir", zap.String("db", db), zap.String("rp", rp), zap.String("sub", name),
+			zap.String("dir", dir))
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		s.Logger.Error("fail to remove subscriber queue", zap.String("db", db), zap.String("rp", rp), zap.String("sub", name), zap.Error(err))
+	}
+}
+
 func (s *SubscriberManager) Send(db, rp string, lineProtocol []byte) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
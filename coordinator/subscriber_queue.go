@@ -0,0 +1,506 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+)
+
+const (
+	DefaultSubscriberSegmentSize = 16 * 1024 * 1024
+
+	subscriberSegmentSuffix = ".seg"
+	subscriberCursorFile    = "cursor"
+	// the header of an entry is the length and the crc32 of the payload, and the unix nano time of the entry
+	subscriberEntryHeaderSize = 16
+)
+
+var (
+	errSubscriberQueueEmpty  = errors.New("subscriber queue is empty")
+	errSubscriberQueueClosed = errors.New("subscriber queue is closed")
+)
+
+type queueSegment struct {
+	id    uint64
+	size  int64
+	count int64
+}
+
+// SubscriberQueue is the on-disk queue of the line protocol of a subscription destination.
+// The entries are appended to the segment files in order, the first segment is the one being read and
+// the cursor file keeps the read position, so the entries not delivered are replayed after a restart.
+// The oldest segment is dropped if the queue exceeds the max size, and the entries older than the max age are skipped.
+// The segment is synced to the disk when it is rolled and every sync interval, or on every append if the interval is zero.
+type SubscriberQueue struct {
+	mu           sync.Mutex
+	dir          string
+	maxSize      int64
+	maxAge       time.Duration
+	syncInterval time.Duration
+	segSize      int64
+	segments     []*queueSegment
+	size         int64
+	count        int64
+	// dirty is true if the segment or the cursor is changed after the last sync
+	dirty bool
+
+	w      *os.File
+	r      *os.File
+	cursor *os.File
+	rOff   int64
+	rCount int64
+	peeked int64
+
+	closed  bool
+	closing chan struct{}
+	wg      sync.WaitGroup
+	notify  chan struct{}
+	stat    *statistics.SubscriberStatItem
+}
+
+func OpenSubscriberQueue(dir string, maxSize int64, maxAge, syncInterval time.Duration, stat *statistics.SubscriberStatItem) (*SubscriberQueue, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	q := &SubscriberQueue{
+		dir:          dir,
+		maxSize:      maxSize,
+		maxAge:       maxAge,
+		syncInterval: syncInterval,
+		segSize:      DefaultSubscriberSegmentSize,
+		closing:      make(chan struct{}),
+		notify:       make(chan struct{}, 1),
+		stat:         stat,
+	}
+	if q.segSize > maxSize/4 {
+		q.segSize = maxSize / 4
+	}
+
+	ids, err := q.segmentIDs()
+	if err != nil {
+		return nil, err
+	}
+	q.cursor, err = os.OpenFile(filepath.Join(dir, subscriberCursorFile), os.O_CREATE|os.O_RDWR, 0640)
+	if err != nil {
+		return nil, err
+	}
+	curID, curOff := q.readCursor()
+
+	for _, id := range ids {
+		// the segments before the cursor have been delivered
+		if id < curID {
+			_ = os.Remove(q.segmentPath(id))
+			continue
+		}
+		seg := &queueSegment{id: id}
+		limit := int64(-1)
+		if id == curID {
+			limit = curOff
+		}
+		var before int64
+		seg.size, seg.count, before, err = scanSegment(q.segmentPath(id), limit)
+		if err != nil {
+			_ = q.cursor.Close()
+			return nil, err
+		}
+		if id == curID {
+			q.rOff, q.rCount = curOff, before
+		}
+		q.segments = append(q.segments, seg)
+		q.size += seg.size
+		q.count += seg.count
+	}
+
+	// always append to a new segment, so a torn entry at the end of the last segment is not followed by the new ones
+	if err = q.roll(); err != nil {
+		_ = q.cursor.Close()
+		return nil, err
+	}
+	q.updateStat()
+	if q.syncInterval > 0 {
+		q.wg.Add(1)
+		go q.syncLoop()
+	}
+	return q, nil
+}
+
+func (q *SubscriberQueue) syncLoop() {
+	defer q.wg.Done()
+	ticker := time.NewTicker(q.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			q.mu.Lock()
+			if !q.closed {
+				_ = q.sync()
+			}
+			q.mu.Unlock()
+		case <-q.closing:
+			return
+		}
+	}
+}
+
+// sync flushes the segment being appended and the cursor to the disk.
+func (q *SubscriberQueue) sync() error {
+	if !q.dirty {
+		return nil
+	}
+	if err := q.w.Sync(); err != nil {
+		return err
+	}
+	if err := q.cursor.Sync(); err != nil {
+		return err
+	}
+	q.dirty = false
+	return nil
+}
+
+func (q *SubscriberQueue) segmentPath(id uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%016x%s", id, subscriberSegmentSuffix))
+}
+
+func (q *SubscriberQueue) segmentIDs() ([]uint64, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, subscriberSegmentSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, subscriberSegmentSuffix), 16, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func (q *SubscriberQueue) readCursor() (uint64, int64) {
+	buf := make([]byte, 16)
+	if n, _ := q.cursor.ReadAt(buf, 0); n != len(buf) {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint64(buf), int64(binary.BigEndian.Uint64(buf[8:]))
+}
+
+func (q *SubscriberQueue) saveCursor() error {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, q.segments[0].id)
+	binary.BigEndian.PutUint64(buf[8:], uint64(q.rOff))
+	_, err := q.cursor.WriteAt(buf, 0)
+	q.dirty = true
+	return err
+}
+
+// scanSegment returns the size and the number of the entries of the segment, and the number of the entries before the limit.
+func scanSegment(path string, limit int64) (size, count, before int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return
+	}
+	size = info.Size()
+
+	header := make([]byte, subscriberEntryHeaderSize)
+	var off int64
+	for off+subscriberEntryHeaderSize <= size {
+		if _, err = f.ReadAt(header, off); err != nil {
+			return
+		}
+		next := off + subscriberEntryHeaderSize + int64(binary.BigEndian.Uint32(header))
+		if next > size {
+			break
+		}
+		count++
+		if next <= limit {
+			before++
+		}
+		off = next
+	}
+	return
+}
+
+// roll creates a new segment to append the entries to, the previous one is synced before.
+func (q *SubscriberQueue) roll() error {
+	if q.w != nil {
+		if err := q.sync(); err != nil {
+			return err
+		}
+	}
+	var id uint64 = 1
+	if len(q.segments) > 0 {
+		id = q.segments[len(q.segments)-1].id + 1
+	}
+	f, err := os.OpenFile(q.segmentPath(id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	if q.w != nil {
+		_ = q.w.Close()
+	}
+	q.w = f
+	q.segments = append(q.segments, &queueSegment{id: id})
+	return nil
+}
+
+// dropHead removes the first segment, the entries of it which have not been read are dropped.
+func (q *SubscriberQueue) dropHead() {
+	head := q.segments[0]
+	if q.r != nil {
+		_ = q.r.Close()
+		q.r = nil
+	}
+	_ = os.Remove(q.segmentPath(head.id))
+	atomic.AddInt64(&q.stat.DroppedReq, head.count-q.rCount)
+	q.segments = q.segments[1:]
+	q.size -= head.size
+	q.count -= head.count
+	q.rOff, q.rCount, q.peeked = 0, 0, 0
+	_ = q.saveCursor()
+}
+
+func (q *SubscriberQueue) updateStat() {
+	atomic.StoreInt64(&q.stat.PendingReq, q.count-q.rCount)
+	atomic.StoreInt64(&q.stat.PendingBytes, q.size-q.rOff)
+}
+
+// Append appends the line protocol of the database and the retention policy to the queue.
+func (q *SubscriberQueue) Append(db, rp string, lineProtocol []byte) error {
+	payload := make([]byte, 0, 4+len(db)+len(rp)+len(lineProtocol))
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(db)))
+	payload = append(payload, db...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(rp)))
+	payload = append(payload, rp...)
+	payload = append(payload, lineProtocol...)
+
+	buf := make([]byte, subscriberEntryHeaderSize, subscriberEntryHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(buf, uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:], crc32.ChecksumIEEE(payload))
+	binary.BigEndian.PutUint64(buf[8:], uint64(time.Now().UnixNano()))
+	buf = append(buf, payload...)
+
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return errSubscriberQueueClosed
+	}
+	if q.segments[len(q.segments)-1].size >= q.segSize {
+		if err := q.roll(); err != nil {
+			q.mu.Unlock()
+			return err
+		}
+	}
+	tail := q.segments[len(q.segments)-1]
+	n, err := q.w.Write(buf)
+	tail.size += int64(n)
+	q.size += int64(n)
+	if err != nil {
+		// the torn entry is skipped by the reader
+		_ = q.roll()
+		q.mu.Unlock()
+		return err
+	}
+	tail.count++
+	q.count++
+	q.dirty = true
+	if q.syncInterval == 0 {
+		if err = q.sync(); err != nil {
+			q.mu.Unlock()
+			return err
+		}
+	}
+	for q.size > q.maxSize && len(q.segments) > 1 {
+		q.dropHead()
+	}
+	q.updateStat()
+	q.mu.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Peek returns the first entry which has not been delivered, or errSubscriberQueueEmpty if there is none.
+// The entry is removed from the queue by Advance.
+func (q *SubscriberQueue) Peek() (db, rp string, lineProtocol []byte, ts time.Time, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		err = errSubscriberQueueClosed
+		return
+	}
+
+	header := make([]byte, subscriberEntryHeaderSize)
+	for {
+		head := q.segments[0]
+		if q.rOff >= head.size {
+			if len(q.segments) == 1 {
+				err = errSubscriberQueueEmpty
+				return
+			}
+			q.dropHead()
+			continue
+		}
+
+		if q.r == nil {
+			if q.r, err = os.Open(q.segmentPath(head.id)); err != nil {
+				return
+			}
+		}
+		var payload []byte
+		payload, ts, err = q.readEntry(header, head)
+		if err != nil {
+			// the rest of the corrupted segment is skipped
+			if len(q.segments) == 1 {
+				if err = q.roll(); err != nil {
+					return
+				}
+			}
+			q.dropHead()
+			continue
+		}
+
+		size := int64(subscriberEntryHeaderSize + len(payload))
+		if q.maxAge > 0 && time.Since(ts) > q.maxAge {
+			q.rOff += size
+			q.rCount++
+			atomic.AddInt64(&q.stat.DroppedReq, 1)
+			continue
+		}
+		var ok bool
+		if db, rp, lineProtocol, ok = decodeQueueEntry(payload); !ok {
+			q.rOff += size
+			q.rCount++
+			atomic.AddInt64(&q.stat.DroppedReq, 1)
+			continue
+		}
+		q.peeked = size
+		return
+	}
+}
+
+func (q *SubscriberQueue) readEntry(header []byte, head *queueSegment) ([]byte, time.Time, error) {
+	if q.rOff+subscriberEntryHeaderSize > head.size {
+		return nil, time.Time{}, errors.New("torn entry header")
+	}
+	if _, err := q.r.ReadAt(header, q.rOff); err != nil {
+		return nil, time.Time{}, err
+	}
+	n := int64(binary.BigEndian.Uint32(header))
+	if q.rOff+subscriberEntryHeaderSize+n > head.size {
+		return nil, time.Time{}, errors.New("torn entry")
+	}
+	payload := make([]byte, n)
+	if _, err := q.r.ReadAt(payload, q.rOff+subscriberEntryHeaderSize); err != nil {
+		return nil, time.Time{}, err
+	}
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+		return nil, time.Time{}, errors.New("entry checksum mismatch")
+	}
+	return payload, time.Unix(0, int64(binary.BigEndian.Uint64(header[8:]))), nil
+}
+
+func decodeQueueEntry(payload []byte) (db, rp string, lineProtocol []byte, ok bool) {
+	readString := func() (string, bool) {
+		if len(payload) < 2 {
+			return "", false
+		}
+		n := int(binary.BigEndian.Uint16(payload))
+		if len(payload) < 2+n {
+			return "", false
+		}
+		s := string(payload[2 : 2+n])
+		payload = payload[2+n:]
+		return s, true
+	}
+	if db, ok = readString(); !ok {
+		return
+	}
+	if rp, ok = readString(); !ok {
+		return
+	}
+	return db, rp, payload, true
+}
+
+// Advance removes the entry returned by Peek from the queue.
+func (q *SubscriberQueue) Advance() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return errSubscriberQueueClosed
+	}
+	// the segment of the entry has been dropped
+	if q.peeked == 0 {
+		return nil
+	}
+	q.rOff += q.peeked
+	q.rCount++
+	q.peeked = 0
+	q.updateStat()
+	return q.saveCursor()
+}
+
+// Notify returns the channel notified when an entry is appended.
+func (q *SubscriberQueue) Notify() <-chan struct{} {
+	return q.notify
+}
+
+func (q *SubscriberQueue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	err := q.sync()
+	if q.r != nil {
+		_ = q.r.Close()
+	}
+	_ = q.w.Close()
+	if e := q.cursor.Close(); err == nil {
+		err = e
+	}
+	q.mu.Unlock()
+
+	close(q.closing)
+	q.wg.Wait()
+	return err
+}
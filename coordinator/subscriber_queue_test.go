@@ -0,0 +1,159 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func peekQueue(t *testing.T, q *SubscriberQueue) string {
+	db, rp, lp, _, err := q.Peek()
+	require.NoError(t, err)
+	require.NoError(t, q.Advance())
+	return fmt.Sprintf("%s.%s %s", db, rp, lp)
+}
+
+func TestSubscriberQueue_Replay(t *testing.T) {
+	dir := t.TempDir()
+	stat := &statistics.SubscriberStatItem{}
+	q, err := OpenSubscriberQueue(dir, 1024*1024, 0, 0, stat)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, q.Append("db0", "rp0", []byte(fmt.Sprintf("cpu v=%d", i))))
+	}
+	assert.Equal(t, int64(3), stat.PendingReq)
+	assert.Equal(t, "db0.rp0 cpu v=0", peekQueue(t, q))
+
+	// the entry peeked but not advanced is replayed
+	_, _, _, _, err = q.Peek()
+	require.NoError(t, err)
+	require.NoError(t, q.Close())
+	_, _, _, _, err = q.Peek()
+	assert.Equal(t, errSubscriberQueueClosed, err)
+
+	q, err = OpenSubscriberQueue(dir, 1024*1024, 0, 0, stat)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stat.PendingReq)
+	require.NoError(t, q.Append("db0", "", []byte("cpu v=3")))
+	assert.Equal(t, "db0.rp0 cpu v=1", peekQueue(t, q))
+	assert.Equal(t, "db0.rp0 cpu v=2", peekQueue(t, q))
+	assert.Equal(t, "db0. cpu v=3", peekQueue(t, q))
+	_, _, _, _, err = q.Peek()
+	assert.Equal(t, errSubscriberQueueEmpty, err)
+	assert.Equal(t, int64(0), stat.PendingReq)
+	require.NoError(t, q.Close())
+
+	// the segments delivered are removed
+	q, err = OpenSubscriberQueue(dir, 1024*1024, 0, 0, stat)
+	require.NoError(t, err)
+	defer q.Close()
+	_, _, _, _, err = q.Peek()
+	assert.Equal(t, errSubscriberQueueEmpty, err)
+	ids, err := q.segmentIDs()
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(ids))
+}
+
+func TestSubscriberQueue_MaxSize(t *testing.T) {
+	stat := &statistics.SubscriberStatItem{}
+	// every segment holds one entry
+	q, err := OpenSubscriberQueue(t.TempDir(), 100, 0, 0, stat)
+	require.NoError(t, err)
+	defer q.Close()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, q.Append("db0", "rp0", []byte(fmt.Sprintf("cpu v=%d", i))))
+	}
+	assert.True(t, q.size <= 100)
+	assert.Equal(t, int64(7), stat.DroppedReq)
+	assert.Equal(t, "db0.rp0 cpu v=7", peekQueue(t, q))
+}
+
+func TestSubscriberQueue_MaxAge(t *testing.T) {
+	stat := &statistics.SubscriberStatItem{}
+	q, err := OpenSubscriberQueue(t.TempDir(), 1024*1024, 50*time.Millisecond, 0, stat)
+	require.NoError(t, err)
+	defer q.Close()
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=0")))
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=1")))
+	assert.Equal(t, "db0.rp0 cpu v=1", peekQueue(t, q))
+	assert.Equal(t, int64(1), stat.DroppedReq)
+}
+
+func TestSubscriberQueue_Corrupted(t *testing.T) {
+	dir := t.TempDir()
+	stat := &statistics.SubscriberStatItem{}
+	q, err := OpenSubscriberQueue(dir, 1024*1024, 0, 0, stat)
+	require.NoError(t, err)
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=0")))
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=1")))
+	require.NoError(t, q.Close())
+
+	// corrupt the payload of the last entry and append a torn entry
+	f, err := os.OpenFile(q.segmentPath(1), os.O_RDWR, 0640)
+	require.NoError(t, err)
+	info, err := f.Stat()
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("x"), info.Size()-1)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{0, 0, 1}, info.Size())
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	q, err = OpenSubscriberQueue(dir, 1024*1024, 0, 0, stat)
+	require.NoError(t, err)
+	defer q.Close()
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=2")))
+	assert.Equal(t, "db0.rp0 cpu v=0", peekQueue(t, q))
+	assert.Equal(t, "db0.rp0 cpu v=2", peekQueue(t, q))
+	assert.Equal(t, int64(1), stat.DroppedReq)
+}
+
+func TestSubscriberQueue_Sync(t *testing.T) {
+	dirty := func(q *SubscriberQueue) bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.dirty
+	}
+
+	// synced on every append
+	q, err := OpenSubscriberQueue(t.TempDir(), 1024*1024, 0, 0, &statistics.SubscriberStatItem{})
+	require.NoError(t, err)
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=0")))
+	assert.False(t, dirty(q))
+	require.NoError(t, q.Close())
+
+	// synced every interval and on the roll of the segment
+	q, err = OpenSubscriberQueue(t.TempDir(), 100, 0, time.Hour, &statistics.SubscriberStatItem{})
+	require.NoError(t, err)
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=0")))
+	assert.True(t, dirty(q))
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=1")))
+	assert.Equal(t, 2, len(q.segments))
+	require.NoError(t, q.Close())
+
+	q, err = OpenSubscriberQueue(t.TempDir(), 1024*1024, 0, 10*time.Millisecond, &statistics.SubscriberStatItem{})
+	require.NoError(t, err)
+	defer q.Close()
+	require.NoError(t, q.Append("db0", "rp0", []byte("cpu v=0")))
+	require.Eventually(t, func() bool { return !dirty(q) }, time.Second, 5*time.Millisecond)
+}
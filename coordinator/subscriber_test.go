@@ -21,8 +21,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -30,6 +34,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	assert2 "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -377,6 +382,193 @@ func TestSendWriteRequest(t *testing.T) {
 	s.StopAllWriters()
 }
 
+func TestSendWriteRequest_Durable(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	available := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/write", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		// the destination is down until all the lines are queued
+		if !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := &MockSubscriberMetaClient{databases: make(map[string]*meta.DatabaseInfo)}
+	client.CreateSubscription("db0", "rp0", "sub0", "ALL", []string{server.URL})
+
+	conf := config.NewSubscriber()
+	conf.QueueDir = t.TempDir()
+	conf.WriteConcurrency = 1
+	conf.RetryInterval = toml.Duration(10 * time.Millisecond)
+	conf.RetryMaxInterval = toml.Duration(20 * time.Millisecond)
+	s := NewSubscriberManager(conf, client, logger.NewLogger(errno.ModuleCoordinator))
+	s.InitWriters()
+	for i := 0; i < 5; i++ {
+		s.Send("db0", "rp0", []byte(fmt.Sprintf("cpu v=%d", i)))
+	}
+
+	stat := statistics.NewSubscriberStatistics().Item("db0", "rp0", "sub0", server.URL)
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&stat.Retries) > 1 }, 5*time.Second, 10*time.Millisecond)
+	// the lines queued are replayed after a restart
+	s.StopAllWriters()
+	mu.Lock()
+	available = true
+	mu.Unlock()
+	s = NewSubscriberManager(conf, client, logger.NewLogger(errno.ModuleCoordinator))
+	s.InitWriters()
+
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&stat.PendingReq) == 0 }, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	require.Equal(t, 5, len(received))
+	for i, line := range received {
+		assert2.Equal(t, fmt.Sprintf("cpu v=%d", i), line)
+	}
+	mu.Unlock()
+	assert2.Equal(t, int64(5), atomic.LoadInt64(&stat.DeliveredReq))
+
+	// the queues of the subscription dropped are removed
+	require.NoError(t, client.DropSubscription("db0", "rp0", "sub0"))
+	s.UpdateWriters()
+	_, err := os.Stat(s.subscriptionDir("db0", "rp0", "sub0"))
+	assert2.True(t, os.IsNotExist(err))
+}
+
+func TestDropSubscription_HostileName(t *testing.T) {
+	dataDir := t.TempDir()
+	conf := config.NewSubscriber()
+	conf.QueueDir = filepath.Join(dataDir, "a", "b", "queue")
+	sentinel := filepath.Join(dataDir, "data")
+	require.NoError(t, os.MkdirAll(sentinel, 0750))
+
+	client := &MockSubscriberMetaClient{databases: make(map[string]*meta.DatabaseInfo)}
+	names := []string{"..", "../../../../..", `..\..`, "."}
+	for _, name := range names {
+		client.CreateSubscription("db0", "rp0", name, "ALL", []string{"http://127.0.0.1:8086"})
+	}
+	s := NewSubscriberManager(conf, client, logger.NewLogger(errno.ModuleCoordinator))
+	s.InitWriters()
+	for _, name := range names {
+		dir := s.subscriptionDir("db0", "rp0", name)
+		assert2.True(t, underDir(conf.QueueDir, dir), dir)
+		require.NoError(t, client.DropSubscription("db0", "rp0", name))
+	}
+	s.UpdateWriters()
+	s.StopAllWriters()
+
+	_, err := os.Stat(sentinel)
+	assert2.NoError(t, err)
+	_, err = os.Stat(filepath.Join(conf.QueueDir, "db0", "rp0"))
+	assert2.NoError(t, err)
+
+	assert2.False(t, underDir(conf.QueueDir, conf.QueueDir))
+	assert2.False(t, underDir(conf.QueueDir, filepath.Join(conf.QueueDir, "..")))
+	assert2.False(t, underDir(conf.QueueDir, filepath.Join(conf.QueueDir, "..", "queue2")))
+	assert2.True(t, underDir(conf.QueueDir, filepath.Join(conf.QueueDir, "..db", "rp0")))
+}
+
+func TestRetryableSendError(t *testing.T) {
+	assert2.True(t, retryableSendError(errors.New("connection refused")))
+	assert2.True(t, retryableSendError(&SendError{StatusCode: http.StatusServiceUnavailable}))
+	assert2.True(t, retryableSendError(&SendError{StatusCode: http.StatusTooManyRequests}))
+	assert2.False(t, retryableSendError(&SendError{StatusCode: http.StatusBadRequest}))
+	assert2.False(t, retryableSendError(fmt.Errorf("wrapped: %w", &SendError{StatusCode: http.StatusForbidden})))
+}
+
+func TestSendWriteRequest_DurablePermanentError(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/write", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := &MockSubscriberMetaClient{databases: make(map[string]*meta.DatabaseInfo)}
+	client.CreateSubscription("db0", "rp0", "sub1", "ALL", []string{server.URL})
+
+	conf := config.NewSubscriber()
+	conf.QueueDir = t.TempDir()
+	conf.WriteConcurrency = 1
+	s := NewSubscriberManager(conf, client, logger.NewLogger(errno.ModuleCoordinator))
+	s.InitWriters()
+	defer s.StopAllWriters()
+
+	// the line rejected by the destination does not block the ones after it
+	for _, line := range []string{"cpu v=0", "bad", "cpu v=1"} {
+		s.Send("db0", "rp0", []byte(line))
+	}
+	stat := statistics.NewSubscriberStatistics().Item("db0", "rp0", "sub1", server.URL)
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&stat.PendingReq) == 0 }, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	assert2.Equal(t, []string{"cpu v=0", "cpu v=1"}, received)
+	mu.Unlock()
+	assert2.Equal(t, int64(1), atomic.LoadInt64(&stat.DroppedReq))
+	assert2.Equal(t, int64(0), atomic.LoadInt64(&stat.Retries))
+}
+
+func TestSendWriteRequest_DurableHandOff(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/write", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	healthy := httptest.NewServer(mux)
+	defer healthy.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	client := &MockSubscriberMetaClient{databases: make(map[string]*meta.DatabaseInfo)}
+	client.CreateSubscription("db0", "rp0", "sub2", "ANY", []string{down.URL, healthy.URL})
+
+	conf := config.NewSubscriber()
+	conf.QueueDir = t.TempDir()
+	conf.WriteConcurrency = 1
+	conf.RetryInterval = toml.Duration(time.Hour)
+	conf.RetryMaxInterval = toml.Duration(time.Hour)
+	s := NewSubscriberManager(conf, client, logger.NewLogger(errno.ModuleCoordinator))
+	s.InitWriters()
+	defer s.StopAllWriters()
+
+	// the lines of the destination down are delivered by the healthy one
+	for i := 0; i < 10; i++ {
+		s.Send("db0", "rp0", []byte(fmt.Sprintf("cpu v=%d", i)))
+	}
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 10
+	}, 5*time.Second, 10*time.Millisecond)
+	downStat := statistics.NewSubscriberStatistics().Item("db0", "rp0", "sub2", down.URL)
+	assert2.Equal(t, int64(1), atomic.LoadInt64(&downStat.Retries))
+	assert2.Equal(t, int64(5), atomic.LoadInt64(&downStat.HandedOffReq))
+	assert2.Equal(t, int64(0), atomic.LoadInt64(&downStat.PendingReq))
+}
+
 func TestNewHTTPSClient(t *testing.T) {
 	dir := t.TempDir()
 	err := execCommand([]string{
@@ -484,6 +484,10 @@ func (wh *recordWriterHelper) checkAndUpdateRecordSchema(db, rp, mst, originName
 		return
 	}
 	timeCol := &rec.ColVals[colNum]
+	if len(timeCol.IntegerValues()) == 0 {
+		err = errno.NewError(errno.ArrowRecordTimeFieldErr)
+		return
+	}
 
 	// check the field name and type
 	samePreSchema := wh.sameSchema && wh.preSchema != nil && len(*wh.preSchema) == int(rec.ColNums())
@@ -493,19 +497,21 @@ func (wh *recordWriterHelper) checkAndUpdateRecordSchema(db, rp, mst, originName
 	}
 	wh.preMst.SchemaLock.RLock()
 	for i := 0; i < colNum; i++ {
-		_, ok := wh.preMst.Schema.GetTyp(rec.Schema.Field(i).Name)
+		colType, ok := wh.preMst.Schema.GetTyp(rec.Schema.Field(i).Name)
+		fieldType := rec.Schema.Field(i).Type
 		if !ok {
-			wh.fieldToCreatePool = appendField(wh.fieldToCreatePool, rec.Schema.Field(i).Name, int32(rec.Schema.Field(i).Type))
+			wh.fieldToCreatePool = appendField(wh.fieldToCreatePool, rec.Schema.Field(i).Name, int32(fieldType))
+		} else if (colType == influx.Field_Type_Tag && fieldType != influx.Field_Type_String && fieldType != influx.Field_Type_Tag) ||
+			(colType != influx.Field_Type_Tag && fieldType != int(colType)) {
+			err = errno.NewError(errno.ColumnStoreFieldTypeErr, mst, rec.Schema.Field(i).Name, fieldType, colType)
+			wh.preMst.SchemaLock.RUnlock()
+			return
 		}
 		if !samePreSchema {
 			*wh.preSchema = append(*wh.preSchema, *rec.Schema.Field(i))
 		}
 	}
 	wh.preMst.SchemaLock.RUnlock()
-	if len(timeCol.IntegerValues()) == 0 {
-		err = errno.NewError(errno.ArrowRecordTimeFieldErr)
-		return
-	}
 	startTime, endTime = timeCol.IntegerValues()[0], timeCol.IntegerValues()[timeCol.Len-1]
 	if !samePreSchema {
 		*wh.preSchema = append(*wh.preSchema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
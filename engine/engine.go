@@ -36,6 +36,7 @@ import (
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
@@ -1396,8 +1397,53 @@ func (e *Engine) RowCount(db string, ptId uint32, shardIDs []uint64, schema *exe
 	return rowCount, nil
 }
 
+// CollectStatistics collects the statistics of a measurement in each shard, the result is keyed by the shard id.
+func (e *Engine) CollectStatistics(db string, ptId uint32, shardIDs []uint64, mst string, tagKeys []string) (map[uint64]*optimizer.Statistics, error) {
+	stats := make(map[uint64]*optimizer.Statistics, len(shardIDs))
+	for _, shardId := range shardIDs {
+		s, err := e.GetShard(db, ptId, shardId)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			e.log.Warn(fmt.Sprintf("CollectStatistics shard is null. db: %s, ptId: %d, shardId: %d", db, ptId, shardId))
+			continue
+		}
+		shardStats, err := s.CollectStatistics(mst, tagKeys)
+		if err != nil {
+			return nil, err
+		}
+		stats[shardId] = shardStats
+	}
+	return stats, nil
+}
+
 func (e *Engine) LogicalPlanCost(db string, ptId uint32, sources influxql.Sources, opt query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
-	panic("implement me")
+	pt := e.getDBPTInfo(db, ptId)
+	if pt == nil {
+		return hybridqp.LogicalPlanCost{}, nil
+	}
+
+	tr := &influxql.TimeRange{Min: time.Unix(0, opt.StartTime), Max: time.Unix(0, opt.EndTime)}
+	shardIDs := pt.ShardIds(tr)
+	total := optimizer.NewStatistics()
+	for _, src := range sources {
+		mst, ok := src.(*influxql.Measurement)
+		if !ok {
+			continue
+		}
+		stats, err := e.CollectStatistics(db, ptId, shardIDs, mst.Name, nil)
+		if err != nil {
+			return hybridqp.LogicalPlanCost{}, err
+		}
+		for _, shardStats := range stats {
+			total.Merge(shardStats)
+		}
+	}
+
+	cost := total.PlanCost()
+	cost.NumShards = int64(len(shardIDs))
+	return cost, nil
 }
 
 func (e *Engine) checkAndAddRefPTSNoLock(database string, ptIDs []uint32) ([]uint32, error) {
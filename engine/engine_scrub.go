@@ -0,0 +1,90 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"path/filepath"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/util"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// ScrubShards verifies the TSSP files of the opened shards. If quarantineDir is not empty, the corrupted files are
+// moved into quarantineDir with the same relative path as in the data directory.
+func (e *Engine) ScrubShards(quarantineDir string) *immutable.VerifyReport {
+	report := &immutable.VerifyReport{}
+	for _, ident := range e.openedShards() {
+		if e.closed.Closed() {
+			break
+		}
+		if shard := e.scrubShard(ident, quarantineDir); shard != nil {
+			report.Add(shard)
+		}
+	}
+	return report
+}
+
+func (e *Engine) openedShards() []*meta2.ShardIdentifier {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var idents []*meta2.ShardIdentifier
+	for db := range e.DBPartitions {
+		for pt := range e.DBPartitions[db] {
+			e.DBPartitions[db][pt].mu.RLock()
+			for _, sh := range e.DBPartitions[db][pt].shards {
+				// the files of the cold shards are in the object storage
+				if sh.IsOpened() && sh.GetTier() != util.Cold {
+					idents = append(idents, sh.GetIdent())
+				}
+			}
+			e.DBPartitions[db][pt].mu.RUnlock()
+		}
+	}
+	return idents
+}
+
+func (e *Engine) scrubShard(ident *meta2.ShardIdentifier, quarantineDir string) *immutable.ShardVerifyReport {
+	e.mu.RLock()
+	if err := e.checkAndAddRefPTNoLock(ident.OwnerDb, ident.OwnerPt); err != nil {
+		e.mu.RUnlock()
+		return nil
+	}
+	dbPtInfo := e.DBPartitions[ident.OwnerDb][ident.OwnerPt]
+	e.mu.RUnlock()
+	defer e.unrefDBPT(ident.OwnerDb, ident.OwnerPt)
+
+	sh := dbPtInfo.Shard(ident.ShardID)
+	if sh == nil || !sh.IsOpened() {
+		return nil
+	}
+	mmsTables, ok := sh.GetTableStore().(*immutable.MmsTables)
+	if !ok {
+		return nil
+	}
+
+	dir := immutable.GetDir(sh.GetEngineType(), sh.GetDataPath())
+	if quarantineDir != "" {
+		rel, err := filepath.Rel(e.dataPath, dir)
+		if err != nil {
+			e.log.Error("failed to get the quarantine dir", zap.String("path", dir), zap.Error(err))
+			return nil
+		}
+		quarantineDir = filepath.Join(quarantineDir, rel)
+	}
+	return mmsTables.Scrub(quarantineDir)
+}
@@ -190,6 +190,25 @@ var promSubqueryFunc map[string]CallFn = make(map[string]CallFn)
 
 func init() {
 	promSubqueryFunc["rate_prom"] = rate
+	promSubqueryFunc["increase"] = increase
+	promSubqueryFunc["delta_prom"] = delta
+	promSubqueryFunc["irate_prom"] = irate
+	promSubqueryFunc["idelta_prom"] = idelta
+	promSubqueryFunc["sum_over_time"] = sumOverTime
+	promSubqueryFunc["avg_over_time"] = avgOverTime
+	promSubqueryFunc["max_over_time"] = maxOverTime
+	promSubqueryFunc["min_over_time"] = minOverTime
+	promSubqueryFunc["count_over_time"] = countOverTime
+	promSubqueryFunc["last_over_time_prom"] = lastOverTime
+	promSubqueryFunc["present_over_time_prom"] = presentOverTime
+	promSubqueryFunc["stddev_over_time_prom"] = stddevOverTime
+	promSubqueryFunc["stdvar_over_time_prom"] = stdvarOverTime
+	promSubqueryFunc["quantile_over_time_prom"] = quantileOverTime
+	promSubqueryFunc["deriv"] = deriv
+	promSubqueryFunc["predict_linear"] = predictLinear
+	promSubqueryFunc["holt_winters_prom"] = holtWintersProm
+	promSubqueryFunc["changes_prom"] = changes
+	promSubqueryFunc["resets_prom"] = resets
 }
 
 func CalcReduceResult(prevT, currT []int64, prevV, currV []float64, isCounter bool) (int64, int64, float64, float64, float64) {
@@ -229,11 +248,23 @@ func CalcReduceResult(prevT, currT []int64, prevV, currV []float64, isCounter bo
 }
 
 func rate(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	return extrapolatedRate(preTimes, currTimes, preValues, currValues, ts, call, true, true)
+}
+
+func increase(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	return extrapolatedRate(preTimes, currTimes, preValues, currValues, ts, call, true, false)
+}
+
+func delta(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	return extrapolatedRate(preTimes, currTimes, preValues, currValues, ts, call, false, false)
+}
+
+func extrapolatedRate(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall, isCounter, isRate bool) (float64, bool) {
 	pointCount := len(preTimes) + len(currTimes)
 	if pointCount <= 1 {
 		return 0, false
 	}
-	firstTime, lastTime, firstValue, _, reduceResult := CalcReduceResult(preTimes, currTimes, preValues, currValues, true)
+	firstTime, lastTime, firstValue, _, reduceResult := CalcReduceResult(preTimes, currTimes, preValues, currValues, isCounter)
 	if lastTime == firstTime || call.Range.Nanoseconds() == 0 {
 		return 0, false
 	}
@@ -246,7 +277,7 @@ func rate(preTimes, currTimes []int64, preValues, currValues []float64, ts int64
 	sampledInterval := float64(lastTime-firstTime) / 1e9
 	averageDurationBetweenSamples := sampledInterval / float64(pointCount-1)
 
-	if reduceResult > 0 && pointCount > 0 && firstValue >= 0 {
+	if isCounter && reduceResult > 0 && firstValue >= 0 {
 		durationToZero := sampledInterval * (firstValue / reduceResult)
 		if durationToZero < durationToStart {
 			durationToStart = durationToZero
@@ -271,11 +302,378 @@ func rate(preTimes, currTimes []int64, preValues, currValues []float64, ts int64
 		extrapolateToInterval += averageDurationBetweenSamples / 2
 	}
 	factor := extrapolateToInterval / sampledInterval
-	factor /= call.Range.Seconds()
+	if isRate {
+		factor /= call.Range.Seconds()
+	}
 	reduceResult *= factor
 	return reduceResult, true
 }
 
+// lastTwoPoints returns the last two points of preBuf + currBuf.
+func lastTwoPoints(preTimes, currTimes []int64, preValues, currValues []float64) (int64, int64, float64, float64) {
+	switch len(currTimes) {
+	case 0:
+		n := len(preTimes)
+		return preTimes[n-2], preTimes[n-1], preValues[n-2], preValues[n-1]
+	case 1:
+		n := len(preTimes)
+		return preTimes[n-1], currTimes[0], preValues[n-1], currValues[0]
+	default:
+		n := len(currTimes)
+		return currTimes[n-2], currTimes[n-1], currValues[n-2], currValues[n-1]
+	}
+}
+
+func irate(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	return instantValue(preTimes, currTimes, preValues, currValues, true)
+}
+
+func idelta(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	return instantValue(preTimes, currTimes, preValues, currValues, false)
+}
+
+func instantValue(preTimes, currTimes []int64, preValues, currValues []float64, isRate bool) (float64, bool) {
+	if len(preTimes)+len(currTimes) < 2 {
+		return 0, false
+	}
+	prevTime, lastTime, prevValue, lastValue := lastTwoPoints(preTimes, currTimes, preValues, currValues)
+
+	var resultValue float64
+	if isRate && lastValue < prevValue {
+		// Counter reset.
+		resultValue = lastValue
+	} else {
+		resultValue = lastValue - prevValue
+	}
+
+	sampledInterval := lastTime - prevTime
+	if sampledInterval == 0 {
+		// Avoid dividing by 0.
+		return 0, false
+	}
+	if isRate {
+		// Convert to per-second.
+		resultValue /= float64(sampledInterval) / 1e9
+	}
+	return resultValue, true
+}
+
+func sumOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range preValues {
+		sum += v
+	}
+	for _, v := range currValues {
+		sum += v
+	}
+	return sum, true
+}
+
+func avgOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	var mean, count float64
+	reduce := func(values []float64) {
+		for _, v := range values {
+			count++
+			if math.IsInf(mean, 0) {
+				if math.IsInf(v, 0) && (mean > 0) == (v > 0) {
+					// The `mean` and `v` values are `Inf` of the same sign. They
+					// can't be subtracted, but the value of `mean` is correct already.
+					continue
+				}
+				if !math.IsInf(v, 0) && !math.IsNaN(v) {
+					// The mean is an infinite, adding a finite value keeps it.
+					continue
+				}
+			}
+			mean += v/count - mean/count
+		}
+	}
+	reduce(preValues)
+	reduce(currValues)
+	return mean, true
+}
+
+func maxOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	maxValue := math.NaN()
+	for _, values := range [2][]float64{preValues, currValues} {
+		for _, v := range values {
+			if v > maxValue || math.IsNaN(maxValue) {
+				maxValue = v
+			}
+		}
+	}
+	return maxValue, true
+}
+
+func minOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	minValue := math.NaN()
+	for _, values := range [2][]float64{preValues, currValues} {
+		for _, v := range values {
+			if v < minValue || math.IsNaN(minValue) {
+				minValue = v
+			}
+		}
+	}
+	return minValue, true
+}
+
+func countOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	count := len(preValues) + len(currValues)
+	return float64(count), count > 0
+}
+
+func lastOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(currValues) > 0 {
+		return currValues[len(currValues)-1], true
+	}
+	if len(preValues) > 0 {
+		return preValues[len(preValues)-1], true
+	}
+	return 0, false
+}
+
+func presentOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	return 1, len(preValues)+len(currValues) > 0
+}
+
+func stdvarOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	var aux, count, mean float64
+	for _, values := range [2][]float64{preValues, currValues} {
+		for _, v := range values {
+			count++
+			delta := v - mean
+			mean += delta / count
+			aux += delta * (v - mean)
+		}
+	}
+	return aux / count, true
+}
+
+func stddevOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	stdVar, ok := stdvarOverTime(preTimes, currTimes, preValues, currValues, ts, call)
+	return math.Sqrt(stdVar), ok
+}
+
+func quantileOverTime(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	n := len(preValues) + len(currValues)
+	if n == 0 {
+		return 0, false
+	}
+	percentile, ok := promSubCallArg(call, 0)
+	if !ok {
+		return 0, false
+	}
+	if math.IsNaN(percentile) {
+		return math.NaN(), true
+	}
+	if percentile < 0 {
+		return math.Inf(-1), true
+	}
+	if percentile > 1 {
+		return math.Inf(+1), true
+	}
+	// copy the values, the buffers are shared with the following windows.
+	values := make([]float64, 0, n)
+	values = append(values, preValues...)
+	values = append(values, currValues...)
+	sort.Float64s(values)
+
+	rank := percentile * float64(n-1)
+	lowerIndex := math.Max(0, math.Floor(rank))
+	upperIndex := math.Min(float64(n-1), lowerIndex+1)
+	weight := rank - math.Floor(rank)
+	return values[int(lowerIndex)]*(1-weight) + values[int(upperIndex)]*weight, true
+}
+
+func deriv(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preTimes)+len(currTimes) < 2 {
+		return 0, false
+	}
+	// We pass in an arbitrary timestamp that is near the values in use
+	// to avoid floating point accuracy issues.
+	var interceptTime int64
+	if len(preTimes) > 0 {
+		interceptTime = preTimes[0]
+	} else {
+		interceptTime = currTimes[0]
+	}
+	slope, _ := linearRegression(preTimes, currTimes, preValues, currValues, interceptTime)
+	return slope, true
+}
+
+func predictLinear(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preTimes)+len(currTimes) < 2 {
+		return 0, false
+	}
+	duration, ok := promSubCallArg(call, 0)
+	if !ok {
+		return 0, false
+	}
+	// ts is the end of the range, the prediction is made w.r.t. the evaluation time.
+	slope, intercept := linearRegression(preTimes, currTimes, preValues, currValues, ts+call.Offset.Nanoseconds())
+	return slope*duration + intercept, true
+}
+
+// linearRegression performs a least-square linear regression analysis on the
+// points of preBuf + currBuf. It returns the slope, and the intercept value at
+// the provided time.
+func linearRegression(preTimes, currTimes []int64, preValues, currValues []float64, interceptTime int64) (float64, float64) {
+	var (
+		n                   float64
+		sumX, sumY          float64
+		sumXY, sumX2        float64
+		firstValue          = math.NaN()
+		constY, initialized = true, false
+	)
+	reduce := func(times []int64, values []float64) {
+		for i, v := range values {
+			if !initialized {
+				firstValue, initialized = v, true
+			} else if constY && v != firstValue {
+				constY = false
+			}
+			n += 1.0
+			x := float64(times[i]-interceptTime) / 1e9
+			sumX += x
+			sumY += v
+			sumXY += x * v
+			sumX2 += x * x
+		}
+	}
+	reduce(preTimes, preValues)
+	reduce(currTimes, currValues)
+	if constY {
+		if math.IsInf(firstValue, 0) {
+			return math.NaN(), math.NaN()
+		}
+		return 0, firstValue
+	}
+	covXY := sumXY - sumX*sumY/n
+	varX := sumX2 - sumX*sumX/n
+
+	slope := covXY / varX
+	intercept := sumY/n - slope*sumX/n
+	return slope, intercept
+}
+
+func holtWintersProm(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	l := len(preValues) + len(currValues)
+	// Can't do the smoothing operation with less than two points.
+	if l < 2 {
+		return 0, false
+	}
+	// The smoothing factor argument.
+	sf, ok := promSubCallArg(call, 0)
+	if !ok || sf <= 0 || sf >= 1 {
+		return 0, false
+	}
+	// The trend factor argument.
+	tf, ok := promSubCallArg(call, 1)
+	if !ok || tf <= 0 || tf >= 1 {
+		return 0, false
+	}
+	value := func(i int) float64 {
+		if i < len(preValues) {
+			return preValues[i]
+		}
+		return currValues[i-len(preValues)]
+	}
+
+	var s0, s1, b float64
+	// Set initial values.
+	s1 = value(0)
+	b = value(1) - value(0)
+	// Run the smoothing operation.
+	var x, y float64
+	for i := 1; i < l; i++ {
+		// Scale the raw value against the smoothing factor.
+		x = sf * value(i)
+		// Scale the last smoothed value with the trend at this point.
+		b = calcTrendValue(i-1, tf, s0, s1, b)
+		y = (1 - sf) * (s1 + b)
+		s0, s1 = s1, x+y
+	}
+	return s1, true
+}
+
+// calcTrendValue calculates the trend value at the given index i in raw data d.
+// The argument "tf" is the trend factor, "s0" is the computed smoothed value,
+// "s1" is the computed trend factor and "b" is the raw input value.
+func calcTrendValue(i int, tf, s0, s1, b float64) float64 {
+	if i == 0 {
+		return b
+	}
+	x := tf * (s1 - s0)
+	y := (1 - tf) * b
+	return x + y
+}
+
+func changes(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	var count int
+	var prev float64
+	var initialized bool
+	for _, values := range [2][]float64{preValues, currValues} {
+		for _, current := range values {
+			if initialized && current != prev && !(math.IsNaN(current) && math.IsNaN(prev)) {
+				count++
+			}
+			prev, initialized = current, true
+		}
+	}
+	return float64(count), true
+}
+
+func resets(preTimes, currTimes []int64, preValues, currValues []float64, ts int64, call *influxql.PromSubCall) (float64, bool) {
+	if len(preValues)+len(currValues) == 0 {
+		return 0, false
+	}
+	var count int
+	var prev float64
+	var initialized bool
+	for _, values := range [2][]float64{preValues, currValues} {
+		for _, current := range values {
+			if initialized && current < prev {
+				count++
+			}
+			prev, initialized = current, true
+		}
+	}
+	return float64(count), true
+}
+
+// promSubCallArg returns the i-th scalar argument of the prom subquery function.
+func promSubCallArg(call *influxql.PromSubCall, i int) (float64, bool) {
+	if i >= len(call.InArgs) {
+		return 0, false
+	}
+	switch arg := call.InArgs[i].(type) {
+	case *influxql.NumberLiteral:
+		return arg.Val, true
+	case *influxql.IntegerLiteral:
+		return float64(arg.Val), true
+	default:
+		return 0, false
+	}
+}
+
 func GroupReduce(c Chunk, values []float64, ordinal, start, end int) (int, float64, bool) {
 	column := c.Column(ordinal)
 	if column.NilCount() == 0 {
@@ -16,14 +16,18 @@ package executor
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/array"
 	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/uuid"
 	"github.com/openGemini/openGemini/engine/op"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/openGemini/openGemini/services/castor"
 )
@@ -565,3 +569,284 @@ func copyChunkToRecord(b *array.RecordBuilder, c Chunk, seriesStart, seriesEnd i
 	}
 	return nil
 }
+
+// RowsArrowSchema is the arrow schema of the series of a statement result: tags are string columns placed before
+// the fields and the time is stored as int64 nanoseconds in the last column.
+type RowsArrowSchema struct {
+	Schema *arrow.Schema
+
+	tagKeys []string
+	tagIdx  map[string]int
+	infos   []*fieldInfo
+	hasTime bool
+}
+
+// NewRowsArrowSchema builds the arrow schema of the rows of a select statement from the schema reported by the
+// executor, so all the results of the statement share it whatever values their series hold.
+func NewRowsArrowSchema(s *query.RowsSchema) *RowsArrowSchema {
+	infos := make([]*fieldInfo, 0, len(s.Columns))
+	for i, col := range s.Columns {
+		dType := s.Types[i]
+		if dType == influxql.Tag {
+			dType = influxql.String
+		}
+		infos = append(infos, &fieldInfo{name: col, dType: dType, idx: len(s.Dimensions) + i})
+	}
+	return newRowsArrowSchema(s.Dimensions, infos, true)
+}
+
+// InferRowsArrowSchema infers the arrow schema from the values of the series, it serves the results which carry
+// no schema, e.g. the results of the SHOW statements.
+func InferRowsArrowSchema(rows models.Rows) (*RowsArrowSchema, error) {
+	tagKeys, infos, hasTime, err := getRowsFieldInfo(rows)
+	if err != nil {
+		return nil, err
+	}
+	return newRowsArrowSchema(tagKeys, infos, hasTime), nil
+}
+
+func newRowsArrowSchema(tagKeys []string, infos []*fieldInfo, hasTime bool) *RowsArrowSchema {
+	s := &RowsArrowSchema{tagKeys: tagKeys, tagIdx: make(map[string]int, len(tagKeys)), infos: infos, hasTime: hasTime}
+	aFields := make([]arrow.Field, 0, len(tagKeys)+len(infos)+1)
+	for i, key := range tagKeys {
+		s.tagIdx[key] = i
+		aFields = append(aFields, arrow.Field{Name: key, Type: arrow.BinaryTypes.String, Nullable: true})
+	}
+	for _, f := range infos {
+		aFields = append(aFields, arrow.Field{Name: f.name, Type: rowArrowDataType(f.dType), Nullable: true})
+	}
+	if hasTime {
+		aFields = append(aFields, arrow.Field{Name: string(castor.DataTime), Type: arrow.PrimitiveTypes.Int64})
+	}
+	s.Schema = arrow.NewSchema(aFields, nil)
+	return s
+}
+
+// Records converts the series to arrow records of the schema, one record per series. The columns the series
+// lack are null. Records must release record after use
+func (s *RowsArrowSchema) Records(rows models.Rows) ([]arrow.Record, error) {
+	ret := make([]arrow.Record, 0, len(rows))
+	pool := memory.NewGoAllocator()
+	for _, row := range rows {
+		for key := range row.Tags {
+			if _, ok := s.tagIdx[key]; !ok {
+				releaseRecords(ret)
+				return nil, fmt.Errorf("tag %s of series %s is not in the schema", key, row.Name)
+			}
+		}
+		b := array.NewRecordBuilder(pool, s.Schema)
+		err := copyRowToRecord(b, row, s.tagKeys, s.infos, s.hasTime)
+		if err == nil {
+			ret = append(ret, b.NewRecord())
+		}
+		b.Release()
+		if err != nil {
+			releaseRecords(ret)
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func releaseRecords(records []arrow.Record) {
+	for _, rec := range records {
+		rec.Release()
+	}
+}
+
+// RowsToArrowRecords converts the series of a statement result to arrow records, one record per series, the schema
+// is inferred from the values of the series. RowsToArrowRecords must release record after use
+func RowsToArrowRecords(rows models.Rows) (*arrow.Schema, []arrow.Record, error) {
+	s, err := InferRowsArrowSchema(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, err := s.Records(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.Schema, records, nil
+}
+
+// getRowsFieldInfo returns the sorted tag keys and the fields of the series, the index of a field is
+// the index of its column in the arrow record.
+func getRowsFieldInfo(rows models.Rows) ([]string, []*fieldInfo, bool, error) {
+	tagSet := make(map[string]struct{})
+	fieldSet := make(map[string]*fieldInfo)
+	var infos []*fieldInfo
+	hasTime := false
+	for _, row := range rows {
+		for key := range row.Tags {
+			tagSet[key] = struct{}{}
+		}
+		for i, col := range row.Columns {
+			if col == string(castor.DataTime) {
+				hasTime = true
+				continue
+			}
+			f, ok := fieldSet[col]
+			if !ok {
+				f = &fieldInfo{name: col, dType: influxql.Unknown}
+				fieldSet[col] = f
+				infos = append(infos, f)
+			}
+			for _, values := range row.Values {
+				if i >= len(values) || values[i] == nil {
+					continue
+				}
+				dType := rowValueDataType(values[i])
+				if f.dType == influxql.Unknown {
+					f.dType = dType
+				} else if f.dType != dType {
+					return nil, nil, false, fmt.Errorf("field type conflict: %s is %s and %s", col, f.dType, dType)
+				}
+			}
+		}
+	}
+
+	tagKeys := make([]string, 0, len(tagSet))
+	for key := range tagSet {
+		tagKeys = append(tagKeys, key)
+	}
+	sort.Strings(tagKeys)
+	for i, f := range infos {
+		f.idx = len(tagKeys) + i
+	}
+	return tagKeys, infos, hasTime, nil
+}
+
+func rowValueDataType(v interface{}) influxql.DataType {
+	switch v.(type) {
+	case float64:
+		return influxql.Float
+	case int64, int:
+		return influxql.Integer
+	case uint64:
+		return influxql.Unsigned
+	case bool:
+		return influxql.Boolean
+	default:
+		return influxql.String
+	}
+}
+
+func rowArrowDataType(dType influxql.DataType) arrow.DataType {
+	switch dType {
+	case influxql.Integer:
+		return arrow.PrimitiveTypes.Int64
+	case influxql.Unsigned:
+		return arrow.PrimitiveTypes.Uint64
+	case influxql.Boolean:
+		return arrow.FixedWidthTypes.Boolean
+	case influxql.String:
+		return arrow.BinaryTypes.String
+	default:
+		// a column without any value is kept as float
+		return arrow.PrimitiveTypes.Float64
+	}
+}
+
+func copyRowToRecord(b *array.RecordBuilder, row *models.Row, tagKeys []string, infos []*fieldInfo, hasTime bool) error {
+	for i, key := range tagKeys {
+		v, ok := row.Tags[key]
+		if !ok {
+			b.Field(i).AppendNulls(len(row.Values))
+			continue
+		}
+		for range row.Values {
+			b.Field(i).(*array.StringBuilder).Append(v)
+		}
+	}
+
+	colIdx := make(map[string]int, len(row.Columns))
+	for i, col := range row.Columns {
+		colIdx[col] = i
+	}
+	for _, f := range infos {
+		i, ok := colIdx[f.name]
+		for _, values := range row.Values {
+			if !ok || i >= len(values) {
+				b.Field(f.idx).AppendNull()
+				continue
+			}
+			if err := appendArrowRowValue(b.Field(f.idx), f.dType, values[i]); err != nil {
+				return fmt.Errorf("column %s: %s", f.name, err)
+			}
+		}
+	}
+
+	if !hasTime {
+		return nil
+	}
+	tb := b.Field(len(tagKeys) + len(infos)).(*array.Int64Builder)
+	i, ok := colIdx[string(castor.DataTime)]
+	for _, values := range row.Values {
+		if !ok || i >= len(values) {
+			tb.AppendNull()
+			continue
+		}
+		switch t := values[i].(type) {
+		case time.Time:
+			tb.Append(t.UnixNano())
+		case int64:
+			tb.Append(t)
+		default:
+			tb.AppendNull()
+		}
+	}
+	return nil
+}
+
+func appendArrowRowValue(b array.Builder, dType influxql.DataType, v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+	var ok bool
+	switch dType {
+	case influxql.Integer:
+		var n int64
+		switch value := v.(type) {
+		case int64:
+			n, ok = value, true
+		case int:
+			n, ok = int64(value), true
+		}
+		if ok {
+			b.(*array.Int64Builder).Append(n)
+		}
+	case influxql.Unsigned:
+		var n uint64
+		if n, ok = v.(uint64); ok {
+			b.(*array.Uint64Builder).Append(n)
+		}
+	case influxql.Boolean:
+		var bv bool
+		if bv, ok = v.(bool); ok {
+			b.(*array.BooleanBuilder).Append(bv)
+		}
+	case influxql.String:
+		if s, isStr := v.(string); isStr {
+			b.(*array.StringBuilder).Append(s)
+		} else {
+			b.(*array.StringBuilder).Append(fmt.Sprint(v))
+		}
+		ok = true
+	default:
+		// float, and the columns whose type is unknown
+		var f float64
+		switch value := v.(type) {
+		case float64:
+			f, ok = value, true
+		case int64:
+			f, ok = float64(value), true
+		}
+		if ok {
+			b.(*array.Float64Builder).Append(f)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("value %v is not %s", v, dType)
+	}
+	return nil
+}
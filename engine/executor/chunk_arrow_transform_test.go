@@ -19,14 +19,19 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/services/castor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type timeValTuple struct {
@@ -529,3 +534,72 @@ func buildChunkSchema(schema *arrow.Schema) (*hybridqp.RowDataTypeImpl, *errno.E
 	row := hybridqp.NewRowDataTypeImpl(varRefs...)
 	return row, nil
 }
+
+func Test_RowsToArrowRecords(t *testing.T) {
+	rows := models.Rows{
+		{Name: "cpu", Tags: map[string]string{"host": "a", "region": "r1"}, Columns: []string{"time", "count", "name", "ok"},
+			Values: [][]interface{}{{time.Unix(0, 1), int64(1), "x", true}, {time.Unix(0, 2), nil, nil, false}}},
+		{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: []string{"time", "count", "name", "ok"},
+			Values: [][]interface{}{{time.Unix(0, 3), int64(3), "z", nil}}},
+	}
+	schema, recs, err := executor.RowsToArrowRecords(rows)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(recs))
+	defer func() {
+		for _, r := range recs {
+			r.Release()
+		}
+	}()
+
+	var names []string
+	for _, f := range schema.Fields() {
+		names = append(names, f.Name+":"+f.Type.String())
+	}
+	assert.Equal(t, []string{"host:utf8", "region:utf8", "count:int64", "name:utf8", "ok:bool", "time:int64"}, names)
+	assert.Equal(t, int64(2), recs[0].NumRows())
+	assert.Equal(t, "r1", recs[0].Column(1).(*array.String).Value(1))
+	assert.True(t, recs[0].Column(2).IsNull(1))
+	assert.Equal(t, int64(2), recs[0].Column(5).(*array.Int64).Value(1))
+	assert.True(t, recs[1].Column(1).IsNull(0))
+	assert.Equal(t, int64(3), recs[1].Column(2).(*array.Int64).Value(0))
+	assert.True(t, recs[1].Column(4).IsNull(0))
+
+	// the rows of SHOW statements have no time
+	schema, recs, err = executor.RowsToArrowRecords(models.Rows{{Name: "databases", Columns: []string{"name"}, Values: [][]interface{}{{"db0"}}}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(schema.Fields()))
+	recs[0].Release()
+
+	_, _, err = executor.RowsToArrowRecords(models.Rows{{Name: "cpu", Columns: []string{"time", "v"},
+		Values: [][]interface{}{{time.Unix(0, 1), 1.5}, {time.Unix(0, 2), "x"}}}})
+	assert.Error(t, err)
+}
+
+func Test_RowsArrowSchema(t *testing.T) {
+	s := executor.NewRowsArrowSchema(&query.RowsSchema{
+		Dimensions: []string{"host"},
+		Columns:    []string{"value", "name"},
+		Types:      []influxql.DataType{influxql.Integer, influxql.Tag},
+	})
+	var names []string
+	for _, f := range s.Schema.Fields() {
+		names = append(names, f.Name+":"+f.Type.String())
+	}
+	assert.Equal(t, []string{"host:utf8", "value:int64", "name:utf8", "time:int64"}, names)
+
+	// the columns without any value keep the types of the schema
+	recs, err := s.Records(models.Rows{{Name: "cpu", Columns: []string{"time", "value", "name"},
+		Values: [][]interface{}{{time.Unix(0, 1), nil, nil}}}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(recs))
+	assert.True(t, recs[0].Column(0).IsNull(0))
+	assert.True(t, recs[0].Column(1).IsNull(0))
+	recs[0].Release()
+
+	_, err = s.Records(models.Rows{{Name: "cpu", Tags: map[string]string{"region": "r1"}, Columns: []string{"time", "value"},
+		Values: [][]interface{}{{time.Unix(0, 1), int64(1)}}}})
+	assert.EqualError(t, err, "tag region of series cpu is not in the schema")
+	_, err = s.Records(models.Rows{{Name: "cpu", Columns: []string{"time", "value"},
+		Values: [][]interface{}{{time.Unix(0, 1), "x"}}}})
+	assert.EqualError(t, err, "column value: value x is not integer")
+}
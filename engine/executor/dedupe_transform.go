@@ -0,0 +1,204 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// DedupeTransform removes the duplicate rows of the UNION. The input is sorted by the
+// series and the time, so the duplicate rows are adjacent to each other, only the rows
+// of the same series and the same time are compared.
+type DedupeTransform struct {
+	BaseProcessor
+
+	Input  *ChunkPort
+	Output *ChunkPort
+
+	opt     *query.ProcessorOptions
+	builder *ChunkBuilder
+
+	started bool
+	tags    string
+	time    int64
+	seen    map[string]struct{}
+	key     []byte
+
+	dedupeCost *tracing.Span
+}
+
+func NewDedupeTransform(inRowDataType, outRowDataType hybridqp.RowDataType, opt *query.ProcessorOptions) *DedupeTransform {
+	return &DedupeTransform{
+		Input:   NewChunkPort(inRowDataType),
+		Output:  NewChunkPort(outRowDataType),
+		opt:     opt,
+		builder: NewChunkBuilder(outRowDataType),
+		seen:    make(map[string]struct{}),
+	}
+}
+
+type DedupeTransformCreator struct {
+}
+
+func (c *DedupeTransformCreator) Create(plan LogicalPlan, opt *query.ProcessorOptions) (Processor, error) {
+	p := NewDedupeTransform(plan.Children()[0].RowDataType(), plan.RowDataType(), opt)
+	return p, nil
+}
+
+var _ = RegistryTransformCreator(&LogicalDedupe{}, &DedupeTransformCreator{})
+
+func (trans *DedupeTransform) Work(ctx context.Context) error {
+	span := trans.StartSpan("[Dedupe]TotalWorkCost", false)
+	trans.dedupeCost = tracing.Start(span, "dedupe_cost", false)
+	defer func() {
+		tracing.Finish(span, trans.dedupeCost)
+	}()
+	for {
+		select {
+		case c, ok := <-trans.Input.State:
+			tracing.StartPP(span)
+			if !ok {
+				trans.Close()
+				return nil
+			}
+			if out := trans.dedupe(c); out.NumberOfRows() > 0 {
+				trans.Output.State <- out
+			}
+			tracing.EndPP(span)
+		case <-ctx.Done():
+			trans.Close()
+			return nil
+		}
+	}
+}
+
+func (trans *DedupeTransform) dedupe(c Chunk) Chunk {
+	out := trans.builder.NewChunk(c.Name())
+	tags, tagIndex := c.Tags(), c.TagIndex()
+	if len(tagIndex) == 0 {
+		tags, tagIndex = []ChunkTags{{}}, []int{0}
+	}
+	for i := range tagIndex {
+		start, end := tagIndex[i], c.NumberOfRows()
+		if i+1 < len(tagIndex) {
+			end = tagIndex[i+1]
+		}
+		key := string(tags[i].GetTag())
+		appended := false
+		for j := start; j < end; j++ {
+			if !trans.started || key != trans.tags || c.TimeByIndex(j) != trans.time {
+				trans.started, trans.tags, trans.time = true, key, c.TimeByIndex(j)
+				trans.seen = make(map[string]struct{})
+			}
+			trans.key = trans.rowKey(trans.key[:0], c, j)
+			if _, ok := trans.seen[string(trans.key)]; ok {
+				continue
+			}
+			trans.seen[string(trans.key)] = struct{}{}
+			if !appended {
+				out.AppendTagsAndIndex(tags[i], out.NumberOfRows())
+				out.AppendIntervalIndex(out.NumberOfRows())
+				appended = true
+			}
+			trans.appendRow(out, c, j)
+		}
+	}
+	return out
+}
+
+// rowKey encodes the values of the row, a nil value is encoded differently from any value.
+func (trans *DedupeTransform) rowKey(dst []byte, c Chunk, row int) []byte {
+	for _, column := range c.Columns() {
+		if column.IsNilV2(row) {
+			dst = append(dst, 0)
+			continue
+		}
+		v := strconv.Quote(stringOfValue(getRowValue(column, column.GetValueIndexV2(row))))
+		dst = append(dst, 1)
+		dst = append(dst, v...)
+	}
+	return dst
+}
+
+func (trans *DedupeTransform) appendRow(out, c Chunk, row int) {
+	out.AppendTime(c.TimeByIndex(row))
+	for i, column := range c.Columns() {
+		dst := out.Column(i)
+		if column.IsNilV2(row) {
+			dst.AppendNil()
+			continue
+		}
+		AppendRowValue(dst, getRowValue(column, column.GetValueIndexV2(row)))
+		dst.AppendNotNil()
+	}
+}
+
+func stringOfValue(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+func (trans *DedupeTransform) Name() string {
+	return "DedupeTransform"
+}
+
+func (trans *DedupeTransform) Explain() []ValuePair {
+	return nil
+}
+
+func (trans *DedupeTransform) Close() {
+	trans.Output.Close()
+}
+
+func (trans *DedupeTransform) GetOutputs() Ports {
+	ports := make(Ports, 0, 1)
+	ports = append(ports, trans.Output)
+	return ports
+}
+
+func (trans *DedupeTransform) GetInputs() Ports {
+	ports := make(Ports, 0, 1)
+	ports = append(ports, trans.Input)
+	return ports
+}
+
+func (trans *DedupeTransform) GetOutputNumber(port Port) int {
+	if trans.Output == port {
+		return 0
+	}
+	return INVALID_NUMBER
+}
+
+func (trans *DedupeTransform) GetInputNumber(port Port) int {
+	if trans.Input == port {
+		return 0
+	}
+	return INVALID_NUMBER
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupeTransform(t *testing.T) {
+	rt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "a", Type: influxql.Float}, influxql.VarRef{Val: "b", Type: influxql.Float})
+	buildChunk := func(times []int64, tags []string, tagIndex []int, a, b []interface{}) executor.Chunk {
+		chunk := executor.NewChunkBuilder(rt).NewChunk("cpu")
+		chunk.AppendTimes(times)
+		for i := range tags {
+			chunk.AddTagAndIndex(*ParseChunkTags("host=" + tags[i]), tagIndex[i])
+		}
+		chunk.AddIntervalIndex(0)
+		for c, values := range [][]interface{}{a, b} {
+			for _, v := range values {
+				if v == nil {
+					chunk.Column(c).AppendNil()
+					continue
+				}
+				chunk.Column(c).AppendFloatValue(v.(float64))
+				chunk.Column(c).AppendNotNil()
+			}
+		}
+		return chunk
+	}
+	// the duplicate rows of the same time and host are removed, the duplicate row at time 3 spans two chunks
+	chunks := []executor.Chunk{
+		buildChunk([]int64{1, 1, 2, 2, 3}, []string{"a"}, []int{0},
+			[]interface{}{1.0, 1.0, 2.0, 2.0, 3.0}, []interface{}{nil, nil, nil, 2.0, 3.0}),
+		buildChunk([]int64{3, 1, 1}, []string{"a", "b"}, []int{0, 1},
+			[]interface{}{3.0, 1.0, 1.0}, []interface{}{3.0, nil, 1.0}),
+	}
+
+	trans := executor.NewDedupeTransform(rt, rt, &query.ProcessorOptions{ChunkSize: 1024})
+	source := NewSourceFromMultiChunk(rt, chunks)
+	var times []int64
+	var tags []string
+	var a, b []interface{}
+	sink := NewSinkFromFunction(rt, func(chunk executor.Chunk) error {
+		times = append(times, chunk.Time()...)
+		for _, tag := range chunk.Tags() {
+			tags = append(tags, string(tag.GetTag()))
+		}
+		for j := 0; j < chunk.NumberOfRows(); j++ {
+			for c, values := range []*[]interface{}{&a, &b} {
+				column := chunk.Column(c)
+				if column.IsNilV2(j) {
+					*values = append(*values, nil)
+					continue
+				}
+				*values = append(*values, column.FloatValue(column.GetValueIndexV2(j)))
+			}
+		}
+		return nil
+	})
+	executor.Connect(source.Output, trans.GetInputs()[0])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+
+	assert.Equal(t, []int64{1, 2, 2, 3, 1, 1}, times)
+	assert.Equal(t, []interface{}{1.0, 2.0, 2.0, 3.0, 1.0, 1.0}, a)
+	assert.Equal(t, []interface{}{nil, nil, 2.0, 3.0, nil, 1.0}, b)
+	// the second chunk of host=a only has the duplicate row
+	assert.Equal(t, 2, len(tags))
+}
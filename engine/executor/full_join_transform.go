@@ -58,6 +58,19 @@ type FullJoinTransform struct {
 	opt                 *query.ProcessorOptions
 	nextChunksCloseOnce []sync.Once
 	errs                errno.Errs
+	joinType            influxql.JoinType
+	tolerance           int64
+	seriesTags          *ChunkTags // the tags of the series to be appended before its first row
+	asofRow             asofRow
+}
+
+// asofRow is the latest right row at or before the time of the current left row, the values are copied
+// because the matched row may be in a right chunk which is already released.
+type asofRow struct {
+	valid  bool
+	time   int64
+	series []string
+	values []interface{} // nil if the value is null
 }
 
 const (
@@ -118,6 +131,8 @@ func NewFullJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType
 		schema:         schema,
 		opt:            schema.opt.(*query.ProcessorOptions),
 		fulljoinLogger: logger.NewLogger(errno.ModuleQueryEngine),
+		joinType:       joinCase.JoinType,
+		tolerance:      int64(joinCase.Tolerance),
 	}
 	for i := range inRowDataTypes {
 		trans.inputs = append(trans.inputs, NewChunkPort(inRowDataTypes[i]))
@@ -129,6 +144,9 @@ func NewFullJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType
 	}
 
 	trans.outputChunk = trans.chunkPool.GetChunk()
+	if trans.joinType.IsAsof() {
+		trans.asofRow.values = make([]interface{}, inRowDataTypes[1].NumColumn())
+	}
 	err := trans.initNewName()
 	if err != nil {
 		return nil, err
@@ -444,6 +462,10 @@ func (trans *FullJoinTransform) compareStrings(l []string, r []string) int {
 func (trans *FullJoinTransform) joinMatch(ltags ChunkTags, rtags ChunkTags) int {
 	_, ltagvals := ltags.GetChunkTagAndValues()
 	_, rtagvals := rtags.GetChunkTagAndValues()
+	return trans.joinMatchTagValues(ltagvals, rtagvals)
+}
+
+func (trans *FullJoinTransform) joinMatchTagValues(ltagvals []string, rtagvals []string) int {
 	for i, joinTag := range trans.joinTags {
 		tagId := trans.joinTagids[i]
 		if tagId < trans.leftTagNum {
@@ -470,25 +492,36 @@ func (trans *FullJoinTransform) joinMatch(ltags ChunkTags, rtags ChunkTags) int
 	}
 }
 
-func (trans *FullJoinTransform) joinSeriesKey(ltagChunk *ChunkTags, rtagChunk *ChunkTags) int {
-	var newTagIndex int = trans.outputChunk.NumberOfRows()
-	var newTagKey []string
-	var newTagVal []string
+// joinSeriesKey sets the tags of the series to be joined, they are appended to the output chunk with the first row
+// of the series, so that the series without any row left by the join type is not appended.
+func (trans *FullJoinTransform) joinSeriesKey(ltagChunk *ChunkTags, rtagChunk *ChunkTags) {
 	if ltagChunk != nil {
-		newTagKey, newTagVal = ltagChunk.GetChunkTagAndValues()
+		trans.seriesTags = ltagChunk
 	} else {
-		newTagKey, newTagVal = rtagChunk.GetChunkTagAndValues()
+		trans.seriesTags = rtagChunk
 	}
+}
+
+func (trans *FullJoinTransform) appendSeriesKey(tagChunk *ChunkTags) {
+	var newTagIndex int = trans.outputChunk.NumberOfRows()
+	newTagKey, newTagVal := tagChunk.GetChunkTagAndValues()
 	if trans.outputChunk.TagLen() > 0 {
 		_, lastTagVal := trans.outputChunk.Tags()[trans.outputChunk.TagLen()-1].GetChunkTagAndValues()
 		if trans.compareStrings(newTagVal, lastTagVal) == 0 {
-			return newTagIndex
+			return
 		}
 	}
 	newChunkTags := NewChunkTagsByTagKVs(newTagKey, newTagVal)
 	trans.outputChunk.AppendTagsAndIndex(*newChunkTags, newTagIndex)
 	trans.outputChunk.AppendIntervalIndex(newTagIndex)
-	return newTagIndex
+}
+
+func (trans *FullJoinTransform) appendTime(time int64) {
+	if trans.seriesTags != nil {
+		trans.appendSeriesKey(trans.seriesTags)
+		trans.seriesTags = nil
+	}
+	trans.outputChunk.AppendTime(time)
 }
 
 func (trans *FullJoinTransform) findColumnToJoin(colIndex int) (Column, bool) {
@@ -535,12 +568,15 @@ func (trans *FullJoinTransform) joinRow(timeState int, lstartIndex int, rstartIn
 	}
 }
 func (trans *FullJoinTransform) joinLastRow(i int, startIndex *int, endIndex int) {
+	if (i == 0 && !trans.joinType.PreserveLeft()) || (i == 1 && !trans.joinType.PreserveRight()) {
+		*startIndex = endIndex
+	}
 	for {
 		if *startIndex >= endIndex {
 			break
 		}
 		time := trans.bufChunks[i].chunk.TimeByIndex(*startIndex)
-		trans.outputChunk.AppendTime(time)
+		trans.appendTime(time)
 		var j int = 0
 		for {
 			if j == len(trans.outputChunk.Columns()) {
@@ -575,17 +611,21 @@ func (trans *FullJoinTransform) joinSeriesVal(lstartIndex int, lendIndex int, rs
 		ltime := trans.bufChunks[0].chunk.TimeByIndex(lstartIndex)
 		rtime := trans.bufChunks[1].chunk.TimeByIndex(rstartIndex)
 		if ltime == rtime {
-			trans.outputChunk.AppendTime(ltime)
+			trans.appendTime(ltime)
 			trans.joinRow(0, lstartIndex, rstartIndex, ltime, rtime)
 			lstartIndex++
 			rstartIndex++
 		} else if ltime < rtime {
-			trans.outputChunk.AppendTime(ltime)
-			trans.joinRow(-1, lstartIndex, rstartIndex, ltime, rtime)
+			if trans.joinType.PreserveLeft() {
+				trans.appendTime(ltime)
+				trans.joinRow(-1, lstartIndex, rstartIndex, ltime, rtime)
+			}
 			lstartIndex++
 		} else {
-			trans.outputChunk.AppendTime(rtime)
-			trans.joinRow(1, lstartIndex, rstartIndex, ltime, rtime)
+			if trans.joinType.PreserveRight() {
+				trans.appendTime(rtime)
+				trans.joinRow(1, lstartIndex, rstartIndex, ltime, rtime)
+			}
 			rstartIndex++
 		}
 	}
@@ -606,6 +646,109 @@ func (trans *FullJoinTransform) joinSeriesVal(lstartIndex int, lendIndex int, rs
 	trans.bufChunks[1].seriesValLoc = rstartIndex
 }
 
+// asofJoinSeriesVal joins each left row of the series to the latest right row at or before its time. The right rows
+// are nil if the right series is not matched. If all the right rows of the chunk are consumed, the remaining left
+// rows are joined after the next right chunk is read, because the following right rows of the series may be in it.
+func (trans *FullJoinTransform) asofJoinSeriesVal(ltagChunk *ChunkTags, rtagChunk *ChunkTags, lstartIndex int, lendIndex int,
+	rstartIndex int, rendIndex int) {
+	_, ltagvals := ltagChunk.GetChunkTagAndValues()
+	var rtagvals []string
+	if rtagChunk != nil {
+		_, rtagvals = rtagChunk.GetChunkTagAndValues()
+	}
+	matched := trans.asofRow.valid && trans.joinMatchTagValues(ltagvals, trans.asofRow.series) == 0
+	rchunk := trans.bufChunks[1].chunk
+	for lstartIndex < lendIndex {
+		ltime := trans.bufChunks[0].chunk.TimeByIndex(lstartIndex)
+		for rstartIndex < rendIndex && rchunk.TimeByIndex(rstartIndex) <= ltime {
+			trans.saveAsofRow(rtagvals, rstartIndex)
+			matched = true
+			rstartIndex++
+		}
+		if rtagChunk != nil && rstartIndex == rchunk.NumberOfRows() {
+			break
+		}
+		if matched && (trans.tolerance == 0 || ltime-trans.asofRow.time <= trans.tolerance) {
+			trans.appendTime(ltime)
+			trans.asofJoinRow(lstartIndex, ltime, true)
+		} else if trans.joinType.PreserveLeft() {
+			trans.appendTime(ltime)
+			trans.asofJoinRow(lstartIndex, ltime, false)
+		}
+		lstartIndex++
+	}
+	trans.bufChunks[0].seriesValLoc = lstartIndex
+	if rtagChunk != nil {
+		trans.bufChunks[1].seriesValLoc = rstartIndex
+	}
+}
+
+func (trans *FullJoinTransform) saveAsofRow(rtagvals []string, index int) {
+	if !trans.asofRow.valid || trans.compareStrings(trans.asofRow.series, rtagvals) != 0 {
+		trans.asofRow.series = trans.asofRow.series[:0]
+		for _, val := range rtagvals {
+			trans.asofRow.series = append(trans.asofRow.series, strings.Clone(val))
+		}
+	}
+	trans.asofRow.valid = true
+	trans.asofRow.time = trans.bufChunks[1].chunk.TimeByIndex(index)
+	for i, column := range trans.bufChunks[1].chunk.Columns() {
+		if column.IsNilV2(index) {
+			trans.asofRow.values[i] = nil
+			continue
+		}
+		valueIndex := column.GetValueIndexV2(index)
+		switch column.DataType() {
+		case influxql.Float:
+			trans.asofRow.values[i] = column.FloatValue(valueIndex)
+		case influxql.Integer:
+			trans.asofRow.values[i] = column.IntegerValue(valueIndex)
+		case influxql.Boolean:
+			trans.asofRow.values[i] = column.BooleanValue(valueIndex)
+		case influxql.String, influxql.Tag:
+			trans.asofRow.values[i] = strings.Clone(column.StringValue(valueIndex))
+		default:
+			trans.asofRow.values[i] = nil
+		}
+	}
+}
+
+func (trans *FullJoinTransform) asofJoinRow(lIndex int, ltime int64, matched bool) {
+	for i := range trans.outputChunk.Columns() {
+		colLoc := trans.outFiledMap[i]
+		if colLoc < len(trans.outputChunk.Columns()) {
+			column := trans.bufChunks[0].chunk.Columns()[colLoc]
+			trans.appendSeriesVal(column.DataType(), i, column, lIndex, ltime)
+			continue
+		}
+		colLoc -= len(trans.outputChunk.Columns())
+		if !matched {
+			trans.appendNilSeriesVal(trans.outputChunk.Column(i).DataType(), i, ltime)
+			continue
+		}
+		trans.appendAsofVal(i, trans.asofRow.values[colLoc], ltime)
+	}
+}
+
+func (trans *FullJoinTransform) appendAsofVal(i int, val interface{}, time int64) {
+	ocolumn := trans.outputChunk.Columns()[i]
+	ocolumn.AppendColumnTime(time)
+	switch v := val.(type) {
+	case float64:
+		ocolumn.AppendFloatValue(v)
+	case int64:
+		ocolumn.AppendIntegerValue(v)
+	case bool:
+		ocolumn.AppendBooleanValue(v)
+	case string:
+		ocolumn.AppendStringValue(v)
+	default:
+		ocolumn.AppendNil()
+		return
+	}
+	ocolumn.AppendNotNil()
+}
+
 func (trans *FullJoinTransform) appendNilSeriesVal(oDataType influxql.DataType, i int, time int64) {
 	ocolumn := trans.outputChunk.Columns()[i]
 	ocolumn.AppendColumnTime(time)
@@ -681,6 +824,7 @@ func (trans *FullJoinTransform) appendSeriesVal(oDataType influxql.DataType, i i
 
 func (trans *FullJoinTransform) fullJoinAlgorithm() {
 	trans.outputChunk.SetName(trans.newName)
+	trans.seriesTags = nil
 	ltagset := trans.bufChunks[0].chunk.Tags()
 	rtagset := trans.bufChunks[1].chunk.Tags()
 	ltagIndex := trans.bufChunks[0].chunk.TagIndex()
@@ -708,7 +852,11 @@ func (trans *FullJoinTransform) fullJoinAlgorithm() {
 		joinState := trans.joinMatch(ltagset[*ltagLoc], rtagset[*rtagLoc])
 		if joinState == 0 {
 			trans.joinSeriesKey(&(ltagset[*ltagLoc]), &(rtagset[*rtagLoc]))
-			trans.joinSeriesVal(lstartIndex, lendIndex, rstartIndex, rendIndex)
+			if trans.joinType.IsAsof() {
+				trans.asofJoinSeriesVal(&(ltagset[*ltagLoc]), &(rtagset[*rtagLoc]), lstartIndex, lendIndex, rstartIndex, rendIndex)
+			} else {
+				trans.joinSeriesVal(lstartIndex, lendIndex, rstartIndex, rendIndex)
+			}
 			if trans.bufChunks[0].seriesValLoc == lendIndex {
 				*ltagLoc++
 			}
@@ -717,7 +865,11 @@ func (trans *FullJoinTransform) fullJoinAlgorithm() {
 			}
 		} else if joinState < 0 {
 			trans.joinSeriesKey(&(ltagset[*ltagLoc]), nil)
-			trans.joinSeriesVal(lstartIndex, lendIndex, -1, -1)
+			if trans.joinType.IsAsof() {
+				trans.asofJoinSeriesVal(&(ltagset[*ltagLoc]), nil, lstartIndex, lendIndex, -1, -1)
+			} else {
+				trans.joinSeriesVal(lstartIndex, lendIndex, -1, -1)
+			}
 			*ltagLoc++
 		} else {
 			trans.joinSeriesKey(nil, &(rtagset[*rtagLoc]))
@@ -732,6 +884,7 @@ func (trans *FullJoinTransform) fullJoinLast(i int) {
 		return
 	}
 	trans.outputChunk.SetName(trans.newName)
+	trans.seriesTags = nil
 	tagset := trans.bufChunks[i].chunk.Tags()
 	tagIndex := trans.bufChunks[i].chunk.TagIndex()
 	var tagLoc int = trans.bufChunks[i].seriesKeyLoc
@@ -749,6 +902,9 @@ func (trans *FullJoinTransform) fullJoinLast(i int) {
 		if i == 1 {
 			trans.joinSeriesKey(nil, &(tagset[tagLoc]))
 			trans.joinSeriesVal(-1, -1, startIndex, endIndex)
+		} else if trans.joinType.IsAsof() {
+			trans.joinSeriesKey(&(tagset[tagLoc]), nil)
+			trans.asofJoinSeriesVal(&(tagset[tagLoc]), nil, startIndex, endIndex, -1, -1)
 		} else {
 			trans.joinSeriesKey(&(tagset[tagLoc]), nil)
 			trans.joinSeriesVal(startIndex, endIndex, -1, -1)
@@ -16,13 +16,16 @@ package executor_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func buildJoinCondition() influxql.Expr {
@@ -402,3 +405,99 @@ func TestFullJoinTransformDemo4(t *testing.T) {
 	_, err := executor.NewFullJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
 	assert.NotEqual(t, err, nil)
 }
+
+type joinSeries struct {
+	tag   string
+	times []int64
+}
+
+// buildJoinInChunk builds the chunk of the series, the float value of each row is its time and the integer value is ten times of it
+func buildJoinInChunk(name string, series ...joinSeries) executor.Chunk {
+	b := executor.NewChunkBuilder(buildInRowDataType())
+	chunk := b.NewChunk(name)
+	for _, s := range series {
+		chunk.AddTagAndIndex(*ParseChunkTags("tag1=" + s.tag), chunk.NumberOfRows())
+		chunk.AddIntervalIndex(chunk.NumberOfRows())
+		for _, t := range s.times {
+			chunk.AppendTime(t)
+			chunk.Column(0).AppendFloatValue(float64(t))
+			chunk.Column(1).AppendStringValue(fmt.Sprintf("f%d", t))
+			chunk.Column(2).AppendBooleanValue(true)
+			chunk.Column(3).AppendIntegerValue(t * 10)
+			for i := range chunk.Columns() {
+				chunk.Column(i).AppendColumnTime(t)
+				chunk.Column(i).AppendNotNil()
+			}
+		}
+	}
+	return chunk
+}
+
+func runJoinTransform(t *testing.T, joinCase *influxql.Join, left, right []executor.Chunk) []string {
+	outputRowDataType := buildOutputRowDataType()
+	source1 := NewSourceFromMultiChunk(buildInRowDataType(), left)
+	source2 := NewSourceFromMultiChunk(buildInRowDataType(), right)
+	trans, err := executor.NewFullJoinTransform([]hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType},
+		outputRowDataType, joinCase, buildFullJoinSchema())
+	require.NoError(t, err)
+
+	var rows []string
+	sink := NewSinkFromFunction(outputRowDataType, func(chunk executor.Chunk) error {
+		tagIndex := chunk.TagIndex()
+		for i, tags := range chunk.Tags() {
+			end := chunk.NumberOfRows()
+			if i+1 < len(tagIndex) {
+				end = tagIndex[i+1]
+			}
+			_, tagValues := tags.GetChunkTagAndValues()
+			for j := tagIndex[i]; j < end; j++ {
+				require.False(t, chunk.Column(0).IsNilV2(j) || chunk.Column(3).IsNilV2(j))
+				rows = append(rows, fmt.Sprintf("%s@%d:%v,%v", tagValues[0], chunk.TimeByIndex(j),
+					chunk.Column(0).FloatValue(chunk.Column(0).GetValueIndexV2(j)),
+					chunk.Column(3).IntegerValue(chunk.Column(3).GetValueIndexV2(j))))
+			}
+		}
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	return rows
+}
+
+func TestJoinTransform_JoinType(t *testing.T) {
+	for _, item := range []struct {
+		joinType  influxql.JoinType
+		tolerance time.Duration
+		expected  []string
+	}{
+		{influxql.FullJoin, 0, []string{"a@1:1,0", "a@2:2,20", "a@3:3,0", "a@4:0,40", "a@6:6,0", "b@1:0,10", "c@1:1,0"}},
+		{influxql.InnerJoin, 0, []string{"a@2:2,20"}},
+		{influxql.LeftOuterJoin, 0, []string{"a@1:1,0", "a@2:2,20", "a@3:3,0", "a@6:6,0", "c@1:1,0"}},
+		{influxql.RightOuterJoin, 0, []string{"a@2:2,20", "a@4:0,40", "b@1:0,10"}},
+		{influxql.AsofJoin, 0, []string{"a@2:2,20", "a@3:3,20", "a@6:6,40"}},
+		{influxql.AsofJoin, 1, []string{"a@2:2,20", "a@3:3,20"}},
+		{influxql.LeftAsofJoin, 1, []string{"a@1:1,0", "a@2:2,20", "a@3:3,20", "a@6:6,0", "c@1:1,0"}},
+	} {
+		// the right series a is split into two chunks
+		left := []executor.Chunk{buildJoinInChunk("m1", joinSeries{"a", []int64{1, 2, 3, 6}}, joinSeries{"c", []int64{1}})}
+		right := []executor.Chunk{buildJoinInChunk("m2", joinSeries{"a", []int64{2}}),
+			buildJoinInChunk("m2", joinSeries{"a", []int64{4}}, joinSeries{"b", []int64{1}})}
+		joinCase := buildJoinCase()
+		joinCase.JoinType = item.joinType
+		joinCase.Tolerance = item.tolerance
+		require.Equal(t, item.expected, runJoinTransform(t, joinCase, left, right), joinCase.String())
+	}
+}
+
+func TestJoinTransform_AsofAcrossLeftChunks(t *testing.T) {
+	left := []executor.Chunk{buildJoinInChunk("m1", joinSeries{"a", []int64{5, 10}}),
+		buildJoinInChunk("m1", joinSeries{"a", []int64{15}}, joinSeries{"b", []int64{3}})}
+	right := []executor.Chunk{buildJoinInChunk("m2", joinSeries{"a", []int64{1, 9}}, joinSeries{"b", []int64{1, 2, 4}})}
+	joinCase := buildJoinCase()
+	joinCase.JoinType = influxql.AsofJoin
+	require.Equal(t, []string{"a@5:5,10", "a@10:10,90", "a@15:15,90", "b@3:3,20"}, runJoinTransform(t, joinCase, left, right))
+}
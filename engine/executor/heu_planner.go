@@ -17,6 +17,7 @@ package executor
 import (
 	"container/list"
 	"fmt"
+	"slices"
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/pool"
@@ -1027,3 +1028,17 @@ func BuildHeuristicPlanner() hybridqp.Planner {
 	planner := NewHeuPlannerImpl(NewHeuProgram(sqlHeuInstruction))
 	return planner
 }
+
+// BuildHeuristicPlannerWithout builds a heuristic planner skipping the rules of the catagories,
+// it is used to build the alternative plans compared by the cost based optimizer.
+func BuildHeuristicPlannerWithout(catagories ...OptRuleCatagory) hybridqp.Planner {
+	instructions := make([]HeuInstruction, 0, len(sqlHeuInstruction))
+	for _, instruction := range sqlHeuInstruction {
+		ri, ok := instruction.(*RuleInstruction)
+		if ok && slices.Contains(catagories, ri.RuleCatagory()) {
+			continue
+		}
+		instructions = append(instructions, instruction)
+	}
+	return NewHeuPlannerImpl(NewHeuProgram(instructions))
+}
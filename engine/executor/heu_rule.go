@@ -882,6 +882,11 @@ func (r *AggPushDownToSubQueryRule) canPush(agg *LogicalAggregate, project *Logi
 		return false
 	}
 
+	// the window functions of the subquery are evaluated on all the rows
+	if project.Schema().HasWindowCall() {
+		return false
+	}
+
 	if project.Schema().HasMath() || project.Schema().HasString() {
 		return false
 	}
@@ -911,6 +916,10 @@ func (r *AggPushDownToSubQueryRule) canPush(agg *LogicalAggregate, project *Logi
 	if project.Schema().Options().IsRangeVectorSelector() {
 		return false
 	}
+	// the range functions of the promql subquery are evaluated after the project
+	if len(project.Schema().GetPromCalls()) > 0 {
+		return false
+	}
 	return true
 }
 
@@ -686,6 +686,92 @@ func TestAggPushDownToSubQueryRuleWithAlias(t *testing.T) {
 	}
 }
 
+func TestAggPushDownToSubQueryRuleWithPromSubquery(t *testing.T) {
+	fieldsSub := influxql.Fields{
+		&influxql.Field{
+			Expr: &influxql.VarRef{
+				Val:  "value",
+				Type: influxql.Float,
+			},
+			Alias: "value",
+		},
+	}
+	columnsName := []string{"value"}
+	opt := query.ProcessorOptions{}
+
+	schema := executor.NewQuerySchema(fieldsSub, columnsName, &opt, nil)
+	schema.SetPromCalls([]*influxql.PromSubCall{{Name: "max_over_time", Range: time.Minute, Interval: int64(10 * time.Second)}})
+	planBuilder := executor.NewLogicalPlanBuilderImpl(schema)
+
+	var plan hybridqp.QueryNode
+	var err error
+	if plan, err = planBuilder.CreateSeriesPlan(); err != nil {
+		t.Error(err.Error())
+	}
+	if plan, err = planBuilder.CreateMeasurementPlan(plan); err != nil {
+		t.Error(err.Error())
+	}
+	if plan, err = planBuilder.CreateScanPlan(plan); err != nil {
+		t.Error(err.Error())
+	}
+	if plan, err = planBuilder.CreateShardPlan(plan); err != nil {
+		t.Error(err.Error())
+	}
+	if plan, err = planBuilder.CreateNodePlan(plan, nil); err != nil {
+		t.Error(err.Error())
+	}
+	planBuilder.Push(plan)
+	planBuilder.Project()
+	planBuilder.PromSubquery(schema.GetPromCalls()[0])
+	planBuilder.SubQuery()
+
+	if plan, err = planBuilder.Build(); err != nil {
+		t.Error(err.Error())
+	}
+
+	fields := influxql.Fields{
+		&influxql.Field{
+			Expr: &influxql.Call{
+				Name: "sum",
+				Args: []influxql.Expr{
+					&influxql.VarRef{
+						Val:  "value",
+						Type: influxql.Float,
+					},
+				},
+			},
+		},
+	}
+	sources := make(influxql.Sources, 0)
+	sources = append(sources, &influxql.SubQuery{})
+	schemaOut := executor.NewQuerySchemaWithSources(fields, sources, columnsName, &opt, nil)
+	planBuilderOut := executor.NewLogicalPlanBuilderImpl(schemaOut)
+	planBuilderOut.Push(plan)
+	planBuilderOut.GroupBy()
+	planBuilderOut.OrderBy()
+	planBuilderOut.Aggregate()
+	planBuilderOut.Project()
+
+	if plan, err = planBuilderOut.Build(); err != nil {
+		t.Error(err.Error())
+	}
+
+	pb := NewHeuProgramBuilder()
+	pb.AddRuleCatagory(executor.RULE_SUBQUERY)
+	planner := executor.NewHeuPlannerImpl(pb.Build())
+	planner.AddRule(executor.NewAggPushDownToSubQueryRule(""))
+	planner.SetRoot(plan)
+
+	best := planner.FindBestExp()
+	if best == nil {
+		t.Fatal("no best plan found")
+	}
+	// the sum is evaluated on the result of max_over_time, not on the rows of the subquery
+	if !best.Schema().HasCall() {
+		t.Errorf("aggregate of promql subquery should not be pushed down")
+	}
+}
+
 func TestAggPushDownToSubQueryRuleWithAliasWithNoPreAgg1(t *testing.T) {
 	config.GetCommon().PreAggEnabled = false
 	defer func() {
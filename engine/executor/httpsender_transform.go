@@ -53,6 +53,7 @@ type HttpChunkSender struct {
 	offset    int
 	prevRow   *models.Row
 	trans     AbortProcessor
+	schema    *query.RowsSchema
 }
 
 func NewHttpChunkSender(opt *query.ProcessorOptions) *HttpChunkSender {
@@ -327,10 +328,30 @@ func (w *HttpChunkSender) GetRows(chunk Chunk) models.Rows {
 	return w.buffRows
 }
 
+// SetRowsSchema sets the schema sent with the rows, it is built from the row data type of the input.
+func (w *HttpChunkSender) SetRowsSchema(rdt hybridqp.RowDataType) {
+	s := &query.RowsSchema{
+		Dimensions: append([]string{}, w.opt.GetOptDimension()...),
+		Columns:    make([]string, 0, rdt.NumColumn()),
+		Types:      make([]influxql.DataType, 0, rdt.NumColumn()),
+	}
+	sort.Strings(s.Dimensions)
+	for _, f := range rdt.Fields() {
+		typ := influxql.Unknown
+		if ref, ok := f.Expr.(*influxql.VarRef); ok {
+			typ = ref.Type
+		}
+		s.Columns = append(s.Columns, f.Name())
+		s.Types = append(s.Types, typ)
+	}
+	w.schema = s
+}
+
 func (w *HttpChunkSender) sendRows(rows models.Rows, partial bool) {
 	rc := query.RowsChan{
 		Rows:    rows,
 		Partial: partial,
+		Schema:  w.schema,
 	}
 
 	if w.opt.AbortChan == nil {
@@ -653,6 +674,7 @@ func NewHttpSenderTransform(inRowDataType hybridqp.RowDataType, schema *QuerySch
 		Writer: NewHttpChunkSender(schema.Options().(*query.ProcessorOptions)),
 		schema: schema,
 	}
+	trans.Writer.SetRowsSchema(inRowDataType)
 
 	if schema.Options().IsExcept() && schema.Options().GetLimit() > 0 {
 		trans.Writer.SetAbortProcessor(trans)
@@ -795,6 +817,7 @@ func NewHttpSenderHintTransform(inRowDataType hybridqp.RowDataType, schema *Quer
 		Writer: NewHttpChunkSender(schema.Options().(*query.ProcessorOptions)),
 		schema: schema,
 	}
+	trans.Writer.SetRowsSchema(inRowDataType)
 	if schema.Options().IsExcept() && schema.Options().GetLimit() > 0 {
 		trans.Writer.SetAbortProcessor(trans)
 	}
@@ -19,6 +19,7 @@ import (
 	"container/list"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strconv"
@@ -27,6 +28,7 @@ import (
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/sysconfig"
@@ -60,6 +62,7 @@ var (
 	_ LogicalPlan = &LogicalColumnStoreReader{}
 	_ LogicalPlan = &LogicalJoin{}
 	_ LogicalPlan = &LogicalBinOp{}
+	_ LogicalPlan = &LogicalWindow{}
 )
 
 type AggLevel uint8
@@ -184,6 +187,9 @@ type LogicalPlanWriterImpl struct {
 	Builder *strings.Builder
 	Values  *list.List
 	Spacer  *Spacer
+
+	// Estimates are the estimated rows and cost of the nodes, keyed by the node id
+	Estimates map[uint64]optimizer.Cost
 }
 
 func NewLogicalPlanWriterImpl(builder *strings.Builder) *LogicalPlanWriterImpl {
@@ -199,6 +205,11 @@ func (w *LogicalPlanWriterImpl) String() string {
 }
 
 func (w *LogicalPlanWriterImpl) Explain(node LogicalPlan) {
+	if cost, ok := w.Estimates[node.ID()]; ok {
+		w.Item("rows", int64(math.Round(cost.Rows)))
+		w.Item("cost", cost)
+	}
+
 	w.Builder.WriteString(w.Spacer.String())
 	w.Builder.WriteString(node.String())
 
@@ -1045,6 +1056,7 @@ func NewLogicalLimit(input hybridqp.QueryNode, schema hybridqp.Catalog, paramete
 	limit.init()
 
 	limit.SetHoltWintersType(true, limit.rt.Fields())
+	limit.SetWindowType(true, limit.rt.Fields())
 	return limit
 }
 
@@ -1413,7 +1425,6 @@ type LogicalDedupe struct {
 	LogicalPlanSingle
 }
 
-// NewLogicalDedupe unused
 func NewLogicalDedupe(input hybridqp.QueryNode, schema hybridqp.Catalog) *LogicalDedupe {
 	dedupe := &LogicalDedupe{
 		LogicalPlanSingle: *NewLogicalPlanSingle(input, schema),
@@ -1424,16 +1435,17 @@ func NewLogicalDedupe(input hybridqp.QueryNode, schema hybridqp.Catalog) *Logica
 	return dedupe
 }
 
-// impl me
 func (p *LogicalDedupe) New(inputs []hybridqp.QueryNode, schema hybridqp.Catalog, eTrait []hybridqp.Trait) hybridqp.QueryNode {
-	return nil
+	return NewLogicalDedupe(inputs[0], schema)
 }
 
 func (p *LogicalDedupe) init() {
 	p.InitRef(p.inputs[0])
 }
 
-func (p *LogicalDedupe) DeriveOperations() {}
+func (p *LogicalDedupe) DeriveOperations() {
+	p.init()
+}
 
 func (p *LogicalDedupe) Clone() hybridqp.QueryNode {
 	clone := &LogicalDedupe{}
@@ -2326,6 +2338,7 @@ func (p *LogicalHttpSender) init() {
 		p.ops = append(p.ops, hybridqp.ExprOptions{Expr: influxql.CloneExpr(f.Expr), Ref: p.schema.FieldsRef()[i]})
 	}
 	p.SetHoltWintersType(true, p.rt.Fields())
+	p.SetWindowType(true, p.rt.Fields())
 }
 
 func (p *LogicalHttpSender) Clone() hybridqp.QueryNode {
@@ -2638,6 +2651,13 @@ func (b *LogicalPlanBuilderImpl) HoltWinters() LogicalPlanBuilder {
 	return b
 }
 
+func (b *LogicalPlanBuilderImpl) Window() LogicalPlanBuilder {
+	last := b.stack.Pop()
+	plan := NewLogicalWindow(last, b.schema)
+	b.stack.Push(plan)
+	return b
+}
+
 func (b *LogicalPlanBuilderImpl) CountDistinct() LogicalPlanBuilder {
 	if b.schema.CountDistinct() != nil {
 		last := b.stack.Pop()
@@ -3709,6 +3729,59 @@ func (p *LogicalHoltWinters) Digest() string {
 	return string(p.digestName)
 }
 
+// LogicalWindow evaluates the window functions with the OVER clause on the rows of each group.
+type LogicalWindow struct {
+	LogicalPlanSingle
+}
+
+func NewLogicalWindow(input hybridqp.QueryNode, schema hybridqp.Catalog) *LogicalWindow {
+	window := &LogicalWindow{
+		LogicalPlanSingle: *NewLogicalPlanSingle(input, schema),
+	}
+	window.init()
+	return window
+}
+
+func (p *LogicalWindow) New(inputs []hybridqp.QueryNode, schema hybridqp.Catalog, eTrait []hybridqp.Trait) hybridqp.QueryNode {
+	return NewLogicalWindow(inputs[0], schema)
+}
+
+func (p *LogicalWindow) DeriveOperations() {
+	p.init()
+}
+
+func (p *LogicalWindow) init() {
+	p.ForwardInit(p.inputs[0])
+	p.SetWindowType(false, p.rt.Fields())
+}
+
+func (p *LogicalWindow) Clone() hybridqp.QueryNode {
+	clone := &LogicalWindow{}
+	*clone = *p
+	clone.id = hybridqp.GenerateNodeId()
+	return clone
+}
+
+func (p *LogicalWindow) Explain(writer LogicalPlanWriter) {
+	p.ExplainIterms(writer)
+	writer.Explain(p)
+}
+
+func (p *LogicalWindow) Type() string {
+	return GetType(p)
+}
+
+func (p *LogicalWindow) Digest() string {
+	if p.digest {
+		return string(p.digestName)
+	}
+	p.digest = true
+	p.digestName = p.digestName[:0]
+	p.digestName = encoding.MarshalUint32(p.digestName, uint32(p.LogicPlanType()))
+	p.digestName = encoding.MarshalUint64(p.digestName, p.inputs[0].ID())
+	return string(p.digestName)
+}
+
 // Digest format: printf("%s(%d)[%d](%s)(%s)", name, typ, id, fields, calls)
 func buildDigest(buf *bytes.Buffer, name string, typ int, id uint64, fields influxql.Fields,
 	calls map[string]*influxql.Call, callsOrder []string) {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	internal "github.com/openGemini/openGemini/lib/util/lifted/influx/query/proto"
 )
 
@@ -247,6 +248,30 @@ func (p *LogicalPlanSingle) SetHoltWintersType(setOps bool, fields influxql.Fiel
 	}
 }
 
+// SetWindowType sets the types of the columns of the window functions to the types of their results.
+func (p *LogicalPlanSingle) SetWindowType(setOps bool, fields influxql.Fields) {
+	for i, ref := range fields {
+		rVal, ok := ref.Expr.(*influxql.VarRef)
+		if !ok {
+			continue
+		}
+		v := rVal.Val
+		call := p.schema.WindowCall(v)
+		if call == nil {
+			continue
+		}
+		typ := query.WindowFunctionType(call.Name, rVal.Type)
+		p.rt.SetDataType(i, typ)
+		if val, ok := p.ops[i].Expr.(*influxql.VarRef); ok {
+			val.SetDataType(typ)
+			if setOps {
+				val.SetVal(v)
+			}
+		}
+		p.ops[i].Ref.SetDataType(typ)
+	}
+}
+
 type LogicalPlanMulti struct {
 	LogicalPlanBase
 }
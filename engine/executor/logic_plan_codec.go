@@ -228,6 +228,10 @@ func (p *LogicalHoltWinters) LogicPlanType() internal.LogicPlanType {
 	return internal.LogicPlanType_LogicalHoltWinters
 }
 
+func (p *LogicalWindow) LogicPlanType() internal.LogicPlanType {
+	return internal.LogicPlanType_LogicalWindow
+}
+
 func (p *LogicalSortAppend) LogicPlanType() internal.LogicPlanType {
 	return internal.LogicPlanType_LogicalSortAppend
 }
@@ -400,6 +404,10 @@ func (p *LogicalHoltWinters) String() string {
 	return "LogicalHoltWinters"
 }
 
+func (p *LogicalWindow) String() string {
+	return "LogicalWindow"
+}
+
 func (p *LogicalSortAppend) String() string {
 	return "LogicalSortAppend"
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	qry "github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -430,6 +431,7 @@ func RegistryMockTransformCreator() {
 type MockShardGroup struct {
 	shards           map[string]*Table
 	needNodeExchange bool
+	statistics       *optimizer.Statistics
 }
 
 func (mock *MockShardGroup) FieldDimensions(
@@ -525,6 +527,13 @@ func (mock *MockShardGroup) LogicalPlanCost(source *influxql.Measurement, opt qr
 	panic("GetSources is not implements")
 }
 
+func (mock *MockShardGroup) Statistics(m *influxql.Measurement, tagKeys []string) (*optimizer.Statistics, error) {
+	if mock.statistics == nil {
+		return optimizer.NewStatistics(), nil
+	}
+	return mock.statistics.Clone(), nil
+}
+
 func (mock *MockShardGroup) AddShard(table *Table) {
 	mock.shards[table.Name()] = table
 }
@@ -676,6 +685,7 @@ func (c *Catalog) CreateDatabase(name string, rp string) (*Database, error) {
 type MockShardMapper struct {
 	catalog          *Catalog
 	needNodeExchange bool
+	statistics       *optimizer.Statistics
 }
 
 func (mock *MockShardMapper) SetNeedNodeExchange(enable bool) {
@@ -689,6 +699,7 @@ func (mock *MockShardMapper) MapShards(
 	condition influxql.Expr) (qry.ShardGroup, error) {
 	shardGroup := NewMockShardGroup()
 	shardGroup.SetNeedNodeExchange(mock.needNodeExchange)
+	shardGroup.statistics = mock.statistics
 	for _, s := range sources {
 		switch s := s.(type) {
 		case *influxql.Measurement:
@@ -699,7 +710,13 @@ func (mock *MockShardMapper) MapShards(
 				continue
 			}
 		case *influxql.SubQuery:
-			return mock.MapShards(s.Statement.Sources, t, opt, condition)
+			sg, err := mock.MapShards(s.Statement.Sources, t, opt, condition)
+			if err != nil {
+				return nil, err
+			}
+			for _, table := range sg.(*MockShardGroup).shards {
+				shardGroup.AddShard(table)
+			}
 		default:
 			panic("unsupport source")
 		}
@@ -954,8 +971,9 @@ func DFSSources(sources influxql.Sources) []*influxql.Measurement {
 }
 
 type TSDBSystem struct {
-	catalog *Catalog
-	storage *Storage
+	catalog    *Catalog
+	storage    *Storage
+	statistics *optimizer.Statistics
 }
 
 func NewTSDBSystem() *TSDBSystem {
@@ -975,6 +993,11 @@ func (s *TSDBSystem) DML(handler func(*Storage) error) error {
 	return handler(s.storage)
 }
 
+// SetStatistics sets the statistics returned by the shard groups to the cost based optimizer
+func (s *TSDBSystem) SetStatistics(stats *optimizer.Statistics) {
+	s.statistics = stats
+}
+
 func NilGetPlanType(schema hybridqp.Catalog, stmt *influxql.SelectStatement) executor.PlanType {
 	return executor.UNKNOWN
 }
@@ -1029,6 +1052,7 @@ func (s *TSDBSystem) ExecSQL(sql string,
 
 	shardMapper := NewMockShardMapper(s.catalog)
 	shardMapper.SetNeedNodeExchange(needNodeExchange)
+	shardMapper.statistics = s.statistics
 	selectStmt, ok := stmt.(*influxql.SelectStatement)
 	if !ok {
 		return fmt.Errorf("not select statement(%v)", stmt)
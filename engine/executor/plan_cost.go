@@ -0,0 +1,354 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+)
+
+// costBasedAlternatives are the plans compared with the heuristic plan by the cost based optimizer,
+// each of them is built by the heuristic planner without the rules of the catagories.
+var costBasedAlternatives = [][]OptRuleCatagory{
+	nil,
+	{RULE_SPREAD_AGG},
+	{RULE_PUSHDOWN_AGG, RULE_SPREAD_AGG},
+}
+
+// costBasedScans are the ways of finding the series read by the alternative plans: the tag filter is looked up
+// in the index, or it is evaluated on the key of every series of the measurement.
+var costBasedScans = []hybridqp.HintType{
+	hybridqp.DefaultNoHint,
+	hybridqp.SeriesScanQuery,
+}
+
+// PlanCostEstimator estimates the rows and the cost of each node of a logical plan from the statistics
+// of the measurements read by the plan.
+type PlanCostEstimator struct {
+	model     *optimizer.CostModel
+	stats     *optimizer.Statistics
+	estimates map[uint64]optimizer.Cost
+}
+
+func NewPlanCostEstimator(stats *optimizer.Statistics, model *optimizer.CostModel) *PlanCostEstimator {
+	return &PlanCostEstimator{
+		model:     model,
+		stats:     stats,
+		estimates: make(map[uint64]optimizer.Cost),
+	}
+}
+
+// Estimate returns the cost of the whole plan, the cost of each node is kept and can be got by Estimates.
+func (e *PlanCostEstimator) Estimate(plan hybridqp.QueryNode) optimizer.Cost {
+	return e.estimate(plan, 1)
+}
+
+func (e *PlanCostEstimator) Estimates() map[uint64]optimizer.Cost {
+	return e.estimates
+}
+
+// estimate walks the plan from the root, instances is the number of the instances of the node running in parallel,
+// each of them produces its own groups when the node is an aggregate.
+func (e *PlanCostEstimator) estimate(node hybridqp.QueryNode, instances float64) optimizer.Cost {
+	if node == nil {
+		return optimizer.Cost{}
+	}
+
+	if exchange, ok := node.(*LogicalExchange); ok {
+		instances = e.exchangeInstances(exchange, instances)
+	}
+
+	children := node.Children()
+	var cost optimizer.Cost
+	if len(children) == 0 {
+		cost = e.scanCost(node)
+	} else {
+		var input optimizer.Cost
+		for _, child := range children {
+			input = input.Add(e.estimate(child, instances))
+		}
+		cost = e.operatorCost(node, input, instances).On(input)
+	}
+
+	e.estimates[node.ID()] = cost
+	return cost
+}
+
+func (e *PlanCostEstimator) exchangeInstances(exchange *LogicalExchange, instances float64) float64 {
+	switch exchange.EType() {
+	case NODE_EXCHANGE:
+		return math.Max(instances, float64(len(exchange.ETraits())))
+	case SHARD_EXCHANGE, SINGLE_SHARD_EXCHANGE, READER_EXCHANGE:
+		return math.Max(instances, float64(e.stats.ShardN))
+	case SERIES_EXCHANGE:
+		est := e.stats.Estimate(exchange.Schema().Options().GetCondition(), exchange.Schema().Options().GetStartTime(),
+			exchange.Schema().Options().GetEndTime())
+		return math.Max(instances, est.SeriesN)
+	default:
+		return instances
+	}
+}
+
+func (e *PlanCostEstimator) scanCost(node hybridqp.QueryNode) optimizer.Cost {
+	if node.Schema() == nil {
+		return optimizer.Cost{}
+	}
+	opt := node.Schema().Options()
+	cost := e.model.ScanCost(e.stats, e.stats.Estimate(opt.GetCondition(), opt.GetStartTime(), opt.GetEndTime()))
+
+	tagExpr, _, ok := optimizer.SplitTagCondition(opt.GetCondition())
+	if !ok {
+		return cost
+	}
+	if opt.GetHintType() == hybridqp.SeriesScanQuery {
+		return cost.Add(e.model.SeriesScanFilterCost(e.stats))
+	}
+	return cost.Add(e.model.IndexFilterCost(e.stats, tagExpr))
+}
+
+func (e *PlanCostEstimator) operatorCost(node hybridqp.QueryNode, input optimizer.Cost, instances float64) optimizer.Cost {
+	switch n := node.(type) {
+	case *LogicalExchange:
+		if n.EType() == NODE_EXCHANGE {
+			return e.model.TransferCost(input)
+		}
+		return optimizer.Cost{Rows: input.Rows}
+	case *LogicalIndexScan:
+		// the series are looked up in the index by the scan below
+		return optimizer.Cost{Rows: input.Rows}
+	case *LogicalAggregate, *LogicalHashAgg, *LogicalIncAgg, *LogicalIncHashAgg, *LogicalSlidingWindow:
+		return e.model.AggregateCost(input, e.groups(node, instances))
+	case *LogicalLimit:
+		cost := e.model.OperatorCost(input)
+		limit := float64(n.LimitPara.Limit+n.LimitPara.Offset) * e.groups(node, 1)
+		if n.LimitPara.Limit > 0 && limit < cost.Rows {
+			cost.Rows = limit
+		}
+		return cost
+	default:
+		return e.model.OperatorCost(input)
+	}
+}
+
+// groups is the number of the rows produced by all the instances of an aggregate
+func (e *PlanCostEstimator) groups(node hybridqp.QueryNode, instances float64) float64 {
+	if node.Schema() == nil {
+		return instances
+	}
+	opt := node.Schema().Options()
+
+	est := e.stats.Estimate(opt.GetCondition(), opt.GetStartTime(), opt.GetEndTime())
+	groups := e.stats.GroupN(opt.GetOptDimension())
+	if est.SeriesN > 0 {
+		groups = math.Min(groups, math.Max(1, est.SeriesN/instances))
+	}
+	return instances * groups * e.windows(opt)
+}
+
+func (e *PlanCostEstimator) windows(opt hybridqp.Options) float64 {
+	interval := opt.GetInterval()
+	if !opt.HasInterval() || interval <= 0 {
+		return 1
+	}
+
+	start, end := opt.GetStartTime(), opt.GetEndTime()
+	if !e.stats.IsEmpty() {
+		start = max(start, e.stats.MinTime)
+		end = min(end, e.stats.MaxTime)
+	}
+	if end < start || start == influxql.MinTime || end == influxql.MaxTime {
+		return 1
+	}
+	return math.Max(1, math.Ceil(float64(end-start+1)/float64(interval.Nanoseconds())))
+}
+
+// canCostBasedOptimize reports whether the plan is chosen by the cost based optimizer. The plans of the column
+// store are rebuilt after the heuristic planner and read the data by the sparse index, they are not covered.
+func (p *preparedStatement) canCostBasedOptimize(schema *QuerySchema, haveOnlyCSStore bool) bool {
+	if sysconfig.GetEnableCostBasedOptimizer() == 0 || haveOnlyCSStore {
+		return false
+	}
+	if !schema.HasCall() || schema.HasSubQuery() || schema.MatchPreAgg() {
+		return false
+	}
+	_, ok := p.qc.(optimizer.StatisticsProvider)
+	return ok
+}
+
+// cheapestPlan builds the alternative plans and returns the cheapest one, the heuristic plan is kept
+// when the statistics are unavailable.
+func (p *preparedStatement) cheapestPlan(ctx context.Context, sources influxql.Sources, best hybridqp.QueryNode, mstsReqs *[]*MultiMstReqs) hybridqp.QueryNode {
+	stats, ok := p.statistics(sources, best.Schema().Options())
+	if !ok {
+		return best
+	}
+	merged := optimizer.NewStatistics()
+	for _, s := range stats {
+		merged.Merge(s)
+	}
+	if merged.IsEmpty() {
+		return best
+	}
+
+	bestCost := NewPlanCostEstimator(merged, &optimizer.DefaultCostModel).Estimate(best)
+	opt, _ := p.opt.(*query.ProcessorOptions)
+	for _, hint := range costBasedScans {
+		if hint == hybridqp.SeriesScanQuery && !canSeriesScan(opt) {
+			continue
+		}
+		for _, catagories := range costBasedAlternatives {
+			if hint == hybridqp.DefaultNoHint && len(catagories) == 0 {
+				// the heuristic plan
+				continue
+			}
+			alternative, reqs := p.alternativePlan(ctx, sources, opt, hint, catagories)
+			if alternative == nil {
+				continue
+			}
+
+			cost := NewPlanCostEstimator(merged, &optimizer.DefaultCostModel).Estimate(alternative)
+			if cost.Less(bestCost) {
+				best, bestCost = alternative, cost
+				*mstsReqs = reqs
+			}
+		}
+	}
+	PrintPlan("cost based plan", best)
+	return best
+}
+
+// alternativePlan builds the plan of the statement without the rules of the catagories, the series are found
+// as the hint tells. nil is returned when the plan can not be built.
+func (p *preparedStatement) alternativePlan(ctx context.Context, sources influxql.Sources, opt *query.ProcessorOptions,
+	hint hybridqp.HintType, catagories []OptRuleCatagory) (hybridqp.QueryNode, []*MultiMstReqs) {
+	clone := opt.Clone()
+	clone.HintType = hint
+	schema := p.newQuerySchema(sources, clone)
+	p.stmt.Sources = sources
+	plan, err := buildExtendedPlan(ctx, p.stmt, p.qc, schema)
+	if err != nil || plan == nil {
+		return nil, nil
+	}
+
+	reqs := make([]*MultiMstReqs, 0)
+	if localStorageForQuery != nil {
+		p.removeNodeLogicalExchange(plan, &reqs)
+	}
+	planner := BuildHeuristicPlannerWithout(catagories...)
+	planner.SetRoot(plan)
+	return planner.FindBestExp(), reqs
+}
+
+// canSeriesScan reports whether the series can be found by evaluating the tag filter on their keys,
+// the hints given by the user are kept.
+func canSeriesScan(opt *query.ProcessorOptions) bool {
+	if opt == nil || opt.HintType != hybridqp.DefaultNoHint {
+		return false
+	}
+	_, _, ok := optimizer.SplitTagCondition(opt.Condition)
+	return ok
+}
+
+// statistics collects the statistics of each measurement queried, false is returned when they are unavailable
+func (p *preparedStatement) statistics(sources influxql.Sources, opt hybridqp.Options) ([]*optimizer.Statistics, bool) {
+	provider, ok := p.qc.(optimizer.StatisticsProvider)
+	if !ok {
+		return nil, false
+	}
+
+	tagKeys := statisticsTagKeys(opt)
+	stats := make([]*optimizer.Statistics, 0, len(sources))
+	for _, source := range sources {
+		m, ok := source.(*influxql.Measurement)
+		if !ok {
+			return nil, false
+		}
+		s, err := provider.Statistics(m, tagKeys)
+		if err != nil {
+			logger.GetLogger().Warn("failed to collect statistics for the cost based optimizer",
+				zap.String("measurement", m.Name), zap.Error(err))
+			return nil, false
+		}
+		stats = append(stats, s)
+	}
+	return stats, true
+}
+
+// statisticsTagKeys returns the tag keys in the group by and in the condition,
+// their number of distinct values are needed to estimate the selectivity and the groups
+func statisticsTagKeys(opt hybridqp.Options) []string {
+	var keys []string
+	for _, dim := range opt.GetOptDimension() {
+		if !slices.Contains(keys, dim) {
+			keys = append(keys, dim)
+		}
+	}
+	if opt.GetCondition() == nil {
+		return keys
+	}
+	influxql.WalkFunc(opt.GetCondition(), func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok && ref.Type == influxql.Tag && !slices.Contains(keys, ref.Val) {
+			keys = append(keys, ref.Val)
+		}
+	})
+	return keys
+}
+
+// Explain returns the plan of the statement, the nodes are annotated with the estimated rows and cost
+// when the statistics of the measurements are available.
+func (p *preparedStatement) Explain() (string, error) {
+	sources := p.stmt.Sources
+	best, _, err := p.BuildLogicalPlan(context.Background())
+	if err != nil {
+		return "", err
+	}
+	if best == nil {
+		return "", nil
+	}
+
+	writer := NewLogicalPlanWriterImpl(&strings.Builder{})
+	if stats, ok := p.statistics(sources, best.Schema().Options()); ok {
+		merged := optimizer.NewStatistics()
+		for _, s := range stats {
+			merged.Merge(s)
+		}
+		if !merged.IsEmpty() {
+			for i, s := range stats {
+				writer.Builder.WriteString(fmt.Sprintf("statistics of %s: %s\n", sources[i].String(), s.String()))
+			}
+			estimator := NewPlanCostEstimator(merged, &optimizer.DefaultCostModel)
+			estimator.Estimate(best)
+			writer.Estimates = estimator.Estimates()
+		}
+	}
+
+	plan, ok := best.(LogicalPlan)
+	if !ok {
+		return "", fmt.Errorf("unexpected plan type: %T", best)
+	}
+	plan.Explain(writer)
+	return writer.String(), nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	qry "github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCostTestStatistics() *optimizer.Statistics {
+	return &optimizer.Statistics{
+		ShardN:    4,
+		SeriesN:   10000,
+		RowN:      10000000,
+		FileN:     40,
+		FileSize:  1 << 30,
+		MinTime:   0,
+		MaxTime:   3600 * 1e9,
+		TagValueN: map[string]int64{"t": 10000},
+	}
+}
+
+func newCostTestPlan(t *testing.T, sql string, stats *optimizer.Statistics) qry.PreparedStatement {
+	stmt, err := influxql.ParseStatement(sql)
+	require.NoError(t, err)
+	stmt, err = qry.RewriteStatement(stmt)
+	require.NoError(t, err)
+
+	catalog := NewCatalog()
+	require.NoError(t, createUnionTables(catalog))
+	shardMapper := NewMockShardMapper(catalog)
+	shardMapper.SetNeedNodeExchange(true)
+	shardMapper.statistics = stats
+
+	selectStmt, ok := stmt.(*influxql.SelectStatement)
+	require.True(t, ok)
+	prepared, err := qry.Prepare(selectStmt, shardMapper, qry.SelectOptions{})
+	require.NoError(t, err)
+	return prepared
+}
+
+func TestPlanCostEstimator(t *testing.T) {
+	stats := newCostTestStatistics()
+	schema := executor.NewQuerySchema(nil, nil, &qry.ProcessorOptions{
+		StartTime: influxql.MinTime,
+		EndTime:   influxql.MaxTime,
+		Interval:  hybridqp.Interval{Duration: time.Minute},
+	}, nil)
+
+	builder := executor.NewLogicalPlanBuilderImpl(schema)
+	builder.Series()
+	builder.Exchange(executor.SERIES_EXCHANGE, nil)
+	builder.Reader(config.TSSTORE)
+	builder.Exchange(executor.READER_EXCHANGE, nil)
+	builder.Exchange(executor.SHARD_EXCHANGE, nil)
+	builder.Exchange(executor.NODE_EXCHANGE, nil)
+	raw, err := builder.Build()
+	require.NoError(t, err)
+
+	estimator := executor.NewPlanCostEstimator(stats, &optimizer.DefaultCostModel)
+	cost := estimator.Estimate(raw)
+	assert.Equal(t, float64(stats.RowN), cost.Rows)
+	assert.Equal(t, float64(stats.RowN)*optimizer.DefaultCostModel.RowTransferCost, cost.Network)
+	assert.Equal(t, 6, len(estimator.Estimates()))
+
+	windows := executor.NewPlanCostEstimator(stats, &optimizer.DefaultCostModel)
+	schema.Options().(*qry.ProcessorOptions).StartTime = 0
+	schema.Options().(*qry.ProcessorOptions).EndTime = 3600*1e9 - 1
+	builder = executor.NewLogicalPlanBuilderImpl(schema)
+	builder.Series()
+	builder.Exchange(executor.SERIES_EXCHANGE, nil)
+	builder.Reader(config.TSSTORE)
+	builder.Exchange(executor.READER_EXCHANGE, nil)
+	builder.Exchange(executor.SHARD_EXCHANGE, nil)
+	builder.Exchange(executor.NODE_EXCHANGE, nil)
+	builder.Aggregate()
+	agg, err := builder.Build()
+	require.NoError(t, err)
+	// one group per window
+	assert.Equal(t, float64(60), windows.Estimate(agg).Rows)
+}
+
+func TestPlanCostEstimator_SeriesScan(t *testing.T) {
+	stats := newCostTestStatistics()
+	newPlan := func(condition string, hint hybridqp.HintType) hybridqp.QueryNode {
+		schema := executor.NewQuerySchema(nil, nil, &qry.ProcessorOptions{
+			StartTime: influxql.MinTime,
+			EndTime:   influxql.MaxTime,
+			Condition: influxql.MustParseExpr(condition),
+			HintType:  hint,
+		}, nil)
+		builder := executor.NewLogicalPlanBuilderImpl(schema)
+		builder.Series()
+		builder.Exchange(executor.SERIES_EXCHANGE, nil)
+		builder.Reader(config.TSSTORE)
+		builder.Exchange(executor.READER_EXCHANGE, nil)
+		builder.Exchange(executor.SHARD_EXCHANGE, nil)
+		builder.Exchange(executor.NODE_EXCHANGE, nil)
+		plan, err := builder.Build()
+		require.NoError(t, err)
+		return plan
+	}
+	cost := func(condition string, hint hybridqp.HintType) optimizer.Cost {
+		return executor.NewPlanCostEstimator(stats, &optimizer.DefaultCostModel).Estimate(newPlan(condition, hint))
+	}
+
+	// a tag value is looked up in the index
+	eq := "t::tag = 'x'"
+	assert.True(t, cost(eq, hybridqp.DefaultNoHint).Less(cost(eq, hybridqp.SeriesScanQuery)))
+
+	// the regexes read all the values of a tag having a value per series from the index
+	regex := "t::tag =~ /^x/ AND t::tag !~ /y$/ AND t::tag =~ /z/ AND a::integer > 1"
+	assert.True(t, cost(regex, hybridqp.SeriesScanQuery).Less(cost(regex, hybridqp.DefaultNoHint)))
+
+	// the hint changes nothing when the series can not be found by evaluating the condition on their keys
+	mixed := "t::tag = 'x' OR a::integer > 1"
+	assert.Equal(t, cost(mixed, hybridqp.DefaultNoHint), cost(mixed, hybridqp.SeriesScanQuery))
+}
+
+func TestExplainWithEstimates(t *testing.T) {
+	prepared := newCostTestPlan(t, "SELECT count(a) FROM db0.rp0.m1 WHERE t = 'x'", newCostTestStatistics())
+	plan, err := prepared.Explain()
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(plan), "\n")
+	require.True(t, len(lines) > 1)
+	assert.True(t, strings.HasPrefix(lines[0], "statistics of db0.rp0.m1: shards=4 series=10000"), lines[0])
+	assert.Contains(t, lines[1], "rows=[1]")
+	for _, line := range lines[1:] {
+		assert.Contains(t, line, "cost=[", line)
+	}
+
+	// the plan is explained without the estimates when no statistics are available
+	prepared = newCostTestPlan(t, "SELECT count(a) FROM db0.rp0.m1 WHERE t = 'x'", nil)
+	plan, err = prepared.Explain()
+	require.NoError(t, err)
+	assert.NotContains(t, plan, "statistics of")
+	assert.NotContains(t, plan, "cost=[")
+}
+
+func TestCostBasedOptimizer(t *testing.T) {
+	sysconfig.SetEnableCostBasedOptimizer(1)
+	defer sysconfig.SetEnableCostBasedOptimizer(0)
+
+	for _, stats := range []*optimizer.Statistics{nil, newCostTestStatistics()} {
+		tsdb := NewTSDBSystem()
+		tsdb.SetStatistics(stats)
+		require.NoError(t, tsdb.DDL(createUnionTables))
+		require.NoError(t, tsdb.DML(writeUnionRows))
+		for _, sql := range []string{
+			"SELECT count(a) FROM db0.rp0.m1",
+			"SELECT count(a) FROM db0.rp0.m1 WHERE t =~ /^x/ AND t !~ /y$/ AND t =~ /x/ AND c > 0",
+		} {
+			err := tsdb.ExecSQL(sql, func(results []executor.Chunk) {
+				assert.Equal(t, 1, len(results))
+				assert.Equal(t, []int64{2}, results[0].Columns()[0].IntegerValues())
+			}, nil, false)
+			require.NoError(t, err)
+		}
+	}
+}
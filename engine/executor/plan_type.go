@@ -174,7 +174,7 @@ func NormalGetPlanType(schema hybridqp.Catalog, stmt *influxql.SelectStatement)
 	}
 
 	// Avoid all special operators
-	if schema.HasSlidingWindowCall() || schema.HasHoltWintersCall() || schema.HasBlankRowCall() {
+	if schema.HasSlidingWindowCall() || schema.HasHoltWintersCall() || schema.HasWindowCall() || schema.HasBlankRowCall() {
 		return UNKNOWN
 	}
 	// Avoid the lack of the Fill operator.
@@ -338,3 +338,67 @@ func TestPromRangeVectorTransform7(t *testing.T) {
 	}
 	PromRangeVectorTransformTestBase(t, []executor.Chunk{chunk1, chunk2}, call, BuildPromSubqueryResult1()[0])
 }
+
+func BuildPromSubqueryInChunk9() executor.Chunk {
+	rowDataType := buildPromBinOpOutputRowDataType()
+	b := executor.NewChunkBuilder(rowDataType)
+	chunk := b.NewChunk("m1")
+	chunk.AppendTimes([]int64{times[0], times[1], times[0], times[1]})
+	chunk.AddTagAndIndex(*ParseChunkTags("tk1=1"), 0)
+	chunk.AddTagAndIndex(*ParseChunkTags("tk1=2"), 2)
+	chunk.AddIntervalIndex(0)
+	chunk.AddIntervalIndex(2)
+	chunk.Column(0).AppendFloatValues([]float64{1, 3, 4, 2})
+	chunk.Column(0).AppendManyNotNil(4)
+	return chunk
+}
+
+func BuildPromSubqueryResult5(values []float64) executor.Chunk {
+	rowDataType := buildPromBinOpOutputRowDataType()
+	b := executor.NewChunkBuilder(rowDataType)
+	chunk := b.NewChunk("")
+	chunk.AppendTimes([]int64{times[2], times[2]})
+	chunk.AddTagAndIndex(*ParseChunkTags("tk1=1"), 0)
+	chunk.AddTagAndIndex(*ParseChunkTags("tk1=2"), 1)
+	chunk.AddIntervalIndex(0)
+	chunk.AddIntervalIndex(1)
+	AppendFloatValues(chunk, 0, values, []bool{true, true})
+	return chunk
+}
+
+// range functions over subquery
+func TestPromRangeVectorTransformFunctions(t *testing.T) {
+	tests := []struct {
+		name   string
+		inArgs []influxql.Expr
+		want   []float64
+	}{
+		{name: "sum_over_time", want: []float64{4, 6}},
+		{name: "avg_over_time", want: []float64{2, 3}},
+		{name: "max_over_time", want: []float64{3, 4}},
+		{name: "min_over_time", want: []float64{1, 2}},
+		{name: "count_over_time", want: []float64{2, 2}},
+		{name: "last_over_time_prom", want: []float64{3, 2}},
+		{name: "present_over_time_prom", want: []float64{1, 1}},
+		{name: "stdvar_over_time_prom", want: []float64{1, 1}},
+		{name: "stddev_over_time_prom", want: []float64{1, 1}},
+		{name: "quantile_over_time_prom", inArgs: []influxql.Expr{&influxql.NumberLiteral{Val: 0.5}}, want: []float64{2, 3}},
+		{name: "changes_prom", want: []float64{1, 1}},
+		{name: "resets_prom", want: []float64{0, 1}},
+		{name: "idelta_prom", want: []float64{2, -2}},
+		{name: "irate_prom", want: []float64{2000, 2000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call := &influxql.PromSubCall{
+				Name:      tt.name,
+				Interval:  int64(2 * time.Millisecond),
+				StartTime: int64(2 * time.Millisecond),
+				EndTime:   int64(2 * time.Millisecond),
+				Range:     3 * time.Millisecond,
+				InArgs:    tt.inArgs,
+			}
+			PromRangeVectorTransformTestBase(t, []executor.Chunk{BuildPromSubqueryInChunk9()}, call, BuildPromSubqueryResult5(tt.want))
+		})
+	}
+}
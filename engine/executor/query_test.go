@@ -25,6 +25,7 @@ import (
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/openGemini/openGemini/services/castor"
 )
@@ -161,6 +162,101 @@ func TestMockTSDBSystem(t *testing.T) {
 					[]int64{0, 0, 1, 1, 2, 2, 0, 0, 1, 1, 2, 2})
 			},
 		},
+		{
+			name: "Window Functions Select",
+			sql:  "SELECT v, lag(v) OVER (PARTITION BY t ORDER BY time) AS prev, row_number() OVER (PARTITION BY t ORDER BY time) AS rn, sum(v) OVER (PARTITION BY t ORDER BY time ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) AS s FROM db0.rp0.mst0",
+			ddl: func(c *Catalog) error {
+				db, err := c.CreateDatabase("db0", "rp0")
+				if err != nil {
+					return err
+				}
+				mst0 := NewTable("mst0")
+				dataTypes := make(map[string]influxql.DataType)
+				dataTypes["t"] = influxql.Tag
+				dataTypes["v"] = influxql.Integer
+				mst0.AddDataTypes(dataTypes)
+				db.AddTable(mst0)
+				return nil
+			},
+			dml: func(s *Storage) error {
+				rdt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "t", Type: influxql.String},
+					influxql.VarRef{Val: "v", Type: influxql.Integer})
+				builder := executor.NewChunkBuilder(rdt)
+				chunk1 := builder.NewChunk("mst0")
+				chunk1.AppendTimes([]int64{1, 2, 3})
+				chunk1.Column(0).AppendStringValues([]string{"a", "a", "a"})
+				chunk1.Column(0).AppendManyNotNil(3)
+				chunk1.Column(1).AppendIntegerValues([]int64{1, 2, 3})
+				chunk1.Column(1).AppendManyNotNil(3)
+				pts1 := influx.PointTags{influx.Tag{Key: "t", Value: "a"}}
+				s.Write("db0.rp0.mst0", &pts1, chunk1)
+
+				chunk2 := builder.NewChunk("mst0")
+				chunk2.AppendTimes([]int64{1, 2})
+				chunk2.Column(0).AppendStringValues([]string{"b", "b"})
+				chunk2.Column(0).AppendManyNotNil(2)
+				chunk2.Column(1).AppendIntegerValues([]int64{10, 20})
+				chunk2.Column(1).AppendManyNotNil(2)
+				pts2 := influx.PointTags{influx.Tag{Key: "t", Value: "b"}}
+				s.Write("db0.rp0.mst0", &pts2, chunk2)
+				return nil
+			},
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{1, 2, 3, 1, 2})
+				assert.Equal(t, results[0].TagIndex(), []int{0, 3})
+				assert.Equal(t, results[0].Columns()[1].IntegerValues(), []int64{1, 2, 10})
+				assert.Equal(t, results[0].Columns()[1].NilCount(), 2)
+				assert.Equal(t, results[0].Columns()[2].IntegerValues(), []int64{1, 2, 3, 1, 2})
+				assert.Equal(t, results[0].Columns()[3].IntegerValues(), []int64{1, 3, 5, 10, 30})
+			},
+		},
+		{
+			name: "Union All Select",
+			sql:  "SELECT a, c FROM db0.rp0.m1 UNION ALL SELECT b AS a FROM db0.rp0.m2",
+			ddl:  createUnionTables,
+			dml:  writeUnionRows,
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{1, 2, 3, 4})
+				assert.Equal(t, results[0].Columns()[0].FloatValues(), []float64{1, 2.5, 3, 4.5})
+				assert.Equal(t, results[0].Columns()[1].IntegerValues(), []int64{10, 30})
+				assert.Equal(t, results[0].Columns()[1].NilCount(), 2)
+			},
+		},
+		{
+			name: "Union Select",
+			sql:  "SELECT a FROM db0.rp0.m1 UNION SELECT a FROM db0.rp0.m1",
+			ddl:  createUnionTables,
+			dml:  writeUnionRows,
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{1, 3})
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{1, 3})
+			},
+		},
+		{
+			name: "Union Select Limit",
+			sql:  "SELECT a FROM db0.rp0.m1 UNION SELECT a FROM db0.rp0.m1 LIMIT 2",
+			ddl:  createUnionTables,
+			dml:  writeUnionRows,
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{1, 3})
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{1, 3})
+			},
+		},
+		{
+			name: "Union All Select Limit",
+			sql:  "SELECT a FROM db0.rp0.m1 UNION ALL SELECT b AS a FROM db0.rp0.m2 LIMIT 3",
+			ddl:  createUnionTables,
+			dml:  writeUnionRows,
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{1, 2, 3})
+				assert.Equal(t, results[0].Columns()[0].FloatValues(), []float64{1, 2.5, 3})
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			tsdb := NewTSDBSystem()
@@ -177,6 +273,71 @@ func TestMockTSDBSystem(t *testing.T) {
 	}
 }
 
+func TestPrepareUnionStatement(t *testing.T) {
+	tsdb := NewTSDBSystem()
+	if err := tsdb.DDL(createUnionTables); err != nil {
+		t.Fatal(err)
+	}
+	sql := "SELECT a FROM db0.rp0.m1 WHERE a > 1 UNION ALL SELECT b AS a FROM db0.rp0.m2"
+	yaccParser := influxql.NewYyParser(influxql.NewScanner(strings.NewReader(sql)), make(map[string]interface{}))
+	yaccParser.ParseTokens()
+	q, err := yaccParser.GetQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt := q.Statements[0].(*influxql.SelectStatement)
+	prepared, err := query.Prepare(stmt, NewMockShardMapper(tsdb.catalog), query.SelectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prepared.Close()
+
+	assert.Equal(t, stmt.String(), sql)
+	assert.Equal(t, prepared.Statement().String(),
+		"SELECT a::float FROM (SELECT cast_float64(a::integer) AS a FROM db0.rp0.m1 WHERE a::integer > 1), (SELECT b::float AS a FROM db0.rp0.m2)")
+}
+
+func createUnionTables(c *Catalog) error {
+	db, err := c.CreateDatabase("db0", "rp0")
+	if err != nil {
+		return err
+	}
+	m1 := NewTable("m1")
+	m1.AddDataTypes(map[string]influxql.DataType{"t": influxql.Tag, "a": influxql.Integer, "c": influxql.Integer})
+	db.AddTable(m1)
+	m2 := NewTable("m2")
+	m2.AddDataTypes(map[string]influxql.DataType{"t": influxql.Tag, "b": influxql.Float})
+	db.AddTable(m2)
+	return nil
+}
+
+func writeUnionRows(s *Storage) error {
+	rdt1 := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "t", Type: influxql.String},
+		influxql.VarRef{Val: "a", Type: influxql.Integer}, influxql.VarRef{Val: "c", Type: influxql.Integer})
+	chunk1 := executor.NewChunkBuilder(rdt1).NewChunk("m1")
+	chunk1.AppendTimes([]int64{1, 3})
+	chunk1.Column(0).AppendStringValues([]string{"x", "x"})
+	chunk1.Column(0).AppendManyNotNil(2)
+	chunk1.Column(1).AppendIntegerValues([]int64{1, 3})
+	chunk1.Column(1).AppendManyNotNil(2)
+	chunk1.Column(2).AppendIntegerValues([]int64{10, 30})
+	chunk1.Column(2).AppendManyNotNil(2)
+	pts1 := influx.PointTags{influx.Tag{Key: "t", Value: "x"}}
+	s.Write("db0.rp0.m1", &pts1, chunk1)
+
+	rdt2 := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "t", Type: influxql.String},
+		influxql.VarRef{Val: "b", Type: influxql.Float})
+	chunk2 := executor.NewChunkBuilder(rdt2).NewChunk("m2")
+	chunk2.AppendTimes([]int64{2, 4})
+	chunk2.Column(0).AppendStringValues([]string{"y", "y"})
+	chunk2.Column(0).AppendManyNotNil(2)
+	chunk2.Column(1).AppendFloatValues([]float64{2.5, 4.5})
+	chunk2.Column(1).AppendManyNotNil(2)
+	pts2 := influx.PointTags{influx.Tag{Key: "t", Value: "y"}}
+	s.Write("db0.rp0.m2", &pts2, chunk2)
+	return nil
+}
+
 func TestSelectInto(t *testing.T) {
 	for _, tc := range []struct {
 		name          string
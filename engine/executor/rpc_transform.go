@@ -21,6 +21,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor/spdy"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/bufferpool"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -49,6 +50,10 @@ type RPCReaderTransform struct {
 	span       *tracing.Span
 	outputSpan *tracing.Span
 	queryId    uint64
+
+	memTracker *resourcegroup.MemTracker
+	// sizes of the chunks sent to the output port, which may not be consumed yet
+	sentSizes []int64
 }
 
 func (t *RPCReaderTransform) IsSink() bool {
@@ -115,6 +120,7 @@ func (t *RPCReaderTransform) Work(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.memTracker = resourcegroup.MemTrackerFromContext(ctx)
 	client := &t.client
 	client.Init(ctx, queryNode)
 	client.StartAnalyze(t.BaseSpan())
@@ -170,17 +176,42 @@ func (t *RPCReaderTransform) chunkResponse(data interface{}) error {
 	chunk.SetRowDataType(t.Output.RowDataType)
 
 	statistics.ExecutorStat.SourceRows.Push(int64(chunk.NumberOfRows()))
+	size := int64(chunk.Size())
+	if err := t.memTracker.Grow(size); err != nil {
+		t.memTracker.Shrink(size)
+		return err
+	}
 
 	tracing.StartPP(t.outputSpan)
 	select {
 	case t.Output.State <- chunk:
+		t.releaseConsumed(size)
 	case <-t.abortSignal:
+		t.memTracker.Shrink(size)
 	}
 	tracing.EndPP(t.outputSpan)
 
 	return nil
 }
 
+// releaseConsumed gives back the memory of the chunks the next processor has finished with.
+// Once a chunk is sent, the port buffers at most cap(State) chunks and the next processor works on one more,
+// so the chunks sent before them have been consumed.
+func (t *RPCReaderTransform) releaseConsumed(size int64) {
+	if t.memTracker == nil {
+		return
+	}
+	t.sentSizes = append(t.sentSizes, size)
+	n := len(t.sentSizes) - cap(t.Output.State) - 1
+	if n <= 0 {
+		return
+	}
+	for _, s := range t.sentSizes[:n] {
+		t.memTracker.Shrink(s)
+	}
+	t.sentSizes = append(t.sentSizes[:0], t.sentSizes[n:]...)
+}
+
 type RPCSenderTransform struct {
 	BaseProcessor
 
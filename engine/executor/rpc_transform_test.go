@@ -0,0 +1,78 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCReaderTransform_ReleaseConsumedChunks(t *testing.T) {
+	rt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value", Type: influxql.Integer})
+	newChunk := func() Chunk {
+		chunk := NewChunkBuilder(rt).NewChunk("mst")
+		chunk.AppendTimes([]int64{1, 2, 3})
+		chunk.Column(0).AppendIntegerValues([]int64{1, 2, 3})
+		chunk.Column(0).AppendManyNotNil(3)
+		return chunk
+	}
+	size := int64(newChunk().Size())
+
+	group := resourcegroup.NewManager(config.ResourceGroups{{Name: "g0", MaxQueryMem: toml.Size(3 * size), Users: []string{"u0"}}}).Match("u0", "")
+	tracker := group.NewMemTracker()
+	trans := NewRPCReaderTransform(rt, 0, &RemoteQuery{})
+	trans.memTracker = tracker
+	trans.Output.Connect(NewChunkPort(rt))
+
+	// the port buffers one chunk and the next processor works on another one, the chunks before them are released
+	for i := 0; i < 10; i++ {
+		require.NoError(t, trans.chunkResponse(newChunk()))
+		<-trans.Output.State
+		expected := int64(i+1) * size
+		if expected > 2*size {
+			expected = 2 * size
+		}
+		require.Equal(t, expected, tracker.Used())
+		require.Equal(t, expected, group.Stat().MemUsed)
+	}
+
+	tracker.Close()
+	require.Equal(t, int64(0), group.Stat().MemUsed)
+
+	// the chunk is given back if it is not sent because the query is aborted
+	trans = NewRPCReaderTransform(rt, 0, &RemoteQuery{})
+	trans.memTracker = group.NewMemTracker()
+	trans.Output.Connect(NewChunkPort(rt))
+	trans.Output.State <- newChunk()
+	close(trans.abortSignal)
+	require.NoError(t, trans.chunkResponse(newChunk()))
+	require.Equal(t, int64(0), trans.memTracker.Used())
+
+	// the chunks held by the next processor exceed the budget
+	trans = NewRPCReaderTransform(rt, 0, &RemoteQuery{})
+	trans.memTracker = group.NewMemTracker()
+	trans.Output.Connect(NewChunkPort(rt))
+	require.NoError(t, trans.memTracker.Grow(2*size+1))
+	err := trans.chunkResponse(newChunk())
+	require.True(t, errno.Equal(err, errno.ResourceGroupMemoryExceeded))
+	require.Equal(t, 2*size+1, trans.memTracker.Used())
+}
@@ -182,6 +182,7 @@ type QuerySchema struct {
 	promTimeCalls map[string]*influxql.Call
 	slidingWindow map[string]*influxql.Call
 	holtWinters   []*influxql.Field
+	windows       []*influxql.Field
 	compositeCall map[string]*hybridqp.OGSketchCompositeOperator
 	// promNestedCall is used to optimize the nested push down of function and aggregate operator
 	promNestedCall map[string]*hybridqp.PromNestedCall
@@ -283,13 +284,14 @@ func (qs *QuerySchema) reset(fields influxql.Fields, column []string) {
 	qs.slidingWindow = make(map[string]*influxql.Call)
 	qs.promNestedCall = make(map[string]*hybridqp.PromNestedCall)
 	qs.holtWinters = qs.holtWinters[0:0]
+	qs.windows = qs.windows[0:0]
 	qs.unnestCases = qs.unnestCases[:0]
 	qs.i = 0
 	qs.init()
 }
 
 func (qs *QuerySchema) init() {
-	for _, f := range qs.queryFields {
+	for i, f := range qs.queryFields {
 		clone := qs.CloneField(f)
 		if call, ok := clone.Expr.(*influxql.Call); ok {
 			if call.Name == "sliding_window" {
@@ -298,6 +300,11 @@ func (qs *QuerySchema) init() {
 			} else if call.Name == "holt_winters" || call.Name == "holt_winters_with_fit" {
 				qs.AddHoltWinters(call, f.Alias)
 				clone.Expr = call.Args[0]
+			} else if query.IsWindowCall(call) {
+				// the window function is evaluated on the rows of its argument. The functions without
+				// arguments, such as row_number(), are evaluated on the rows of another field.
+				qs.AddWindow(call, qs.columnNames[i])
+				clone.Expr = qs.windowArg(call)
 			}
 		}
 		clone.Expr = qs.rewriteBaseCallTransformExprCall(clone.Expr)
@@ -534,7 +541,7 @@ func (qs *QuerySchema) CanLimitCut() bool {
 func (qs *QuerySchema) CountField() map[int]bool {
 	fieldColIdx := make(map[int]bool)
 	for i, f := range qs.queryFields {
-		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == "count" {
+		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == "count" && c.Over == nil {
 			fieldColIdx[i] = true
 		}
 	}
@@ -543,7 +550,7 @@ func (qs *QuerySchema) CountField() map[int]bool {
 
 func (qs *QuerySchema) HasMeanCall() bool {
 	for _, f := range qs.queryFields {
-		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == "mean" {
+		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == "mean" && c.Over == nil {
 			return true
 		}
 	}
@@ -946,6 +953,49 @@ func (qs *QuerySchema) AddHoltWinters(call *influxql.Call, alias string) {
 	qs.holtWinters = append(qs.holtWinters, f)
 }
 
+func (qs *QuerySchema) AddWindow(call *influxql.Call, column string) {
+	f := &influxql.Field{
+		Expr:  call,
+		Alias: column,
+	}
+	qs.windows = append(qs.windows, f)
+}
+
+func (qs *QuerySchema) windowArg(call *influxql.Call) influxql.Expr {
+	if len(call.Args) > 0 {
+		return call.Args[0]
+	}
+	for _, f := range qs.queryFields {
+		c, ok := f.Expr.(*influxql.Call)
+		if !ok || !query.IsWindowCall(c) {
+			return influxql.CloneExpr(f.Expr)
+		}
+		if len(c.Args) > 0 {
+			return influxql.CloneExpr(c.Args[0])
+		}
+	}
+	panic(fmt.Sprintf("no field for the window function %s", call))
+}
+
+// Windows returns the window functions, whose aliases are the names of their columns.
+func (qs *QuerySchema) Windows() []*influxql.Field {
+	return qs.windows
+}
+
+func (qs *QuerySchema) HasWindowCall() bool {
+	return len(qs.windows) > 0
+}
+
+// WindowCall returns the window function of the column.
+func (qs *QuerySchema) WindowCall(column string) *influxql.Call {
+	for _, w := range qs.windows {
+		if w.Alias == column {
+			return w.Expr.(*influxql.Call)
+		}
+	}
+	return nil
+}
+
 func (qs *QuerySchema) Visit(n influxql.Node) influxql.Visitor {
 	expr, ok := n.(influxql.Expr)
 	if !ok {
@@ -1147,7 +1197,7 @@ func (qs *QuerySchema) OnlyOneCallRef() *influxql.VarRef {
 		if qs.MatchPreAgg() {
 			for i := range qs.queryFields {
 				f := qs.queryFields[i]
-				if call, ok := f.Expr.(*influxql.Call); ok {
+				if call, ok := f.Expr.(*influxql.Call); ok && call.Over == nil {
 					return call.Args[0].(*influxql.VarRef)
 				}
 			}
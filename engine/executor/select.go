@@ -167,6 +167,7 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 	}
 	var mstsReqs []*MultiMstReqs = make([]*MultiMstReqs, 0)
 	ctx = context.WithValue(ctx, NowKey, p.now)
+	sources := p.stmt.Sources
 
 	opt, ok := p.opt.(*query.ProcessorOptions)
 	if !ok {
@@ -176,13 +177,13 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 
 	rewriteVarfName(p.stmt.Fields)
 
-	schema := NewQuerySchemaWithJoinCase(p.stmt.Fields, p.stmt.Sources, p.stmt.ColumnNames(), opt, p.stmt.JoinSource,
-		p.stmt.UnnestSource, p.stmt.SortFields)
-	schema.SetPromCalls(p.stmt.PromSubCalls)
+	schema := p.newQuerySchema(sources, opt)
 
 	HaveOnlyCSStore := schema.Sources().HaveOnlyCSStore()
+	costBased := p.canCostBasedOptimize(schema, HaveOnlyCSStore)
 	planType := GetPlanType(schema, p.stmt)
-	if planType != UNKNOWN {
+	// the template plans are not compared with the alternative plans by the cost based optimizer
+	if planType != UNKNOWN && !costBased {
 		if p != nil && !HaveOnlyCSStore {
 			var templatePlan []hybridqp.QueryNode
 			if localStorageForQuery != nil {
@@ -215,6 +216,10 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 	planner.SetRoot(plan)
 	best := planner.FindBestExp()
 
+	if costBased {
+		best = p.cheapestPlan(ctx, sources, best, &mstsReqs)
+	}
+
 	if HaveOnlyCSStore {
 		if schema.Options().IsUnifyPlan() {
 			if best.Schema().HasCall() {
@@ -236,6 +241,13 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 	return best, mstsReqs, nil
 }
 
+func (p *preparedStatement) newQuerySchema(sources influxql.Sources, opt *query.ProcessorOptions) *QuerySchema {
+	schema := NewQuerySchemaWithJoinCase(p.stmt.Fields, sources, p.stmt.ColumnNames(), opt, p.stmt.JoinSource,
+		p.stmt.UnnestSource, p.stmt.SortFields)
+	schema.SetPromCalls(p.stmt.PromSubCalls)
+	return schema
+}
+
 func (p *preparedStatement) Select(ctx context.Context) (hybridqp.Executor, error) {
 	best, req, err := p.BuildLogicalPlan(ctx)
 	if err != nil {
@@ -286,10 +298,6 @@ func (p *preparedStatement) ChangeOptimizer(optimizer hybridqp.ExecutorBuilderOp
 	p.optimizer = optimizer
 }
 
-func (p *preparedStatement) Explain() (string, error) {
-	return "", nil
-}
-
 func (p *preparedStatement) Close() error {
 	return p.qc.Close()
 }
@@ -608,6 +616,16 @@ func buildNodes(builder *LogicalPlanBuilderImpl, schema hybridqp.Catalog, s *Que
 		builder.Fill()
 	}
 
+	// the window functions are evaluated on the filled rows
+	if schema.HasWindowCall() {
+		builder.Window()
+	}
+
+	// the duplicate rows of UNION are removed before the limit
+	if s.opt.(*query.ProcessorOptions).Dedupe {
+		builder.Dedupe()
+	}
+
 	if hasSort && (HaveOnlyCSStore || isSubQuery) {
 		builder.Sort()
 	}
@@ -0,0 +1,673 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// windowPartition buffers the rows of the current partition which are still needed
+// by the window functions. The rows are indexed from the start of the partition.
+type windowPartition struct {
+	ascending bool
+	closed    bool
+	// base is the index of the first buffered row
+	base  int
+	times []int64
+	// values are the arguments of the window functions of the buffered rows
+	values [][]interface{}
+}
+
+func (p *windowPartition) reset(columns int) {
+	p.closed = false
+	p.base = 0
+	p.times = p.times[:0]
+	if len(p.values) != columns {
+		p.values = make([][]interface{}, columns)
+	}
+	for i := range p.values {
+		p.values[i] = p.values[i][:0]
+	}
+}
+
+// rows returns the number of the rows of the partition.
+func (p *windowPartition) rows() int {
+	return p.base + len(p.times)
+}
+
+func (p *windowPartition) time(i int) int64 {
+	return p.times[i-p.base]
+}
+
+func (p *windowPartition) value(column, i int) interface{} {
+	return p.values[column][i-p.base]
+}
+
+// distance returns how far the row j is after the row i in the order of the partition.
+func (p *windowPartition) distance(i, j int) int64 {
+	if p.ascending {
+		return p.time(j) - p.time(i)
+	}
+	return p.time(i) - p.time(j)
+}
+
+// trim drops the rows before the row i.
+func (p *windowPartition) trim(i int) {
+	drop := i - p.base
+	if drop <= 0 {
+		return
+	}
+	n := copy(p.times, p.times[drop:])
+	p.times = p.times[:n]
+	for c := range p.values {
+		n = copy(p.values[c], p.values[c][drop:])
+		p.values[c] = p.values[c][:n]
+	}
+	p.base = i
+}
+
+// windowColumn evaluates a window function over the rows of the partition in order.
+type windowColumn struct {
+	index   int
+	call    *influxql.Call
+	frame   influxql.WindowFrame
+	argType influxql.DataType
+	outType influxql.DataType
+
+	// offset and def are the arguments of lag() and lead()
+	offset int
+	def    interface{}
+
+	// next is the index of the next row to be evaluated
+	next int
+	// the rows in [lo, hi] have been added into the frame
+	lo, hi int
+
+	lastTime   int64
+	rank       int64
+	denseRank  int64
+	count      int64
+	sumInt     int64
+	sumFloat   float64
+	firstValue interface{}
+	lastValue  interface{}
+	// deque keeps the candidates of min() and max() in the frame
+	deque []windowItem
+
+	results []interface{}
+}
+
+func newWindowColumn(index int, call *influxql.Call, argType influxql.DataType) (*windowColumn, error) {
+	w := &windowColumn{
+		index:   index,
+		call:    call,
+		frame:   query.WindowFrame(call.Over),
+		argType: argType,
+		outType: query.WindowFunctionType(call.Name, argType),
+		offset:  1,
+	}
+	w.reset()
+
+	switch call.Name {
+	case "sum", "mean", "min", "max":
+		if argType != influxql.Float && argType != influxql.Integer && argType != influxql.Unknown {
+			return nil, fmt.Errorf("unsupported data type %s for window function %s()", argType, call.Name)
+		}
+	case "lag", "lead":
+		if len(call.Args) > 1 {
+			w.offset = int(call.Args[1].(*influxql.IntegerLiteral).Val)
+		}
+		if len(call.Args) > 2 {
+			def, err := windowDefaultValue(call.Args[2], argType)
+			if err != nil {
+				return nil, fmt.Errorf("%s(): %s", call.Name, err)
+			}
+			w.def = def
+		}
+	}
+	return w, nil
+}
+
+// windowDefaultValue converts the default value of lag() and lead() to the type of the argument.
+func windowDefaultValue(expr influxql.Expr, typ influxql.DataType) (interface{}, error) {
+	switch lit := expr.(type) {
+	case *influxql.NilLiteral:
+		return nil, nil
+	case *influxql.IntegerLiteral:
+		switch typ {
+		case influxql.Integer:
+			return lit.Val, nil
+		case influxql.Float:
+			return float64(lit.Val), nil
+		}
+	case *influxql.NumberLiteral:
+		if typ == influxql.Float {
+			return lit.Val, nil
+		}
+	case *influxql.StringLiteral:
+		if typ == influxql.String || typ == influxql.Tag {
+			return lit.Val, nil
+		}
+	case *influxql.BooleanLiteral:
+		if typ == influxql.Boolean {
+			return lit.Val, nil
+		}
+	}
+	if typ == influxql.Unknown {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("default value %s does not match the data type %s", expr, typ)
+}
+
+func (w *windowColumn) reset() {
+	w.next = 0
+	w.lo, w.hi = 0, -1
+	w.rank, w.denseRank = 0, 0
+	w.count, w.sumInt, w.sumFloat = 0, 0, 0
+	w.firstValue, w.lastValue = nil, nil
+	w.deque = w.deque[:0]
+}
+
+// ready returns true if all the rows needed by the row i have been buffered.
+func (w *windowColumn) ready(p *windowPartition, i int) bool {
+	if p.closed {
+		return true
+	}
+	switch w.call.Name {
+	case "lead":
+		return i+w.offset < p.rows()
+	case "row_number", "rank", "dense_rank", "lag":
+		return true
+	}
+
+	last := p.rows() - 1
+	switch w.frame.End.Type {
+	case influxql.UnboundedFollowing:
+		return false
+	case influxql.Following:
+		if w.frame.Range {
+			return p.distance(i, last) > w.frame.End.Offset
+		}
+		return i+int(w.frame.End.Offset) <= last
+	case influxql.CurrentRow:
+		if w.frame.Range {
+			return p.distance(i, last) > 0
+		}
+	}
+	return true
+}
+
+// bounds returns the first and the last row of the frame of the row i.
+func (w *windowColumn) bounds(p *windowPartition, i int) (int, int) {
+	lo, hi := 0, p.rows()-1
+	switch w.frame.Start.Type {
+	case influxql.Preceding, influxql.CurrentRow:
+		if w.frame.Range {
+			lo = w.lo
+			for lo < i && p.distance(lo, i) > w.frame.Start.Offset {
+				lo++
+			}
+		} else {
+			lo = i - int(w.frame.Start.Offset)
+		}
+	case influxql.Following:
+		if w.frame.Range {
+			lo = i
+			if w.lo > lo {
+				lo = w.lo
+			}
+			for lo <= hi && p.distance(i, lo) < w.frame.Start.Offset {
+				lo++
+			}
+		} else {
+			lo = i + int(w.frame.Start.Offset)
+		}
+	}
+
+	switch w.frame.End.Type {
+	case influxql.Preceding:
+		if w.frame.Range {
+			end := i
+			for end > w.hi && p.distance(end, i) < w.frame.End.Offset {
+				end--
+			}
+			hi = end
+		} else {
+			hi = i - int(w.frame.End.Offset)
+		}
+	case influxql.CurrentRow, influxql.Following:
+		if w.frame.Range {
+			end := i
+			if w.hi > end {
+				end = w.hi
+			}
+			for end+1 <= hi && p.distance(i, end+1) <= w.frame.End.Offset {
+				end++
+			}
+			hi = end
+		} else if end := i + int(w.frame.End.Offset); end < hi {
+			hi = end
+		}
+	}
+	if lo < 0 {
+		lo = 0
+	}
+	return lo, hi
+}
+
+// keep returns the first row which is still needed by the window function.
+func (w *windowColumn) keep() int {
+	switch w.call.Name {
+	case "lag":
+		return w.next - w.offset
+	case "row_number", "rank", "dense_rank", "lead":
+		return w.next
+	}
+	keep := w.next
+	if w.hi+1 < keep {
+		keep = w.hi + 1
+	}
+	if w.frame.Start.Type != influxql.UnboundedPreceding && w.lo < keep {
+		keep = w.lo
+	}
+	return keep
+}
+
+// evaluate computes the window function of the rows which are ready.
+func (w *windowColumn) evaluate(p *windowPartition, column int) {
+	for ; w.next < p.rows() && w.ready(p, w.next); w.next++ {
+		w.results = append(w.results, w.compute(p, column, w.next))
+	}
+}
+
+func (w *windowColumn) compute(p *windowPartition, column, i int) interface{} {
+	switch w.call.Name {
+	case "row_number":
+		return int64(i + 1)
+	case "rank", "dense_rank":
+		if i == 0 || p.time(i) != w.lastTime {
+			w.rank = int64(i + 1)
+			w.denseRank++
+			w.lastTime = p.time(i)
+		}
+		if w.call.Name == "rank" {
+			return w.rank
+		}
+		return w.denseRank
+	case "lag":
+		if i-w.offset < 0 {
+			return w.def
+		}
+		return p.value(column, i-w.offset)
+	case "lead":
+		if i+w.offset >= p.rows() {
+			return w.def
+		}
+		return p.value(column, i+w.offset)
+	}
+
+	lo, hi := w.bounds(p, i)
+	for w.hi < hi {
+		w.hi++
+		if w.hi >= w.lo {
+			w.add(p.value(column, w.hi), w.hi)
+		}
+	}
+	for w.lo < lo {
+		if w.lo <= w.hi {
+			w.remove(p.value(column, w.lo), w.lo)
+		}
+		w.lo++
+	}
+	if w.lo > w.hi {
+		if w.call.Name == "count" {
+			return int64(0)
+		}
+		return nil
+	}
+
+	switch w.call.Name {
+	case "first_value":
+		if w.frame.Start.Type == influxql.UnboundedPreceding {
+			return w.firstValue
+		}
+		return p.value(column, w.lo)
+	case "last_value":
+		return w.lastValue
+	case "count":
+		return w.count
+	}
+	if w.count == 0 {
+		return nil
+	}
+	switch w.call.Name {
+	case "sum":
+		if w.argType == influxql.Integer {
+			return w.sumInt
+		}
+		return w.sumFloat
+	case "mean":
+		if w.argType == influxql.Integer {
+			return float64(w.sumInt) / float64(w.count)
+		}
+		return w.sumFloat / float64(w.count)
+	default:
+		return w.deque[0].value
+	}
+}
+
+func (w *windowColumn) add(v interface{}, i int) {
+	if i == 0 {
+		w.firstValue = v
+	}
+	w.lastValue = v
+	if v == nil {
+		return
+	}
+	w.count++
+	switch val := v.(type) {
+	case int64:
+		w.sumInt += val
+	case float64:
+		w.sumFloat += val
+	}
+	if w.call.Name == "min" || w.call.Name == "max" {
+		for len(w.deque) > 0 && !w.prior(w.deque[len(w.deque)-1].value, v) {
+			w.deque = w.deque[:len(w.deque)-1]
+		}
+		w.deque = append(w.deque, windowItem{index: i, value: v})
+	}
+}
+
+func (w *windowColumn) remove(v interface{}, i int) {
+	if v == nil {
+		return
+	}
+	w.count--
+	switch val := v.(type) {
+	case int64:
+		w.sumInt -= val
+	case float64:
+		w.sumFloat -= val
+	}
+	if len(w.deque) > 0 && w.deque[0].index == i {
+		w.deque = w.deque[1:]
+	}
+}
+
+// prior returns true if a is strictly prior to b for min() or max().
+func (w *windowColumn) prior(a, b interface{}) bool {
+	var less bool
+	switch va := a.(type) {
+	case int64:
+		less = va < b.(int64)
+		if va == b.(int64) {
+			return false
+		}
+	case float64:
+		less = va < b.(float64)
+		if va == b.(float64) {
+			return false
+		}
+	}
+	if w.call.Name == "min" {
+		return less
+	}
+	return !less
+}
+
+type windowItem struct {
+	index int
+	value interface{}
+}
+
+type windowChunk struct {
+	chunk Chunk
+	// end is the sequence number following the last row of the chunk
+	end int
+}
+
+// WindowTransform evaluates the window functions of the rows. The rows of a group are a partition,
+// and a chunk is sent once the window functions of all its rows are evaluated.
+type WindowTransform struct {
+	BaseProcessor
+
+	Input  ChunkPort
+	Output ChunkPort
+
+	schema    hybridqp.Catalog
+	opt       *query.ProcessorOptions
+	columns   []*windowColumn
+	partition windowPartition
+	tags      string
+	started   bool
+
+	// seq is the sequence number of the first row of the partition
+	seq int
+	// done is the sequence number of the first row which is not evaluated
+	done   int
+	chunks []windowChunk
+	rows   int
+
+	windowCost *tracing.Span
+}
+
+func NewWindowTransform(
+	inRowDataType, outRowDataType hybridqp.RowDataType, opt *query.ProcessorOptions, schema hybridqp.Catalog,
+) (*WindowTransform, error) {
+	trans := &WindowTransform{
+		Input:     *NewChunkPort(inRowDataType),
+		Output:    *NewChunkPort(outRowDataType),
+		schema:    schema,
+		opt:       opt,
+		partition: windowPartition{ascending: opt.Ascending},
+	}
+	for _, f := range schema.Windows() {
+		index := inRowDataType.FieldIndex(f.Alias)
+		if index < 0 {
+			return nil, fmt.Errorf("unknown column %s of window function", f.Alias)
+		}
+		argType := inRowDataType.Field(index).Expr.(*influxql.VarRef).Type
+		call := f.Expr.(*influxql.Call)
+		if len(call.Args) == 0 {
+			argType = influxql.Unknown
+		}
+		column, err := newWindowColumn(index, call, argType)
+		if err != nil {
+			return nil, err
+		}
+		trans.columns = append(trans.columns, column)
+	}
+	trans.partition.reset(len(trans.columns))
+	return trans, nil
+}
+
+type WindowTransformCreator struct {
+}
+
+func (c *WindowTransformCreator) Create(plan LogicalPlan, opt *query.ProcessorOptions) (Processor, error) {
+	p, err := NewWindowTransform(plan.Children()[0].RowDataType(), plan.RowDataType(), opt, plan.Schema())
+	return p, err
+}
+
+var _ = RegistryTransformCreator(&LogicalWindow{}, &WindowTransformCreator{})
+
+func (trans *WindowTransform) Work(ctx context.Context) error {
+	span := trans.StartSpan("[Window]TotalWorkCost", false)
+	trans.windowCost = tracing.Start(span, "window_cost", false)
+	defer func() {
+		tracing.Finish(span, trans.windowCost)
+	}()
+	for {
+		select {
+		case c, ok := <-trans.Input.State:
+			tracing.StartPP(span)
+			if !ok {
+				trans.closePartition()
+				trans.sendChunks()
+				trans.Close()
+				return nil
+			}
+			trans.addChunk(c)
+			tracing.EndPP(span)
+		case <-ctx.Done():
+			trans.Close()
+			return nil
+		}
+	}
+}
+
+// addChunk buffers the rows of the chunk. The chunk is cloned because the upstream may reuse it.
+func (trans *WindowTransform) addChunk(c Chunk) {
+	if c.NumberOfRows() == 0 {
+		return
+	}
+	chunk := c.Clone()
+	tags, tagIndex := chunk.Tags(), chunk.TagIndex()
+	if len(tagIndex) == 0 {
+		tags, tagIndex = []ChunkTags{{}}, []int{0}
+	}
+	for i := range tagIndex {
+		start, end := tagIndex[i], chunk.NumberOfRows()
+		if i+1 < len(tagIndex) {
+			end = tagIndex[i+1]
+		}
+		key := string(tags[i].GetTag())
+		if !trans.started || key != trans.tags {
+			trans.closePartition()
+			trans.tags = key
+			trans.started = true
+		}
+		trans.addRows(chunk, start, end)
+	}
+	trans.rows += chunk.NumberOfRows()
+	trans.chunks = append(trans.chunks, windowChunk{chunk: chunk, end: trans.rows})
+	trans.evaluate()
+	trans.sendChunks()
+}
+
+func (trans *WindowTransform) addRows(chunk Chunk, start, end int) {
+	p := &trans.partition
+	p.times = append(p.times, chunk.Time()[start:end]...)
+	for i, w := range trans.columns {
+		column := chunk.Column(w.index)
+		for j := start; j < end; j++ {
+			var v interface{}
+			if w.argType != influxql.Unknown && !column.IsNilV2(j) {
+				v = getRowValue(column, column.GetValueIndexV2(j))
+			}
+			p.values[i] = append(p.values[i], v)
+		}
+	}
+}
+
+// evaluate computes the window functions of the rows which are ready, and drops the rows
+// which are no longer needed.
+func (trans *WindowTransform) evaluate() {
+	p := &trans.partition
+	done, keep := p.rows(), p.rows()
+	for i, w := range trans.columns {
+		w.evaluate(p, i)
+		if w.next < done {
+			done = w.next
+		}
+		if k := w.keep(); k < keep {
+			keep = k
+		}
+	}
+	p.trim(keep)
+	trans.done = trans.seq + done
+}
+
+func (trans *WindowTransform) closePartition() {
+	if !trans.started {
+		return
+	}
+	trans.partition.closed = true
+	trans.evaluate()
+	trans.seq += trans.partition.rows()
+	trans.partition.reset(len(trans.columns))
+	for _, w := range trans.columns {
+		w.reset()
+	}
+}
+
+// sendChunks sends the chunks whose rows are all evaluated.
+func (trans *WindowTransform) sendChunks() {
+	for len(trans.chunks) > 0 && trans.chunks[0].end <= trans.done {
+		chunk := trans.chunks[0].chunk
+		rows := chunk.NumberOfRows()
+		for _, w := range trans.columns {
+			column := NewColumnImpl(w.outType)
+			for _, v := range w.results[:rows] {
+				if v == nil {
+					column.AppendNil()
+					continue
+				}
+				AppendRowValue(column, v)
+				column.AppendNotNil()
+			}
+			w.results = w.results[rows:]
+			chunk.SetColumn(column, w.index)
+		}
+		chunk.SetRowDataType(trans.Output.RowDataType)
+		trans.chunks = trans.chunks[1:]
+		trans.Output.State <- chunk
+	}
+}
+
+func (trans *WindowTransform) Name() string {
+	return "WindowTransform"
+}
+
+func (trans *WindowTransform) Explain() []ValuePair {
+	return nil
+}
+
+func (trans *WindowTransform) Close() {
+	trans.Output.Close()
+}
+
+func (trans *WindowTransform) GetOutputs() Ports {
+	ports := make(Ports, 0, 1)
+	ports = append(ports, &trans.Output)
+	return ports
+}
+
+func (trans *WindowTransform) GetInputs() Ports {
+	ports := make(Ports, 0, 1)
+	ports = append(ports, &trans.Input)
+	return ports
+}
+
+func (trans *WindowTransform) GetOutputNumber(port Port) int {
+	if &trans.Output == port {
+		return 0
+	}
+	return INVALID_NUMBER
+}
+
+func (trans *WindowTransform) GetInputNumber(port Port) int {
+	if &trans.Input == port {
+		return 0
+	}
+	return INVALID_NUMBER
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildWindowInChunk(rt hybridqp.RowDataType, times []int64, tags []string, tagIndex []int, values []interface{}) executor.Chunk {
+	chunk := executor.NewChunkBuilder(rt).NewChunk("cpu")
+	for i := range times {
+		chunk.AppendTime(times[i] * 1e9)
+	}
+	for i := range tags {
+		chunk.AddTagAndIndex(*ParseChunkTags("host=" + tags[i]), tagIndex[i])
+	}
+	chunk.AddIntervalIndex(0)
+	for c := 0; c < chunk.NumberOfCols(); c++ {
+		for _, v := range values {
+			if v == nil {
+				chunk.Column(c).AppendNil()
+				continue
+			}
+			chunk.Column(c).AppendFloatValue(v.(float64))
+			chunk.Column(c).AppendNotNil()
+		}
+	}
+	return chunk
+}
+
+func runWindowTransform(t *testing.T, exprs []string, chunks func(rt hybridqp.RowDataType) []executor.Chunk) [][]interface{} {
+	opt := &query.ProcessorOptions{
+		ChunkSize:  1024,
+		Dimensions: []string{"host"},
+		Ascending:  true,
+	}
+	schema := executor.NewQuerySchema(nil, nil, opt, nil)
+	refs := make([]influxql.VarRef, 0, len(exprs))
+	for i, s := range exprs {
+		column := fmt.Sprintf("w%d", i)
+		schema.AddWindow(influxql.MustParseExpr(s).(*influxql.Call), column)
+		refs = append(refs, influxql.VarRef{Val: column, Type: influxql.Float})
+	}
+	inRowDataType := hybridqp.NewRowDataTypeImpl(refs...)
+
+	trans, err := executor.NewWindowTransform(inRowDataType, inRowDataType, opt, schema)
+	require.NoError(t, err)
+	source := NewSourceFromMultiChunk(inRowDataType, chunks(inRowDataType))
+
+	rows := make([][]interface{}, len(exprs))
+	sink := NewSinkFromFunction(inRowDataType, func(chunk executor.Chunk) error {
+		for i := range exprs {
+			column := chunk.Column(i)
+			for j := 0; j < chunk.NumberOfRows(); j++ {
+				if column.IsNilV2(j) {
+					rows[i] = append(rows[i], nil)
+					continue
+				}
+				index := column.GetValueIndexV2(j)
+				switch column.DataType() {
+				case influxql.Integer:
+					rows[i] = append(rows[i], column.IntegerValue(index))
+				case influxql.Float:
+					rows[i] = append(rows[i], column.FloatValue(index))
+				}
+			}
+		}
+		return nil
+	})
+	executor.Connect(source.Output, trans.GetInputs()[0])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	processors := executor.Processors{source, trans, sink}
+	executors := executor.NewPipelineExecutor(processors)
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	return rows
+}
+
+func TestWindowTransform(t *testing.T) {
+	exprs := []string{
+		"row_number() OVER (PARTITION BY host ORDER BY time)",
+		"rank() OVER (PARTITION BY host ORDER BY time)",
+		"dense_rank() OVER (PARTITION BY host ORDER BY time)",
+		"lag(value) OVER (PARTITION BY host ORDER BY time)",
+		"lead(value, 1, 0) OVER (PARTITION BY host ORDER BY time)",
+		"sum(value) OVER (PARTITION BY host ORDER BY time)",
+		"sum(value) OVER (PARTITION BY host ORDER BY time ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)",
+		"count(value) OVER (PARTITION BY host ORDER BY time RANGE BETWEEN 1s PRECEDING AND 1s FOLLOWING)",
+		"max(value) OVER (PARTITION BY host)",
+		"first_value(value) OVER (PARTITION BY host ORDER BY time ROWS BETWEEN 1 FOLLOWING AND 2 FOLLOWING)",
+		"mean(value) OVER (PARTITION BY host ORDER BY time ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING)",
+		"min(value) OVER (PARTITION BY host ORDER BY time ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)",
+	}
+	// host=a: time 1, 2, 2, 3, 5 and value 1, 2, 3, null, 5; host=b: time 1, 2 and value 10, 20
+	rows := runWindowTransform(t, exprs, func(rt hybridqp.RowDataType) []executor.Chunk {
+		return []executor.Chunk{
+			buildWindowInChunk(rt, []int64{1, 2, 2}, []string{"a"}, []int{0}, []interface{}{1.0, 2.0, 3.0}),
+			buildWindowInChunk(rt, []int64{3, 5, 1}, []string{"a", "b"}, []int{0, 2}, []interface{}{nil, 5.0, 10.0}),
+			buildWindowInChunk(rt, []int64{2}, []string{"b"}, []int{0}, []interface{}{20.0}),
+		}
+	})
+
+	expected := [][]interface{}{
+		{int64(1), int64(2), int64(3), int64(4), int64(5), int64(1), int64(2)},
+		{int64(1), int64(2), int64(2), int64(4), int64(5), int64(1), int64(2)},
+		{int64(1), int64(2), int64(2), int64(3), int64(4), int64(1), int64(2)},
+		{nil, 1.0, 2.0, 3.0, nil, nil, 10.0},
+		{2.0, 3.0, nil, 5.0, 0.0, 20.0, 0.0},
+		{1.0, 6.0, 6.0, 6.0, 11.0, 10.0, 30.0},
+		{1.0, 3.0, 5.0, 3.0, 5.0, 10.0, 30.0},
+		{int64(3), int64(3), int64(3), int64(2), int64(1), int64(2), int64(2)},
+		{5.0, 5.0, 5.0, 5.0, 5.0, 20.0, 20.0},
+		{2.0, 3.0, nil, 5.0, nil, 20.0, nil},
+		{nil, 1.0, 1.5, 2.0, 2.0, nil, 10.0},
+		{1.0, 2.0, 3.0, 5.0, 5.0, 10.0, 20.0},
+	}
+	for i := range exprs {
+		assert.Equal(t, expected[i], rows[i], exprs[i])
+	}
+}
+
+func TestWindowTransformDescending(t *testing.T) {
+	opt := &query.ProcessorOptions{ChunkSize: 1024, Ascending: false}
+	schema := executor.NewQuerySchema(nil, nil, opt, nil)
+	schema.AddWindow(influxql.MustParseExpr("sum(value) OVER (ORDER BY time DESC RANGE BETWEEN 1s PRECEDING AND CURRENT ROW)").(*influxql.Call), "w0")
+	rt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "w0", Type: influxql.Float})
+	trans, err := executor.NewWindowTransform(rt, rt, opt, schema)
+	require.NoError(t, err)
+
+	var values []float64
+	source := NewSourceFromMultiChunk(rt, []executor.Chunk{
+		buildWindowInChunk(rt, []int64{5, 4, 2}, []string{""}, []int{0}, []interface{}{1.0, 2.0, 3.0}),
+		buildWindowInChunk(rt, []int64{1}, []string{""}, []int{0}, []interface{}{4.0}),
+	})
+	sink := NewSinkFromFunction(rt, func(chunk executor.Chunk) error {
+		values = append(values, chunk.Column(0).FloatValues()...)
+		return nil
+	})
+	executor.Connect(source.Output, trans.GetInputs()[0])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	assert.Equal(t, []float64{1, 3, 3, 7}, values)
+}
+
+func TestWindowTransformError(t *testing.T) {
+	opt := &query.ProcessorOptions{ChunkSize: 1024}
+	rt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "w0", Type: influxql.String})
+	for _, s := range []string{
+		"sum(value) OVER ()",
+		"lag(value, 1, 1) OVER (ORDER BY time)",
+	} {
+		schema := executor.NewQuerySchema(nil, nil, opt, nil)
+		schema.AddWindow(influxql.MustParseExpr(s).(*influxql.Call), "w0")
+		_, err := executor.NewWindowTransform(rt, rt, opt, schema)
+		assert.Error(t, err, s)
+	}
+}
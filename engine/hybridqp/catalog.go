@@ -222,6 +222,7 @@ type Catalog interface {
 	Calls() map[string]*influxql.Call
 	SlidingWindow() map[string]*influxql.Call
 	HoltWinters() []*influxql.Field
+	Windows() []*influxql.Field
 	CompositeCall() map[string]*OGSketchCompositeOperator
 	PromNestedCall() map[string]*PromNestedCall
 	Binarys() map[string]*influxql.BinaryExpr
@@ -246,6 +247,7 @@ type Catalog interface {
 	HasStreamCall() bool
 	HasSlidingWindowCall() bool
 	HasHoltWintersCall() bool
+	HasWindowCall() bool
 	IsMultiMeasurements() bool
 	HasGroupBy() bool
 	Sources() influxql.Sources
@@ -257,6 +259,7 @@ type Catalog interface {
 	GetJoinCaseCount() int
 	GetJoinCases() []*influxql.Join
 	IsHoltWinters(val string) bool
+	WindowCall(column string) *influxql.Call
 	GetSortFields() influxql.SortFields
 	SetUnnests(unnests []*influxql.Unnest)
 	GetUnnests() influxql.Unnests
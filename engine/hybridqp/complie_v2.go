@@ -27,6 +27,9 @@ const (
 	ExactStatisticQuery
 	FullSeriesQuery
 	SpecificSeriesQuery
+	// SeriesScanQuery is set by the cost based optimizer, the series are found by evaluating the tag filter
+	// on the key of every series of the measurement instead of looking it up in the index
+	SeriesScanQuery
 )
 
 var (
@@ -162,3 +162,29 @@ func (r *PrimaryKeyReader) ReadData() (*record.Record, int8, error) {
 	}
 	return dst, tcLocation, nil
 }
+
+// VerifyPrimaryKeyFile checks that the primary key index file can be decoded, the file is never removed even if it is invalid
+func VerifyPrimaryKeyFile(name string) (err error) {
+	fi, err := fileops.Stat(name)
+	if err != nil {
+		return err
+	}
+	if fi.Size() < int64(headerSize+util.Uint32SizeBytes) {
+		return fmt.Errorf("invalid file(%v) size:%v", name, fi.Size())
+	}
+
+	lockPath := ""
+	r, err := NewPrimaryKeyReader(name, &lockPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = r.Close()
+		// the corrupted meta makes the decoder out of range
+		if e := recover(); e != nil {
+			err = fmt.Errorf("decode file(%v) failed: %v", name, e)
+		}
+	}()
+	_, _, err = r.ReadData()
+	return err
+}
@@ -221,3 +221,25 @@ func TestDecodeColumnDataPanic(t *testing.T) {
 	err := decodeColumnData(&ref, data, nil, nil)
 	require.Equal(t, err, fmt.Errorf("type(0) in table not eq select type(1)"))
 }
+
+func TestVerifyPrimaryKeyFile(t *testing.T) {
+	testCompDir := t.TempDir()
+	filePath := filepath.Join(testCompDir, "verify.idx")
+	lockPath := ""
+	indexBuilder := NewIndexBuilder(&lockPath, filePath)
+	require.NoError(t, indexBuilder.WriteData(genData(100), DefaultTCLocation))
+	require.NoError(t, indexBuilder.writer.Close())
+	require.NoError(t, VerifyPrimaryKeyFile(filePath))
+
+	// the meta size is out of the file
+	fd, err := os.OpenFile(filePath, os.O_RDWR, 0640)
+	require.NoError(t, err)
+	_, err = fd.WriteAt([]byte{0xff, 0xff}, int64(headerSize))
+	require.NoError(t, err)
+	require.NoError(t, fd.Close())
+	require.ErrorContains(t, VerifyPrimaryKeyFile(filePath), "decode file")
+
+	require.NoError(t, os.Truncate(filePath, int64(headerSize)))
+	require.ErrorContains(t, VerifyPrimaryKeyFile(filePath), "invalid file")
+	require.FileExists(t, filePath)
+}
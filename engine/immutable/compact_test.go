@@ -3874,3 +3874,23 @@ func TestGetLocalBloomFilterData(t *testing.T) {
 		t.Fatal("should return no such file or dir")
 	}
 }
+
+func TestSetIndexFiles(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "00000001-0000-00000000")
+	lock := fileops.FileLockOption("")
+	for _, field := range []string{"region", "status"} {
+		name := colstore.AppendSecondaryIndexSuffix(fileName, field, index.Set, 0)
+		require.NoError(t, os.WriteFile(name+tmpFileSuffix, []byte(field), 0640))
+	}
+	// the field "model" is absent from the written records
+	require.NoError(t, renameSetIndexFiles(fileName, []string{"region", "status", "model"}, lock))
+	files, err := filepath.Glob(fileName + ".*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{fileName + ".region.set", fileName + ".status.set"}, files)
+
+	require.NoError(t, removeSetIndexFiles(fileName+tsspFileSuffix))
+	files, err = filepath.Glob(fileName + ".*")
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
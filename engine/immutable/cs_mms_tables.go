@@ -491,6 +491,9 @@ func (c *csImmTableImpl) ReplaceFiles(m *MmsTables, name string, oldFiles, newFi
 				return err
 			}
 		}
+		if err = removeSetIndexFiles(f.Path()); err != nil {
+			return err
+		}
 		fs.deleteFile(f)
 		if err = m.deleteFiles(f); err != nil {
 			return
@@ -519,6 +522,20 @@ func (c *csImmTableImpl) FullyCompacted(m *MmsTables) bool {
 	return count == len(m.CSFiles)
 }
 
+// removeSetIndexFiles removes the set index files of a compacted file, the fragments of the new file are not skipped by them
+func removeSetIndexFiles(fname string) error {
+	files, err := fileops.Glob(fname[:len(fname)-tsspFileSuffixLen] + ".*" + colstore.SetIndexFileSuffix)
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		if err = fileops.Remove(files[i]); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func RenameIndexFiles(fname string, indexList []string) error {
 	lock := fileops.FileLockOption("")
 	// rename pk index file
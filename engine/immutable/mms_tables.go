@@ -984,6 +984,10 @@ func RenameTmpFilesWithPKIndex(newFiles []TSSPFile, ir *influxql.IndexRelation)
 							return err
 						}
 					}
+				} else if oid == uint32(index.Set) {
+					if err := renameSetIndexFiles(fileName, ir.IndexList[i].IList, lock); err != nil {
+						return err
+					}
 				} else {
 					newName := colstore.AppendSecondaryIndexSuffix(fileName, ir.IndexList[i].IList[0], index.IndexType(oid), 0)
 					oldName := newName + tmpFileSuffix
@@ -1000,6 +1004,23 @@ func RenameTmpFilesWithPKIndex(newFiles []TSSPFile, ir *influxql.IndexRelation)
 	return nil
 }
 
+// renameSetIndexFiles renames the set index file of each field, a field absent from the written records has no file
+func renameSetIndexFiles(fileName string, fields []string, lock fileops.FSOption) error {
+	for _, field := range fields {
+		newName := colstore.AppendSecondaryIndexSuffix(fileName, field, index.Set, 0)
+		oldName := newName + tmpFileSuffix
+		if _, err := fileops.Stat(oldName); os.IsNotExist(err) {
+			continue
+		}
+		if err := fileops.RenameFile(oldName, newName, lock); err != nil {
+			err = errno.NewError(errno.RenameFileFailed, zap.String("old", oldName), zap.String("new", newName), err)
+			log.Error("rename file fail", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
 func RenameTmpFullTextIdxFile(msb *MsBuilder) error {
 	if !msb.fullTextIdx {
 		return nil
@@ -127,6 +127,10 @@ func (t *Trailer) MetaIndexItemNum() int64 {
 	return t.metaIndexItemNum
 }
 
+func (t *Trailer) IdCount() int64 {
+	return t.idCount
+}
+
 func (t *Trailer) copyTo(tr *Trailer) {
 	tr.dataOffset = t.dataOffset
 	tr.dataSize = t.dataSize
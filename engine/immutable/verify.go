@@ -0,0 +1,407 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"fmt"
+	"hash/crc32"
+	"path/filepath"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"go.uber.org/zap"
+)
+
+// stop verifying the file when there are too many errors, the rest of the file is most likely garbage
+const maxVerifyErrors = 32
+
+// FileVerifyReport is the result of verifying a TSSP file
+type FileVerifyReport struct {
+	Path        string `json:"path"`
+	Measurement string `json:"measurement,omitempty"`
+	Size        int64  `json:"size"`
+	Chunks      int    `json:"chunks"`
+	Columns     int    `json:"columns"`
+	// the number of the column blocks written without crc, such as the blocks written by the stream compaction
+	NoCrcColumns int      `json:"no_crc_columns,omitempty"`
+	Errors       []string `json:"errors,omitempty"`
+	// the path the corrupted file is moved to
+	Quarantined string `json:"quarantined,omitempty"`
+}
+
+func (r *FileVerifyReport) Corrupted() bool {
+	return len(r.Errors) > 0
+}
+
+func (r *FileVerifyReport) addError(format string, a ...interface{}) bool {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, a...))
+	return len(r.Errors) < maxVerifyErrors
+}
+
+// ShardVerifyReport is the result of verifying the TSSP files of a shard
+type ShardVerifyReport struct {
+	Path  string              `json:"path"`
+	Files []*FileVerifyReport `json:"files"`
+}
+
+// VerifyReport is the machine-readable report of the verify command and the scrub service
+type VerifyReport struct {
+	Files       int                  `json:"files"`
+	Corrupted   int                  `json:"corrupted"`
+	Quarantined int                  `json:"quarantined"`
+	Shards      []*ShardVerifyReport `json:"shards"`
+}
+
+func (r *VerifyReport) Add(shard *ShardVerifyReport) {
+	for _, f := range shard.Files {
+		r.Files++
+		if f.Corrupted() {
+			r.Corrupted++
+		}
+		if f.Quarantined != "" {
+			r.Quarantined++
+		}
+	}
+	r.Shards = append(r.Shards, shard)
+}
+
+type fileVerifier struct {
+	f      TSSPFile
+	report *FileVerifyReport
+	tr     *Trailer
+	buf    []byte
+	cms    []ChunkMeta
+
+	// the sid of the last verified chunk, the sids in the file must be strictly increasing
+	lastSid uint64
+}
+
+// VerifyTSSPFile checks the trailer, the meta index, the chunk metas and the crc of each column block of the file
+func VerifyTSSPFile(f TSSPFile) *FileVerifyReport {
+	v := &fileVerifier{
+		f:      f,
+		report: &FileVerifyReport{Path: f.Path(), Size: f.FileSize()},
+		tr:     f.FileStat(),
+	}
+	defer func() {
+		// the corrupted chunk meta makes the decoder out of range
+		if e := recover(); e != nil {
+			v.report.addError("verify file failed: %v", e)
+		}
+	}()
+	if v.verifyTrailer() {
+		v.verifyMetaIndexes()
+	}
+	return v.report
+}
+
+// VerifyTSSPFileByPath opens the file read only and verifies it, the file is never removed even if it is invalid
+func VerifyTSSPFileByPath(path string, isOrder bool) *FileVerifyReport {
+	report := &FileVerifyReport{Path: path}
+	fi, err := fileops.Stat(path)
+	if err != nil {
+		report.addError("stat file failed: %v", err)
+		return report
+	}
+	report.Size = fi.Size()
+	// the reader removes the file which is too small to be a TSSP file
+	if fi.Size() < minTableSize() {
+		report.addError("invalid file size %d, less than %d", fi.Size(), minTableSize())
+		return report
+	}
+
+	lockPath := ""
+	f, err := OpenTSSPFile(path, &lockPath, isOrder, false)
+	if err != nil {
+		report.addError("open file failed: %v", err)
+		return report
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return VerifyTSSPFile(f)
+}
+
+func (v *fileVerifier) verifyTrailer() bool {
+	tr, size := v.tr, v.report.Size
+	if tr.dataOffset != int64(fileHeaderSize) {
+		v.report.addError("invalid data offset %d in trailer", tr.dataOffset)
+		return false
+	}
+
+	end := tr.dataOffset + tr.dataSize + tr.indexSize + tr.metaIndexSize + tr.bloomSize + tr.idTimeSize
+	if tr.dataSize < 0 || tr.indexSize <= 0 || tr.metaIndexSize <= 0 || end > size {
+		v.report.addError("invalid trailer, data size %d, index size %d, meta index size %d, file size %d",
+			tr.dataSize, tr.indexSize, tr.metaIndexSize, size)
+		return false
+	}
+
+	if tr.idCount <= 0 || tr.metaIndexItemNum <= 0 || tr.minId > tr.maxId || tr.minTime > tr.maxTime {
+		v.report.addError("invalid trailer, id count %d, meta index count %d, id range [%d, %d], time range [%d, %d]",
+			tr.idCount, tr.metaIndexItemNum, tr.minId, tr.maxId, tr.minTime, tr.maxTime)
+		return false
+	}
+	return true
+}
+
+func (v *fileVerifier) verifyMetaIndexes() {
+	tr := v.tr
+	indexOffset, indexSize := tr.metaOffsetSize()
+	for i := 0; i < int(tr.metaIndexItemNum); i++ {
+		mi, err := v.f.MetaIndexAt(i)
+		if err != nil {
+			v.report.addError("read meta index %d failed: %v", i, err)
+			return
+		}
+
+		if mi.offset < indexOffset || mi.offset+int64(mi.size) > indexOffset+indexSize || mi.count == 0 {
+			v.report.addError("meta index %d points out of the chunk meta block, offset %d, size %d, count %d",
+				i, mi.offset, mi.size, mi.count)
+			return
+		}
+		if mi.minTime > mi.maxTime || mi.minTime < tr.minTime || mi.maxTime > tr.maxTime {
+			if !v.report.addError("meta index %d has invalid time range [%d, %d]", i, mi.minTime, mi.maxTime) {
+				return
+			}
+		}
+
+		v.cms, err = v.f.ReadChunkMetaData(i, mi, v.cms[:0], fileops.IO_PRIORITY_LOW_READ)
+		if err != nil {
+			if !v.report.addError("read chunk metas of meta index %d failed: %v", i, err) {
+				return
+			}
+			continue
+		}
+		if len(v.cms) != int(mi.count) {
+			if !v.report.addError("meta index %d has %d chunk metas, expect %d", i, len(v.cms), mi.count) {
+				return
+			}
+			continue
+		}
+		if v.cms[0].sid != mi.id {
+			if !v.report.addError("meta index %d has id %d, but the first chunk meta has sid %d", i, mi.id, v.cms[0].sid) {
+				return
+			}
+		}
+
+		for j := range v.cms {
+			if !v.verifyChunk(mi, &v.cms[j]) {
+				return
+			}
+		}
+	}
+
+	if v.report.Chunks != int(tr.idCount) {
+		v.report.addError("chunk count %d does not match the id count %d in trailer", v.report.Chunks, tr.idCount)
+	}
+}
+
+func (v *fileVerifier) verifyChunk(mi *MetaIndex, cm *ChunkMeta) bool {
+	tr := v.tr
+	v.report.Chunks++
+	if cm.sid <= v.lastSid && v.report.Chunks > 1 {
+		return v.report.addError("sid %d is not greater than the previous sid %d", cm.sid, v.lastSid)
+	}
+	v.lastSid = cm.sid
+	if !tr.ContainsId(cm.sid) {
+		return v.report.addError("sid %d is out of the id range [%d, %d] in trailer", cm.sid, tr.minId, tr.maxId)
+	}
+
+	if cm.offset < tr.dataOffset || cm.offset+int64(cm.size) > tr.dataOffset+tr.dataSize {
+		return v.report.addError("chunk of sid %d is out of the data block, offset %d, size %d", cm.sid, cm.offset, cm.size)
+	}
+	if len(cm.timeRange) == 0 || len(cm.timeRange) != int(cm.segCount) || len(cm.colMeta) == 0 {
+		return v.report.addError("chunk of sid %d has %d segments, %d time ranges and %d columns",
+			cm.sid, cm.segCount, len(cm.timeRange), len(cm.colMeta))
+	}
+	if min, max := cm.MinMaxTime(); min > max || min < mi.minTime || max > mi.maxTime {
+		if !v.report.addError("chunk of sid %d has time range [%d, %d] out of the meta index [%d, %d]",
+			cm.sid, min, max, mi.minTime, mi.maxTime) {
+			return false
+		}
+	}
+
+	for i := range cm.colMeta {
+		if !v.verifyColumn(cm, &cm.colMeta[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *fileVerifier) verifyColumn(cm *ChunkMeta, col *ColumnMeta) bool {
+	v.report.Columns++
+	if len(col.entries) != int(cm.segCount) {
+		return v.report.addError("column %s of sid %d has %d segments, expect %d", col.name, cm.sid, len(col.entries), cm.segCount)
+	}
+
+	chunkEnd := cm.offset + int64(cm.size)
+	contiguous := true
+	for i, seg := range col.entries {
+		if seg.offset < cm.offset+crcSize || seg.offset+int64(seg.size) > chunkEnd {
+			return v.report.addError("segment %d of column %s of sid %d is out of the chunk, offset %d, size %d",
+				i, col.name, cm.sid, seg.offset, seg.size)
+		}
+		if i > 0 {
+			prev := col.entries[i-1]
+			if seg.offset < prev.offset+int64(prev.size) {
+				return v.report.addError("segment %d of column %s of sid %d overlaps the previous one", i, col.name, cm.sid)
+			}
+			contiguous = contiguous && seg.offset == prev.offset+int64(prev.size)
+		}
+	}
+
+	// the crc is written in front of the contiguous segments of the column
+	if !contiguous {
+		v.report.NoCrcColumns++
+		return true
+	}
+	first, last := col.entries[0], col.entries[len(col.entries)-1]
+	size := last.offset + int64(last.size) - first.offset + crcSize
+	data, err := v.f.ReadData(first.offset-crcSize, uint32(size), &v.buf, fileops.IO_PRIORITY_LOW_READ)
+	if err != nil {
+		return v.report.addError("read column %s of sid %d failed: %v", col.name, cm.sid, err)
+	}
+	if len(data) != int(size) {
+		return v.report.addError("read column %s of sid %d failed: short read %d < %d", col.name, cm.sid, len(data), size)
+	}
+
+	crc := numberenc.UnmarshalUint32(data[:crcSize])
+	// the stream compaction pads the crc with zero
+	if crc == 0 {
+		v.report.NoCrcColumns++
+		return true
+	}
+	if crc != crc32.ChecksumIEEE(data[crcSize:]) {
+		return v.report.addError("crc mismatch of column %s of sid %d", col.name, cm.sid)
+	}
+	return true
+}
+
+// Scrub verifies all the TSSP files of the shard. If quarantineDir is not empty, the corrupted files are removed
+// from the shard and moved into quarantineDir, so that the rest of the shard is still queryable.
+func (m *MmsTables) Scrub(quarantineDir string) *ShardVerifyReport {
+	report := &ShardVerifyReport{Path: m.path}
+	for _, tables := range m.scrubTables() {
+		for _, f := range tables.files {
+			if m.isClosed() {
+				UnrefFiles(f)
+				continue
+			}
+
+			fr := VerifyTSSPFile(f)
+			fr.Measurement = tables.name
+			UnrefFiles(f)
+			report.Files = append(report.Files, fr)
+			if !fr.Corrupted() {
+				continue
+			}
+
+			log.Error("corrupted file found", zap.String("file", fr.Path), zap.Strings("errors", fr.Errors))
+			if quarantineDir == "" {
+				continue
+			}
+			dst, err := m.quarantineFile(tables, f, quarantineDir)
+			if err != nil {
+				log.Error("failed to quarantine file", zap.String("file", fr.Path), zap.Error(err))
+				continue
+			}
+			fr.Quarantined = dst
+		}
+	}
+	return report
+}
+
+type scrubTables struct {
+	name  string
+	all   *TSSPFiles
+	files []TSSPFile
+	cs    bool
+}
+
+// scrubTables returns the referenced files of each measurement
+func (m *MmsTables) scrubTables() []*scrubTables {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var tables []*scrubTables
+	add := func(mmsTbls map[string]*TSSPFiles, cs bool) {
+		for name, files := range mmsTbls {
+			files.RLock()
+			t := &scrubTables{name: name, all: files, cs: cs}
+			for _, f := range files.Files() {
+				f.Ref()
+				t.files = append(t.files, f)
+			}
+			files.RUnlock()
+			tables = append(tables, t)
+		}
+	}
+	add(m.Order, false)
+	add(m.OutOfOrder, false)
+	add(m.CSFiles, true)
+	return tables
+}
+
+// quarantineFile removes the file from the measurement and moves it into dir with the same relative path as in the shard
+func (m *MmsTables) quarantineFile(tables *scrubTables, f TSSPFile, dir string) (string, error) {
+	name := f.Path()
+	rel, err := filepath.Rel(m.path, name)
+	if err != nil {
+		return "", err
+	}
+	dst := filepath.Join(dir, rel)
+
+	// the file must not be compacted or merged while it is being quarantined
+	if !m.acquire([]string{name}) {
+		return "", fmt.Errorf("file is in compaction")
+	}
+	defer m.CompactDone([]string{name})
+
+	tables.all.lock.Lock()
+	if tables.all.fileIndex(f) < 0 {
+		tables.all.lock.Unlock()
+		return "", fmt.Errorf("file is removed")
+	}
+	tables.all.deleteFile(f)
+	tables.all.lock.Unlock()
+
+	// wait for the queries which are reading the file
+	_ = f.Close()
+	lock := fileops.FileLockOption(*m.lock)
+	if err = fileops.MkdirAll(filepath.Dir(dst), 0750, lock); err != nil {
+		return "", err
+	}
+	if err = fileops.RenameFile(name, dst, lock); err != nil {
+		return "", err
+	}
+
+	if !tables.cs {
+		return dst, nil
+	}
+	pkFile := name[:len(name)-len(tsspFileSuffix)] + colstore.IndexFileSuffix
+	if pkFiles, ok := m.getPKFiles(tables.name); ok {
+		pkFiles.DelPKInfo(pkFile)
+	}
+	if _, err = fileops.Stat(pkFile); err != nil {
+		return dst, nil
+	}
+	if err = fileops.RenameFile(pkFile, strings.TrimSuffix(dst, tsspFileSuffix)+colstore.IndexFileSuffix, lock); err != nil {
+		log.Error("failed to quarantine primary key file", zap.String("file", pkFile), zap.Error(err))
+	}
+	return dst, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+func corruptFile(t *testing.T, path string, offset int64) {
+	fd, err := os.OpenFile(path, os.O_RDWR, 0640)
+	require.NoError(t, err)
+	defer fd.Close()
+
+	b := make([]byte, 1)
+	_, err = fd.ReadAt(b, offset)
+	require.NoError(t, err)
+	b[0] = ^b[0]
+	_, err = fd.WriteAt(b, offset)
+	require.NoError(t, err)
+}
+
+func TestVerifyTSSPFile(t *testing.T) {
+	defer beforeTest(t, 0)()
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	rg := newRecordGenerator(1e12, defaultInterval, true)
+	for i := 0; i < 10; i++ {
+		mh.addRecord(uint64(100+i), rg.generate(getDefaultSchemas(), 100))
+	}
+	require.NoError(t, mh.saveToOrder())
+
+	files, ok := mh.store.Order["mst"]
+	require.True(t, ok)
+	file := files.Files()[0]
+	path := file.Path()
+
+	report := immutable.VerifyTSSPFile(file)
+	require.False(t, report.Corrupted(), report.Errors)
+	require.Equal(t, 10, report.Chunks)
+	require.Equal(t, 10*(len(getDefaultSchemas())+1), report.Columns)
+	require.Equal(t, 0, report.NoCrcColumns)
+	require.NoError(t, mh.store.Close())
+
+	corruptFile(t, path, 100)
+	report = immutable.VerifyTSSPFileByPath(path, true)
+	require.True(t, report.Corrupted())
+	require.Contains(t, report.Errors[0], "crc mismatch")
+
+	lockPath := ""
+	store := immutable.NewTableStore(saveDir, &lockPath, &defaultTier, false, immutable.NewTsStoreConfig())
+	store.SetImmTableType(config.TSSTORE)
+	_, err := store.Open()
+	require.NoError(t, err)
+	defer store.Close()
+	require.Equal(t, 1, store.GetTableFileNum("mst", true))
+
+	quarantineDir := t.TempDir()
+	shard := store.Scrub(quarantineDir)
+	require.Equal(t, 1, len(shard.Files))
+	require.True(t, shard.Files[0].Corrupted())
+	require.Equal(t, "mst", shard.Files[0].Measurement)
+	require.Equal(t, filepath.Join(quarantineDir, "mst", filepath.Base(path)), shard.Files[0].Quarantined)
+	require.FileExists(t, shard.Files[0].Quarantined)
+	require.NoFileExists(t, path)
+	require.Equal(t, 0, store.GetTableFileNum("mst", true))
+
+	vr := &immutable.VerifyReport{}
+	vr.Add(shard)
+	require.Equal(t, 1, vr.Files)
+	require.Equal(t, 1, vr.Corrupted)
+	require.Equal(t, 1, vr.Quarantined)
+}
+
+func TestVerifyTSSPFile_Invalid(t *testing.T) {
+	defer beforeTest(t, 0)()
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	rg := newRecordGenerator(1e12, defaultInterval, true)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	path := mh.store.Order["mst"].Files()[0].Path()
+	size := mh.store.Order["mst"].Files()[0].FileSize()
+	require.NoError(t, mh.store.Close())
+
+	// the footer points to the trailer
+	corruptFile(t, path, size-2)
+	report := immutable.VerifyTSSPFileByPath(path, true)
+	require.True(t, report.Corrupted())
+	require.Contains(t, report.Errors[0], "open file failed")
+
+	// the file is kept for the report even if it is too small
+	require.NoError(t, os.Truncate(path, 16))
+	report = immutable.VerifyTSSPFileByPath(path, true)
+	require.True(t, report.Corrupted())
+	require.Contains(t, report.Errors[0], "invalid file size")
+	require.FileExists(t, path)
+
+	report = immutable.VerifyTSSPFileByPath(filepath.Join(saveDir, "not_exist.tssp"), true)
+	require.Contains(t, report.Errors[0], "stat file failed")
+}
@@ -15,11 +15,36 @@
 package sparseindex
 
 import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"os"
+	"path"
+	"strings"
+
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/logstore"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/rpn"
 	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	// SetIndexMaxValues is the maximum number of distinct values kept for a fragment.
+	// A fragment with more values is marked as overflowed and can not be skipped.
+	SetIndexMaxValues = 256
+	// SetIndexMaxBytes is the maximum total size of the distinct values kept for a fragment.
+	SetIndexMaxBytes = 64 * 1024
+
+	setFlagExact    uint8 = 0
+	setFlagOverflow uint8 = 1
 )
 
 var _ = RegistrySKFileReaderCreator(uint32(index.Set), &SetReaderCreator{})
@@ -31,12 +56,24 @@ func (index *SetReaderCreator) CreateSKFileReader(rpnExpr *rpn.RPNExpr, schema r
 	return NewSetIndexReader(rpnExpr, schema, option, isCache)
 }
 
+// SetIndexReader:
+//  1. the data record. the fragment size is 3.
+//     region
+//     east
+//     west
+//     east
+//  2. the index of the fragment
+//     {east, west}
+//
+// a fragment is skipped if the condition can not be true for any value of the set.
+// an overflowed set or a missing index file means the fragment may contain any value.
 type SetIndexReader struct {
 	init    bool
 	isCache bool
 	schema  record.Schemas
 	option  hybridqp.Options
 	sk      SKCondition
+	sets    map[string][]fragmentSet // the sets of each fragment by field name, nil if the field has no index file
 	span    *tracing.Span
 }
 
@@ -45,19 +82,64 @@ func NewSetIndexReader(rpnExpr *rpn.RPNExpr, schema record.Schemas, option hybri
 	if err != nil {
 		return nil, err
 	}
-	return &SetIndexReader{schema: schema, option: option, isCache: isCache, sk: sk}, nil
+	return &SetIndexReader{schema: schema, option: option, isCache: isCache, sk: sk, sets: make(map[string][]fragmentSet)}, nil
 }
 
 func (r *SetIndexReader) MayBeInFragment(fragId uint32) (bool, error) {
-	return false, nil
+	return r.sk.IsExist(int64(fragId), r)
+}
+
+// IsExist determines whether the values of a fragment may satisfy the element. only = and != are evaluated,
+// IN is rewritten as the OR of = by the parser.
+func (r *SetIndexReader) IsExist(blockId int64, elem *rpn.SKRPNElement) (bool, error) {
+	sets := r.sets[elem.Key]
+	if blockId >= int64(len(sets)) || sets[blockId].overflow {
+		return true, nil
+	}
+	idx := r.schema.FieldIndex(elem.Key)
+	if idx < 0 {
+		return true, nil
+	}
+	key, ok := setIndexKey(elem.Value, r.schema[idx].Type)
+	if !ok {
+		return true, nil
+	}
+	set := sets[blockId].values
+	_, contains := set[key]
+	switch elem.Op {
+	case influxql.EQ:
+		return contains, nil
+	case influxql.NEQ:
+		return len(set) > 1 || (len(set) == 1 && !contains), nil
+	default:
+		return true, nil
+	}
 }
 
 func (r *SetIndexReader) ReInit(file interface{}) (err error) {
-	if !r.init {
-		r.init = true
-		return
+	r.init = true
+	for k := range r.sets {
+		delete(r.sets, k)
 	}
-	return
+	f, ok := file.(TsspFile)
+	if !ok {
+		// the set index is only built for the attached files, the fragments of others are not skipped
+		return nil
+	}
+	index := strings.LastIndex(f.Path(), "/")
+	dir := f.Path()[:index]
+	fileName := strings.Split(f.Path()[index+1:], ".")[0]
+	for i := range r.schema {
+		if _, ok := r.sets[r.schema[i].Name]; ok {
+			continue
+		}
+		sets, err := readSetIndexFile(path.Join(dir, fileName+"."+r.schema[i].Name+colstore.SetIndexFileSuffix))
+		if err != nil {
+			return err
+		}
+		r.sets[r.schema[i].Name] = sets
+	}
+	return nil
 }
 
 func (r *SetIndexReader) Close() error {
@@ -68,6 +150,119 @@ func (r *SetIndexReader) StartSpan(span *tracing.Span) {
 	r.span = span
 }
 
+type fragmentSet struct {
+	overflow bool
+	values   map[string]struct{}
+}
+
+func readSetIndexFile(fileName string) ([]fragmentSet, error) {
+	buf, err := fileops.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return UnmarshalSetIndex(buf, fileName)
+}
+
+// UnmarshalSetIndex decodes the sets of the fragments from the set index file
+func UnmarshalSetIndex(buf []byte, fileName string) ([]fragmentSet, error) {
+	var sets []fragmentSet
+	for len(buf) > 0 {
+		if len(buf) < util.Uint32SizeBytes {
+			return nil, fmt.Errorf("invalid set index file %s: truncated block header", fileName)
+		}
+		size := int(binary.LittleEndian.Uint32(buf))
+		buf = buf[util.Uint32SizeBytes:]
+		if len(buf) < size+crcSize {
+			return nil, fmt.Errorf("invalid set index file %s: truncated block", fileName)
+		}
+		payload := buf[:size]
+		if crc32.Checksum(payload, logstore.Table) != binary.LittleEndian.Uint32(buf[size:]) {
+			return nil, fmt.Errorf("invalid set index file %s: checksum mismatch of block %d", fileName, len(sets))
+		}
+		buf = buf[size+crcSize:]
+
+		set, err := unmarshalFragmentSet(payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid set index file %s: %v", fileName, err)
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+func unmarshalFragmentSet(payload []byte) (fragmentSet, error) {
+	if len(payload) < 1+util.Uint32SizeBytes {
+		return fragmentSet{}, fmt.Errorf("short block")
+	}
+	set := fragmentSet{overflow: payload[0] == setFlagOverflow}
+	count := int(binary.LittleEndian.Uint32(payload[1:]))
+	payload = payload[1+util.Uint32SizeBytes:]
+	if set.overflow {
+		return set, nil
+	}
+	set.values = make(map[string]struct{}, count)
+	for i := 0; i < count; i++ {
+		if len(payload) < util.Uint32SizeBytes {
+			return fragmentSet{}, fmt.Errorf("short value")
+		}
+		n := int(binary.LittleEndian.Uint32(payload))
+		payload = payload[util.Uint32SizeBytes:]
+		if len(payload) < n {
+			return fragmentSet{}, fmt.Errorf("short value")
+		}
+		set.values[string(payload[:n])] = struct{}{}
+		payload = payload[n:]
+	}
+	return set, nil
+}
+
+// setIndexKey encodes the value of the condition in the same way as the values of the field are encoded
+func setIndexKey(value interface{}, typ int) (string, bool) {
+	var b [util.Uint64SizeBytes]byte
+	switch typ {
+	case influx.Field_Type_String, influx.Field_Type_Tag:
+		v, ok := value.(string)
+		return v, ok
+	case influx.Field_Type_Int:
+		switch v := value.(type) {
+		case int64:
+			binary.LittleEndian.PutUint64(b[:], uint64(v))
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v > math.MaxInt64 {
+				return "", false
+			}
+			binary.LittleEndian.PutUint64(b[:], uint64(int64(v)))
+		default:
+			return "", false
+		}
+		return string(b[:]), true
+	case influx.Field_Type_Float:
+		switch v := value.(type) {
+		case float64:
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		case int64:
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(float64(v)))
+		default:
+			return "", false
+		}
+		return string(b[:]), true
+	case influx.Field_Type_Boolean:
+		v, ok := value.(bool)
+		if !ok {
+			return "", false
+		}
+		if v {
+			return "\x01", true
+		}
+		return "\x00", true
+	default:
+		return "", false
+	}
+}
+
 type SetWriter struct {
 	*skipIndexWriter
 }
@@ -86,10 +281,100 @@ func (s *SetWriter) Close() error {
 	return nil
 }
 
+func (s *SetWriter) getSkipIndexFilePath(fieldName string) string {
+	return path.Join(s.dir, s.msName, colstore.AppendSecondaryIndexSuffix(s.dataFilePath, fieldName, index.Set, 0)+tmpFileSuffix)
+}
+
 func (s *SetWriter) CreateAttachIndex(writeRec *record.Record, schemaIdx, rowsPerSegment []int) error {
+	for _, i := range schemaIdx {
+		data := GenSetData(&writeRec.ColVals[i], rowsPerSegment, writeRec.Schema[i].Type)
+		if err := writeSkipIndexToDisk(data, s.lockPath, s.getSkipIndexFilePath(writeRec.Schema[i].Name)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// CreateDetachIndex is not supported by the set index, the fragments of the detached files are not skipped.
 func (s *SetWriter) CreateDetachIndex(writeRec *record.Record, schemaIdx, rowsPerSegment []int, dataBuf [][]byte) ([][]byte, []string) {
 	return nil, nil
 }
+
+// GenSetData generates a block of the distinct values for each segment of the column.
+// block: | size(4B) | flag(1B) | count(4B) | len(4B) | value | ... | crc(4B) |
+func GenSetData(src *record.ColVal, rowsPerSegment []int, refType int) []byte {
+	var res []byte
+	var segCol []record.ColVal
+	segCol = src.SplitColBySize(segCol, rowsPerSegment, refType)
+
+	var strs []string
+	set := make(map[string]struct{})
+	for i := range segCol {
+		for k := range set {
+			delete(set, k)
+		}
+		overflow := false
+		size := 0
+		add := func(v string) {
+			if overflow {
+				return
+			}
+			if _, ok := set[v]; ok {
+				return
+			}
+			if len(set) >= SetIndexMaxValues || size+len(v) > SetIndexMaxBytes {
+				overflow = true
+				return
+			}
+			set[v] = struct{}{}
+			size += len(v)
+		}
+
+		col := &segCol[i]
+		switch refType {
+		case influx.Field_Type_String, influx.Field_Type_Tag:
+			strs = col.StringValues(strs[:0])
+			for _, v := range strs {
+				add(v)
+			}
+		case influx.Field_Type_Int:
+			for _, v := range col.IntegerValues() {
+				key, _ := setIndexKey(v, refType)
+				add(key)
+			}
+		case influx.Field_Type_Float:
+			for _, v := range col.FloatValues() {
+				key, _ := setIndexKey(v, refType)
+				add(key)
+			}
+		case influx.Field_Type_Boolean:
+			for _, v := range col.BooleanValues() {
+				key, _ := setIndexKey(v, refType)
+				add(key)
+			}
+		default:
+			overflow = true
+		}
+		res = appendFragmentSet(res, set, overflow)
+	}
+	return res
+}
+
+func appendFragmentSet(dst []byte, set map[string]struct{}, overflow bool) []byte {
+	start := len(dst)
+	dst = binary.LittleEndian.AppendUint32(dst, 0)
+	if overflow {
+		dst = append(dst, setFlagOverflow)
+		dst = binary.LittleEndian.AppendUint32(dst, 0)
+	} else {
+		dst = append(dst, setFlagExact)
+		dst = binary.LittleEndian.AppendUint32(dst, uint32(len(set)))
+		for v := range set {
+			dst = binary.LittleEndian.AppendUint32(dst, uint32(len(v)))
+			dst = append(dst, v...)
+		}
+	}
+	payload := dst[start+util.Uint32SizeBytes:]
+	binary.LittleEndian.PutUint32(dst[start:], uint32(len(payload)))
+	return binary.LittleEndian.AppendUint32(dst, crc32.Checksum(payload, logstore.Table))
+}
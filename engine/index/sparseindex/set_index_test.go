@@ -15,11 +15,14 @@
 package sparseindex_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
 	"github.com/openGemini/openGemini/engine/index"
 	"github.com/openGemini/openGemini/engine/index/sparseindex"
-	"github.com/openGemini/openGemini/lib/fileops"
 	indextype "github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/rpn"
@@ -28,48 +31,134 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestSetIndexReader(t *testing.T) {
-	schema := record.Schemas{{Name: "region", Type: influx.Field_Type_String}}
-	option := &query.ProcessorOptions{Condition: &influxql.BinaryExpr{
-		Op:  influxql.EQ,
-		LHS: &influxql.VarRef{Val: "value", Type: influxql.Integer},
-		RHS: &influxql.IntegerLiteral{Val: 2},
-	}}
-	rpnExpr := rpn.ConvertToRPNExpr(option.GetCondition())
-	reader, err := sparseindex.NewSetIndexReader(rpnExpr, schema, option, true)
-	if err != nil {
-		t.Fatal(err)
+func buildSetIndexRecord() *record.Record {
+	schema := record.Schemas{
+		{Name: "region", Type: influx.Field_Type_String},
+		{Name: "code", Type: influx.Field_Type_Int},
 	}
-	dataFile := "00000001-0001-00000001.tssp"
-	assert.Equal(t, reader.ReInit(dataFile), nil)
-	ok, err := reader.MayBeInFragment(0)
-	assert.Equal(t, err, nil)
-	assert.Equal(t, ok, false)
-	assert.Equal(t, reader.Close(), nil)
+	rec := record.NewRecord(schema, false)
+	// fragment 0: east, west
+	rec.Column(0).AppendStrings("east", "west", "east")
+	rec.Column(1).AppendIntegers(200, 200, 200)
+	// fragment 1: north and null
+	rec.Column(0).AppendStrings("north")
+	rec.Column(0).AppendStringNull()
+	rec.Column(0).AppendStrings("north")
+	rec.Column(1).AppendIntegers(404)
+	rec.Column(1).AppendIntegerNull()
+	rec.Column(1).AppendIntegers(500)
+	// fragment 2: overflow
+	for i := 0; i < sparseindex.SetIndexMaxValues+1; i++ {
+		rec.Column(0).AppendStrings(fmt.Sprintf("city%d", i))
+		rec.Column(1).AppendIntegers(int64(i))
+	}
+	return rec
 }
 
-func TestSetIndexWriter(t *testing.T) {
-	testCompDir := t.TempDir()
-	_ = fileops.RemoveAll(testCompDir)
-
-	msName := "cpu"
-	setWriter := index.NewIndexWriter(testCompDir, msName, "", "", indextype.Set, tokenizer.CONTENT_SPLITTER)
-	err := setWriter.Open()
-	if err != nil {
-		t.Fatal(err)
+func writeSetIndex(t *testing.T, dir, msName, dataFile string) {
+	rec := buildSetIndexRecord()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, msName), 0750))
+	setWriter := index.NewIndexWriter(dir, msName, dataFile, "", indextype.Set, tokenizer.CONTENT_SPLITTER)
+	require.NoError(t, setWriter.Open())
+	require.NoError(t, setWriter.CreateAttachIndex(rec, []int{0, 1}, []int{3, 6, rec.RowNums()}))
+	buf, paths := setWriter.CreateDetachIndex(rec, []int{0, 1}, []int{3, 6, rec.RowNums()}, nil)
+	assert.Nil(t, buf)
+	assert.Nil(t, paths)
+	require.NoError(t, setWriter.Close())
+	for _, field := range []string{"region", "code"} {
+		name := filepath.Join(dir, msName, colstore.AppendSecondaryIndexSuffix(dataFile, field, indextype.Set, 0))
+		require.NoError(t, os.Rename(name+".init", name))
 	}
+}
 
-	err = setWriter.CreateAttachIndex(nil, nil, nil)
-	if err != nil {
-		t.Fatal(err)
+func newSetIndexReader(t *testing.T, condition string) *sparseindex.SetIndexReader {
+	expr, err := influxql.ParseExpr(condition)
+	require.NoError(t, err)
+	expr = influxql.Reduce(expr, nil)
+	influxql.WalkFunc(expr, func(n influxql.Node) {
+		if ref, ok := n.(*influxql.VarRef); ok {
+			if ref.Val == "region" {
+				ref.Type = influxql.String
+			} else {
+				ref.Type = influxql.Integer
+			}
+		}
+	})
+	schema := record.Schemas{
+		{Name: "region", Type: influx.Field_Type_String},
+		{Name: "code", Type: influx.Field_Type_Int},
 	}
+	option := &query.ProcessorOptions{Condition: expr}
+	reader, err := sparseindex.NewSetIndexReader(rpn.ConvertToRPNExpr(option.GetCondition()), schema, option, true)
+	require.NoError(t, err)
+	return reader
+}
 
-	_, _ = setWriter.CreateDetachIndex(nil, nil, nil, nil)
+func TestSetIndexReader(t *testing.T) {
+	dir := t.TempDir()
+	dataFile := "00000001-0001-00000001"
+	writeSetIndex(t, dir, "cpu", dataFile)
+	file := &MockTssp{path: filepath.Join(dir, "cpu", dataFile+".tssp")}
 
-	err = setWriter.Close()
-	if err != nil {
-		t.Fatal(err)
+	for condition, expected := range map[string][]bool{
+		"region = 'east'":                     {true, false, true},
+		"region = 'south'":                    {false, false, true},
+		"region != 'north'":                   {true, false, true},
+		"region != 'east'":                    {true, true, true},
+		"region = 'south' OR region = 'west'": {true, false, true},
+		"region = 'north' AND code = 404":     {false, true, true},
+		"region = 'north' AND code = 200":     {false, false, true},
+		"code = 200.0":                        {true, false, true},
+		"code = 200.5":                        {true, true, true},
+		"code > 1000":                         {true, true, true},
+		"region = 'east' OR host = 'a'":       {true, true, true},
+	} {
+		reader := newSetIndexReader(t, condition)
+		require.NoError(t, reader.ReInit(file))
+		for fragId := range expected {
+			ok, err := reader.MayBeInFragment(uint32(fragId))
+			require.NoError(t, err)
+			assert.Equal(t, expected[fragId], ok, "condition: %s, fragment: %d", condition, fragId)
+		}
+		// the fragments beyond the index can not be skipped
+		ok, err := reader.MayBeInFragment(uint32(len(expected)))
+		require.NoError(t, err)
+		assert.True(t, ok)
+		require.NoError(t, reader.Close())
 	}
 }
+
+func TestSetIndexReader_NoIndexFile(t *testing.T) {
+	reader := newSetIndexReader(t, "region = 'east'")
+	require.NoError(t, reader.ReInit(&MockTssp{path: filepath.Join(t.TempDir(), "00000001-0001-00000001.tssp")}))
+	ok, err := reader.MayBeInFragment(0)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, reader.ReInit(sparseindex.NewOBSFilterPath("", t.TempDir(), nil)))
+	ok, err = reader.MayBeInFragment(0)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestSetIndexReader_Corrupted(t *testing.T) {
+	dir := t.TempDir()
+	dataFile := "00000001-0001-00000001"
+	writeSetIndex(t, dir, "cpu", dataFile)
+	name := filepath.Join(dir, "cpu", colstore.AppendSecondaryIndexSuffix(dataFile, "region", indextype.Set, 0))
+	buf, err := os.ReadFile(name)
+	require.NoError(t, err)
+	buf[10] ^= 0xff
+	require.NoError(t, os.WriteFile(name, buf, 0640))
+
+	reader := newSetIndexReader(t, "region = 'east'")
+	err = reader.ReInit(&MockTssp{path: filepath.Join(dir, "cpu", dataFile+".tssp")})
+	require.ErrorContains(t, err, "checksum mismatch of block 0")
+
+	buf[10] ^= 0xff
+	_, err = sparseindex.UnmarshalSetIndex(buf[:len(buf)-1], name)
+	require.ErrorContains(t, err, "truncated block")
+}
@@ -1,4 +1,4 @@
-//go:build linux && amd64
+//go:build linux && amd64 && !purego
 // Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -34,7 +34,10 @@ void SimpleGramTokenizer::Init(const char *splitStr, uint32_t len, bool hasChin)
         }
     }
     for (int i = 0; i < len; i++) {
-        splitTable[splitStr[i]] = 0;
+        uint8_t c = (uint8_t)splitStr[i];
+        if (c < 0x80) { // only the ascii characters are the split chars
+            splitTable[c] = 0;
+        }
     }
 }
 
@@ -65,9 +68,17 @@ bool SimpleGramTokenizer::NextBatch(ColVal *colVal, uint32_t startRow, VToken *v
                 tokenStart = startloc;
                 continue;
             } else if (splitTable[c] > 1) {  // es. chinese character
-                token.Reinit(&colVal->val[tokenStart], splitTable[c]);
+                if (tokenStart < startloc) {
+                    token.Reinit(&colVal->val[tokenStart], startloc - tokenStart);
+                    vtoken[i].Append(&token);
+                }
+                uint32_t charLen = splitTable[c];
+                if (startloc + charLen > endloc) {
+                    charLen = endloc - startloc;
+                }
+                token.Reinit(&colVal->val[startloc], charLen);
                 vtoken[i].Append(&token);
-                startloc += splitTable[c];
+                startloc += charLen;
                 tokenStart = startloc;
                 continue;
             }
@@ -125,7 +136,7 @@ uint32_t FullTextIndex::InsertPostingList(MemPool *pool, Invert *root, ListNode
             if (node == nullptr) {
                 ele = pool->GetInvertElement();
                 ele->token = vtoken[i].tokens[j];
-                ListInsertToTail(&hashSections[ele->token.data[0]], &ele->hashSection);
+                ListInsertToTail(&hashSections[(uint8_t)ele->token.data[0]], &ele->hashSection);
                 hashTable->InsertByToken(&ele->node, &(vtoken[i].tokens[j]));
                 nodeCount++;
             } else {
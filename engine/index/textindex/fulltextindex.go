@@ -0,0 +1,313 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+)
+
+// The pure-Go implementation of the full text index builder. It writes the same keys and posting
+// lists as the C++ builder in FullTextIndex.cpp and mempool.cpp, so that the index files do not
+// depend on the architecture which builds them.
+
+const (
+	bitsetContainerType uint8 = 1
+	arrayContainerType  uint8 = 2
+	runContainerType    uint8 = 3
+
+	cookieHeaderSize    = 6 // containers count(2B) + cardinality(4B)
+	containerHeaderSize = 5 // type(1B) + key(2B) + cardinality-1(2B)
+	bitsetContainerSize = 8192
+	containerValueMask  = 0xFFFF
+)
+
+// runPair is the run [value, value+length]
+type runPair struct {
+	value  uint16
+	length uint16
+}
+
+type runContainer struct {
+	key   uint16
+	card  uint32 // real cardinality
+	pairs []runPair
+}
+
+func getRunContainerSeriSize(nRuns int) int {
+	return containerHeaderSize + 2 + nRuns*4
+}
+
+func getBitsetContainerSeriSize() int {
+	return containerHeaderSize + bitsetContainerSize
+}
+
+func getArrayContainerSeriSize(card int) int {
+	return containerHeaderSize + card*2
+}
+
+// seriSize returns the serialized size and type of the container, the smallest one wins
+func (c *runContainer) seriSize() (int, uint8) {
+	size, typ := getRunContainerSeriSize(len(c.pairs)), runContainerType
+	if bitsetSize := getBitsetContainerSeriSize(); size > bitsetSize {
+		size, typ = bitsetSize, bitsetContainerType
+	}
+	if arraySize := getArrayContainerSeriSize(int(c.card)); size > arraySize {
+		size, typ = arraySize, arrayContainerType
+	}
+	return size, typ
+}
+
+func (c *runContainer) marshal(dst []byte, typ uint8) []byte {
+	dst = append(dst, typ)
+	dst = binary.BigEndian.AppendUint16(dst, c.key)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(c.card-1))
+	switch typ {
+	case runContainerType:
+		dst = binary.BigEndian.AppendUint16(dst, uint16(len(c.pairs)))
+		for _, p := range c.pairs {
+			dst = binary.BigEndian.AppendUint16(dst, p.value)
+			dst = binary.BigEndian.AppendUint16(dst, p.length)
+		}
+	case bitsetContainerType:
+		start := len(dst)
+		dst = append(dst, make([]byte, bitsetContainerSize)...)
+		bitset := dst[start:]
+		for _, p := range c.pairs {
+			for v := uint32(p.value); v <= uint32(p.value)+uint32(p.length); v++ {
+				// the bitset is the uint64 slice in big endian
+				word := bitset[(v>>6)<<3:]
+				word[7-(v&63)>>3] |= 1 << (v & 7)
+			}
+		}
+	case arrayContainerType:
+		for _, p := range c.pairs {
+			for v := uint32(p.value); v <= uint32(p.value)+uint32(p.length); v++ {
+				dst = binary.BigEndian.AppendUint16(dst, uint16(v))
+			}
+		}
+	}
+	return dst
+}
+
+type invertElement struct {
+	token      []byte
+	containers []*runContainer
+}
+
+// appendRowId appends the rowId to the posting list, the rowIds must be appended in ascending order
+func (e *invertElement) appendRowId(rowId uint32) {
+	key := uint16(rowId >> 16)
+	val := uint16(rowId & containerValueMask)
+	if len(e.containers) == 0 || e.containers[len(e.containers)-1].key != key {
+		e.containers = append(e.containers, &runContainer{key: key, card: 1, pairs: []runPair{{value: val}}})
+		return
+	}
+	c := e.containers[len(e.containers)-1]
+	p := &c.pairs[len(c.pairs)-1]
+	end := uint32(p.value) + uint32(p.length)
+	if uint32(val) <= end {
+		return
+	}
+	if uint32(val) == end+1 {
+		p.length++
+	} else {
+		c.pairs = append(c.pairs, runPair{value: val})
+	}
+	c.card++
+}
+
+type goTextIndexBuilder struct {
+	// 0: split char, 1: ascii char, n: the length of the utf-8 character
+	splitTable [256]uint8
+}
+
+func newGoTextIndexBuilder(splitChars string) *goTextIndexBuilder {
+	b := &goTextIndexBuilder{}
+	for i := range b.splitTable {
+		switch {
+		case i < 0x80:
+			b.splitTable[i] = 1
+		case i < 0xe0:
+			b.splitTable[i] = 2
+		case i < 0xf0:
+			b.splitTable[i] = 3
+		case i < 0xf8:
+			b.splitTable[i] = 4
+		case i < 0xfc:
+			b.splitTable[i] = 5
+		default:
+			b.splitTable[i] = 6
+		}
+	}
+	for i := 0; i < len(splitChars); i++ {
+		// only the ascii characters are the split chars
+		if splitChars[i] < 0x80 {
+			b.splitTable[splitChars[i]] = 0
+		}
+	}
+	return b
+}
+
+// tokenize splits the row into the ascii words and the single non-ascii characters
+func (b *goTextIndexBuilder) tokenize(row []byte, fn func(token []byte)) {
+	tokenStart, loc := 0, 0
+	for loc < len(row) {
+		n := int(b.splitTable[row[loc]])
+		if n == 1 {
+			loc++
+			continue
+		}
+		if tokenStart < loc {
+			fn(row[tokenStart:loc])
+		}
+		if n == 0 {
+			loc++
+		} else {
+			end := loc + n
+			if end > len(row) {
+				end = len(row)
+			}
+			fn(row[loc:end])
+			loc = end
+		}
+		tokenStart = loc
+	}
+	if tokenStart < loc {
+		fn(row[tokenStart:loc])
+	}
+}
+
+// addDocument builds the inverted index of the rows [startRow, endRow). The tokens refer to val,
+// which must be kept until the posting lists are retrieved.
+func (b *goTextIndexBuilder) addDocument(val []byte, offset []uint32, startRow, endRow int) *goInvertMemElement {
+	elements := make(map[string]*invertElement)
+	for row := startRow; row < endRow; row++ {
+		start, end := offset[row], uint32(len(val))
+		if row < len(offset)-1 {
+			end = offset[row+1]
+		}
+		b.tokenize(val[start:end], func(token []byte) {
+			ele, ok := elements[string(token)]
+			if !ok {
+				ele = &invertElement{token: token}
+				elements[string(token)] = ele
+			}
+			ele.appendRowId(uint32(row))
+		})
+	}
+
+	m := &goInvertMemElement{group: make([]*invertElement, 0, len(elements))}
+	for _, ele := range elements {
+		m.group = append(m.group, ele)
+	}
+	sort.Slice(m.group, func(i, j int) bool {
+		return bytes.Compare(m.group[i].token, m.group[j].token) < 0
+	})
+	return m
+}
+
+// goInvertMemElement holds the sorted inverted elements of the rows, and retrieves them block by block
+type goInvertMemElement struct {
+	group    []*invertElement
+	iter     int
+	keysOffs []byte
+	dataOffs []byte
+}
+
+// addPosting adds the rowId to the posting list of the key, the keys keep the order of the first insertion
+func (m *goInvertMemElement) addPosting(key []byte, rowId uint32) {
+	for _, ele := range m.group {
+		if bytes.Equal(ele.token, key) {
+			ele.appendRowId(rowId)
+			return
+		}
+	}
+	ele := &invertElement{token: append([]byte{}, key...)}
+	ele.appendRowId(rowId)
+	m.group = append(m.group, ele)
+}
+
+// next serializes the keys and posting lists into keys and data until one of them is full. The layout is:
+// keys: key0 | key1 | ... | keyN | keysOffs(end offset of each key, 4B BE)
+// data: cookieHeader | container0 | container1 | ... | dataOffs(end offset of each posting list, 4B BE)
+// The posting list which does not fit in is split and continued in the next block.
+// The res is the same as the C++ builder, and the return value reports if there is more data.
+func (m *goInvertMemElement) next(keys, data []byte, res []uint32) bool {
+	keys, data = keys[:0], data[:0]
+	keysLen, dataLen := cap(keys), cap(data)
+	m.keysOffs, m.dataOffs = m.keysOffs[:0], m.dataOffs[:0]
+	firstTokenEnd, lastTokenStart := 0, 0
+	startIter := m.iter
+
+	for m.iter < len(m.group) {
+		ele := m.group[m.iter]
+		if len(ele.containers) == 0 {
+			m.iter++
+			continue
+		}
+		// at least there is still memory space to copy a post container
+		seriSize, _ := ele.containers[0].seriSize()
+		if len(keys)+len(ele.token)+len(m.keysOffs)+4 > keysLen ||
+			len(data)+seriSize+cookieHeaderSize+len(m.dataOffs)+4 > dataLen {
+			break
+		}
+
+		if firstTokenEnd == 0 {
+			firstTokenEnd = len(ele.token)
+		}
+		lastTokenStart = len(keys)
+		keys = append(keys, ele.token...)
+		m.keysOffs = binary.BigEndian.AppendUint32(m.keysOffs, uint32(len(keys)))
+
+		cookieHeader := len(data)
+		data = append(data, make([]byte, cookieHeaderSize)...)
+		containerCnt, cardinality := 0, uint32(0)
+		for _, c := range ele.containers {
+			seriSize, typ := c.seriSize()
+			if len(data)+seriSize+len(m.dataOffs)+4 > dataLen {
+				break
+			}
+			data = c.marshal(data, typ)
+			containerCnt++
+			cardinality += c.card
+		}
+		binary.BigEndian.PutUint16(data[cookieHeader:], uint16(containerCnt))
+		binary.BigEndian.PutUint32(data[cookieHeader+2:], cardinality)
+		m.dataOffs = binary.BigEndian.AppendUint32(m.dataOffs, uint32(len(data)))
+
+		ele.containers = ele.containers[containerCnt:]
+		if len(ele.containers) == 0 {
+			m.group[m.iter] = nil
+			m.iter++
+		}
+	}
+
+	keysSize, dataSize := len(keys), len(data)
+	keys = append(keys, m.keysOffs...)
+	data = append(data, m.dataOffs...)
+	res[0], res[1] = 0, uint32(firstTokenEnd)
+	res[2], res[3] = uint32(lastTokenStart), uint32(keysSize)
+	res[4], res[5] = uint32(keysSize), uint32(len(keys))
+	res[6], res[7] = uint32(dataSize), uint32(len(data))
+	res[8] = uint32(m.iter - startIter)
+	return m.iter < len(m.group)
+}
+
+func (m *goInvertMemElement) reset() {
+	m.group = m.group[:0]
+	m.iter = 0
+}
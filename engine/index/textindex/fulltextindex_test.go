@@ -0,0 +1,175 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textindex
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// decodePostingBlocks reads the blocks like the TextIndexFilterReader does, and returns the rowIds of each key
+func decodePostingBlocks(t *testing.T, retrieve func(data *BlockData, bh *BlockHeader) bool, keysCap, dataCap int) ([]string, map[string][]uint32) {
+	data := &BlockData{Keys: make([]byte, 0, keysCap), Data: make([]byte, 0, dataCap)}
+	bh := NewBlockHeader()
+	var keys []string
+	postings := make(map[string][]uint32)
+	for next := true; next; {
+		next = retrieve(data, bh)
+		require.True(t, bh.KeysSize > 0 || !next, "no progress in the block")
+		keysOffs := UnarshalUint32Slice(data.Keys[bh.KeysSize:])
+		postsOffs := UnarshalUint32Slice(data.Data[bh.PostSize:])
+		require.Equal(t, len(keysOffs), len(postsOffs))
+		for i := range keysOffs {
+			start, end := DecodeOffs(keysOffs, i)
+			key := string(data.Keys[start:end])
+			if i == 0 {
+				require.Equal(t, key, string(bh.FirstItem))
+			}
+			if i == len(keysOffs)-1 {
+				require.Equal(t, key, string(bh.LastItem))
+			}
+			start, end = DecodeOffs(postsOffs, i)
+			containers, err := UnmarshalContainer(data.Data[start:end])
+			require.NoError(t, err)
+			if _, ok := postings[key]; !ok {
+				keys = append(keys, key)
+			}
+			for _, c := range containers {
+				postings[key] = append(postings[key], c.Serialize()...)
+			}
+		}
+		data.Keys, data.Data = data.Keys[:0], data.Data[:0]
+		bh.Reset()
+	}
+	return keys, postings
+}
+
+func goPostingListRetriever(m *goInvertMemElement) func(data *BlockData, bh *BlockHeader) bool {
+	return func(data *BlockData, bh *BlockHeader) bool {
+		res := make([]uint32, 9)
+		next := m.next(data.Keys, data.Data, res)
+		bh.KeysSize, bh.KeysUnpackSize, bh.PostSize, bh.PostUnpackSize, bh.ItemsCount = res[4], res[5], res[6], res[7], res[8]
+		data.Keys, data.Data = data.Keys[:res[5]], data.Data[:res[7]]
+		bh.FirstItem, bh.LastItem = data.Keys[res[0]:res[1]], data.Keys[res[2]:res[3]]
+		return next
+	}
+}
+
+func TestGoTextIndexBuilder_Tokenize(t *testing.T) {
+	b := newGoTextIndexBuilder(" ？‘;.<>{}[],/")
+	for row, expected := range map[string][]string{
+		"client ip is 10.20.30.15": {"client", "ip", "is", "10", "20", "30", "15"},
+		"  a,,b  ":                 {"a", "b"},
+		"this is a 中文日志":           {"this", "is", "a", "中", "文", "日", "志"},
+		"ab中cd？e":                  {"ab", "中", "cd", "？", "e"},
+		"Safari/534.24\xe4":        {"Safari", "534", "24", "\xe4"},
+		"":                         nil,
+		"Mozilla/5.0 (Windows NT)": {"Mozilla", "5", "0", "(Windows", "NT)"},
+	} {
+		var tokens []string
+		b.tokenize([]byte(row), func(token []byte) {
+			tokens = append(tokens, string(token))
+		})
+		require.Equal(t, expected, tokens, row)
+	}
+}
+
+func TestGoTextIndexBuilder_AddDocument(t *testing.T) {
+	rec, _ := NewRecordSplitForTextIndex()
+	b := newGoTextIndexBuilder(" ？‘;.<>{}[],")
+	col := &rec.ColVals[0]
+
+	expected := make(map[string][]uint32)
+	for row := 1000; row < col.Len; row++ {
+		val, _ := col.StringValueUnsafe(row)
+		b.tokenize([]byte(val), func(token []byte) {
+			ids := expected[string(token)]
+			if len(ids) == 0 || ids[len(ids)-1] != uint32(row) {
+				expected[string(token)] = append(ids, uint32(row))
+			}
+		})
+	}
+
+	for _, caps := range [][2]int{{dataBufSize, dataBufSize}, {64, 16384}, {32, 8400}} {
+		m := b.addDocument(col.Val, col.Offset, 1000, col.Len)
+		keys, postings := decodePostingBlocks(t, goPostingListRetriever(m), caps[0], caps[1])
+		require.Equal(t, expected, postings)
+		for i := 1; i < len(keys); i++ {
+			require.True(t, keys[i-1] < keys[i], "keys are not sorted: %s, %s", keys[i-1], keys[i])
+		}
+	}
+}
+
+func TestGoTextIndexBuilder_Containers(t *testing.T) {
+	m := &goInvertMemElement{}
+	var rowIds []uint32
+	rowIds = GenBitsetContainerRowsId(rowIds, 0)
+	rowIds = GenArrayContainerRowsId(rowIds, 1)
+	rowIds = GenRunContainerRowsId(rowIds, 2)
+	for _, id := range rowIds {
+		m.addPosting([]byte("test"), id)
+		m.addPosting([]byte("test"), id) // duplicated rowIds are ignored
+	}
+	require.Equal(t, 1, len(m.group))
+	var types []uint8
+	for _, c := range m.group[0].containers {
+		_, typ := c.seriSize()
+		types = append(types, typ)
+	}
+	require.Equal(t, []uint8{bitsetContainerType, arrayContainerType, runContainerType}, types)
+
+	keys, postings := decodePostingBlocks(t, goPostingListRetriever(m), 64, 9000) // the posting list is split into two blocks
+	require.Equal(t, []string{"test"}, keys)
+	require.Equal(t, rowIds, postings["test"])
+}
+
+func TestGoTextIndexBuilder_Query(t *testing.T) {
+	rec := record.NewRecord([]record.Field{{Name: "_log", Type: influx.Field_Type_String}}, false)
+	for _, row := range []string{"GET /index.html 200", "POST /api 500", "GET /api 200", "日志 GET"} {
+		rec.ColVals[0].AppendString(row)
+	}
+	col := &rec.ColVals[0]
+	m := newGoTextIndexBuilder(" /.").addDocument(col.Val, col.Offset, 0, col.Len)
+
+	data, bh := NewBlockData(), NewBlockHeader()
+	require.False(t, goPostingListRetriever(m)(data, bh))
+	bd := &BlockReadData{
+		unpackKeyData:  data.Keys,
+		keysOffs:       UnarshalUint32Slice(data.Keys[bh.KeysSize:]),
+		unpackPostData: data.Data,
+		postsOffs:      UnarshalUint32Slice(data.Data[bh.PostSize:]),
+	}
+	for query, expected := range map[string][]uint32{
+		"GET":   {0, 2, 3},
+		"api":   {1, 2},
+		"200":   {0, 2},
+		"日":     {3},
+		"index": {0},
+		"PUT":   nil,
+	} {
+		containers, err := bd.Query(query)
+		require.NoError(t, err)
+		var rowIds []uint32
+		for _, c := range containers {
+			rowIds = append(rowIds, c.Serialize()...)
+		}
+		require.Equal(t, expected, rowIds, query)
+	}
+	require.Equal(t, "200", string(bh.FirstItem))
+	require.Equal(t, "日", string(bh.LastItem))
+}
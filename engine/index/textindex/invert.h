@@ -1,4 +1,4 @@
-//go:build linux && amd64
+//go:build linux && amd64 && !purego
 // Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -319,7 +319,7 @@ struct Token {
                 i++;
                 continue;
             }
-            if (data[i] < t.data[i]) {
+            if ((uint8_t)data[i] < (uint8_t)t.data[i]) {
                 return true;
             } else {
                 return false;
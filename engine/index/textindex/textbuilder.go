@@ -1,6 +1,4 @@
-//go:build !(linux && amd64)
-
-package textindex
+//go:build !(linux && amd64) || purego
 
 // Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
 //
@@ -16,34 +14,57 @@ package textindex
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+package textindex
+
+import "fmt"
+
 type FullTextIndexBuilder struct {
+	builder *goTextIndexBuilder
 }
 
 type InvertMemElement struct {
+	memElement *goInvertMemElement
 }
 
 func (b *FullTextIndexBuilder) AddDocument(val []byte, offset []uint32, startRow int, endRow int) (*InvertMemElement, error) {
-	return nil, nil
+	if endRow > len(offset) || startRow > endRow {
+		return nil, fmt.Errorf("add document to full text index failed, invalid rows [%d, %d) of %d", startRow, endRow, len(offset))
+	}
+	return &InvertMemElement{memElement: b.builder.addDocument(val, offset, startRow, endRow)}, nil
 }
 
+// res is the same as RetrievePostingList of the cgo builder
 func RetrievePostingList(memElement *InvertMemElement, data *BlockData, bh *BlockHeader) bool {
-	return false
+	res := make([]uint32, 9)
+	next := memElement.memElement.next(data.Keys, data.Data, res)
+	bh.KeysSize = res[4]
+	bh.KeysUnpackSize = res[5]
+	bh.PostSize = res[6]
+	bh.PostUnpackSize = res[7]
+	bh.ItemsCount = res[8]
+	data.Keys = data.Keys[:bh.KeysUnpackSize]
+	data.Data = data.Data[:bh.PostUnpackSize]
+	bh.FirstItem = data.Keys[res[0]:res[1]]
+	bh.LastItem = data.Keys[res[2]:res[3]]
+	return next
 }
 
 func NewFullTextIndexBuilder(splitChars string, hasChin bool) *FullTextIndexBuilder {
-	return nil
+	return &FullTextIndexBuilder{builder: newGoTextIndexBuilder(splitChars)}
 }
 
 func FreeFullTextIndexBuilder(builder *FullTextIndexBuilder) {
 }
 
 func PutInvertMemElement(ele *InvertMemElement) {
+	ele.memElement.reset()
 }
 
 func GetMemElement(groupSize uint32) *InvertMemElement {
-	return &InvertMemElement{}
+	return &InvertMemElement{memElement: &goInvertMemElement{group: make([]*invertElement, 0, groupSize)}}
 }
 
 func AddPostingToMem(memElement *InvertMemElement, key []byte, rowId uint32) bool {
+	memElement.memElement.addPosting(key, rowId)
 	return true
 }
@@ -1,3 +1,5 @@
+//go:build linux && amd64 && !purego
+
 // Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
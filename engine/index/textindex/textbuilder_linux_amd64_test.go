@@ -0,0 +1,134 @@
+//go:build linux && amd64 && !purego
+
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textindex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecordForTextBuilder(rows int) *record.Record {
+	rec := record.NewRecord([]record.Field{{Name: "_log", Type: influx.Field_Type_String}}, false)
+	rawData := []string{
+		"Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/534.24 (KHTML, like Gecko) Chrome/11.0.696.12 Safari/534.24",
+		"this is a 中文日志，包含ascii字符abc",
+		"client ip is 10.20.30.%d",
+		"",
+		"user%d login failed？retry",
+	}
+	for i := 0; i < rows; i++ {
+		row := rawData[i%len(rawData)]
+		if i%len(rawData) == 2 || i%len(rawData) == 4 {
+			row = fmt.Sprintf(row, i%300)
+		}
+		rec.ColVals[0].AppendString(row)
+	}
+	return rec
+}
+
+// the cgo builder and the pure-Go builder must write the same blocks
+func TestTextBuilder_CgoAndGoEquivalent(t *testing.T) {
+	splitChars := " ？‘;.<>{}[],/"
+	rec := newRecordForTextBuilder(140000)
+	col := &rec.ColVals[0]
+
+	cgoBuilder := NewFullTextIndexBuilder(splitChars, false)
+	defer FreeFullTextIndexBuilder(cgoBuilder)
+	goBuilder := newGoTextIndexBuilder(splitChars)
+
+	for _, rows := range [][2]int{{0, 5}, {0, col.Len}, {70000, 139999}} {
+		for _, caps := range [][2]int{{dataBufSize, dataBufSize}, {64, 16384}} {
+			cgoEle, err := cgoBuilder.AddDocument(col.Val, col.Offset, rows[0], rows[1])
+			require.NoError(t, err)
+			goEle := goBuilder.addDocument(col.Val, col.Offset, rows[0], rows[1])
+			retrieveGo := goPostingListRetriever(goEle)
+
+			cgoData := &BlockData{Keys: make([]byte, 0, caps[0]), Data: make([]byte, 0, caps[1])}
+			goData := &BlockData{Keys: make([]byte, 0, caps[0]), Data: make([]byte, 0, caps[1])}
+			cgoBh, goBh := NewBlockHeader(), NewBlockHeader()
+			blocks := 0
+			for next := true; next; blocks++ {
+				next = RetrievePostingList(cgoEle, cgoData, cgoBh)
+				require.Equal(t, next, retrieveGo(goData, goBh), "block %d", blocks)
+				require.Equal(t, cgoBh, goBh, "block %d", blocks)
+				require.Equal(t, cgoData.Keys, goData.Keys, "block %d", blocks)
+				require.Equal(t, cgoData.Data, goData.Data, "block %d", blocks)
+				cgoData.Keys, cgoData.Data = cgoData.Keys[:0], cgoData.Data[:0]
+				goData.Keys, goData.Data = goData.Keys[:0], goData.Data[:0]
+				cgoBh.Reset()
+				goBh.Reset()
+			}
+			PutInvertMemElement(cgoEle)
+			require.True(t, blocks > 1 || caps[0] == dataBufSize)
+		}
+	}
+}
+
+func TestTextBuilder_CgoAndGoContainers(t *testing.T) {
+	var rowIds []uint32
+	rowIds = GenBitsetContainerRowsId(rowIds, 0)
+	rowIds = GenArrayContainerRowsId(rowIds, 1)
+	rowIds = GenRunContainerRowsId(rowIds, 2)
+	rowIds = GenBitsetContainerRowsId(rowIds, 3)
+
+	cgoEle := GetMemElement(16)
+	require.NotNil(t, cgoEle)
+	defer PutInvertMemElement(cgoEle)
+	goEle := &goInvertMemElement{}
+	// the cgo builder refers to the keys until the posting lists are retrieved
+	keys := [][]byte{[]byte("b"), []byte("a")}
+	for _, key := range keys {
+		for _, id := range rowIds {
+			require.True(t, AddPostingToMem(cgoEle, key, id))
+			goEle.addPosting(key, id)
+		}
+	}
+
+	cgoKeys, cgoPostings := decodePostingBlocks(t, func(data *BlockData, bh *BlockHeader) bool {
+		return RetrievePostingList(cgoEle, data, bh)
+	}, 64, 9000)
+	goKeys, goPostings := decodePostingBlocks(t, goPostingListRetriever(goEle), 64, 9000)
+	require.Equal(t, []string{"b", "a"}, goKeys)
+	require.Equal(t, cgoKeys, goKeys)
+	require.Equal(t, cgoPostings, goPostings)
+	require.Equal(t, rowIds, goPostings["a"])
+}
+
+// the tokens are split at the non-ascii split chars and the chinese characters, and the keys are
+// sorted as the unsigned bytes, which is the order the reader searches the keys in
+func TestTextBuilder_NonASCIITokens(t *testing.T) {
+	rec := record.NewRecord([]record.Field{{Name: "_log", Type: influx.Field_Type_String}}, false)
+	rec.ColVals[0].AppendString("log中文 retry")
+	col := &rec.ColVals[0]
+
+	builder := NewFullTextIndexBuilder(" ", false)
+	defer FreeFullTextIndexBuilder(builder)
+	ele, err := builder.AddDocument(col.Val, col.Offset, 0, col.Len)
+	require.NoError(t, err)
+	defer PutInvertMemElement(ele)
+
+	data := &BlockData{Keys: make([]byte, 0, 1024), Data: make([]byte, 0, 1024)}
+	bh := NewBlockHeader()
+	require.False(t, RetrievePostingList(ele, data, bh))
+	require.Equal(t, uint32(4), bh.ItemsCount)
+	require.Equal(t, "log", string(bh.FirstItem))
+	require.Equal(t, "文", string(bh.LastItem))
+}
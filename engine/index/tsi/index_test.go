@@ -502,6 +502,55 @@ func TestSearchSeriesWithOpts(t *testing.T) {
 	})
 }
 
+func TestSearchSeriesByScan(t *testing.T) {
+	path := t.TempDir()
+	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	CreateIndexByPts(idx, []string{
+		"mn-1,tk1=value1",
+		"mn-1,tk1=value1,tk2=value2,tk3=value3",
+		"mn-1,tk1=value2,tk2=value22",
+		"mn-1,tk2=value2",
+	}...)
+	name := []byte("mn-1_0000")
+
+	search := func(condition string, hint hybridqp.HintType) map[uint64]string {
+		opt := &query.ProcessorOptions{
+			StartTime: DefaultTR.Min,
+			EndTime:   DefaultTR.Max,
+			Condition: MustParseExpr(condition),
+			HintType:  hint,
+		}
+		itr, err := idx.(*MergeSetIndex).SearchSeriesIterator(nil, name, opt)
+		require.NoError(t, err)
+		series := make(map[uint64]string)
+		for {
+			se, err := itr.Next()
+			require.NoError(t, err)
+			if se.SeriesID == 0 {
+				return series
+			}
+			series[se.SeriesID] = ""
+			if se.Expr != nil {
+				series[se.SeriesID] = se.Expr.String()
+			}
+		}
+	}
+
+	for _, condition := range []string{
+		`tk1='value1'`,
+		`tk1!='value1'`,
+		`tk1=~/value/ AND tk2!~/22/`,
+		`(tk1='value2' OR tk2='value2') AND field_float1>1.0`,
+		`tk1='value1' AND field_float1>1.0 AND field_str0='a'`,
+		`tk1='value1' OR field_float1>1.0`,
+		`field_float1>1.0`,
+	} {
+		require.Equal(t, search(condition, hybridqp.DefaultNoHint), search(condition, hybridqp.SeriesScanQuery), condition)
+	}
+	require.Equal(t, 3, len(search(`tk2=~/value/ AND field_float1>1.0`, hybridqp.SeriesScanQuery)))
+}
+
 func TestSearchSeriesWithExcept(t *testing.T) {
 	path := t.TempDir()
 	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
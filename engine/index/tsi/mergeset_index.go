@@ -933,7 +933,12 @@ func (idx *MergeSetIndex) SearchSeriesIterator(span *tracing.Span, name []byte,
 	is := idx.getIndexSearch()
 
 	is.setDeleted(idx.getDeletedTSIDs())
-	itr, err := is.measurementSeriesByExprIterator(name, opt.Condition, singleSeries, tsid)
+	var itr index.SeriesIDIterator
+	if opt.GetHintType() == hybridqp.SeriesScanQuery {
+		itr, err = is.measurementSeriesByScan(name, opt.Condition)
+	} else {
+		itr, err = is.measurementSeriesByExprIterator(name, opt.Condition, singleSeries, tsid)
+	}
 	if search != nil {
 		search.Finish()
 	}
@@ -35,6 +35,7 @@ import (
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/bytesutil"
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
 	"github.com/openGemini/openGemini/engine/index/mergeindex"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/index"
@@ -1226,6 +1227,102 @@ func (is *indexSearch) measurementSeriesByExprIterator(name []byte, expr influxq
 	return itr, err
 }
 
+// measurementSeriesByScan finds the series like measurementSeriesByExprIterator, but the tag filter is evaluated
+// on the key of every series of the measurement instead of being looked up in the index. It is chosen by the
+// cost based optimizer when the tag filter reads more from the index than the keys of the series.
+func (is *indexSearch) measurementSeriesByScan(name []byte, expr influxql.Expr) (index.SeriesIDIterator, error) {
+	tagExpr, otherExpr, ok := optimizer.SplitTagCondition(expr)
+	if !ok {
+		return is.measurementSeriesByExprIterator(name, expr, false, 0)
+	}
+
+	tsids, err := is.searchTSIDsByTimeRange(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var seriesKeys [][]byte
+	var combineKey []byte
+	var tags influx.PointTags
+	matched := &uint64set.Set{}
+	deleted := is.idx.getDeletedTSIDs()
+	itr := tsids.Iterator()
+	for itr.HasNext() {
+		tsid := itr.Next()
+		if deleted.Has(tsid) {
+			continue
+		}
+		combineKey, err = is.idx.searchSeriesKey(combineKey[:0], tsid)
+		if err != nil {
+			if errno.Equal(err, errno.ErrSearchSeriesKey) {
+				continue
+			}
+			return nil, err
+		}
+		seriesKeys, _, err = unmarshalCombineIndexKeys(seriesKeys, combineKey)
+		if err != nil {
+			return nil, err
+		}
+		// the series sharing the tsid with the tag array are filtered again when they are grouped
+		for _, seriesKey := range seriesKeys {
+			if _, err = influx.IndexKeyToTags(seriesKey, true, &tags); err != nil {
+				return nil, err
+			}
+			if matchSeriesTags(&tags, tagExpr) {
+				matched.Add(tsid)
+				break
+			}
+		}
+	}
+
+	if otherExpr == nil {
+		return index.NewSeriesIDSetIterator(index.NewSeriesIDSetWithSet(matched)), nil
+	}
+	n, err := expr2BinaryExpr(otherExpr)
+	if err != nil {
+		return nil, err
+	}
+	return is.genSeriesIDIterator(matched, n), nil
+}
+
+// matchSeriesTags evaluates a tag filter split by optimizer.SplitTagCondition on the tags of a series,
+// a missing tag has an empty value like in the index.
+func matchSeriesTags(tags *influx.PointTags, expr influxql.Expr) bool {
+	switch e := expr.(type) {
+	case *influxql.ParenExpr:
+		return matchSeriesTags(tags, e.Expr)
+	case *influxql.BinaryExpr:
+		switch e.Op {
+		case influxql.AND:
+			return matchSeriesTags(tags, e.LHS) && matchSeriesTags(tags, e.RHS)
+		case influxql.OR:
+			return matchSeriesTags(tags, e.LHS) || matchSeriesTags(tags, e.RHS)
+		}
+
+		ref, ok := e.LHS.(*influxql.VarRef)
+		value := e.RHS
+		if !ok {
+			ref, ok = e.RHS.(*influxql.VarRef)
+			value = e.LHS
+		}
+		if !ok {
+			return false
+		}
+		var tagValue string
+		if tag := tags.FindPointTag(ref.Val); tag != nil {
+			tagValue = tag.Value
+		}
+
+		switch value := value.(type) {
+		case *influxql.StringLiteral:
+			return (tagValue == value.Val) == (e.Op == influxql.EQ)
+		case *influxql.RegexLiteral:
+			return value.Val.MatchString(tagValue) == (e.Op == influxql.EQREGEX)
+		}
+	}
+	return false
+}
+
 func (is *indexSearch) searchTSIDs(name []byte, expr influxql.Expr, tr TimeRange) ([]uint64, error) {
 	if tr.Min < 0 {
 		tr.Min = 0
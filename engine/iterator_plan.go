@@ -26,6 +26,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/record"
@@ -138,11 +139,23 @@ func buildMultiSourcePlan(srcCursors [][]comm.KeyCursor, cursorsN int) (executor
 	return plan, nil
 }
 
-func (s *shard) LogicalPlanCost(_ influxql.Sources, _ query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *shard) LogicalPlanCost(sources influxql.Sources, _ query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
+	stats := optimizer.NewStatistics()
+	for _, src := range sources {
+		mst, ok := src.(*influxql.Measurement)
+		if !ok {
+			continue
+		}
+		mstStats, err := s.CollectStatistics(mst.Name, nil)
+		if err != nil {
+			return hybridqp.LogicalPlanCost{}, err
+		}
+		stats.Merge(mstStats)
+	}
 
-	panic("impl me")
+	cost := stats.PlanCost()
+	cost.NumShards = 1
+	return cost, nil
 }
 
 type item struct {
@@ -0,0 +1,102 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const (
+	DefaultStatisticsTTL       = time.Minute
+	DefaultStatisticsCacheSize = 100000
+)
+
+// StatisticsProvider is implemented by the shard groups which are able to collect
+// the statistics of the shards they mapped.
+type StatisticsProvider interface {
+	Statistics(m *influxql.Measurement, tagKeys []string) (*Statistics, error)
+}
+
+type StatisticsKey struct {
+	ShardID     uint64
+	Measurement string
+}
+
+type statisticsItem struct {
+	stats  *Statistics
+	expire time.Time
+}
+
+// StatisticsCache keeps the statistics of each shard for a while, so that planning a query
+// does not have to ask every ts-store.
+type StatisticsCache struct {
+	mu    sync.RWMutex
+	ttl   time.Duration
+	size  int
+	items map[StatisticsKey]statisticsItem
+}
+
+func NewStatisticsCache(ttl time.Duration, size int) *StatisticsCache {
+	return &StatisticsCache{
+		ttl:   ttl,
+		size:  size,
+		items: make(map[StatisticsKey]statisticsItem),
+	}
+}
+
+func (c *StatisticsCache) Get(key StatisticsKey) (*Statistics, bool) {
+	c.mu.RLock()
+	item, ok := c.items[key]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(item.expire) {
+		return nil, false
+	}
+	return item.stats, true
+}
+
+func (c *StatisticsCache) Put(key StatisticsKey, stats *Statistics) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.items[key]; !ok && len(c.items) >= c.size {
+		c.evict(now)
+	}
+	c.items[key] = statisticsItem{stats: stats, expire: now.Add(c.ttl)}
+}
+
+// evict drops the expired items, or some random items when nothing has expired yet
+func (c *StatisticsCache) evict(now time.Time) {
+	for k, item := range c.items {
+		if now.After(item.expire) {
+			delete(c.items, k)
+		}
+	}
+	for k := range c.items {
+		if len(c.items) < c.size {
+			break
+		}
+		delete(c.items, k)
+	}
+}
+
+func (c *StatisticsCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.items)
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatisticsCache(t *testing.T) {
+	c := optimizer.NewStatisticsCache(time.Hour, 2)
+	k1 := optimizer.StatisticsKey{ShardID: 1, Measurement: "cpu"}
+	k2 := optimizer.StatisticsKey{ShardID: 2, Measurement: "cpu"}
+	k3 := optimizer.StatisticsKey{ShardID: 3, Measurement: "cpu"}
+
+	_, ok := c.Get(k1)
+	assert.False(t, ok)
+
+	c.Put(k1, newTestStatistics())
+	c.Put(k2, newTestStatistics())
+	c.Put(k3, newTestStatistics())
+	assert.Equal(t, 2, c.Len())
+	got, ok := c.Get(k3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1000), got.SeriesN)
+
+	expired := optimizer.NewStatisticsCache(-time.Second, 2)
+	expired.Put(k1, newTestStatistics())
+	_, ok = expired.Get(k1)
+	assert.False(t, ok)
+	expired.Put(k2, newTestStatistics())
+	expired.Put(k3, newTestStatistics())
+	assert.Equal(t, 1, expired.Len())
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// SplitTagCondition splits a condition which is a conjunction of a tag filter and of an expression
+// referring to no tag, e.g. "host = 'a' AND region =~ /east/ AND value > 1".
+// false is returned when there is no tag filter, or when a term of the conjunction mixes tags and fields.
+func SplitTagCondition(condition influxql.Expr) (tagExpr influxql.Expr, otherExpr influxql.Expr, ok bool) {
+	for _, term := range conjunction(condition, nil) {
+		if isTagFilter(term) {
+			tagExpr = and(tagExpr, term)
+			continue
+		}
+		if hasTagRef(term) {
+			return nil, nil, false
+		}
+		otherExpr = and(otherExpr, term)
+	}
+	return tagExpr, otherExpr, tagExpr != nil
+}
+
+func conjunction(expr influxql.Expr, terms []influxql.Expr) []influxql.Expr {
+	switch e := expr.(type) {
+	case nil:
+		return terms
+	case *influxql.ParenExpr:
+		if inner, ok := e.Expr.(*influxql.BinaryExpr); ok && inner.Op == influxql.AND {
+			return conjunction(inner, terms)
+		}
+		if _, ok := e.Expr.(*influxql.ParenExpr); ok {
+			return conjunction(e.Expr, terms)
+		}
+	case *influxql.BinaryExpr:
+		if e.Op == influxql.AND {
+			return conjunction(e.RHS, conjunction(e.LHS, terms))
+		}
+	}
+	return append(terms, expr)
+}
+
+func and(lhs, rhs influxql.Expr) influxql.Expr {
+	if lhs == nil {
+		return rhs
+	}
+	return &influxql.BinaryExpr{Op: influxql.AND, LHS: lhs, RHS: rhs}
+}
+
+// isTagFilter reports whether the expression only compares tags with string or regex literals,
+// such an expression can be evaluated either by the index or on the key of a series.
+func isTagFilter(expr influxql.Expr) bool {
+	switch e := expr.(type) {
+	case *influxql.ParenExpr:
+		return isTagFilter(e.Expr)
+	case *influxql.BinaryExpr:
+		switch e.Op {
+		case influxql.AND, influxql.OR:
+			return isTagFilter(e.LHS) && isTagFilter(e.RHS)
+		case influxql.EQ, influxql.NEQ, influxql.EQREGEX, influxql.NEQREGEX:
+			ref, value := e.LHS, e.RHS
+			if !isTagRef(ref) {
+				ref, value = value, ref
+			}
+			if !isTagRef(ref) {
+				return false
+			}
+			switch value.(type) {
+			case *influxql.StringLiteral:
+				return e.Op == influxql.EQ || e.Op == influxql.NEQ
+			case *influxql.RegexLiteral:
+				return e.Op == influxql.EQREGEX || e.Op == influxql.NEQREGEX
+			}
+		}
+	}
+	return false
+}
+
+func isTagRef(expr influxql.Expr) bool {
+	ref, ok := expr.(*influxql.VarRef)
+	return ok && ref.Type == influxql.Tag
+}
+
+func hasTagRef(expr influxql.Expr) bool {
+	var found bool
+	influxql.WalkFunc(expr, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok && ref.Type == influxql.Tag {
+			found = true
+		}
+	})
+	return found
+}
+
+// tagFilterLeaves returns the comparisons of a tag filter
+func tagFilterLeaves(expr influxql.Expr, leaves []*influxql.BinaryExpr) []*influxql.BinaryExpr {
+	switch e := expr.(type) {
+	case *influxql.ParenExpr:
+		return tagFilterLeaves(e.Expr, leaves)
+	case *influxql.BinaryExpr:
+		if e.Op == influxql.AND || e.Op == influxql.OR {
+			return tagFilterLeaves(e.RHS, tagFilterLeaves(e.LHS, leaves))
+		}
+		return append(leaves, e)
+	}
+	return leaves
+}
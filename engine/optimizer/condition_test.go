@@ -0,0 +1,57 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitTagCondition(t *testing.T) {
+	for _, c := range []struct {
+		condition string
+		tagExpr   string
+		otherExpr string
+		ok        bool
+	}{
+		{condition: "host::tag = 'a'", tagExpr: "host::tag = 'a'", ok: true},
+		{condition: "(host::tag = 'a' OR host::tag =~ /b/) AND value::float > 1 AND region::tag != 'east'",
+			tagExpr: "(host::tag = 'a' OR host::tag =~ /b/) AND region::tag != 'east'", otherExpr: "value::float > 1", ok: true},
+		{condition: "value::float > 1 AND (v2::integer = 1 OR v3::integer = 1)"},
+		{condition: "host::tag = 'a' OR value::float > 1"},
+		{condition: "host::tag = 'a' AND (region::tag = 'east' OR value::float > 1)"},
+		{condition: "host::tag = region::tag"},
+		{},
+	} {
+		var condition influxql.Expr
+		if c.condition != "" {
+			condition = influxql.MustParseExpr(c.condition)
+		}
+		tagExpr, otherExpr, ok := optimizer.SplitTagCondition(condition)
+		assert.Equal(t, c.ok, ok, c.condition)
+		if !c.ok {
+			continue
+		}
+		assert.Equal(t, c.tagExpr, tagExpr.String())
+		if c.otherExpr == "" {
+			assert.Nil(t, otherExpr)
+		} else {
+			assert.Equal(t, c.otherExpr, otherExpr.String())
+		}
+	}
+}
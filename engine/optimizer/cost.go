@@ -0,0 +1,183 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// CostModel holds the coefficients used to compare alternative plans.
+// Costs are abstract units, one unit is roughly the cost of decoding one row from a TSSP file.
+type CostModel struct {
+	// RowReadCost is the cost of reading and decoding one row
+	RowReadCost float64
+	// SeriesSeekCost is the cost of looking up one series in the index and opening its cursor
+	SeriesSeekCost float64
+	// FileOpenCost is the cost of loading the meta of one TSSP file
+	FileOpenCost float64
+	// RowCPUCost is the cost of passing one row through an operator
+	RowCPUCost float64
+	// GroupCost is the cost of keeping the state of one group in an aggregate
+	GroupCost float64
+	// RowTransferCost is the cost of sending one row from ts-store to ts-sql
+	RowTransferCost float64
+	// TagValueReadCost is the cost of reading one tag value compared by a tag filter from the index
+	TagValueReadCost float64
+	// SeriesIDReadCost is the cost of reading the id of one series from the index
+	SeriesIDReadCost float64
+	// SeriesKeyFilterCost is the cost of reading the key of one series and evaluating a tag filter on it
+	SeriesKeyFilterCost float64
+}
+
+var DefaultCostModel = CostModel{
+	RowReadCost:     1,
+	SeriesSeekCost:  50,
+	FileOpenCost:    200,
+	RowCPUCost:      0.1,
+	GroupCost:       2,
+	RowTransferCost: 4,
+
+	TagValueReadCost:    2,
+	SeriesIDReadCost:    0.05,
+	SeriesKeyFilterCost: 4,
+}
+
+// Cost is the estimated cost of a plan or of a sub plan.
+type Cost struct {
+	// Rows is the estimated number of rows produced
+	Rows float64
+
+	IO      float64
+	CPU     float64
+	Network float64
+}
+
+func (c Cost) Total() float64 {
+	return c.IO + c.CPU + c.Network
+}
+
+// Add accumulates the cost of a child, the rows are summed too.
+func (c Cost) Add(other Cost) Cost {
+	return Cost{
+		Rows:    c.Rows + other.Rows,
+		IO:      c.IO + other.IO,
+		CPU:     c.CPU + other.CPU,
+		Network: c.Network + other.Network,
+	}
+}
+
+// On accumulates the cost of the input consumed by an operator, the rows are those of the operator.
+func (c Cost) On(input Cost) Cost {
+	return Cost{
+		Rows:    c.Rows,
+		IO:      c.IO + input.IO,
+		CPU:     c.CPU + input.CPU,
+		Network: c.Network + input.Network,
+	}
+}
+
+func (c Cost) Less(other Cost) bool {
+	return c.Total() < other.Total()
+}
+
+func (c Cost) String() string {
+	return fmt.Sprintf("%.2f", c.Total())
+}
+
+// ScanCost is the cost of reading the series of a measurement through the index.
+func (m *CostModel) ScanCost(stats *Statistics, est Estimate) Cost {
+	files := float64(stats.FileN)
+	if stats.SeriesN > 0 && est.SeriesN < float64(stats.SeriesN) {
+		// only the files holding the selected series have to be opened, assume they are spread evenly
+		files *= est.SeriesN / float64(stats.SeriesN)
+		if files < 1 && stats.FileN > 0 {
+			files = 1
+		}
+	}
+	return Cost{
+		Rows: est.RowN,
+		IO:   est.ReadN*m.RowReadCost + est.SeriesN*m.SeriesSeekCost + files*m.FileOpenCost,
+		CPU:  est.ReadN * m.RowCPUCost,
+	}
+}
+
+// IndexFilterCost is the cost of finding the series matching the tag filter in the index. The tag values
+// compared are read with the ids of their series, the series matching a negative comparison are removed
+// from all the series of the measurement.
+func (m *CostModel) IndexFilterCost(stats *Statistics, tagExpr influxql.Expr) Cost {
+	var values, ids float64
+	series := float64(stats.SeriesN)
+	for _, leaf := range tagFilterLeaves(tagExpr, nil) {
+		ref, ok := comparedRef(leaf)
+		if !ok {
+			continue
+		}
+		valueN := float64(stats.TagValueN[ref.Val])
+		if valueN <= 0 {
+			valueN = series
+		}
+
+		switch leaf.Op {
+		case influxql.EQ:
+			values++
+			ids += series * stats.tagSelectivity(ref.Val, leaf.Op)
+		case influxql.EQREGEX:
+			values += valueN
+			ids += series * stats.tagSelectivity(ref.Val, leaf.Op)
+		case influxql.NEQ:
+			values++
+			ids += series
+		default:
+			values += valueN
+			ids += series
+		}
+	}
+	return Cost{IO: values*m.TagValueReadCost + ids*m.SeriesIDReadCost}
+}
+
+// SeriesScanFilterCost is the cost of finding the series matching the tag filter by evaluating it
+// on the key of every series of the measurement.
+func (m *CostModel) SeriesScanFilterCost(stats *Statistics) Cost {
+	return Cost{IO: float64(stats.SeriesN) * (m.SeriesIDReadCost + m.SeriesKeyFilterCost)}
+}
+
+// AggregateCost is the cost of an aggregate which produces groups rows from its input.
+func (m *CostModel) AggregateCost(input Cost, groups float64) Cost {
+	if groups > input.Rows {
+		groups = input.Rows
+	}
+	return Cost{
+		Rows: groups,
+		CPU:  input.Rows*m.RowCPUCost + groups*m.GroupCost,
+	}
+}
+
+// OperatorCost is the cost of an operator which handles each row once.
+func (m *CostModel) OperatorCost(input Cost) Cost {
+	return Cost{
+		Rows: input.Rows,
+		CPU:  input.Rows * m.RowCPUCost,
+	}
+}
+
+// TransferCost is the cost of sending the rows to another node.
+func (m *CostModel) TransferCost(input Cost) Cost {
+	return Cost{
+		Rows:    input.Rows,
+		Network: input.Rows * m.RowTransferCost,
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostModel(t *testing.T) {
+	m := optimizer.DefaultCostModel
+	s := newTestStatistics()
+
+	all := m.ScanCost(s, s.Estimate(nil, influxql.MinTime, influxql.MaxTime))
+	one := m.ScanCost(s, s.Estimate(influxql.MustParseExpr("host = 'a'"), influxql.MinTime, influxql.MaxTime))
+	assert.True(t, one.Less(all))
+	assert.Equal(t, float64(100000), all.Rows)
+
+	agg := m.AggregateCost(all, 10)
+	assert.Equal(t, float64(10), agg.Rows)
+	assert.Equal(t, float64(1000), m.AggregateCost(one, 1e6).Rows)
+
+	pushed := m.TransferCost(agg).Add(agg).Add(all)
+	raw := m.AggregateCost(m.TransferCost(all), 10).Add(m.TransferCost(all)).Add(all)
+	assert.True(t, pushed.Less(raw))
+	assert.Equal(t, m.OperatorCost(all).Rows, all.Rows)
+	assert.NotEmpty(t, raw.String())
+
+	on := agg.On(all)
+	assert.Equal(t, agg.Rows, on.Rows)
+	assert.Equal(t, agg.Total()+all.Total(), on.Total())
+}
+
+func TestCostModel_TagFilter(t *testing.T) {
+	m := optimizer.DefaultCostModel
+	s := newTestStatistics()
+	s.TagValueN["id"] = s.SeriesN
+
+	// looking a tag value up in the index is cheaper than reading the key of every series
+	eq := influxql.MustParseExpr("host::tag = 'a'")
+	assert.True(t, m.IndexFilterCost(s, eq).Less(m.SeriesScanFilterCost(s)))
+
+	// the regexes on a tag with a value per series read all the values of the tag from the index several times
+	regex := influxql.MustParseExpr("id::tag =~ /^a/ AND id::tag !~ /b$/ AND id::tag =~ /c/")
+	assert.True(t, m.SeriesScanFilterCost(s).Less(m.IndexFilterCost(s, regex)))
+
+	// the negative comparisons need all the series of the measurement
+	neq := m.IndexFilterCost(s, influxql.MustParseExpr("host::tag != 'a'"))
+	assert.Equal(t, m.TagValueReadCost+float64(s.SeriesN)*m.SeriesIDReadCost, neq.Total())
+}
@@ -0,0 +1,327 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/codec"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const (
+	// selectivity used when the number of distinct tag values is unknown
+	defaultTagEqualSelectivity = 0.1
+	defaultRegexSelectivity    = 0.25
+	defaultRangeSelectivity    = 1.0 / 3
+	defaultFieldEqSelectivity  = 0.1
+
+	// minimal size of a marshaled Statistics: 7 int64 and the number of tags
+	statisticsFixedSize = 7*8 + 4
+)
+
+// Statistics describes the data of one measurement stored in one or more shards.
+// It is collected by ts-store from the index and the TSSP files, and merged by ts-sql.
+// Series are counted per shard, so a series which lives in several shards is counted several times,
+// this matches the number of cursors created when the measurement is scanned.
+type Statistics struct {
+	ShardN   int64
+	SeriesN  int64
+	RowN     int64
+	FileN    int64
+	FileSize int64
+	MinTime  int64
+	MaxTime  int64
+
+	// TagValueN is the number of distinct values of a tag key
+	TagValueN map[string]int64
+}
+
+func NewStatistics() *Statistics {
+	return &Statistics{
+		MinTime:   influxql.MaxTime,
+		MaxTime:   influxql.MinTime,
+		TagValueN: make(map[string]int64),
+	}
+}
+
+func (s *Statistics) Merge(other *Statistics) {
+	if other == nil {
+		return
+	}
+	s.ShardN += other.ShardN
+	s.SeriesN += other.SeriesN
+	s.RowN += other.RowN
+	s.FileN += other.FileN
+	s.FileSize += other.FileSize
+	if other.MinTime < s.MinTime {
+		s.MinTime = other.MinTime
+	}
+	if other.MaxTime > s.MaxTime {
+		s.MaxTime = other.MaxTime
+	}
+
+	if s.TagValueN == nil {
+		s.TagValueN = make(map[string]int64, len(other.TagValueN))
+	}
+	// the same tag values usually appear in every shard, so the largest one is closer than the sum
+	for k, n := range other.TagValueN {
+		if n > s.TagValueN[k] {
+			s.TagValueN[k] = n
+		}
+	}
+}
+
+func (s *Statistics) Clone() *Statistics {
+	clone := &Statistics{}
+	*clone = *s
+	clone.TagValueN = make(map[string]int64, len(s.TagValueN))
+	for k, n := range s.TagValueN {
+		clone.TagValueN[k] = n
+	}
+	return clone
+}
+
+func (s *Statistics) IsEmpty() bool {
+	return s.SeriesN == 0 && s.RowN == 0
+}
+
+// HasTagKeys reports whether the number of distinct values is known for all the keys.
+func (s *Statistics) HasTagKeys(keys []string) bool {
+	for _, k := range keys {
+		if _, ok := s.TagValueN[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// RowsInRange estimates the number of rows between min and max, the rows are assumed to be
+// distributed evenly in time.
+func (s *Statistics) RowsInRange(min, max int64) float64 {
+	if s.RowN <= 0 {
+		return 0
+	}
+	if s.MinTime > s.MaxTime || (min <= s.MinTime && max >= s.MaxTime) {
+		return float64(s.RowN)
+	}
+	if min < s.MinTime {
+		min = s.MinTime
+	}
+	if max > s.MaxTime {
+		max = s.MaxTime
+	}
+	if min > max {
+		return 0
+	}
+	return float64(s.RowN) * (float64(max-min) + 1) / (float64(s.MaxTime-s.MinTime) + 1)
+}
+
+// Estimate is the amount of data read by a scan of the measurement.
+type Estimate struct {
+	// SeriesN is the number of series matching the tag filter
+	SeriesN float64
+	// ReadN is the number of rows read from the matching series
+	ReadN float64
+	// RowN is the number of rows left after the field filter
+	RowN float64
+}
+
+func (s *Statistics) Estimate(condition influxql.Expr, min, max int64) Estimate {
+	seriesSel := s.Selectivity(condition, true)
+	read := s.RowsInRange(min, max) * seriesSel
+	return Estimate{
+		SeriesN: float64(s.SeriesN) * seriesSel,
+		ReadN:   read,
+		RowN:    read * s.Selectivity(condition, false),
+	}
+}
+
+// Selectivity estimates the fraction of the series (onTag is true) or of the rows of a series
+// (onTag is false) which match the condition.
+func (s *Statistics) Selectivity(expr influxql.Expr, onTag bool) float64 {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		return s.Selectivity(expr.Expr, onTag)
+	case *influxql.BinaryExpr:
+		switch expr.Op {
+		case influxql.AND:
+			return s.Selectivity(expr.LHS, onTag) * s.Selectivity(expr.RHS, onTag)
+		case influxql.OR:
+			lhs, rhs := s.Selectivity(expr.LHS, onTag), s.Selectivity(expr.RHS, onTag)
+			return lhs + rhs - lhs*rhs
+		case influxql.EQ, influxql.NEQ, influxql.EQREGEX, influxql.NEQREGEX,
+			influxql.LT, influxql.LTE, influxql.GT, influxql.GTE:
+			ref, ok := comparedRef(expr)
+			if !ok || ref.Val == "time" {
+				return 1
+			}
+			isTag := s.isTag(ref)
+			if isTag != onTag {
+				return 1
+			}
+			if isTag {
+				return s.tagSelectivity(ref.Val, expr.Op)
+			}
+			return fieldSelectivity(expr.Op)
+		}
+	}
+	return 1
+}
+
+func (s *Statistics) isTag(ref *influxql.VarRef) bool {
+	if ref.Type == influxql.Tag {
+		return true
+	}
+	_, ok := s.TagValueN[ref.Val]
+	return ok
+}
+
+func (s *Statistics) tagSelectivity(key string, op influxql.Token) float64 {
+	eq := defaultTagEqualSelectivity
+	if n := s.TagValueN[key]; n > 0 {
+		eq = 1 / float64(n)
+	}
+
+	switch op {
+	case influxql.EQ:
+		return eq
+	case influxql.NEQ:
+		return 1 - eq
+	case influxql.EQREGEX:
+		return defaultRegexSelectivity
+	case influxql.NEQREGEX:
+		return 1 - defaultRegexSelectivity
+	default:
+		return defaultRangeSelectivity
+	}
+}
+
+func fieldSelectivity(op influxql.Token) float64 {
+	switch op {
+	case influxql.EQ:
+		return defaultFieldEqSelectivity
+	case influxql.NEQ:
+		return 1 - defaultFieldEqSelectivity
+	case influxql.EQREGEX:
+		return defaultRegexSelectivity
+	case influxql.NEQREGEX:
+		return 1 - defaultRegexSelectivity
+	default:
+		return defaultRangeSelectivity
+	}
+}
+
+func comparedRef(expr *influxql.BinaryExpr) (*influxql.VarRef, bool) {
+	if ref, ok := expr.LHS.(*influxql.VarRef); ok {
+		return ref, true
+	}
+	ref, ok := expr.RHS.(*influxql.VarRef)
+	return ref, ok
+}
+
+// GroupN estimates the number of groups produced by grouping the series by the tag keys.
+func (s *Statistics) GroupN(keys []string) float64 {
+	groups := 1.0
+	for _, k := range keys {
+		if n := s.TagValueN[k]; n > 0 {
+			groups *= float64(n)
+		} else {
+			return float64(s.SeriesN)
+		}
+		if groups >= float64(s.SeriesN) {
+			return float64(s.SeriesN)
+		}
+	}
+	return groups
+}
+
+// PlanCost converts the statistics to the counters reported by LogicalPlanCost.
+func (s *Statistics) PlanCost() hybridqp.LogicalPlanCost {
+	return hybridqp.LogicalPlanCost{
+		NumShards: s.ShardN,
+		NumSeries: s.SeriesN,
+		NumFiles:  s.FileN,
+		BlockSize: s.FileSize,
+	}
+}
+
+func (s *Statistics) Marshal(dst []byte) []byte {
+	dst = codec.AppendInt64(dst, s.ShardN)
+	dst = codec.AppendInt64(dst, s.SeriesN)
+	dst = codec.AppendInt64(dst, s.RowN)
+	dst = codec.AppendInt64(dst, s.FileN)
+	dst = codec.AppendInt64(dst, s.FileSize)
+	dst = codec.AppendInt64(dst, s.MinTime)
+	dst = codec.AppendInt64(dst, s.MaxTime)
+
+	dst = codec.AppendUint32(dst, uint32(len(s.TagValueN)))
+	for k, n := range s.TagValueN {
+		dst = codec.AppendString(dst, k)
+		dst = codec.AppendInt64(dst, n)
+	}
+	return dst
+}
+
+func (s *Statistics) Unmarshal(buf []byte) error {
+	if len(buf) < statisticsFixedSize {
+		return fmt.Errorf("too small data for statistics: %d < %d", len(buf), statisticsFixedSize)
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	s.ShardN = dec.Int64()
+	s.SeriesN = dec.Int64()
+	s.RowN = dec.Int64()
+	s.FileN = dec.Int64()
+	s.FileSize = dec.Int64()
+	s.MinTime = dec.Int64()
+	s.MaxTime = dec.Int64()
+
+	n := int(dec.Uint32())
+	s.TagValueN = make(map[string]int64, n)
+	for i := 0; i < n; i++ {
+		k := dec.String()
+		s.TagValueN[k] = dec.Int64()
+	}
+	return nil
+}
+
+func (s *Statistics) Size() int {
+	size := statisticsFixedSize
+	for k := range s.TagValueN {
+		size += codec.SizeOfString(k) + codec.SizeOfInt64()
+	}
+	return size
+}
+
+func (s *Statistics) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("shards=%d series=%d rows=%d files=%d size=%d", s.ShardN, s.SeriesN, s.RowN, s.FileN, s.FileSize))
+	if s.MinTime <= s.MaxTime {
+		sb.WriteString(fmt.Sprintf(" time=[%d,%d]", s.MinTime, s.MaxTime))
+	}
+
+	keys := make([]string, 0, len(s.TagValueN))
+	for k := range s.TagValueN {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf(" %s=%d", k, s.TagValueN[k]))
+	}
+	return sb.String()
+}
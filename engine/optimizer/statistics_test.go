@@ -0,0 +1,129 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStatistics() *optimizer.Statistics {
+	return &optimizer.Statistics{
+		ShardN:    2,
+		SeriesN:   1000,
+		RowN:      100000,
+		FileN:     10,
+		FileSize:  1 << 20,
+		MinTime:   0,
+		MaxTime:   99999,
+		TagValueN: map[string]int64{"host": 100, "region": 4},
+	}
+}
+
+func TestStatisticsMerge(t *testing.T) {
+	s := optimizer.NewStatistics()
+	assert.True(t, s.IsEmpty())
+
+	s.Merge(newTestStatistics())
+	other := newTestStatistics()
+	other.MinTime, other.MaxTime = 100000, 199999
+	other.TagValueN = map[string]int64{"host": 200, "az": 3}
+	s.Merge(other)
+	s.Merge(nil)
+
+	assert.Equal(t, int64(4), s.ShardN)
+	assert.Equal(t, int64(2000), s.SeriesN)
+	assert.Equal(t, int64(200000), s.RowN)
+	assert.Equal(t, int64(20), s.FileN)
+	assert.Equal(t, int64(0), s.MinTime)
+	assert.Equal(t, int64(199999), s.MaxTime)
+	assert.Equal(t, map[string]int64{"host": 200, "region": 4, "az": 3}, s.TagValueN)
+	assert.True(t, s.HasTagKeys([]string{"host", "az"}))
+	assert.False(t, s.HasTagKeys([]string{"host", "cpu"}))
+
+	clone := s.Clone()
+	clone.TagValueN["host"] = 1
+	assert.Equal(t, int64(200), s.TagValueN["host"])
+}
+
+func TestStatisticsRowsInRange(t *testing.T) {
+	s := newTestStatistics()
+	assert.Equal(t, float64(100000), s.RowsInRange(influxql.MinTime, influxql.MaxTime))
+	assert.Equal(t, float64(50000), s.RowsInRange(50000, 200000))
+	assert.Equal(t, float64(1000), s.RowsInRange(-100, 999))
+	assert.Equal(t, float64(0), s.RowsInRange(200000, 300000))
+
+	assert.Equal(t, float64(0), optimizer.NewStatistics().RowsInRange(0, 1))
+}
+
+func TestStatisticsSelectivity(t *testing.T) {
+	s := newTestStatistics()
+	tests := []struct {
+		cond  string
+		onTag bool
+		want  float64
+	}{
+		{cond: "host = 'a'", onTag: true, want: 0.01},
+		{cond: "host = 'a'", onTag: false, want: 1},
+		{cond: "host != 'a'", onTag: true, want: 0.99},
+		{cond: "host =~ /a.*/", onTag: true, want: 0.25},
+		{cond: "host = 'a' AND region = 'b'", onTag: true, want: 0.0025},
+		{cond: "(region = 'a' OR region = 'b')", onTag: true, want: 0.4375},
+		{cond: "unknown::tag = 'a'", onTag: true, want: 0.1},
+		{cond: "value > 1", onTag: false, want: 1.0 / 3},
+		{cond: "value > 1", onTag: true, want: 1},
+		{cond: "value = 1 AND host = 'a'", onTag: false, want: 0.1},
+		{cond: "time > 1", onTag: false, want: 1},
+	}
+	for _, tt := range tests {
+		expr := influxql.MustParseExpr(tt.cond)
+		assert.InDelta(t, tt.want, s.Selectivity(expr, tt.onTag), 1e-9, tt.cond)
+	}
+	assert.Equal(t, float64(1), s.Selectivity(nil, true))
+
+	est := s.Estimate(influxql.MustParseExpr("host = 'a' AND value > 1"), 0, 49999)
+	assert.InDelta(t, 10, est.SeriesN, 1e-9)
+	assert.InDelta(t, 500, est.ReadN, 1e-9)
+	assert.InDelta(t, 500.0/3, est.RowN, 1e-9)
+}
+
+func TestStatisticsGroupN(t *testing.T) {
+	s := newTestStatistics()
+	assert.Equal(t, float64(1), s.GroupN(nil))
+	assert.Equal(t, float64(400), s.GroupN([]string{"host", "region"}))
+	assert.Equal(t, float64(1000), s.GroupN([]string{"host", "region", "host"}))
+	assert.Equal(t, float64(1000), s.GroupN([]string{"unknown"}))
+}
+
+func TestStatisticsMarshal(t *testing.T) {
+	s := newTestStatistics()
+	buf := s.Marshal(nil)
+	assert.Equal(t, s.Size(), len(buf))
+
+	other := &optimizer.Statistics{}
+	require.NoError(t, other.Unmarshal(buf))
+	assert.Equal(t, s, other)
+	assert.Equal(t, "shards=2 series=1000 rows=100000 files=10 size=1048576 time=[0,99999] host=100 region=4", other.String())
+
+	assert.Error(t, other.Unmarshal(buf[:10]))
+
+	cost := s.PlanCost()
+	assert.Equal(t, int64(1000), cost.NumSeries)
+	assert.Equal(t, int64(10), cost.NumFiles)
+}
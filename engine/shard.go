@@ -41,6 +41,7 @@ import (
 	"github.com/openGemini/openGemini/engine/index/sparseindex"
 	"github.com/openGemini/openGemini/engine/index/tsi"
 	"github.com/openGemini/openGemini/engine/mutable"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/binaryfilterfunc"
 	"github.com/openGemini/openGemini/lib/bucket"
 	"github.com/openGemini/openGemini/lib/bufferpool"
@@ -128,6 +129,7 @@ type Shard interface {
 	ScanWithSparseIndex(ctx context.Context, schema *executor.QuerySchema, callBack func(num int64) error) (*executor.FileFragments, error)
 	GetIndexInfo(schema *executor.QuerySchema) (*executor.AttachedIndexInfo, error)
 	RowCount(schema *executor.QuerySchema) (int64, error)
+	CollectStatistics(mst string, tagKeys []string) (*optimizer.Statistics, error)
 	NewShardKeyIdx(shardType, dataPath string, lockPath *string) error
 
 	// admin
@@ -0,0 +1,105 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+)
+
+// CollectStatistics collects the statistics of a measurement used by the cost based optimizer.
+// The series are counted in the index of the shard, which may be shared with other shards of the same index group.
+// The rows are estimated from the meta of the TSSP files, the data in the memory tables is not counted.
+func (s *shard) CollectStatistics(mst string, tagKeys []string) (*optimizer.Statistics, error) {
+	if s.isClosing() {
+		return nil, errno.NewError(errno.ErrShardClosed, s.ident.ShardID)
+	}
+
+	stats := optimizer.NewStatistics()
+	stats.ShardN = 1
+	if err := s.indexStatistics(stats, mst, tagKeys); err != nil {
+		return nil, err
+	}
+
+	if s.engineType == config.COLUMNSTORE {
+		if rowCount, ok := s.getRowCount(mst); ok {
+			stats.RowN = rowCount
+		}
+		if files, ok := s.immTables.GetCSFiles(mst); ok {
+			addFilesStatistics(stats, files, false)
+		}
+		return stats, nil
+	}
+
+	for _, isOrder := range []bool{true, false} {
+		if files, ok := s.immTables.GetTSSPFiles(mst, isOrder); ok {
+			addFilesStatistics(stats, files, true)
+		}
+	}
+	return stats, nil
+}
+
+func (s *shard) indexStatistics(stats *optimizer.Statistics, mst string, tagKeys []string) error {
+	indexBuilder := s.GetIndexBuilder()
+	if indexBuilder == nil {
+		return nil
+	}
+	idx, ok := indexBuilder.GetPrimaryIndex().(*tsi.MergeSetIndex)
+	if !ok {
+		return nil
+	}
+
+	name := []byte(mst)
+	seriesN, err := idx.SeriesCardinality(name, nil, tsi.DefaultTR)
+	if err != nil {
+		return err
+	}
+	stats.SeriesN = int64(seriesN)
+
+	for _, key := range tagKeys {
+		n, err := idx.SearchTagValuesCardinality(name, []byte(key))
+		if err != nil {
+			return err
+		}
+		stats.TagValueN[key] = int64(n)
+	}
+	return nil
+}
+
+func addFilesStatistics(stats *optimizer.Statistics, files *immutable.TSSPFiles, countRows bool) {
+	files.RLock()
+	defer files.RUnlock()
+
+	for _, f := range files.Files() {
+		stats.FileN++
+		stats.FileSize += f.FileSize()
+		if minTime, maxTime, err := f.MinMaxTime(); err == nil {
+			if minTime < stats.MinTime {
+				stats.MinTime = minTime
+			}
+			if maxTime > stats.MaxTime {
+				stats.MaxTime = maxTime
+			}
+		}
+		if countRows {
+			stats.RowN += f.FileStat().IdCount() * int64(f.AverageChunkRows())
+		}
+	}
+	immutable.UnrefFilesReader(files.Files()...)
+	immutable.UnrefFiles(files.Files()...)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_CollectStatistics(t *testing.T) {
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, t.TempDir(), config.TSSTORE)
+	require.NoError(t, err)
+	defer func() {
+		_ = closeShard(sh)
+	}()
+
+	seriesNum := 8
+	rows, minTime, maxTime := GenDataRecord([]string{"mst"}, seriesNum, 10, time.Second, time.Now(), true, true, false)
+	require.NoError(t, writeData(sh, rows, true))
+
+	stats, err := sh.CollectStatistics("mst", []string{"tagkey1", "tagkey2"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.ShardN)
+	require.Equal(t, int64(seriesNum), stats.SeriesN)
+	require.Equal(t, int64(seriesNum), stats.TagValueN["tagkey1"])
+	require.Equal(t, int64(seriesNum), stats.TagValueN["tagkey2"])
+	require.True(t, stats.FileN > 0)
+	require.True(t, stats.FileSize > 0)
+	require.True(t, stats.RowN > 0)
+	require.Equal(t, minTime, stats.MinTime)
+	require.Equal(t, maxTime, stats.MaxTime)
+
+	stats, err = sh.CollectStatistics("mst_not_exist", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), stats.SeriesN)
+	require.Equal(t, int64(0), stats.FileN)
+}
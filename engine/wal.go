@@ -19,7 +19,9 @@ import (
 	"bufio"
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -37,6 +39,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/pingcap/failpoint"
@@ -49,6 +52,18 @@ const (
 	WalRecordHeadSize = 1 + 4
 	WalCompBufSize    = 256 * 1024
 	WalCompMaxBufSize = 2 * 1024 * 1024
+
+	// WalRecordHeadSizeV1 is the header of the checksummed record: magic + version + type + length + crc32c.
+	// The magic never equals a valid record type, so the legacy records of WalRecordHeadSize are still readable.
+	WalRecordHeadSizeV1 = 1 + 1 + 1 + 4 + 4
+	WalRecordMagic      = 0xA7
+	WalRecordVersion1   = 1
+
+	WalQuarantineDir    = "quarantine"
+	walResyncBufferSize = 64 * 1024
+
+	// WalQuarantineMaxFiles is the number of quarantined files kept in the wal dir of a shard, the oldest ones are removed
+	WalQuarantineMaxFiles = 8
 )
 
 type WalRecordType byte
@@ -62,6 +77,9 @@ const (
 
 var (
 	walCompBufPool = bufferpool.NewByteBufferPool(WalCompBufSize, cpu.GetCpuNum(), bufferpool.MaxLocalCacheLen)
+	walCRCTable    = crc32.MakeTable(crc32.Castagnoli)
+
+	errWalRecordCorrupted = errors.New("wal record corrupted")
 )
 
 var walRowsObjectsPool sync.Pool
@@ -207,7 +225,7 @@ func (l *WAL) writeBinary(walRecord *walRecord) error {
 	// prepare for compress memory
 	compBuf := walCompBufPool.Get()
 	maxEncodeLen := snappy.MaxEncodedLen(len(walRecord.binary))
-	compBuf = bufferpool.Resize(compBuf, WalRecordHeadSize+maxEncodeLen)
+	compBuf = bufferpool.Resize(compBuf, WalRecordHeadSizeV1+maxEncodeLen)
 	defer func() {
 		if len(compBuf) <= WalCompMaxBufSize {
 			walCompBufPool.Put(compBuf)
@@ -215,13 +233,15 @@ func (l *WAL) writeBinary(walRecord *walRecord) error {
 	}()
 
 	// compress data
-	compData := snappy.Encode(compBuf[WalRecordHeadSize:], walRecord.binary)
+	compData := snappy.Encode(compBuf[WalRecordHeadSizeV1:], walRecord.binary)
 
 	// encode record header
-	compBuf[0] = byte(walRecord.writeWalType)
-
-	binary.BigEndian.PutUint32(compBuf[1:WalRecordHeadSize], uint32(len(compData)))
-	compBuf = compBuf[:WalRecordHeadSize+len(compData)]
+	compBuf[0] = WalRecordMagic
+	compBuf[1] = WalRecordVersion1
+	compBuf[2] = byte(walRecord.writeWalType)
+	binary.BigEndian.PutUint32(compBuf[3:7], uint32(len(compData)))
+	binary.BigEndian.PutUint32(compBuf[7:WalRecordHeadSizeV1], walRecordChecksum(compBuf[2:7], compData))
+	compBuf = compBuf[:WalRecordHeadSizeV1+len(compData)]
 
 	// write data, switch to new file if needed
 	l.mu.RLock()
@@ -295,61 +315,175 @@ func (l *WAL) Remove(files []string) error {
 	return nil
 }
 
-func (l *WAL) replayPhysicRecord(fr *bufio.Reader, walFileName string, recordCompBuff []byte, callBack func(pc *walRecord) error) ([]byte, error) {
-	// read record header
-	var recordHeader [WalRecordHeadSize]byte
-	n, err := io.ReadFull(fr, recordHeader[:])
-	if err != nil {
-		l.log.Error(errno.NewError(errno.ReadWalFileFailed).Error(), zap.Error(err))
-		return recordCompBuff, io.EOF
+// walRecordChecksum is the crc32c of the type and length of the record header and the compressed body
+func walRecordChecksum(head []byte, body []byte) uint32 {
+	return crc32.Update(crc32.Checksum(head, walCRCTable), walCRCTable, body)
+}
+
+func validWalRecordType(writeWalType WalRecordType) bool {
+	return writeWalType > WriteWalUnKnownType && writeWalType < WriteWalEnd
+}
+
+// walFileReplay tracks the position of the record being replayed in a wal file
+type walFileReplay struct {
+	fd     fileops.File
+	fr     *bufio.Reader
+	name   string
+	size   int64
+	offset int64 // start of the next record
+	legacy bool  // the last record read has no checksum
+
+	corruptedRecords int64
+	corruptedBytes   int64
+	tornBytes        int64
+}
+
+// resync finds the next record whose checksum matches after a corrupted one and moves the reader to it.
+// If no record follows, the damaged bytes are the torn tail of an interrupted write and are not counted as corrupted.
+// Legacy records carry no checksum, so the rest of a legacy file is always taken as the torn tail.
+func (r *walFileReplay) resync() bool {
+	start := r.offset
+	next := int64(-1)
+	if !r.legacy {
+		next = r.findNextRecord(start + 1)
+	}
+	if next < 0 {
+		r.tornBytes = r.size - start
+		r.offset = r.size
+		return false
+	}
+	r.corruptedRecords++
+	r.corruptedBytes += next - start
+	r.offset = next
+	if _, err := r.fd.Seek(next, io.SeekStart); err != nil {
+		return false
+	}
+	r.fr.Reset(r.fd)
+	return true
+}
+
+func (r *walFileReplay) findNextRecord(pos int64) int64 {
+	buf := make([]byte, walResyncBufferSize)
+	for pos+WalRecordHeadSizeV1 <= r.size {
+		n, err := r.fd.ReadAt(buf, pos)
+		if n < 2 {
+			return -1
+		}
+		for i := 0; i < n-1; i++ {
+			if buf[i] == WalRecordMagic && buf[i+1] == WalRecordVersion1 && r.validRecordAt(pos+int64(i)) {
+				return pos + int64(i)
+			}
+		}
+		if err != nil {
+			return -1
+		}
+		// keep the last byte, it may be the magic of a record
+		pos += int64(n - 1)
 	}
-	if n != WalRecordHeadSize {
-		l.log.Warn(errno.NewError(errno.WalRecordHeaderCorrupted).Error(), zap.Error(err))
-		return recordCompBuff, io.EOF
+	return -1
+}
+
+func (r *walFileReplay) validRecordAt(pos int64) bool {
+	var header [WalRecordHeadSizeV1]byte
+	if _, err := r.fd.ReadAt(header[:], pos); err != nil {
+		return false
+	}
+	if !validWalRecordType(WalRecordType(header[2])) {
+		return false
+	}
+	compBinaryLen := int64(binary.BigEndian.Uint32(header[3:7]))
+	if compBinaryLen > r.size-pos-WalRecordHeadSizeV1 {
+		return false
+	}
+	body := make([]byte, compBinaryLen)
+	if _, err := r.fd.ReadAt(body, pos+WalRecordHeadSizeV1); err != nil {
+		return false
 	}
+	return walRecordChecksum(header[2:7], body) == binary.BigEndian.Uint32(header[7:WalRecordHeadSizeV1])
+}
 
-	writeWalType := WalRecordType(recordHeader[0])
-	if writeWalType <= WriteWalUnKnownType || writeWalType >= WriteWalEnd {
-		l.log.Error("unKnown write wal type", zap.Int("writeWalType", int(writeWalType)))
+// replayPhysicRecord reads one record of the wal file, errWalRecordCorrupted is returned if the record can not be used
+func (l *WAL) replayPhysicRecord(r *walFileReplay, recordCompBuff []byte, callBack func(pc *walRecord) error) ([]byte, error) {
+	first, err := r.fr.Peek(1)
+	if err != nil {
+		if err != io.EOF {
+			l.log.Error(errno.NewError(errno.ReadWalFileFailed).Error(), zap.String("file", r.name), zap.Error(err))
+		}
 		return recordCompBuff, io.EOF
 	}
 
-	// prepare record memory
-	compBinaryLen := binary.BigEndian.Uint32(recordHeader[1:WalRecordHeadSize])
-	recordCompBuff = bufferpool.Resize(recordCompBuff, int(compBinaryLen))
+	// read record header
+	var recordHeader [WalRecordHeadSizeV1]byte
+	headSize := WalRecordHeadSize
+	r.legacy = first[0] != WalRecordMagic
+	if !r.legacy {
+		headSize = WalRecordHeadSizeV1
+	}
+	if _, err = io.ReadFull(r.fr, recordHeader[:headSize]); err != nil {
+		l.log.Warn(errno.NewError(errno.WalRecordHeaderCorrupted).Error(), zap.String("file", r.name), zap.Int64("offset", r.offset), zap.Error(err))
+		return recordCompBuff, errWalRecordCorrupted
+	}
+
+	var writeWalType WalRecordType
+	var compBinaryLen uint32
+	if r.legacy {
+		writeWalType = WalRecordType(recordHeader[0])
+		compBinaryLen = binary.BigEndian.Uint32(recordHeader[1:WalRecordHeadSize])
+	} else {
+		if recordHeader[1] != WalRecordVersion1 {
+			l.log.Error("unKnown wal record version", zap.String("file", r.name), zap.Int("version", int(recordHeader[1])))
+			return recordCompBuff, errWalRecordCorrupted
+		}
+		writeWalType = WalRecordType(recordHeader[2])
+		compBinaryLen = binary.BigEndian.Uint32(recordHeader[3:7])
+	}
+	if !validWalRecordType(writeWalType) {
+		l.log.Error("unKnown write wal type", zap.String("file", r.name), zap.Int("writeWalType", int(writeWalType)))
+		return recordCompBuff, errWalRecordCorrupted
+	}
+	if int64(compBinaryLen) > r.size-r.offset-int64(headSize) {
+		l.log.Warn(errno.NewError(errno.WalRecordHeaderCorrupted).Error(), zap.String("file", r.name), zap.Int64("offset", r.offset),
+			zap.Uint32("length", compBinaryLen))
+		return recordCompBuff, errWalRecordCorrupted
+	}
 
 	// read wal binary body
+	recordCompBuff = bufferpool.Resize(recordCompBuff, int(compBinaryLen))
+	if _, err = io.ReadFull(r.fr, recordCompBuff); err != nil {
+		l.log.Error(errno.NewError(errno.ReadWalFileFailed).Error(), zap.String("file", r.name), zap.Error(err))
+		return recordCompBuff, errWalRecordCorrupted
+	}
+	if !r.legacy && walRecordChecksum(recordHeader[2:7], recordCompBuff) != binary.BigEndian.Uint32(recordHeader[7:WalRecordHeadSizeV1]) {
+		l.log.Error("wal record checksum mismatch", zap.String("file", r.name), zap.Int64("offset", r.offset))
+		return recordCompBuff, errWalRecordCorrupted
+	}
+
 	var rowsObjects = getWalRowsObjects()
-	var binaryBuff = rowsObjects.rowsDataBuff[:cap(rowsObjects.rowsDataBuff)]
+	binaryBuff, err := snappy.Decode(rowsObjects.rowsDataBuff[:cap(rowsObjects.rowsDataBuff)], recordCompBuff)
+	if err != nil {
+		l.log.Error(errno.NewError(errno.DecompressWalRecordFailed, r.name, err.Error()).Error())
+		putWalRowsObjects(rowsObjects)
+		return recordCompBuff, errWalRecordCorrupted
+	}
 
 	wr := &walRecord{
 		writeWalType: writeWalType,
 	}
-	_, err = io.ReadFull(fr, recordCompBuff)
-	if err == nil || err == io.EOF {
-		var innerErr error
-		binaryBuff, innerErr = snappy.Decode(binaryBuff, recordCompBuff)
-		if innerErr != nil {
-			l.log.Error(errno.NewError(errno.DecompressWalRecordFailed, walFileName, innerErr.Error()).Error())
-			return recordCompBuff, io.EOF
-		}
-		if writeWalType == WriteWalLineProtocol {
-			rowsObjects, err = l.unmarshalRows(binaryBuff, rowsObjects)
-			if err != nil {
-				err = io.EOF
-				return recordCompBuff, err
-			}
-			rowsObjects.rowsDataBuff = binaryBuff
-			wr.rowsObjs = rowsObjects
-		} else {
-			wr.binary = binaryBuff
+	if writeWalType == WriteWalLineProtocol {
+		rowsObjects, err = l.unmarshalRows(binaryBuff, rowsObjects)
+		if err != nil {
+			putWalRowsObjects(rowsObjects)
+			return recordCompBuff, errWalRecordCorrupted
 		}
-
-		innerErr = callBack(wr)
-		return recordCompBuff, innerErr
+		rowsObjects.rowsDataBuff = binaryBuff
+		wr.rowsObjs = rowsObjects
+	} else {
+		wr.binary = binaryBuff
 	}
-	l.log.Error(errno.NewError(errno.ReadWalFileFailed).Error())
-	return recordCompBuff, io.EOF
+	r.offset += int64(headSize) + int64(compBinaryLen)
+	statistics.NewWalStatistics().AddReplayRecords(1)
+
+	return recordCompBuff, callBack(wr)
 }
 
 func (l *WAL) unmarshalRows(binary []byte, ctx *walRowsObjects) (*walRowsObjects, error) {
@@ -376,28 +510,32 @@ func (l *WAL) unmarshalRows(binary []byte, ctx *walRowsObjects) (*walRowsObjects
 	return ctx, err
 }
 
-func (l *WAL) replayWalFile(ctx context.Context, walFileName string, callBack func(pc *walRecord) error) error {
+// replayWalFile replays the records of the wal file, the corrupted records followed by valid ones are skipped and reported
+// by the returned flag, a damaged tail is taken as the end of the file
+func (l *WAL) replayWalFile(ctx context.Context, walFileName string, callBack func(pc *walRecord) error) (bool, error) {
 	failpoint.Inject("mock-replay-wal-error", func(val failpoint.Value) {
 		msg := val.(string)
 		if strings.Contains(walFileName, msg) {
-			failpoint.Return(fmt.Errorf(msg))
+			failpoint.Return(false, fmt.Errorf(msg))
 		}
 	})
 	lock := fileops.FileLockOption("")
 	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_NORMAL)
 	fd, err := fileops.OpenFile(walFileName, os.O_RDONLY, 0640, lock, pri)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	stat, err := fd.Stat()
 	if err != nil {
-		return err
+		util.MustClose(fd)
+		return false, err
 	}
 
 	fileSize := stat.Size()
 	if fileSize == 0 {
-		return nil
+		util.MustClose(fd)
+		return false, nil
 	}
 	logger.GetLogger().Info("start to replay wal file", zap.Uint64("shardID", l.shardID), zap.String("filename", walFileName), zap.String("file size", units.HumanSize(float64(fileSize))))
 	recordCompBuff := walCompBufPool.Get()
@@ -408,36 +546,123 @@ func (l *WAL) replayWalFile(ctx context.Context, walFileName string, callBack fu
 		util.MustClose(fd)
 	}()
 
-	fr := bufio.NewReaderSize(fd, l.replayBatchSize)
+	walStat := statistics.NewWalStatistics()
+	walStat.AddReplayFiles(1)
+	r := &walFileReplay{
+		fd:   fd,
+		fr:   bufio.NewReaderSize(fd, l.replayBatchSize),
+		name: walFileName,
+		size: fileSize,
+	}
+	defer func() {
+		if r.corruptedRecords > 0 {
+			walStat.AddCorruptedRecords(r.corruptedRecords)
+			walStat.AddCorruptedBytes(r.corruptedBytes)
+			l.log.Warn("skip corrupted wal records", zap.Uint64("shardID", l.shardID), zap.String("filename", walFileName),
+				zap.Int64("records", r.corruptedRecords), zap.Int64("bytes", r.corruptedBytes))
+		}
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
 			l.log.Info("cancel replay wal", zap.String("filename", walFileName))
-			return nil
+			return false, nil
 		default:
 		}
-		recordCompBuff, err = l.replayPhysicRecord(fr, walFileName, recordCompBuff, callBack)
-		if err != nil {
-			if err == io.EOF {
-				return nil
+		recordCompBuff, err = l.replayPhysicRecord(r, recordCompBuff, callBack)
+		switch err {
+		case nil:
+		case io.EOF:
+			return r.corruptedRecords > 0, nil
+		case errWalRecordCorrupted:
+			if !r.resync() {
+				l.log.Warn("ignore the torn tail of wal file", zap.Uint64("shardID", l.shardID), zap.String("filename", walFileName),
+					zap.Int64("offset", r.size-r.tornBytes), zap.Int64("bytes", r.tornBytes))
+				return r.corruptedRecords > 0, nil
 			}
-			return err
+		default:
+			return r.corruptedRecords > 0, err
 		}
 	}
 }
 
+// quarantineWalFile moves the damaged wal file out of the partition so that it is kept after the replay
+func (l *WAL) quarantineWalFile(idx int, walFileName string) bool {
+	lock := fileops.FileLockOption(*l.lock)
+	dir := filepath.Join(l.logPath, WalQuarantineDir)
+	if err := fileops.MkdirAll(dir, 0750, lock); err != nil {
+		l.log.Error("create wal quarantine dir failed", zap.String("path", dir), zap.Error(err))
+		return false
+	}
+	dst := filepath.Join(dir, fmt.Sprintf("%d_%d_%s", idx, time.Now().UnixNano(), filepath.Base(walFileName)))
+	if err := fileops.RenameFile(walFileName, dst, lock); err != nil {
+		l.log.Error("move wal file to quarantine failed", zap.String("filename", walFileName), zap.Error(err))
+		return false
+	}
+	statistics.NewWalStatistics().AddQuarantinedFiles(1)
+	l.log.Warn("move corrupted wal file to quarantine", zap.Uint64("shardID", l.shardID), zap.String("filename", walFileName), zap.String("dst", dst))
+	l.pruneQuarantine(dir)
+	return true
+}
+
+// pruneQuarantine removes the oldest quarantined files when there are more than WalQuarantineMaxFiles
+func (l *WAL) pruneQuarantine(dir string) {
+	files, err := fileops.ReadDir(dir)
+	if err != nil {
+		l.log.Error("read wal quarantine dir failed", zap.String("path", dir), zap.Error(err))
+		return
+	}
+	if len(files) <= WalQuarantineMaxFiles {
+		return
+	}
+	// the files are named idx_nanotime_name
+	quarantineTime := func(name string) int64 {
+		parts := strings.SplitN(name, "_", 3)
+		if len(parts) < 3 {
+			return 0
+		}
+		ts, _ := strconv.ParseInt(parts[1], 10, 64)
+		return ts
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return quarantineTime(files[i].Name()) < quarantineTime(files[j].Name())
+	})
+	lock := fileops.FileLockOption(*l.lock)
+	for _, f := range files[:len(files)-WalQuarantineMaxFiles] {
+		fn := filepath.Join(dir, f.Name())
+		if err = fileops.Remove(fn, lock); err != nil {
+			l.log.Error("remove quarantined wal file failed", zap.String("filename", fn), zap.Error(err))
+			continue
+		}
+		l.log.Info("remove quarantined wal file", zap.Uint64("shardID", l.shardID), zap.String("filename", fn))
+	}
+}
+
 func (l *WAL) replayOnePartition(ctx context.Context, idx int, callBack func(pc *walRecord) error) error {
-	for _, fileName := range l.logReplay[idx].fileNames {
+	fileNames := l.logReplay[idx].fileNames
+	// the quarantined files are not removed after the replay
+	replayed := make([]string, 0, len(fileNames))
+	defer func() {
+		l.logReplay[idx].fileNames = replayed
+	}()
+	for i, fileName := range fileNames {
 		select {
 		case <-ctx.Done():
 			l.log.Info("cancel replay wal", zap.String("filename", fileName))
+			replayed = append(replayed, fileNames[i:]...)
 			return nil
 		default:
 		}
-		err := l.replayWalFile(ctx, fileName, callBack)
+		corrupted, err := l.replayWalFile(ctx, fileName, callBack)
 		if err != nil {
+			replayed = append(replayed, fileNames[i:]...)
 			return err
 		}
+		if corrupted && l.quarantineWalFile(idx, fileName) {
+			continue
+		}
+		replayed = append(replayed, fileName)
 	}
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/resourceallocator"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/require"
@@ -448,6 +450,124 @@ func Test_batchReadWalFile(t *testing.T) {
 		return errors.New("mock callback error")
 	}
 
-	err = wal.replayWalFile(cxt, tmpFile, callback)
+	_, err = wal.replayWalFile(cxt, tmpFile, callback)
 	require.Errorf(t, err, "mock callback error")
 }
+
+func writeTestWal(t *testing.T, dir string, values ...string) *WAL {
+	lock := ""
+	wal := NewWAL(dir, &lock, 1, 0, true, false, 1, 0)
+	for _, v := range values {
+		require.NoError(t, wal.Write(&walRecord{binary: []byte(v), writeWalType: WriteWalArrowFlight}))
+	}
+	require.NoError(t, wal.Close())
+	return wal
+}
+
+func replayTestWal(t *testing.T, dir string) ([]string, []string) {
+	lock := ""
+	wal := NewWAL(dir, &lock, 1, 0, true, false, 1, 0)
+	wal.restoreLogs()
+	var values []string
+	files, err := wal.Replay(context.Background(), func(binary []byte, rowsCtx *walRowsObjects, writeWalType WalRecordType) error {
+		values = append(values, string(binary))
+		return nil
+	})
+	require.NoError(t, err)
+	return values, files
+}
+
+func TestWalReplay_CorruptedRecord(t *testing.T) {
+	dir := t.TempDir()
+	writeTestWal(t, dir, "record-0", "record-1", "record-2")
+	walFile := filepath.Join(dir, "0", "1.wal")
+	buf, err := os.ReadFile(walFile)
+	require.NoError(t, err)
+	recordSize := len(buf) / 3
+
+	// flip a byte of the body of the second record
+	buf[recordSize+WalRecordHeadSizeV1] ^= 0xff
+	require.NoError(t, os.WriteFile(walFile, buf, 0640))
+
+	stat := statistics.NewWalStatistics()
+	corrupted, quarantined := stat.CollectOps()[0].Values["CorruptedRecords"].(int64), stat.CollectOps()[0].Values["QuarantinedFiles"].(int64)
+	values, files := replayTestWal(t, dir)
+	require.Equal(t, []string{"record-0", "record-2"}, values)
+	require.Empty(t, files)
+	require.Equal(t, corrupted+1, stat.CollectOps()[0].Values["CorruptedRecords"].(int64))
+	require.Equal(t, quarantined+1, stat.CollectOps()[0].Values["QuarantinedFiles"].(int64))
+
+	// the damaged file is moved to the quarantine dir
+	_, err = os.Stat(walFile)
+	require.True(t, os.IsNotExist(err))
+	entries, err := os.ReadDir(filepath.Join(dir, WalQuarantineDir))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+}
+
+func TestWalReplay_TornRecord(t *testing.T) {
+	dir := t.TempDir()
+	writeTestWal(t, dir, "record-0", "record-1")
+	walFile := filepath.Join(dir, "0", "1.wal")
+	buf, err := os.ReadFile(walFile)
+	require.NoError(t, err)
+	recordSize := len(buf) / 2
+
+	stat := statistics.NewWalStatistics()
+	corrupted, quarantined := stat.CollectOps()[0].Values["CorruptedRecords"].(int64), stat.CollectOps()[0].Values["QuarantinedFiles"].(int64)
+	for _, tail := range [][]byte{
+		buf[:len(buf)-3],   // torn body
+		buf[:recordSize+3], // torn header
+		append(buf[:len(buf)-1:len(buf)-1], buf[len(buf)-1]^0xff), // checksum mismatch of the last record
+	} {
+		require.NoError(t, os.WriteFile(walFile, tail, 0640))
+		values, files := replayTestWal(t, dir)
+		require.Equal(t, []string{"record-0"}, values)
+		require.Equal(t, []string{walFile}, files)
+
+		// the torn tail is the end of the file, the file is neither counted as corrupted nor quarantined
+		require.Equal(t, corrupted, stat.CollectOps()[0].Values["CorruptedRecords"].(int64))
+		require.Equal(t, quarantined, stat.CollectOps()[0].Values["QuarantinedFiles"].(int64))
+		_, err = os.Stat(walFile)
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(dir, WalQuarantineDir))
+		require.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestWalQuarantine_Prune(t *testing.T) {
+	dir := t.TempDir()
+	lock := ""
+	wal := NewWAL(dir, &lock, 1, 0, true, false, 1, 0)
+	for i := 0; i < WalQuarantineMaxFiles+2; i++ {
+		walFile := filepath.Join(dir, fmt.Sprintf("%d.wal", i))
+		require.NoError(t, os.WriteFile(walFile, []byte{1}, 0640))
+		require.True(t, wal.quarantineWalFile(0, walFile))
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, WalQuarantineDir))
+	require.NoError(t, err)
+	require.Equal(t, WalQuarantineMaxFiles, len(entries))
+	// the oldest files are removed
+	for _, e := range entries {
+		require.False(t, strings.HasSuffix(e.Name(), "_0.wal") || strings.HasSuffix(e.Name(), "_1.wal"))
+	}
+}
+
+func TestWalReplay_LegacyRecord(t *testing.T) {
+	dir := t.TempDir()
+	var buf []byte
+	for _, v := range []string{"record-0", "record-1"} {
+		body := snappy.Encode(nil, []byte(v))
+		buf = append(buf, WriteWalArrowFlight)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(body)))
+		buf = append(buf, body...)
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "0"), 0750))
+	walFile := filepath.Join(dir, "0", "1.wal")
+	require.NoError(t, os.WriteFile(walFile, buf, 0640))
+
+	values, files := replayTestWal(t, dir)
+	require.Equal(t, []string{"record-0", "record-1"}, values)
+	require.Equal(t, []string{walFile}, files)
+}
@@ -32,6 +32,8 @@ func TestConfig_Parse(t *testing.T) {
 [subscriber]
   enabled = true
   write-buffer-size = 150
+  queue-dir = "/tmp/subscriber"
+  queue-max-size = "10m"
 `
 	configFile := t.TempDir() + "/sql.conf"
 	_ = os.WriteFile(configFile, []byte(txt), 0600)
@@ -51,6 +53,22 @@ func TestConfig_Parse(t *testing.T) {
 	assert.Equal(t, true, conf.GetSpdy().TLSEnable)
 	assert.Equal(t, true, conf.Subscriber.Enabled)
 	assert.Equal(t, 150, conf.Subscriber.WriteBufferSize)
+	assert.Equal(t, "/tmp/subscriber", conf.Subscriber.QueueDir)
+	assert.Equal(t, uint64(10*1024*1024), uint64(conf.Subscriber.QueueMaxSize))
+	assert.Equal(t, config.DefaultQueueMaxAge, time.Duration(conf.Subscriber.QueueMaxAge))
+	assert.Equal(t, config.DefaultQueueSyncInterval, time.Duration(conf.Subscriber.QueueSyncInterval))
+	assert.NoError(t, conf.Subscriber.Validate())
+
+	conf.Subscriber.QueueSyncInterval = -1
+	assert.EqualError(t, conf.Subscriber.Validate(), "subscriber queue-sync-interval can not be negative")
+	conf.Subscriber.QueueSyncInterval = 0
+
+	conf.Subscriber.RetryMaxInterval = 0
+	assert.EqualError(t, conf.Subscriber.Validate(), "subscriber retry-interval must be positive and not greater than retry-max-interval")
+	conf.Subscriber.QueueMaxSize = 0
+	assert.EqualError(t, conf.Subscriber.Validate(), "subscriber queue-max-size can not be less than 1m")
+	conf.Subscriber.QueueMaxSize = 3
+	assert.EqualError(t, conf.Subscriber.Validate(), "subscriber queue-max-size can not be less than 1m")
 }
 
 func TestLogger(t *testing.T) {
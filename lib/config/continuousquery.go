@@ -31,11 +31,31 @@ type ContinuousQueryConfig struct {
 
 	// MaxProcessCQNumber is the max number of CQs to process in one run.
 	MaxProcessCQNumber int `toml:"max-process-CQ-number"`
+
+	// BackfillChunkSize is the time range of the data a CQ processes in one query when the CQ is backfilled,
+	// or when the windows receiving the late data are re-evaluated.
+	BackfillChunkSize toml.Duration `toml:"backfill-chunk-size"`
+
+	// If this flag is set to true, the windows of the CQs receiving the out-of-order writes are re-evaluated.
+	// Only the rows written through the PointsWriter of the sql node running the CQ are tracked, the writes
+	// through the other sql nodes and the record writes are not, they need BACKFILL CONTINUOUS QUERY.
+	LateDataEnabled bool `toml:"late-data-enabled"`
+
+	// The writes older than LateDataMaxAge are not re-evaluated.
+	LateDataMaxAge toml.Duration `toml:"late-data-max-age"`
+
+	// If this flag is set to true, every run of the CQs is written into HistoryMeasurement of the CQ database.
+	HistoryEnabled     bool   `toml:"history-enabled"`
+	HistoryMeasurement string `toml:"history-measurement"`
 }
 
 const (
 	// DefaultRunInterval is the default interval at which the CQ service will run.
 	DefaultRunInterval = time.Second
+
+	DefaultBackfillChunkSize  = 24 * time.Hour
+	DefaultLateDataMaxAge     = 7 * 24 * time.Hour
+	DefaultHistoryMeasurement = "cq_history"
 )
 
 // NewContinuousQueryConfig returns a new instance of ContinuousQueryConfig with defaults.
@@ -44,6 +64,11 @@ func NewContinuousQueryConfig() ContinuousQueryConfig {
 		Enabled:            true,
 		RunInterval:        toml.Duration(DefaultRunInterval),
 		MaxProcessCQNumber: 0,
+		BackfillChunkSize:  toml.Duration(DefaultBackfillChunkSize),
+		LateDataEnabled:    true,
+		LateDataMaxAge:     toml.Duration(DefaultLateDataMaxAge),
+		HistoryEnabled:     true,
+		HistoryMeasurement: DefaultHistoryMeasurement,
 	}
 }
 
@@ -55,6 +80,12 @@ func (c ContinuousQueryConfig) Validate() error {
 	if c.MaxProcessCQNumber < 0 {
 		return errors.New("continuous query max process CQ number must be greater or equal than 0")
 	}
+	if c.BackfillChunkSize <= 0 {
+		return errors.New("continuous query backfill chunk size must be positive")
+	}
+	if c.HistoryEnabled && c.HistoryMeasurement == "" {
+		return errors.New("continuous query history measurement must be specified")
+	}
 	return nil
 }
 
@@ -34,4 +34,12 @@ func Test_ContinuousQueryConfig_Validate(t *testing.T) {
 	c.MaxProcessCQNumber = 0
 	require.NoError(t, c.Validate())
 
+	c.BackfillChunkSize = 0
+	require.EqualError(t, c.Validate(), "continuous query backfill chunk size must be positive")
+	c.BackfillChunkSize = toml.Duration(time.Hour)
+
+	c.HistoryMeasurement = ""
+	require.EqualError(t, c.Validate(), "continuous query history measurement must be specified")
+	c.HistoryEnabled = false
+	require.NoError(t, c.Validate())
 }
@@ -0,0 +1,129 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultMQTTBindAddress    = "127.0.0.1:1883"
+	DefaultMQTTMaxPacketSize  = 1024 * 1024
+	DefaultMQTTMaxConnections = 10000
+
+	// MQTTFormatLine is the payload of one or more points in line protocol
+	MQTTFormatLine = "line"
+	// MQTTFormatJSON is the payload of a JSON object, or an array of JSON objects, each object is a point
+	MQTTFormatJSON = "json"
+	// MQTTFormatValue is the payload of a single number, boolean or string value
+	MQTTFormatValue = "value"
+
+	DefaultMQTTValueField = "value"
+	DefaultMQTTTimeKey    = "time"
+)
+
+// MQTTConfig is the configuration of the embedded MQTT server, the messages published by
+// the clients are written into the databases through the points writer.
+type MQTTConfig struct {
+	Enabled        bool        `toml:"enabled"`
+	BindAddress    string      `toml:"bind-address"`
+	AuthEnabled    bool        `toml:"auth-enabled"`
+	TLS            tlsConfig   `toml:"TLS"`
+	MaxConnections int         `toml:"max-connections"`
+	MaxPacketSize  toml.Size   `toml:"max-packet-size"`
+	Topics         []MQTTTopic `toml:"topics"`
+}
+
+// MQTTTopic maps the topics matching the template to the database, the retention policy and the measurement.
+// A level of the template is a literal, the wildcard "+", the wildcard "#" as the last level, or a placeholder.
+// The placeholders {database}, {retention_policy} and {measurement} take the value of the level as the
+// destination of the points, any other placeholder like {device} adds the value of the level as a tag.
+type MQTTTopic struct {
+	Template        string `toml:"template"`
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+	Measurement     string `toml:"measurement"`
+
+	Format    string `toml:"format"`
+	Precision string `toml:"precision"`
+
+	// the field name of the value format
+	Field string `toml:"field"`
+
+	// the keys of the JSON format stored as tags, and the key of the timestamp
+	TagKeys []string `toml:"tag-keys"`
+	TimeKey string   `toml:"time-key"`
+}
+
+func NewMQTTConfig() MQTTConfig {
+	return MQTTConfig{
+		Enabled:        false,
+		BindAddress:    DefaultMQTTBindAddress,
+		MaxConnections: DefaultMQTTMaxConnections,
+		MaxPacketSize:  DefaultMQTTMaxPacketSize,
+	}
+}
+
+func (c MQTTConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	prefix := fmt.Errorf("mqtt config: ")
+	if c.BindAddress == "" {
+		return fmt.Errorf("%v bind address is not provided", prefix)
+	}
+	if c.MaxConnections <= 0 || c.MaxPacketSize <= 0 {
+		return fmt.Errorf("%v max-connections and max-packet-size must be greater than zero", prefix)
+	}
+	if err := c.TLS.validate(prefix); err != nil {
+		return err
+	}
+	if len(c.Topics) == 0 {
+		return fmt.Errorf("%v at least one topic must be configured", prefix)
+	}
+	for i := range c.Topics {
+		if err := c.Topics[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t MQTTTopic) Validate() error {
+	if t.Template == "" {
+		return fmt.Errorf("mqtt topic template must be specified")
+	}
+	switch t.Format {
+	case "", MQTTFormatLine, MQTTFormatJSON, MQTTFormatValue:
+	default:
+		return fmt.Errorf("mqtt topic %s: unknown format %s", t.Template, t.Format)
+	}
+	switch t.Precision {
+	case "", "ns", "u", "us", "µ", "ms", "s", "m", "h":
+	default:
+		return fmt.Errorf("mqtt topic %s: unknown precision %s", t.Template, t.Precision)
+	}
+	if t.Database == "" && !strings.Contains(t.Template, "{database}") {
+		return fmt.Errorf("mqtt topic %s: database must be specified", t.Template)
+	}
+	if t.Format != "" && t.Format != MQTTFormatLine && t.Measurement == "" && !strings.Contains(t.Template, "{measurement}") {
+		return fmt.Errorf("mqtt topic %s: measurement must be specified for the %s format", t.Template, t.Format)
+	}
+	return nil
+}
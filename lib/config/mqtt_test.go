@@ -0,0 +1,86 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMQTTConfig_Decode(t *testing.T) {
+	confStr := `
+[mqtt]
+  enabled = true
+  bind-address = "127.0.0.1:1884"
+  [[mqtt.topics]]
+    template = "sensors/{database}/{measurement}/{device}"
+    format = "json"
+    tag-keys = ["location"]
+  [[mqtt.topics]]
+    template = "devices/+/temperature"
+    database = "iot"
+    measurement = "temperature"
+    format = "value"
+`
+	c := NewTSSql(false)
+	_, err := toml.Decode(confStr, c)
+	require.NoError(t, err)
+	require.NoError(t, c.MQTT.Validate())
+	require.Equal(t, "127.0.0.1:1884", c.MQTT.BindAddress)
+	require.Equal(t, DefaultMQTTMaxConnections, c.MQTT.MaxConnections)
+	require.Len(t, c.MQTT.Topics, 2)
+	require.Equal(t, []string{"location"}, c.MQTT.Topics[0].TagKeys)
+	require.Equal(t, "iot", c.MQTT.Topics[1].Database)
+}
+
+func TestMQTTConfig_Validate(t *testing.T) {
+	conf := NewMQTTConfig()
+	require.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	require.EqualError(t, conf.Validate(), "mqtt config:  at least one topic must be configured")
+
+	conf.Topics = []MQTTTopic{{Template: "devices/{device}", Database: "db0", Format: "line"}}
+	require.NoError(t, conf.Validate())
+
+	conf.MaxPacketSize = 0
+	require.EqualError(t, conf.Validate(), "mqtt config:  max-connections and max-packet-size must be greater than zero")
+	conf.MaxPacketSize = DefaultMQTTMaxPacketSize
+
+	conf.TLS.ClientAuth = true
+	require.EqualError(t, conf.Validate(), "mqtt config:  TLS must be enabled first for mutal-TLS")
+	conf.TLS.ClientAuth = false
+
+	for _, item := range []struct {
+		topic MQTTTopic
+		err   string
+	}{
+		{MQTTTopic{Database: "db0"}, "mqtt topic template must be specified"},
+		{MQTTTopic{Template: "a/b", Database: "db0", Format: "csv"}, "mqtt topic a/b: unknown format csv"},
+		{MQTTTopic{Template: "a/b", Database: "db0", Precision: "d"}, "mqtt topic a/b: unknown precision d"},
+		{MQTTTopic{Template: "a/b"}, "mqtt topic a/b: database must be specified"},
+		{MQTTTopic{Template: "a/b", Database: "db0", Format: "value"}, "mqtt topic a/b: measurement must be specified for the value format"},
+		{MQTTTopic{Template: "{database}/{measurement}", Format: "json"}, ""},
+	} {
+		conf.Topics = []MQTTTopic{item.topic}
+		if item.err == "" {
+			require.NoError(t, conf.Validate())
+		} else {
+			require.EqualError(t, conf.Validate(), item.err)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	ObjectStorageOBS = "obs"
+	ObjectStorageS3  = "s3"
+
+	DefaultObjectStorageRegion = "us-east-1"
+	DefaultObjectStorageScheme = "http"
+
+	// the minimum part size of the multipart upload of S3, except the last part
+	MinObjectStoragePartSize     = 5 * 1024 * 1024
+	DefaultObjectStoragePartSize = 16 * 1024 * 1024
+)
+
+// ObjectStorage selects the protocol of the object storage used by the cold tier and the logstore.
+// The endpoint, bucket and credentials still come from the obs options of the database.
+type ObjectStorage struct {
+	// Type is the protocol of the object storage, obs or s3
+	Type string `toml:"type"`
+
+	// Region signs the requests of the s3 protocol
+	Region string `toml:"region"`
+
+	// Scheme is http or https
+	Scheme string `toml:"scheme"`
+
+	// PathStyle addresses the bucket by the path instead of the host, such as MinIO and Ceph RGW do
+	PathStyle bool `toml:"path-style"`
+
+	// PartSize is the part size of the multipart upload
+	PartSize toml.Size `toml:"part-size"`
+}
+
+func NewObjectStorage() ObjectStorage {
+	return ObjectStorage{
+		Type:     ObjectStorageOBS,
+		Region:   DefaultObjectStorageRegion,
+		Scheme:   DefaultObjectStorageScheme,
+		PartSize: toml.Size(DefaultObjectStoragePartSize),
+	}
+}
+
+func (c ObjectStorage) Validate() error {
+	if c.Type != ObjectStorageOBS && c.Type != ObjectStorageS3 {
+		return fmt.Errorf("unknown object storage type: %s", c.Type)
+	}
+	if c.Type == ObjectStorageOBS {
+		return nil
+	}
+	if c.Scheme != "http" && c.Scheme != "https" {
+		return fmt.Errorf("object storage scheme must be http or https, got %s", c.Scheme)
+	}
+	if c.Region == "" {
+		return fmt.Errorf("object storage region must be set for s3")
+	}
+	if c.PartSize < MinObjectStoragePartSize {
+		return fmt.Errorf("object storage part-size must be at least %d", MinObjectStoragePartSize)
+	}
+	return nil
+}
+
+var objectStorage = NewObjectStorage()
+
+func SetObjectStorage(conf ObjectStorage) {
+	objectStorage = conf
+}
+
+func GetObjectStorage() ObjectStorage {
+	return objectStorage
+}
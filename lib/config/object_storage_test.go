@@ -0,0 +1,44 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorage_Validate(t *testing.T) {
+	conf := NewObjectStorage()
+	assert.NoError(t, conf.Validate())
+
+	conf.Type = "gcs"
+	assert.EqualError(t, conf.Validate(), "unknown object storage type: gcs")
+
+	conf.Type = ObjectStorageS3
+	assert.NoError(t, conf.Validate())
+
+	conf.Scheme = "ftp"
+	assert.EqualError(t, conf.Validate(), "object storage scheme must be http or https, got ftp")
+
+	conf.Scheme = "https"
+	conf.Region = ""
+	assert.EqualError(t, conf.Validate(), "object storage region must be set for s3")
+
+	conf.Region = DefaultObjectStorageRegion
+	conf.PartSize = toml.Size(1024)
+	assert.EqualError(t, conf.Validate(), "object storage part-size must be at least 5242880")
+}
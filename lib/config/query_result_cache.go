@@ -0,0 +1,83 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultQueryResultCacheMaxMemorySize  = 256 * 1024 * 1024
+	DefaultQueryResultCacheBucketInterval = time.Hour
+	DefaultQueryResultCacheMaxFreshness   = time.Minute
+	DefaultQueryResultCacheTTL            = 10 * time.Minute
+)
+
+// QueryResultCacheConfig is the configuration of the result cache of the range queries in ts-sql.
+// The range queries are split into the buckets of the time, the results of the complete buckets are cached,
+// and the buckets are invalidated by the writes and the deletes of their time range.
+type QueryResultCacheConfig struct {
+	Enabled       bool      `toml:"enabled"`
+	MaxMemorySize toml.Size `toml:"max-memory-size"`
+
+	// Databases are the databases whose results are cached. The invalidations are local to the ts-sql node,
+	// so the databases must only be written and deleted through this node.
+	Databases []string `toml:"databases"`
+
+	// BucketInterval is the minimum time range of a bucket, it is rounded up to a multiple of the
+	// interval of the query and enlarged for the queries of the long time range
+	BucketInterval toml.Duration `toml:"bucket-interval"`
+
+	// MaxFreshness is the time range before now which is never cached, the data of it may still arrive
+	MaxFreshness toml.Duration `toml:"max-freshness"`
+
+	// TTL bounds the staleness of the buckets changed by the writes not seen by this node despite the above
+	TTL toml.Duration `toml:"ttl"`
+}
+
+func NewQueryResultCacheConfig() QueryResultCacheConfig {
+	return QueryResultCacheConfig{
+		Enabled:        false,
+		MaxMemorySize:  DefaultQueryResultCacheMaxMemorySize,
+		BucketInterval: toml.Duration(DefaultQueryResultCacheBucketInterval),
+		MaxFreshness:   toml.Duration(DefaultQueryResultCacheMaxFreshness),
+		TTL:            toml.Duration(DefaultQueryResultCacheTTL),
+	}
+}
+
+func (c QueryResultCacheConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxMemorySize <= 0 {
+		return fmt.Errorf("query-result-cache max-memory-size must be greater than zero")
+	}
+	if len(c.Databases) == 0 {
+		return fmt.Errorf("query-result-cache databases must not be empty")
+	}
+	if c.BucketInterval <= 0 {
+		return fmt.Errorf("query-result-cache bucket-interval must be greater than zero")
+	}
+	if c.MaxFreshness < 0 {
+		return fmt.Errorf("query-result-cache max-freshness must not be negative")
+	}
+	if c.TTL <= 0 {
+		return fmt.Errorf("query-result-cache ttl must be greater than zero")
+	}
+	return nil
+}
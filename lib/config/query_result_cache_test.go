@@ -0,0 +1,72 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	itoml "github.com/influxdata/influxdb/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResultCacheConfig_Decode(t *testing.T) {
+	confStr := `
+[query-result-cache]
+  enabled = true
+  max-memory-size = "64m"
+  bucket-interval = "30m"
+  databases = ["db0", "db1"]
+`
+	c := NewTSSql(false)
+	_, err := toml.Decode(confStr, c)
+	require.NoError(t, err)
+	require.NoError(t, c.QueryResultCache.Validate())
+	require.True(t, c.QueryResultCache.Enabled)
+	require.Equal(t, itoml.Size(64*1024*1024), c.QueryResultCache.MaxMemorySize)
+	require.Equal(t, itoml.Duration(30*time.Minute), c.QueryResultCache.BucketInterval)
+	require.Equal(t, []string{"db0", "db1"}, c.QueryResultCache.Databases)
+	require.Equal(t, itoml.Duration(DefaultQueryResultCacheTTL), c.QueryResultCache.TTL)
+}
+
+func TestQueryResultCacheConfig_Validate(t *testing.T) {
+	conf := NewQueryResultCacheConfig()
+	conf.MaxMemorySize = 0
+	require.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	require.EqualError(t, conf.Validate(), "query-result-cache max-memory-size must be greater than zero")
+
+	conf = NewQueryResultCacheConfig()
+	conf.Enabled = true
+	require.EqualError(t, conf.Validate(), "query-result-cache databases must not be empty")
+
+	conf.Databases = []string{"db0"}
+	require.NoError(t, conf.Validate())
+
+	conf.BucketInterval = 0
+	require.EqualError(t, conf.Validate(), "query-result-cache bucket-interval must be greater than zero")
+
+	conf = NewQueryResultCacheConfig()
+	conf.Enabled = true
+	conf.Databases = []string{"db0"}
+	conf.MaxFreshness = -1
+	require.EqualError(t, conf.Validate(), "query-result-cache max-freshness must not be negative")
+
+	conf.MaxFreshness = 0
+	conf.TTL = 0
+	require.EqualError(t, conf.Validate(), "query-result-cache ttl must be greater than zero")
+}
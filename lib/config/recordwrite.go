@@ -56,17 +56,21 @@ func (c RecordWriteConfig) Validate() error {
 		return fmt.Errorf("maximum message size must be greater than zero")
 	}
 
-	if !c.TLS.Enabled {
-		if c.TLS.ClientAuth {
-			return fmt.Errorf("%v TLS must be enabled first for mutal-TLS", err)
+	return c.TLS.validate(err)
+}
+
+func (c tlsConfig) validate(prefix error) error {
+	if !c.Enabled {
+		if c.ClientAuth {
+			return fmt.Errorf("%v TLS must be enabled first for mutal-TLS", prefix)
 		}
 	} else {
-		if c.TLS.KeyFile == "" || c.TLS.CertFile == "" {
-			return fmt.Errorf("%v TLS is enabled but no Key/Cert file provided", err)
+		if c.KeyFile == "" || c.CertFile == "" {
+			return fmt.Errorf("%v TLS is enabled but no Key/Cert file provided", prefix)
 		}
-		if c.TLS.ClientAuth {
-			if c.TLS.CARoot == "" {
-				return fmt.Errorf("%v mutal-TLS is enabled but no CA root provided", err)
+		if c.ClientAuth {
+			if c.CARoot == "" {
+				return fmt.Errorf("%v mutal-TLS is enabled but no CA root provided", prefix)
 			}
 		}
 	}
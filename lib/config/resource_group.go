@@ -0,0 +1,90 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+// ResourceGroup limits the queries of the users and the databases assigned to it.
+// The group of the user is used if both the user and the database of a query are assigned.
+type ResourceGroup struct {
+	Name string `toml:"name"`
+
+	// Maximum number of running queries of the group. A value of zero means no limit.
+	MaxConcurrentQueries int `toml:"max-concurrent-queries"`
+
+	// Maximum number of queries waiting for a running slot, the query is rejected if the queue is full.
+	MaxQueuedQueries int `toml:"max-queued-queries"`
+
+	// Maximum number of memory bytes held by the running queries of the group, which is the size of the chunks
+	// received from the stores and not yet consumed by the executor. A value of zero means no limit.
+	MaxQueryMem toml.Size `toml:"max-query-mem"`
+
+	// Percentage of the query parallelism the queries of the group can use, in the range of [0, 100].
+	// A value of zero means the queries use the whole parallelism.
+	CPUShare int `toml:"cpu-share"`
+
+	Users     []string `toml:"users"`
+	Databases []string `toml:"databases"`
+}
+
+func (c ResourceGroup) Validate() error {
+	if c.Name == "" {
+		return errors.New("resource group name must be specified")
+	}
+	if c.MaxConcurrentQueries < 0 || c.MaxQueuedQueries < 0 {
+		return fmt.Errorf("resource group %s: max-concurrent-queries and max-queued-queries can not be negative", c.Name)
+	}
+	if c.CPUShare < 0 || c.CPUShare > 100 {
+		return fmt.Errorf("resource group %s: cpu-share must be in the range of [0, 100]", c.Name)
+	}
+	return nil
+}
+
+type ResourceGroups []ResourceGroup
+
+// Validate checks every group, and that no group name, user or database is defined twice
+func (c ResourceGroups) Validate() error {
+	names := make(map[string]struct{}, len(c))
+	users := make(map[string]string)
+	dbs := make(map[string]string)
+	for _, g := range c {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[g.Name]; ok {
+			return fmt.Errorf("resource group %s is defined more than once", g.Name)
+		}
+		names[g.Name] = struct{}{}
+
+		for _, u := range g.Users {
+			if other, ok := users[u]; ok {
+				return fmt.Errorf("user %s is assigned to resource group %s and %s", u, other, g.Name)
+			}
+			users[u] = g.Name
+		}
+		for _, db := range g.Databases {
+			if other, ok := dbs[db]; ok {
+				return fmt.Errorf("database %s is assigned to resource group %s and %s", db, other, g.Name)
+			}
+			dbs[db] = g.Name
+		}
+	}
+	return nil
+}
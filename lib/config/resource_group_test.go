@@ -0,0 +1,57 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceGroups_Validate(t *testing.T) {
+	conf := NewTSSql(false)
+	_, err := toml.Decode(`
+[coordinator]
+[[coordinator.resource-groups]]
+  name = "dashboard"
+  max-concurrent-queries = 10
+  max-queued-queries = 100
+  max-query-mem = "4g"
+  cpu-share = 50
+  users = ["grafana"]
+[[coordinator.resource-groups]]
+  name = "batch"
+  databases = ["db0"]
+`, conf)
+	require.NoError(t, err)
+	groups := conf.Coordinator.ResourceGroups
+	require.Equal(t, 2, len(groups))
+	require.Equal(t, ResourceGroup{Name: "dashboard", MaxConcurrentQueries: 10, MaxQueuedQueries: 100,
+		MaxQueryMem: 4 * 1024 * 1024 * 1024, CPUShare: 50, Users: []string{"grafana"}}, groups[0])
+	require.NoError(t, conf.Coordinator.Validate())
+
+	require.EqualError(t, ResourceGroups{{}}.Validate(), "resource group name must be specified")
+	require.EqualError(t, ResourceGroups{{Name: "g", MaxQueuedQueries: -1}}.Validate(),
+		"resource group g: max-concurrent-queries and max-queued-queries can not be negative")
+	require.EqualError(t, ResourceGroups{{Name: "g", CPUShare: 101}}.Validate(),
+		"resource group g: cpu-share must be in the range of [0, 100]")
+	require.EqualError(t, ResourceGroups{{Name: "g"}, {Name: "g"}}.Validate(),
+		"resource group g is defined more than once")
+	require.EqualError(t, ResourceGroups{{Name: "g1", Users: []string{"u"}}, {Name: "g2", Users: []string{"u"}}}.Validate(),
+		"user u is assigned to resource group g1 and g2")
+	require.EqualError(t, ResourceGroups{{Name: "g1", Databases: []string{"db"}}, {Name: "g2", Databases: []string{"db"}}}.Validate(),
+		"database db is assigned to resource group g1 and g2")
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultRuleEvaluationInterval is the default interval of the rule groups without interval.
+	DefaultRuleEvaluationInterval = time.Minute
+
+	// DefaultRuleNotifyTimeout is the default timeout of sending alerts to the alertmanager.
+	DefaultRuleNotifyTimeout = 10 * time.Second
+
+	// DefaultRuleResendDelay is the default interval of resending the firing alerts to the alertmanager.
+	DefaultRuleResendDelay = time.Minute
+
+	DefaultRuleDatabase = "prom"
+
+	DefaultRuleRetentionPolicy = "autogen"
+)
+
+// RuleConfig is the configuration for the Prometheus-style rule evaluator service.
+type RuleConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// RuleFiles is the list of the Prometheus rule-group files, file globs are supported.
+	RuleFiles []string `toml:"rule-files"`
+
+	// EvaluationInterval is the interval of the rule groups which do not specify an interval.
+	EvaluationInterval toml.Duration `toml:"evaluation-interval"`
+
+	// Database and RetentionPolicy are where the rules query from and the recording rules write to.
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	// AlertmanagerURL is the Alertmanager-compatible webhook the alerts are posted to, e.g.
+	// http://127.0.0.1:9093/api/v2/alerts. No notification is sent if it is empty.
+	AlertmanagerURL string        `toml:"alertmanager-url"`
+	NotifyTimeout   toml.Duration `toml:"notify-timeout"`
+	ResendDelay     toml.Duration `toml:"resend-delay"`
+}
+
+// NewRuleConfig returns a new instance of RuleConfig with defaults.
+func NewRuleConfig() RuleConfig {
+	return RuleConfig{
+		Enabled:            false,
+		EvaluationInterval: toml.Duration(DefaultRuleEvaluationInterval),
+		Database:           DefaultRuleDatabase,
+		RetentionPolicy:    DefaultRuleRetentionPolicy,
+		NotifyTimeout:      toml.Duration(DefaultRuleNotifyTimeout),
+		ResendDelay:        toml.Duration(DefaultRuleResendDelay),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c RuleConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if time.Duration(c.EvaluationInterval) < time.Second {
+		return errors.New("rule evaluation interval must be at least 1 second")
+	}
+	if c.Database == "" {
+		return errors.New("rule database must not be empty")
+	}
+	if time.Duration(c.NotifyTimeout) <= 0 {
+		return errors.New("rule notify timeout must be greater than 0")
+	}
+	if c.ResendDelay < 0 {
+		return errors.New("rule resend delay must be greater or equal than 0")
+	}
+	if c.AlertmanagerURL != "" {
+		if _, err := url.ParseRequestURI(c.AlertmanagerURL); err != nil {
+			return fmt.Errorf("invalid rule alertmanager url: %v", err)
+		}
+	}
+	return nil
+}
+
+func (c RuleConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"rule.enabled":             c.Enabled,
+		"rule.rule-files":          c.RuleFiles,
+		"rule.evaluation-interval": c.EvaluationInterval,
+		"rule.database":            c.Database,
+		"rule.retention-policy":    c.RetentionPolicy,
+		"rule.alertmanager-url":    c.AlertmanagerURL,
+		"rule.notify-timeout":      c.NotifyTimeout,
+		"rule.resend-delay":        c.ResendDelay,
+	}
+}
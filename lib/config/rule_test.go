@@ -0,0 +1,51 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RuleConfig_Validate(t *testing.T) {
+	c := NewRuleConfig()
+	c.EvaluationInterval = 0
+	require.NoError(t, c.Validate())
+
+	c.Enabled = true
+	require.EqualError(t, c.Validate(), "rule evaluation interval must be at least 1 second")
+	c.EvaluationInterval = toml.Duration(time.Minute)
+
+	c.Database = ""
+	require.EqualError(t, c.Validate(), "rule database must not be empty")
+	c.Database = DefaultRuleDatabase
+
+	c.NotifyTimeout = 0
+	require.EqualError(t, c.Validate(), "rule notify timeout must be greater than 0")
+	c.NotifyTimeout = toml.Duration(time.Second)
+
+	c.ResendDelay = -1
+	require.EqualError(t, c.Validate(), "rule resend delay must be greater or equal than 0")
+	c.ResendDelay = 0
+
+	c.AlertmanagerURL = "127.0.0.1:9093"
+	require.Error(t, c.Validate())
+	c.AlertmanagerURL = "http://127.0.0.1:9093/api/v2/alerts"
+	require.NoError(t, c.Validate())
+	require.Equal(t, c.AlertmanagerURL, c.ShowConfigs()["rule.alertmanager-url"])
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultScrubRunInterval = 24 * time.Hour
+)
+
+// ScrubConfig represents a configuration for the scrub service, which verifies the TSSP files of the shards periodically.
+type ScrubConfig struct {
+	// If false, close the scrub service
+	Enabled bool `toml:"enabled"`
+
+	// Interval time for scrubbing all the shards.
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// The corrupted files are moved into this directory, so that the rest of the shard is still queryable.
+	// If empty, the corrupted files are only reported.
+	QuarantineDir string `toml:"quarantine-dir"`
+
+	// The report of each run is written into this directory in json format. If empty, the report is only logged.
+	ReportDir string `toml:"report-dir"`
+}
+
+func NewScrubConfig() ScrubConfig {
+	return ScrubConfig{
+		Enabled:     false,
+		RunInterval: toml.Duration(DefaultScrubRunInterval),
+	}
+}
+
+func (c ScrubConfig) Validate() error {
+	if c.Enabled && c.RunInterval <= 0 {
+		return errors.New("scrub run-interval must be positive")
+	}
+	return nil
+}
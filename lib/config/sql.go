@@ -91,6 +91,10 @@ type TSSql struct {
 	ContinuousQuery ContinuousQueryConfig `toml:"continuous_queries"`
 	Data            Store                 `toml:"data"`
 	RecordWrite     RecordWriteConfig     `toml:"record-write"`
+	Rule            RuleConfig            `toml:"rule"`
+	MQTT            MQTTConfig            `toml:"mqtt"`
+
+	QueryResultCache QueryResultCacheConfig `toml:"query-result-cache"`
 }
 
 // NewTSSql returns an instance of Config with reasonable defaults.
@@ -109,6 +113,9 @@ func NewTSSql(enableGossip bool) *TSSql {
 	c.ContinuousQuery = NewContinuousQueryConfig()
 	c.Gossip = NewGossip(enableGossip)
 	c.RecordWrite = NewRecordWriteConfig()
+	c.Rule = NewRuleConfig()
+	c.MQTT = NewMQTTConfig()
+	c.QueryResultCache = NewQueryResultCacheConfig()
 	return c
 }
 
@@ -170,6 +177,9 @@ func (c *TSSql) Validate() error {
 		c.Subscriber,
 		c.ContinuousQuery,
 		c.RecordWrite,
+		c.Rule,
+		c.MQTT,
+		c.QueryResultCache,
 	}
 
 	for _, item := range items {
@@ -224,6 +234,9 @@ func (c *TSSql) ShowConfigs() map[string]interface{} {
 	for k, v := range c.RecordWrite.ShowConfigs() {
 		sqlConfig[k] = v
 	}
+	for k, v := range c.Rule.ShowConfigs() {
+		sqlConfig[k] = v
+	}
 	return sqlConfig
 }
 
@@ -254,6 +267,9 @@ type Coordinator struct {
 	QueryLimitFlag          bool `toml:"query-limit-flag"`
 	QueryTimeCompareEnabled bool `toml:"query-time-compare-enabled"`
 	ForceBroadcastQuery     bool `toml:"force-broadcast-query"`
+
+	// Resource groups limit the queries of the assigned users and databases
+	ResourceGroups ResourceGroups `toml:"resource-groups"`
 }
 
 // NewCoordinator returns an instance of Config with defaults.
@@ -287,7 +303,7 @@ func (c Coordinator) Validate() error {
 	if c.ShardMapperTimeout < 0 {
 		return errors.New("coordinator shard-mapper-timeout can not be negative")
 	}
-	return nil
+	return c.ResourceGroups.Validate()
 }
 
 func (c *Coordinator) ShowConfigs() map[string]interface{} {
@@ -88,6 +88,8 @@ type TSStore struct {
 	Retention         retention.Config   `toml:"retention"`
 	DownSample        retention.Config   `toml:"downsample"`
 	HierarchicalStore HierarchicalConfig `toml:"hierarchical_storage"`
+	Scrub             ScrubConfig        `toml:"scrub"`
+	ObjectStorage     ObjectStorage      `toml:"object-storage"`
 	Stream            stream.Config      `toml:"stream"`
 
 	// TLS provides configuration options for all https endpoints.
@@ -123,6 +125,8 @@ func NewTSStore(enableGossip bool) *TSStore {
 	c.Retention = retention.NewConfig()
 	c.DownSample = retention.NewConfig()
 	c.HierarchicalStore = NewHierarchicalConfig()
+	c.Scrub = NewScrubConfig()
+	c.ObjectStorage = NewObjectStorage()
 	c.Gossip = NewGossip(enableGossip)
 
 	c.Analysis = NewCastor()
@@ -149,6 +153,8 @@ func (c *TSStore) Validate() error {
 		c.Retention,
 		c.DownSample,
 		c.HierarchicalStore,
+		c.Scrub,
+		c.ObjectStorage,
 		c.TLS,
 		c.Logging,
 		c.Spdy,
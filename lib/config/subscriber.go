@@ -25,6 +25,13 @@ import (
 const (
 	DefaultHTTPTimeout = 30 * time.Second // 30 seconds
 	DefaultBufferSize  = 100              // channel size 100
+
+	DefaultQueueMaxSize      = 1024 * 1024 * 1024 // 1GB
+	MinQueueMaxSize          = 1024 * 1024        // 1MB, the queue is split into segments of a quarter of it
+	DefaultQueueMaxAge       = 24 * time.Hour
+	DefaultQueueSyncInterval = time.Second
+	DefaultRetryInterval     = time.Second
+	DefaultRetryMaxInterval  = time.Minute
 )
 
 type Subscriber struct {
@@ -34,6 +41,16 @@ type Subscriber struct {
 	HttpsCertificate   string        `toml:"https-certificate"`
 	WriteBufferSize    int           `toml:"write-buffer-size"`
 	WriteConcurrency   int           `toml:"write-concurrency"`
+
+	// QueueDir enables the durable delivery, the line protocol of every destination is queued
+	// in the directory before it is sent, and retried until the destination accepts it.
+	// The queue is synced to the disk every QueueSyncInterval, or on every append if it is zero.
+	QueueDir          string        `toml:"queue-dir"`
+	QueueMaxSize      toml.Size     `toml:"queue-max-size"`
+	QueueMaxAge       toml.Duration `toml:"queue-max-age"`
+	QueueSyncInterval toml.Duration `toml:"queue-sync-interval"`
+	RetryInterval     toml.Duration `toml:"retry-interval"`
+	RetryMaxInterval  toml.Duration `toml:"retry-max-interval"`
 }
 
 func NewSubscriber() Subscriber {
@@ -44,6 +61,11 @@ func NewSubscriber() Subscriber {
 		HttpsCertificate:   "",
 		WriteBufferSize:    DefaultBufferSize,
 		WriteConcurrency:   runtime.NumCPU() * 2,
+		QueueMaxSize:       toml.Size(DefaultQueueMaxSize),
+		QueueMaxAge:        toml.Duration(DefaultQueueMaxAge),
+		QueueSyncInterval:  toml.Duration(DefaultQueueSyncInterval),
+		RetryInterval:      toml.Duration(DefaultRetryInterval),
+		RetryMaxInterval:   toml.Duration(DefaultRetryMaxInterval),
 	}
 }
 
@@ -57,6 +79,21 @@ func (s Subscriber) Validate() error {
 	if s.WriteConcurrency <= 0 {
 		return errors.New("subscriber write-concurrency can not be zero or negative")
 	}
+	if s.QueueDir == "" {
+		return nil
+	}
+	if s.QueueMaxSize < MinQueueMaxSize {
+		return errors.New("subscriber queue-max-size can not be less than 1m")
+	}
+	if s.QueueMaxAge < 0 {
+		return errors.New("subscriber queue-max-age can not be negative")
+	}
+	if s.QueueSyncInterval < 0 {
+		return errors.New("subscriber queue-sync-interval can not be negative")
+	}
+	if s.RetryInterval <= 0 || s.RetryMaxInterval < s.RetryInterval {
+		return errors.New("subscriber retry-interval must be positive and not greater than retry-max-interval")
+	}
 	return nil
 }
 
@@ -68,5 +105,11 @@ func (c *Subscriber) ShowConfigs() map[string]interface{} {
 		"subscriber.https-certificate":    c.HttpsCertificate,
 		"subscriber.write-buffer-size":    c.WriteBufferSize,
 		"subscriber.write-concurrency":    c.WriteConcurrency,
+		"subscriber.queue-dir":            c.QueueDir,
+		"subscriber.queue-max-size":       c.QueueMaxSize,
+		"subscriber.queue-max-age":        c.QueueMaxAge,
+		"subscriber.queue-sync-interval":  c.QueueSyncInterval,
+		"subscriber.retry-interval":       c.RetryInterval,
+		"subscriber.retry-max-interval":   c.RetryMaxInterval,
 	}
 }
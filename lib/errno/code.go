@@ -107,6 +107,9 @@ const (
 	ChunkReaderCursor            = 1127
 	ApplyFuncErr                 = 1128
 	QueryAborted                 = 1129
+	ResourceGroupQueueFull       = 1130
+	ResourceGroupQueueTimeout    = 1131
+	ResourceGroupMemoryExceeded  = 1132
 )
 
 // promql2influxql
@@ -233,6 +233,9 @@ var messageMap = map[Errno]*Message{
 	ShardBucketLacks:               newWarnMessage("get shard resources out of time: bucket lacks of resources", ModuleQueryEngine),
 	SeriesBucketLacks:              newWarnMessage("get series resources out of time: bucket lacks of resources", ModuleQueryEngine),
 	QueryAborted:                   newWarnMessage("query has been aborted", ModuleQueryEngine),
+	ResourceGroupQueueFull:         newWarnMessage("resource group %s: queue is full, %d queries are waiting", ModuleQueryEngine),
+	ResourceGroupQueueTimeout:      newWarnMessage("resource group %s: timeout waiting for a running slot", ModuleQueryEngine),
+	ResourceGroupMemoryExceeded:    newWarnMessage("resource group %s: memory usage %d exceeds the limit %d", ModuleQueryEngine),
 	SortTransformRunningErr:        newWarnMessage("SortTransform run error", ModuleQueryEngine),
 	HashMergeTransformRunningErr:   newWarnMessage("HashMergeTransform run error", ModuleQueryEngine),
 	HashAggTransformRunningErr:     newWarnMessage("HashAggTransform work error", ModuleQueryEngine),
@@ -20,6 +20,7 @@ import (
 
 	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
 	"github.com/openGemini/openGemini/lib/cache"
+	"github.com/openGemini/openGemini/lib/config"
 )
 
 const (
@@ -120,6 +121,9 @@ type obsClient struct {
 }
 
 func newObsClient(conf *obsConf) (ObsClient, error) {
+	if storage := config.GetObjectStorage(); storage.Type == config.ObjectStorageS3 {
+		return newS3Client(conf, storage), nil
+	}
 	client, err := obs.New(conf.ak, conf.sk, conf.endpoint, obs.WithMaxRetryCount(3))
 	if err != nil {
 		return nil, err
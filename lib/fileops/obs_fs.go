@@ -111,6 +111,19 @@ func (o *obsFile) Write(src []byte) (int, error) {
 	return size, nil
 }
 
+// ReadFrom uploads the reader by the multipart upload on S3, where the object can not be appended cheaply
+func (o *obsFile) ReadFrom(r io.Reader) (int64, error) {
+	if c, ok := o.client.(s3Compatible); ok && o.offset == 0 {
+		n, err := c.uploadObject(o.conf.bucket, o.key, r)
+		if err != nil {
+			return 0, err
+		}
+		o.offset += n
+		return n, nil
+	}
+	return io.Copy(struct{ io.Writer }{o}, r)
+}
+
 func (o *obsFile) ReadAt(dst []byte, off int64) (int, error) {
 	size := len(dst)
 	if size <= 0 || off < 0 {
@@ -125,6 +138,7 @@ func (o *obsFile) ReadAt(dst []byte, off int64) (int, error) {
 	if getObjectOutput, err := o.client.GetObject(getObjectInput); err != nil {
 		return 0, fmt.Errorf("obsClient.GetObject failed, error: %v", err)
 	} else {
+		defer getObjectOutput.Body.Close()
 		_, err = io.ReadFull(getObjectOutput.Body, dst)
 		if err != nil {
 			return 0, err
@@ -135,6 +149,10 @@ func (o *obsFile) ReadAt(dst []byte, off int64) (int, error) {
 
 func (o *obsFile) StreamReadBatch(offsets []int64, sizes []int64, minBlockSize int64, c chan *request.StreamReader, rangSize int, isStat bool) {
 	defer close(c)
+	if _, ok := o.client.(s3Compatible); ok {
+		// S3 serves a single range for each request
+		rangSize = 1
+	}
 	rangeRequests, err := NewObsReadRequest(offsets, sizes, minBlockSize, rangSize)
 	if err != nil {
 		c <- &request.StreamReader{Err: err}
@@ -242,8 +260,13 @@ func (o *obsFile) newRequest(objUrl string, rangeRequest *RangeRequest) (*http.R
 		logger.GetLogger().Error("obsClient.StreamReadMultiRange new request failed, url is: " + objUrl)
 		return nil, err
 	}
-	dateStr := time.Now().UTC().Format(time.RFC1123)
 	req.Header = http.Header{}
+	if c, ok := o.client.(s3Compatible); ok {
+		req.Header.Add("Range", "bytes="+rangeRequest.GetRangeString())
+		c.signRequest(req, nil)
+		return req, nil
+	}
+	dateStr := time.Now().UTC().Format(time.RFC1123)
 	req.Header.Add("Authorization", headerSignature(o.conf.ak, o.conf.sk, o.conf.bucket, http.MethodGet, dateStr, o.key))
 	req.Header.Add("Date", dateStr)
 	req.Header.Add("Range", "bytes="+rangeRequest.GetRangeString())
@@ -277,7 +300,7 @@ func (o *obsFile) StreamReadRangeRequests(retryTimes int32, c chan *request.Stre
 			if resp, err := o.client.Do(req); err != nil {
 				retryInfo.dealReadErr(err, c, rangeRequest.readMap)
 				return
-			} else if strings.ToLower(resp.Header.Get("Content-Type")) == "binary/octet-stream" {
+			} else if isOctetStream(resp.Header.Get("Content-Type")) {
 				readers := o.getStreamReaders(resp.Header.Get("Content-range"), rangeRequest)
 				for i, v := range readers {
 					readLen, err := io.ReadFull(resp.Body, v.Content)
@@ -337,7 +360,15 @@ func (o *obsFile) StreamReadRangeRequests(retryTimes int32, c chan *request.Stre
 	return toReadLen
 }
 
+func isOctetStream(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return contentType == "binary/octet-stream" || contentType == "application/octet-stream"
+}
+
 func (o *obsFile) buildObsEndpoint() string {
+	if c, ok := o.client.(s3Compatible); ok {
+		return c.objectURL(o.conf.bucket, o.key, nil)
+	}
 	return fmt.Sprintf("http://%s.%s/%s", o.conf.bucket, o.conf.endpoint, o.key)
 }
 
@@ -349,6 +380,15 @@ func (o *obsFile) Truncate(size int64) error {
 	if size < 0 {
 		return fmt.Errorf("truncate size must be positive")
 	}
+	if c, ok := o.client.(s3Compatible); ok {
+		if err := c.truncateObject(o.conf.bucket, o.key, size); err != nil {
+			return err
+		}
+		if o.offset > size {
+			o.offset = size
+		}
+		return nil
+	}
 	url := fmt.Sprintf("http://%s.%s/%s", o.conf.bucket, o.conf.endpoint, o.key)
 	extension := fmt.Sprintf("?length=%d&truncate", size)
 	req, err := http.NewRequest(http.MethodPut, url+extension, nil)
@@ -507,7 +547,7 @@ func (o *obsFs) OpenFile(path string, flag int, perm os.FileMode, opt ...FSOptio
 
 	meta, err := client.GetObjectMetadata(input)
 	if err != nil {
-		if err.(obs.ObsError).StatusCode == 404 && flag&os.O_CREATE > 0 { // object not found
+		if obsErr, ok := err.(obs.ObsError); ok && obsErr.StatusCode == 404 && flag&os.O_CREATE > 0 { // object not found
 			putObjectInput := &obs.PutObjectInput{
 				Body: bytes.NewReader([]byte{}),
 			}
@@ -775,6 +815,7 @@ func (o *obsFs) ReadFile(filename string, opt ...FSOption) ([]byte, error) {
 	if getObjectOutput, err := client.GetObject(getObjectInput); err != nil {
 		return nil, fmt.Errorf("obsClient.GetObject failed, error: %v", err)
 	} else {
+		defer getObjectOutput.Body.Close()
 		dst := make([]byte, getObjectOutput.ContentLength)
 		_, err = io.ReadFull(getObjectOutput.Body, dst)
 		if err != nil {
@@ -0,0 +1,756 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileops
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
+	"github.com/openGemini/openGemini/lib/config"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3Service         = "s3"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+	s3EmptyPayload    = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	s3ContentType     = "binary/octet-stream"
+	s3MaxCopySize     = 5 * 1024 * 1024 * 1024
+	s3DeleteBatchSize = 1000
+)
+
+// s3MinPartSize is the minimum size of the parts of the multipart upload except the last one
+var s3MinPartSize int64 = config.MinObjectStoragePartSize
+
+// s3Compatible is implemented by the clients of the S3 protocol. The raw requests of obsFile are addressed and signed
+// by the client instead of the OBS way, and the operations S3 does not have are emulated.
+type s3Compatible interface {
+	objectURL(bucket, key string, query url.Values) string
+	signRequest(req *http.Request, payload []byte)
+	truncateObject(bucket, key string, size int64) error
+	uploadObject(bucket, key string, r io.Reader) (int64, error)
+}
+
+// s3Client implements ObsClient on any S3-compatible object storage, such as MinIO and Ceph RGW.
+type s3Client struct {
+	httpClient *http.Client
+	obsConf    *obsConf
+	conf       config.ObjectStorage
+}
+
+func newS3Client(conf *obsConf, storage config.ObjectStorage) *s3Client {
+	return &s3Client{
+		httpClient: &http.Client{
+			Timeout: time.Duration(30) * time.Second,
+		},
+		obsConf: conf,
+		conf:    storage,
+	}
+}
+
+type s3InitiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	UploadId string   `xml:"UploadId"`
+}
+
+type s3CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type s3CompleteMultipartUpload struct {
+	XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+	Parts   []s3CompletedPart `xml:"Part"`
+}
+
+type s3DeleteObjects struct {
+	XMLName xml.Name             `xml:"Delete"`
+	Quiet   bool                 `xml:"Quiet,omitempty"`
+	Objects []obs.ObjectToDelete `xml:"Object"`
+}
+
+type s3CopyResult struct {
+	ETag string `xml:"ETag"`
+}
+
+func s3HashPayload(payload []byte) string {
+	if len(payload) == 0 {
+		return s3EmptyPayload
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+func s3HmacSha256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3EscapePath escapes every segment of the object path as SigV4 requires, the slashes are kept
+func s3EscapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		segments[i] = s3Escape(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+func s3Escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// signV4 signs the request with AWS Signature Version 4, the host and every header set on the request are signed
+func signV4(req *http.Request, ak, sk, region, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format(s3TimeFormat)
+	date := now.UTC().Format(s3DateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k)
+		canonicalHeaders.WriteString(":")
+		canonicalHeaders.WriteString(headers[k])
+		canonicalHeaders.WriteString("\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, s3Service, "aws4_request"}, "/")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+
+	key := s3HmacSha256([]byte("AWS4"+sk), date)
+	key = s3HmacSha256(key, region)
+	key = s3HmacSha256(key, s3Service)
+	key = s3HmacSha256(key, "aws4_request")
+	signature := hex.EncodeToString(s3HmacSha256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, ak, scope, signedHeaders, signature))
+}
+
+func (c *s3Client) objectURL(bucket, key string, query url.Values) string {
+	key = strings.TrimPrefix(key, "/")
+	var u string
+	if c.conf.PathStyle {
+		u = fmt.Sprintf("%s://%s/%s/%s", c.conf.Scheme, c.obsConf.endpoint, bucket, s3EscapePath(key))
+	} else {
+		u = fmt.Sprintf("%s://%s.%s/%s", c.conf.Scheme, bucket, c.obsConf.endpoint, s3EscapePath(key))
+	}
+	if len(query) > 0 {
+		u += "?" + s3CanonicalQuery(query)
+	}
+	return u
+}
+
+func (c *s3Client) signRequest(req *http.Request, payload []byte) {
+	signV4(req, c.obsConf.ak, c.obsConf.sk, c.conf.Region, s3HashPayload(payload), time.Now())
+}
+
+// do sends a signed request on the object, the error of the response is returned as obs.ObsError
+func (c *s3Client) do(method, bucket, key string, query url.Values, header http.Header, payload []byte) (*http.Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, c.objectURL(bucket, key, query), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	c.signRequest(req, payload)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, s3ParseError(resp)
+	}
+	return resp, nil
+}
+
+func s3ParseError(resp *http.Response) error {
+	obsErr := obs.ObsError{}
+	obsErr.StatusCode = resp.StatusCode
+	obsErr.Status = resp.Status
+	obsErr.ResponseHeaders = resp.Header
+	body, _ := io.ReadAll(resp.Body)
+	if len(body) > 0 {
+		_ = xml.Unmarshal(body, &obsErr)
+	}
+	obsErr.RequestId = resp.Header.Get("X-Amz-Request-Id")
+	return obsErr
+}
+
+func s3BaseModel(resp *http.Response) obs.BaseModel {
+	return obs.BaseModel{
+		StatusCode:      resp.StatusCode,
+		RequestId:       resp.Header.Get("X-Amz-Request-Id"),
+		ResponseHeaders: resp.Header,
+	}
+}
+
+func s3DecodeResponse(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+	return xml.NewDecoder(resp.Body).Decode(v)
+}
+
+func s3DiscardResponse(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+func s3Metadata(resp *http.Response) obs.GetObjectMetadataOutput {
+	output := obs.GetObjectMetadataOutput{
+		BaseModel:     s3BaseModel(resp),
+		ContentLength: resp.ContentLength,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ETag:          resp.Header.Get("ETag"),
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		output.LastModified = t
+	}
+	return output
+}
+
+func (c *s3Client) ListBuckets(input *obs.ListBucketsInput) (*obs.ListBucketsOutput, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s://%s/", c.conf.Scheme, c.obsConf.endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	c.signRequest(req, nil)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, s3ParseError(resp)
+	}
+	output := &obs.ListBucketsOutput{BaseModel: s3BaseModel(resp)}
+	return output, s3DecodeResponse(resp, output)
+}
+
+// ListObjects lists the objects of the prefix, all pages are listed if the MaxKeys is not set
+func (c *s3Client) ListObjects(input *obs.ListObjectsInput) (*obs.ListObjectsOutput, error) {
+	marker := input.Marker
+	var result *obs.ListObjectsOutput
+	for {
+		query := url.Values{}
+		query.Set("prefix", input.Prefix)
+		if input.Delimiter != "" {
+			query.Set("delimiter", input.Delimiter)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		if input.MaxKeys > 0 {
+			query.Set("max-keys", strconv.Itoa(input.MaxKeys))
+		}
+		resp, err := c.do(http.MethodGet, input.Bucket, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		output := &obs.ListObjectsOutput{BaseModel: s3BaseModel(resp)}
+		if err = s3DecodeResponse(resp, output); err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = output
+		} else {
+			result.Contents = append(result.Contents, output.Contents...)
+			result.CommonPrefixes = append(result.CommonPrefixes, output.CommonPrefixes...)
+			result.IsTruncated = output.IsTruncated
+			result.NextMarker = output.NextMarker
+		}
+		if !output.IsTruncated || input.MaxKeys > 0 {
+			return result, nil
+		}
+		marker = output.NextMarker
+		if marker == "" && len(output.Contents) > 0 {
+			marker = output.Contents[len(output.Contents)-1].Key
+		}
+		if marker == "" {
+			return result, nil
+		}
+	}
+}
+
+func (c *s3Client) GetObject(input *obs.GetObjectInput) (*obs.GetObjectOutput, error) {
+	header := http.Header{}
+	if input.RangeEnd > 0 || input.RangeStart > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", input.RangeStart, input.RangeEnd))
+	}
+	resp, err := c.do(http.MethodGet, input.Bucket, input.Key, nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &obs.GetObjectOutput{
+		GetObjectMetadataOutput: s3Metadata(resp),
+		Body:                    resp.Body,
+	}, nil
+}
+
+func (c *s3Client) DeleteObject(input *obs.DeleteObjectInput) (*obs.DeleteObjectOutput, error) {
+	resp, err := c.do(http.MethodDelete, input.Bucket, input.Key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	s3DiscardResponse(resp)
+	return &obs.DeleteObjectOutput{BaseModel: s3BaseModel(resp)}, nil
+}
+
+func (c *s3Client) DeleteObjects(input *obs.DeleteObjectsInput) (*obs.DeleteObjectsOutput, error) {
+	result := &obs.DeleteObjectsOutput{}
+	objects := input.Objects
+	for len(objects) > 0 {
+		n := len(objects)
+		if n > s3DeleteBatchSize {
+			n = s3DeleteBatchSize
+		}
+		batch := &s3DeleteObjects{Quiet: input.Quiet, Objects: objects[:n]}
+		objects = objects[n:]
+		payload, err := xml.Marshal(batch)
+		if err != nil {
+			return nil, err
+		}
+		sum := md5Base64(payload)
+		header := http.Header{}
+		header.Set("Content-Md5", sum)
+		header.Set("Content-Type", "application/xml")
+		resp, err := c.do(http.MethodPost, input.Bucket, "", url.Values{"delete": {""}}, header, payload)
+		if err != nil {
+			return nil, err
+		}
+		output := &obs.DeleteObjectsOutput{}
+		if err = s3DecodeResponse(resp, output); err != nil {
+			return nil, err
+		}
+		result.BaseModel = s3BaseModel(resp)
+		result.Deleteds = append(result.Deleteds, output.Deleteds...)
+		result.Errors = append(result.Errors, output.Errors...)
+	}
+	return result, nil
+}
+
+// ModifyObject writes the body at the position of the object. S3 objects can not be modified, so the object is
+// rebuilt from the head of the old one and the body, the head is copied on the server side once it is large enough
+// to be a part of the multipart upload.
+func (c *s3Client) ModifyObject(input *obs.ModifyObjectInput) (*obs.ModifyObjectOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	if input.Position == 0 {
+		resp, err := c.putObject(input.Bucket, input.Key, body)
+		if err != nil {
+			return nil, err
+		}
+		return &obs.ModifyObjectOutput{BaseModel: s3BaseModel(resp), ETag: resp.Header.Get("ETag")}, nil
+	}
+
+	meta, err := c.GetObjectMetadata(&obs.GetObjectMetadataInput{Bucket: input.Bucket, Key: input.Key})
+	if err != nil {
+		return nil, err
+	}
+	if input.Position > meta.ContentLength {
+		return nil, fmt.Errorf("modify position %d is beyond the object size %d", input.Position, meta.ContentLength)
+	}
+
+	if input.Position < s3MinPartSize {
+		head, err := c.readRange(input.Bucket, input.Key, 0, input.Position)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.putObject(input.Bucket, input.Key, append(head, body...))
+		if err != nil {
+			return nil, err
+		}
+		return &obs.ModifyObjectOutput{BaseModel: s3BaseModel(resp), ETag: resp.Header.Get("ETag")}, nil
+	}
+
+	upload, err := c.createMultipartUpload(input.Bucket, input.Key)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := c.copyParts(input.Bucket, input.Key, input.Key, input.Position, upload)
+	if err == nil {
+		var part s3CompletedPart
+		part, err = c.uploadPart(input.Bucket, input.Key, upload, len(parts)+1, body)
+		parts = append(parts, part)
+	}
+	if err == nil {
+		err = c.completeMultipartUpload(input.Bucket, input.Key, upload, parts)
+	}
+	if err != nil {
+		c.abortMultipartUpload(input.Bucket, input.Key, upload)
+		return nil, err
+	}
+	return &obs.ModifyObjectOutput{}, nil
+}
+
+func (c *s3Client) PutObject(input *obs.PutObjectInput) (*obs.PutObjectOutput, error) {
+	if input.Body == nil {
+		input.Body = bytes.NewReader(nil)
+	}
+	if int64(c.conf.PartSize) > 0 && input.ContentLength > int64(c.conf.PartSize) {
+		if _, err := c.uploadObject(input.Bucket, input.Key, input.Body); err != nil {
+			return nil, err
+		}
+		return &obs.PutObjectOutput{}, nil
+	}
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > int64(c.conf.PartSize) {
+		if _, err = c.uploadObject(input.Bucket, input.Key, bytes.NewReader(body)); err != nil {
+			return nil, err
+		}
+		return &obs.PutObjectOutput{}, nil
+	}
+	resp, err := c.putObject(input.Bucket, input.Key, body)
+	if err != nil {
+		return nil, err
+	}
+	return &obs.PutObjectOutput{BaseModel: s3BaseModel(resp), ETag: resp.Header.Get("ETag")}, nil
+}
+
+func (c *s3Client) putObject(bucket, key string, body []byte) (*http.Response, error) {
+	header := http.Header{}
+	header.Set("Content-Type", s3ContentType)
+	resp, err := c.do(http.MethodPut, bucket, key, nil, header, body)
+	if err != nil {
+		return nil, err
+	}
+	s3DiscardResponse(resp)
+	return resp, nil
+}
+
+func (c *s3Client) GetObjectMetadata(input *obs.GetObjectMetadataInput) (*obs.GetObjectMetadataOutput, error) {
+	resp, err := c.do(http.MethodHead, input.Bucket, input.Key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	s3DiscardResponse(resp)
+	output := s3Metadata(resp)
+	return &output, nil
+}
+
+// RenameFile copies the object to the new key and deletes the old one, S3 has no rename
+func (c *s3Client) RenameFile(input *obs.RenameFileInput) (*obs.RenameFileOutput, error) {
+	meta, err := c.GetObjectMetadata(&obs.GetObjectMetadataInput{Bucket: input.Bucket, Key: input.Key})
+	if err != nil {
+		return nil, err
+	}
+	if meta.ContentLength <= s3MaxCopySize {
+		header := http.Header{}
+		header.Set("X-Amz-Copy-Source", s3CopySource(input.Bucket, input.Key))
+		resp, err := c.do(http.MethodPut, input.Bucket, input.NewObjectKey, nil, header, nil)
+		if err != nil {
+			return nil, err
+		}
+		result := &s3CopyResult{}
+		// the copy may fail after the status 200 is sent, the body carries the error then
+		if err = s3DecodeResponse(resp, result); err != nil || result.ETag == "" {
+			return nil, fmt.Errorf("copy object %s to %s failed: %v", input.Key, input.NewObjectKey, err)
+		}
+	} else {
+		upload, err := c.createMultipartUpload(input.Bucket, input.NewObjectKey)
+		if err != nil {
+			return nil, err
+		}
+		parts, err := c.copyParts(input.Bucket, input.NewObjectKey, input.Key, meta.ContentLength, upload)
+		if err == nil {
+			err = c.completeMultipartUpload(input.Bucket, input.NewObjectKey, upload, parts)
+		}
+		if err != nil {
+			c.abortMultipartUpload(input.Bucket, input.NewObjectKey, upload)
+			return nil, err
+		}
+	}
+
+	output, err := c.DeleteObject(&obs.DeleteObjectInput{Bucket: input.Bucket, Key: input.Key})
+	if err != nil {
+		return nil, err
+	}
+	return &obs.RenameFileOutput{BaseModel: output.BaseModel}, nil
+}
+
+// IsObsFile heads the object, the output is nil if the object is not found
+func (c *s3Client) IsObsFile(input *obs.HeadObjectInput) (*obs.BaseModel, error) {
+	resp, err := c.do(http.MethodHead, input.Bucket, input.Key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	s3DiscardResponse(resp)
+	output := s3BaseModel(resp)
+	return &output, nil
+}
+
+func (c *s3Client) Do(r *http.Request) (*http.Response, error) {
+	return c.httpClient.Do(r)
+}
+
+func (c *s3Client) readRange(bucket, key string, off, size int64) ([]byte, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	output, err := c.GetObject(&obs.GetObjectInput{
+		GetObjectMetadataInput: obs.GetObjectMetadataInput{Bucket: bucket, Key: key},
+		RangeStart:             off,
+		RangeEnd:               off + size - 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+	buf := make([]byte, size)
+	_, err = io.ReadFull(output.Body, buf)
+	return buf, err
+}
+
+// truncateObject keeps the head of the object of the size, S3 has no truncate
+func (c *s3Client) truncateObject(bucket, key string, size int64) error {
+	meta, err := c.GetObjectMetadata(&obs.GetObjectMetadataInput{Bucket: bucket, Key: key})
+	if err != nil {
+		return err
+	}
+	if size >= meta.ContentLength {
+		return nil
+	}
+	if size >= s3MinPartSize {
+		upload, err := c.createMultipartUpload(bucket, key)
+		if err != nil {
+			return err
+		}
+		parts, err := c.copyParts(bucket, key, key, size, upload)
+		if err == nil {
+			err = c.completeMultipartUpload(bucket, key, upload, parts)
+		}
+		if err != nil {
+			c.abortMultipartUpload(bucket, key, upload)
+		}
+		return err
+	}
+	head, err := c.readRange(bucket, key, 0, size)
+	if err != nil {
+		return err
+	}
+	_, err = c.putObject(bucket, key, head)
+	return err
+}
+
+// uploadObject uploads the content of the reader by the multipart upload, one part is buffered at a time
+func (c *s3Client) uploadObject(bucket, key string, r io.Reader) (int64, error) {
+	partSize := int64(c.conf.PartSize)
+	buf := make([]byte, partSize)
+	n, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// small enough for a single request
+		_, err = c.putObject(bucket, key, buf[:n])
+		return int64(n), err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	upload, err := c.createMultipartUpload(bucket, key)
+	if err != nil {
+		return 0, err
+	}
+	var parts []s3CompletedPart
+	var written int64
+	for n > 0 {
+		var part s3CompletedPart
+		part, err = c.uploadPart(bucket, key, upload, len(parts)+1, buf[:n])
+		if err != nil {
+			break
+		}
+		parts = append(parts, part)
+		written += int64(n)
+
+		n, err = io.ReadFull(r, buf)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = nil
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = c.completeMultipartUpload(bucket, key, upload, parts)
+	}
+	if err != nil {
+		c.abortMultipartUpload(bucket, key, upload)
+		return 0, err
+	}
+	return written, nil
+}
+
+func (c *s3Client) createMultipartUpload(bucket, key string) (string, error) {
+	header := http.Header{}
+	header.Set("Content-Type", s3ContentType)
+	resp, err := c.do(http.MethodPost, bucket, key, url.Values{"uploads": {""}}, header, nil)
+	if err != nil {
+		return "", err
+	}
+	result := &s3InitiateMultipartUploadResult{}
+	if err = s3DecodeResponse(resp, result); err != nil {
+		return "", err
+	}
+	return result.UploadId, nil
+}
+
+func (c *s3Client) uploadPart(bucket, key, upload string, number int, body []byte) (s3CompletedPart, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {upload}}
+	resp, err := c.do(http.MethodPut, bucket, key, query, nil, body)
+	if err != nil {
+		return s3CompletedPart{}, err
+	}
+	s3DiscardResponse(resp)
+	return s3CompletedPart{PartNumber: number, ETag: resp.Header.Get("ETag")}, nil
+}
+
+// copyParts copies the head of the source object of the size as the parts of the upload on the server side
+func (c *s3Client) copyParts(bucket, key, srcKey string, size int64, upload string) ([]s3CompletedPart, error) {
+	partSize := int64(c.conf.PartSize)
+	var parts []s3CompletedPart
+	for off := int64(0); off < size; off += partSize {
+		end := off + partSize
+		// the last part may be smaller than the minimum part size, so it is merged into the previous one
+		if end > size || size-end < s3MinPartSize {
+			end = size
+		}
+		number := len(parts) + 1
+		header := http.Header{}
+		header.Set("X-Amz-Copy-Source", s3CopySource(bucket, srcKey))
+		header.Set("X-Amz-Copy-Source-Range", fmt.Sprintf("bytes=%d-%d", off, end-1))
+		query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {upload}}
+		resp, err := c.do(http.MethodPut, bucket, key, query, header, nil)
+		if err != nil {
+			return nil, err
+		}
+		result := &s3CopyResult{}
+		if err = s3DecodeResponse(resp, result); err != nil {
+			return nil, err
+		}
+		parts = append(parts, s3CompletedPart{PartNumber: number, ETag: result.ETag})
+		if end == size {
+			break
+		}
+	}
+	return parts, nil
+}
+
+func (c *s3Client) completeMultipartUpload(bucket, key, upload string, parts []s3CompletedPart) error {
+	payload, err := xml.Marshal(&s3CompleteMultipartUpload{Parts: parts})
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/xml")
+	resp, err := c.do(http.MethodPost, bucket, key, url.Values{"uploadId": {upload}}, header, payload)
+	if err != nil {
+		return err
+	}
+	// the completion may fail after the status 200 is sent, the body carries the error then
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(body, []byte("<Error>")) {
+		obsErr := obs.ObsError{}
+		_ = xml.Unmarshal(body, &obsErr)
+		obsErr.StatusCode = http.StatusInternalServerError
+		return obsErr
+	}
+	return nil
+}
+
+func (c *s3Client) abortMultipartUpload(bucket, key, upload string) {
+	resp, err := c.do(http.MethodDelete, bucket, key, url.Values{"uploadId": {upload}}, nil, nil)
+	if err == nil {
+		s3DiscardResponse(resp)
+	}
+}
+
+func s3CopySource(bucket, key string) string {
+	return "/" + bucket + "/" + s3EscapePath(strings.TrimPrefix(key, "/"))
+}
+
+func md5Base64(payload []byte) string {
+	sum := md5.Sum(payload)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
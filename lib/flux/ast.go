@@ -0,0 +1,174 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"regexp"
+	"time"
+)
+
+// Program is a parsed Flux script.
+type Program struct {
+	Body []Statement
+}
+
+type Statement interface {
+	stmt()
+}
+
+// VariableAssignment is a statement such as "data = from(bucket: "db")".
+type VariableAssignment struct {
+	Name string
+	Init Expr
+}
+
+// ExpressionStatement is an expression used as a statement, the tables it returns are yielded implicitly.
+type ExpressionStatement struct {
+	Expr Expr
+}
+
+// ReturnStatement is the last statement of a function block.
+type ReturnStatement struct {
+	Argument Expr
+}
+
+func (*VariableAssignment) stmt()  {}
+func (*ExpressionStatement) stmt() {}
+func (*ReturnStatement) stmt()     {}
+
+type Expr interface {
+	expr()
+}
+
+type Identifier struct {
+	Name string
+}
+
+type IntegerLiteral struct {
+	Value int64
+}
+
+type FloatLiteral struct {
+	Value float64
+}
+
+type StringLiteral struct {
+	Value string
+}
+
+type DurationLiteral struct {
+	Value time.Duration
+}
+
+type TimeLiteral struct {
+	Value time.Time
+}
+
+type RegexLiteral struct {
+	Value *regexp.Regexp
+}
+
+type ArrayExpr struct {
+	Elements []Expr
+}
+
+type Property struct {
+	Key   string
+	Value Expr
+}
+
+// RecordExpr is a record such as {a: 1}, or {r with a: 1} which extends the record r.
+type RecordExpr struct {
+	With       Expr
+	Properties []*Property
+}
+
+type Parameter struct {
+	Name    string
+	Default Expr
+}
+
+// FunctionExpr is a function literal, its body is an expression or a block ends with a return statement.
+type FunctionExpr struct {
+	Params []*Parameter
+	Body   Expr
+	Block  []Statement
+}
+
+// CallExpr is a function call, all arguments of Flux functions are named.
+type CallExpr struct {
+	Callee Expr
+	Args   []*Property
+}
+
+// PipeExpr pipes the tables returned by Argument to the first parameter of Call, which is the "tables" parameter.
+type PipeExpr struct {
+	Argument Expr
+	Call     *CallExpr
+}
+
+type MemberExpr struct {
+	Object   Expr
+	Property string
+}
+
+type IndexExpr struct {
+	Object Expr
+	Index  Expr
+}
+
+type BinaryExpr struct {
+	Op    tokenType
+	Left  Expr
+	Right Expr
+}
+
+// LogicalExpr is the "and" or "or" expression.
+type LogicalExpr struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// UnaryExpr is the "-", "not" or "exists" expression.
+type UnaryExpr struct {
+	Op       string
+	Argument Expr
+}
+
+type ConditionalExpr struct {
+	Test       Expr
+	Consequent Expr
+	Alternate  Expr
+}
+
+func (*Identifier) expr()      {}
+func (*IntegerLiteral) expr()  {}
+func (*FloatLiteral) expr()    {}
+func (*StringLiteral) expr()   {}
+func (*DurationLiteral) expr() {}
+func (*TimeLiteral) expr()     {}
+func (*RegexLiteral) expr()    {}
+func (*ArrayExpr) expr()       {}
+func (*RecordExpr) expr()      {}
+func (*FunctionExpr) expr()    {}
+func (*CallExpr) expr()        {}
+func (*PipeExpr) expr()        {}
+func (*MemberExpr) expr()      {}
+func (*IndexExpr) expr()       {}
+func (*BinaryExpr) expr()      {}
+func (*LogicalExpr) expr()     {}
+func (*UnaryExpr) expr()       {}
+func (*ConditionalExpr) expr() {}
@@ -0,0 +1,394 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"fmt"
+	"strings"
+)
+
+// universe returns the scope holding the builtin values and functions.
+func universe() *scope {
+	s := newScope(nil)
+	s.set("true", true)
+	s.set("false", false)
+
+	functions := map[string]func(in *interpreter, args *arguments) (interface{}, error){
+		"from":            builtinFrom,
+		"range":           builtinRange,
+		"filter":          builtinFilter,
+		"aggregateWindow": builtinAggregateWindow,
+		"group":           builtinGroup,
+		"map":             builtinMap,
+		"yield":           builtinYield,
+		"pivot":           builtinPivot,
+		"keep":            builtinKeep,
+		"drop":            builtinDrop,
+		"sort":            builtinSort,
+		"limit":           builtinLimit,
+		"now":             builtinNow,
+		"float":           conversion(convertFloat),
+		"int":             conversion(convertInt),
+		"string":          conversion(convertString),
+		"bool":            conversion(convertBool),
+	}
+	for name := range pushdownAggregates {
+		functions[name] = builtinAggregate
+	}
+	for name, fn := range functions {
+		s.set(name, &builtin{name: name, call: fn})
+	}
+	return s
+}
+
+func builtinFrom(_ *interpreter, args *arguments) (interface{}, error) {
+	bucket, err := args.string("bucket", "", true)
+	if err != nil {
+		return nil, err
+	}
+	db, rp := bucket, ""
+	if i := strings.IndexByte(bucket, '/'); i >= 0 {
+		db, rp = bucket[:i], bucket[i+1:]
+	}
+	if db == "" {
+		return nil, fmt.Errorf("bucket must not be empty")
+	}
+	return &stream{read: &readSpec{db: db, rp: rp}}, nil
+}
+
+func builtinRange(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	start, _, err := args.time("start", in.now, true)
+	if err != nil {
+		return nil, err
+	}
+	stop, ok, err := args.time("stop", in.now, false)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		stop = in.now
+	}
+	start, stop = start.UTC(), stop.UTC()
+
+	if st.read != nil && st.read.window == nil {
+		read := st.read.clone()
+		if !read.hasRange {
+			read.hasRange, read.start, read.stop = true, start, stop
+		} else {
+			if start.After(read.start) {
+				read.start = start
+			}
+			if stop.Before(read.stop) {
+				read.stop = stop
+			}
+		}
+		return &stream{read: read}, nil
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	return &stream{tables: rangeTables(tables, start, stop)}, nil
+}
+
+func builtinFilter(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	fn, err := args.function("fn", true)
+	if err != nil {
+		return nil, err
+	}
+	if st.read != nil && st.read.window == nil {
+		read := st.read.clone()
+		if err = read.pushFilter(in, fn); err != nil {
+			return nil, err
+		}
+		return &stream{read: read}, nil
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	if tables, err = in.filterTables(tables, fn); err != nil {
+		return nil, err
+	}
+	return &stream{tables: tables}, nil
+}
+
+func builtinAggregateWindow(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	w := &windowSpec{}
+	if w.every, err = args.duration("every", 0, true); err != nil {
+		return nil, err
+	}
+	if w.every <= 0 {
+		return nil, fmt.Errorf("every must be positive")
+	}
+	if w.offset, err = args.duration("offset", 0, false); err != nil {
+		return nil, err
+	}
+	if w.createEmpty, err = args.bool("createEmpty", true); err != nil {
+		return nil, err
+	}
+	if w.timeSrc, err = args.string("timeSrc", stopColumn, false); err != nil {
+		return nil, err
+	}
+	timeDst, err := args.string("timeDst", timeColumn, false)
+	if err != nil {
+		return nil, err
+	}
+	column, err := args.string("column", valueColumn, false)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := args.values["fn"]
+	if !ok {
+		return nil, args.missing("fn")
+	}
+	fn, ok := v.(*builtin)
+	if !ok || !pushdownAggregates[fn.name] {
+		return nil, fmt.Errorf("unsupported aggregate function for aggregateWindow")
+	}
+	w.fn = fn.name
+
+	if st.read != nil && st.read.window == nil && len(st.read.residual) == 0 && column == valueColumn && timeDst == timeColumn {
+		read := st.read.clone()
+		read.window = w
+		return &stream{read: read}, nil
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	if tables, err = aggregateWindowTables(tables, w, column, timeDst); err != nil {
+		return nil, err
+	}
+	return &stream{tables: tables}, nil
+}
+
+func builtinAggregate(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	column, err := args.string("column", valueColumn, false)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	if tables, err = aggregateTables(tables, args.fn, column); err != nil {
+		return nil, err
+	}
+	return &stream{tables: tables}, nil
+}
+
+func builtinGroup(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := args.strings("columns", nil)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := args.string("mode", "by", false)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	if tables, err = groupTables(tables, columns, mode); err != nil {
+		return nil, err
+	}
+	return &stream{tables: tables}, nil
+}
+
+func builtinMap(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	fn, err := args.function("fn", true)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	if tables, err = in.mapTables(tables, fn); err != nil {
+		return nil, err
+	}
+	return &stream{tables: tables}, nil
+}
+
+func builtinYield(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	name, err := args.string("name", DefaultResultName, false)
+	if err != nil {
+		return nil, err
+	}
+	return in.yield(name, st)
+}
+
+func builtinPivot(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	rowKey, err := args.strings("rowKey", nil)
+	if err != nil {
+		return nil, err
+	}
+	columnKey, err := args.strings("columnKey", nil)
+	if err != nil {
+		return nil, err
+	}
+	valueCol, err := args.string("valueColumn", "", true)
+	if err != nil {
+		return nil, err
+	}
+	if len(rowKey) == 0 || len(columnKey) == 0 {
+		return nil, fmt.Errorf("rowKey and columnKey must not be empty")
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	return &stream{tables: pivotTables(tables, rowKey, columnKey, valueCol)}, nil
+}
+
+func builtinKeep(in *interpreter, args *arguments) (interface{}, error) {
+	return keepOrDrop(in, args, true)
+}
+
+func builtinDrop(in *interpreter, args *arguments) (interface{}, error) {
+	return keepOrDrop(in, args, false)
+}
+
+func keepOrDrop(in *interpreter, args *arguments, keep bool) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := args.strings("columns", nil)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := args.function("fn", false)
+	if err != nil {
+		return nil, err
+	}
+	if fn == nil && !args.has("columns") {
+		return nil, fmt.Errorf("either columns or fn must be specified")
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	if tables, err = in.selectColumns(tables, columns, fn, keep); err != nil {
+		return nil, err
+	}
+	return &stream{tables: tables}, nil
+}
+
+func builtinSort(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := args.strings("columns", []string{valueColumn})
+	if err != nil {
+		return nil, err
+	}
+	desc, err := args.bool("desc", false)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	return &stream{tables: sortTables(tables, columns, desc)}, nil
+}
+
+func builtinLimit(in *interpreter, args *arguments) (interface{}, error) {
+	st, err := args.stream()
+	if err != nil {
+		return nil, err
+	}
+	n, err := args.int("n", 0, true)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := args.int("offset", 0, false)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || offset < 0 {
+		return nil, fmt.Errorf("n and offset must not be negative")
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	return &stream{tables: limitTables(tables, n, offset)}, nil
+}
+
+func builtinNow(in *interpreter, _ *arguments) (interface{}, error) {
+	return in.now, nil
+}
+
+func conversion(convert func(v interface{}) (interface{}, error)) func(in *interpreter, args *arguments) (interface{}, error) {
+	return func(_ *interpreter, args *arguments) (interface{}, error) {
+		v, ok := args.values["v"]
+		if !ok {
+			return nil, args.missing("v")
+		}
+		return convert(v)
+	}
+}
+
+// yield registers the tables of the stream as the result of the name.
+func (in *interpreter) yield(name string, st *stream) (*stream, error) {
+	for _, res := range in.results {
+		if res.Name == name {
+			return nil, fmt.Errorf("duplicate yield name %q", name)
+		}
+	}
+	tables, err := in.materialize(st)
+	if err != nil {
+		return nil, err
+	}
+	in.results = append(in.results, &Result{Name: name, Tables: tables})
+	return &stream{tables: tables, yielded: true}, nil
+}
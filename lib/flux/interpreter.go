@@ -0,0 +1,426 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type interpreter struct {
+	ctx     context.Context
+	querier Querier
+	now     time.Time
+	results []*Result
+}
+
+func (in *interpreter) eval(s *scope, expr Expr) (interface{}, error) {
+	switch e := expr.(type) {
+	case *Identifier:
+		v, ok := s.lookup(e.Name)
+		if !ok {
+			return nil, fmt.Errorf("undefined identifier %s", e.Name)
+		}
+		return v, nil
+	case *IntegerLiteral:
+		return e.Value, nil
+	case *FloatLiteral:
+		return e.Value, nil
+	case *StringLiteral:
+		return e.Value, nil
+	case *DurationLiteral:
+		return e.Value, nil
+	case *TimeLiteral:
+		return e.Value, nil
+	case *RegexLiteral:
+		return e.Value, nil
+	case *ArrayExpr:
+		arr := make([]interface{}, 0, len(e.Elements))
+		for _, elem := range e.Elements {
+			v, err := in.eval(s, elem)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case *RecordExpr:
+		return in.evalRecord(s, e)
+	case *FunctionExpr:
+		return &closure{fn: e, scope: s}, nil
+	case *CallExpr:
+		return in.evalCall(s, e, nil)
+	case *PipeExpr:
+		tables, err := in.eval(s, e.Argument)
+		if err != nil {
+			return nil, err
+		}
+		return in.evalCall(s, e.Call, tables)
+	case *MemberExpr:
+		obj, err := in.eval(s, e.Object)
+		if err != nil {
+			return nil, err
+		}
+		rec, ok := obj.(*Record)
+		if !ok {
+			return nil, fmt.Errorf("cannot access property %s of %s", e.Property, typeName(obj))
+		}
+		v, _ := rec.Get(e.Property)
+		return v, nil
+	case *IndexExpr:
+		return in.evalIndex(s, e)
+	case *BinaryExpr:
+		l, err := in.eval(s, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := in.eval(s, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return binaryOp(e.Op, l, r)
+	case *LogicalExpr:
+		l, err := in.eval(s, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		if e.Op == "and" && l != true {
+			return false, nil
+		}
+		if e.Op == "or" && l == true {
+			return true, nil
+		}
+		r, err := in.eval(s, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return r == true, nil
+	case *UnaryExpr:
+		v, err := in.eval(s, e.Argument)
+		if err != nil {
+			return nil, err
+		}
+		return unaryOp(e.Op, v)
+	case *ConditionalExpr:
+		test, err := in.eval(s, e.Test)
+		if err != nil {
+			return nil, err
+		}
+		if test == true {
+			return in.eval(s, e.Consequent)
+		}
+		return in.eval(s, e.Alternate)
+	}
+	return nil, fmt.Errorf("unsupported expression %T", expr)
+}
+
+func unaryOp(op string, v interface{}) (interface{}, error) {
+	switch op {
+	case "exists":
+		return v != nil, nil
+	case "not":
+		if v == nil {
+			return nil, nil
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("unsupported operand for not: %s", typeName(v))
+		}
+		return !b, nil
+	}
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		return -v, nil
+	case float64:
+		return -v, nil
+	case time.Duration:
+		return -v, nil
+	}
+	return nil, fmt.Errorf("unsupported operand for -: %s", typeName(v))
+}
+
+func (in *interpreter) evalRecord(s *scope, e *RecordExpr) (interface{}, error) {
+	rec := NewRecord()
+	if e.With != nil {
+		v, err := in.eval(s, e.With)
+		if err != nil {
+			return nil, err
+		}
+		base, ok := v.(*Record)
+		if !ok {
+			return nil, fmt.Errorf("cannot extend %s with properties", typeName(v))
+		}
+		rec = base.clone()
+	}
+	for _, prop := range e.Properties {
+		v, err := in.eval(s, prop.Value)
+		if err != nil {
+			return nil, err
+		}
+		rec.Set(prop.Key, v)
+	}
+	return rec, nil
+}
+
+func (in *interpreter) evalIndex(s *scope, e *IndexExpr) (interface{}, error) {
+	obj, err := in.eval(s, e.Object)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := in.eval(s, e.Index)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := obj.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot index into %s", typeName(obj))
+	}
+	i, ok := idx.(int64)
+	if !ok {
+		return nil, fmt.Errorf("array index must be an int, got %s", typeName(idx))
+	}
+	if i < 0 || int(i) >= len(arr) {
+		return nil, fmt.Errorf("array index %d out of bounds", i)
+	}
+	return arr[i], nil
+}
+
+func (in *interpreter) evalCall(s *scope, e *CallExpr, pipe interface{}) (interface{}, error) {
+	callee, err := in.eval(s, e.Callee)
+	if err != nil {
+		return nil, err
+	}
+	args := &arguments{values: make(map[string]interface{}, len(e.Args)+1)}
+	for _, arg := range e.Args {
+		v, err := in.eval(s, arg.Value)
+		if err != nil {
+			return nil, err
+		}
+		args.values[arg.Key] = v
+	}
+	if pipe != nil {
+		args.values["tables"] = pipe
+	}
+
+	switch fn := callee.(type) {
+	case *builtin:
+		args.fn = fn.name
+		v, err := fn.call(in, args)
+		if err != nil {
+			return nil, fmt.Errorf("error calling function %q: %w", fn.name, err)
+		}
+		return v, nil
+	case *closure:
+		return in.call(fn, args.values)
+	}
+	return nil, fmt.Errorf("cannot call %s", typeName(callee))
+}
+
+// call calls the function literal with the named arguments.
+func (in *interpreter) call(fn *closure, args map[string]interface{}) (interface{}, error) {
+	s := newScope(fn.scope)
+	for _, param := range fn.fn.Params {
+		v, ok := args[param.Name]
+		if !ok {
+			if param.Default == nil {
+				return nil, fmt.Errorf("missing required argument %s", param.Name)
+			}
+			var err error
+			if v, err = in.eval(fn.scope, param.Default); err != nil {
+				return nil, err
+			}
+		}
+		s.set(param.Name, v)
+	}
+	if fn.fn.Body != nil {
+		return in.eval(s, fn.fn.Body)
+	}
+	for _, stmt := range fn.fn.Block {
+		switch st := stmt.(type) {
+		case *VariableAssignment:
+			v, err := in.eval(s, st.Init)
+			if err != nil {
+				return nil, err
+			}
+			s.set(st.Name, v)
+		case *ReturnStatement:
+			return in.eval(s, st.Argument)
+		case *ExpressionStatement:
+			if _, err := in.eval(s, st.Expr); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return nil, fmt.Errorf("function block must end with a return statement")
+}
+
+// callPredicate calls the function with the record r and returns whether the result is true.
+func (in *interpreter) callPredicate(fn *closure, r *Record) (bool, error) {
+	v, err := in.call(fn, map[string]interface{}{"r": r})
+	if err != nil {
+		return false, err
+	}
+	switch v.(type) {
+	case nil, bool:
+		return v == true, nil
+	}
+	return false, fmt.Errorf("predicate must return a bool, got %s", typeName(v))
+}
+
+// arguments are the named arguments of a builtin function call.
+type arguments struct {
+	fn     string
+	values map[string]interface{}
+}
+
+func (a *arguments) has(name string) bool {
+	v, ok := a.values[name]
+	return ok && v != nil
+}
+
+func (a *arguments) typeError(name, expected string, v interface{}) error {
+	return fmt.Errorf("argument %s must be %s, got %s", name, expected, typeName(v))
+}
+
+func (a *arguments) missing(name string) error {
+	return fmt.Errorf("missing required argument %s", name)
+}
+
+func (a *arguments) stream() (*stream, error) {
+	v, ok := a.values["tables"]
+	if !ok {
+		return nil, a.missing("tables")
+	}
+	st, ok := v.(*stream)
+	if !ok {
+		return nil, a.typeError("tables", "a stream of tables", v)
+	}
+	return st, nil
+}
+
+func (a *arguments) string(name, def string, required bool) (string, error) {
+	v, ok := a.values[name]
+	if !ok {
+		if required {
+			return "", a.missing(name)
+		}
+		return def, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", a.typeError(name, "a string", v)
+	}
+	return s, nil
+}
+
+func (a *arguments) strings(name string, def []string) ([]string, error) {
+	v, ok := a.values[name]
+	if !ok {
+		return def, nil
+	}
+	arr, ok := v.([]interface{})
+	if !ok {
+		return nil, a.typeError(name, "an array of strings", v)
+	}
+	s := make([]string, 0, len(arr))
+	for _, elem := range arr {
+		str, ok := elem.(string)
+		if !ok {
+			return nil, a.typeError(name, "an array of strings", v)
+		}
+		s = append(s, str)
+	}
+	return s, nil
+}
+
+func (a *arguments) int(name string, def int64, required bool) (int64, error) {
+	v, ok := a.values[name]
+	if !ok {
+		if required {
+			return 0, a.missing(name)
+		}
+		return def, nil
+	}
+	i, ok := v.(int64)
+	if !ok {
+		return 0, a.typeError(name, "an int", v)
+	}
+	return i, nil
+}
+
+func (a *arguments) bool(name string, def bool) (bool, error) {
+	v, ok := a.values[name]
+	if !ok {
+		return def, nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, a.typeError(name, "a bool", v)
+	}
+	return b, nil
+}
+
+func (a *arguments) duration(name string, def time.Duration, required bool) (time.Duration, error) {
+	v, ok := a.values[name]
+	if !ok {
+		if required {
+			return 0, a.missing(name)
+		}
+		return def, nil
+	}
+	d, ok := v.(time.Duration)
+	if !ok {
+		return 0, a.typeError(name, "a duration", v)
+	}
+	return d, nil
+}
+
+func (a *arguments) function(name string, required bool) (*closure, error) {
+	v, ok := a.values[name]
+	if !ok {
+		if required {
+			return nil, a.missing(name)
+		}
+		return nil, nil
+	}
+	fn, ok := v.(*closure)
+	if !ok {
+		return nil, a.typeError(name, "a function", v)
+	}
+	return fn, nil
+}
+
+// time returns the absolute time of the argument, durations are relative to now and integers are unix seconds.
+func (a *arguments) time(name string, now time.Time, required bool) (time.Time, bool, error) {
+	v, ok := a.values[name]
+	if !ok {
+		if required {
+			return time.Time{}, false, a.missing(name)
+		}
+		return time.Time{}, false, nil
+	}
+	switch t := v.(type) {
+	case time.Time:
+		return t, true, nil
+	case time.Duration:
+		return now.Add(t), true, nil
+	case int64:
+		return time.Unix(t, 0), true, nil
+	}
+	return time.Time{}, false, a.typeError(name, "a time or duration", v)
+}
@@ -0,0 +1,596 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parse parses the Flux script into a program.
+func Parse(src string) (*Program, error) {
+	tokens, err := scan(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	return p.parseProgram()
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekAt(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	tok := p.peek()
+	return tok.typ == tokIdent && tok.lit == kw
+}
+
+func (p *parser) expect(typ tokenType) (token, error) {
+	tok := p.next()
+	if tok.typ != typ {
+		return tok, p.errorf(tok, "expected %s", typ)
+	}
+	return tok, nil
+}
+
+func (p *parser) expectKeyword(kw string) error {
+	tok := p.next()
+	if tok.typ != tokIdent || tok.lit != kw {
+		return p.errorf(tok, "expected %s", kw)
+	}
+	return nil
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	found := tok.lit
+	if tok.typ == tokEOF {
+		found = "EOF"
+	}
+	return fmt.Errorf("error at position %d: %s, found %s", tok.pos, fmt.Sprintf(format, args...), found)
+}
+
+func (p *parser) parseProgram() (*Program, error) {
+	prog := &Program{}
+	for p.peek().typ != tokEOF {
+		// package and import clauses are accepted but ignored, the supported functions are all built in
+		if p.isKeyword("package") || p.isKeyword("import") {
+			p.next()
+			if p.peek().typ == tokIdent && p.peekAt(1).typ == tokString {
+				p.next()
+			}
+			p.next()
+			continue
+		}
+		stmt, err := p.parseStatement()
+		if err != nil {
+			return nil, err
+		}
+		prog.Body = append(prog.Body, stmt)
+	}
+	return prog, nil
+}
+
+func (p *parser) parseStatement() (Statement, error) {
+	if p.isKeyword("option") {
+		p.next()
+	}
+	if p.isKeyword("return") {
+		p.next()
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return &ReturnStatement{Argument: arg}, nil
+	}
+	if p.peek().typ == tokIdent && p.peekAt(1).typ == tokAssign {
+		name := p.next().lit
+		p.next()
+		init, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return &VariableAssignment{Name: name, Init: init}, nil
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &ExpressionStatement{Expr: expr}, nil
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	if !p.isKeyword("if") {
+		return p.parseOr()
+	}
+	p.next()
+	test, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectKeyword("then"); err != nil {
+		return nil, err
+	}
+	cons, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectKeyword("else"); err != nil {
+		return nil, err
+	}
+	alt, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalExpr{Test: test, Consequent: cons, Alternate: alt}, nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &LogicalExpr{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &LogicalExpr{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.isKeyword("not") || p.isKeyword("exists") {
+		op := p.next().lit
+		arg, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryExpr{Op: op, Argument: arg}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch op := p.peek().typ; op {
+		case tokEQ, tokNEQ, tokLT, tokLTE, tokGT, tokGTE, tokRegexEQ, tokRegexNEQ:
+			p.next()
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			left = &BinaryExpr{Op: op, Left: left, Right: right}
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *parser) parseAdditive() (Expr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch op := p.peek().typ; op {
+		case tokAdd, tokSub:
+			p.next()
+			right, err := p.parseMultiplicative()
+			if err != nil {
+				return nil, err
+			}
+			left = &BinaryExpr{Op: op, Left: left, Right: right}
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *parser) parseMultiplicative() (Expr, error) {
+	left, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch op := p.peek().typ; op {
+		case tokMul, tokDiv, tokMod:
+			p.next()
+			right, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			left = &BinaryExpr{Op: op, Left: left, Right: right}
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *parser) parsePipe() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == tokPipe {
+		p.next()
+		tok := p.peek()
+		right, err := p.parsePostfix()
+		if err != nil {
+			return nil, err
+		}
+		call, ok := right.(*CallExpr)
+		if !ok {
+			return nil, p.errorf(tok, "pipe destination must be a function call")
+		}
+		left = &PipeExpr{Argument: left, Call: call}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.peek().typ == tokSub {
+		p.next()
+		arg, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryExpr{Op: "-", Argument: arg}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (Expr, error) {
+	expr, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().typ {
+		case tokLParen:
+			args, err := p.parseCallArgs()
+			if err != nil {
+				return nil, err
+			}
+			expr = &CallExpr{Callee: expr, Args: args}
+		case tokDot:
+			p.next()
+			tok, err := p.expect(tokIdent)
+			if err != nil {
+				return nil, err
+			}
+			expr = &MemberExpr{Object: expr, Property: tok.lit}
+		case tokLBrack:
+			p.next()
+			index, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if _, err = p.expect(tokRBrack); err != nil {
+				return nil, err
+			}
+			if s, ok := index.(*StringLiteral); ok {
+				expr = &MemberExpr{Object: expr, Property: s.Value}
+			} else {
+				expr = &IndexExpr{Object: expr, Index: index}
+			}
+		default:
+			return expr, nil
+		}
+	}
+}
+
+func (p *parser) parseCallArgs() ([]*Property, error) {
+	if _, err := p.expect(tokLParen); err != nil {
+		return nil, err
+	}
+	var args []*Property
+	for p.peek().typ != tokRParen {
+		prop, err := p.parseProperty()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, prop)
+		if p.peek().typ != tokComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokRParen); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+func (p *parser) parseProperty() (*Property, error) {
+	tok := p.next()
+	if tok.typ != tokIdent && tok.typ != tokString {
+		return nil, p.errorf(tok, "expected property key")
+	}
+	if p.peek().typ != tokColon {
+		// shorthand property such as {a, b}
+		if tok.typ != tokIdent {
+			return nil, p.errorf(p.peek(), "expected :")
+		}
+		return &Property{Key: tok.lit, Value: &Identifier{Name: tok.lit}}, nil
+	}
+	p.next()
+	value, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &Property{Key: tok.lit, Value: value}, nil
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.peek()
+	switch tok.typ {
+	case tokIdent:
+		p.next()
+		return &Identifier{Name: tok.lit}, nil
+	case tokInt:
+		p.next()
+		v, err := strconv.ParseInt(tok.lit, 10, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid integer")
+		}
+		return &IntegerLiteral{Value: v}, nil
+	case tokFloat:
+		p.next()
+		v, err := strconv.ParseFloat(tok.lit, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid float")
+		}
+		return &FloatLiteral{Value: v}, nil
+	case tokString:
+		p.next()
+		return &StringLiteral{Value: tok.lit}, nil
+	case tokDuration:
+		p.next()
+		d, err := parseDuration(tok.lit)
+		if err != nil {
+			return nil, p.errorf(tok, "%v", err)
+		}
+		return &DurationLiteral{Value: d}, nil
+	case tokTime:
+		p.next()
+		t, err := parseTime(tok.lit)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid time")
+		}
+		return &TimeLiteral{Value: t}, nil
+	case tokRegex:
+		p.next()
+		re, err := regexp.Compile(tok.lit)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid regex: %v", err)
+		}
+		return &RegexLiteral{Value: re}, nil
+	case tokLBrack:
+		return p.parseArray()
+	case tokLBrace:
+		return p.parseRecord()
+	case tokLParen:
+		if fn, ok, err := p.tryParseFunction(); ok || err != nil {
+			return fn, err
+		}
+		p.next()
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = p.expect(tokRParen); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return nil, p.errorf(tok, "unexpected token")
+}
+
+func (p *parser) parseArray() (Expr, error) {
+	p.next()
+	arr := &ArrayExpr{}
+	for p.peek().typ != tokRBrack {
+		elem, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		arr.Elements = append(arr.Elements, elem)
+		if p.peek().typ != tokComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokRBrack); err != nil {
+		return nil, err
+	}
+	return arr, nil
+}
+
+func (p *parser) parseRecord() (Expr, error) {
+	p.next()
+	rec := &RecordExpr{}
+	if p.peek().typ == tokIdent && p.peekAt(1).typ == tokIdent && p.peekAt(1).lit == "with" {
+		rec.With = &Identifier{Name: p.next().lit}
+		p.next()
+	}
+	for p.peek().typ != tokRBrace {
+		prop, err := p.parseProperty()
+		if err != nil {
+			return nil, err
+		}
+		rec.Properties = append(rec.Properties, prop)
+		if p.peek().typ != tokComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokRBrace); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// tryParseFunction parses a function literal such as (r) => r._value > 0, the position is restored
+// if the parenthesis does not start a function.
+func (p *parser) tryParseFunction() (Expr, bool, error) {
+	start := p.pos
+	p.next()
+	var params []*Parameter
+	for p.peek().typ != tokRParen {
+		tok := p.next()
+		if tok.typ != tokIdent {
+			p.pos = start
+			return nil, false, nil
+		}
+		param := &Parameter{Name: tok.lit}
+		if p.peek().typ == tokAssign {
+			p.next()
+			def, err := p.parseExpr()
+			if err != nil {
+				p.pos = start
+				return nil, false, nil
+			}
+			param.Default = def
+		}
+		params = append(params, param)
+		if p.peek().typ != tokComma {
+			break
+		}
+		p.next()
+	}
+	if p.peek().typ != tokRParen || p.peekAt(1).typ != tokArrow {
+		p.pos = start
+		return nil, false, nil
+	}
+	p.next()
+	p.next()
+
+	fn := &FunctionExpr{Params: params}
+	if p.peek().typ != tokLBrace {
+		body, err := p.parseExpr()
+		if err != nil {
+			return nil, true, err
+		}
+		fn.Body = body
+		return fn, true, nil
+	}
+
+	p.next()
+	for p.peek().typ != tokRBrace {
+		if p.peek().typ == tokEOF {
+			return nil, true, p.errorf(p.peek(), "expected }")
+		}
+		stmt, err := p.parseStatement()
+		if err != nil {
+			return nil, true, err
+		}
+		fn.Block = append(fn.Block, stmt)
+	}
+	p.next()
+	return fn, true, nil
+}
+
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+	// calendar units are approximated by fixed durations
+	"mo": 30 * 24 * time.Hour,
+	"y":  365 * 24 * time.Hour,
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	var d time.Duration
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && isDigit(s[j]) {
+			j++
+		}
+		n, err := strconv.ParseInt(s[i:j], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		k := j
+		for k < len(s) && !isDigit(s[k]) {
+			k++
+		}
+		unit, ok := durationUnits[s[j:k]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration unit %q", s[j:k])
+		}
+		d += time.Duration(n) * unit
+		i = k
+	}
+	return d, nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	if !strings.Contains(s, "T") {
+		return time.Parse("2006-01-02", s)
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	prog, err := Parse(`import "strings"
+// comment
+option now = () => 2024-01-01T00:00:00Z
+data = from(bucket: "db/rp") |> range(start: -1h30m, stop: 2024-01-01)
+data |> filter(fn: (r) => r._value / 2 > 1.5 and not exists r.x or r.host !~ /a\/b/)
+  |> map(fn: (r) => { v = if r._value > 0 then "pos" else "neg"
+    return {r with kind: v, arr: [1, 2][0], s: r["host"]}
+  })`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(prog.Body))
+
+	assign := prog.Body[1].(*VariableAssignment)
+	require.Equal(t, "data", assign.Name)
+	pipe := assign.Init.(*PipeExpr)
+	require.Equal(t, "range", pipe.Call.Callee.(*Identifier).Name)
+	startArg := pipe.Call.Args[0].Value.(*UnaryExpr)
+	require.Equal(t, "-", startArg.Op)
+	require.Equal(t, 90*time.Minute, startArg.Argument.(*DurationLiteral).Value)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), pipe.Call.Args[1].Value.(*TimeLiteral).Value)
+
+	stmt := prog.Body[2].(*ExpressionStatement).Expr.(*PipeExpr)
+	filter := stmt.Argument.(*PipeExpr).Call.Args[0].Value.(*FunctionExpr)
+	or := filter.Body.(*LogicalExpr)
+	require.Equal(t, "or", or.Op)
+	require.Equal(t, "and", or.Left.(*LogicalExpr).Op)
+	require.Equal(t, "a/b", or.Right.(*BinaryExpr).Right.(*RegexLiteral).Value.String())
+
+	fn := stmt.Call.Args[0].Value.(*FunctionExpr)
+	require.Equal(t, 2, len(fn.Block))
+	_, ok := fn.Block[0].(*VariableAssignment).Init.(*ConditionalExpr)
+	require.True(t, ok)
+	rec := fn.Block[1].(*ReturnStatement).Argument.(*RecordExpr)
+	require.NotNil(t, rec.With)
+	require.Equal(t, "host", rec.Properties[2].Value.(*MemberExpr).Property)
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, src := range []string{
+		`from(bucket: "db"`,
+		`from(bucket: "db") |> 1`,
+		`x = "abc`,
+		`1h2`,
+		`a = 1 $`,
+		`f = (r) => {`,
+	} {
+		_, err := Parse(src)
+		require.Error(t, err, src)
+	}
+}
+
+func TestEvalExpressions(t *testing.T) {
+	in := &interpreter{now: time.Unix(100, 0)}
+	s := newScope(universe())
+	for src, expected := range map[string]interface{}{
+		`1 + 2 * 3`:                         int64(7),
+		`7 % 4 - 1.5`:                       1.5,
+		`"a" + "b" == "ab"`:                 true,
+		`2024-01-01T00:00:00Z + 1h`:         time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+		`"abc" =~ /b/ and not ("x" !~ /x/)`: true,
+		`if 1 > 2 then "a" else "b"`:        "b",
+		`int(v: "12") + int(v: 1.9)`:        int64(13),
+		`string(v: 1.5)`:                    "1.5",
+		`bool(v: "true")`:                   true,
+		`((x, y=2) => x * y)(x: 3)`:         int64(6),
+		`{a: 1, b: {c: "d"}}.b.c`:           "d",
+		`exists {a: 1}.b`:                   false,
+		`now()`:                             time.Unix(100, 0),
+	} {
+		prog, err := Parse(src)
+		require.NoError(t, err, src)
+		v, err := in.eval(s, prog.Body[0].(*ExpressionStatement).Expr)
+		require.NoError(t, err, src)
+		require.Equal(t, expected, v, src)
+	}
+}
@@ -0,0 +1,511 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// stream is the value of a table expression. It is either a read which has not been executed yet,
+// and can absorb range, filter and aggregateWindow, or tables materialized in memory.
+type stream struct {
+	read    *readSpec
+	tables  []*Table
+	yielded bool
+}
+
+// readSpec describes the InfluxQL query a from() call and the operations pushed down into it are lowered to.
+type readSpec struct {
+	db string
+	rp string
+
+	hasRange bool
+	start    time.Time
+	stop     time.Time
+
+	measurements     []string
+	measurementRegex *regexp.Regexp
+	fields           []string
+	conds            []string
+
+	// residual filters are evaluated on the rows returned by the query
+	residual []*closure
+	window   *windowSpec
+}
+
+type windowSpec struct {
+	every       time.Duration
+	offset      time.Duration
+	fn          string
+	createEmpty bool
+	timeSrc     string
+}
+
+// pushdownAggregates are the aggregateWindow functions supported by InfluxQL.
+var pushdownAggregates = map[string]bool{
+	"mean": true, "sum": true, "count": true, "min": true, "max": true, "first": true, "last": true,
+	"median": true, "spread": true, "stddev": true,
+}
+
+func (s *readSpec) clone() *readSpec {
+	c := *s
+	c.measurements = append([]string(nil), s.measurements...)
+	c.fields = append([]string(nil), s.fields...)
+	c.conds = append([]string(nil), s.conds...)
+	c.residual = append([]*closure(nil), s.residual...)
+	return &c
+}
+
+func (s *readSpec) hasMeasurement() bool {
+	return len(s.measurements) > 0 || s.measurementRegex != nil
+}
+
+// pushFilter lowers the conjuncts of the predicate which InfluxQL is able to evaluate into the query,
+// the others are kept as a residual filter.
+func (s *readSpec) pushFilter(in *interpreter, fn *closure) error {
+	if len(fn.fn.Params) != 1 || fn.fn.Body == nil {
+		s.residual = append(s.residual, fn)
+		return nil
+	}
+	param := fn.fn.Params[0].Name
+
+	var rest []Expr
+	conjuncts := splitConjuncts(fn.fn.Body)
+
+	// measurements and fields are lowered first, so that _value conditions know the field they apply to
+	var others []Expr
+	for _, expr := range conjuncts {
+		if !s.hasMeasurement() {
+			if names, re, ok := in.measurementCondition(fn.scope, param, expr); ok {
+				s.measurements, s.measurementRegex = names, re
+				continue
+			}
+		}
+		if len(s.fields) == 0 {
+			if names, ok := in.orEqualsCondition(fn.scope, param, fieldColumn, expr); ok {
+				s.fields = names
+				continue
+			}
+		}
+		others = append(others, expr)
+	}
+
+	for _, expr := range others {
+		if cond, ok := in.influxqlCondition(fn.scope, param, expr, s.fields); ok {
+			s.conds = append(s.conds, cond)
+			continue
+		}
+		rest = append(rest, expr)
+	}
+
+	if len(rest) > 0 {
+		body := rest[0]
+		for _, expr := range rest[1:] {
+			body = &LogicalExpr{Op: "and", Left: body, Right: expr}
+		}
+		s.residual = append(s.residual, &closure{fn: &FunctionExpr{Params: fn.fn.Params, Body: body}, scope: fn.scope})
+	}
+	return nil
+}
+
+func splitConjuncts(expr Expr) []Expr {
+	if e, ok := expr.(*LogicalExpr); ok && e.Op == "and" {
+		return append(splitConjuncts(e.Left), splitConjuncts(e.Right)...)
+	}
+	return []Expr{expr}
+}
+
+func splitDisjuncts(expr Expr) []Expr {
+	if e, ok := expr.(*LogicalExpr); ok && e.Op == "or" {
+		return append(splitDisjuncts(e.Left), splitDisjuncts(e.Right)...)
+	}
+	return []Expr{expr}
+}
+
+// columnOf returns the column name if the expression is r.column.
+func columnOf(param string, expr Expr) (string, bool) {
+	m, ok := expr.(*MemberExpr)
+	if !ok {
+		return "", false
+	}
+	id, ok := m.Object.(*Identifier)
+	if !ok || id.Name != param {
+		return "", false
+	}
+	return m.Property, true
+}
+
+func referencesIdent(expr Expr, name string) bool {
+	found := false
+	var walk func(e Expr)
+	walk = func(e Expr) {
+		if found || e == nil {
+			return
+		}
+		switch e := e.(type) {
+		case *Identifier:
+			found = e.Name == name
+		case *ArrayExpr:
+			for _, elem := range e.Elements {
+				walk(elem)
+			}
+		case *RecordExpr:
+			walk(e.With)
+			for _, p := range e.Properties {
+				walk(p.Value)
+			}
+		case *FunctionExpr:
+			// be conservative, parameters may shadow the name
+			found = true
+		case *CallExpr:
+			walk(e.Callee)
+			for _, p := range e.Args {
+				walk(p.Value)
+			}
+		case *PipeExpr:
+			walk(e.Argument)
+			walk(e.Call)
+		case *MemberExpr:
+			walk(e.Object)
+		case *IndexExpr:
+			walk(e.Object)
+			walk(e.Index)
+		case *BinaryExpr:
+			walk(e.Left)
+			walk(e.Right)
+		case *LogicalExpr:
+			walk(e.Left)
+			walk(e.Right)
+		case *UnaryExpr:
+			walk(e.Argument)
+		case *ConditionalExpr:
+			walk(e.Test)
+			walk(e.Consequent)
+			walk(e.Alternate)
+		}
+	}
+	walk(expr)
+	return found
+}
+
+// comparison returns the column, operator and the constant value of a comparison such as r.host == "a".
+func (in *interpreter) comparison(s *scope, param string, expr Expr) (string, tokenType, interface{}, bool) {
+	b, ok := expr.(*BinaryExpr)
+	if !ok {
+		return "", 0, nil, false
+	}
+	col, ok := columnOf(param, b.Left)
+	if !ok || referencesIdent(b.Right, param) {
+		return "", 0, nil, false
+	}
+	v, err := in.eval(s, b.Right)
+	if err != nil {
+		return "", 0, nil, false
+	}
+	return col, b.Op, v, true
+}
+
+func (in *interpreter) measurementCondition(s *scope, param string, expr Expr) ([]string, *regexp.Regexp, bool) {
+	if col, op, v, ok := in.comparison(s, param, expr); ok && col == measurementColumn && op == tokRegexEQ {
+		if re, ok := v.(*regexp.Regexp); ok {
+			return nil, re, true
+		}
+		return nil, nil, false
+	}
+	names, ok := in.orEqualsCondition(s, param, measurementColumn, expr)
+	return names, nil, ok
+}
+
+// orEqualsCondition matches r.column == "a" or r.column == "b" ...
+func (in *interpreter) orEqualsCondition(s *scope, param, column string, expr Expr) ([]string, bool) {
+	var names []string
+	for _, e := range splitDisjuncts(expr) {
+		col, op, v, ok := in.comparison(s, param, e)
+		if !ok || col != column || op != tokEQ {
+			return nil, false
+		}
+		name, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// influxqlCondition lowers comparisons of tags, and of _value if only one field is read, into an InfluxQL condition.
+func (in *interpreter) influxqlCondition(s *scope, param string, expr Expr, fields []string) (string, bool) {
+	disjuncts := splitDisjuncts(expr)
+	conds := make([]string, 0, len(disjuncts))
+	for _, e := range disjuncts {
+		col, op, v, ok := in.comparison(s, param, e)
+		if !ok {
+			return "", false
+		}
+		cond, ok := lowerComparison(col, op, v, fields)
+		if !ok {
+			return "", false
+		}
+		conds = append(conds, cond)
+	}
+	if len(conds) == 1 {
+		return conds[0], true
+	}
+	return "(" + strings.Join(conds, " OR ") + ")", true
+}
+
+var influxqlOperators = map[tokenType]string{
+	tokEQ: "=", tokNEQ: "!=", tokLT: "<", tokLTE: "<=", tokGT: ">", tokGTE: ">=", tokRegexEQ: "=~", tokRegexNEQ: "!~",
+}
+
+func lowerComparison(col string, op tokenType, v interface{}, fields []string) (string, bool) {
+	if col == valueColumn {
+		if len(fields) != 1 {
+			return "", false
+		}
+		var lit string
+		switch v := v.(type) {
+		case int64:
+			lit = strconv.FormatInt(v, 10)
+		case float64:
+			lit = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			if op != tokEQ && op != tokNEQ {
+				return "", false
+			}
+			lit = strconv.FormatBool(v)
+		default:
+			return "", false
+		}
+		if op == tokRegexEQ || op == tokRegexNEQ {
+			return "", false
+		}
+		return influxql.QuoteIdent(fields[0]) + " " + influxqlOperators[op] + " " + lit, true
+	}
+
+	// the columns other than the ones starting with an underscore are tags before the tables are reshaped
+	if strings.HasPrefix(col, "_") {
+		return "", false
+	}
+	switch v := v.(type) {
+	case string:
+		if op != tokEQ && op != tokNEQ {
+			return "", false
+		}
+		return influxql.QuoteIdent(col) + " " + influxqlOperators[op] + " " + influxql.QuoteString(v), true
+	case *regexp.Regexp:
+		if op != tokRegexEQ && op != tokRegexNEQ {
+			return "", false
+		}
+		return influxql.QuoteIdent(col) + " " + influxqlOperators[op] + " " + quoteRegex(v), true
+	}
+	return "", false
+}
+
+func quoteRegex(re *regexp.Regexp) string {
+	return "/" + strings.ReplaceAll(re.String(), "/", `\/`) + "/"
+}
+
+// SQL returns the InfluxQL statement of the read.
+func (s *readSpec) SQL() string {
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	switch {
+	case s.window != nil && len(s.fields) == 0:
+		sb.WriteString(s.window.fn + "(*)")
+	case s.window != nil:
+		for i, f := range s.fields {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(s.window.fn + "(" + influxql.QuoteIdent(f) + ") AS " + influxql.QuoteIdent(f))
+		}
+	case len(s.fields) == 0:
+		sb.WriteString("*")
+	default:
+		for i, f := range s.fields {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(influxql.QuoteIdent(f))
+		}
+	}
+
+	sb.WriteString(" FROM ")
+	switch {
+	case s.measurementRegex != nil:
+		sb.WriteString(quoteRegex(s.measurementRegex))
+	case len(s.measurements) > 0:
+		for i, m := range s.measurements {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(influxql.QuoteIdent(m))
+		}
+	default:
+		sb.WriteString("/.*/")
+	}
+
+	sb.WriteString(" WHERE time >= ")
+	sb.WriteString(strconv.FormatInt(s.start.UnixNano(), 10))
+	sb.WriteString(" AND time < ")
+	sb.WriteString(strconv.FormatInt(s.stop.UnixNano(), 10))
+	for _, cond := range s.conds {
+		sb.WriteString(" AND ")
+		sb.WriteString(cond)
+	}
+
+	sb.WriteString(" GROUP BY ")
+	if s.window != nil {
+		sb.WriteString("time(" + s.window.every.String())
+		if s.window.offset != 0 {
+			sb.WriteString(", " + s.window.offset.String())
+		}
+		sb.WriteString("), *")
+		if s.window.createEmpty {
+			sb.WriteString(" fill(null)")
+		} else {
+			sb.WriteString(" fill(none)")
+		}
+	} else {
+		sb.WriteString("*")
+	}
+	return sb.String()
+}
+
+// rowsToTables converts the series returned by the query into one table per series and field.
+func (s *readSpec) rowsToTables(rows models.Rows) []*Table {
+	var tables []*Table
+	for _, row := range rows {
+		tags := make([]string, 0, len(row.Tags))
+		for k := range row.Tags {
+			tags = append(tags, k)
+		}
+		sort.Strings(tags)
+
+		key := append([]string{startColumn, stopColumn, fieldColumn, measurementColumn}, tags...)
+		columns := append([]string{startColumn, stopColumn, timeColumn, valueColumn, fieldColumn, measurementColumn}, tags...)
+		for i := 1; i < len(row.Columns); i++ {
+			field := row.Columns[i]
+			if s.window != nil && len(s.fields) == 0 {
+				field = strings.TrimPrefix(field, s.window.fn+"_")
+			}
+			t := &Table{Key: sortedCopy(key), Columns: columns}
+			for _, values := range row.Values {
+				if i >= len(values) {
+					continue
+				}
+				v := normalizeValue(values[i])
+				if v == nil && (s.window == nil || !s.window.createEmpty) {
+					continue
+				}
+				ts, ok := toTime(values[0])
+				if !ok {
+					continue
+				}
+				if s.window != nil && s.window.timeSrc != startColumn {
+					ts = ts.Add(s.window.every)
+					if ts.After(s.stop) {
+						ts = s.stop
+					}
+				}
+				r := map[string]interface{}{
+					startColumn:       s.start,
+					stopColumn:        s.stop,
+					timeColumn:        ts,
+					valueColumn:       v,
+					fieldColumn:       field,
+					measurementColumn: row.Name,
+				}
+				for k, tv := range row.Tags {
+					r[k] = tv
+				}
+				t.Rows = append(t.Rows, r)
+			}
+			if len(t.Rows) > 0 {
+				tables = append(tables, t)
+			}
+		}
+	}
+	return tables
+}
+
+func toTime(v interface{}) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v.UTC(), true
+	case int64:
+		return time.Unix(0, v).UTC(), true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+func normalizeValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case uint32:
+		return uint64(v)
+	}
+	return v
+}
+
+// execute runs the query of the read and applies the residual filters.
+func (in *interpreter) execute(s *readSpec) ([]*Table, error) {
+	if !s.hasRange {
+		return nil, fmt.Errorf("cannot submit unbounded read to %q; try bounding 'from' with a call to 'range'", s.bucket())
+	}
+	rows, err := in.querier.Query(in.ctx, s.db, s.rp, s.SQL())
+	if err != nil {
+		return nil, err
+	}
+	tables := s.rowsToTables(rows)
+	for _, fn := range s.residual {
+		if tables, err = in.filterTables(tables, fn); err != nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
+
+func (s *readSpec) bucket() string {
+	if s.rp == "" {
+		return s.db
+	}
+	return s.db + "/" + s.rp
+}
+
+// materialize returns the tables of the stream, executing the read if needed.
+func (in *interpreter) materialize(st *stream) ([]*Table, error) {
+	if st.read == nil {
+		return st.tables, nil
+	}
+	return in.execute(st.read)
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package flux implements a subset of the Flux query language on top of InfluxQL.
+
+The reads made by from() and the range, filter and aggregateWindow calls piped into it are lowered
+into one InfluxQL statement, which is planned and executed by the query engine like any other
+InfluxQL query. The operations which can not be lowered, and all the operations following them,
+are evaluated in memory on the returned tables.
+*/
+package flux
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// Querier executes an InfluxQL statement in the database and retention policy.
+type Querier interface {
+	Query(ctx context.Context, db, rp, sql string) (models.Rows, error)
+}
+
+// Execute runs the Flux script and returns the yielded results, now is the time now() returns.
+func Execute(ctx context.Context, querier Querier, script string, now time.Time) ([]*Result, error) {
+	prog, err := Parse(script)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &interpreter{ctx: ctx, querier: querier, now: now.UTC()}
+	s := newScope(universe())
+	for _, stmt := range prog.Body {
+		switch st := stmt.(type) {
+		case *VariableAssignment:
+			v, err := in.eval(s, st.Init)
+			if err != nil {
+				return nil, err
+			}
+			s.set(st.Name, v)
+		case *ExpressionStatement:
+			v, err := in.eval(s, st.Expr)
+			if err != nil {
+				return nil, err
+			}
+			// the tables of an expression statement are yielded implicitly
+			if str, ok := v.(*stream); ok && !str.yielded {
+				if _, err = in.yield(DefaultResultName, str); err != nil {
+					return nil, err
+				}
+			}
+		case *ReturnStatement:
+			return nil, fmt.Errorf("return is only allowed in a function block")
+		}
+	}
+	if len(in.results) == 0 {
+		return nil, fmt.Errorf("no streaming data found in the query")
+	}
+	return in.results, nil
+}
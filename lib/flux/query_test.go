@@ -0,0 +1,266 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/flux"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+type mockQuerier struct {
+	queries []string
+	rows    models.Rows
+}
+
+// Query returns the rows of the mock, the columns are projected on the fields the statement selects.
+func (m *mockQuerier) Query(_ context.Context, db, rp, sql string) (models.Rows, error) {
+	m.queries = append(m.queries, db+"|"+rp+"|"+sql)
+	selected := strings.TrimPrefix(sql[:strings.Index(sql, " FROM ")], "SELECT ")
+	if selected == "*" || strings.Contains(selected, "(") {
+		return m.rows, nil
+	}
+	fields := strings.Split(selected, ", ")
+	var rows models.Rows
+	for _, row := range m.rows {
+		projected := &models.Row{Name: row.Name, Tags: row.Tags, Columns: append([]string{"time"}, fields...)}
+		for _, values := range row.Values {
+			v := []interface{}{values[0]}
+			for _, f := range fields {
+				for i, col := range row.Columns {
+					if col == f {
+						v = append(v, values[i])
+					}
+				}
+			}
+			projected.Values = append(projected.Values, v)
+		}
+		rows = append(rows, projected)
+	}
+	return rows, nil
+}
+
+var (
+	now   = time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	start = now.Add(-time.Hour)
+)
+
+func cpuRows() models.Rows {
+	return models.Rows{
+		{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "usage", "idle"},
+			Values: [][]interface{}{
+				{start, 1.5, int64(90)},
+				{start.Add(time.Minute), 2.5, nil},
+			}},
+		{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: []string{"time", "usage", "idle"},
+			Values: [][]interface{}{
+				{start, 3.0, int64(80)},
+			}},
+	}
+}
+
+func execute(t *testing.T, q *mockQuerier, script string) []*flux.Result {
+	results, err := flux.Execute(context.Background(), q, script, now)
+	require.NoError(t, err)
+	return results
+}
+
+func parseInfluxQL(t *testing.T, sql string) {
+	p := influxql.NewParser(strings.NewReader(sql))
+	defer p.Release()
+	yy := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yy.ParseTokens()
+	_, err := yy.GetQuery()
+	require.NoError(t, err, sql)
+}
+
+func TestExecute_Pushdown(t *testing.T) {
+	tests := []struct {
+		script string
+		sql    string
+	}{
+		{
+			script: `from(bucket: "db0/rp0") |> range(start: -1h)`,
+			sql:    `db0|rp0|SELECT * FROM /.*/ WHERE time >= 1704067200000000000 AND time < 1704070800000000000 GROUP BY *`,
+		},
+		{
+			script: `from(bucket: "db0")
+  |> range(start: 2024-01-01T00:00:00Z, stop: 2024-01-01T00:30:00Z)
+  |> filter(fn: (r) => r._measurement == "cpu" and (r._field == "usage" or r._field == "idle"))
+  |> filter(fn: (r) => r.host =~ /^a|b$/ and r.dc != "x")`,
+			sql: `db0||SELECT usage, idle FROM cpu WHERE time >= 1704067200000000000 AND time < 1704069000000000000` +
+				` AND host =~ /^a|b$/ AND dc != 'x' GROUP BY *`,
+		},
+		{
+			script: `host = "a"
+from(bucket: "db0")
+  |> range(start: -1h)
+  |> filter(fn: (r) => r._measurement =~ /cpu.*/ and r._field == "usage" and r._value > 1 and r.host == host)
+  |> aggregateWindow(every: 10m, fn: mean, createEmpty: false)`,
+			sql: `db0||SELECT mean(usage) AS usage FROM /cpu.*/ WHERE time >= 1704067200000000000 AND time < 1704070800000000000` +
+				` AND usage > 1 AND host = 'a' GROUP BY time(10m0s), * fill(none)`,
+		},
+		{
+			script: `from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu") |> aggregateWindow(every: 1m, offset: 30s, fn: count)`,
+			sql: `db0||SELECT count(*) FROM cpu WHERE time >= 1704067200000000000 AND time < 1704070800000000000` +
+				` GROUP BY time(1m0s, 30s), * fill(null)`,
+		},
+	}
+	for _, tt := range tests {
+		q := &mockQuerier{}
+		execute(t, q, tt.script)
+		require.Equal(t, []string{tt.sql}, q.queries)
+		parseInfluxQL(t, strings.SplitN(tt.sql, "|", 3)[2])
+	}
+}
+
+func TestExecute_ResidualFilter(t *testing.T) {
+	q := &mockQuerier{rows: cpuRows()}
+	results := execute(t, q, `from(bucket: "db0")
+  |> range(start: -1h)
+  |> filter(fn: (r) => r._measurement == "cpu" and (r._value > 2 or r.host == "a"))`)
+	require.Equal(t, `db0||SELECT * FROM cpu WHERE time >= 1704067200000000000 AND time < 1704070800000000000 GROUP BY *`, q.queries[0])
+
+	require.Equal(t, 1, len(results))
+	require.Equal(t, flux.DefaultResultName, results[0].Name)
+	tables := results[0].Tables
+	require.Equal(t, 4, len(tables))
+	require.Equal(t, []string{"_start", "_stop", "_time", "_value", "_field", "_measurement", "host"}, tables[0].Columns)
+	require.Equal(t, []string{"_field", "_measurement", "_start", "_stop", "host"}, tables[0].Key)
+	require.Equal(t, 2, len(tables[0].Rows))
+	require.Equal(t, "idle", tables[1].Rows[0]["_field"])
+	require.Equal(t, "b", tables[2].Rows[0]["host"])
+	require.Equal(t, 3.0, tables[2].Rows[0]["_value"])
+}
+
+func TestExecute_Unbounded(t *testing.T) {
+	_, err := flux.Execute(context.Background(), &mockQuerier{}, `from(bucket: "db0") |> filter(fn: (r) => true)`, now)
+	require.EqualError(t, err, `cannot submit unbounded read to "db0"; try bounding 'from' with a call to 'range'`)
+
+	_, err = flux.Execute(context.Background(), &mockQuerier{}, `x = 1`, now)
+	require.Error(t, err)
+}
+
+func TestExecute_Transformations(t *testing.T) {
+	q := &mockQuerier{rows: cpuRows()}
+	results := execute(t, q, `data = from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")
+data
+  |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+  |> map(fn: (r) => ({r with total: float(v: r.idle) + r.usage}))
+  |> keep(columns: ["_time", "host", "total"])
+  |> yield(name: "pivot")
+data
+  |> group(columns: ["_field"])
+  |> sort(columns: ["_value"], desc: true)
+  |> limit(n: 2)
+  |> yield(name: "top")
+data |> filter(fn: (r) => r._field == "usage") |> group() |> max()
+`)
+	require.Equal(t, 3, len(results))
+	require.Equal(t, 3, len(q.queries))
+
+	pivot := results[0]
+	require.Equal(t, "pivot", pivot.Name)
+	require.Equal(t, 2, len(pivot.Tables))
+	require.Equal(t, []string{"host"}, pivot.Tables[0].Key)
+	require.Equal(t, []string{"host", "_time", "total"}, pivot.Tables[0].Columns)
+	require.Equal(t, 91.5, pivot.Tables[0].Rows[0]["total"])
+	require.Nil(t, pivot.Tables[0].Rows[1]["total"])
+	require.Equal(t, 83.0, pivot.Tables[1].Rows[0]["total"])
+
+	top := results[1]
+	require.Equal(t, 2, len(top.Tables))
+	require.Equal(t, []string{"_field"}, top.Tables[0].Key)
+	require.Equal(t, 2, len(top.Tables[0].Rows))
+	require.Equal(t, 3.0, top.Tables[0].Rows[0]["_value"])
+	require.Equal(t, 2.5, top.Tables[0].Rows[1]["_value"])
+	require.Equal(t, int64(90), top.Tables[1].Rows[0]["_value"])
+
+	max := results[2]
+	require.Equal(t, flux.DefaultResultName, max.Name)
+	require.Equal(t, 1, len(max.Tables))
+	require.Equal(t, 1, len(max.Tables[0].Rows))
+	require.Equal(t, "b", max.Tables[0].Rows[0]["host"])
+
+	_, err := flux.Execute(context.Background(), q, `
+from(bucket: "db0") |> range(start: -1h) |> yield(name: "a")
+from(bucket: "db0") |> range(start: -1h) |> yield(name: "a")`, now)
+	require.EqualError(t, err, `error calling function "yield": duplicate yield name "a"`)
+}
+
+func TestExecute_AggregateWindow(t *testing.T) {
+	q := &mockQuerier{rows: models.Rows{
+		{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "usage"},
+			Values: [][]interface{}{
+				{start, 1.5},
+				{start.Add(30 * time.Minute), nil},
+				{start.Add(50 * time.Minute), 2.0},
+			}},
+	}}
+	results := execute(t, q, `from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")
+  |> aggregateWindow(every: 20m, fn: mean)`)
+	rows := results[0].Tables[0].Rows
+	require.Equal(t, 3, len(rows))
+	require.Equal(t, start.Add(20*time.Minute), rows[0]["_time"])
+	require.Nil(t, rows[1]["_value"])
+	require.Equal(t, now, rows[2]["_time"])
+
+	// the residual filter prevents the aggregate from being pushed down
+	q = &mockQuerier{rows: cpuRows()}
+	results = execute(t, q, `from(bucket: "db0") |> range(start: -1h)
+  |> filter(fn: (r) => r._measurement == "cpu" and r._value < 100.0)
+  |> aggregateWindow(every: 30m, fn: sum, createEmpty: false)`)
+	require.Equal(t, `db0||SELECT * FROM cpu WHERE time >= 1704067200000000000 AND time < 1704070800000000000 GROUP BY *`, q.queries[0])
+	tables := results[0].Tables
+	require.Equal(t, 4, len(tables))
+	require.Equal(t, 4.0, tables[0].Rows[0]["_value"])
+	require.Equal(t, start.Add(30*time.Minute), tables[0].Rows[0]["_time"])
+	require.Equal(t, int64(90), tables[1].Rows[0]["_value"])
+}
+
+func TestWriteAnnotatedCSV(t *testing.T) {
+	q := &mockQuerier{rows: cpuRows()}
+	results := execute(t, q, `data = from(bucket: "db0") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")
+data |> filter(fn: (r) => r._field == "usage")
+data |> filter(fn: (r) => r._field == "idle") |> keep(columns: ["_value", "host"]) |> yield(name: "idle")`)
+
+	var buf bytes.Buffer
+	require.NoError(t, flux.WriteAnnotatedCSV(&buf, results))
+	expected := strings.Join([]string{
+		"#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string",
+		"#group,false,false,true,true,false,false,true,true,true",
+		"#default,_result,,,,,,,,",
+		",result,table,_start,_stop,_time,_value,_field,_measurement,host",
+		",_result,0,2024-01-01T00:00:00Z,2024-01-01T01:00:00Z,2024-01-01T00:00:00Z,1.5,usage,cpu,a",
+		",_result,0,2024-01-01T00:00:00Z,2024-01-01T01:00:00Z,2024-01-01T00:01:00Z,2.5,usage,cpu,a",
+		",_result,1,2024-01-01T00:00:00Z,2024-01-01T01:00:00Z,2024-01-01T00:00:00Z,3,usage,cpu,b",
+		"",
+		"#datatype,string,long,long,string",
+		"#group,false,false,false,true",
+		"#default,idle,,,",
+		",result,table,_value,host",
+		",idle,0,90,a",
+		",idle,1,80,b",
+		"",
+	}, "\r\n")
+	require.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,321 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenType int
+
+const (
+	tokEOF tokenType = iota
+	tokIdent
+	tokInt
+	tokFloat
+	tokString
+	tokDuration
+	tokTime
+	tokRegex
+
+	tokPipe     // |>
+	tokArrow    // =>
+	tokAssign   // =
+	tokLParen   // (
+	tokRParen   // )
+	tokLBrack   // [
+	tokRBrack   // ]
+	tokLBrace   // {
+	tokRBrace   // }
+	tokComma    // ,
+	tokColon    // :
+	tokDot      // .
+	tokAdd      // +
+	tokSub      // -
+	tokMul      // *
+	tokDiv      // /
+	tokMod      // %
+	tokEQ       // ==
+	tokNEQ      // !=
+	tokLT       // <
+	tokLTE      // <=
+	tokGT       // >
+	tokGTE      // >=
+	tokRegexEQ  // =~
+	tokRegexNEQ // !~
+)
+
+var tokenNames = map[tokenType]string{
+	tokEOF: "EOF", tokIdent: "identifier", tokInt: "integer", tokFloat: "float", tokString: "string",
+	tokDuration: "duration", tokTime: "time", tokRegex: "regex",
+	tokPipe: "|>", tokArrow: "=>", tokAssign: "=", tokLParen: "(", tokRParen: ")", tokLBrack: "[", tokRBrack: "]",
+	tokLBrace: "{", tokRBrace: "}", tokComma: ",", tokColon: ":", tokDot: ".", tokAdd: "+", tokSub: "-",
+	tokMul: "*", tokDiv: "/", tokMod: "%", tokEQ: "==", tokNEQ: "!=", tokLT: "<", tokLTE: "<=", tokGT: ">",
+	tokGTE: ">=", tokRegexEQ: "=~", tokRegexNEQ: "!~",
+}
+
+func (t tokenType) String() string {
+	return tokenNames[t]
+}
+
+type token struct {
+	typ tokenType
+	lit string
+	pos int
+}
+
+// scan splits the Flux source into tokens. A slash starts a regex literal unless it follows an operand.
+func scan(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+		case r == '/' && strings.HasPrefix(src[i:], "//"):
+			// comment until the end of the line
+			if end := strings.IndexByte(src[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(src)
+			}
+			continue
+		}
+
+		start := i
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+			for i < len(src) {
+				r, size = utf8.DecodeRuneInString(src[i:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, token{typ: tokIdent, lit: src[start:i], pos: start})
+		case r >= '0' && r <= '9':
+			tok, err := scanNumber(src, start)
+			if err != nil {
+				return nil, err
+			}
+			i += len(tok.lit)
+			tokens = append(tokens, tok)
+		case r == '"':
+			lit, n, err := scanString(src[start:])
+			if err != nil {
+				return nil, fmt.Errorf("%v at position %d", err, start)
+			}
+			i += n
+			tokens = append(tokens, token{typ: tokString, lit: lit, pos: start})
+		case r == '/' && !followsOperand(tokens):
+			lit, n, err := scanRegex(src[start:])
+			if err != nil {
+				return nil, fmt.Errorf("%v at position %d", err, start)
+			}
+			i += n
+			tokens = append(tokens, token{typ: tokRegex, lit: lit, pos: start})
+		default:
+			typ, n := scanOperator(src[start:])
+			if n == 0 {
+				return nil, fmt.Errorf("invalid character %q at position %d", r, start)
+			}
+			i += n
+			tokens = append(tokens, token{typ: typ, lit: src[start:i], pos: start})
+		}
+	}
+	tokens = append(tokens, token{typ: tokEOF, pos: len(src)})
+	return tokens, nil
+}
+
+func followsOperand(tokens []token) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+	switch tokens[len(tokens)-1].typ {
+	case tokIdent, tokInt, tokFloat, tokString, tokDuration, tokTime, tokRegex, tokRParen, tokRBrack, tokRBrace:
+		return true
+	}
+	return false
+}
+
+func scanOperator(s string) (tokenType, int) {
+	if len(s) >= 2 {
+		switch s[:2] {
+		case "|>":
+			return tokPipe, 2
+		case "=>":
+			return tokArrow, 2
+		case "==":
+			return tokEQ, 2
+		case "!=":
+			return tokNEQ, 2
+		case "<=":
+			return tokLTE, 2
+		case ">=":
+			return tokGTE, 2
+		case "=~":
+			return tokRegexEQ, 2
+		case "!~":
+			return tokRegexNEQ, 2
+		}
+	}
+	switch s[0] {
+	case '=':
+		return tokAssign, 1
+	case '(':
+		return tokLParen, 1
+	case ')':
+		return tokRParen, 1
+	case '[':
+		return tokLBrack, 1
+	case ']':
+		return tokRBrack, 1
+	case '{':
+		return tokLBrace, 1
+	case '}':
+		return tokRBrace, 1
+	case ',':
+		return tokComma, 1
+	case ':':
+		return tokColon, 1
+	case '.':
+		return tokDot, 1
+	case '+':
+		return tokAdd, 1
+	case '-':
+		return tokSub, 1
+	case '*':
+		return tokMul, 1
+	case '/':
+		return tokDiv, 1
+	case '%':
+		return tokMod, 1
+	case '<':
+		return tokLT, 1
+	case '>':
+		return tokGT, 1
+	}
+	return tokEOF, 0
+}
+
+// scanNumber scans an integer, float, duration such as 1h30m, or time such as 2024-01-01T00:00:00Z.
+func scanNumber(src string, start int) (token, error) {
+	i := start
+	for i < len(src) && isDigit(src[i]) {
+		i++
+	}
+
+	// date time
+	if i-start == 4 && i+2 < len(src) && src[i] == '-' && isDigit(src[i+1]) {
+		for i < len(src) && (isDigit(src[i]) || strings.IndexByte("-:.TZ+", src[i]) >= 0) {
+			i++
+		}
+		return token{typ: tokTime, lit: src[start:i], pos: start}, nil
+	}
+
+	// float
+	if i+1 < len(src) && src[i] == '.' && isDigit(src[i+1]) {
+		i++
+		for i < len(src) && isDigit(src[i]) {
+			i++
+		}
+		return token{typ: tokFloat, lit: src[start:i], pos: start}, nil
+	}
+
+	// duration, which is a sequence of the integer and unit pairs
+	if i < len(src) && isLetter(src[i]) {
+		for {
+			for i < len(src) && isLetter(src[i]) {
+				i++
+			}
+			if i < len(src) && isDigit(src[i]) {
+				for i < len(src) && isDigit(src[i]) {
+					i++
+				}
+				if i >= len(src) || !isLetter(src[i]) {
+					return token{}, fmt.Errorf("invalid duration %q at position %d", src[start:i], start)
+				}
+				continue
+			}
+			break
+		}
+		return token{typ: tokDuration, lit: src[start:i], pos: start}, nil
+	}
+	return token{typ: tokInt, lit: src[start:i], pos: start}, nil
+}
+
+func scanString(s string) (string, int, error) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 >= len(s) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			default:
+				sb.WriteByte(s[i])
+			}
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+func scanRegex(s string) (string, int, error) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '/':
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(s) && s[i+1] == '/' {
+				sb.WriteByte('/')
+				i++
+				continue
+			}
+			sb.WriteByte(s[i])
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated regex")
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated regex")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	// 0xC2 0xB5 is the UTF-8 encoding of the micro sign in µs
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == 0xC2 || c == 0xB5
+}
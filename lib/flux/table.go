@@ -0,0 +1,197 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	startColumn       = "_start"
+	stopColumn        = "_stop"
+	timeColumn        = "_time"
+	valueColumn       = "_value"
+	fieldColumn       = "_field"
+	measurementColumn = "_measurement"
+
+	DefaultResultName = "_result"
+)
+
+// Table is a set of rows sharing the same values of the group key columns.
+type Table struct {
+	Key     []string
+	Columns []string
+	Rows    []map[string]interface{}
+}
+
+// Result is a named set of tables returned by yield.
+type Result struct {
+	Name   string
+	Tables []*Table
+}
+
+func (t *Table) isKey(col string) bool {
+	for _, k := range t.Key {
+		if k == col {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) hasColumn(col string) bool {
+	for _, c := range t.Columns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) addColumn(col string) {
+	if !t.hasColumn(col) {
+		t.Columns = append(t.Columns, col)
+	}
+}
+
+// record converts a row into the record passed to the functions of filter and map.
+func (t *Table) record(row map[string]interface{}) *Record {
+	rec := &Record{keys: make([]string, 0, len(t.Columns)), values: make(map[string]interface{}, len(t.Columns))}
+	for _, col := range t.Columns {
+		rec.Set(col, row[col])
+	}
+	return rec
+}
+
+func groupKeyString(key []string, row map[string]interface{}) string {
+	var sb strings.Builder
+	for _, k := range key {
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(typeName(row[k]))
+		sb.WriteByte(':')
+		sb.WriteString(formatValue(row[k]))
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// regroup moves the rows of the tables into the tables grouped by the key columns,
+// the tables are ordered by their first appearance.
+func regroup(tables []*Table, key []string) []*Table {
+	return regroupBy(tables, func(*Table) []string {
+		return key
+	})
+}
+
+func sortedCopy(s []string) []string {
+	c := make([]string, len(s))
+	copy(c, s)
+	sort.Strings(c)
+	return c
+}
+
+func columnType(t *Table, col string) string {
+	for _, row := range t.Rows {
+		switch row[col].(type) {
+		case nil:
+			continue
+		case float64:
+			return "double"
+		case int64:
+			return "long"
+		case uint64:
+			return "unsignedLong"
+		case bool:
+			return "boolean"
+		case time.Time:
+			return "dateTime:RFC3339"
+		case time.Duration:
+			return "duration"
+		default:
+			return "string"
+		}
+	}
+	return "string"
+}
+
+// WriteAnnotatedCSV encodes the results as the annotated CSV returned by the InfluxDB 2.x query API.
+// New annotations are written whenever the schema of the tables changes.
+func WriteAnnotatedCSV(w io.Writer, results []*Result) error {
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+
+	var lastSchema string
+	first := true
+	for _, res := range results {
+		tableIdx := 0
+		for _, t := range res.Tables {
+			if len(t.Rows) == 0 {
+				continue
+			}
+			types := make([]string, len(t.Columns))
+			groups := make([]string, len(t.Columns))
+			for i, col := range t.Columns {
+				types[i] = columnType(t, col)
+				if t.isKey(col) {
+					groups[i] = "true"
+				} else {
+					groups[i] = "false"
+				}
+			}
+
+			schema := res.Name + "\x00" + strings.Join(t.Columns, ",") + "\x00" + strings.Join(types, ",") + "\x00" + strings.Join(groups, ",")
+			if schema != lastSchema {
+				if !first {
+					cw.Flush()
+					if _, err := io.WriteString(w, "\r\n"); err != nil {
+						return err
+					}
+				}
+				first = false
+				lastSchema = schema
+
+				records := [][]string{
+					append([]string{"#datatype", "string", "long"}, types...),
+					append([]string{"#group", "false", "false"}, groups...),
+					append([]string{"#default", res.Name, ""}, make([]string, len(t.Columns))...),
+					append([]string{"", "result", "table"}, t.Columns...),
+				}
+				if err := cw.WriteAll(records); err != nil {
+					return err
+				}
+			}
+
+			line := make([]string, len(t.Columns)+3)
+			line[1] = res.Name
+			line[2] = formatValue(int64(tableIdx))
+			for _, row := range t.Rows {
+				for i, col := range t.Columns {
+					line[i+3] = formatValue(row[col])
+				}
+				if err := cw.Write(line); err != nil {
+					return err
+				}
+			}
+			tableIdx++
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,557 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The transformations below run in memory on the tables returned by the query.
+
+// regroupBy moves the rows into tables grouped by the key returned by keyFn for the table the row belongs to.
+func regroupBy(tables []*Table, keyFn func(t *Table) []string) []*Table {
+	var result []*Table
+	index := make(map[string]*Table)
+	for _, t := range tables {
+		key := sortedCopy(keyFn(t))
+		prefix := strings.Join(key, ",") + "\x01"
+		for _, row := range t.Rows {
+			id := prefix + groupKeyString(key, row)
+			g, ok := index[id]
+			if !ok {
+				g = &Table{Key: key}
+				index[id] = g
+				result = append(result, g)
+			}
+			for _, col := range t.Columns {
+				g.addColumn(col)
+			}
+			g.Rows = append(g.Rows, row)
+		}
+	}
+	return result
+}
+
+func intersect(key, columns []string) []string {
+	var result []string
+	for _, k := range key {
+		for _, c := range columns {
+			if k == c {
+				result = append(result, k)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func (in *interpreter) filterTables(tables []*Table, fn *closure) ([]*Table, error) {
+	var result []*Table
+	for _, t := range tables {
+		out := &Table{Key: t.Key, Columns: t.Columns}
+		for _, row := range t.Rows {
+			ok, err := in.callPredicate(fn, t.record(row))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				out.Rows = append(out.Rows, row)
+			}
+		}
+		if len(out.Rows) > 0 {
+			result = append(result, out)
+		}
+	}
+	return result, nil
+}
+
+func rangeTables(tables []*Table, start, stop time.Time) []*Table {
+	var result []*Table
+	for _, t := range tables {
+		out := &Table{Key: t.Key, Columns: t.Columns}
+		for _, row := range t.Rows {
+			ts, ok := row[timeColumn].(time.Time)
+			if !ok || ts.Before(start) || !ts.Before(stop) {
+				continue
+			}
+			r := copyRow(row)
+			r[startColumn], r[stopColumn] = start, stop
+			out.Rows = append(out.Rows, r)
+		}
+		if len(out.Rows) == 0 {
+			continue
+		}
+		if !out.hasColumn(startColumn) || !out.hasColumn(stopColumn) {
+			out.Columns = append([]string{startColumn, stopColumn}, removeColumns(out.Columns, startColumn, stopColumn)...)
+		}
+		if !out.isKey(startColumn) || !out.isKey(stopColumn) {
+			out.Key = sortedCopy(append(removeColumns(out.Key, startColumn, stopColumn), startColumn, stopColumn))
+		}
+		result = append(result, out)
+	}
+	return result
+}
+
+func removeColumns(columns []string, remove ...string) []string {
+	result := make([]string, 0, len(columns))
+	for _, c := range columns {
+		keep := true
+		for _, r := range remove {
+			if c == r {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func copyRow(row map[string]interface{}) map[string]interface{} {
+	r := make(map[string]interface{}, len(row))
+	for k, v := range row {
+		r[k] = v
+	}
+	return r
+}
+
+func groupTables(tables []*Table, columns []string, mode string) ([]*Table, error) {
+	switch mode {
+	case "by":
+		return regroup(tables, columns), nil
+	case "except":
+		return regroupBy(tables, func(t *Table) []string {
+			return removeColumns(t.Columns, columns...)
+		}), nil
+	}
+	return nil, fmt.Errorf("unknown group mode %q", mode)
+}
+
+func (in *interpreter) mapTables(tables []*Table, fn *closure) ([]*Table, error) {
+	var mapped []*Table
+	for _, t := range tables {
+		out := &Table{Key: t.Key}
+		for _, row := range t.Rows {
+			v, err := in.call(fn, map[string]interface{}{"r": t.record(row)})
+			if err != nil {
+				return nil, err
+			}
+			rec, ok := v.(*Record)
+			if !ok {
+				return nil, fmt.Errorf("map function must return a record, got %s", typeName(v))
+			}
+			r := make(map[string]interface{}, len(rec.keys))
+			for _, k := range rec.keys {
+				out.addColumn(k)
+				r[k] = rec.values[k]
+			}
+			out.Rows = append(out.Rows, r)
+		}
+		mapped = append(mapped, out)
+	}
+	// the rows are regrouped since the values of the group key columns may have been modified
+	return regroupBy(mapped, func(t *Table) []string {
+		return intersect(t.Key, t.Columns)
+	}), nil
+}
+
+// selectColumns implements keep and drop, the columns are selected either by name or by the predicate fn.
+func (in *interpreter) selectColumns(tables []*Table, columns []string, fn *closure, keep bool) ([]*Table, error) {
+	var selected []*Table
+	for _, t := range tables {
+		out := &Table{}
+		for _, col := range t.Columns {
+			var match bool
+			if fn != nil {
+				v, err := in.call(fn, map[string]interface{}{"column": col})
+				if err != nil {
+					return nil, err
+				}
+				match = v == true
+			} else {
+				for _, c := range columns {
+					if c == col {
+						match = true
+						break
+					}
+				}
+			}
+			if match == keep {
+				out.Columns = append(out.Columns, col)
+			}
+		}
+		out.Key = intersect(t.Key, out.Columns)
+		for _, row := range t.Rows {
+			r := make(map[string]interface{}, len(out.Columns))
+			for _, col := range out.Columns {
+				r[col] = row[col]
+			}
+			out.Rows = append(out.Rows, r)
+		}
+		selected = append(selected, out)
+	}
+	return regroupBy(selected, func(t *Table) []string { return t.Key }), nil
+}
+
+func sortTables(tables []*Table, columns []string, desc bool) []*Table {
+	result := make([]*Table, 0, len(tables))
+	for _, t := range tables {
+		out := &Table{Key: t.Key, Columns: t.Columns, Rows: append([]map[string]interface{}(nil), t.Rows...)}
+		sort.SliceStable(out.Rows, func(i, j int) bool {
+			c := compareRows(out.Rows[i], out.Rows[j], columns)
+			if desc {
+				return c > 0
+			}
+			return c < 0
+		})
+		result = append(result, out)
+	}
+	return result
+}
+
+func compareRows(a, b map[string]interface{}, columns []string) int {
+	for _, col := range columns {
+		c, ok := compareValues(a[col], b[col])
+		if !ok {
+			c = strings.Compare(formatValue(a[col]), formatValue(b[col]))
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func limitTables(tables []*Table, n, offset int64) []*Table {
+	var result []*Table
+	for _, t := range tables {
+		rows := t.Rows
+		if int64(len(rows)) <= offset {
+			continue
+		}
+		rows = rows[offset:]
+		if int64(len(rows)) > n {
+			rows = rows[:n]
+		}
+		result = append(result, &Table{Key: t.Key, Columns: t.Columns, Rows: rows})
+	}
+	return result
+}
+
+// pivotTables turns the values of the columnKey columns into new columns holding the values of valueColumn,
+// the rows having the same values of the rowKey columns are merged.
+func pivotTables(tables []*Table, rowKey, columnKey []string, valueColumn string) []*Table {
+	grouped := regroupBy(tables, func(t *Table) []string {
+		return removeColumns(t.Key, append(columnKey, valueColumn)...)
+	})
+
+	result := make([]*Table, 0, len(grouped))
+	for _, t := range grouped {
+		out := &Table{Key: t.Key}
+		for _, col := range t.Columns {
+			if t.isKey(col) {
+				out.Columns = append(out.Columns, col)
+			}
+		}
+		for _, col := range rowKey {
+			out.addColumn(col)
+		}
+
+		index := make(map[string]map[string]interface{})
+		for _, row := range t.Rows {
+			id := groupKeyString(rowKey, row)
+			r, ok := index[id]
+			if !ok {
+				r = make(map[string]interface{}, len(out.Columns))
+				for _, col := range out.Columns {
+					r[col] = row[col]
+				}
+				index[id] = r
+				out.Rows = append(out.Rows, r)
+			}
+			names := make([]string, len(columnKey))
+			for i, col := range columnKey {
+				names[i] = formatValue(row[col])
+			}
+			name := strings.Join(names, "_")
+			out.addColumn(name)
+			r[name] = row[valueColumn]
+		}
+		sort.SliceStable(out.Rows, func(i, j int) bool {
+			return compareRows(out.Rows[i], out.Rows[j], rowKey) < 0
+		})
+		result = append(result, out)
+	}
+	return result
+}
+
+// selectors return the selected rows, the other aggregates return the group key columns and the aggregated column.
+var selectors = map[string]bool{"first": true, "last": true, "min": true, "max": true}
+
+func aggregateTables(tables []*Table, fn, column string) ([]*Table, error) {
+	var result []*Table
+	for _, t := range tables {
+		if selectors[fn] {
+			if idx := selectRow(t.Rows, fn, column); idx >= 0 {
+				result = append(result, &Table{Key: t.Key, Columns: t.Columns, Rows: t.Rows[idx : idx+1]})
+			}
+			continue
+		}
+
+		values := make([]interface{}, len(t.Rows))
+		for i, row := range t.Rows {
+			values[i] = row[column]
+		}
+		v, err := aggregate(fn, values)
+		if err != nil {
+			return nil, err
+		}
+		out := &Table{Key: t.Key}
+		for _, col := range t.Columns {
+			if t.isKey(col) {
+				out.Columns = append(out.Columns, col)
+			}
+		}
+		out.addColumn(column)
+		r := make(map[string]interface{}, len(out.Columns))
+		if len(t.Rows) > 0 {
+			for _, k := range t.Key {
+				r[k] = t.Rows[0][k]
+			}
+		}
+		r[column] = v
+		out.Rows = append(out.Rows, r)
+		result = append(result, out)
+	}
+	return result, nil
+}
+
+func selectRow(rows []map[string]interface{}, fn, column string) int {
+	idx := -1
+	for i, row := range rows {
+		v := row[column]
+		if v == nil {
+			continue
+		}
+		if idx < 0 {
+			idx = i
+			if fn == "first" {
+				return idx
+			}
+			continue
+		}
+		c, _ := compareValues(v, rows[idx][column])
+		switch fn {
+		case "last":
+			idx = i
+		case "min":
+			if c < 0 {
+				idx = i
+			}
+		case "max":
+			if c > 0 {
+				idx = i
+			}
+		}
+	}
+	return idx
+}
+
+// aggregate reduces the non null values by the aggregate function.
+func aggregate(fn string, values []interface{}) (interface{}, error) {
+	var nonNull []interface{}
+	allInt := true
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if _, ok := v.(int64); !ok {
+			allInt = false
+		}
+		nonNull = append(nonNull, v)
+	}
+	if fn == "count" {
+		return int64(len(nonNull)), nil
+	}
+	if len(nonNull) == 0 {
+		return nil, nil
+	}
+
+	switch fn {
+	case "first":
+		return nonNull[0], nil
+	case "last":
+		return nonNull[len(nonNull)-1], nil
+	case "min", "max":
+		v := nonNull[0]
+		for _, x := range nonNull[1:] {
+			c, ok := compareValues(x, v)
+			if !ok {
+				return nil, fmt.Errorf("cannot compare %s and %s", typeName(x), typeName(v))
+			}
+			if (fn == "min" && c < 0) || (fn == "max" && c > 0) {
+				v = x
+			}
+		}
+		return v, nil
+	}
+
+	floats := make([]float64, len(nonNull))
+	for i, v := range nonNull {
+		f, ok := toFloat(v)
+		if !ok {
+			return nil, fmt.Errorf("unsupported input type for %s aggregate: %s", fn, typeName(v))
+		}
+		floats[i] = f
+	}
+	switch fn {
+	case "sum":
+		if allInt {
+			var sum int64
+			for _, v := range nonNull {
+				sum += v.(int64)
+			}
+			return sum, nil
+		}
+		var sum float64
+		for _, f := range floats {
+			sum += f
+		}
+		return sum, nil
+	case "mean":
+		var sum float64
+		for _, f := range floats {
+			sum += f
+		}
+		return sum / float64(len(floats)), nil
+	case "median":
+		sort.Float64s(floats)
+		n := len(floats)
+		if n%2 == 1 {
+			return floats[n/2], nil
+		}
+		return (floats[n/2-1] + floats[n/2]) / 2, nil
+	case "spread":
+		lo, hi := floats[0], floats[0]
+		for _, f := range floats[1:] {
+			lo, hi = math.Min(lo, f), math.Max(hi, f)
+		}
+		if allInt {
+			return int64(hi - lo), nil
+		}
+		return hi - lo, nil
+	case "stddev":
+		if len(floats) < 2 {
+			return nil, nil
+		}
+		var mean float64
+		for _, f := range floats {
+			mean += f
+		}
+		mean /= float64(len(floats))
+		var variance float64
+		for _, f := range floats {
+			variance += (f - mean) * (f - mean)
+		}
+		return math.Sqrt(variance / float64(len(floats)-1)), nil
+	}
+	return nil, fmt.Errorf("unsupported aggregate %s", fn)
+}
+
+// aggregateWindowTables aggregates the rows of each table in the time windows of every.
+func aggregateWindowTables(tables []*Table, w *windowSpec, column, timeDst string) ([]*Table, error) {
+	var result []*Table
+	for _, t := range tables {
+		if len(t.Rows) == 0 {
+			continue
+		}
+		start, hasStart := t.Rows[0][startColumn].(time.Time)
+		stop, hasStop := t.Rows[0][stopColumn].(time.Time)
+
+		windows := make(map[int64][]interface{})
+		var order []int64
+		for _, row := range t.Rows {
+			ts, ok := row[timeColumn].(time.Time)
+			if !ok {
+				continue
+			}
+			ws := windowStart(ts, w.every, w.offset).UnixNano()
+			if _, ok := windows[ws]; !ok {
+				order = append(order, ws)
+			}
+			windows[ws] = append(windows[ws], row[column])
+		}
+		if w.createEmpty && hasStart && hasStop {
+			order = order[:0]
+			for ws := windowStart(start, w.every, w.offset); ws.Before(stop); ws = ws.Add(w.every) {
+				order = append(order, ws.UnixNano())
+			}
+		}
+		sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+
+		out := &Table{Key: t.Key}
+		for _, col := range t.Columns {
+			if t.isKey(col) || col == timeDst || col == column {
+				out.Columns = append(out.Columns, col)
+			}
+		}
+		out.addColumn(timeDst)
+		out.addColumn(column)
+		for _, ws := range order {
+			v, err := aggregate(w.fn, windows[ws])
+			if err != nil {
+				return nil, err
+			}
+			if v == nil && !w.createEmpty {
+				continue
+			}
+			ts := time.Unix(0, ws).UTC()
+			if w.timeSrc != startColumn {
+				ts = ts.Add(w.every)
+				if hasStop && ts.After(stop) {
+					ts = stop
+				}
+			} else if hasStart && ts.Before(start) {
+				ts = start
+			}
+			r := make(map[string]interface{}, len(out.Columns))
+			for _, k := range t.Key {
+				r[k] = t.Rows[0][k]
+			}
+			r[timeDst] = ts
+			r[column] = v
+			out.Rows = append(out.Rows, r)
+		}
+		if len(out.Rows) > 0 {
+			result = append(result, out)
+		}
+	}
+	return result, nil
+}
+
+func windowStart(ts time.Time, every, offset time.Duration) time.Time {
+	n := ts.UnixNano() - int64(offset)
+	start := n - n%int64(every)
+	if n%int64(every) < 0 {
+		start -= int64(every)
+	}
+	return time.Unix(0, start+int64(offset)).UTC()
+}
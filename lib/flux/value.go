@@ -0,0 +1,415 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flux
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Values of the interpreter are represented by the Go types nil, bool, int64, float64, string,
+// time.Time, time.Duration, *regexp.Regexp, []interface{}, *Record, *closure, *builtin and *stream.
+
+// Record is an ordered set of properties.
+type Record struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func NewRecord() *Record {
+	return &Record{values: make(map[string]interface{})}
+}
+
+func (r *Record) Get(key string) (interface{}, bool) {
+	v, ok := r.values[key]
+	return v, ok
+}
+
+func (r *Record) Set(key string, value interface{}) {
+	if _, ok := r.values[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.values[key] = value
+}
+
+func (r *Record) Keys() []string {
+	return r.keys
+}
+
+func (r *Record) clone() *Record {
+	c := &Record{keys: make([]string, len(r.keys)), values: make(map[string]interface{}, len(r.values))}
+	copy(c.keys, r.keys)
+	for k, v := range r.values {
+		c.values[k] = v
+	}
+	return c
+}
+
+// closure is a function literal together with the scope it is defined in.
+type closure struct {
+	fn    *FunctionExpr
+	scope *scope
+}
+
+// builtin is a function implemented in Go, the piped tables are passed as the "tables" argument.
+type builtin struct {
+	name string
+	call func(in *interpreter, args *arguments) (interface{}, error)
+}
+
+type scope struct {
+	parent *scope
+	vars   map[string]interface{}
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{parent: parent, vars: make(map[string]interface{})}
+}
+
+func (s *scope) lookup(name string) (interface{}, bool) {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.vars[name]; ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (s *scope) set(name string, v interface{}) {
+	s.vars[name] = v
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case int64:
+		return "int"
+	case uint64:
+		return "uint"
+	case float64:
+		return "float"
+	case string:
+		return "string"
+	case time.Time:
+		return "time"
+	case time.Duration:
+		return "duration"
+	case *regexp.Regexp:
+		return "regexp"
+	case []interface{}:
+		return "array"
+	case *Record:
+		return "record"
+	case *closure, *builtin:
+		return "function"
+	case *stream:
+		return "stream"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func binaryOp(op tokenType, l, r interface{}) (interface{}, error) {
+	if l == nil || r == nil {
+		return nil, nil
+	}
+	switch op {
+	case tokRegexEQ, tokRegexNEQ:
+		s, ok1 := l.(string)
+		re, ok2 := r.(*regexp.Regexp)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("unsupported operands for %s: %s and %s", op, typeName(l), typeName(r))
+		}
+		return re.MatchString(s) == (op == tokRegexEQ), nil
+	case tokEQ, tokNEQ, tokLT, tokLTE, tokGT, tokGTE:
+		c, ok := compareValues(l, r)
+		if !ok {
+			if op == tokEQ || op == tokNEQ {
+				return (op == tokNEQ), nil
+			}
+			return nil, fmt.Errorf("unsupported operands for %s: %s and %s", op, typeName(l), typeName(r))
+		}
+		switch op {
+		case tokEQ:
+			return c == 0, nil
+		case tokNEQ:
+			return c != 0, nil
+		case tokLT:
+			return c < 0, nil
+		case tokLTE:
+			return c <= 0, nil
+		case tokGT:
+			return c > 0, nil
+		default:
+			return c >= 0, nil
+		}
+	}
+	return arithmetic(op, l, r)
+}
+
+func arithmetic(op tokenType, l, r interface{}) (interface{}, error) {
+	switch lv := l.(type) {
+	case int64:
+		if rv, ok := r.(int64); ok {
+			switch op {
+			case tokAdd:
+				return lv + rv, nil
+			case tokSub:
+				return lv - rv, nil
+			case tokMul:
+				return lv * rv, nil
+			case tokDiv:
+				if rv == 0 {
+					return nil, fmt.Errorf("cannot divide by zero")
+				}
+				return lv / rv, nil
+			case tokMod:
+				if rv == 0 {
+					return nil, fmt.Errorf("cannot divide by zero")
+				}
+				return lv % rv, nil
+			}
+		}
+	case string:
+		if rv, ok := r.(string); ok && op == tokAdd {
+			return lv + rv, nil
+		}
+	case time.Time:
+		if rv, ok := r.(time.Duration); ok {
+			switch op {
+			case tokAdd:
+				return lv.Add(rv), nil
+			case tokSub:
+				return lv.Add(-rv), nil
+			}
+		}
+	case time.Duration:
+		if rv, ok := r.(time.Duration); ok {
+			switch op {
+			case tokAdd:
+				return lv + rv, nil
+			case tokSub:
+				return lv - rv, nil
+			}
+		}
+	}
+
+	lf, ok1 := toFloat(l)
+	rf, ok2 := toFloat(r)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("unsupported operands for %s: %s and %s", op, typeName(l), typeName(r))
+	}
+	switch op {
+	case tokAdd:
+		return lf + rf, nil
+	case tokSub:
+		return lf - rf, nil
+	case tokMul:
+		return lf * rf, nil
+	case tokDiv:
+		return lf / rf, nil
+	case tokMod:
+		return math.Mod(lf, rf), nil
+	}
+	return nil, fmt.Errorf("unsupported operator %s", op)
+}
+
+// compareValues compares two values of comparable types, nil is ordered before any other value.
+func compareValues(l, r interface{}) (int, bool) {
+	if l == nil || r == nil {
+		switch {
+		case l == nil && r == nil:
+			return 0, true
+		case l == nil:
+			return -1, true
+		default:
+			return 1, true
+		}
+	}
+	switch lv := l.(type) {
+	case string:
+		if rv, ok := r.(string); ok {
+			return strings.Compare(lv, rv), true
+		}
+	case bool:
+		if rv, ok := r.(bool); ok {
+			switch {
+			case lv == rv:
+				return 0, true
+			case !lv:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case time.Time:
+		if rv, ok := r.(time.Time); ok {
+			return lv.Compare(rv), true
+		}
+	case time.Duration:
+		if rv, ok := r.(time.Duration); ok {
+			return compareOrdered(lv, rv), true
+		}
+	case int64:
+		if rv, ok := r.(int64); ok {
+			return compareOrdered(lv, rv), true
+		}
+	}
+	lf, ok1 := toFloat(l)
+	rf, ok2 := toFloat(r)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	return compareOrdered(lf, rf), true
+}
+
+func compareOrdered[T int64 | float64 | time.Duration](l, r T) int {
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	}
+	return 0
+}
+
+func convertFloat(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case bool:
+		if v {
+			return float64(1), nil
+		}
+		return float64(0), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert string %q to float", v)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to float", typeName(v))
+}
+
+func convertInt(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		return v, nil
+	case uint64:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	case bool:
+		if v {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case time.Time:
+		return v.UnixNano(), nil
+	case time.Duration:
+		return int64(v), nil
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert string %q to int", v)
+		}
+		return i, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to int", typeName(v))
+}
+
+func convertString(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return v, nil
+	case bool, int64, uint64, float64, time.Duration:
+		return formatValue(v), nil
+	case time.Time:
+		return formatValue(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to string", typeName(v))
+}
+
+func convertBool(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		return v, nil
+	case int64:
+		return v != 0, nil
+	case float64:
+		return v != 0, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert string %q to bool", v)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to bool", typeName(v))
+}
+
+// formatValue formats a column value the way the annotated CSV encodes it.
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case time.Duration:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
@@ -429,6 +429,29 @@ func (c *GetCqLeaseCallback) Handle(data interface{}) error {
 	return nil
 }
 
+type GetRuleLeaseCallback struct {
+	BaseCallback
+	Owner bool
+	Host  string
+}
+
+func (c *GetRuleLeaseCallback) Handle(data interface{}) error {
+	metaMsg, err := c.Trans2MetaMsg(data)
+	if err != nil {
+		return err
+	}
+	msg, ok := metaMsg.Data().(*message.GetRuleLeaseResponse)
+	if !ok {
+		return fmt.Errorf("data is not a GetRuleLeaseResponse, got type %T", metaMsg.Data())
+	}
+	if msg.Err != "" {
+		return fmt.Errorf("get rule lease callback error: %s", msg.Err)
+	}
+	c.Owner = msg.Owner
+	c.Host = msg.Host
+	return nil
+}
+
 type VerifyDataNodeStatusCallback struct {
 	BaseCallback
 }
@@ -128,6 +128,25 @@ func TestGetCqLeaseCallbackResponse(t *testing.T) {
 	assert.EqualError(t, err, "get cq lease callback error: mock error")
 }
 
+func TestGetRuleLeaseCallbackResponse(t *testing.T) {
+	callback := &metaclient.GetRuleLeaseCallback{}
+	msg := message.NewMetaMessage(message.GetRuleLeaseResponseMessage, &message.GetRuleLeaseResponse{Owner: true, Host: "127.0.0.1:8086"})
+	err := callback.Handle(msg)
+	assert.NoError(t, err)
+	assert.True(t, callback.Owner)
+	assert.Equal(t, "127.0.0.1:8086", callback.Host)
+
+	// wrong message
+	badMsg := message.NewMetaMessage(message.UnknownMessage, &message.PingResponse{})
+	err = callback.Handle(badMsg)
+	assert.EqualError(t, err, "data is not a GetRuleLeaseResponse, got type *message.PingResponse")
+
+	// wrong message
+	badMsg2 := message.NewMetaMessage(message.GetRuleLeaseResponseMessage, &message.GetRuleLeaseResponse{Err: "mock error"})
+	err = callback.Handle(badMsg2)
+	assert.EqualError(t, err, "get rule lease callback error: mock error")
+}
+
 func TestVerifyDataNodeStatusCallbackResponse(t *testing.T) {
 	callback := &metaclient.VerifyDataNodeStatusCallback{}
 	msg := message.NewMetaMessage(message.VerifyDataNodeStatusResponseMessage, &message.VerifyDataNodeStatusResponse{})
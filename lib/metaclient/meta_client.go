@@ -186,6 +186,15 @@ type MetaClient interface {
 	UserPrivilege(username, database string) (*originql.Privilege, error)
 	UserPrivileges(username string) (map[string]originql.Privilege, error)
 	Users() []meta2.UserInfo
+	CreateRole(name string) error
+	DropRole(name string) error
+	Roles() []meta2.RoleInfo
+	SetMeasurementPrivilege(name, database, measurement string, p originql.Privilege, deny bool) error
+	RevokePrivilege(name, database, measurement string, p originql.Privilege) error
+	SetUserRole(username, role string, revoke bool) error
+	UpdatePromMetadata(database, policy string, metadata []meta2.PromMetricMetadata) error
+	PromMetadata(database, policy, mst string) ([]meta2.PromMetricMetadata, error)
+	Grants(name string) ([]meta2.Grant, error)
 	MarkDatabaseDelete(name string) error
 	MarkRetentionPolicyDelete(database, name string) error
 	MarkMeasurementDelete(database, policy, measurement string) error
@@ -387,6 +396,12 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_UpdateUserCommand:                applyUpdateUser,
 	proto2.Command_SetPrivilegeCommand:              applySetPrivilege,
 	proto2.Command_SetAdminPrivilegeCommand:         applySetAdminPrivilege,
+	proto2.Command_CreateRoleCommand:                applyCreateRole,
+	proto2.Command_DropRoleCommand:                  applyDropRole,
+	proto2.Command_SetMeasurementPrivilegeCommand:   applySetMeasurementPrivilege,
+	proto2.Command_RevokePrivilegeCommand:           applyRevokePrivilege,
+	proto2.Command_SetUserRoleCommand:               applySetUserRole,
+	proto2.Command_UpdatePromMetadataCommand:        applyUpdatePromMetadata,
 	proto2.Command_SetDataCommand:                   applySetData,
 	proto2.Command_CreateMetaNodeCommand:            applyCreateMetaNode,
 	proto2.Command_DeleteMetaNodeCommand:            applyDeleteMetaNode,
@@ -2131,6 +2146,128 @@ func (c *Client) SetAdminPrivilege(username string, admin bool) error {
 	)
 }
 
+// CreateRole creates a role with the given name.
+func (c *Client) CreateRole(name string) error {
+	return c.retryUntilExec(proto2.Command_CreateRoleCommand, proto2.E_CreateRoleCommand_Command,
+		&proto2.CreateRoleCommand{
+			Name: proto.String(name),
+		},
+	)
+}
+
+// DropRole removes the role with the given name.
+func (c *Client) DropRole(name string) error {
+	return c.retryUntilExec(proto2.Command_DropRoleCommand, proto2.E_DropRoleCommand_Command,
+		&proto2.DropRoleCommand{
+			Name: proto.String(name),
+		},
+	)
+}
+
+// Roles returns a list of all roles sorted by name.
+func (c *Client) Roles() []meta2.RoleInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	roles := make([]meta2.RoleInfo, 0, len(c.cacheData.Roles))
+	for _, role := range c.cacheData.Roles {
+		roles = append(roles, *role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return roles
+}
+
+// SetMeasurementPrivilege grants or denies a privilege for the given user or role on the measurement.
+// The empty measurement means the whole database.
+func (c *Client) SetMeasurementPrivilege(name, database, measurement string, p originql.Privilege, deny bool) error {
+	return c.retryUntilExec(proto2.Command_SetMeasurementPrivilegeCommand, proto2.E_SetMeasurementPrivilegeCommand_Command,
+		&proto2.SetMeasurementPrivilegeCommand{
+			Name:        proto.String(name),
+			Database:    proto.String(database),
+			Measurement: proto.String(measurement),
+			Privilege:   proto.Int32(int32(p)),
+			Deny:        proto.Bool(deny),
+		},
+	)
+}
+
+// RevokePrivilege revokes the granted and denied privilege for the given user or role on the database or measurement.
+func (c *Client) RevokePrivilege(name, database, measurement string, p originql.Privilege) error {
+	return c.retryUntilExec(proto2.Command_RevokePrivilegeCommand, proto2.E_RevokePrivilegeCommand_Command,
+		&proto2.RevokePrivilegeCommand{
+			Name:        proto.String(name),
+			Database:    proto.String(database),
+			Measurement: proto.String(measurement),
+			Privilege:   proto.Int32(int32(p)),
+		},
+	)
+}
+
+// SetUserRole assigns the role to the given user, or unassigns it if revoke is true.
+func (c *Client) SetUserRole(username, role string, revoke bool) error {
+	return c.retryUntilExec(proto2.Command_SetUserRoleCommand, proto2.E_SetUserRoleCommand_Command,
+		&proto2.SetUserRoleCommand{
+			Username: proto.String(username),
+			Role:     proto.String(role),
+			Revoke:   proto.Bool(revoke),
+		},
+	)
+}
+
+// UpdatePromMetadata stores the Prometheus metric metadata received by the remote write.
+// Only the metadata which is new or changed is sent to the meta service, the new metadata beyond
+// the limit of the retention policy is dropped and ErrPromMetadataExceeded is returned.
+func (c *Client) UpdatePromMetadata(database, policy string, metadata []meta2.PromMetricMetadata) error {
+	c.mu.RLock()
+	rpi, err := c.cacheData.RetentionPolicy(database, policy)
+	if err != nil {
+		c.mu.RUnlock()
+		return err
+	}
+	changed, dropped := rpi.PromMetadataToUpdate(metadata)
+	c.mu.RUnlock()
+
+	if len(changed) > 0 {
+		pb := make([]*proto2.PromMetricMetadata, len(changed))
+		for i := range changed {
+			pb[i] = changed[i].Marshal()
+		}
+		err = c.retryUntilExec(proto2.Command_UpdatePromMetadataCommand, proto2.E_UpdatePromMetadataCommand_Command,
+			&proto2.UpdatePromMetadataCommand{
+				Database:        proto.String(database),
+				RetentionPolicy: proto.String(policy),
+				Metadata:        pb,
+			},
+		)
+	}
+	if err == nil && dropped > 0 {
+		err = meta2.ErrPromMetadataExceeded
+	}
+	return err
+}
+
+// PromMetadata returns the Prometheus metric metadata of the metric store.
+func (c *Client) PromMetadata(database, policy, mst string) ([]meta2.PromMetricMetadata, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	rpi, err := c.cacheData.RetentionPolicy(database, policy)
+	if err != nil {
+		return nil, err
+	}
+	return rpi.GetPromMetadata(mst), nil
+}
+
+// Grants returns the privileges and deny rules of the given user or role.
+func (c *Client) Grants(name string) ([]meta2.Grant, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.cacheData.Grants(name)
+}
+
 // UserPrivileges returns the privileges for a user mapped by database name.
 func (c *Client) UserPrivileges(username string) (map[string]originql.Privilege, error) {
 	c.mu.RLock()
@@ -3447,6 +3584,30 @@ func applySetAdminPrivilege(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplySetAdminPrivilege(c.cacheData, cmd)
 }
 
+func applyCreateRole(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyCreateRole(c.cacheData, cmd)
+}
+
+func applyDropRole(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDropRole(c.cacheData, cmd)
+}
+
+func applySetMeasurementPrivilege(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplySetMeasurementPrivilege(c.cacheData, cmd)
+}
+
+func applyRevokePrivilege(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyRevokePrivilege(c.cacheData, cmd)
+}
+
+func applySetUserRole(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplySetUserRole(c.cacheData, cmd)
+}
+
+func applyUpdatePromMetadata(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyUpdatePromMetadata(c.cacheData, cmd)
+}
+
 func applySetData(c *Client, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_SetDataCommand_Command)
 	v, ok := ext.(*proto2.SetDataCommand)
@@ -2394,6 +2394,12 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_UpdateUserCommand:                newUpdateUserPb,
 	proto2.Command_SetPrivilegeCommand:              newSetPrivilegePb,
 	proto2.Command_SetAdminPrivilegeCommand:         newSetAdminPrivilegePb,
+	proto2.Command_CreateRoleCommand:                newCreateRolePb,
+	proto2.Command_DropRoleCommand:                  newDropRolePb,
+	proto2.Command_SetMeasurementPrivilegeCommand:   newSetMeasurementPrivilegePb,
+	proto2.Command_RevokePrivilegeCommand:           newRevokePrivilegePb,
+	proto2.Command_SetUserRoleCommand:               newSetUserRolePb,
+	proto2.Command_UpdatePromMetadataCommand:        newUpdatePromMetadataPb,
 	proto2.Command_SetDataCommand:                   newSetDataPb,
 	proto2.Command_CreateMetaNodeCommand:            newCreateMetaNodePb,
 	proto2.Command_DeleteMetaNodeCommand:            newDeleteMetaNodePb,
@@ -2540,6 +2546,51 @@ func newSetAdminPrivilegePb() (interface{}, *proto.ExtensionDesc) {
 	}, proto2.E_SetAdminPrivilegeCommand_Command
 }
 
+func newCreateRolePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.CreateRoleCommand{
+		Name: proto.String("role0"),
+	}, proto2.E_CreateRoleCommand_Command
+}
+
+func newDropRolePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DropRoleCommand{
+		Name: proto.String("role0"),
+	}, proto2.E_DropRoleCommand_Command
+}
+
+func newSetMeasurementPrivilegePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.SetMeasurementPrivilegeCommand{
+		Name:     proto.String("role0"),
+		Database: proto.String("ds"),
+	}, proto2.E_SetMeasurementPrivilegeCommand_Command
+}
+
+func newRevokePrivilegePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.RevokePrivilegeCommand{
+		Name:     proto.String("role0"),
+		Database: proto.String("ds"),
+	}, proto2.E_RevokePrivilegeCommand_Command
+}
+
+func newSetUserRolePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.SetUserRoleCommand{
+		Username: proto.String("use0"),
+		Role:     proto.String("role0"),
+	}, proto2.E_SetUserRoleCommand_Command
+}
+
+func newUpdatePromMetadataPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.UpdatePromMetadataCommand{
+		Database:        proto.String("ds"),
+		RetentionPolicy: proto.String("ds"),
+		Metadata: []*proto2.PromMetricMetadata{{
+			MetricStore:      proto.String("prom"),
+			MetricFamilyName: proto.String("http_requests_total"),
+			Type:             proto.String("counter"),
+		}},
+	}, proto2.E_UpdatePromMetadataCommand_Command
+}
+
 func newSetDataPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.SetDataCommand{
 		Data: &proto2.Data{
@@ -133,6 +133,49 @@ func (c *Client) getCQLease(currentServer int, host string) ([]string, error) {
 	return callback.CQNames, err
 }
 
+// GetRuleLease returns the sql host holding the lease of the prometheus rules, the lease is renewed by every call
+// of the owner
+func (c *Client) GetRuleLease(host string) (string, error) {
+	startTime := time.Now()
+	currentServer := connectedServer
+	var err error
+	var owner string
+	for {
+		c.mu.RLock()
+		select {
+		case <-c.closing:
+			c.mu.RUnlock()
+			return "", nil
+		default:
+		}
+
+		if currentServer >= len(c.metaServers) {
+			currentServer = 0
+		}
+		c.mu.RUnlock()
+		owner, err = c.getRuleLease(currentServer, host)
+		if err == nil {
+			break
+		}
+
+		c.logger.Debug("get rule lease failed", zap.String("sql host", host), zap.Error(err), zap.Duration("duration", time.Since(startTime)))
+		if time.Since(startTime).Seconds() > float64(len(c.metaServers))*HttpReqTimeout.Seconds() {
+			break
+		}
+		time.Sleep(errSleep)
+
+		currentServer++
+	}
+	return owner, err
+}
+
+func (c *Client) getRuleLease(currentServer int, host string) (string, error) {
+	callback := &GetRuleLeaseCallback{}
+	msg := message.NewMetaMessage(message.GetRuleLeaseRequestMessage, &message.GetRuleLeaseRequest{Host: host})
+	err := c.SendRPCMsg(currentServer, msg, callback)
+	return callback.Host, err
+}
+
 // BatchUpdateContinuousQueryStat reports all continuous queries state
 func (c *Client) BatchUpdateContinuousQueryStat(cqStats map[string]int64) error {
 	cmd := &proto2.ContinuousQueryReportCommand{}
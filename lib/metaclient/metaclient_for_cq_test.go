@@ -184,6 +184,31 @@ func TestClient_GetCqLease(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestClient_GetRuleLease(t *testing.T) {
+	defer func() {
+		connectedServer = 0
+	}()
+	c := &Client{
+		changed: make(chan chan struct{}, 1),
+		logger:  logger.NewLogger(errno.ModuleUnknown).SetZapLogger(zap.NewNop()),
+		closing: make(chan struct{}),
+	}
+	c.SendRPCMessage = &mockRPCMessageSender{}
+	_, err := c.GetRuleLease("127.0.0.1:8086")
+	assert.EqualError(t, err, "mock error")
+
+	// SetMetaServers
+	c.SetMetaServers([]string{"127.0.0.1:8088", "127.0.0.2:8088", "127.0.0.3:8088"})
+	_, err = c.GetRuleLease("127.0.0.1:8086")
+	assert.NoError(t, err)
+
+	// close
+	close(c.closing)
+	owner, err := c.GetRuleLease("127.0.0.1:8086")
+	assert.NoError(t, err)
+	assert.Equal(t, "", owner)
+}
+
 func TestClient_BatchUpdateContinuousQueryStat(t *testing.T) {
 	defer func() {
 		connectedServer = 0
@@ -22,6 +22,7 @@ import (
 
 	"github.com/openGemini/openGemini/app/ts-meta/meta/message"
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	netdata "github.com/openGemini/openGemini/lib/netstorage/data"
@@ -551,3 +552,39 @@ func Test_RaftMsgMessage_Unmarshal(t *testing.T) {
 	assert.Equal(t, "unknown message type: 97", err.Error())
 	req.Instance()
 }
+
+func TestStatisticsRequest_Marshal_Unmarshal(t *testing.T) {
+	req := &netstorage.StatisticsRequest{
+		Db:          "db0",
+		PtID:        2,
+		ShardIDs:    []uint64{1, 3},
+		Measurement: "cpu_0000",
+		TagKeys:     []string{"host", "region"},
+	}
+	buf, err := req.MarshalBinary()
+	require.NoError(t, err)
+	req2 := &netstorage.StatisticsRequest{}
+	require.NoError(t, req2.UnmarshalBinary(buf))
+	assert.Equal(t, req, req2)
+	assert.Error(t, req2.UnmarshalBinary(nil))
+}
+
+func TestStatisticsResponse_Marshal_Unmarshal(t *testing.T) {
+	stats := optimizer.NewStatistics()
+	stats.SeriesN = 10
+	stats.TagValueN["host"] = 5
+	resp := &netstorage.StatisticsResponse{Statistics: map[uint64]*optimizer.Statistics{1: stats}}
+	buf, err := resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 := &netstorage.StatisticsResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	assert.Equal(t, resp.Statistics, resp2.Statistics)
+	require.NoError(t, resp2.Error())
+
+	resp = &netstorage.StatisticsResponse{Err: netstorage.MarshalError(errno.NewError(errno.ErrShardClosed, 1))}
+	buf, err = resp.MarshalBinary()
+	require.NoError(t, err)
+	resp2 = &netstorage.StatisticsResponse{}
+	require.NoError(t, resp2.UnmarshalBinary(buf))
+	assert.True(t, errno.Equal(resp2.Error(), errno.ErrShardClosed))
+}
@@ -60,6 +60,9 @@ const (
 
 	RaftMessagesRequestMessage
 	RaftMessagesResponseMessage
+
+	StatisticsRequestMessage
+	StatisticsResponseMessage
 )
 
 var MessageBinaryCodec = make(map[uint8]func() codec.BinaryCodec, 20)
@@ -92,6 +95,8 @@ func init() {
 	MessageBinaryCodec[ShowTagKeysResponseMessage] = func() codec.BinaryCodec { return &ShowTagKeysResponse{} }
 	MessageBinaryCodec[RaftMessagesRequestMessage] = func() codec.BinaryCodec { return &RaftMessagesRequest{} }
 	MessageBinaryCodec[RaftMessagesResponseMessage] = func() codec.BinaryCodec { return &RaftMessagesResponse{} }
+	MessageBinaryCodec[StatisticsRequestMessage] = func() codec.BinaryCodec { return &StatisticsRequest{} }
+	MessageBinaryCodec[StatisticsResponseMessage] = func() codec.BinaryCodec { return &StatisticsResponse{} }
 
 	MessageResponseTyp = map[uint8]uint8{
 		SeriesKeysRequestMessage:               SeriesKeysResponseMessage,
@@ -106,5 +111,6 @@ func init() {
 		KillQueryRequestMessage:                KillQueryResponseMessage,
 		ShowTagKeysRequestMessage:              ShowTagKeysResponseMessage,
 		RaftMessagesRequestMessage:             RaftMessagesResponseMessage,
+		StatisticsRequestMessage:               StatisticsResponseMessage,
 	}
 }
@@ -42,6 +42,7 @@ func TestMessageTypes(t *testing.T) {
 		store.ShowQueriesRequestMessage:              {&store.ShowQueriesRequest{}, &store.ShowQueriesResponse{}},
 		store.KillQueryRequestMessage:                {&store.KillQueryRequest{}, &store.KillQueryResponse{}},
 		store.ShowTagKeysRequestMessage:              {&store.ShowTagKeysRequest{}, &store.ShowTagKeysResponse{}},
+		store.StatisticsRequestMessage:               {&store.StatisticsRequest{}, &store.StatisticsResponse{}},
 	}
 
 	for typ, items := range data {
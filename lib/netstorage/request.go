@@ -20,6 +20,8 @@ import (
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/bytesutil"
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
 	"github.com/cockroachdb/errors"
+	"github.com/openGemini/openGemini/engine/optimizer"
+	"github.com/openGemini/openGemini/lib/codec"
 	"github.com/openGemini/openGemini/lib/errno"
 	internal2 "github.com/openGemini/openGemini/lib/netstorage/data"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -545,3 +547,82 @@ func (r *RaftMessagesResponse) MarshalBinary() ([]byte, error) {
 func (r *RaftMessagesResponse) UnmarshalBinary(buf []byte) error {
 	return proto.Unmarshal(buf, &r.RaftMessagesResponse)
 }
+
+type StatisticsRequest struct {
+	Db          string
+	PtID        uint32
+	ShardIDs    []uint64
+	Measurement string
+	TagKeys     []string
+}
+
+func (r *StatisticsRequest) MarshalBinary() ([]byte, error) {
+	var dst []byte
+	dst = codec.AppendString(dst, r.Db)
+	dst = codec.AppendUint32(dst, r.PtID)
+	dst = codec.AppendUint64Slice(dst, r.ShardIDs)
+	dst = codec.AppendString(dst, r.Measurement)
+	dst = codec.AppendStringSlice(dst, r.TagKeys)
+	return dst, nil
+}
+
+func (r *StatisticsRequest) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return errors.New("empty statistics request")
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	r.Db = dec.String()
+	r.PtID = dec.Uint32()
+	r.ShardIDs = dec.Uint64Slice()
+	r.Measurement = dec.String()
+	r.TagKeys = dec.StringSlice()
+	return nil
+}
+
+type StatisticsResponse struct {
+	Statistics map[uint64]*optimizer.Statistics
+	Err        *string
+}
+
+func (r *StatisticsResponse) MarshalBinary() ([]byte, error) {
+	var dst []byte
+	dst = codec.AppendBool(dst, r.Err != nil)
+	if r.Err != nil {
+		return codec.AppendBytes(dst, []byte(*r.Err)), nil
+	}
+
+	dst = codec.AppendUint32(dst, uint32(len(r.Statistics)))
+	for id, stats := range r.Statistics {
+		dst = codec.AppendUint64(dst, id)
+		dst = codec.AppendUint32(dst, uint32(stats.Size()))
+		dst = stats.Marshal(dst)
+	}
+	return dst, nil
+}
+
+func (r *StatisticsResponse) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return errors.New("empty statistics response")
+	}
+	dec := codec.NewBinaryDecoder(buf)
+	if dec.Bool() {
+		r.Err = proto.String(string(dec.Bytes()))
+		return nil
+	}
+
+	n := int(dec.Uint32())
+	r.Statistics = make(map[uint64]*optimizer.Statistics, n)
+	for i := 0; i < n; i++ {
+		id := dec.Uint64()
+		stats := &optimizer.Statistics{}
+		if err := stats.Unmarshal(dec.BytesNoCopy()); err != nil {
+			return err
+		}
+		r.Statistics[id] = stats
+	}
+	return nil
+}
+
+func (r *StatisticsResponse) Error() error {
+	return NormalizeError(r.Err)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/executor/spdy"
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
+	"github.com/openGemini/openGemini/engine/optimizer"
 	"github.com/openGemini/openGemini/lib/codec"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -54,6 +55,7 @@ type Storage interface {
 	TagValuesCardinality(nodeID uint64, db string, ptIDs []uint32, tagKeys map[string]map[string]struct{}, cond influxql.Expr) (map[string]uint64, error)
 
 	ShowTagKeys(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr) ([]string, error)
+	CollectStatistics(nodeID uint64, db string, ptID uint32, shardIDs []uint64, mst string, tagKeys []string) (map[uint64]*optimizer.Statistics, error)
 
 	ShowSeries(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr) ([]string, error)
 	SeriesCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error)
@@ -360,6 +362,28 @@ func (s *NetStorage) ShowTagKeys(nodeID uint64, db string, ptIDs []uint32, measu
 	return resp.TagKeys, resp.Error()
 }
 
+func (s *NetStorage) CollectStatistics(nodeID uint64, db string, ptID uint32, shardIDs []uint64, mst string, tagKeys []string) (map[uint64]*optimizer.Statistics, error) {
+	req := &StatisticsRequest{
+		Db:          db,
+		PtID:        ptID,
+		ShardIDs:    shardIDs,
+		Measurement: mst,
+		TagKeys:     tagKeys,
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, StatisticsRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*StatisticsResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.StatisticsResponse", v)
+	}
+
+	return resp.Statistics, resp.Error()
+}
+
 func (s *NetStorage) ShowSeries(nodeID uint64, db string, ptIDs []uint32, measurements []string, condition influxql.Expr) ([]string, error) {
 	req := &SeriesKeysRequest{}
 	req.Db = proto.String(db)
@@ -0,0 +1,591 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb-observability/common"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	semconv "go.opentelemetry.io/collector/semconv/v1.16.0"
+)
+
+const (
+	JaegerDefaultLimit    = 20
+	JaegerDefaultLookback = time.Hour
+	// JaegerDefaultTraceLookback is the time range the trace is looked up in if it is not specified by start and end
+	JaegerDefaultTraceLookback = 24 * time.Hour
+
+	// the span kinds are stored as the names of the OTLP enum
+	spanKindPrefix = "SPAN_KIND_"
+
+	jaegerRefChildOf    = "CHILD_OF"
+	jaegerTagSpanKind   = "span.kind"
+	jaegerTagTraceState = "w3c.tracestate"
+	jaegerTagError      = "error"
+	jaegerTagEvent      = "event"
+
+	jaegerWarnInvalidParent = "invalid parent span IDs=%s; skipping clock skew adjustment"
+)
+
+// JaegerSpanKinds are the span kinds supported by the Jaeger query API, the empty one means unspecified.
+var JaegerSpanKinds = []string{"server", "client", "producer", "consumer", "internal", ""}
+
+// spanTagKeys are the tags describing the span itself, the others are the resource attributes of the process.
+var spanTagKeys = map[string]struct{}{
+	common.AttributeTraceID:                       {},
+	common.AttributeSpanID:                        {},
+	common.AttributeParentSpanID:                  {},
+	common.AttributeTraceState:                    {},
+	common.AttributeName:                          {},
+	common.AttributeSpanKind:                      {},
+	common.AttributeInstrumentationLibraryName:    {},
+	common.AttributeInstrumentationLibraryVersion: {},
+}
+
+// JaegerResponse is the response envelope of the Jaeger query API.
+type JaegerResponse struct {
+	Data   interface{}   `json:"data"`
+	Total  int           `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+	Errors []JaegerError `json:"errors"`
+}
+
+type JaegerError struct {
+	Code    int    `json:"code,omitempty"`
+	Msg     string `json:"msg"`
+	TraceID string `json:"traceID,omitempty"`
+}
+
+type JaegerOperation struct {
+	Name     string `json:"name"`
+	SpanKind string `json:"spanKind"`
+}
+
+type JaegerTrace struct {
+	TraceID   string                    `json:"traceID"`
+	Spans     []*JaegerSpan             `json:"spans"`
+	Processes map[string]*JaegerProcess `json:"processes"`
+	Warnings  []string                  `json:"warnings"`
+}
+
+type JaegerSpan struct {
+	TraceID       string            `json:"traceID"`
+	SpanID        string            `json:"spanID"`
+	Flags         uint32            `json:"flags,omitempty"`
+	OperationName string            `json:"operationName"`
+	References    []JaegerReference `json:"references"`
+	StartTime     int64             `json:"startTime"` // microseconds since epoch
+	Duration      int64             `json:"duration"`  // microseconds
+	Tags          []JaegerKeyValue  `json:"tags"`
+	Logs          []JaegerLog       `json:"logs"`
+	ProcessID     string            `json:"processID"`
+	Warnings      []string          `json:"warnings"`
+
+	parentSpanID string
+}
+
+type JaegerReference struct {
+	RefType string `json:"refType"`
+	TraceID string `json:"traceID"`
+	SpanID  string `json:"spanID"`
+}
+
+type JaegerKeyValue struct {
+	Key   string      `json:"key"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type JaegerLog struct {
+	Timestamp int64            `json:"timestamp"`
+	Fields    []JaegerKeyValue `json:"fields"`
+}
+
+type JaegerProcess struct {
+	ServiceName string           `json:"serviceName"`
+	Tags        []JaegerKeyValue `json:"tags"`
+}
+
+// TraceQueryParameters are the conditions of the trace search.
+type TraceQueryParameters struct {
+	ServiceName   string
+	OperationName string
+	Tags          map[string]string
+	StartTimeMin  time.Time
+	StartTimeMax  time.Time
+	DurationMin   time.Duration
+	DurationMax   time.Duration
+	NumTraces     int
+}
+
+// NormalizeTraceID converts a Jaeger trace ID to the 32 hex characters trace ID stored by OTLP.
+func NormalizeTraceID(id string) (string, error) {
+	id = strings.ToLower(id)
+	if len(id) == 0 || len(id) > 32 {
+		return "", fmt.Errorf("invalid trace ID %q", id)
+	}
+	for _, c := range id {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return "", fmt.Errorf("invalid trace ID %q", id)
+		}
+	}
+	return strings.Repeat("0", 32-len(id)) + id, nil
+}
+
+// ServicesQuery returns the statement listing the services of the stored spans.
+func ServicesQuery() string {
+	return fmt.Sprintf("SHOW TAG VALUES FROM %s WITH KEY = %s",
+		influxql.QuoteIdent(common.MeasurementSpans), influxql.QuoteIdent(semconv.AttributeServiceName))
+}
+
+// OperationsQuery returns one statement per span kind listing the operations of the service.
+// All span kinds in JaegerSpanKinds are listed if spanKind is empty.
+func OperationsQuery(service, spanKind string) string {
+	kinds := JaegerSpanKinds
+	if spanKind != "" {
+		kinds = []string{spanKind}
+	}
+	stmts := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		stmts = append(stmts, fmt.Sprintf("SHOW TAG VALUES FROM %s WITH KEY = %s WHERE %s = %s AND %s = %s",
+			influxql.QuoteIdent(common.MeasurementSpans), influxql.QuoteIdent(common.AttributeName),
+			influxql.QuoteIdent(semconv.AttributeServiceName), influxql.QuoteString(service),
+			influxql.QuoteIdent(common.AttributeSpanKind), influxql.QuoteString(toOtelSpanKind(kind))))
+	}
+	return strings.Join(stmts, "; ")
+}
+
+// OperationsFromRows converts the results of OperationsQuery, which are in the same order of the span kinds.
+func OperationsFromRows(results []models.Rows, spanKind string) []JaegerOperation {
+	kinds := JaegerSpanKinds
+	if spanKind != "" {
+		kinds = []string{spanKind}
+	}
+	ops := make([]JaegerOperation, 0)
+	for i, rows := range results {
+		if i >= len(kinds) {
+			break
+		}
+		for _, name := range TagValuesFromRows(rows) {
+			ops = append(ops, JaegerOperation{Name: name, SpanKind: kinds[i]})
+		}
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].Name < ops[j].Name
+	})
+	return ops
+}
+
+// TagValuesFromRows returns the sorted distinct values of the SHOW TAG VALUES results.
+func TagValuesFromRows(rows models.Rows) []string {
+	seen := make(map[string]struct{})
+	values := make([]string, 0)
+	for _, row := range rows {
+		idx := columnIndex(row.Columns, "value")
+		if idx < 0 {
+			continue
+		}
+		for _, v := range row.Values {
+			s, ok := v[idx].(string)
+			if !ok {
+				continue
+			}
+			if _, ok = seen[s]; ok {
+				continue
+			}
+			seen[s] = struct{}{}
+			values = append(values, s)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// FindTraceIDsQuery returns the statement finding the latest NumTraces traces which have the spans matching
+// the parameters. The spans are aggregated per trace ID, each trace is ordered by the latest end time of its spans.
+func FindTraceIDsQuery(p *TraceQueryParameters) string {
+	conds := []string{
+		fmt.Sprintf("time >= %d", p.StartTimeMin.UnixNano()),
+		fmt.Sprintf("time <= %d", p.StartTimeMax.UnixNano()),
+		fmt.Sprintf("%s = %s", influxql.QuoteIdent(semconv.AttributeServiceName), influxql.QuoteString(p.ServiceName)),
+	}
+	if p.OperationName != "" {
+		conds = append(conds, fmt.Sprintf("%s = %s", influxql.QuoteIdent(common.AttributeName), influxql.QuoteString(p.OperationName)))
+	}
+
+	keys := make([]string, 0, len(p.Tags))
+	for k := range p.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		conds = append(conds, fmt.Sprintf("%s = %s", influxql.QuoteIdent(k), influxql.QuoteString(p.Tags[k])))
+	}
+
+	if p.DurationMin > 0 {
+		conds = append(conds, fmt.Sprintf("%s >= %d", influxql.QuoteIdent(common.AttributeDurationNano), p.DurationMin.Nanoseconds()))
+	}
+	if p.DurationMax > 0 {
+		conds = append(conds, fmt.Sprintf("%s <= %d", influxql.QuoteIdent(common.AttributeDurationNano), p.DurationMax.Nanoseconds()))
+	}
+
+	limit := p.NumTraces
+	if limit <= 0 {
+		limit = JaegerDefaultLimit
+	}
+	return fmt.Sprintf("SELECT top(max, %s, %d) FROM (SELECT max(%s) FROM %s WHERE %s GROUP BY %s)",
+		influxql.QuoteIdent(common.AttributeTraceID), limit,
+		influxql.QuoteIdent(common.AttributeEndTimeUnixNano), influxql.QuoteIdent(common.MeasurementSpans),
+		strings.Join(conds, " AND "), influxql.QuoteIdent(common.AttributeTraceID))
+}
+
+// TraceIDsFromRows returns the trace IDs of the FindTraceIDsQuery results, the latest first.
+func TraceIDsFromRows(rows models.Rows, limit int) []string {
+	latest := make(map[string]int64)
+	for _, row := range rows {
+		idIdx, endIdx := columnIndex(row.Columns, common.AttributeTraceID), columnIndex(row.Columns, "top")
+		if idIdx < 0 || endIdx < 0 {
+			continue
+		}
+		for _, v := range row.Values {
+			id, ok := v[idIdx].(string)
+			if !ok || id == "" {
+				continue
+			}
+			if ts, ok := timeValue(v[endIdx]); ok && ts > latest[id] {
+				latest[id] = ts
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(latest))
+	for id := range latest {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if latest[ids[i]] != latest[ids[j]] {
+			return latest[ids[i]] > latest[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+// TracesQuery returns two statements, which query the spans and the span events of the traces between
+// startTimeMin and startTimeMax respectively.
+func TracesQuery(traceIDs []string, startTimeMin, startTimeMax time.Time) string {
+	conds := make([]string, 0, len(traceIDs))
+	for _, id := range traceIDs {
+		conds = append(conds, fmt.Sprintf("%s = %s", influxql.QuoteIdent(common.AttributeTraceID), influxql.QuoteString(id)))
+	}
+	cond := fmt.Sprintf("time >= %d AND time <= %d AND (%s)",
+		startTimeMin.UnixNano(), startTimeMax.UnixNano(), strings.Join(conds, " OR "))
+	return fmt.Sprintf("SELECT * FROM %s WHERE %s GROUP BY *; SELECT * FROM %s WHERE %s GROUP BY *",
+		influxql.QuoteIdent(common.MeasurementSpans), cond, influxql.QuoteIdent(common.MeasurementLogs), cond)
+}
+
+// RowsToTraces rebuilds the traces from the results of TracesQuery, in the order of traceIDs.
+func RowsToTraces(traceIDs []string, spanRows, eventRows models.Rows) []*JaegerTrace {
+	builders := make(map[string]*traceBuilder, len(traceIDs))
+	for _, id := range traceIDs {
+		builders[id] = newTraceBuilder(id)
+	}
+
+	for _, row := range spanRows {
+		tb, ok := builders[row.Tags[common.AttributeTraceID]]
+		if !ok {
+			continue
+		}
+		for _, values := range row.Values {
+			tb.addSpan(row.Tags, row.Columns, values)
+		}
+	}
+	for _, row := range eventRows {
+		tb, ok := builders[row.Tags[common.AttributeTraceID]]
+		if !ok {
+			continue
+		}
+		for _, values := range row.Values {
+			tb.addLog(row.Tags, row.Columns, values)
+		}
+	}
+
+	traces := make([]*JaegerTrace, 0, len(traceIDs))
+	for _, id := range traceIDs {
+		if trace := builders[id].build(); trace != nil {
+			traces = append(traces, trace)
+		}
+	}
+	return traces
+}
+
+type traceBuilder struct {
+	trace      *JaegerTrace
+	spans      map[string]*JaegerSpan
+	processIDs map[string]string
+}
+
+func newTraceBuilder(traceID string) *traceBuilder {
+	return &traceBuilder{
+		trace: &JaegerTrace{
+			TraceID:   traceID,
+			Processes: make(map[string]*JaegerProcess),
+		},
+		spans:      make(map[string]*JaegerSpan),
+		processIDs: make(map[string]string),
+	}
+}
+
+func (tb *traceBuilder) addSpan(tags map[string]string, columns []string, values []interface{}) {
+	spanID := tags[common.AttributeSpanID]
+	if _, ok := tb.spans[spanID]; ok || spanID == "" {
+		return
+	}
+
+	span := &JaegerSpan{
+		TraceID:       tb.trace.TraceID,
+		SpanID:        spanID,
+		OperationName: tags[common.AttributeName],
+		References:    []JaegerReference{},
+		Logs:          []JaegerLog{},
+		parentSpanID:  tags[common.AttributeParentSpanID],
+	}
+	if span.parentSpanID != "" {
+		span.References = append(span.References, JaegerReference{
+			RefType: jaegerRefChildOf,
+			TraceID: tb.trace.TraceID,
+			SpanID:  span.parentSpanID,
+		})
+	}
+
+	var spanTags []JaegerKeyValue
+	for k, v := range tags {
+		switch k {
+		case common.AttributeSpanKind:
+			spanTags = append(spanTags, stringKeyValue(jaegerTagSpanKind, fromOtelSpanKind(v)))
+		case common.AttributeTraceState:
+			spanTags = append(spanTags, stringKeyValue(jaegerTagTraceState, v))
+		case common.AttributeInstrumentationLibraryName, common.AttributeInstrumentationLibraryVersion:
+			spanTags = append(spanTags, stringKeyValue(k, v))
+		}
+	}
+
+	for i, col := range columns {
+		v := values[i]
+		if v == nil {
+			continue
+		}
+		switch col {
+		case "time":
+			if ts, ok := timeValue(v); ok {
+				span.StartTime = ts / int64(time.Microsecond)
+			}
+		case common.AttributeDurationNano:
+			if d, ok := v.(int64); ok {
+				span.Duration = d / int64(time.Microsecond)
+			}
+		case common.AttributeEndTimeUnixNano:
+		case common.AttributeStatusCode:
+			spanTags = append(spanTags, toKeyValue(col, v))
+			if v == common.AttributeStatusCodeError {
+				spanTags = append(spanTags, JaegerKeyValue{Key: jaegerTagError, Type: "bool", Value: true})
+			}
+		default:
+			spanTags = append(spanTags, toKeyValue(col, v))
+		}
+	}
+	sortKeyValues(spanTags)
+	span.Tags = spanTags
+	if span.Tags == nil {
+		span.Tags = []JaegerKeyValue{}
+	}
+	span.ProcessID = tb.processID(tags)
+
+	tb.spans[spanID] = span
+}
+
+// processID returns the ID of the process described by the resource attributes, which are the tags
+// except the span tags.
+func (tb *traceBuilder) processID(tags map[string]string) string {
+	process := &JaegerProcess{
+		ServiceName: tags[semconv.AttributeServiceName],
+		Tags:        []JaegerKeyValue{},
+	}
+	for k, v := range tags {
+		if _, ok := spanTagKeys[k]; ok || k == semconv.AttributeServiceName {
+			continue
+		}
+		process.Tags = append(process.Tags, stringKeyValue(k, v))
+	}
+	sortKeyValues(process.Tags)
+
+	var sb strings.Builder
+	sb.WriteString(process.ServiceName)
+	for _, kv := range process.Tags {
+		sb.WriteByte(',')
+		sb.WriteString(kv.Key)
+		sb.WriteByte('=')
+		sb.WriteString(kv.Value.(string))
+	}
+	key := sb.String()
+	if id, ok := tb.processIDs[key]; ok {
+		return id
+	}
+	id := "p" + strconv.Itoa(len(tb.processIDs)+1)
+	tb.processIDs[key] = id
+	tb.trace.Processes[id] = process
+	return id
+}
+
+func (tb *traceBuilder) addLog(tags map[string]string, columns []string, values []interface{}) {
+	span, ok := tb.spans[tags[common.AttributeSpanID]]
+	if !ok {
+		return
+	}
+
+	log := JaegerLog{}
+	if name := tags[common.AttributeName]; name != "" {
+		log.Fields = append(log.Fields, stringKeyValue(jaegerTagEvent, name))
+	}
+	for i, col := range columns {
+		v := values[i]
+		if v == nil {
+			continue
+		}
+		if col == "time" {
+			if ts, ok := timeValue(v); ok {
+				log.Timestamp = ts / int64(time.Microsecond)
+			}
+			continue
+		}
+		log.Fields = append(log.Fields, toKeyValue(col, v))
+	}
+	span.Logs = append(span.Logs, log)
+}
+
+// build orders the spans as the depth-first traversal of the span tree, the spans whose parent
+// is missing are taken as the roots.
+func (tb *traceBuilder) build() *JaegerTrace {
+	if len(tb.spans) == 0 {
+		return nil
+	}
+
+	var roots []*JaegerSpan
+	children := make(map[string][]*JaegerSpan)
+	for _, span := range tb.spans {
+		sort.SliceStable(span.Logs, func(i, j int) bool {
+			return span.Logs[i].Timestamp < span.Logs[j].Timestamp
+		})
+		if span.parentSpanID == "" {
+			roots = append(roots, span)
+			continue
+		}
+		if _, ok := tb.spans[span.parentSpanID]; !ok {
+			span.Warnings = append(span.Warnings, fmt.Sprintf(jaegerWarnInvalidParent, span.parentSpanID))
+			roots = append(roots, span)
+			continue
+		}
+		children[span.parentSpanID] = append(children[span.parentSpanID], span)
+	}
+
+	spans := make([]*JaegerSpan, 0, len(tb.spans))
+	var walk func(level []*JaegerSpan)
+	walk = func(level []*JaegerSpan) {
+		sortSpans(level)
+		for _, span := range level {
+			spans = append(spans, span)
+			walk(children[span.SpanID])
+		}
+	}
+	walk(roots)
+
+	tb.trace.Spans = spans
+	return tb.trace
+}
+
+func sortSpans(spans []*JaegerSpan) {
+	sort.Slice(spans, func(i, j int) bool {
+		if spans[i].StartTime != spans[j].StartTime {
+			return spans[i].StartTime < spans[j].StartTime
+		}
+		return spans[i].SpanID < spans[j].SpanID
+	})
+}
+
+func sortKeyValues(kvs []JaegerKeyValue) {
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].Key < kvs[j].Key
+	})
+}
+
+func stringKeyValue(key, value string) JaegerKeyValue {
+	return JaegerKeyValue{Key: key, Type: "string", Value: value}
+}
+
+func toKeyValue(key string, v interface{}) JaegerKeyValue {
+	switch value := v.(type) {
+	case string:
+		return stringKeyValue(key, value)
+	case bool:
+		return JaegerKeyValue{Key: key, Type: "bool", Value: value}
+	case int64:
+		return JaegerKeyValue{Key: key, Type: "int64", Value: value}
+	case float64:
+		return JaegerKeyValue{Key: key, Type: "float64", Value: value}
+	default:
+		return stringKeyValue(key, fmt.Sprintf("%v", v))
+	}
+}
+
+func toOtelSpanKind(kind string) string {
+	if kind == "" {
+		return ""
+	}
+	return spanKindPrefix + strings.ToUpper(kind)
+}
+
+func fromOtelSpanKind(kind string) string {
+	return strings.ToLower(strings.TrimPrefix(kind, spanKindPrefix))
+}
+
+func columnIndex(columns []string, name string) int {
+	for i, col := range columns {
+		if col == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// timeValue returns the time of the result in nanoseconds.
+func timeValue(v interface{}) (int64, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UnixNano(), true
+	case int64:
+		return t, true
+	}
+	return 0, false
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/opentelemetry"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func parseStatements(t *testing.T, sql string) []influxql.Statement {
+	p := influxql.NewParser(strings.NewReader(sql))
+	defer p.Release()
+	yy := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yy.ParseTokens()
+	q, err := yy.GetQuery()
+	require.NoError(t, err, sql)
+	return q.Statements
+}
+
+func TestJaegerQueries(t *testing.T) {
+	require.Equal(t, `SHOW TAG VALUES FROM spans WITH KEY = "service.name"`, opentelemetry.ServicesQuery())
+	require.Equal(t, 1, len(parseStatements(t, opentelemetry.ServicesQuery())))
+
+	sql := opentelemetry.OperationsQuery("frontend", "server")
+	require.Equal(t, `SHOW TAG VALUES FROM spans WITH KEY = "name" WHERE "service.name" = 'frontend' AND kind = 'SPAN_KIND_SERVER'`, sql)
+	require.Equal(t, 1, len(parseStatements(t, sql)))
+	require.Equal(t, len(opentelemetry.JaegerSpanKinds), len(parseStatements(t, opentelemetry.OperationsQuery("it's", ""))))
+
+	params := &opentelemetry.TraceQueryParameters{
+		ServiceName:   "frontend",
+		OperationName: "GET /",
+		Tags:          map[string]string{"http.method": "GET", "error": "true"},
+		StartTimeMin:  time.Unix(100, 0),
+		StartTimeMax:  time.Unix(200, 0),
+		DurationMin:   time.Millisecond,
+		DurationMax:   time.Second,
+		NumTraces:     5,
+	}
+	sql = opentelemetry.FindTraceIDsQuery(params)
+	require.Equal(t, `SELECT top(max, trace_id, 5) FROM (SELECT max(end_time_unix_nano) FROM spans WHERE time >= 100000000000 AND time <= 200000000000`+
+		` AND "service.name" = 'frontend' AND "name" = 'GET /' AND error = 'true' AND "http.method" = 'GET' AND duration_nano >= 1000000`+
+		` AND duration_nano <= 1000000000 GROUP BY trace_id)`, sql)
+	require.Equal(t, 1, len(parseStatements(t, sql)))
+
+	params.NumTraces = 0
+	require.Contains(t, opentelemetry.FindTraceIDsQuery(params), "top(max, trace_id, 20)")
+
+	sql = opentelemetry.TracesQuery([]string{"0a", "0b"}, time.Unix(100, 0), time.Unix(200, 0))
+	require.Equal(t, `SELECT * FROM spans WHERE time >= 100000000000 AND time <= 200000000000 AND (trace_id = '0a' OR trace_id = '0b') GROUP BY *;`+
+		` SELECT * FROM logs WHERE time >= 100000000000 AND time <= 200000000000 AND (trace_id = '0a' OR trace_id = '0b') GROUP BY *`, sql)
+	require.Equal(t, 2, len(parseStatements(t, sql)))
+}
+
+func TestNormalizeTraceID(t *testing.T) {
+	id, err := opentelemetry.NormalizeTraceID("ABC")
+	require.NoError(t, err)
+	require.Equal(t, "00000000000000000000000000000abc", id)
+
+	_, err = opentelemetry.NormalizeTraceID("xyz")
+	require.Error(t, err)
+	_, err = opentelemetry.NormalizeTraceID(strings.Repeat("a", 33))
+	require.Error(t, err)
+}
+
+func TestOperationsAndTagValuesFromRows(t *testing.T) {
+	rows := func(values ...string) models.Rows {
+		row := &models.Row{Name: "spans", Columns: []string{"key", "value"}}
+		for _, v := range values {
+			row.Values = append(row.Values, []interface{}{"name", v})
+		}
+		return models.Rows{row}
+	}
+	require.Equal(t, []string{"a", "b"}, opentelemetry.TagValuesFromRows(rows("b", "a", "b")))
+
+	results := []models.Rows{rows("GET /"), rows("redis"), nil, nil, rows("work"), nil}
+	ops := opentelemetry.OperationsFromRows(results, "")
+	require.Equal(t, []opentelemetry.JaegerOperation{
+		{Name: "GET /", SpanKind: "server"},
+		{Name: "redis", SpanKind: "client"},
+		{Name: "work", SpanKind: "internal"},
+	}, ops)
+
+	ops = opentelemetry.OperationsFromRows([]models.Rows{rows("redis")}, "client")
+	require.Equal(t, []opentelemetry.JaegerOperation{{Name: "redis", SpanKind: "client"}}, ops)
+}
+
+func TestTraceIDsFromRows(t *testing.T) {
+	// the rows of top() are ordered by the time
+	rows := models.Rows{
+		{Name: "spans", Columns: []string{"time", "top", "trace_id"},
+			Values: [][]interface{}{
+				{time.Unix(1, 0), time.Unix(5, 0).UnixNano(), "a"},
+				{time.Unix(3, 0), time.Unix(3, 0).UnixNano(), "b"},
+				{time.Unix(8, 0), time.Unix(9, 0).UnixNano(), "c"},
+			}},
+	}
+	require.Equal(t, []string{"c", "a", "b"}, opentelemetry.TraceIDsFromRows(rows, 0))
+	require.Equal(t, []string{"c", "a"}, opentelemetry.TraceIDsFromRows(rows, 2))
+}
+
+func TestRowsToTraces(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	spanRow := func(spanID, parentID, name, kind, service string, offset time.Duration, fields map[string]interface{}) *models.Row {
+		tags := map[string]string{
+			"trace_id":     "t1",
+			"span_id":      spanID,
+			"name":         name,
+			"kind":         kind,
+			"service.name": service,
+			"host.name":    "host-" + service,
+		}
+		if parentID != "" {
+			tags["parent_span_id"] = parentID
+		}
+		columns := []string{"time", "duration_nano", "end_time_unix_nano"}
+		values := []interface{}{start.Add(offset), int64(2 * time.Millisecond), start.Add(offset).UnixNano() + int64(2*time.Millisecond)}
+		for k, v := range fields {
+			columns = append(columns, k)
+			values = append(values, v)
+		}
+		return &models.Row{Name: "spans", Tags: tags, Columns: columns, Values: [][]interface{}{values}}
+	}
+
+	spanRows := models.Rows{
+		spanRow("s3", "s2", "query", "SPAN_KIND_CLIENT", "backend", 3*time.Millisecond, map[string]interface{}{"db.statement": "select 1"}),
+		spanRow("s1", "", "GET /", "SPAN_KIND_SERVER", "frontend", 0, map[string]interface{}{"http.status_code": int64(500), "otel.status_code": "ERROR"}),
+		spanRow("s2", "s1", "call", "SPAN_KIND_CLIENT", "frontend", time.Millisecond, nil),
+		spanRow("s4", "s1", "render", "SPAN_KIND_INTERNAL", "frontend", 2*time.Millisecond, map[string]interface{}{"cached": nil}),
+		spanRow("s5", "missing", "orphan", "SPAN_KIND_INTERNAL", "backend", 10*time.Millisecond, nil),
+		spanRow("x1", "", "other", "SPAN_KIND_SERVER", "frontend", 0, nil),
+	}
+	spanRows[5].Tags["trace_id"] = "t2"
+
+	eventRows := models.Rows{
+		{Name: "logs", Tags: map[string]string{"trace_id": "t1", "span_id": "s1", "name": "exception"},
+			Columns: []string{"time", "exception.message"},
+			Values:  [][]interface{}{{start.Add(time.Millisecond), "boom"}}},
+		{Name: "logs", Tags: map[string]string{"trace_id": "t1", "span_id": "s9", "name": "lost"},
+			Columns: []string{"time", "count"},
+			Values:  [][]interface{}{{start, int64(1)}}},
+	}
+
+	traces := opentelemetry.RowsToTraces([]string{"t1", "t3"}, spanRows, eventRows)
+	require.Equal(t, 1, len(traces))
+	trace := traces[0]
+	require.Equal(t, "t1", trace.TraceID)
+
+	var order []string
+	for _, s := range trace.Spans {
+		order = append(order, s.SpanID)
+	}
+	require.Equal(t, []string{"s1", "s2", "s3", "s4", "s5"}, order)
+
+	root := trace.Spans[0]
+	require.Equal(t, "GET /", root.OperationName)
+	require.Equal(t, start.UnixMicro(), root.StartTime)
+	require.Equal(t, int64(2000), root.Duration)
+	require.Empty(t, root.References)
+	require.Equal(t, []opentelemetry.JaegerKeyValue{
+		{Key: "error", Type: "bool", Value: true},
+		{Key: "http.status_code", Type: "int64", Value: int64(500)},
+		{Key: "otel.status_code", Type: "string", Value: "ERROR"},
+		{Key: "span.kind", Type: "string", Value: "server"},
+	}, root.Tags)
+	require.Equal(t, []opentelemetry.JaegerLog{{
+		Timestamp: start.Add(time.Millisecond).UnixMicro(),
+		Fields: []opentelemetry.JaegerKeyValue{
+			{Key: "event", Type: "string", Value: "exception"},
+			{Key: "exception.message", Type: "string", Value: "boom"},
+		},
+	}}, root.Logs)
+
+	child := trace.Spans[2]
+	require.Equal(t, []opentelemetry.JaegerReference{{RefType: "CHILD_OF", TraceID: "t1", SpanID: "s2"}}, child.References)
+
+	orphan := trace.Spans[4]
+	require.Equal(t, []string{"invalid parent span IDs=missing; skipping clock skew adjustment"}, orphan.Warnings)
+
+	require.Equal(t, 2, len(trace.Processes))
+	require.Equal(t, root.ProcessID, trace.Spans[1].ProcessID)
+	require.NotEqual(t, root.ProcessID, child.ProcessID)
+	process := trace.Processes[child.ProcessID]
+	require.Equal(t, "backend", process.ServiceName)
+	require.Equal(t, []opentelemetry.JaegerKeyValue{{Key: "host.name", Type: "string", Value: "host-backend"}}, process.Tags)
+}
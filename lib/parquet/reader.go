@@ -0,0 +1,410 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	// SeriesColumn is the column of the series written by the Writer, the series is the tags joined as k1=v1,k2=v2.
+	SeriesColumn = "series"
+
+	DefaultReadBatchSize = 8192
+)
+
+// ReaderOptions are the options of mapping the columns of a Parquet file to tags, fields and time.
+type ReaderOptions struct {
+	// TagColumns are the string columns read as tags, the other columns except the time and the series are read as fields.
+	TagColumns []string
+	// TimeColumn is the timestamp or int64 column of the time in nanoseconds, record.TimeField if empty.
+	TimeColumn string
+	// BatchSize is the maximum number of rows of the records returned by Read.
+	BatchSize int64
+}
+
+// Reader reads a Parquet file as records, the record of each batch has the tags first, then the fields,
+// both sorted by name, and the time last.
+type Reader struct {
+	file      *file.Reader
+	rr        pqarrow.RecordReader
+	timeIdx   int
+	seriesIdx int
+	tags      []readColumn
+	fields    []readColumn
+}
+
+type readColumn struct {
+	idx  int
+	name string
+	typ  int
+}
+
+// OpenReader opens the Parquet file of the path.
+func OpenReader(path string, opts ReaderOptions) (*Reader, error) {
+	f, err := file.OpenParquetFile(path, false)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(f, opts)
+}
+
+// NewReader returns the reader of the Parquet data of r.
+func NewReader(r parquet.ReaderAtSeeker, opts ReaderOptions) (*Reader, error) {
+	f, err := file.NewParquetReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(f, opts)
+}
+
+func newReader(f *file.Reader, opts ReaderOptions) (*Reader, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultReadBatchSize
+	}
+	fr, err := pqarrow.NewFileReader(f, pqarrow.ArrowReadProperties{BatchSize: opts.BatchSize}, memory.DefaultAllocator)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	schema, err := fr.Schema()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	r := &Reader{file: f, timeIdx: -1, seriesIdx: -1}
+	if err = r.mapSchema(schema, opts); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if r.rr, err = fr.GetRecordReader(context.Background(), nil, nil); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// mapSchema maps the columns of the Arrow schema to the tags, fields and time.
+func (r *Reader) mapSchema(schema *arrow.Schema, opts ReaderOptions) error {
+	timeColumn := opts.TimeColumn
+	if timeColumn == "" {
+		timeColumn = record.TimeField
+	}
+	tagColumns := make(map[string]bool, len(opts.TagColumns))
+	for _, tag := range opts.TagColumns {
+		tagColumns[tag] = true
+	}
+
+	for i, field := range schema.Fields() {
+		switch {
+		case field.Name == timeColumn:
+			if field.Type.ID() != arrow.TIMESTAMP && field.Type.ID() != arrow.INT64 {
+				return fmt.Errorf("unsupported type %s of time column %s", field.Type, field.Name)
+			}
+			r.timeIdx = i
+		case tagColumns[field.Name]:
+			if field.Type.ID() != arrow.STRING {
+				return fmt.Errorf("unsupported type %s of tag column %s", field.Type, field.Name)
+			}
+			r.tags = append(r.tags, readColumn{idx: i, name: field.Name, typ: influx.Field_Type_Tag})
+			delete(tagColumns, field.Name)
+		case field.Name == SeriesColumn && field.Type.ID() == arrow.STRING:
+			r.seriesIdx = i
+		default:
+			typ := arrowTypeToFieldType(field.Type)
+			if typ == influx.Field_Type_Unknown {
+				return fmt.Errorf("unsupported type %s of column %s", field.Type, field.Name)
+			}
+			r.fields = append(r.fields, readColumn{idx: i, name: field.Name, typ: typ})
+		}
+	}
+
+	if r.timeIdx < 0 {
+		return fmt.Errorf("time column %s is not found", timeColumn)
+	}
+	for tag := range tagColumns {
+		return fmt.Errorf("tag column %s is not found", tag)
+	}
+	if len(r.fields) == 0 {
+		return fmt.Errorf("no field column is found")
+	}
+	sort.Slice(r.tags, func(i, j int) bool { return r.tags[i].name < r.tags[j].name })
+	sort.Slice(r.fields, func(i, j int) bool { return r.fields[i].name < r.fields[j].name })
+	return nil
+}
+
+func arrowTypeToFieldType(dt arrow.DataType) int {
+	switch dt.ID() {
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return influx.Field_Type_Float
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64, arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64, arrow.TIMESTAMP:
+		return influx.Field_Type_Int
+	case arrow.BOOL:
+		return influx.Field_Type_Boolean
+	case arrow.STRING, arrow.LARGE_STRING:
+		return influx.Field_Type_String
+	default:
+		return influx.Field_Type_Unknown
+	}
+}
+
+// Read returns the record of the next batch, or io.EOF if there are no more rows.
+// The rows without time are skipped.
+func (r *Reader) Read() (*record.Record, error) {
+	for {
+		rec, err := r.rr.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec.NumRows() == 0 {
+			continue
+		}
+		native := r.toRecord(rec)
+		if native.RowNums() > 0 {
+			return native, nil
+		}
+	}
+}
+
+// Close closes the Parquet file.
+func (r *Reader) Close() error {
+	r.rr.Release()
+	return r.file.Close()
+}
+
+func (r *Reader) toRecord(rec arrow.Record) *record.Record {
+	rows := int(rec.NumRows())
+
+	// the tags of the series override the columns of the same names
+	var series []map[string]string
+	seriesTags := make(map[string]bool)
+	if r.seriesIdx >= 0 {
+		col := rec.Column(r.seriesIdx).(*array.String)
+		series = make([]map[string]string, rows)
+		for i := 0; i < rows; i++ {
+			if col.IsValid(i) {
+				series[i] = ParseSeries(col.Value(i))
+				for k := range series[i] {
+					seriesTags[k] = true
+				}
+			}
+		}
+	}
+
+	schema := make(record.Schemas, 0, len(r.tags)+len(seriesTags)+len(r.fields)+1)
+	tagCols := make([]*readColumn, 0, cap(schema))
+	for i := range r.tags {
+		if !seriesTags[r.tags[i].name] {
+			schema = append(schema, record.Field{Name: r.tags[i].name, Type: influx.Field_Type_Tag})
+			tagCols = append(tagCols, &r.tags[i])
+		}
+	}
+	for k := range seriesTags {
+		schema = append(schema, record.Field{Name: k, Type: influx.Field_Type_Tag})
+		tagCols = append(tagCols, nil)
+	}
+	sort.Sort(tagsByName{schema: schema, cols: tagCols})
+	fieldStart := len(schema)
+	fieldCols := make([]*readColumn, 0, len(r.fields))
+	for i := range r.fields {
+		if !seriesTags[r.fields[i].name] {
+			schema = append(schema, record.Field{Name: r.fields[i].name, Type: r.fields[i].typ})
+			fieldCols = append(fieldCols, &r.fields[i])
+		}
+	}
+	schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+
+	native := record.NewRecordBuilder(schema)
+	times := rec.Column(r.timeIdx)
+	for i := 0; i < rows; i++ {
+		if times.IsNull(i) {
+			continue
+		}
+		for j, col := range tagCols {
+			cv := &native.ColVals[j]
+			if col != nil {
+				appendString(cv, rec.Column(col.idx), i)
+			} else if v, ok := series[i][schema[j].Name]; ok {
+				cv.AppendString(v)
+			} else {
+				cv.AppendStringNull()
+			}
+		}
+		for j, col := range fieldCols {
+			appendValue(&native.ColVals[fieldStart+j], col.typ, rec.Column(col.idx), i)
+		}
+		native.ColVals[len(schema)-1].AppendInteger(timeValue(times, i))
+	}
+	return native
+}
+
+type tagsByName struct {
+	schema record.Schemas
+	cols   []*readColumn
+}
+
+func (t tagsByName) Len() int           { return len(t.cols) }
+func (t tagsByName) Less(i, j int) bool { return t.schema[i].Name < t.schema[j].Name }
+func (t tagsByName) Swap(i, j int) {
+	t.schema[i], t.schema[j] = t.schema[j], t.schema[i]
+	t.cols[i], t.cols[j] = t.cols[j], t.cols[i]
+}
+
+// ParseSeries parses the series written by the Writer into the tags.
+func ParseSeries(series string) map[string]string {
+	tags := make(map[string]string)
+	for _, kv := range strings.Split(series, ",") {
+		if i := strings.IndexByte(kv, '='); i > 0 {
+			tags[kv[:i]] = kv[i+1:]
+		}
+	}
+	return tags
+}
+
+func timeValue(arr arrow.Array, i int) int64 {
+	switch a := arr.(type) {
+	case *array.Timestamp:
+		unit := a.DataType().(*arrow.TimestampType).Unit
+		return int64(a.Value(i)) * int64(unit.Multiplier())
+	case *array.Int64:
+		return a.Value(i)
+	}
+	return 0
+}
+
+func appendString(cv *record.ColVal, arr arrow.Array, i int) {
+	if arr.IsNull(i) {
+		cv.AppendStringNull()
+		return
+	}
+	switch a := arr.(type) {
+	case *array.String:
+		cv.AppendString(a.Value(i))
+	case *array.LargeString:
+		cv.AppendString(a.Value(i))
+	default:
+		cv.AppendStringNull()
+	}
+}
+
+func appendValue(cv *record.ColVal, typ int, arr arrow.Array, i int) {
+	if arr.IsNull(i) {
+		switch typ {
+		case influx.Field_Type_Float:
+			cv.AppendFloatNull()
+		case influx.Field_Type_Int:
+			cv.AppendIntegerNull()
+		case influx.Field_Type_Boolean:
+			cv.AppendBooleanNull()
+		default:
+			cv.AppendStringNull()
+		}
+		return
+	}
+
+	switch a := arr.(type) {
+	case *array.Float16:
+		cv.AppendFloat(float64(a.Value(i).Float32()))
+	case *array.Float32:
+		cv.AppendFloat(float64(a.Value(i)))
+	case *array.Float64:
+		cv.AppendFloat(a.Value(i))
+	case *array.Int8:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Int16:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Int32:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Int64:
+		cv.AppendInteger(a.Value(i))
+	case *array.Uint8:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Uint16:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Uint32:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Uint64:
+		cv.AppendInteger(int64(a.Value(i)))
+	case *array.Timestamp:
+		cv.AppendInteger(timeValue(a, i))
+	case *array.Boolean:
+		cv.AppendBoolean(a.Value(i))
+	case *array.String:
+		cv.AppendString(a.Value(i))
+	case *array.LargeString:
+		cv.AppendString(a.Value(i))
+	}
+}
+
+// RecordToRows appends the rows of the record read by the Reader to dst, the null fields are omitted
+// and the rows without fields are skipped.
+func RecordToRows(dst []influx.Row, mst string, rec *record.Record) []influx.Row {
+	timeCol := rec.ColNums() - 1
+	times := rec.ColVals[timeCol].IntegerValues()
+	// valid is the index of the next non-null value of each column
+	valid := make([]int, timeCol)
+	for i := 0; i < rec.RowNums(); i++ {
+		row := influx.Row{Name: mst, Timestamp: times[i]}
+		for j := 0; j < timeCol; j++ {
+			field := &rec.Schema[j]
+			cv := &rec.ColVals[j]
+			if field.Type == influx.Field_Type_Tag || field.Type == influx.Field_Type_String {
+				v, isNil := cv.StringValue(i)
+				if isNil {
+					continue
+				}
+				if field.Type == influx.Field_Type_String {
+					row.Fields = append(row.Fields, influx.Field{Key: field.Name, StrValue: string(v), Type: influx.Field_Type_String})
+				} else if len(v) > 0 {
+					row.Tags = append(row.Tags, influx.Tag{Key: field.Name, Value: string(v)})
+				}
+				continue
+			}
+			if cv.IsNil(i) {
+				continue
+			}
+			f := influx.Field{Key: field.Name, Type: int32(field.Type)}
+			switch field.Type {
+			case influx.Field_Type_Float:
+				f.NumValue = cv.FloatValues()[valid[j]]
+			case influx.Field_Type_Int:
+				f.NumValue = float64(cv.IntegerValues()[valid[j]])
+			case influx.Field_Type_Boolean:
+				if cv.BooleanValues()[valid[j]] {
+					f.NumValue = 1
+				}
+			}
+			valid[j]++
+			row.Fields = append(row.Fields, f)
+		}
+		if len(row.Fields) > 0 {
+			dst = append(dst, row)
+		}
+	}
+	return dst
+}
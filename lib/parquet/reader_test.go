@@ -0,0 +1,127 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), "test.parquet")
+	writer, err := NewWriter(file, "", MetaData{Mst: "cpu", Schemas: map[string]uint8{
+		"idle": influx.Field_Type_Float, "count": influx.Field_Type_Int, "ok": influx.Field_Type_Boolean,
+		"region": influx.Field_Type_String, record.TimeField: influx.Field_Type_Int,
+	}})
+	require.NoError(t, err)
+	defer writer.Close()
+
+	for i, series := range []string{"host=a", "host=b,zone=z1"} {
+		rec := record.NewRecordBuilder(record.Schemas{
+			{Name: "count", Type: influx.Field_Type_Int},
+			{Name: "idle", Type: influx.Field_Type_Float},
+			{Name: "ok", Type: influx.Field_Type_Boolean},
+			{Name: "region", Type: influx.Field_Type_String},
+			{Name: record.TimeField, Type: influx.Field_Type_Int},
+		})
+		rec.ColVals[0].AppendInteger(int64(i))
+		rec.ColVals[0].AppendIntegerNull()
+		rec.ColVals[1].AppendFloat(0.5)
+		rec.ColVals[1].AppendFloat(1.5)
+		rec.ColVals[2].AppendBoolean(true)
+		rec.ColVals[2].AppendBooleanNull()
+		rec.ColVals[3].AppendString("r1")
+		rec.ColVals[3].AppendString("r2")
+		rec.ColVals[4].AppendIntegers(int64(i*10+1), int64(i*10+2))
+		require.NoError(t, writer.WriteRecord(series, rec))
+	}
+	require.NoError(t, writer.WriteStop())
+	return file
+}
+
+func readAllRows(t *testing.T, r *Reader) []influx.Row {
+	var rows []influx.Row
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		rows = RecordToRows(rows, "cpu", rec)
+	}
+	require.NoError(t, r.Close())
+	return rows
+}
+
+func TestReader_RoundTrip(t *testing.T) {
+	file := writeTestFile(t)
+	r, err := OpenReader(file, ReaderOptions{})
+	require.NoError(t, err)
+
+	rows := readAllRows(t, r)
+	require.Equal(t, 4, len(rows))
+	assert.Equal(t, influx.Row{
+		Name:      "cpu",
+		Timestamp: 1,
+		Tags:      influx.PointTags{{Key: "host", Value: "a"}},
+		Fields: influx.Fields{
+			{Key: "count", NumValue: 0, Type: influx.Field_Type_Int},
+			{Key: "idle", NumValue: 0.5, Type: influx.Field_Type_Float},
+			{Key: "ok", NumValue: 1, Type: influx.Field_Type_Boolean},
+			{Key: "region", StrValue: "r1", Type: influx.Field_Type_String},
+		},
+	}, rows[0])
+	assert.Equal(t, influx.Fields{
+		{Key: "idle", NumValue: 1.5, Type: influx.Field_Type_Float},
+		{Key: "region", StrValue: "r2", Type: influx.Field_Type_String},
+	}, rows[1].Fields)
+	assert.Equal(t, influx.PointTags{{Key: "host", Value: "b"}, {Key: "zone", Value: "z1"}}, rows[2].Tags)
+	assert.Equal(t, int64(12), rows[3].Timestamp)
+}
+
+func TestReader_TagColumns(t *testing.T) {
+	file := writeTestFile(t)
+	r, err := OpenReader(file, ReaderOptions{TagColumns: []string{"region"}, BatchSize: 1})
+	require.NoError(t, err)
+
+	rows := readAllRows(t, r)
+	require.Equal(t, 4, len(rows))
+	assert.Equal(t, influx.PointTags{{Key: "host", Value: "b"}, {Key: "region", Value: "r2"}, {Key: "zone", Value: "z1"}}, rows[3].Tags)
+	assert.Equal(t, 3, len(rows[0].Fields))
+}
+
+func TestReader_BadSchema(t *testing.T) {
+	file := writeTestFile(t)
+	for opts, msg := range map[*ReaderOptions]string{
+		{TimeColumn: "ts"}:              "time column ts is not found",
+		{TagColumns: []string{"idle"}}:  "unsupported type float64 of tag column idle",
+		{TagColumns: []string{"shard"}}: "tag column shard is not found",
+		{TimeColumn: "region"}:          "unsupported type utf8 of time column region",
+	} {
+		_, err := OpenReader(file, *opts)
+		assert.EqualError(t, err, msg)
+	}
+}
+
+func TestParseSeries(t *testing.T) {
+	assert.Equal(t, map[string]string{"host": "a", "zone": "z=1"}, ParseSeries("host=a,zone=z=1"))
+	assert.Equal(t, map[string]string{}, ParseSeries(""))
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycache
+
+import (
+	"time"
+)
+
+// maxBuckets is the maximum number of the buckets of a query, the buckets are enlarged for the long time range
+const maxBuckets = 64
+
+// Bucket is the time range [Start, End) of a range query, the result of it is cached if the query covers it fully.
+type Bucket struct {
+	Start int64
+	End   int64
+
+	// Full is true if the time range of the query covers the whole bucket
+	Full bool
+}
+
+// BucketSize returns the size of the buckets of the time range [start, end], it is a multiple of the unit,
+// which is the interval or the step of the query, and at least the minimum size.
+func BucketSize(minSize, unit time.Duration, start, end int64) int64 {
+	u := int64(unit)
+	size := (int64(minSize) + u - 1) / u * u
+	for (end-start)/size >= maxBuckets {
+		size *= 2
+	}
+	return size
+}
+
+// SplitBuckets splits the time range [start, end] into the buckets of the size, the buckets start at the multiples
+// of the size after the offset.
+func SplitBuckets(start, end, size, offset int64) []Bucket {
+	if start > end || size <= 0 {
+		return nil
+	}
+	offset %= size
+	first := floor(start-offset, size) + offset
+
+	var buckets []Bucket
+	for bs := first; bs <= end; bs += size {
+		be := bs + size
+		buckets = append(buckets, Bucket{
+			Start: bs,
+			End:   be,
+			Full:  bs >= start && be-1 <= end,
+		})
+	}
+	return buckets
+}
+
+func floor(t, size int64) int64 {
+	r := t % size
+	if r < 0 {
+		r += size
+	}
+	return t - r
+}
@@ -0,0 +1,320 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycache
+
+import (
+	"container/list"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	// maxInvalidations is the number of the recent invalidations kept to reject the results computed before them
+	maxInvalidations = 1024
+
+	// writeDelay bounds the time between the notification of a write and the data being visible to the queries,
+	// the results of the queries started in it may miss the data of the write
+	writeDelay = 10 * time.Second
+)
+
+// Dependency is the data a cached result is computed from,
+// the result is invalidated when the data is written or deleted.
+type Dependency struct {
+	Database string
+	// Measurements is empty if the result depends on all the measurements of the database
+	Measurements []string
+	MinTime      int64
+	MaxTime      int64
+}
+
+func (d *Dependency) overlaps(inv *invalidation) bool {
+	if d.Database != inv.database || inv.minTime > d.MaxTime || inv.maxTime < d.MinTime {
+		return false
+	}
+	return inv.measurement == "" || len(d.Measurements) == 0 || slices.Contains(d.Measurements, inv.measurement)
+}
+
+// invalidation is a write or a delete of the data in the time range of a measurement,
+// the measurement is empty if all the measurements of the database are changed
+type invalidation struct {
+	at          time.Time
+	database    string
+	measurement string
+	minTime     int64
+	maxTime     int64
+}
+
+type entry struct {
+	key     string
+	dep     Dependency
+	rows    models.Rows
+	size    int64
+	created time.Time
+}
+
+// Cache is the LRU cache of the results of the time buckets of the range queries.
+// The results are kept until they are evicted by the memory limit, expired by the ttl,
+// or invalidated by the writes and the deletes of the data they depend on.
+type Cache struct {
+	mu sync.Mutex
+
+	maxBytes       int64
+	usedBytes      int64
+	ttl            time.Duration
+	maxFreshness   time.Duration
+	bucketInterval time.Duration
+	// the databases whose results are cached, they are only written through this node
+	cacheable map[string]struct{}
+
+	ll      *list.List
+	entries map[string]*list.Element
+	// the entries of each database, they are checked by the invalidations of the database
+	databases map[string]map[*list.Element]struct{}
+
+	// the recent invalidations, the results of the queries started before them are not cached
+	invalidations []invalidation
+
+	stat *statistics.QueryCacheStatistics
+}
+
+func NewCache(conf config.QueryResultCacheConfig) *Cache {
+	cacheable := make(map[string]struct{}, len(conf.Databases))
+	for _, db := range conf.Databases {
+		cacheable[db] = struct{}{}
+	}
+	return &Cache{
+		cacheable:      cacheable,
+		maxBytes:       int64(conf.MaxMemorySize),
+		ttl:            time.Duration(conf.TTL),
+		maxFreshness:   time.Duration(conf.MaxFreshness),
+		bucketInterval: time.Duration(conf.BucketInterval),
+		ll:             list.New(),
+		entries:        make(map[string]*list.Element),
+		databases:      make(map[string]map[*list.Element]struct{}),
+		stat:           statistics.NewQueryCacheStatistics(),
+	}
+}
+
+// BucketInterval is the minimum time range of a bucket
+func (c *Cache) BucketInterval() time.Duration {
+	return c.bucketInterval
+}
+
+// Cacheable returns true if the results of the database are cached, the invalidations are local to this node,
+// so only the databases written and deleted through this node are cached.
+func (c *Cache) Cacheable(database string) bool {
+	_, ok := c.cacheable[database]
+	return ok
+}
+
+// Complete returns true if the data of the time range before end can be cached at now,
+// the data of the recent time range may still arrive.
+func (c *Cache) Complete(end int64, now time.Time) bool {
+	return end <= now.Add(-c.maxFreshness).UnixNano()
+}
+
+// Get returns a copy of the cached rows of the key
+func (c *Cache) Get(key string) (models.Rows, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.stat.AddMisses(1)
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	if time.Since(e.created) > c.ttl {
+		c.remove(elem)
+		c.updateGauges()
+		c.stat.AddMisses(1)
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	c.stat.AddHits(1)
+	return copyRows(e.rows), true
+}
+
+// Put caches a copy of the rows of the key, start is the time the query computing the rows started.
+// The rows are dropped if the data they depend on may be changed after the query started.
+func (c *Cache) Put(key string, dep Dependency, rows models.Rows, start time.Time) {
+	size := rowsSize(rows) + int64(len(key))
+	if size > c.maxBytes {
+		c.stat.AddRejects(1)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.changedSince(&dep, start.Add(-writeDelay)) {
+		c.stat.AddRejects(1)
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	e := &entry{key: key, dep: dep, rows: copyRows(rows), size: size, created: time.Now()}
+	elem := c.ll.PushFront(e)
+	c.entries[key] = elem
+	db, ok := c.databases[dep.Database]
+	if !ok {
+		db = make(map[*list.Element]struct{})
+		c.databases[dep.Database] = db
+	}
+	db[elem] = struct{}{}
+	c.usedBytes += size
+	c.stat.AddPuts(1)
+
+	for c.usedBytes > c.maxBytes {
+		c.remove(c.ll.Back())
+		c.stat.AddEvictions(1)
+	}
+	c.updateGauges()
+}
+
+func (c *Cache) changedSince(dep *Dependency, since time.Time) bool {
+	if len(c.invalidations) == maxInvalidations && !c.invalidations[0].at.Before(since) {
+		// the invalidations after since may be dropped
+		return true
+	}
+	for i := len(c.invalidations) - 1; i >= 0; i-- {
+		inv := &c.invalidations[i]
+		if inv.at.Before(since) {
+			break
+		}
+		if dep.overlaps(inv) {
+			return true
+		}
+	}
+	return false
+}
+
+// Invalidate drops the cached results depending on the data of the measurement in the time range [minTime, maxTime],
+// the results of all the measurements of the database are dropped if the measurement is empty.
+func (c *Cache) Invalidate(database, measurement string, minTime, maxTime int64) {
+	inv := invalidation{
+		at:          time.Now(),
+		database:    database,
+		measurement: measurement,
+		minTime:     minTime,
+		maxTime:     maxTime,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.invalidations) == maxInvalidations {
+		copy(c.invalidations, c.invalidations[1:])
+		c.invalidations = c.invalidations[:maxInvalidations-1]
+	}
+	c.invalidations = append(c.invalidations, inv)
+
+	for elem := range c.databases[database] {
+		if e := elem.Value.(*entry); e.dep.overlaps(&inv) {
+			c.remove(elem)
+			c.stat.AddInvalidations(1)
+		}
+	}
+	c.updateGauges()
+}
+
+// InvalidateDatabase drops all the cached results of the database
+func (c *Cache) InvalidateDatabase(database string) {
+	c.Invalidate(database, "", math.MinInt64, math.MaxInt64)
+}
+
+// InvalidateMeasurement drops all the cached results depending on the measurement
+func (c *Cache) InvalidateMeasurement(database, measurement string) {
+	c.Invalidate(database, measurement, math.MinInt64, math.MaxInt64)
+}
+
+// ObserveRows invalidates the cached results depending on the rows to write, it implements coordinator.RowsObserver.
+// The rows of the recent time range are skipped, the results of it are never cached.
+func (c *Cache) ObserveRows(database string, rows []influx.Row) {
+	oldest := time.Now().Add(-c.maxFreshness).UnixNano()
+
+	var ranges map[string][2]int64
+	for i := range rows {
+		t := rows[i].Timestamp
+		if t >= oldest {
+			continue
+		}
+		if ranges == nil {
+			ranges = make(map[string][2]int64)
+		}
+		name := influx.GetOriginMstName(rows[i].Name)
+		r, ok := ranges[name]
+		if !ok {
+			ranges[name] = [2]int64{t, t}
+			continue
+		}
+		ranges[name] = [2]int64{min(r[0], t), max(r[1], t)}
+	}
+
+	for name, r := range ranges {
+		c.Invalidate(database, name, r[0], r[1])
+	}
+}
+
+// ObserveRecord invalidates the cached results depending on the record to write in the time range [minTime, maxTime],
+// it implements coordinator.RecordObserver. The recent time range is skipped, the results of it are never cached.
+func (c *Cache) ObserveRecord(database, measurement string, minTime, maxTime int64) {
+	oldest := time.Now().Add(-c.maxFreshness).UnixNano()
+	if minTime >= oldest {
+		return
+	}
+	c.Invalidate(database, measurement, minTime, min(maxTime, oldest-1))
+}
+
+// Len returns the number of the cached results
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Size returns the memory size of the cached results
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.usedBytes
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	e := elem.Value.(*entry)
+	c.ll.Remove(elem)
+	delete(c.entries, e.key)
+	if db, ok := c.databases[e.dep.Database]; ok {
+		delete(db, elem)
+		if len(db) == 0 {
+			delete(c.databases, e.dep.Database)
+		}
+	}
+	c.usedBytes -= e.size
+}
+
+func (c *Cache) updateGauges() {
+	c.stat.SetEntries(int64(c.ll.Len()))
+	c.stat.SetBytes(c.usedBytes)
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCache() *Cache {
+	conf := config.NewQueryResultCacheConfig()
+	conf.Enabled = true
+	return NewCache(conf)
+}
+
+func newTestRows(name string, times ...int64) models.Rows {
+	row := &models.Row{Name: name, Tags: map[string]string{"host": "a"}, Columns: []string{"time", "count"}}
+	for _, t := range times {
+		row.Values = append(row.Values, []interface{}{time.Unix(0, t).UTC(), int64(1)})
+	}
+	return models.Rows{row}
+}
+
+func TestCache_GetPut(t *testing.T) {
+	c := newTestCache()
+	dep := Dependency{Database: "db0", Measurements: []string{"cpu"}, MinTime: 0, MaxTime: 99}
+
+	_, ok := c.Get("k1")
+	require.False(t, ok)
+
+	rows := newTestRows("cpu", 0, 50)
+	c.Put("k1", dep, rows, time.Now())
+	require.Equal(t, 1, c.Len())
+	require.True(t, c.Size() > 0)
+
+	// the cached rows are not changed by the callers
+	rows[0].Values[0][0] = int64(0)
+	got, ok := c.Get("k1")
+	require.True(t, ok)
+	require.Equal(t, time.Unix(0, 0).UTC(), got[0].Values[0][0])
+	got[0].Values[1][0] = int64(50)
+	got, _ = c.Get("k1")
+	require.Equal(t, time.Unix(0, 50).UTC(), got[0].Values[1][0])
+}
+
+func TestCache_Evict(t *testing.T) {
+	c := newTestCache()
+	dep := Dependency{Database: "db0", MinTime: 0, MaxTime: 99}
+	size := rowsSize(newTestRows("cpu", 0)) + 2
+	c.maxBytes = 2 * size
+
+	c.Put("k1", dep, newTestRows("cpu", 0), time.Now())
+	c.Put("k2", dep, newTestRows("cpu", 0), time.Now())
+	_, ok := c.Get("k1")
+	require.True(t, ok)
+	c.Put("k3", dep, newTestRows("cpu", 0), time.Now())
+
+	// k2 is the least recently used one
+	_, ok = c.Get("k2")
+	require.False(t, ok)
+	_, ok = c.Get("k1")
+	require.True(t, ok)
+	require.Equal(t, 2, c.Len())
+	require.Equal(t, 2*size, c.Size())
+
+	// too large to cache
+	c.Put("k4", dep, newTestRows("cpu", 0, 1, 2, 3, 4, 5, 6, 7, 8, 9), time.Now())
+	_, ok = c.Get("k4")
+	require.False(t, ok)
+
+	c.ttl = 0
+	_, ok = c.Get("k1")
+	require.False(t, ok)
+	require.Equal(t, 1, c.Len())
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	c := newTestCache()
+	c.Put("cpu1", Dependency{Database: "db0", Measurements: []string{"cpu"}, MinTime: 0, MaxTime: 99}, nil, time.Now())
+	c.Put("cpu2", Dependency{Database: "db0", Measurements: []string{"cpu"}, MinTime: 100, MaxTime: 199}, nil, time.Now())
+	c.Put("mem", Dependency{Database: "db0", Measurements: []string{"mem"}, MinTime: 0, MaxTime: 99}, nil, time.Now())
+	c.Put("all", Dependency{Database: "db0", MinTime: 0, MaxTime: 99}, nil, time.Now())
+	c.Put("db1", Dependency{Database: "db1", MinTime: 0, MaxTime: 99}, nil, time.Now())
+	require.Equal(t, 5, c.Len())
+
+	c.ObserveRows("db0", []influx.Row{{Name: "cpu_0000", Timestamp: 10}, {Name: "cpu_0000", Timestamp: 20},
+		{Name: "disk", Timestamp: 500}, {Name: "cpu", Timestamp: time.Now().UnixNano()}})
+	for key, cached := range map[string]bool{"cpu1": false, "cpu2": true, "mem": true, "all": false, "db1": true} {
+		_, ok := c.Get(key)
+		require.Equal(t, cached, ok, key)
+	}
+
+	c.InvalidateMeasurement("db0", "mem")
+	require.Equal(t, 2, c.Len())
+	c.InvalidateDatabase("db0")
+	require.Equal(t, 1, c.Len())
+	_, ok := c.Get("db1")
+	require.True(t, ok)
+}
+
+func TestCache_ObserveRecord(t *testing.T) {
+	c := newTestCache()
+	c.Put("cpu1", Dependency{Database: "db0", Measurements: []string{"cpu"}, MinTime: 0, MaxTime: 99}, nil, time.Now())
+	c.Put("cpu2", Dependency{Database: "db0", Measurements: []string{"cpu"}, MinTime: 100, MaxTime: 199}, nil, time.Now())
+	c.Put("mem", Dependency{Database: "db0", Measurements: []string{"mem"}, MinTime: 0, MaxTime: 99}, nil, time.Now())
+
+	// the record of the recent time range is skipped
+	c.ObserveRecord("db0", "cpu", time.Now().UnixNano(), time.Now().UnixNano())
+	require.Equal(t, 3, c.Len())
+
+	c.ObserveRecord("db0", "cpu", 50, time.Now().UnixNano())
+	for key, cached := range map[string]bool{"cpu1": false, "cpu2": false, "mem": true} {
+		_, ok := c.Get(key)
+		require.Equal(t, cached, ok, key)
+	}
+}
+
+func TestCache_PutAfterInvalidate(t *testing.T) {
+	c := newTestCache()
+	start := time.Now().Add(-time.Minute)
+	dep := Dependency{Database: "db0", Measurements: []string{"cpu"}, MinTime: 0, MaxTime: 99}
+
+	// the data is changed after the query started
+	c.Invalidate("db0", "cpu", 50, 60)
+	c.Put("k1", dep, nil, start)
+	require.Equal(t, 0, c.Len())
+
+	c.Invalidate("db0", "cpu", 100, 200)
+	c.Invalidate("db0", "mem", 50, 60)
+	c.Put("k1", dep, nil, time.Now().Add(writeDelay))
+	require.Equal(t, 1, c.Len())
+
+	// the invalidations may be dropped
+	for i := 0; i < maxInvalidations; i++ {
+		c.Invalidate("db1", "cpu", 0, 1)
+	}
+	c.Put("k2", dep, nil, start)
+	require.Equal(t, 1, c.Len())
+}
+
+func TestCache_Complete(t *testing.T) {
+	conf := config.NewQueryResultCacheConfig()
+	conf.MaxFreshness = toml.Duration(time.Minute)
+	c := NewCache(conf)
+	now := time.Now()
+	require.True(t, c.Complete(now.Add(-time.Hour).UnixNano(), now))
+	require.False(t, c.Complete(now.Add(-time.Second).UnixNano(), now))
+	require.Equal(t, time.Hour, c.BucketInterval())
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycache
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+const (
+	rowSize   = int64(unsafe.Sizeof(models.Row{}))
+	valueSize = int64(unsafe.Sizeof(interface{}(nil)))
+	sliceSize = int64(unsafe.Sizeof([]interface{}{}))
+	timeSize  = int64(unsafe.Sizeof(time.Time{}))
+)
+
+// rowsSize estimates the memory size of the rows
+func rowsSize(rows models.Rows) int64 {
+	var size int64
+	for _, row := range rows {
+		size += rowSize + int64(len(row.Name))
+		for k, v := range row.Tags {
+			size += int64(len(k)+len(v)) + 2*sliceSize
+		}
+		for _, col := range row.Columns {
+			size += int64(len(col)) + sliceSize
+		}
+		for _, values := range row.Values {
+			size += sliceSize + int64(len(values))*valueSize
+			for _, v := range values {
+				switch v := v.(type) {
+				case string:
+					size += int64(len(v))
+				case time.Time:
+					size += timeSize
+				}
+			}
+		}
+	}
+	return size
+}
+
+// copyRows copies the rows and their values, the values may be modified by the callers,
+// such as converting the time to the epoch
+func copyRows(rows models.Rows) models.Rows {
+	if rows == nil {
+		return nil
+	}
+	dst := make(models.Rows, len(rows))
+	for i, row := range rows {
+		r := *row
+		r.Values = make([][]interface{}, len(row.Values))
+		for j := range row.Values {
+			r.Values[j] = slices.Clone(row.Values[j])
+		}
+		dst[i] = &r
+	}
+	return dst
+}
+
+// FillFunc returns the values of the series missing in the bucket i, nil is returned if nothing is filled
+type FillFunc func(i int, series *models.Row) [][]interface{}
+
+// MergeRows concatenates the rows of the buckets in the order of the buckets, the series are matched by the name
+// and the tags. The series are kept in the order of the first bucket if all the buckets have the same series,
+// otherwise they are sorted by the name and the tags. False is returned if the columns of a series differ
+// between the buckets, the rows can not be merged then.
+func MergeRows(buckets []models.Rows, fill FillFunc) (models.Rows, bool) {
+	var keys []string
+	series := make(map[string]*models.Row)
+	for _, rows := range buckets {
+		for _, row := range rows {
+			key := seriesKey(row)
+			s, ok := series[key]
+			if !ok {
+				series[key] = &models.Row{Name: row.Name, Tags: row.Tags, Columns: row.Columns}
+				keys = append(keys, key)
+				continue
+			}
+			if !slices.Equal(s.Columns, row.Columns) {
+				return nil, false
+			}
+		}
+	}
+
+	same := true
+	for i, rows := range buckets {
+		present := make(map[string]struct{}, len(rows))
+		for _, row := range rows {
+			key := seriesKey(row)
+			present[key] = struct{}{}
+			s := series[key]
+			s.Values = append(s.Values, row.Values...)
+			s.Partial = s.Partial || row.Partial
+		}
+		if len(present) == len(keys) {
+			continue
+		}
+		same = false
+		for _, key := range keys {
+			if _, ok := present[key]; ok || fill == nil {
+				continue
+			}
+			s := series[key]
+			s.Values = append(s.Values, fill(i, s)...)
+		}
+	}
+
+	if !same {
+		sort.Strings(keys)
+	}
+	merged := make(models.Rows, 0, len(keys))
+	for _, key := range keys {
+		merged = append(merged, series[key])
+	}
+	return merged, true
+}
+
+// seriesKey is the name and the sorted tags of the series, it is ordered by the name first
+func seriesKey(row *models.Row) string {
+	tags := make([]string, 0, len(row.Tags))
+	for k, v := range row.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+
+	var b strings.Builder
+	b.WriteString(row.Name)
+	b.WriteByte(0)
+	b.WriteString(strings.Join(tags, ","))
+	return b.String()
+}
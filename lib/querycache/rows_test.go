@@ -0,0 +1,75 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBuckets(t *testing.T) {
+	require.Equal(t, []Bucket{
+		{Start: 0, End: 10, Full: false},
+		{Start: 10, End: 20, Full: true},
+		{Start: 20, End: 30, Full: false},
+	}, SplitBuckets(5, 25, 10, 0))
+
+	require.Equal(t, []Bucket{
+		{Start: 3, End: 13, Full: true},
+		{Start: 13, End: 23, Full: true},
+	}, SplitBuckets(3, 22, 10, 23))
+
+	require.Equal(t, []Bucket{{Start: -10, End: 0, Full: false}}, SplitBuckets(-5, -1, 10, 0))
+	require.Nil(t, SplitBuckets(10, 5, 10, 0))
+}
+
+func TestBucketSize(t *testing.T) {
+	require.Equal(t, int64(time.Hour), BucketSize(time.Hour, time.Minute, 0, int64(time.Hour)))
+	require.Equal(t, int64(63*time.Minute), BucketSize(time.Hour, 7*time.Minute, 0, int64(time.Hour)))
+	require.Equal(t, int64(2*time.Hour), BucketSize(time.Hour, time.Minute, 0, int64(64*time.Hour)))
+}
+
+func TestMergeRows(t *testing.T) {
+	cols := []string{"time", "count"}
+	a := &models.Row{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: cols, Values: [][]interface{}{{int64(0), 1}}}
+	b := &models.Row{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: cols, Values: [][]interface{}{{int64(0), 2}}}
+	a2 := &models.Row{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: cols, Values: [][]interface{}{{int64(10), 3}}}
+	b2 := &models.Row{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: cols, Values: [][]interface{}{{int64(10), 4}}}
+
+	// the order of the first bucket is kept
+	merged, ok := MergeRows([]models.Rows{{b, a}, {b2, a2}}, nil)
+	require.True(t, ok)
+	require.Len(t, merged, 2)
+	require.Equal(t, "b", merged[0].Tags["host"])
+	require.Equal(t, [][]interface{}{{int64(0), 1}, {int64(10), 3}}, merged[1].Values)
+
+	// the missing series are filled
+	fill := func(i int, series *models.Row) [][]interface{} {
+		return [][]interface{}{{int64(i * 10), nil}}
+	}
+	merged, ok = MergeRows([]models.Rows{{b}, nil, {a2, b2}}, fill)
+	require.True(t, ok)
+	require.Len(t, merged, 2)
+	require.Equal(t, "a", merged[0].Tags["host"])
+	require.Equal(t, [][]interface{}{{int64(0), nil}, {int64(10), nil}, {int64(10), 3}}, merged[0].Values)
+	require.Equal(t, [][]interface{}{{int64(0), 2}, {int64(10), nil}, {int64(10), 4}}, merged[1].Values)
+
+	c := &models.Row{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "max"}}
+	_, ok = MergeRows([]models.Rows{{a}, {c}}, nil)
+	require.False(t, ok)
+}
@@ -55,6 +55,7 @@ const (
 	SeriesLoopPool
 	LogStoreRecord
 	LogStoreFailRecord
+	ColumnStoreRecord
 	UnknownPool
 )
 
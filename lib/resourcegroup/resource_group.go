@@ -0,0 +1,302 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcegroup
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/cpu"
+	"github.com/openGemini/openGemini/lib/errno"
+)
+
+// Group limits the number of running queries, the length of the admission queue and the memory
+// used by the queries of the users and the databases assigned to it.
+type Group struct {
+	conf config.ResourceGroup
+
+	mu      sync.Mutex
+	running int
+	queued  int
+	// broadcast is closed to notify the waiting queries when a running query leaves the group
+	broadcast chan struct{}
+
+	memUsed int64
+
+	admitted    int64
+	rejected    int64
+	memExceeded int64
+}
+
+func newGroup(conf config.ResourceGroup) *Group {
+	return &Group{
+		conf:      conf,
+		broadcast: make(chan struct{}),
+	}
+}
+
+func (g *Group) Name() string {
+	return g.conf.Name
+}
+
+// Acquire takes a running slot of the group. If all the slots are in use, the query waits in the queue
+// until a slot is released, the query is aborted, or the timeout expires. A zero timeout means no timeout.
+// A nil error must be followed by Release.
+func (g *Group) Acquire(abort <-chan struct{}, timeout time.Duration) error {
+	g.mu.Lock()
+	if g.tryAcquire() {
+		g.mu.Unlock()
+		return nil
+	}
+	if g.queued >= g.conf.MaxQueuedQueries {
+		queued := g.queued
+		g.mu.Unlock()
+		atomic.AddInt64(&g.rejected, 1)
+		return errno.NewError(errno.ResourceGroupQueueFull, g.conf.Name, queued)
+	}
+	g.queued++
+
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	for {
+		broadcast := g.broadcast
+		g.mu.Unlock()
+
+		select {
+		case <-broadcast:
+		case <-abort:
+			g.leaveQueue()
+			return errno.NewError(errno.QueryAborted)
+		case <-timeoutCh:
+			g.leaveQueue()
+			atomic.AddInt64(&g.rejected, 1)
+			return errno.NewError(errno.ResourceGroupQueueTimeout, g.conf.Name)
+		}
+
+		g.mu.Lock()
+		if g.tryAcquire() {
+			g.queued--
+			g.mu.Unlock()
+			return nil
+		}
+	}
+}
+
+// tryAcquire must be called with the lock held
+func (g *Group) tryAcquire() bool {
+	if g.conf.MaxConcurrentQueries > 0 && g.running >= g.conf.MaxConcurrentQueries {
+		return false
+	}
+	g.running++
+	atomic.AddInt64(&g.admitted, 1)
+	return true
+}
+
+func (g *Group) leaveQueue() {
+	g.mu.Lock()
+	g.queued--
+	g.mu.Unlock()
+}
+
+// Release gives back the running slot taken by Acquire
+func (g *Group) Release() {
+	g.mu.Lock()
+	g.running--
+	broadcast := g.broadcast
+	g.broadcast = make(chan struct{})
+	g.mu.Unlock()
+	close(broadcast)
+}
+
+// Parallelism returns the query parallelism the queries of the group can use.
+// parallel is the configured query parallelism, the number of CPUs is used if it is not positive.
+func (g *Group) Parallelism(parallel int) int {
+	if g == nil || g.conf.CPUShare <= 0 || g.conf.CPUShare >= 100 {
+		return parallel
+	}
+	if parallel <= 0 {
+		parallel = cpu.GetCpuNum()
+	}
+	n := parallel * g.conf.CPUShare / 100
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// NewMemTracker returns a tracker which accounts the memory of a query against the memory budget of the group
+func (g *Group) NewMemTracker() *MemTracker {
+	return &MemTracker{group: g}
+}
+
+// Stat is the status of a resource group shown by SHOW RESOURCE GROUPS
+type Stat struct {
+	config.ResourceGroup
+
+	Running     int
+	Queued      int
+	MemUsed     int64
+	Admitted    int64
+	Rejected    int64
+	MemExceeded int64
+}
+
+func (g *Group) Stat() Stat {
+	g.mu.Lock()
+	running, queued := g.running, g.queued
+	g.mu.Unlock()
+	return Stat{
+		ResourceGroup: g.conf,
+		Running:       running,
+		Queued:        queued,
+		MemUsed:       atomic.LoadInt64(&g.memUsed),
+		Admitted:      atomic.LoadInt64(&g.admitted),
+		Rejected:      atomic.LoadInt64(&g.rejected),
+		MemExceeded:   atomic.LoadInt64(&g.memExceeded),
+	}
+}
+
+// MemTracker accounts the memory used by a query. The memory of a chunk is accounted when the query receives it
+// from the stores, and is given back by Shrink once the executor consumed the chunk, the rest is given back to
+// the group when the query finishes. All the methods can be called on a nil tracker, which means the query is not limited.
+type MemTracker struct {
+	group *Group
+	used  int64
+}
+
+// Grow adds size bytes to the query and its group, an error is returned if the memory budget of the group is exceeded
+func (t *MemTracker) Grow(size int64) error {
+	if t == nil {
+		return nil
+	}
+	atomic.AddInt64(&t.used, size)
+	g := t.group
+	total := atomic.AddInt64(&g.memUsed, size)
+	limit := int64(g.conf.MaxQueryMem)
+	if limit > 0 && total > limit {
+		atomic.AddInt64(&g.memExceeded, 1)
+		return errno.NewError(errno.ResourceGroupMemoryExceeded, g.conf.Name, total, limit)
+	}
+	return nil
+}
+
+// Shrink gives back size bytes of the query to its group
+func (t *MemTracker) Shrink(size int64) {
+	if t == nil {
+		return
+	}
+	atomic.AddInt64(&t.used, -size)
+	atomic.AddInt64(&t.group.memUsed, -size)
+}
+
+// Group returns the resource group of the query
+func (t *MemTracker) Group() *Group {
+	if t == nil {
+		return nil
+	}
+	return t.group
+}
+
+// Used returns the memory bytes accounted to the query
+func (t *MemTracker) Used() int64 {
+	if t == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&t.used)
+}
+
+// Close gives back all the memory of the query to its group
+func (t *MemTracker) Close() {
+	if t == nil {
+		return
+	}
+	atomic.AddInt64(&t.group.memUsed, -atomic.SwapInt64(&t.used, 0))
+}
+
+type memTrackerKey struct{}
+
+// NewContextWithMemTracker returns a new context with the memory tracker of the query
+func NewContextWithMemTracker(ctx context.Context, t *MemTracker) context.Context {
+	if t == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, memTrackerKey{}, t)
+}
+
+// MemTrackerFromContext returns the memory tracker of the query, nil if the query belongs to no group
+func MemTrackerFromContext(ctx context.Context) *MemTracker {
+	if ctx == nil {
+		return nil
+	}
+	t, _ := ctx.Value(memTrackerKey{}).(*MemTracker)
+	return t
+}
+
+// Manager maps the users and the databases to their resource groups
+type Manager struct {
+	groups []*Group
+	users  map[string]*Group
+	dbs    map[string]*Group
+}
+
+func NewManager(confs config.ResourceGroups) *Manager {
+	m := &Manager{
+		groups: make([]*Group, 0, len(confs)),
+		users:  make(map[string]*Group),
+		dbs:    make(map[string]*Group),
+	}
+	for _, conf := range confs {
+		g := newGroup(conf)
+		m.groups = append(m.groups, g)
+		for _, u := range conf.Users {
+			m.users[u] = g
+		}
+		for _, db := range conf.Databases {
+			m.dbs[db] = g
+		}
+	}
+	sort.Slice(m.groups, func(i, j int) bool {
+		return m.groups[i].Name() < m.groups[j].Name()
+	})
+	return m
+}
+
+// Match returns the resource group of the query run by user against db, nil if neither is assigned.
+// The group of the user wins over the group of the database.
+func (m *Manager) Match(user, db string) *Group {
+	if m == nil {
+		return nil
+	}
+	if g, ok := m.users[user]; ok && user != "" {
+		return g
+	}
+	return m.dbs[db]
+}
+
+func (m *Manager) Groups() []*Group {
+	if m == nil {
+		return nil
+	}
+	return m.groups
+}
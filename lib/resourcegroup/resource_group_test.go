@@ -0,0 +1,136 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcegroup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
+	"github.com/stretchr/testify/require"
+)
+
+func newManager() *resourcegroup.Manager {
+	return resourcegroup.NewManager(config.ResourceGroups{
+		{Name: "dashboard", MaxConcurrentQueries: 1, MaxQueuedQueries: 1, MaxQueryMem: 1024, CPUShare: 50, Users: []string{"grafana"}},
+		{Name: "batch", Databases: []string{"db0"}},
+	})
+}
+
+func TestManager_Match(t *testing.T) {
+	m := newManager()
+	require.Equal(t, "dashboard", m.Match("grafana", "db0").Name())
+	require.Equal(t, "batch", m.Match("admin", "db0").Name())
+	require.Equal(t, "batch", m.Match("", "db0").Name())
+	require.Nil(t, m.Match("admin", "db1"))
+	require.Equal(t, 2, len(m.Groups()))
+	require.Equal(t, "batch", m.Groups()[0].Name())
+
+	var nilManager *resourcegroup.Manager
+	require.Nil(t, nilManager.Match("grafana", "db0"))
+	require.Nil(t, nilManager.Groups())
+}
+
+func TestGroup_Acquire(t *testing.T) {
+	g := newManager().Match("grafana", "")
+	require.NoError(t, g.Acquire(nil, 0))
+
+	// the second query waits in the queue until the first one is released
+	acquired := make(chan error)
+	go func() {
+		acquired <- g.Acquire(nil, 0)
+	}()
+	require.Eventually(t, func() bool {
+		return g.Stat().Queued == 1
+	}, time.Second, time.Millisecond)
+
+	// the queue is full
+	err := g.Acquire(nil, 0)
+	require.True(t, errno.Equal(err, errno.ResourceGroupQueueFull))
+
+	g.Release()
+	require.NoError(t, <-acquired)
+	stat := g.Stat()
+	require.Equal(t, 1, stat.Running)
+	require.Equal(t, 0, stat.Queued)
+	require.Equal(t, int64(2), stat.Admitted)
+	require.Equal(t, int64(1), stat.Rejected)
+
+	err = g.Acquire(nil, 10*time.Millisecond)
+	require.True(t, errno.Equal(err, errno.ResourceGroupQueueTimeout))
+
+	abort := make(chan struct{})
+	close(abort)
+	err = g.Acquire(abort, 0)
+	require.True(t, errno.Equal(err, errno.QueryAborted))
+	require.Equal(t, 0, g.Stat().Queued)
+
+	g.Release()
+	require.Equal(t, 0, g.Stat().Running)
+
+	// no limit of the running queries
+	batch := newManager().Match("", "db0")
+	for i := 0; i < 10; i++ {
+		require.NoError(t, batch.Acquire(nil, 0))
+	}
+	require.Equal(t, 10, batch.Stat().Running)
+}
+
+func TestMemTracker(t *testing.T) {
+	g := newManager().Match("grafana", "")
+	t1, t2 := g.NewMemTracker(), g.NewMemTracker()
+	require.NoError(t, t1.Grow(512))
+	require.NoError(t, t2.Grow(512))
+	err := t2.Grow(1)
+	require.True(t, errno.Equal(err, errno.ResourceGroupMemoryExceeded))
+	require.Equal(t, int64(513), t2.Used())
+	require.Equal(t, int64(1025), g.Stat().MemUsed)
+	require.Equal(t, int64(1), g.Stat().MemExceeded)
+
+	t2.Shrink(1)
+	require.Equal(t, int64(512), t2.Used())
+	require.Equal(t, int64(1024), g.Stat().MemUsed)
+	t2.Close()
+	require.Equal(t, int64(512), g.Stat().MemUsed)
+	require.NoError(t, t1.Grow(512))
+
+	ctx := resourcegroup.NewContextWithMemTracker(context.Background(), t1)
+	require.Equal(t, t1, resourcegroup.MemTrackerFromContext(ctx))
+	require.Equal(t, g, resourcegroup.MemTrackerFromContext(ctx).Group())
+
+	var nilTracker *resourcegroup.MemTracker
+	require.NoError(t, nilTracker.Grow(1<<30))
+	require.Equal(t, int64(0), nilTracker.Used())
+	require.Nil(t, nilTracker.Group())
+	nilTracker.Shrink(1)
+	nilTracker.Close()
+	ctx = resourcegroup.NewContextWithMemTracker(context.Background(), nil)
+	require.Nil(t, resourcegroup.MemTrackerFromContext(ctx))
+}
+
+func TestGroup_Parallelism(t *testing.T) {
+	m := newManager()
+	require.Equal(t, 4, m.Match("grafana", "").Parallelism(8))
+	require.Equal(t, 1, m.Match("grafana", "").Parallelism(1))
+	require.True(t, m.Match("grafana", "").Parallelism(-1) >= 1)
+	require.Equal(t, 8, m.Match("", "db0").Parallelism(8))
+	require.Equal(t, -1, m.Match("", "db0").Parallelism(-1))
+
+	var nilGroup *resourcegroup.Group
+	require.Equal(t, 8, nilGroup.Parallelism(8))
+}
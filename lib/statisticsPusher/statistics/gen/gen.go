@@ -26,3 +26,9 @@ package gen
 
 //go:generate tmpl -data=@hit_ratio.data -o=../hit_ratio.gen.go statistics.tmpl
 //go:generate tmpl -data=@hit_ratio.data -o=../hit_ratio.gen_test.go statistics_test.tmpl
+
+//go:generate tmpl -data=@wal.data -o=../wal_statistics.gen.go statistics.tmpl
+//go:generate tmpl -data=@wal.data -o=../wal_statistics.gen_test.go statistics_test.tmpl
+
+//go:generate tmpl -data=@query_cache.data -o=../query_cache_statistics.gen.go statistics.tmpl
+//go:generate tmpl -data=@query_cache.data -o=../query_cache_statistics.gen_test.go statistics_test.tmpl
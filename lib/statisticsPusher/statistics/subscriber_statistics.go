@@ -0,0 +1,155 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics/opsStat"
+)
+
+const (
+	subscriberStatisticsName = "subscriber"
+
+	StatSubscriberDatabase        = "database"
+	StatSubscriberRetentionPolicy = "retentionPolicy"
+	StatSubscriberSubscription    = "subscription"
+	StatSubscriberDestination     = "destination"
+
+	StatSubscriberWriteReq     = "writeReq"
+	StatSubscriberDeliveredReq = "deliveredReq"
+	StatSubscriberDroppedReq   = "droppedReq"
+	StatSubscriberHandedOffReq = "handedOffReq"
+	StatSubscriberRetries      = "retries"
+	StatSubscriberPendingReq   = "pendingReq"
+	StatSubscriberPendingBytes = "pendingBytes"
+	StatSubscriberLag          = "lag"
+)
+
+// SubscriberStatItem is the statistics of a destination of a subscription.
+type SubscriberStatItem struct {
+	Database        string
+	RetentionPolicy string
+	Subscription    string
+	Destination     string
+
+	WriteReq     int64
+	DeliveredReq int64
+	DroppedReq   int64
+	// HandedOffReq is the number of the line protocol handed to the other destinations while this one is down
+	HandedOffReq int64
+	Retries      int64
+	PendingReq   int64
+	PendingBytes int64
+	// Lag is the age in nanoseconds of the oldest line protocol which is not delivered
+	Lag int64
+}
+
+type subscriberKey struct {
+	db, rp, sub, dest string
+}
+
+type SubscriberStatistics struct {
+	mu    sync.RWMutex
+	tags  map[string]string
+	items map[subscriberKey]*SubscriberStatItem
+}
+
+var subscriberStat = &SubscriberStatistics{items: make(map[subscriberKey]*SubscriberStatItem)}
+
+func NewSubscriberStatistics() *SubscriberStatistics {
+	return subscriberStat
+}
+
+func (s *SubscriberStatistics) Init(tags map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tags = make(map[string]string)
+	AllocTagMap(s.tags, tags)
+}
+
+// Item returns the statistics of the destination of the subscription, the item is created if it does not exist.
+func (s *SubscriberStatistics) Item(db, rp, sub, dest string) *SubscriberStatItem {
+	key := subscriberKey{db: db, rp: rp, sub: sub, dest: dest}
+	s.mu.RLock()
+	item, ok := s.items[key]
+	s.mu.RUnlock()
+	if ok {
+		return item
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if item, ok = s.items[key]; !ok {
+		item = &SubscriberStatItem{Database: db, RetentionPolicy: rp, Subscription: sub, Destination: dest}
+		s.items[key] = item
+	}
+	return item
+}
+
+// Remove removes the statistics of all the destinations of the subscription.
+func (s *SubscriberStatistics) Remove(db, rp, sub string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.items {
+		if key.db == db && key.rp == rp && key.sub == sub {
+			delete(s.items, key)
+		}
+	}
+}
+
+func (s *SubscriberStatistics) walk(fn func(tags map[string]string, fields map[string]interface{})) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, item := range s.items {
+		tags := make(map[string]string, len(s.tags)+4)
+		AllocTagMap(tags, s.tags)
+		tags[StatSubscriberDatabase] = item.Database
+		tags[StatSubscriberRetentionPolicy] = item.RetentionPolicy
+		tags[StatSubscriberSubscription] = item.Subscription
+		tags[StatSubscriberDestination] = item.Destination
+		fields := map[string]interface{}{
+			StatSubscriberWriteReq:     atomic.LoadInt64(&item.WriteReq),
+			StatSubscriberDeliveredReq: atomic.LoadInt64(&item.DeliveredReq),
+			StatSubscriberDroppedReq:   atomic.LoadInt64(&item.DroppedReq),
+			StatSubscriberHandedOffReq: atomic.LoadInt64(&item.HandedOffReq),
+			StatSubscriberRetries:      atomic.LoadInt64(&item.Retries),
+			StatSubscriberPendingReq:   atomic.LoadInt64(&item.PendingReq),
+			StatSubscriberPendingBytes: atomic.LoadInt64(&item.PendingBytes),
+			StatSubscriberLag:          atomic.LoadInt64(&item.Lag),
+		}
+		fn(tags, fields)
+	}
+}
+
+func (s *SubscriberStatistics) Collect(buffer []byte) ([]byte, error) {
+	s.walk(func(tags map[string]string, fields map[string]interface{}) {
+		buffer = AddPointToBuffer(subscriberStatisticsName, tags, fields, buffer)
+	})
+	return buffer, nil
+}
+
+func (s *SubscriberStatistics) CollectOps() []opsStat.OpsStatistic {
+	var stats []opsStat.OpsStatistic
+	s.walk(func(tags map[string]string, fields map[string]interface{}) {
+		stats = append(stats, opsStat.OpsStatistic{
+			Name:   subscriberStatisticsName,
+			Tags:   tags,
+			Values: fields,
+		})
+	})
+	return stats
+}
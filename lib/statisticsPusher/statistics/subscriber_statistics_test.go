@@ -0,0 +1,63 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriberStatistics(t *testing.T) {
+	stat := statistics.NewSubscriberStatistics()
+	stat.Init(map[string]string{"hostname": "127.0.0.1:8090", "app": "ts-sql"})
+	statistics.NewTimestamp().Init(time.Second)
+
+	item := stat.Item("db0", "rp0", "sub0", "http://127.0.0.1:8086")
+	require.Same(t, item, stat.Item("db0", "rp0", "sub0", "http://127.0.0.1:8086"))
+	atomic.AddInt64(&item.WriteReq, 3)
+	atomic.AddInt64(&item.DeliveredReq, 2)
+	atomic.AddInt64(&item.DroppedReq, 1)
+	atomic.StoreInt64(&item.Lag, int64(time.Second))
+
+	buf, err := stat.Collect(nil)
+	require.NoError(t, err)
+	tags := map[string]string{
+		"hostname":        "127.0.0.1:8090",
+		"app":             "ts-sql",
+		"database":        "db0",
+		"retentionPolicy": "rp0",
+		"subscription":    "sub0",
+		"destination":     "http://127.0.0.1:8086",
+	}
+	fields := map[string]interface{}{
+		"writeReq":     int64(3),
+		"deliveredReq": int64(2),
+		"droppedReq":   int64(1),
+		"handedOffReq": int64(0),
+		"retries":      int64(0),
+		"pendingReq":   int64(0),
+		"pendingBytes": int64(0),
+		"lag":          int64(time.Second),
+	}
+	require.NoError(t, compareBuffer("subscriber", tags, fields, buf))
+	require.Equal(t, 1, len(stat.CollectOps()))
+
+	stat.Remove("db0", "rp0", "sub0")
+	require.Empty(t, stat.CollectOps())
+}
@@ -23,6 +23,7 @@ var (
 	EnablePrintLogicalPlan    int64 = 0
 	EnableSlidingWindowPushUp int64 = 0
 	EnableForceBroadcastQuery int64 = 0
+	EnableCostBasedOptimizer  int64 = 0
 	OnSlidingWindowPushUp     int64 = 0
 	OnPrintLogicalPlan        int64 = 1
 	OnForceBroadcastQuery     int64 = 1
@@ -62,6 +63,14 @@ func GetEnableForceBroadcastQuery() int64 {
 	return atomic.LoadInt64(&EnableForceBroadcastQuery)
 }
 
+func SetEnableCostBasedOptimizer(enabled int64) {
+	atomic.StoreInt64(&EnableCostBasedOptimizer, enabled)
+}
+
+func GetEnableCostBasedOptimizer() int64 {
+	return atomic.LoadInt64(&EnableCostBasedOptimizer)
+}
+
 func SetQuerySchemaLimit(limit int) {
 	querySchemaLimit = limit
 }
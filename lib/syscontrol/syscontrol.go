@@ -80,6 +80,7 @@ curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=print_logical_plan&enabled=
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=sliding_window_push_up&enabled=1'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=log_rows&switchon=true&rules=mst,tk1=tv1'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=force_broadcast_query&enabled=1'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=cost_based_optimizer&enabled=1'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=time_filter_protection&enabled=true'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=disablewrite&switchon=true'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=disableread&switchon=true'
@@ -96,6 +97,7 @@ const (
 	PrintLogicalPlan      = "print_logical_plan"
 	SlidingWindowPushUp   = "sliding_window_push_up"
 	ForceBroadcastQuery   = "force_broadcast_query"
+	CostBasedOptimizer    = "cost_based_optimizer"
 	Failpoint             = "failpoint"
 	NodeReadonly          = "readonly"
 	LogRows               = "log_rows"
@@ -451,6 +453,17 @@ func ProcessRequest(req netstorage.SysCtrlRequest, resp *strings.Builder) (err e
 		sysconfig.SetEnableForceBroadcastQuery(enabled)
 		res := "\n\tsuccess"
 		resp.WriteString(res)
+	case CostBasedOptimizer:
+		enabled, err := GetIntValue(req.Param(), "enabled")
+		if err != nil {
+			return err
+		}
+		if enabled != 0 && enabled != 1 {
+			return fmt.Errorf("invalid enabled:%v", enabled)
+		}
+		sysconfig.SetEnableCostBasedOptimizer(enabled)
+		res := "\n\tsuccess"
+		resp.WriteString(res)
 	case TimeFilterProtection:
 		enabled, err := GetBoolValue(req.Param(), "enabled")
 		if err != nil {
@@ -159,6 +159,32 @@ func TestProcessRequest_ForceBroadcastQuery(t *testing.T) {
 	sb.Reset()
 }
 
+func TestProcessRequest_CostBasedOptimizer(t *testing.T) {
+	SysCtrl.MetaClient = &mockMetaClient{}
+	SysCtrl.NetStore = &mockStorage{}
+	var req netstorage.SysCtrlRequest
+	req.SetMod("cost_based_optimizer")
+	req.SetParam(map[string]string{
+		"enabled": "1",
+	})
+	var sb strings.Builder
+	require.NoError(t, ProcessRequest(req, &sb))
+	require.Contains(t, sb.String(), "\n\tsuccess")
+	assert.Equal(t, int64(1), sysconfig.GetEnableCostBasedOptimizer())
+	sb.Reset()
+
+	req.SetParam(map[string]string{
+		"enabled": "2",
+	})
+	require.Error(t, ProcessRequest(req, &sb))
+
+	req.SetParam(map[string]string{
+		"enabled": "0",
+	})
+	require.NoError(t, ProcessRequest(req, &sb))
+	assert.Equal(t, int64(0), sysconfig.GetEnableCostBasedOptimizer())
+}
+
 func TestProcessRequest_ForceBroadcastQueryInvalid(t *testing.T) {
 	SysCtrl.MetaClient = &mockMetaClient{}
 	SysCtrl.NetStore = &mockStorage{}
@@ -92,3 +92,19 @@ func (a WriteAuthorizer) AuthorizeWrite(username, database string) error {
 	}
 	return nil
 }
+
+// AuthorizeWriteMeasurements returns nil if the user has permission to write to the database or
+// some of its measurements. The measurement of each point must be checked by the user before writing.
+func (a WriteAuthorizer) AuthorizeWriteMeasurements(username, database string) error {
+	u, err := a.Client.User(username)
+	if err != nil || u == nil {
+		return &meta.ErrAuthorize{
+			Database: database,
+			Message:  fmt.Sprintf("%s not authorized to write to %s", username, database),
+		}
+	}
+	if ui, ok := u.(*meta.UserInfo); ok && ui.AuthorizeAnyMeasurement(originql.WritePrivilege, database) {
+		return nil
+	}
+	return a.AuthorizeWrite(username, database)
+}
@@ -42,10 +42,13 @@ import (
 	"github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/querycache"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -99,6 +102,16 @@ type StatementExecutor struct {
 	// hostname for show configs statement
 	Hostname   string
 	SqlConfigs map[string]interface{}
+
+	// ContinuousQueryBackfiller runs the BACKFILL CONTINUOUS QUERY statements, nil if the continuous query service is disabled
+	ContinuousQueryBackfiller ContinuousQueryBackfiller
+
+	// ResultCache is the cache of the range query results invalidated by the drop statements, nil if it is disabled
+	ResultCache *querycache.Cache
+}
+
+type ContinuousQueryBackfiller interface {
+	Backfill(database, name string, startTime, endTime time.Time, abort <-chan struct{}) (int, int64, error)
 }
 
 type combinedRunState uint8
@@ -110,12 +123,13 @@ const (
 )
 
 type combinedQueryExeInfo struct {
-	qid          uint64
-	stmt         string
-	database     string
-	beginTime    int64
-	runningHosts map[string]struct{}
-	killedHosts  map[string]struct{}
+	qid           uint64
+	stmt          string
+	database      string
+	beginTime     int64
+	runningHosts  map[string]struct{}
+	killedHosts   map[string]struct{}
+	resourceGroup string
 }
 
 func (q *combinedQueryExeInfo) updateBeginTime(newBegin int64) {
@@ -177,6 +191,7 @@ func (q *combinedQueryExeInfo) toOutputRow(colNum int, isKilledPart bool) []inte
 	} else {
 		res = append(res, "running", hostsJoined(q.runningHosts))
 	}
+	res = append(res, q.resourceGroup)
 
 	return res
 }
@@ -274,6 +289,11 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeDropContinuousQueryStatement(stmt)
+	case *influxql.BackfillContinuousQueryStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		rows, err = e.executeBackfillContinuousQueryStatement(stmt, ctx)
 	case *influxql.CreateUserStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -331,18 +351,39 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
-		// TODO: transform to `github.com/influxdata/influxql` RevokeStatement
-		stmt1 := originql.RevokeStatement{
-			Privilege: originql.Privilege(stmt.Privilege),
-			On:        stmt.On,
-			User:      stmt.User,
-		}
-		err = e.executeRevokeStatement(&stmt1)
+		err = e.executeRevokeStatement(stmt)
 	case *influxql.RevokeAdminStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeRevokeAdminStatement(stmt)
+	case *influxql.DenyStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDenyStatement(stmt)
+	case *influxql.CreateRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeCreateRoleStatement(stmt)
+	case *influxql.DropRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropRoleStatement(stmt)
+	case *influxql.GrantRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeGrantRoleStatement(stmt)
+	case *influxql.RevokeRoleStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeRevokeRoleStatement(stmt)
+	case *influxql.ShowRolesStatement:
+		rows, err = e.executeShowRolesStatement(stmt)
 	case *influxql.ShowDatabasesStatement:
 		rows, err = e.executeShowDatabasesStatement(stmt, ctx)
 	case *influxql.ShowDiagnosticsStatement:
@@ -402,6 +443,8 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		err = e.executeSetPasswordUserStatement(stmt)
 	case *influxql.ShowQueriesStatement:
 		rows, err = e.executeShowQueriesStatement()
+	case *influxql.ShowResourceGroupsStatement:
+		return e.TaskManager.ExecuteStatement(stmt, ctx, seq)
 	case *influxql.KillQueryStatement:
 		err = e.executeKillQuery(stmt)
 	case *influxql.PrepareSnapshotStatement:
@@ -834,10 +877,34 @@ func (e *StatementExecutor) executeDropContinuousQueryStatement(stmt *influxql.D
 	return nil
 }
 
+// executeBackfillContinuousQueryStatement runs a continuous query over a past time range.
+func (e *StatementExecutor) executeBackfillContinuousQueryStatement(stmt *influxql.BackfillContinuousQueryStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	if e.ContinuousQueryBackfiller == nil {
+		return nil, errors.New("continuous query service is disabled")
+	}
+	if stmt.Database == "" {
+		return nil, coordinator.ErrDatabaseNameRequired
+	}
+	e.StmtExecLogger.Info("backfill continuous query start", zap.String("cq name", stmt.Name), zap.String("database", stmt.Database))
+	chunks, written, err := e.ContinuousQueryBackfiller.Backfill(stmt.Database, stmt.Name, stmt.StartTime, stmt.EndTime, ctx.Done())
+	if err != nil {
+		e.StmtExecLogger.Error("backfill continuous query error", zap.String("cq name", stmt.Name), zap.String("database", stmt.Database),
+			zap.Int("chunks", chunks), zap.Int64("written", written), zap.Error(err))
+		return nil, err
+	}
+	return models.Rows{{
+		Columns: []string{"chunks", "written"},
+		Values:  [][]interface{}{{chunks, written}},
+	}}, nil
+}
+
 func (e *StatementExecutor) executeCreateSubscriptionStatement(q *influxql.CreateSubscriptionStatement) error {
 	if !config.GetSubscriptionEnable() {
 		return errors.New("subscription is not enabled")
 	}
+	if !meta2.ValidSubscriptionName(q.Name) {
+		return meta2.ErrInvalidName
+	}
 	return e.MetaClient.CreateSubscription(q.Database, q.RetentionPolicy, q.Name, q.Mode, q.Destinations)
 }
 
@@ -863,6 +930,9 @@ func (e *StatementExecutor) executeDropDatabaseStatement(stmt *influxql.DropData
 		return err
 	}
 
+	if e.ResultCache != nil {
+		e.ResultCache.InvalidateDatabase(stmt.Name)
+	}
 	return nil
 }
 
@@ -871,7 +941,13 @@ func (e *StatementExecutor) executeDropMeasurementStatement(stmt *influxql.DropM
 		return err
 	}
 
-	return e.MetaClient.MarkMeasurementDelete(database, stmt.RpName, stmt.Name)
+	if err := e.MetaClient.MarkMeasurementDelete(database, stmt.RpName, stmt.Name); err != nil {
+		return err
+	}
+	if e.ResultCache != nil {
+		e.ResultCache.InvalidateMeasurement(database, stmt.Name)
+	}
+	return nil
 }
 
 func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.DropRetentionPolicyStatement) error {
@@ -891,6 +967,10 @@ func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.D
 	}
 
 	e.StmtExecLogger.Info("suc delete rp ", zap.String("db", stmt.Database), zap.String("rp", stmt.Name))
+	if e.ResultCache != nil {
+		// the cached results are not tracked by the retention policy
+		e.ResultCache.InvalidateDatabase(stmt.Database)
+	}
 
 	return nil
 }
@@ -907,7 +987,25 @@ func (e *StatementExecutor) executeDropUserStatement(q *influxql.DropUserStateme
 }
 
 func (e *StatementExecutor) executeExplainStatement(q *influxql.ExplainStatement, ctx *query.ExecutionContext) (models.Rows, error) {
-	panic("impl me")
+	sopt := e.GetOptions(ctx.ExecutionOptions, nil)
+	prepared, err := query.Prepare(q.Statement, e.ShardMapper, sopt)
+	if err != nil {
+		return nil, err
+	}
+	defer util.MustClose(prepared)
+
+	plan, err := prepared.Explain()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{
+		Columns: []string{"EXPLAIN"},
+	}
+	for _, s := range strings.Split(strings.TrimSpace(plan), "\n") {
+		row.Values = append(row.Values, []interface{}{s})
+	}
+	return models.Rows{row}, nil
 }
 
 func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainStatement, ectx *query.ExecutionContext) (models.Rows, error) {
@@ -982,27 +1080,53 @@ func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainSt
 }
 
 func (e *StatementExecutor) executeGrantStatement(stmt *influxql.GrantStatement) error {
-	return e.MetaClient.SetPrivilege(stmt.User, stmt.On, originql.Privilege(stmt.Privilege))
+	if stmt.Measurement == "" {
+		return e.MetaClient.SetPrivilege(stmt.User, stmt.On, originql.Privilege(stmt.Privilege))
+	}
+	return e.MetaClient.SetMeasurementPrivilege(stmt.User, stmt.On, stmt.Measurement, originql.Privilege(stmt.Privilege), false)
 }
 
-func (e *StatementExecutor) executeGrantAdminStatement(stmt *influxql.GrantAdminStatement) error {
-	return e.MetaClient.SetAdminPrivilege(stmt.User, true)
+func (e *StatementExecutor) executeDenyStatement(stmt *influxql.DenyStatement) error {
+	return e.MetaClient.SetMeasurementPrivilege(stmt.User, stmt.On, stmt.Measurement, originql.Privilege(stmt.Privilege), true)
 }
 
-func (e *StatementExecutor) executeRevokeStatement(stmt *originql.RevokeStatement) error {
-	priv := originql.NoPrivileges
+func (e *StatementExecutor) executeCreateRoleStatement(stmt *influxql.CreateRoleStatement) error {
+	return e.MetaClient.CreateRole(stmt.Name)
+}
 
-	// Revoking all privileges means there's no need to look at existing user privileges.
-	if stmt.Privilege != originql.AllPrivileges {
-		p, err := e.MetaClient.UserPrivilege(stmt.User, stmt.On)
-		if err != nil {
-			return err
+func (e *StatementExecutor) executeDropRoleStatement(stmt *influxql.DropRoleStatement) error {
+	return e.MetaClient.DropRole(stmt.Name)
+}
+
+func (e *StatementExecutor) executeGrantRoleStatement(stmt *influxql.GrantRoleStatement) error {
+	return e.MetaClient.SetUserRole(stmt.User, stmt.Role, false)
+}
+
+func (e *StatementExecutor) executeRevokeRoleStatement(stmt *influxql.RevokeRoleStatement) error {
+	return e.MetaClient.SetUserRole(stmt.User, stmt.Role, true)
+}
+
+func (e *StatementExecutor) executeShowRolesStatement(q *influxql.ShowRolesStatement) (models.Rows, error) {
+	users := e.MetaClient.Users()
+	row := &models.Row{Columns: []string{"role", "users"}}
+	for _, role := range e.MetaClient.Roles() {
+		var names []string
+		for i := range users {
+			if users[i].HasRole(role.Name) {
+				names = append(names, users[i].Name)
+			}
 		}
-		// Bit clear (AND NOT) the user's privilege with the revoked privilege.
-		priv = *p &^ stmt.Privilege
+		row.Values = append(row.Values, []interface{}{role.Name, strings.Join(names, ",")})
 	}
+	return []*models.Row{row}, nil
+}
 
-	return e.MetaClient.SetPrivilege(stmt.User, stmt.On, priv)
+func (e *StatementExecutor) executeGrantAdminStatement(stmt *influxql.GrantAdminStatement) error {
+	return e.MetaClient.SetAdminPrivilege(stmt.User, true)
+}
+
+func (e *StatementExecutor) executeRevokeStatement(stmt *influxql.RevokeStatement) error {
+	return e.MetaClient.RevokePrivilege(stmt.User, stmt.On, stmt.Measurement, originql.Privilege(stmt.Privilege))
 }
 
 func (e *StatementExecutor) executeRevokeAdminStatement(stmt *influxql.RevokeAdminStatement) error {
@@ -1089,6 +1213,7 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	go func() {
 		defer wg.Done()
 		ctxWithWriter := context.WithValue(context.Background(), executor.WRITER_CONTEXT, ctx.PointsWriter)
+		ctxWithWriter = resourcegroup.NewContextWithMemTracker(ctxWithWriter, resourcegroup.MemTrackerFromContext(ctx))
 		ec <- pipelineExecutor.ExecuteExecutor(ctxWithWriter)
 		close(ec)
 		proxy.close()
@@ -1118,6 +1243,7 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 			result := &query.Result{
 				Series:  rowsChan.Rows,
 				Partial: rowsChan.Partial,
+				Schema:  rowsChan.Schema,
 			}
 
 			// Send results or exit if closing.
@@ -1215,6 +1341,8 @@ func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan
 
 func (e *StatementExecutor) createPipelineExecutor(ctx context.Context, stmt *influxql.SelectStatement, opt query.ExecutionOptions, rowsChan chan query.RowsChan) (pipelineExecutor *executor.PipelineExecutor, err error) {
 	sopt := e.GetOptions(opt, rowsChan)
+	// the cpu share of the resource group limits the parallelism of the query
+	sopt.MaxQueryParallel = resourcegroup.MemTrackerFromContext(ctx).Group().Parallelism(sopt.MaxQueryParallel)
 
 	defer func() {
 		if e := recover(); e != nil {
@@ -1409,14 +1537,18 @@ func getPrimaryKey(mst *meta2.MeasurementInfo) *models.Row {
 }
 
 func (e *StatementExecutor) executeShowGrantsForUserStatement(q *influxql.ShowGrantsForUserStatement) (models.Rows, error) {
-	priv, err := e.MetaClient.UserPrivileges(q.Name)
+	grants, err := e.MetaClient.Grants(q.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	row := &models.Row{Columns: []string{"database", "privilege"}}
-	for d, p := range priv {
-		row.Values = append(row.Values, []interface{}{d, p.String()})
+	row := &models.Row{Columns: []string{"database", "privilege", "measurement", "type", "role"}}
+	for _, g := range grants {
+		typ := "GRANT"
+		if g.Deny {
+			typ = "DENY"
+		}
+		row.Values = append(row.Values, []interface{}{g.Database, g.Privilege.String(), g.Measurement, typ, g.Role})
 	}
 	return []*models.Row{row}, nil
 }
@@ -1434,6 +1566,7 @@ func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMea
 	if err != nil {
 		return err
 	}
+	measurements = authorizedMeasurements(ctx.ExecutionOptions.Authorizer, q.Database, measurements)
 
 	if q.Offset > 0 {
 		if q.Offset >= len(measurements) {
@@ -1468,6 +1601,24 @@ func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMea
 	}, seq)
 }
 
+// authorizedMeasurements returns the measurements the user is authorized to read,
+// the measurements are not filtered if the authorizer does not check measurements.
+func authorizedMeasurements(a query.FineAuthorizer, database string, measurements []string) []string {
+	ma, ok := a.(interface {
+		AuthorizeMeasurement(p originql.Privilege, database, measurement string) bool
+	})
+	if !ok {
+		return measurements
+	}
+	authorized := measurements[:0]
+	for _, name := range measurements {
+		if ma.AuthorizeMeasurement(originql.ReadPrivilege, database, name) {
+			authorized = append(authorized, name)
+		}
+	}
+	return authorized
+}
+
 func (e *StatementExecutor) executeShowMeasurementsDetailStatement(stmt *influxql.ShowMeasurementsDetailStatement, ctx *query.ExecutionContext, seq int) error {
 	if stmt.Database == "" {
 		return coordinator.ErrDatabaseNameRequired
@@ -2124,11 +2275,12 @@ func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
 	}
 	sort.Sort(sortedResult)
 
-	row := models.Row{Columns: []string{"qid", "query", "database", "duration", "status", "host"}}
+	row := models.Row{Columns: []string{"qid", "query", "database", "duration", "status", "host", "resource_group"}}
 	values := make([][]interface{}, 0, len(resMap))
 
 	// Generate output row for every query
 	for _, cmbInfo := range sortedResult {
+		cmbInfo.resourceGroup = e.resourceGroupOf(cmbInfo.qid)
 		switch cmbInfo.getCombinedRunState() {
 		case allKilled:
 			continue
@@ -2143,6 +2295,14 @@ func (e *StatementExecutor) executeShowQueriesStatement() (models.Rows, error) {
 	return models.Rows{&row}, nil
 }
 
+// resourceGroupOf returns the resource group of the query, the query is only known by the sql node it is attached to
+func (e *StatementExecutor) resourceGroupOf(qid uint64) string {
+	if tm, ok := e.TaskManager.(*query.TaskManager); ok {
+		return tm.ResourceGroupOf(qid)
+	}
+	return ""
+}
+
 func (e *StatementExecutor) getQueryExeInfoOnNode(nodeID uint64) []*netstorage.QueryExeInfo {
 	exeInfos, err := e.NetStorage.GetQueriesOnNode(nodeID)
 	if err != nil {
@@ -2284,6 +2444,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.BackfillContinuousQueryStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.ShowMeasurementsStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
@@ -1,12 +1,14 @@
 package coordinator
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/errno"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
@@ -268,6 +270,39 @@ func TestStatementExecutor_executeKillQuery(t *testing.T) {
 	assert.NoError(t, err2)
 }
 
+type mockBackfiller struct {
+	database, name string
+}
+
+func (b *mockBackfiller) Backfill(database, name string, startTime, endTime time.Time, abort <-chan struct{}) (int, int64, error) {
+	if database != b.database || name != b.name {
+		return 0, 0, meta2.ErrContinuousQueryNotFound
+	}
+	return 2, 10, nil
+}
+
+func TestStatementExecutor_executeBackfillContinuousQueryStatement(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	ctx := &query.ExecutionContext{Context: context.Background()}
+	stmt := &influxql.BackfillContinuousQueryStatement{Name: "cq0", Database: "db0", StartTime: time.Unix(0, 0), EndTime: time.Unix(3600, 0)}
+	_, err := e.executeBackfillContinuousQueryStatement(stmt, ctx)
+	assert.EqualError(t, err, "continuous query service is disabled")
+
+	e.ContinuousQueryBackfiller = &mockBackfiller{database: "db0", name: "cq0"}
+	rows, err := e.executeBackfillContinuousQueryStatement(stmt, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"chunks", "written"}, rows[0].Columns)
+	assert.Equal(t, []interface{}{2, int64(10)}, rows[0].Values[0])
+
+	stmt.Name = "cq1"
+	_, err = e.executeBackfillContinuousQueryStatement(stmt, ctx)
+	assert.Equal(t, meta2.ErrContinuousQueryNotFound, err)
+
+	stmt.Database = ""
+	_, err = e.executeBackfillContinuousQueryStatement(stmt, ctx)
+	assert.Error(t, err)
+}
+
 func TestStatementExecutor_executeCreateContinuousQueryStatement(t *testing.T) {
 	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
 	err := e.executeCreateContinuousQueryStatement(
@@ -397,3 +432,20 @@ func TestStatementExecutor_NormalizeStatement(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func Test_authorizedMeasurements(t *testing.T) {
+	data := &meta2.Data{PtNumPerNode: 1}
+	data.Databases = map[string]*meta2.DatabaseInfo{"db0": {Name: "db0"}}
+	assert.NoError(t, data.CreateUser("reader", "xxxxhashxxxx", false, false))
+	assert.NoError(t, data.SetPrivilege("reader", "db0", originql.ReadPrivilege))
+	assert.NoError(t, data.SetMeasurementPrivilege("reader", "db0", "secret", originql.ReadPrivilege, true))
+	assert.NoError(t, data.CreateUser("cpu_reader", "xxxxhashxxxx", false, false))
+	assert.NoError(t, data.SetMeasurementPrivilege("cpu_reader", "db0", "cpu", originql.ReadPrivilege, false))
+
+	measurements := func() []string { return []string{"cpu", "mem", "secret"} }
+	assert.Equal(t, measurements(), authorizedMeasurements(query.OpenAuthorizer, "db0", measurements()))
+	// the denied measurement is hidden
+	assert.Equal(t, []string{"cpu", "mem"}, authorizedMeasurements(data.GetUser("reader"), "db0", measurements()))
+	// only the granted measurement is shown
+	assert.Equal(t, []string{"cpu"}, authorizedMeasurements(data.GetUser("cpu_reader"), "db0", measurements()))
+}
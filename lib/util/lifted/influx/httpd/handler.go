@@ -24,6 +24,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/services/httpd"
 	"github.com/influxdata/influxdb/uuid"
+	originql "github.com/influxdata/influxql"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/openGemini/openGemini/app"
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -36,6 +37,7 @@ import (
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/opentelemetry"
+	"github.com/openGemini/openGemini/lib/querycache"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
@@ -116,6 +118,14 @@ type SubscriberManager interface {
 	Send(db, rp string, lineProtocol []byte)
 }
 
+// RuleManager provides the data of the Prometheus rules and alerts API.
+type RuleManager interface {
+	RuleDiscovery(typ string) interface{}
+	AlertDiscovery() interface{}
+	// RuleOwner returns the host of the ts-sql evaluating the rules if it is not this one.
+	RuleOwner() string
+}
+
 // Handler represents an HTTP handler for the InfluxDB server.
 type Handler struct {
 	mux       *mux.Router
@@ -141,7 +151,9 @@ type Handler struct {
 		CreateStreamPolicy(info *meta2.StreamInfo) error
 		CreateStreamMeasurement(info *meta2.StreamInfo, src, dest *influxql.Measurement, stmt *influxql.SelectStatement) error
 		DropStream(name string) error
+		CreateDatabaseWithRetentionPolicy(name string, spec *meta2.RetentionPolicySpec, shardKey *meta2.ShardKeyInfo, enableTagArray bool, replicaN uint32) (*meta2.DatabaseInfo, error)
 		CreateRetentionPolicy(database string, spec *meta2.RetentionPolicySpec, makeDefault bool) (*meta2.RetentionPolicyInfo, error)
+		UpdateRetentionPolicy(database, name string, rpu *meta2.RetentionPolicyUpdate, makeDefault bool) error
 		RetentionPolicy(database, name string) (rpi *meta2.RetentionPolicyInfo, err error)
 		DBPtView(database string) (meta2.DBPtInfos, error)
 		MarkRetentionPolicyDelete(database, name string) error
@@ -150,6 +162,8 @@ type Handler struct {
 		UpdateMeasurement(db, rp, mst string, options *meta2.Options) error
 		GetShardGroupByTimeRange(repoName, streamName string, min, max time.Time) ([]*meta2.ShardGroupInfo, error)
 		RevertRetentionPolicyDelete(database, name string) error
+		UpdatePromMetadata(database, policy string, metadata []meta2.PromMetricMetadata) error
+		PromMetadata(database, policy, mst string) ([]meta2.PromMetricMetadata, error)
 	}
 
 	QueryAuthorizer interface {
@@ -158,6 +172,7 @@ type Handler struct {
 
 	WriteAuthorizer interface {
 		AuthorizeWrite(username, database string) error
+		AuthorizeWriteMeasurements(username, database string) error
 	}
 
 	ExtSysCtrl interface {
@@ -175,10 +190,13 @@ type Handler struct {
 
 	RecordWriter interface {
 		RetryWriteLogRecord(rec *record.BulkRecords) error
+		RetryWriteNativeRecord(database, retentionPolicy, measurement string, rec *record.Record) error
 	}
 
 	SubscriberManager
 
+	RuleManager RuleManager
+
 	Config           *config.Config
 	Logger           *logger.Logger
 	CLFLogger        *zap.Logger
@@ -191,6 +209,9 @@ type Handler struct {
 	slowQueries      chan *hybridqp.SelectDuration
 	StatisticsPusher *statisticsPusher.StatisticsPusher
 	SQLConfig        *config2.TSSql
+
+	// ResultCache caches the results of the range queries by the buckets of the time, nil if it is disabled
+	ResultCache *querycache.Cache
 }
 
 // NewHandler returns a new instance of handler with routes.
@@ -241,6 +262,54 @@ func NewHandler(c config.Config) *Handler {
 			"write", // Data-ingest route.
 			"POST", "/write", true, writeLogEnabled, h.serveWrite,
 		},
+		Route{
+			"write-parquet", // Parquet bulk-upload route.
+			"POST", "/write/parquet", false, writeLogEnabled, h.serveWriteParquet,
+		},
+		Route{
+			"write-v2", // InfluxDB 2.x data-ingest route.
+			"POST", "/api/v2/write", true, writeLogEnabled, h.serveWriteV2,
+		},
+		Route{
+			"buckets-v2",
+			"GET", "/api/v2/buckets", true, true, h.serveV2Buckets,
+		},
+		Route{
+			"buckets-v2",
+			"POST", "/api/v2/buckets", true, true, h.serveCreateV2Bucket,
+		},
+		Route{
+			"bucket-v2",
+			"GET", "/api/v2/buckets/{id}", true, true, h.serveV2Bucket,
+		},
+		Route{
+			"bucket-v2",
+			"PATCH", "/api/v2/buckets/{id}", true, true, h.serveUpdateV2Bucket,
+		},
+		Route{
+			"bucket-v2",
+			"DELETE", "/api/v2/buckets/{id}", true, true, h.serveDeleteV2Bucket,
+		},
+		Route{
+			"orgs-v2",
+			"GET", "/api/v2/orgs", true, true, h.serveV2Orgs,
+		},
+		Route{
+			"orgs-v2",
+			"POST", "/api/v2/orgs", true, true, h.serveModifyV2Org,
+		},
+		Route{
+			"org-v2",
+			"GET", "/api/v2/orgs/{id}", true, true, h.serveV2Org,
+		},
+		Route{
+			"org-v2",
+			"PATCH", "/api/v2/orgs/{id}", true, true, h.serveModifyV2Org,
+		},
+		Route{
+			"org-v2",
+			"DELETE", "/api/v2/orgs/{id}", true, true, h.serveModifyV2Org,
+		},
 		Route{ // Ping
 			"ping",
 			"GET", "/ping", false, true, h.servePing,
@@ -317,6 +386,54 @@ func NewHandler(c config.Config) *Handler {
 			"prometheus-metadata-query", // Prometheus metadata query
 			"GET", "/api/v1/metadata", true, true, h.servePromQueryMetaData,
 		},
+		Route{
+			"jaeger-services", // Jaeger services query
+			"GET", "/api/services", true, true, h.serveJaegerServices,
+		},
+		Route{
+			"jaeger-service-operations", // Jaeger operations query of a service
+			"GET", "/api/services/{service}/operations", true, true, h.serveJaegerServiceOperations,
+		},
+		Route{
+			"jaeger-operations", // Jaeger operations query
+			"GET", "/api/operations", true, true, h.serveJaegerOperations,
+		},
+		Route{
+			"jaeger-traces", // Jaeger traces search
+			"GET", "/api/traces", true, true, h.serveJaegerSearch,
+		},
+		Route{
+			"jaeger-trace", // Jaeger trace query by ID
+			"GET", "/api/traces/{traceID}", true, true, h.serveJaegerTrace,
+		},
+		Route{
+			"jaeger-services-db", // Jaeger services query
+			"GET", "/jaeger/{db}/api/services", true, true, h.serveJaegerServices,
+		},
+		Route{
+			"jaeger-service-operations-db", // Jaeger operations query of a service
+			"GET", "/jaeger/{db}/api/services/{service}/operations", true, true, h.serveJaegerServiceOperations,
+		},
+		Route{
+			"jaeger-operations-db", // Jaeger operations query
+			"GET", "/jaeger/{db}/api/operations", true, true, h.serveJaegerOperations,
+		},
+		Route{
+			"jaeger-traces-db", // Jaeger traces search
+			"GET", "/jaeger/{db}/api/traces", true, true, h.serveJaegerSearch,
+		},
+		Route{
+			"jaeger-trace-db", // Jaeger trace query by ID
+			"GET", "/jaeger/{db}/api/traces/{traceID}", true, true, h.serveJaegerTrace,
+		},
+		Route{
+			"prometheus-rules", // Prometheus recording and alerting rules
+			"GET", "/api/v1/rules", true, true, h.servePromRules,
+		},
+		Route{
+			"prometheus-alerts", // Prometheus active alerts
+			"GET", "/api/v1/alerts", true, true, h.servePromAlerts,
+		},
 		Route{
 			"prometheus-write-metric-store", // Prometheus remote write
 			"POST", "/prometheus/{metric_store}/api/v1/prom/write", false, true, h.servePromWriteWithMetricStore,
@@ -750,6 +867,25 @@ func (h *Handler) checkAuthorization(user meta2.User, query *influxql.Query, dat
 	return nil
 }
 
+// authorizeWriteRows returns an authorization error if the user is not authorized to write to any measurement of the rows.
+func authorizeWriteRows(user meta2.User, database string, rows []influx.Row) error {
+	var authorized string
+	for i := range rows {
+		name := rows[i].Name
+		if name == authorized {
+			continue
+		}
+		if !user.AuthorizeMeasurement(originql.WritePrivilege, database, name) {
+			return meta2.ErrAuthorize{
+				Database: database,
+				Message:  fmt.Sprintf("%s not authorized to write to %s", user.ID(), influxql.QuoteIdent(database, name)),
+			}
+		}
+		authorized = name
+	}
+	return nil
+}
+
 func (h *Handler) parseChunkSize(r *http.Request) (bool, int, int, error) {
 	// Parse chunk size. Use default if not provided or unparsable.
 	chunked := r.FormValue("chunked") == "true"
@@ -818,6 +954,14 @@ func (h *Handler) getAuthorizer(user meta2.User) query.FineAuthorizer {
 	}
 }
 
+// userName returns the name of the user running the query, empty if the authentication is disabled
+func userName(user meta2.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.ID()
+}
+
 func (h *Handler) getResultRowsCnt(r *query.Result, rows int) int {
 	// Limit the number of rows that can be returned in a non-chunked
 	// response.  This is to prevent the server from going OOM when
@@ -1104,6 +1248,7 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		UserName:        userName(user),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -1125,8 +1270,13 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		}()
 	}
 
-	// Execute query
-	results := h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
+	// Execute query, the range query is executed by the buckets of the time if the result cache is enabled
+	var results <-chan *query.Result
+	if bq := h.newInfluxBucketedQuery(q, &opts, time.Now()); bq != nil && !async && !isPipe {
+		results = h.executeBucketedQuery(bq, opts, closing, qDuration)
+	} else {
+		results = h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
+	}
 
 	// If we are running in async mode, open a goroutine to drain the results
 	// and return with a StatusNoContent.
@@ -1306,7 +1456,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			return
 		}
 
-		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), database); err != nil {
+		if err := h.WriteAuthorizer.AuthorizeWriteMeasurements(user.ID(), database); err != nil {
 			err := errno.NewError(errno.HttpForbidden)
 			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), database), http.StatusForbidden)
 			h.Logger.Error("write error:user is not authorized to write to database", zap.Error(err), zap.String("db", database), zap.String("user", user.ID()))
@@ -1383,7 +1533,13 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			if atomic.LoadInt32(&syscontrol.LogRowsRuleSwitch) == 1 {
 				h.logRowsIfNecessary(rows, uw.ReqBuf)
 			}
-			if err = h.PointsWriter.RetryWritePointRows(db, rp, rows); err != nil {
+			if h.Config.AuthEnabled {
+				err = authorizeWriteRows(user, db, rows)
+			}
+			if err == nil {
+				err = h.PointsWriter.RetryWritePointRows(db, rp, rows)
+			}
+			if err != nil {
 				ctx.ErrLock.Lock()
 				if ctx.CallbackErr == nil {
 					ctx.CallbackErr = err
@@ -1736,16 +1892,6 @@ func (h *Handler) serveLogsWrite(w http.ResponseWriter, r *http.Request, user me
 	h.writeHeader(w, http.StatusNoContent)
 }
 
-func (h *Handler) serveFluxQuery(w http.ResponseWriter, r *http.Request, user meta2.User) {
-	if syscontrol.DisableReads {
-		h.httpError(w, `disable read!`, http.StatusForbidden)
-		h.Logger.Error("read is forbidden!", zap.Bool("DisableReads", syscontrol.DisableReads))
-		return
-	}
-	h.httpError(w, "not implementation", http.StatusBadRequest)
-
-}
-
 // serveExpvar serves internal metrics in /debug/vars format over HTTP.
 func (h *Handler) serveExpvar(w http.ResponseWriter, r *http.Request) {
 	app.SetStatsResponse(h.StatisticsPusher, w, r)
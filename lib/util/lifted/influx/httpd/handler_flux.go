@@ -0,0 +1,140 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/flux"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// fluxQueryRequest is the body of the InfluxDB 2.x query API, only the query is used, the dialect
+// is always the annotated CSV with the default annotations.
+type fluxQueryRequest struct {
+	Query string    `json:"query"`
+	Type  string    `json:"type"`
+	Now   time.Time `json:"now"`
+}
+
+// queryError is the error of a statement executed for the Flux query, it keeps the HTTP status code.
+type queryError struct {
+	code int
+	err  error
+}
+
+func (e *queryError) Error() string {
+	return e.err.Error()
+}
+
+// fluxQuerier executes the InfluxQL statements the Flux query is lowered to on behalf of the user.
+type fluxQuerier struct {
+	h    *Handler
+	user meta2.User
+}
+
+func (q *fluxQuerier) Query(ctx context.Context, db, rp, sql string) (models.Rows, error) {
+	results, code, err := q.h.queryRows(ctx, q.user, db, rp, sql)
+	if err != nil {
+		return nil, &queryError{code: code, err: err}
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return results[0], nil
+}
+
+// serveFluxQuery serves the /api/v2/query endpoint, the response is the annotated CSV.
+func (h *Handler) serveFluxQuery(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if syscontrol.DisableReads {
+		h.httpError(w, `disable read!`, http.StatusForbidden)
+		h.Logger.Error("read is forbidden!", zap.Bool("DisableReads", syscontrol.DisableReads))
+		return
+	}
+
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	start := time.Now()
+	defer func() {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}()
+
+	req, err := decodeFluxQueryRequest(r, int64(h.Config.MaxBodySize))
+	if err != nil {
+		writeV2DecodeError(w, err)
+		return
+	}
+	now := req.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	results, err := flux.Execute(r.Context(), &fluxQuerier{h: h, user: user}, req.Query, now)
+	if err != nil {
+		code := http.StatusBadRequest
+		var qErr *queryError
+		if errors.As(err, &qErr) {
+			code = qErr.code
+		}
+		h.Logger.Error("flux query failed", zap.Error(err))
+		writeV2Error(w, code, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err = flux.WriteAnnotatedCSV(w, results); err != nil {
+		h.Logger.Error("write flux query results failed", zap.Error(err))
+	}
+}
+
+// decodeFluxQueryRequest decodes the request body, errTruncated is returned if the body exceeds maxBodySize.
+func decodeFluxQueryRequest(r *http.Request, maxBodySize int64) (*fluxQueryRequest, error) {
+	body, err := readAllLimited(r.Body, maxBodySize)
+	if err != nil {
+		return nil, err
+	}
+	req := &fluxQueryRequest{}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/vnd.flux":
+		req.Query = string(body)
+	case "application/json", "":
+		if err = json2.Unmarshal(body, req); err != nil {
+			return nil, fmt.Errorf("failed to decode request body: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", mediaType)
+	}
+	if req.Type != "" && req.Type != "flux" {
+		return nil, fmt.Errorf("unsupported query type %q", req.Type)
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	return req, nil
+}
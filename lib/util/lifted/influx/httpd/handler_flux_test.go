@@ -0,0 +1,98 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeFluxQueryRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/query", strings.NewReader(`from(bucket: "db")`))
+	req.Header.Set("Content-Type", "application/vnd.flux")
+	q, err := decodeFluxQueryRequest(req, 0)
+	require.NoError(t, err)
+	assert.Equal(t, `from(bucket: "db")`, q.Query)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v2/query",
+		strings.NewReader(`{"query": "from(bucket: \"db\")", "type": "flux", "now": "2024-01-01T00:00:00Z", "dialect": {"annotations": ["group"]}}`))
+	req.Header.Set("Content-Type", "application/json")
+	q, err = decodeFluxQueryRequest(req, 0)
+	require.NoError(t, err)
+	assert.Equal(t, `from(bucket: "db")`, q.Query)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), q.Now)
+
+	for contentType, body := range map[string]string{
+		"application/json":     `{"query": "x", "type": "influxql"}`,
+		"application/xml":      `x`,
+		"application/vnd.flux": ` `,
+		"":                     `{`,
+	} {
+		req = httptest.NewRequest(http.MethodPost, "/api/v2/query", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		_, err = decodeFluxQueryRequest(req, 0)
+		assert.Error(t, err, body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v2/query", strings.NewReader(`from(bucket: "db")`))
+	req.Header.Set("Content-Type", "application/vnd.flux")
+	_, err = decodeFluxQueryRequest(req, 10)
+	assert.Equal(t, errTruncated, err)
+}
+
+func TestHandler_FluxQuery_BodyTooLarge(t *testing.T) {
+	h := Handler{
+		Logger: logger.NewLogger(errno.ModuleHTTP),
+		Config: &config.Config{MaxBodySize: 10},
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/query", strings.NewReader(`from(bucket: "db") |> range(start: -1h)`))
+	req.Header.Set("Content-Type", "application/vnd.flux")
+	h.serveFluxQuery(w, req, nil)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.JSONEq(t, `{"code":"request too large","message":"Request Entity Too Large"}`, w.Body.String())
+}
+
+func TestHandler_FluxQuery_Invalid(t *testing.T) {
+	h := Handler{
+		Logger: logger.NewLogger(errno.ModuleHTTP),
+		Config: &config.Config{},
+	}
+	var user meta.User
+
+	for query, msg := range map[string]string{
+		`from(bucket: "db"`:                             `error at position 17: expected ), found EOF`,
+		`from(bucket: "db") |> filter(fn: (r) => true)`: `cannot submit unbounded read to \"db\"; try bounding 'from' with a call to 'range'`,
+		`from(bucket: "db") |> range(start: -1h) |> pivot(rowKey: [], columnKey: ["_field"], valueColumn: "_value")`: `error calling function \"pivot\": rowKey and columnKey must not be empty`,
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/query", strings.NewReader(query))
+		req.Header.Set("Content-Type", "application/vnd.flux")
+		h.serveFluxQuery(w, req, user)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.JSONEq(t, `{"code":"invalid","message":"`+msg+`"}`, w.Body.String(), query)
+	}
+}
@@ -0,0 +1,394 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/opentelemetry"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+)
+
+// The Jaeger query API over the spans written by serveTracesWrite. The database is specified by the path
+// variable "db" for the routes prefixed with /jaeger/{db}, so that the Grafana Jaeger datasource can use it
+// as the URL directly, otherwise it is specified by the query parameter "db".
+
+// serveJaegerServices returns the services of the spans.
+func (h *Handler) serveJaegerServices(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	results, ok := h.jaegerQuery(w, r, user, opentelemetry.ServicesQuery())
+	if !ok {
+		return
+	}
+	services := opentelemetry.TagValuesFromRows(results[0])
+	writeJaegerResponse(w, http.StatusOK, &opentelemetry.JaegerResponse{Data: services, Total: len(services)})
+}
+
+// serveJaegerServiceOperations returns the operation names of the service.
+func (h *Handler) serveJaegerServiceOperations(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	service := mux.Vars(r)["service"]
+	results, ok := h.jaegerQuery(w, r, user, opentelemetry.OperationsQuery(service, ""))
+	if !ok {
+		return
+	}
+
+	seen := make(map[string]struct{})
+	names := make([]string, 0)
+	for _, op := range opentelemetry.OperationsFromRows(results, "") {
+		if _, ok := seen[op.Name]; !ok {
+			seen[op.Name] = struct{}{}
+			names = append(names, op.Name)
+		}
+	}
+	writeJaegerResponse(w, http.StatusOK, &opentelemetry.JaegerResponse{Data: names, Total: len(names)})
+}
+
+// serveJaegerOperations returns the operations of the service, filtered by the span kind if specified.
+func (h *Handler) serveJaegerOperations(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	service := r.FormValue("service")
+	if service == "" {
+		writeJaegerError(w, http.StatusBadRequest, fmt.Errorf("parameter 'service' is required"))
+		return
+	}
+	spanKind := r.FormValue("spanKind")
+	if !isJaegerSpanKind(spanKind) {
+		writeJaegerError(w, http.StatusBadRequest, fmt.Errorf("unsupported span kind %q", spanKind))
+		return
+	}
+
+	results, ok := h.jaegerQuery(w, r, user, opentelemetry.OperationsQuery(service, spanKind))
+	if !ok {
+		return
+	}
+	ops := opentelemetry.OperationsFromRows(results, spanKind)
+	writeJaegerResponse(w, http.StatusOK, &opentelemetry.JaegerResponse{Data: ops, Total: len(ops)})
+}
+
+// serveJaegerTrace returns the trace of the trace ID, which is looked up between the start and the end.
+func (h *Handler) serveJaegerTrace(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	traceID, err := opentelemetry.NormalizeTraceID(mux.Vars(r)["traceID"])
+	if err != nil {
+		writeJaegerError(w, http.StatusBadRequest, err)
+		return
+	}
+	start, end, err := parseJaegerTimeRange(r, opentelemetry.JaegerDefaultTraceLookback)
+	if err != nil {
+		writeJaegerError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	traces, ok := h.jaegerTraces(w, r, user, []string{traceID}, start, end)
+	if !ok {
+		return
+	}
+	if len(traces) == 0 {
+		writeJaegerError(w, http.StatusNotFound, fmt.Errorf("trace not found"))
+		return
+	}
+	writeJaegerResponse(w, http.StatusOK, &opentelemetry.JaegerResponse{Data: traces, Total: len(traces)})
+}
+
+// serveJaegerSearch returns the traces matching the search parameters.
+func (h *Handler) serveJaegerSearch(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if err := r.ParseForm(); err != nil {
+		writeJaegerError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// the traces are queried by the IDs directly if specified
+	if ids := r.Form["traceID"]; len(ids) > 0 {
+		traceIDs := make([]string, 0, len(ids))
+		for _, id := range ids {
+			traceID, err := opentelemetry.NormalizeTraceID(id)
+			if err != nil {
+				writeJaegerError(w, http.StatusBadRequest, err)
+				return
+			}
+			traceIDs = append(traceIDs, traceID)
+		}
+		start, end, err := parseJaegerTimeRange(r, opentelemetry.JaegerDefaultTraceLookback)
+		if err != nil {
+			writeJaegerError(w, http.StatusBadRequest, err)
+			return
+		}
+		traces, ok := h.jaegerTraces(w, r, user, traceIDs, start, end)
+		if ok {
+			writeJaegerResponse(w, http.StatusOK, &opentelemetry.JaegerResponse{Data: traces, Total: len(traces)})
+		}
+		return
+	}
+
+	params, err := parseJaegerSearchParams(r)
+	if err != nil {
+		writeJaegerError(w, http.StatusBadRequest, err)
+		return
+	}
+	results, ok := h.jaegerQuery(w, r, user, opentelemetry.FindTraceIDsQuery(params))
+	if !ok {
+		return
+	}
+	traceIDs := opentelemetry.TraceIDsFromRows(results[0], params.NumTraces)
+
+	traces := make([]*opentelemetry.JaegerTrace, 0)
+	if len(traceIDs) > 0 {
+		if traces, ok = h.jaegerTraces(w, r, user, traceIDs, params.StartTimeMin, params.StartTimeMax); !ok {
+			return
+		}
+	}
+	writeJaegerResponse(w, http.StatusOK, &opentelemetry.JaegerResponse{Data: traces, Total: len(traces), Limit: params.NumTraces})
+}
+
+func (h *Handler) jaegerTraces(w http.ResponseWriter, r *http.Request, user meta2.User, traceIDs []string,
+	start, end time.Time) ([]*opentelemetry.JaegerTrace, bool) {
+	results, ok := h.jaegerQuery(w, r, user, opentelemetry.TracesQuery(traceIDs, start, end))
+	if !ok {
+		return nil, false
+	}
+	var eventRows models.Rows
+	if len(results) > 1 {
+		eventRows = results[1]
+	}
+	return opentelemetry.RowsToTraces(traceIDs, results[0], eventRows), true
+}
+
+// parseJaegerSearchParams parses the parameters of the Jaeger trace search, the times are in microseconds.
+func parseJaegerSearchParams(r *http.Request) (*opentelemetry.TraceQueryParameters, error) {
+	p := &opentelemetry.TraceQueryParameters{
+		ServiceName:   r.FormValue("service"),
+		OperationName: r.FormValue("operation"),
+		Tags:          make(map[string]string),
+		NumTraces:     opentelemetry.JaegerDefaultLimit,
+	}
+	if p.ServiceName == "" {
+		return nil, fmt.Errorf("parameter 'service' is required")
+	}
+
+	lookback := opentelemetry.JaegerDefaultLookback
+	if lb := r.FormValue("lookback"); lb != "" && lb != "custom" {
+		d, err := influxql.ParseDuration(lb)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse param 'lookback': %w", err)
+		}
+		lookback = d
+	}
+	var err error
+	if p.StartTimeMin, p.StartTimeMax, err = parseJaegerTimeRange(r, lookback); err != nil {
+		return nil, err
+	}
+
+	if d := r.FormValue("minDuration"); d != "" {
+		if p.DurationMin, err = time.ParseDuration(d); err != nil {
+			return nil, fmt.Errorf("unable to parse param 'minDuration': %w", err)
+		}
+	}
+	if d := r.FormValue("maxDuration"); d != "" {
+		if p.DurationMax, err = time.ParseDuration(d); err != nil {
+			return nil, fmt.Errorf("unable to parse param 'maxDuration': %w", err)
+		}
+	}
+	if p.DurationMin > 0 && p.DurationMax > 0 && p.DurationMin > p.DurationMax {
+		return nil, fmt.Errorf("'maxDuration' should be greater than 'minDuration'")
+	}
+
+	if limit := r.FormValue("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("unable to parse param 'limit': %s", limit)
+		}
+		if n > 0 {
+			p.NumTraces = n
+		}
+	}
+
+	// the tags are specified as a JSON object by "tags", or as "key:value" by "tag"
+	if tags := r.FormValue("tags"); tags != "" {
+		if err = json2.Unmarshal([]byte(tags), &p.Tags); err != nil {
+			return nil, fmt.Errorf("malformed 'tags' parameter: %w", err)
+		}
+	}
+	for _, tag := range r.Form["tag"] {
+		kv := strings.SplitN(tag, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("malformed 'tag' parameter, expecting key:value, received: %s", tag)
+		}
+		p.Tags[kv[0]] = kv[1]
+	}
+	return p, nil
+}
+
+// parseJaegerTimeRange parses the parameters "start" and "end" in microseconds. The end is now by default,
+// and the start is the lookback before the end.
+func parseJaegerTimeRange(r *http.Request, lookback time.Duration) (time.Time, time.Time, error) {
+	end := time.Now()
+	if s := r.FormValue("end"); s != "" {
+		us, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("unable to parse param 'end': %w", err)
+		}
+		end = time.UnixMicro(us)
+	}
+	start := end.Add(-lookback)
+	if s := r.FormValue("start"); s != "" {
+		us, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("unable to parse param 'start': %w", err)
+		}
+		start = time.UnixMicro(us)
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("'end' should not be before 'start'")
+	}
+	return start, end, nil
+}
+
+func isJaegerSpanKind(kind string) bool {
+	for _, k := range opentelemetry.JaegerSpanKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// jaegerQuery executes the statements of the sql on the database of the request, and returns the result rows
+// of each statement. An error response is written if the query fails.
+func (h *Handler) jaegerQuery(w http.ResponseWriter, r *http.Request, user meta2.User, sql string) ([]models.Rows, bool) {
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	start := time.Now()
+	defer func() {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}()
+
+	if err := r.ParseForm(); err != nil {
+		writeJaegerError(w, http.StatusBadRequest, err)
+		return nil, false
+	}
+	if syscontrol.DisableReads {
+		writeJaegerError(w, http.StatusForbidden, fmt.Errorf("disable read! "))
+		return nil, false
+	}
+
+	db := mux.Vars(r)["db"]
+	if db == "" {
+		db = r.FormValue("db")
+	}
+	if db == "" {
+		writeJaegerError(w, http.StatusBadRequest, fmt.Errorf("database is required"))
+		return nil, false
+	}
+
+	results, code, err := h.queryRows(r.Context(), user, db, r.FormValue("rp"), sql)
+	if err != nil {
+		h.Logger.Error("jaeger query failed", zap.Error(err), zap.String("db", db))
+		writeJaegerError(w, code, err)
+		return nil, false
+	}
+	return results, true
+}
+
+func writeJaegerResponse(w http.ResponseWriter, code int, resp *opentelemetry.JaegerResponse) {
+	b, err := json2.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
+
+func writeJaegerError(w http.ResponseWriter, code int, err error) {
+	writeJaegerResponse(w, code, &opentelemetry.JaegerResponse{
+		Errors: []opentelemetry.JaegerError{{Code: code, Msg: err.Error()}},
+	})
+}
+
+// queryRows executes the statements of the sql on the database and returns the result rows of each statement.
+// The statements querying a measurement which does not exist return no rows instead of an error.
+func (h *Handler) queryRows(ctx context.Context, user meta2.User, db, rp, sql string) ([]models.Rows, int, error) {
+	q, err := h.getPureSqlQuery(strings.NewReader(sql))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if err = h.checkAuthorization(user, q, db); err != nil {
+		return nil, http.StatusForbidden, fmt.Errorf("error authorizing query: %w", err)
+	}
+
+	opts := query.ExecutionOptions{
+		Database:        db,
+		RetentionPolicy: rp,
+		ChunkSize:       DefaultChunkSize,
+		InnerChunkSize:  DefaultInnerChunkSize,
+		ReadOnly:        true,
+		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
+		Quiet:           true,
+		Authorizer:      h.getAuthorizer(user),
+		UserName:        userName(user),
+	}
+
+	// Make sure if the client disconnects we signal the query to abort
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	opts.AbortCh = closing
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		close(closing)
+	}()
+
+	stmtID2Result := make(map[int]*query.Result)
+	for result := range h.QueryExecutor.ExecuteQuery(q, opts, closing, nil) {
+		if result == nil {
+			continue
+		}
+		h.updateStmtId2Result(result, stmtID2Result)
+	}
+
+	resp := h.getStmtResult(stmtID2Result)
+	if resp.Err != nil {
+		return nil, http.StatusInternalServerError, resp.Err
+	}
+	results := make([]models.Rows, len(q.Statements))
+	for _, result := range resp.Results {
+		if result.Err != nil {
+			if errno.Equal(result.Err, errno.ErrMeasurementNotFound) {
+				continue
+			}
+			return nil, http.StatusInternalServerError, result.Err
+		}
+		if result.StatementID < len(results) {
+			results[result.StatementID] = result.Series
+		}
+	}
+	return results, http.StatusOK, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJaegerSearchParams(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, `/api/traces?service=frontend&operation=GET&start=1000000&end=2000000`+
+		`&minDuration=10ms&maxDuration=1s&limit=5&tags=%7B%22http.method%22%3A%22GET%22%7D&tag=error:true`, nil)
+	p, err := parseJaegerSearchParams(req)
+	require.NoError(t, err)
+	assert.Equal(t, "frontend", p.ServiceName)
+	assert.Equal(t, "GET", p.OperationName)
+	assert.Equal(t, time.UnixMicro(1000000), p.StartTimeMin)
+	assert.Equal(t, time.UnixMicro(2000000), p.StartTimeMax)
+	assert.Equal(t, 10*time.Millisecond, p.DurationMin)
+	assert.Equal(t, time.Second, p.DurationMax)
+	assert.Equal(t, 5, p.NumTraces)
+	assert.Equal(t, map[string]string{"http.method": "GET", "error": "true"}, p.Tags)
+
+	req = httptest.NewRequest(http.MethodGet, `/api/traces?service=frontend&end=2000000000&lookback=2d`, nil)
+	p, err = parseJaegerSearchParams(req)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMicro(2000000000).Add(-48*time.Hour), p.StartTimeMin)
+	assert.Equal(t, 20, p.NumTraces)
+
+	for _, query := range []string{
+		"",
+		"service=a&start=x",
+		"service=a&end=x",
+		"service=a&start=2000000&end=1000000",
+		"service=a&lookback=x",
+		"service=a&minDuration=x",
+		"service=a&maxDuration=x",
+		"service=a&minDuration=2s&maxDuration=1s",
+		"service=a&limit=-1",
+		"service=a&tags=x",
+		"service=a&tag=x",
+	} {
+		_, err = parseJaegerSearchParams(httptest.NewRequest(http.MethodGet, "/api/traces?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+func TestParseJaegerTimeRange(t *testing.T) {
+	start, end, err := parseJaegerTimeRange(httptest.NewRequest(http.MethodGet, "/api/traces/0a?end=2000000", nil), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMicro(2000000), end)
+	assert.Equal(t, end.Add(-time.Hour), start)
+
+	start, end, err = parseJaegerTimeRange(httptest.NewRequest(http.MethodGet, "/api/traces/0a", nil), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, end.Sub(start))
+	assert.WithinDuration(t, time.Now(), end, time.Minute)
+
+	start, _, err = parseJaegerTimeRange(httptest.NewRequest(http.MethodGet, "/api/traces/0a?start=1000000&end=2000000", nil), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMicro(1000000), start)
+}
+
+func TestHandler_Jaeger_BadRequest(t *testing.T) {
+	h := Handler{
+		Logger: logger.NewLogger(errno.ModuleHTTP),
+		Config: &config.Config{},
+	}
+	var user meta.User
+
+	w := httptest.NewRecorder()
+	h.serveJaegerServices(w, httptest.NewRequest(http.MethodGet, "/api/services", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"data":null,"total":0,"limit":0,"offset":0,"errors":[{"code":400,"msg":"database is required"}]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	h.serveJaegerOperations(w, httptest.NewRequest(http.MethodGet, "/api/operations?db=otel", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.serveJaegerOperations(w, httptest.NewRequest(http.MethodGet, "/api/operations?db=otel&service=a&spanKind=foo", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.serveJaegerSearch(w, httptest.NewRequest(http.MethodGet, "/api/traces?db=otel&traceID=xyz", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.serveJaegerSearch(w, httptest.NewRequest(http.MethodGet, "/api/traces?db=otel&traceID=0a&start=x", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.serveJaegerSearch(w, httptest.NewRequest(http.MethodGet, "/api/traces?db=otel", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.serveJaegerTrace(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/traces/0a?db=otel&end=x", nil),
+		map[string]string{"traceID": "0a"}), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
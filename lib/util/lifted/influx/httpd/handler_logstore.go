@@ -34,6 +34,7 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/gorilla/mux"
 	"github.com/influxdata/influxdb/uuid"
+	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/bufferpool"
 	compression "github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
@@ -195,6 +196,26 @@ func ValidateRepoAndLogStream(repoName, streamName string) error {
 	return nil
 }
 
+// authorizeLogWrite returns an authorization error if the user is not authorized to write to the log stream.
+func (h *Handler) authorizeLogWrite(user meta2.User, repository, logStream string) error {
+	if !h.Config.AuthEnabled {
+		return nil
+	}
+	if user == nil {
+		return meta2.ErrAuthorize{
+			Database: repository,
+			Message:  fmt.Sprintf("user is required to write to repository %q", repository),
+		}
+	}
+	if !user.AuthorizeMeasurement(originql.WritePrivilege, repository, logStream) {
+		return meta2.ErrAuthorize{
+			Database: repository,
+			Message:  fmt.Sprintf("%s not authorized to write to %s", user.ID(), influxql.QuoteIdent(repository, logStream)),
+		}
+	}
+	return nil
+}
+
 func (h *Handler) serveCreateRepository(w http.ResponseWriter, r *http.Request, user meta2.User) {
 	repository := mux.Vars(r)[Repository]
 	if err := ValidateRepository(repository); err != nil {
@@ -1675,6 +1696,13 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		return
 	}
 
+	if err = h.authorizeLogWrite(user, req.repository, req.logStream); err != nil {
+		h.Logger.Error("serveRecord authorizeLogWrite fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusForbidden)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+
 	logInfo, err := h.validateRetentionPolicy(req.repository, req.logStream)
 	if err != nil {
 		h.Logger.Error("GetLogStreamByName fail", zap.Error(err), zap.String("repository", req.repository),
@@ -1851,6 +1879,13 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 		return
 	}
 
+	if err := h.authorizeLogWrite(user, repository, logStream); err != nil {
+		h.Logger.Error("serveUpload authorizeLogWrite fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusForbidden)
+		atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+		return
+	}
+
 	scanner := bufio.NewScanner(r.Body)
 	scanBuf := byteBufferPool.Get()
 	defer byteBufferPool.Put(scanBuf)
@@ -158,6 +158,7 @@ func getMetrics(h *Handler, r *http.Request, user meta.User, tableName string) (
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		UserName:        userName(user),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
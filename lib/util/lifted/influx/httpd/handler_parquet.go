@@ -0,0 +1,164 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb"
+	originql "github.com/influxdata/influxql"
+	config2 "github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// serveWriteParquet writes the rows of the Parquet file of the request body into the measurement.
+// The tags are the columns of the tags parameter and the series column of the files exported by openGemini,
+// the time column is "time" unless the time parameter is given, the other columns are the fields.
+func (h *Handler) serveWriteParquet(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequestBytesIn, r.ContentLength)
+	defer func(start time.Time) {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.WriteRequestDuration, time.Since(start).Nanoseconds())
+	}(time.Now())
+
+	if syscontrol.DisableWrites {
+		h.httpError(w, `disable write!`, http.StatusForbidden)
+		return
+	}
+	if syscontrol.IsReadonly() {
+		h.httpError(w, "readonly now and writing is not allowed", http.StatusBadRequest)
+		return
+	}
+
+	urlValues := r.URL.Query()
+	database, rp, mst := urlValues.Get("db"), urlValues.Get("rp"), urlValues.Get("mst")
+	if database == "" {
+		h.httpError(w, "database is required", http.StatusBadRequest)
+		return
+	}
+	if mst == "" {
+		h.httpError(w, "measurement is required", http.StatusBadRequest)
+		return
+	}
+	dbi, err := h.MetaClient.Database(database)
+	if err != nil {
+		h.httpError(w, fmt.Sprintf("database not found: %q", database), http.StatusNotFound)
+		return
+	}
+
+	if h.Config.AuthEnabled {
+		if user == nil {
+			h.httpError(w, fmt.Sprintf("user is required to write to database %q", database), http.StatusForbidden)
+			return
+		}
+		if err := h.WriteAuthorizer.AuthorizeWriteMeasurements(user.ID(), database); err != nil {
+			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), database), http.StatusForbidden)
+			return
+		}
+		if !user.AuthorizeMeasurement(originql.WritePrivilege, database, mst) {
+			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to measurement %q", user.ID(), mst), http.StatusForbidden)
+			return
+		}
+	}
+
+	if h.Config.MaxBodySize > 0 && r.ContentLength > int64(h.Config.MaxBodySize) {
+		h.httpError(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	body := r.Body
+	if h.Config.MaxBodySize > 0 {
+		body = truncateReader(body, int64(h.Config.MaxBodySize))
+	}
+	// the footer of the Parquet file is at the end, so the whole file is read before decoding
+	buf, err := io.ReadAll(body)
+	if err == errTruncated {
+		h.httpError(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	} else if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	opts := parquet.ReaderOptions{TimeColumn: urlValues.Get("time")}
+	if tags := urlValues.Get("tags"); tags != "" {
+		opts.TagColumns = strings.Split(tags, ",")
+	}
+	reader, err := parquet.NewReader(bytes.NewReader(buf), opts)
+	if err != nil {
+		h.httpError(w, fmt.Sprintf("invalid parquet file: %s", err), http.StatusBadRequest)
+		return
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	// the records of the column store measurements are written by the record writer directly
+	useRecordWriter := false
+	if h.RecordWriter != nil {
+		mstRp := rp
+		if mstRp == "" {
+			mstRp = dbi.DefaultRetentionPolicy
+		}
+		if ms, err := h.MetaClient.Measurement(database, mstRp, mst); err == nil && ms.EngineType == config2.COLUMNSTORE {
+			useRecordWriter = true
+		}
+	}
+
+	var rows []influx.Row
+	for {
+		rec, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			h.httpError(w, fmt.Sprintf("invalid parquet file: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		if useRecordWriter {
+			err = h.RecordWriter.RetryWriteNativeRecord(database, rp, mst, rec)
+		} else {
+			rows = parquet.RecordToRows(rows[:0], mst, rec)
+			if err = h.PointsWriter.RetryWritePointRows(database, rp, rows); err == nil {
+				atomic.AddInt64(&statistics.HandlerStat.PointsWrittenOK, int64(len(rows)))
+			}
+		}
+		if influxdb.IsClientError(err) || errno.Equal(err, errno.ColumnStoreFieldTypeErr) {
+			h.httpError(w, err.Error(), http.StatusBadRequest)
+			atomic.AddInt64(&statistics.HandlerStat.Write400ErrRequests, 1)
+			return
+		} else if err != nil {
+			h.Logger.Error("write parquet error", zap.Error(err), zap.String("db", database), zap.String("mst", mst))
+			h.httpError(w, err.Error(), http.StatusInternalServerError)
+			atomic.AddInt64(&statistics.HandlerStat.Write500ErrRequests, 1)
+			return
+		}
+	}
+
+	h.writeHeader(w, http.StatusNoContent)
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockParquetPointsWriter struct {
+	rows []influx.Row
+	err  error
+}
+
+func (w *mockParquetPointsWriter) RetryWritePointRows(_, _ string, rows []influx.Row) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.rows = append(w.rows, rows...)
+	return nil
+}
+
+type mockParquetRecordWriter struct {
+	rows int
+	err  error
+}
+
+func (w *mockParquetRecordWriter) RetryWriteNativeRecord(_, _, _ string, rec *record.Record) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.rows += rec.RowNums()
+	return nil
+}
+
+func (w *mockParquetRecordWriter) RetryWriteLogRecord(_ *record.BulkRecords) error {
+	return nil
+}
+
+// mockParquetMetaClient serves the measurements of the column store
+type mockParquetMetaClient struct {
+	*mockV2MetaClient
+}
+
+func (c *mockParquetMetaClient) Measurement(_, _, mst string) (*meta.MeasurementInfo, error) {
+	return &meta.MeasurementInfo{Name: mst, EngineType: config.COLUMNSTORE}, nil
+}
+
+func parquetTestBody(t *testing.T) []byte {
+	file := filepath.Join(t.TempDir(), "cpu.parquet")
+	writer, err := parquet.NewWriter(file, "", parquet.MetaData{Mst: "cpu", Schemas: map[string]uint8{
+		"host": influx.Field_Type_String, "idle": influx.Field_Type_Float, record.TimeField: influx.Field_Type_Int,
+	}})
+	require.NoError(t, err)
+	defer writer.Close()
+
+	rec := record.NewRecordBuilder(record.Schemas{
+		{Name: "host", Type: influx.Field_Type_String},
+		{Name: "idle", Type: influx.Field_Type_Float},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	})
+	rec.ColVals[0].AppendStrings("a", "b")
+	rec.ColVals[1].AppendFloats(0.5, 1.5)
+	rec.ColVals[2].AppendIntegers(1, 2)
+	require.NoError(t, writer.WriteRecord("", rec))
+	require.NoError(t, writer.WriteStop())
+
+	body, err := os.ReadFile(file)
+	require.NoError(t, err)
+	return body
+}
+
+func TestHandler_WriteParquet(t *testing.T) {
+	h := newV2TestHandler()
+	pw := &mockParquetPointsWriter{}
+	h.PointsWriter = pw
+	var user meta.User
+	body := parquetTestBody(t)
+
+	w := httptest.NewRecorder()
+	h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst=cpu&tags=host", bytes.NewReader(body)), user)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	require.Equal(t, 2, len(pw.rows))
+	assert.Equal(t, influx.Row{
+		Name:      "cpu",
+		Timestamp: 2,
+		Tags:      influx.PointTags{{Key: "host", Value: "b"}},
+		Fields:    influx.Fields{{Key: "idle", NumValue: 1.5, Type: influx.Field_Type_Float}},
+	}, pw.rows[1])
+
+	for query, code := range map[string]int{
+		"/write/parquet?mst=cpu":                  http.StatusBadRequest,
+		"/write/parquet?db=db0":                   http.StatusBadRequest,
+		"/write/parquet?db=db1&mst=cpu":           http.StatusNotFound,
+		"/write/parquet?db=db0&mst=cpu&time=ts":   http.StatusBadRequest,
+		"/write/parquet?db=db0&mst=cpu&tags=idle": http.StatusBadRequest,
+	} {
+		w = httptest.NewRecorder()
+		h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, query, bytes.NewReader(body)), user)
+		assert.Equal(t, code, w.Code, query)
+	}
+
+	w = httptest.NewRecorder()
+	h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst=cpu", bytes.NewReader([]byte("m v=1"))), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// the write errors are returned to the client
+	for err, code := range map[error]int{
+		errno.NewError(errno.FieldTypeConflict, "idle", "cpu", "float", "integer"): http.StatusBadRequest,
+		io.EOF: http.StatusInternalServerError,
+	} {
+		pw.err = err
+		w = httptest.NewRecorder()
+		h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst=cpu&tags=host", bytes.NewReader(body)), user)
+		assert.Equal(t, code, w.Code, err.Error())
+	}
+	pw.err = nil
+
+	// the user granted the measurement only can write to it
+	h.Config.AuthEnabled = true
+	h.WriteAuthorizer = &mockParquetWriteAuthorizer{}
+	user = &meta.UserInfo{Name: "user1", MeasurementGrants: meta.MeasurementGrants{
+		MeasurementPrivileges: map[string]map[string]originql.Privilege{"db0": {"cpu": originql.WritePrivilege}},
+	}}
+	for mst, code := range map[string]int{"cpu": http.StatusNoContent, "mem": http.StatusForbidden} {
+		w = httptest.NewRecorder()
+		h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst="+mst, bytes.NewReader(body)), user)
+		assert.Equal(t, code, w.Code, mst)
+	}
+}
+
+// mockParquetWriteAuthorizer authorizes the users granted the database or some of its measurements
+type mockParquetWriteAuthorizer struct{}
+
+func (a *mockParquetWriteAuthorizer) AuthorizeWrite(username, database string) error {
+	return fmt.Errorf("%s not authorized to write to %s", username, database)
+}
+
+func (a *mockParquetWriteAuthorizer) AuthorizeWriteMeasurements(_, _ string) error {
+	return nil
+}
+
+func TestHandler_WriteParquetColumnStore(t *testing.T) {
+	h := newV2TestHandler()
+	h.MetaClient = &mockParquetMetaClient{h.MetaClient.(*mockV2MetaClient)}
+	rw := &mockParquetRecordWriter{}
+	h.RecordWriter = rw
+	body := parquetTestBody(t)
+
+	w := httptest.NewRecorder()
+	h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst=cpu&tags=host", bytes.NewReader(body)), nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	assert.Equal(t, 2, rw.rows)
+
+	// the records are written synchronously, a type conflict fails the request
+	rw.err = errno.NewError(errno.ColumnStoreFieldTypeErr, "cpu", "idle", influx.Field_Type_Float, influx.Field_Type_Int)
+	w = httptest.NewRecorder()
+	h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst=cpu&tags=host", bytes.NewReader(body)), nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "field type")
+
+	rw.err = io.EOF
+	w = httptest.NewRecorder()
+	h.serveWriteParquet(w, httptest.NewRequest(http.MethodPost, "/write/parquet?db=db0&mst=cpu&tags=host", bytes.NewReader(body)), nil)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -26,11 +26,11 @@ import (
 	"time"
 
 	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
-	Parser "github.com/VictoriaMetrics/VictoriaMetrics/lib/protoparser/promremotewrite"
 	"github.com/golang/snappy"
 	"github.com/influxdata/influxdb"
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/prometheus"
+	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/op"
 	"github.com/openGemini/openGemini/lib/pool"
@@ -40,6 +40,7 @@ import (
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	Parser "github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/promremotewrite"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/prometheus/prometheus/promql/parser"
 	"go.uber.org/zap"
@@ -93,7 +94,7 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 			return
 		}
 
-		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), db); err != nil {
+		if err := h.WriteAuthorizer.AuthorizeWriteMeasurements(user.ID(), db); err != nil {
 			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), db), http.StatusForbidden)
 			return
 		}
@@ -111,7 +112,7 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 		}
 	}
 
-	err := Parser.ParseStream(body, func(tss []prompb2.TimeSeries) error {
+	err := Parser.ParseStream(body, func(tss []prompb2.TimeSeries, mms []prompb.MetricMetadata) error {
 		var maxPoints int
 		var err error
 		for _, ts := range tss {
@@ -126,14 +127,34 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 			return err
 		}
 
-		if err = h.PointsWriter.RetryWritePointRows(db, rp, *rs); influxdb.IsClientError(err) {
+		if h.Config.AuthEnabled {
+			err = authorizeWriteRows(user, db, *rs)
+		}
+		if err == nil {
+			err = h.PointsWriter.RetryWritePointRows(db, rp, *rs)
+		}
+		if influxdb.IsClientError(err) {
 			h.httpError(w, err.Error(), http.StatusBadRequest)
 		} else if influxdb.IsAuthorizationError(err) {
 			h.httpError(w, err.Error(), http.StatusForbidden)
 		} else if err != nil {
 			h.httpError(w, err.Error(), http.StatusInternalServerError)
 		}
-		return err
+		if err != nil || len(mms) == 0 {
+			return err
+		}
+		metadata := metricMetadata2Meta(mst, mms)
+		if h.Config.AuthEnabled {
+			metadata = authorizeWriteMetadata(user, db, metadata)
+		}
+		if len(metadata) > 0 {
+			// the metadata is auxiliary, a failure to store it does not fail the write
+			if mdErr := h.MetaClient.UpdatePromMetadata(db, rp, metadata); mdErr != nil {
+				h.Logger.Warn("update prometheus metric metadata failed", zap.String("db", db), zap.String("rp", rp),
+					zap.String("mst", mst), zap.Error(mdErr))
+			}
+		}
+		return nil
 	})
 
 	if err != nil {
@@ -254,6 +275,7 @@ func (h *Handler) servePromReadBase(w http.ResponseWriter, r *http.Request, user
 		RetentionPolicy: rp,
 		ReadOnly:        r.Method == "GET",
 		Quiet:           true,
+		UserName:        userName(user),
 	}
 
 	if h.Config.AuthEnabled {
@@ -531,6 +553,7 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		UserName:        userName(user),
 		IsPromQuery:     true,
 	}
 
@@ -553,8 +576,13 @@ func (h *Handler) servePromBaseQuery(w http.ResponseWriter, r *http.Request, use
 		}()
 	}
 
-	// Execute query
-	resultCh := h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
+	// Execute query, the range query is executed by the buckets of the time if the result cache is enabled
+	var resultCh <-chan *query.Result
+	if bq := h.newPromBucketedQuery(q, expr, promCommand, transpiler, &opts, time.Now()); bq != nil && !async && !isExplain {
+		resultCh = h.executeBucketedQuery(bq, opts, closing, qDuration)
+	} else {
+		resultCh = h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
+	}
 
 	// If we are running in async mode, open a goroutine to drain the results
 	// and return with a StatusNoContent.
@@ -794,6 +822,7 @@ func (h *Handler) servePromBaseMetaQuery(w http.ResponseWriter, r *http.Request,
 		ParallelQuery:   atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
+		UserName:        userName(user),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -831,11 +860,72 @@ func (h *Handler) servePromBaseMetaQuery(w http.ResponseWriter, r *http.Request,
 
 // servePromQueryMetaData Executes a metadata query of the PromQL and returns the query result.
 func (h *Handler) servePromQueryMetaData(w http.ResponseWriter, r *http.Request, user meta2.User) {
-	// TODO query metadata
+	h.servePromQueryMetaDataBase(w, r, user, EmptyPromMst)
 }
 
 // servePromQueryMetaDataWithMetricStore Executes a metadata query of the PromQL and returns the query result.
 func (h *Handler) servePromQueryMetaDataWithMetricStore(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	mst, ok := getMstByProm(h, w, r)
+	if !ok {
+		return
+	}
+	h.servePromQueryMetaDataBase(w, r, user, mst)
+}
+
+// servePromQueryMetaDataBase returns the metric metadata received by the remote write of the metric store.
+func (h *Handler) servePromQueryMetaDataBase(w http.ResponseWriter, r *http.Request, user meta2.User, mst string) {
+	// Retrieve the underlying ResponseWriter or initialize our own.
+	rw, ok := w.(ResponseWriter)
+	if !ok {
+		rw = NewResponseWriter(w, r)
+	}
+
+	limit := -1
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil {
+			respondError(w, &apiError{errorBadData, fmt.Errorf("limit must be a number")}, nil)
+			return
+		}
+	}
+	metric := r.FormValue("metric")
+
+	db, rp := getDbRpByProm(r)
+	if h.Config.AuthEnabled {
+		if user == nil || !user.AuthorizeDatabase(originql.ReadPrivilege, db) {
+			respondError(w, &apiError{errorForbidden, fmt.Errorf("user is not authorized to read database %q", db)}, nil)
+			return
+		}
+		if mst != EmptyPromMst && !user.AuthorizeMeasurement(originql.ReadPrivilege, db, mst) {
+			respondError(w, &apiError{errorForbidden, fmt.Errorf("user is not authorized to read measurement %q", mst)}, nil)
+			return
+		}
+	}
+
+	metadata, err := h.MetaClient.PromMetadata(db, rp, mst)
+	if err != nil {
+		respondError(w, &apiError{errorNotFound, err}, nil)
+		return
+	}
+
+	data := make(map[string][]promMetadata)
+	for i := range metadata {
+		if limit >= 0 && len(data) >= limit {
+			break
+		}
+		m := &metadata[i]
+		if metric != "" && m.MetricFamilyName != metric {
+			continue
+		}
+		// every metric is a measurement without the metric store
+		if h.Config.AuthEnabled && mst == EmptyPromMst && !user.AuthorizeMeasurement(originql.ReadPrivilege, db, m.MetricFamilyName) {
+			continue
+		}
+		data[m.MetricFamilyName] = append(data[m.MetricFamilyName], promMetadata{Type: m.Type, Help: m.Help, Unit: m.Unit})
+	}
+
+	n, _ := rw.WritePromResponse(PromResponse{Status: StatusSuccess, Data: data})
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequestBytesTransmitted, int64(n))
 }
 
 type PromTimeValuer struct {
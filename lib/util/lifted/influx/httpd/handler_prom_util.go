@@ -27,14 +27,17 @@ import (
 
 	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
 	"github.com/gorilla/mux"
+	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/prompb"
 	"github.com/prometheus/prometheus/promql"
 	"github.com/prometheus/prometheus/promql/parser"
 	"go.uber.org/zap"
@@ -319,6 +322,47 @@ func (r *PromResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// promMetadata is an entry of the response of the Prometheus metadata API.
+type promMetadata struct {
+	Type string `json:"type"`
+	Help string `json:"help"`
+	Unit string `json:"unit"`
+}
+
+// metricMetadata2Meta converts the metric metadata of the remote write request of the metric store.
+func metricMetadata2Meta(mst string, mms []prompb.MetricMetadata) []meta2.PromMetricMetadata {
+	metadata := make([]meta2.PromMetricMetadata, 0, len(mms))
+	for i := range mms {
+		if mms[i].MetricFamilyName == "" {
+			continue
+		}
+		metadata = append(metadata, meta2.PromMetricMetadata{
+			MetricStore:      mst,
+			MetricFamilyName: mms[i].MetricFamilyName,
+			Type:             strings.ToLower(mms[i].Type.String()),
+			Help:             mms[i].Help,
+			Unit:             mms[i].Unit,
+		})
+	}
+	return metadata
+}
+
+// authorizeWriteMetadata drops the metadata of the metrics the user is not authorized to write,
+// the metric is the metric store, or the metric family without the metric store
+func authorizeWriteMetadata(user meta2.User, database string, metadata []meta2.PromMetricMetadata) []meta2.PromMetricMetadata {
+	authorized := metadata[:0]
+	for i := range metadata {
+		name := metadata[i].MetricStore
+		if name == "" {
+			name = metadata[i].MetricFamilyName
+		}
+		if user.AuthorizeMeasurement(originql.WritePrivilege, database, name) {
+			authorized = append(authorized, metadata[i])
+		}
+	}
+	return authorized
+}
+
 type timeSeries2RowsFunc func(mst string, dst []influx.Row, tss []prompb2.TimeSeries) ([]influx.Row, error)
 
 func timeSeries2Rows(mst string, dst []influx.Row, tss []prompb2.TimeSeries) ([]influx.Row, error) {
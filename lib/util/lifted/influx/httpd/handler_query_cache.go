@@ -0,0 +1,441 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/querycache"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// crossWindowFunctions are the functions whose result of a window depends on the other windows,
+// the results of the buckets computed separately differ from the result of the whole query
+var crossWindowFunctions = map[string]struct{}{
+	"derivative":              {},
+	"non_negative_derivative": {},
+	"difference":              {},
+	"non_negative_difference": {},
+	"moving_average":          {},
+	"cumulative_sum":          {},
+	"elapsed":                 {},
+	"holt_winters":            {},
+	"holt_winters_with_fit":   {},
+	"sliding_window":          {},
+}
+
+// bucketedQuery is a range query split into the buckets of the time. The results of the complete buckets are
+// cached, the other buckets are computed by the statements of their time ranges and merged with the cached ones.
+type bucketedQuery struct {
+	cache *querycache.Cache
+	query *influxql.Query
+
+	key string
+	dep querycache.Dependency
+	// lookback is the time range before a bucket the result of the bucket depends on
+	lookback int64
+	buckets  []querycache.Bucket
+
+	// statement returns the statement computing the result of the bucket
+	statement func(b querycache.Bucket) (influxql.Statement, error)
+	fill      querycache.FillFunc
+}
+
+func (bq *bucketedQuery) bucketKey(b querycache.Bucket) string {
+	return bq.key + "|" + strconv.FormatInt(b.Start, 10)
+}
+
+func (bq *bucketedQuery) dependency(b querycache.Bucket) querycache.Dependency {
+	dep := bq.dep
+	dep.MinTime, dep.MaxTime = b.Start-bq.lookback, b.End-1
+	return dep
+}
+
+// executeBucketedQuery executes the query by the buckets, the query is executed as a whole
+// if the results of the buckets can not be merged.
+func (h *Handler) executeBucketedQuery(bq *bucketedQuery, opts query.ExecutionOptions, closing chan struct{},
+	qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	results := make(chan *query.Result, 1)
+	go func() {
+		defer close(results)
+		if result, ok := h.runBucketedQuery(bq, opts, closing, qDuration); ok {
+			results <- result
+			return
+		}
+
+		for r := range h.QueryExecutor.ExecuteQuery(bq.query, opts, closing, qDuration) {
+			select {
+			case results <- r:
+			case <-closing:
+				return
+			}
+		}
+	}()
+	return results
+}
+
+func (h *Handler) runBucketedQuery(bq *bucketedQuery, opts query.ExecutionOptions, closing chan struct{},
+	qDuration *statistics.SQLSlowQueryStatistics) (*query.Result, bool) {
+	start := time.Now()
+	rows := make([]models.Rows, len(bq.buckets))
+	q := &influxql.Query{}
+	var pending []int
+	for i, b := range bq.buckets {
+		if b.Full {
+			if cached, ok := bq.cache.Get(bq.bucketKey(b)); ok {
+				rows[i] = cached
+				continue
+			}
+		}
+		stmt, err := bq.statement(b)
+		if err != nil {
+			return nil, false
+		}
+		q.Statements = append(q.Statements, stmt)
+		pending = append(pending, i)
+	}
+
+	var messages []*query.Message
+	if len(q.Statements) > 0 {
+		stmtID2Result := make(map[int]*query.Result)
+		for r := range h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration) {
+			if r != nil {
+				h.updateStmtId2Result(r, stmtID2Result)
+			}
+		}
+		// the errors not belonging to a statement, such as the query is rejected by the task manager
+		for id, r := range stmtID2Result {
+			if (id < 0 || id >= len(pending)) && r.Err != nil {
+				return &query.Result{Err: r.Err}, true
+			}
+		}
+		for id, i := range pending {
+			r, ok := stmtID2Result[id]
+			if !ok {
+				continue
+			}
+			if r.Err != nil {
+				return &query.Result{Err: r.Err}, true
+			}
+			messages = append(messages, r.Messages...)
+			rows[i] = r.Series
+			if b := bq.buckets[i]; b.Full && !r.Partial {
+				bq.cache.Put(bq.bucketKey(b), bq.dependency(b), r.Series, start)
+			}
+		}
+	}
+
+	merged, ok := querycache.MergeRows(rows, bq.fill)
+	if !ok {
+		return nil, false
+	}
+	return &query.Result{Series: merged, Messages: messages}, true
+}
+
+// newInfluxBucketedQuery returns the bucketed query of a SELECT statement grouped by time,
+// nil is returned if the result of the statement can not be merged from the results of the buckets.
+func (h *Handler) newInfluxBucketedQuery(q *influxql.Query, opts *query.ExecutionOptions, now time.Time) *bucketedQuery {
+	if h.ResultCache == nil || opts.Chunked || len(q.Statements) != 1 {
+		return nil
+	}
+	stmt, ok := q.Statements[0].(*influxql.SelectStatement)
+	if !ok || !cacheableSelect(stmt) {
+		return nil
+	}
+	interval, err := stmt.GroupByInterval()
+	if err != nil || interval <= 0 {
+		return nil
+	}
+	offset, err := stmt.GroupByOffset()
+	if err != nil {
+		return nil
+	}
+	database, measurements, ok := selectDependency(stmt, opts.Database)
+	if !ok || !h.ResultCache.Cacheable(database) {
+		return nil
+	}
+
+	// the query must be bounded by the start time, the end time is now by default
+	_, tr, err := influxql.ConditionExpr(stmt.Condition, &influxql.NowValuer{Now: now})
+	if err != nil || tr.Min.IsZero() {
+		return nil
+	}
+	startTime, endTime := tr.MinTimeNano(), now.UnixNano()
+	if !tr.Max.IsZero() {
+		endTime = tr.MaxTimeNano()
+	}
+	if endTime < startTime {
+		return nil
+	}
+
+	size := querycache.BucketSize(h.ResultCache.BucketInterval(), interval, startTime, endTime)
+	buckets := querycache.SplitBuckets(startTime, endTime, size, int64(offset))
+	for i := range buckets {
+		buckets[i].Full = buckets[i].Full && h.ResultCache.Complete(buckets[i].End, now)
+	}
+
+	keyStmt := stmt.Clone()
+	keyStmt.Condition = withoutTimeCondition(stmt.Condition)
+	bq := &bucketedQuery{
+		cache:   h.ResultCache,
+		query:   q,
+		key:     strings.Join([]string{"influxql", opts.UserName, opts.Database, opts.RetentionPolicy, keyStmt.String(), strconv.FormatInt(size, 10)}, "|"),
+		dep:     querycache.Dependency{Database: database, Measurements: measurements},
+		buckets: buckets,
+		statement: func(b querycache.Bucket) (influxql.Statement, error) {
+			s := stmt.Clone()
+			err := s.SetTimeRange(time.Unix(0, max(b.Start, startTime)), time.Unix(0, min(b.End, endTime+1)))
+			return s, err
+		},
+	}
+	if stmt.Fill == influxql.NullFill || stmt.Fill == influxql.NumberFill {
+		bq.fill = func(i int, series *models.Row) [][]interface{} {
+			return fillWindows(stmt, series, max(buckets[i].Start, startTime), min(buckets[i].End, endTime+1), int64(interval), int64(offset))
+		}
+	}
+	return bq
+}
+
+// fillWindows returns the values of the windows in [start, end) filled by the fill option of the statement
+func fillWindows(stmt *influxql.SelectStatement, series *models.Row, start, end, interval, offset int64) [][]interface{} {
+	first := start - offset
+	if r := first % interval; r < 0 {
+		first -= r + interval
+	} else {
+		first -= r
+	}
+
+	var values [][]interface{}
+	for t := first + offset; t < end; t += interval {
+		v := make([]interface{}, len(series.Columns))
+		v[0] = time.Unix(0, t).UTC()
+		if stmt.Fill == influxql.NumberFill {
+			for j := 1; j < len(v); j++ {
+				v[j] = stmt.FillValue
+			}
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func cacheableSelect(stmt *influxql.SelectStatement) bool {
+	if stmt.Target != nil || stmt.Limit > 0 || stmt.Offset > 0 || stmt.SLimit > 0 || stmt.SOffset > 0 ||
+		stmt.Location != nil || stmt.OmitTime || stmt.TimeAlias != "" || stmt.IsPromQuery || stmt.Scroll != (influxql.Scroll{}) ||
+		len(stmt.JoinSource) > 0 || len(stmt.UnnestSource) > 0 || len(stmt.BinOpSource) > 0 || stmt.Union != nil {
+		return false
+	}
+	switch stmt.Fill {
+	case influxql.NullFill, influxql.NumberFill, influxql.NoFill:
+	default:
+		return false
+	}
+	for _, f := range stmt.SortFields {
+		if !f.Ascending {
+			return false
+		}
+	}
+
+	ok := true
+	for _, f := range stmt.Fields {
+		influxql.WalkFunc(f.Expr, func(node influxql.Node) {
+			if call, isCall := node.(*influxql.Call); isCall {
+				if _, cross := crossWindowFunctions[call.Name]; cross || call.Over != nil {
+					ok = false
+				}
+			}
+		})
+	}
+	return ok && cacheableCondition(stmt.Condition)
+}
+
+// cacheableCondition returns true if the condition compares the time only with "time" on the left, and no function
+// is called out of the time comparisons, the time comparisons of such a condition are replaced by SetTimeRange.
+func cacheableCondition(cond influxql.Expr) bool {
+	switch e := cond.(type) {
+	case nil:
+		return true
+	case *influxql.BinaryExpr:
+		if e.LHS.String() == "time" {
+			return true
+		}
+		return cacheableCondition(e.LHS) && cacheableCondition(e.RHS)
+	case *influxql.ParenExpr:
+		return cacheableCondition(e.Expr)
+	case *influxql.Call:
+		return false
+	case *influxql.VarRef:
+		return e.Val != "time"
+	default:
+		return true
+	}
+}
+
+func withoutTimeCondition(cond influxql.Expr) influxql.Expr {
+	if cond == nil {
+		return nil
+	}
+	expr := influxql.RewriteExpr(influxql.CloneExpr(cond), func(expr influxql.Expr) influxql.Expr {
+		if e, ok := expr.(*influxql.BinaryExpr); ok && e.LHS.String() == "time" {
+			return &influxql.BooleanLiteral{Val: true}
+		}
+		return expr
+	})
+	return influxql.Reduce(expr, nil)
+}
+
+// selectDependency returns the database and the measurements the statement reads,
+// the measurements are empty if they are matched by a regex.
+func selectDependency(stmt *influxql.SelectStatement, defaultDatabase string) (string, []string, bool) {
+	var database string
+	var measurements []string
+	regex := false
+	for i, source := range stmt.Sources {
+		m, ok := source.(*influxql.Measurement)
+		if !ok {
+			return "", nil, false
+		}
+		db := defaultDatabase
+		if m.Database != "" {
+			db = m.Database
+		}
+		if i > 0 && db != database {
+			return "", nil, false
+		}
+		database = db
+		if m.Regex != nil {
+			regex = true
+			continue
+		}
+		measurements = append(measurements, m.Name)
+	}
+	if database == "" {
+		return "", nil, false
+	}
+	if regex {
+		measurements = nil
+	}
+	return database, measurements, true
+}
+
+// newPromBucketedQuery returns the bucketed query of a PromQL range query,
+// nil is returned if the result of the query can not be merged from the results of the buckets.
+func (h *Handler) newPromBucketedQuery(q *influxql.Query, expr parser.Expr, cmd promql2influxql.PromCommand,
+	transpiler *promql2influxql.Transpiler, opts *query.ExecutionOptions, now time.Time) *bucketedQuery {
+	if h.ResultCache == nil || opts.Chunked || len(q.Statements) != 1 || transpiler.DuplicateResult() {
+		return nil
+	}
+	if _, ok := q.Statements[0].(*influxql.SelectStatement); !ok {
+		return nil
+	}
+	if cmd.Start == nil || cmd.End == nil || cmd.Step <= 0 || !h.ResultCache.Cacheable(cmd.Database) {
+		return nil
+	}
+	step := int64(cmd.Step)
+	startTime, endTime := cmd.Start.UnixNano(), cmd.End.UnixNano()
+	// the evaluations of the buckets must be the ones of the query
+	if startTime%step != 0 {
+		return nil
+	}
+	lookback, ok := promLookback(expr, cmd.LookBackDelta)
+	if !ok {
+		return nil
+	}
+
+	size := querycache.BucketSize(h.ResultCache.BucketInterval(), cmd.Step, startTime, endTime)
+	buckets := querycache.SplitBuckets(startTime, endTime, size, 0)
+	for i := range buckets {
+		b := &buckets[i]
+		b.Full = b.Start >= startTime && b.End-step <= endTime && h.ResultCache.Complete(b.End, now)
+	}
+
+	var measurements []string
+	if cmd.Measurement != "" {
+		measurements = []string{cmd.Measurement}
+	}
+	return &bucketedQuery{
+		cache: h.ResultCache,
+		query: q,
+		key: strings.Join([]string{"promql", opts.UserName, cmd.Database, cmd.RetentionPolicy, cmd.Measurement, cmd.Cmd,
+			strconv.FormatInt(step, 10), strconv.FormatInt(int64(cmd.LookBackDelta), 10), strconv.FormatInt(size, 10)}, "|"),
+		dep:      querycache.Dependency{Database: cmd.Database, Measurements: measurements},
+		lookback: int64(lookback),
+		buckets:  buckets,
+		statement: func(b querycache.Bucket) (influxql.Statement, error) {
+			c := cmd
+			start, end := time.Unix(0, max(b.Start, startTime)), time.Unix(0, min(b.End-step, endTime))
+			c.Start, c.End = &start, &end
+
+			// the expression is modified by the transpiler
+			e, err := parser.ParseExpr(cmd.Cmd)
+			if err != nil {
+				return nil, err
+			}
+			node, err := (&promql2influxql.Transpiler{PromCommand: c}).Transpile(e)
+			if err != nil {
+				return nil, err
+			}
+			stmt, ok := node.(*influxql.SelectStatement)
+			if !ok {
+				return nil, fmt.Errorf("unexpected statement of the bucket: %T", node)
+			}
+			return stmt, nil
+		},
+	}
+}
+
+// promLookback returns the time range before an evaluation the result of the expression depends on,
+// false is returned if the expression is evaluated at a fixed time or after the evaluation.
+func promLookback(expr parser.Expr, lookbackDelta time.Duration) (time.Duration, bool) {
+	var lookback time.Duration
+	ok := true
+	parser.Inspect(expr, func(node parser.Node, path []parser.Node) error {
+		vs, isVector := node.(*parser.VectorSelector)
+		if !isVector {
+			return nil
+		}
+		if vs.Timestamp != nil || vs.StartOrEnd != 0 || vs.OriginalOffset < 0 {
+			ok = false
+			return nil
+		}
+
+		d := vs.OriginalOffset + lookbackDelta
+		if len(path) > 0 {
+			if ms, isMatrix := path[len(path)-1].(*parser.MatrixSelector); isMatrix {
+				d = vs.OriginalOffset + ms.Range
+			}
+		}
+		for _, p := range path {
+			if sq, isSubquery := p.(*parser.SubqueryExpr); isSubquery {
+				if sq.Timestamp != nil || sq.StartOrEnd != 0 || sq.OriginalOffset < 0 {
+					ok = false
+					return nil
+				}
+				d += sq.Range + sq.OriginalOffset
+			}
+		}
+		lookback = max(lookback, d)
+		return nil
+	})
+	return lookback, ok
+}
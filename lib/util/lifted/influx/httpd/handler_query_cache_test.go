@@ -0,0 +1,253 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	config2 "github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/querycache"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var cacheTestStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// windowStatementExecutor returns a count of each 10m window in the time range of the statement,
+// the series host=b has the data in the first hour only
+type windowStatementExecutor struct {
+	executed int64
+}
+
+func (e *windowStatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
+	atomic.AddInt64(&e.executed, 1)
+	s := stmt.(*influxql.SelectStatement)
+	_, tr, err := influxql.ConditionExpr(s.Condition, nil)
+	if err != nil {
+		return err
+	}
+
+	a := &models.Row{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "count"}}
+	b := &models.Row{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: []string{"time", "count"}}
+	for t := tr.Min.Truncate(10 * time.Minute); !t.After(tr.Max); t = t.Add(10 * time.Minute) {
+		a.Values = append(a.Values, []interface{}{t, int64(1)})
+		if t.Before(cacheTestStart.Add(time.Hour)) {
+			b.Values = append(b.Values, []interface{}{t, int64(2)})
+		}
+	}
+	rows := models.Rows{a}
+	if len(b.Values) > 0 {
+		rows = append(rows, b)
+	}
+	return ctx.Send(&query.Result{Series: rows}, seq)
+}
+
+func (e *windowStatementExecutor) Statistics(buffer []byte) ([]byte, error) {
+	return buffer, nil
+}
+
+type cacheTestRegister struct{}
+
+func (cacheTestRegister) RetryRegisterQueryIDOffset(host string) (uint64, error) {
+	return 0, nil
+}
+
+func newCacheTestHandler() (*Handler, *windowStatementExecutor) {
+	h := NewHandler(config.NewConfig())
+	conf := config2.NewQueryResultCacheConfig()
+	conf.Enabled = true
+	conf.Databases = []string{"db0", "prom"}
+	h.ResultCache = querycache.NewCache(conf)
+	e := &windowStatementExecutor{}
+	h.QueryExecutor.StatementExecutor = e
+	h.QueryExecutor.TaskManager.Register = cacheTestRegister{}
+	return h, e
+}
+
+func parseCacheTestQuery(t *testing.T, sql string) *influxql.Query {
+	p := influxql.NewParser(strings.NewReader(sql))
+	defer p.Release()
+	yy := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yy.ParseTokens()
+	q, err := yy.GetQuery()
+	require.NoError(t, err, sql)
+	return q
+}
+
+func executeCacheTestQuery(t *testing.T, h *Handler, sql string) *query.Result {
+	q := parseCacheTestQuery(t, sql)
+	opts := query.ExecutionOptions{Database: "db0", ParallelQuery: true, Quiet: true}
+	bq := h.newInfluxBucketedQuery(q, &opts, time.Now())
+	require.NotNil(t, bq)
+
+	var results []*query.Result
+	for r := range h.executeBucketedQuery(bq, opts, make(chan struct{}), nil) {
+		results = append(results, r)
+	}
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	return results[0]
+}
+
+func TestHandler_BucketedQuery(t *testing.T) {
+	h, e := newCacheTestHandler()
+	sql := "SELECT count(value) FROM cpu WHERE time >= '2024-01-01T00:00:00Z' AND time < '2024-01-01T03:30:00Z' GROUP BY time(10m), host fill(null)"
+
+	result := executeCacheTestQuery(t, h, sql)
+	require.Equal(t, int64(4), e.executed)
+	require.Len(t, result.Series, 2)
+	a, b := result.Series[0], result.Series[1]
+	require.Equal(t, "a", a.Tags["host"])
+	require.Len(t, a.Values, 21)
+	require.Len(t, b.Values, 21)
+	for i, v := range b.Values {
+		assert.Equal(t, cacheTestStart.Add(time.Duration(i)*10*time.Minute), v[0])
+		if i < 6 {
+			assert.Equal(t, int64(2), v[1])
+		} else {
+			assert.Nil(t, v[1])
+		}
+	}
+
+	// the complete buckets are read from the cache
+	cached := executeCacheTestQuery(t, h, sql)
+	require.Equal(t, int64(5), e.executed)
+	require.Equal(t, result.Series, cached.Series)
+
+	// the write of the old data invalidates its bucket
+	h.ResultCache.ObserveRows("db0", []influx.Row{{Name: "cpu", Timestamp: cacheTestStart.Add(90 * time.Minute).UnixNano()}})
+	cached = executeCacheTestQuery(t, h, sql)
+	require.Equal(t, int64(7), e.executed)
+	require.Equal(t, result.Series, cached.Series)
+}
+
+func TestHandler_NewInfluxBucketedQuery(t *testing.T) {
+	h, _ := newCacheTestHandler()
+	now := cacheTestStart.Add(10 * time.Hour)
+	opts := &query.ExecutionOptions{Database: "db0"}
+
+	for sql, cacheable := range map[string]bool{
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m)":                            true,
+		"SELECT mean(value) FROM db1..cpu, mem WHERE host = 'a' AND time >= now() - 6h GROUP BY time(1m)":   false,
+		"SELECT mean(value) FROM db1..cpu WHERE host = 'a' AND time >= now() - 6h GROUP BY time(1m)":        false,
+		"SELECT mean(value) FROM /c.*/ WHERE host = 'a' AND time >= now() - 6h GROUP BY time(1m), host":     true,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h":                                              false,
+		"SELECT mean(value) FROM cpu WHERE time < now() GROUP BY time(1m)":                                  false,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m) LIMIT 10":                   false,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m) fill(previous)":             false,
+		"SELECT derivative(mean(value)) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m)":                false,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m) ORDER BY time DESC":         false,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m) tz('Asia/Shanghai')":        false,
+		"SELECT mean(value) FROM cpu WHERE now() - 6h <= time GROUP BY time(1m)":                            false,
+		"SELECT mean(value) INTO cpu_1m FROM cpu WHERE time >= now() - 6h GROUP BY time(1m)":                false,
+		"SELECT mean(value) FROM (SELECT value FROM cpu) WHERE time >= now() - 6h GROUP BY time(1m)":        false,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h GROUP BY time(1m); SELECT value FROM cpu":     false,
+		"SELECT mean(value) FROM cpu WHERE time >= now() - 6h AND time <= now() - 1h GROUP BY time(7m, 1m)": true,
+	} {
+		bq := h.newInfluxBucketedQuery(parseCacheTestQuery(t, sql), opts, now)
+		require.Equal(t, cacheable, bq != nil, sql)
+	}
+
+	// the buckets are aligned with the windows, the key does not change with now
+	q := parseCacheTestQuery(t, "SELECT mean(value) FROM cpu WHERE time >= now() - 6h AND time <= now() - 1h GROUP BY time(7m, 1m)")
+	bq := h.newInfluxBucketedQuery(q, opts, now)
+	for _, b := range bq.buckets {
+		require.Equal(t, int64(0), (b.Start-int64(time.Minute))%int64(7*time.Minute))
+	}
+	require.Equal(t, []string{"cpu"}, bq.dep.Measurements)
+	other := h.newInfluxBucketedQuery(q, opts, now.Add(time.Minute))
+	require.Equal(t, bq.key, other.key)
+
+	stmt, err := bq.statement(bq.buckets[0])
+	require.NoError(t, err)
+	end := time.Unix(0, bq.buckets[0].End).UTC().Format(time.RFC3339)
+	require.Equal(t, "SELECT mean(value) FROM cpu WHERE time >= '2024-01-01T04:00:00Z' AND time < '"+end+"' GROUP BY time(7m, 1m)", stmt.String())
+}
+
+func TestHandler_NewPromBucketedQuery(t *testing.T) {
+	h, _ := newCacheTestHandler()
+	now := cacheTestStart.Add(10 * time.Hour)
+	opts := &query.ExecutionOptions{Database: "prom"}
+
+	newQuery := func(promql string, start time.Time) *bucketedQuery {
+		expr, err := parser.ParseExpr(promql)
+		require.NoError(t, err)
+		end := now
+		cmd := promql2influxql.PromCommand{Cmd: promql, Database: "prom", Start: &start, End: &end, Step: time.Minute,
+			LookBackDelta: promql2influxql.DefaultLookBackDelta, DataType: promql2influxql.GRAPH_DATA}
+		transpiler := &promql2influxql.Transpiler{PromCommand: cmd}
+		node, err := transpiler.Transpile(expr)
+		require.NoError(t, err)
+		stmt, ok := node.(*influxql.SelectStatement)
+		if !ok {
+			return nil
+		}
+		q := &influxql.Query{Statements: []influxql.Statement{stmt}}
+		return h.newPromBucketedQuery(q, expr, cmd, transpiler, opts, now)
+	}
+
+	bq := newQuery("rate(http_requests_total[5m])", cacheTestStart)
+	require.NotNil(t, bq)
+	require.Equal(t, int64(5*time.Minute), bq.lookback)
+	require.Len(t, bq.buckets, 11)
+	require.True(t, bq.buckets[0].Full)
+	require.False(t, bq.buckets[10].Full)
+
+	stmt, err := bq.statement(bq.buckets[1])
+	require.NoError(t, err)
+	require.NotNil(t, stmt)
+
+	require.Nil(t, newQuery("rate(http_requests_total[5m])", cacheTestStart.Add(time.Second)))
+	require.Nil(t, newQuery("rate(http_requests_total[5m] @ 1704067200)", cacheTestStart))
+
+	// the database is not written only through this node
+	conf := config2.NewQueryResultCacheConfig()
+	conf.Enabled = true
+	conf.Databases = []string{"db0"}
+	h.ResultCache = querycache.NewCache(conf)
+	require.Nil(t, newQuery("rate(http_requests_total[5m])", cacheTestStart))
+}
+
+func TestPromLookback(t *testing.T) {
+	for promql, lookback := range map[string]time.Duration{
+		"up":                                  5 * time.Minute,
+		"rate(up[10m] offset 1h)":             70 * time.Minute,
+		"max_over_time(rate(up[1m])[30m:1m])": 31 * time.Minute,
+		"sum(up) / sum(up offset 5m)":         10 * time.Minute,
+		"up @ 1704067200":                     -1,
+		"up offset -5m":                       -1,
+		"max_over_time(up[10m:1m] @ end())":   -1,
+	} {
+		expr, err := parser.ParseExpr(promql)
+		require.NoError(t, err, promql)
+		d, ok := promLookback(expr, 5*time.Minute)
+		if lookback < 0 {
+			require.False(t, ok, promql)
+			continue
+		}
+		require.True(t, ok, promql)
+		require.Equal(t, lookback, d, promql)
+	}
+}
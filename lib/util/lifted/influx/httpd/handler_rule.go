@@ -0,0 +1,95 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openGemini/openGemini/lib/proxy"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// RuleProxy marks the request forwarded to the ts-sql evaluating the rules, it is served there
+// even if the rule lease has moved meanwhile.
+const RuleProxy = "Rule-Proxy"
+
+// servePromRules returns the recording and alerting rules, filtered by the type parameter "alert" or "record".
+func (h *Handler) servePromRules(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if h.forwardToRuleOwner(w, r) {
+		return
+	}
+	typ := r.FormValue("type")
+	if typ != "" && typ != "alert" && typ != "record" {
+		respondError(w, &apiError{errorBadData, fmt.Errorf("not supported value %q", typ)}, nil)
+		return
+	}
+
+	var data interface{} = map[string]interface{}{"groups": []interface{}{}}
+	if h.RuleManager != nil {
+		data = h.RuleManager.RuleDiscovery(typ)
+	}
+	h.writePromData(w, r, data)
+}
+
+// servePromAlerts returns the pending and firing alerts.
+func (h *Handler) servePromAlerts(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if h.forwardToRuleOwner(w, r) {
+		return
+	}
+	var data interface{} = map[string]interface{}{"alerts": []interface{}{}}
+	if h.RuleManager != nil {
+		data = h.RuleManager.AlertDiscovery()
+	}
+	h.writePromData(w, r, data)
+}
+
+// forwardToRuleOwner forwards the request to the ts-sql evaluating the rules, as the state of the rules
+// and alerts is kept in its memory only. It returns false if the request is served by this ts-sql.
+func (h *Handler) forwardToRuleOwner(w http.ResponseWriter, r *http.Request) bool {
+	if h.RuleManager == nil || r.Header.Get(RuleProxy) != "" {
+		return false
+	}
+	owner := h.RuleManager.RuleOwner()
+	if owner == "" {
+		return false
+	}
+
+	if h.Config.HTTPSEnabled {
+		r.URL.Scheme = Https
+	} else {
+		r.URL.Scheme = Http
+	}
+	reverseProxy, err := proxy.NewPool().Get(r.URL, owner)
+	if err != nil {
+		h.Logger.Error("get rule owner proxy failed", zap.Error(err), zap.String("owner", owner))
+		return false
+	}
+	r.Header.Set(RuleProxy, "true")
+	reverseProxy.ServeHTTP(w, r)
+	return true
+}
+
+func (h *Handler) writePromData(w http.ResponseWriter, r *http.Request, data interface{}) {
+	rw, ok := w.(ResponseWriter)
+	if !ok {
+		rw = NewResponseWriter(w, r)
+	}
+	_, _ = rw.WritePromResponse(PromResponse{
+		Status: StatusSuccess,
+		Data:   data,
+	})
+}
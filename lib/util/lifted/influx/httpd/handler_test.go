@@ -2,6 +2,7 @@ package httpd
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"math"
 	"net/http"
@@ -12,8 +13,11 @@ import (
 	"time"
 
 	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/golang/snappy"
 	"github.com/gorilla/mux"
+	"github.com/influxdata/influxdb"
 	"github.com/influxdata/influxdb/services/httpd"
+	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
@@ -25,6 +29,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -260,6 +265,236 @@ func TestHandler_Prom_Metadata_Query(t *testing.T) {
 	})
 }
 
+type mockPromMetaClient struct {
+	mockV2MetaClient
+	metadata []meta.PromMetricMetadata
+}
+
+func (c *mockPromMetaClient) PromMetadata(database, _, mst string) ([]meta.PromMetricMetadata, error) {
+	if database != promql2influxql.DefaultDatabaseName {
+		return nil, errno.NewError(errno.DatabaseNotFound, database)
+	}
+	var metadata []meta.PromMetricMetadata
+	for _, m := range c.metadata {
+		if m.MetricStore == mst {
+			metadata = append(metadata, m)
+		}
+	}
+	return metadata, nil
+}
+
+func (c *mockPromMetaClient) UpdatePromMetadata(_, _ string, metadata []meta.PromMetricMetadata) error {
+	c.metadata = append(c.metadata, metadata...)
+	return nil
+}
+
+func TestHandler_Prom_Write_Metadata_Authorization(t *testing.T) {
+	mc := &mockPromMetaClient{mockV2MetaClient: mockV2MetaClient{databases: map[string]*meta.DatabaseInfo{
+		promql2influxql.DefaultDatabaseName: {Name: promql2influxql.DefaultDatabaseName},
+	}}}
+	h := Handler{
+		requestTracker:  httpd.NewRequestTracker(),
+		Logger:          logger.NewLogger(errno.ModuleHTTP),
+		Config:          &config.Config{AuthEnabled: true},
+		MetaClient:      mc,
+		PointsWriter:    &mockParquetPointsWriter{},
+		WriteAuthorizer: &mockParquetWriteAuthorizer{},
+	}
+	user := &meta.UserInfo{Name: "user1", MeasurementGrants: meta.MeasurementGrants{
+		MeasurementPrivileges: map[string]map[string]originql.Privilege{
+			promql2influxql.DefaultDatabaseName: {"go_goroutines": originql.WritePrivilege, "mst": originql.WritePrivilege},
+		},
+	}}
+
+	// the request holds the metadata only, the metadata of the metrics not granted is dropped
+	data, err := (&prompb.WriteRequest{Metadata: []prompb.MetricMetadata{
+		{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "go_goroutines", Help: "Number of goroutines."},
+		{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: "Total HTTP requests."},
+	}}).Marshal()
+	assert.NoError(t, err)
+	body := snappy.Encode(nil, data)
+
+	w := httptest.NewRecorder()
+	h.servePromWrite(w, httptest.NewRequest(http.MethodPost, "/api/v1/prom/write", bytes.NewReader(body)), user)
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	assert.Equal(t, []meta.PromMetricMetadata{
+		{MetricFamilyName: "go_goroutines", Type: "gauge", Help: "Number of goroutines."},
+	}, mc.metadata)
+
+	// the metric store is authorized instead of the metric families
+	mc.metadata = nil
+	w = httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/prometheus/mst/api/v1/write", bytes.NewReader(body)), map[string]string{MetricStore: "mst"})
+	h.servePromWriteWithMetricStore(w, req, user)
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	assert.Equal(t, 2, len(mc.metadata))
+
+	mc.metadata = nil
+	w = httptest.NewRecorder()
+	req = mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/prometheus/mst1/api/v1/write", bytes.NewReader(body)), map[string]string{MetricStore: "mst1"})
+	h.servePromWriteWithMetricStore(w, req, user)
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	assert.Equal(t, 0, len(mc.metadata))
+}
+
+func TestHandler_Prom_Metric_Metadata(t *testing.T) {
+	h := Handler{
+		Logger: logger.NewLogger(errno.ModuleHTTP),
+		Config: &config.Config{},
+		MetaClient: &mockPromMetaClient{metadata: []meta.PromMetricMetadata{
+			{MetricFamilyName: "go_goroutines", Type: "gauge", Help: "Number of goroutines."},
+			{MetricFamilyName: "http_requests_total", Type: "counter", Help: "Total HTTP requests."},
+			{MetricStore: "mst", MetricFamilyName: "node_memory_bytes", Type: "gauge", Help: "Memory in use.", Unit: "bytes"},
+		}},
+	}
+	var user meta.User
+
+	query := func(url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.servePromQueryMetaData(w, httptest.NewRequest(http.MethodGet, url, nil), user)
+		return w
+	}
+
+	w := query("/api/v1/metadata")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"status":"success","data":{"go_goroutines":[{"type":"gauge","help":"Number of goroutines.","unit":""}],`+
+		`"http_requests_total":[{"type":"counter","help":"Total HTTP requests.","unit":""}]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = query("/api/v1/metadata?metric=http_requests_total")
+	assert.Equal(t, `{"status":"success","data":{"http_requests_total":[{"type":"counter","help":"Total HTTP requests.","unit":""}]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = query("/api/v1/metadata?limit=1")
+	assert.Equal(t, `{"status":"success","data":{"go_goroutines":[{"type":"gauge","help":"Number of goroutines.","unit":""}]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = query("/api/v1/metadata?metric=unknown")
+	assert.Equal(t, `{"status":"success","data":{}}`, strings.TrimSpace(w.Body.String()))
+
+	w = query("/api/v1/metadata?limit=a")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = query("/api/v1/metadata?db=db0")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/prometheus/mst/api/v1/metadata", nil), map[string]string{MetricStore: "mst"})
+	h.servePromQueryMetaDataWithMetricStore(w, req, user)
+	assert.Equal(t, `{"status":"success","data":{"node_memory_bytes":[{"type":"gauge","help":"Memory in use.","unit":"bytes"}]}}`, strings.TrimSpace(w.Body.String()))
+
+	h.Config.AuthEnabled = true
+	w = query("/api/v1/metadata")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// the metrics and the metric stores denied to the user are hidden
+	db := promql2influxql.DefaultDatabaseName
+	user = &meta.UserInfo{
+		Name:       "user1",
+		Privileges: map[string]originql.Privilege{db: originql.ReadPrivilege},
+		MeasurementGrants: meta.MeasurementGrants{
+			Denies: map[string]map[string]originql.Privilege{db: {"go_goroutines": originql.ReadPrivilege, "mst": originql.ReadPrivilege}},
+		},
+	}
+	w = query("/api/v1/metadata")
+	assert.Equal(t, `{"status":"success","data":{"http_requests_total":[{"type":"counter","help":"Total HTTP requests.","unit":""}]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = httptest.NewRecorder()
+	h.servePromQueryMetaDataWithMetricStore(w, req, user)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+type mockRuleManager struct {
+	owner string
+}
+
+func (m *mockRuleManager) RuleDiscovery(typ string) interface{} {
+	return map[string]interface{}{"groups": []string{typ}}
+}
+
+func (m *mockRuleManager) AlertDiscovery() interface{} {
+	if m.owner != "" {
+		return map[string]interface{}{"alerts": []string{}}
+	}
+	return map[string]interface{}{"alerts": []string{"alert"}}
+}
+
+func (m *mockRuleManager) RuleOwner() string {
+	return m.owner
+}
+
+func TestHandler_Prom_Rules_Alerts(t *testing.T) {
+	h := Handler{
+		Logger: logger.NewLogger(errno.ModuleHTTP),
+		Config: &config.Config{},
+	}
+	var user meta.User
+
+	w := httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules", nil), user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"status":"success","data":{"groups":[]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = httptest.NewRecorder()
+	h.servePromAlerts(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil), user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"status":"success","data":{"alerts":[]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules?type=foo", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	h.RuleManager = &mockRuleManager{}
+	w = httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules?type=alert", nil), user)
+	assert.Equal(t, `{"status":"success","data":{"groups":["alert"]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = httptest.NewRecorder()
+	h.servePromAlerts(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil), user)
+	assert.Equal(t, `{"status":"success","data":{"alerts":["alert"]}}`, strings.TrimSpace(w.Body.String()))
+}
+
+func TestHandler_Prom_Rules_Alerts_Forward(t *testing.T) {
+	var user meta.User
+	owner := &Handler{
+		Logger:      logger.NewLogger(errno.ModuleHTTP),
+		Config:      &config.Config{},
+		RuleManager: &mockRuleManager{},
+	}
+	var forwarded []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = append(forwarded, r.Header.Get(RuleProxy))
+		if r.URL.Path == "/api/v1/rules" {
+			owner.servePromRules(w, r, user)
+			return
+		}
+		owner.servePromAlerts(w, r, user)
+	}))
+	defer server.Close()
+
+	// the ts-sql not holding the rule lease returns the rules and alerts of the owner
+	h := &Handler{
+		Logger:      logger.NewLogger(errno.ModuleHTTP),
+		Config:      &config.Config{},
+		RuleManager: &mockRuleManager{owner: strings.TrimPrefix(server.URL, "http://")},
+	}
+	w := httptest.NewRecorder()
+	h.servePromAlerts(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil), user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"status":"success","data":{"alerts":["alert"]}}`, strings.TrimSpace(w.Body.String()))
+
+	w = httptest.NewRecorder()
+	h.servePromRules(w, httptest.NewRequest(http.MethodGet, "/api/v1/rules?type=record", nil), user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"status":"success","data":{"groups":["record"]}}`, strings.TrimSpace(w.Body.String()))
+	assert.Equal(t, []string{"true", "true"}, forwarded)
+
+	// the forwarded request is not forwarded again
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil)
+	req.Header.Set(RuleProxy, "true")
+	w = httptest.NewRecorder()
+	h.servePromAlerts(w, req, user)
+	assert.Equal(t, `{"status":"success","data":{"alerts":[]}}`, strings.TrimSpace(w.Body.String()))
+	assert.Len(t, forwarded, 2)
+}
+
 func TestHandler_Disabled_Write_Read(t *testing.T) {
 	h := Handler{
 		requestTracker: httpd.NewRequestTracker(),
@@ -460,6 +695,25 @@ func TestHandler_ServeWrite_ReadOnly(t *testing.T) {
 	})
 }
 
+func TestAuthorizeWriteRows(t *testing.T) {
+	user := &meta.UserInfo{
+		Name:       "user1",
+		Privileges: map[string]originql.Privilege{"db0": originql.WritePrivilege},
+		MeasurementGrants: meta.MeasurementGrants{
+			MeasurementPrivileges: map[string]map[string]originql.Privilege{"db1": {"cpu": originql.AllPrivileges}},
+			Denies:                map[string]map[string]originql.Privilege{"db0": {"secret": originql.WritePrivilege}},
+		},
+	}
+
+	rows := []influx.Row{{Name: "cpu"}, {Name: "cpu"}, {Name: "mem"}}
+	assert.NoError(t, authorizeWriteRows(user, "db0", rows))
+	err := authorizeWriteRows(user, "db1", rows)
+	assert.EqualError(t, err, `user1 not authorized to write to "db1".mem`)
+	assert.True(t, influxdb.IsAuthorizationError(err))
+	assert.NoError(t, authorizeWriteRows(user, "db1", rows[:2]))
+	assert.Error(t, authorizeWriteRows(user, "db0", append(rows, influx.Row{Name: "secret"})))
+}
+
 func TestTransYaccSyntaxErr(t *testing.T) {
 	testStr := [][2]string{
 		{"unexpected COMMA", "unexpected COMMA"},
@@ -633,6 +887,16 @@ func TestTimeSeries2Rows(t *testing.T) {
 	}
 }
 
+func TestMetricMetadata2Meta(t *testing.T) {
+	mms := []prompb.MetricMetadata{
+		{Type: prompb.MetricMetadata_GAUGEHISTOGRAM, MetricFamilyName: "request_duration_seconds", Help: "Request duration.", Unit: "seconds"},
+		{Type: prompb.MetricMetadata_COUNTER},
+	}
+	assert.Equal(t, []meta.PromMetricMetadata{
+		{MetricStore: "mst", MetricFamilyName: "request_duration_seconds", Type: "gaugehistogram", Help: "Request duration.", Unit: "seconds"},
+	}, metricMetadata2Meta("mst", mms))
+}
+
 func TestParseJson(t *testing.T) {
 	h := &Handler{
 		mux: mux.NewRouter(),
@@ -0,0 +1,568 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// The InfluxDB 2.x compatibility API. A bucket is a retention policy of a database and is named
+// "database/retention-policy", the name of the database alone refers to its default retention policy.
+// openGemini has no organizations, all the buckets belong to the single virtual organization "openGemini",
+// the requests specifying any other organization are rejected as not found.
+
+const (
+	v2DefaultOrgName      = "openGemini"
+	v2DefaultBucketsLimit = 20
+	v2MaxBucketsLimit     = 100
+)
+
+var (
+	errV2BucketNotFound = errors.New("bucket not found")
+	errV2OrgNotFound    = errors.New("organization not found")
+
+	v2DefaultOrgID = v2ID(v2DefaultOrgName)
+)
+
+type v2Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type v2RetentionRule struct {
+	Type                      string `json:"type"`
+	EverySeconds              int64  `json:"everySeconds"`
+	ShardGroupDurationSeconds int64  `json:"shardGroupDurationSeconds,omitempty"`
+}
+
+type v2Bucket struct {
+	ID             string            `json:"id"`
+	OrgID          string            `json:"orgID"`
+	Type           string            `json:"type"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	RetentionRules []v2RetentionRule `json:"retentionRules"`
+	Links          map[string]string `json:"links"`
+}
+
+type v2Buckets struct {
+	Links   map[string]string `json:"links"`
+	Buckets []*v2Bucket       `json:"buckets"`
+}
+
+type v2BucketRequest struct {
+	OrgID          string            `json:"orgID"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	RetentionRules []v2RetentionRule `json:"retentionRules"`
+}
+
+type v2Org struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Links       map[string]string `json:"links"`
+}
+
+type v2Orgs struct {
+	Links map[string]string `json:"links"`
+	Orgs  []*v2Org          `json:"orgs"`
+}
+
+// v2ID returns the 16 hex digits ID of the name, the IDs are derived from the names so that they need not be stored.
+func v2ID(name string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func newV2Org(name string) *v2Org {
+	id := v2ID(name)
+	return &v2Org{
+		ID:   id,
+		Name: name,
+		Links: map[string]string{
+			"self":    "/api/v2/orgs/" + id,
+			"buckets": "/api/v2/buckets?org=" + name,
+		},
+	}
+}
+
+func newV2Bucket(db string, rpi *meta2.RetentionPolicyInfo) *v2Bucket {
+	name := db + "/" + rpi.Name
+	id := v2ID(name)
+	b := &v2Bucket{
+		ID:             id,
+		OrgID:          v2DefaultOrgID,
+		Type:           "user",
+		Name:           name,
+		RetentionRules: []v2RetentionRule{},
+		Links: map[string]string{
+			"self": "/api/v2/buckets/" + id,
+			"org":  "/api/v2/orgs/" + v2DefaultOrgID,
+		},
+	}
+	if rpi.Duration > 0 {
+		b.RetentionRules = append(b.RetentionRules, v2RetentionRule{
+			Type:                      "expire",
+			EverySeconds:              int64(rpi.Duration / time.Second),
+			ShardGroupDurationSeconds: int64(rpi.ShardGroupDuration / time.Second),
+		})
+	}
+	return b
+}
+
+// splitBucket splits the bucket name into the database and retention policy.
+func splitBucket(bucket string) (string, string, error) {
+	if bucket == "" {
+		return "", "", fmt.Errorf("bucket is required")
+	}
+	db, rp := bucket, ""
+	if i := strings.IndexByte(bucket, '/'); i >= 0 {
+		db, rp = bucket[:i], bucket[i+1:]
+	}
+	if db == "" || strings.Contains(rp, "/") {
+		return "", "", fmt.Errorf("invalid bucket name %q, the name must be database/retention-policy", bucket)
+	}
+	return db, rp, nil
+}
+
+func writeV2Error(w http.ResponseWriter, code int, err error) {
+	var v2Code string
+	switch code {
+	case http.StatusNotFound:
+		v2Code = "not found"
+	case http.StatusUnauthorized:
+		v2Code = "unauthorized"
+	case http.StatusForbidden:
+		v2Code = "forbidden"
+	case http.StatusMethodNotAllowed:
+		v2Code = "method not allowed"
+	case http.StatusUnprocessableEntity:
+		v2Code = "conflict"
+	case http.StatusRequestEntityTooLarge:
+		v2Code = "request too large"
+	case http.StatusInternalServerError:
+		v2Code = "internal error"
+	default:
+		v2Code = "invalid"
+	}
+	b, _ := json2.Marshal(&v2Error{Code: v2Code, Message: err.Error()})
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
+
+// writeV2DecodeError writes the error of decoding the request body, the body exceeding the max body size is 413.
+func writeV2DecodeError(w http.ResponseWriter, err error) {
+	if err == errTruncated {
+		writeV2Error(w, http.StatusRequestEntityTooLarge, errors.New(http.StatusText(http.StatusRequestEntityTooLarge)))
+		return
+	}
+	writeV2Error(w, http.StatusBadRequest, err)
+}
+
+func writeV2Response(w http.ResponseWriter, code int, v interface{}) {
+	b, err := json2.Marshal(v)
+	if err != nil {
+		writeV2Error(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
+
+// serveWriteV2 serves the /api/v2/write endpoint by rewriting the request into the /write request of the bucket.
+func (h *Handler) serveWriteV2(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	values := r.URL.Query()
+	if err := checkV2Org(values.Get("org"), values.Get("orgID")); err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	switch precision := values.Get("precision"); precision {
+	case "", "ns", "us", "ms", "s":
+	default:
+		writeV2Error(w, http.StatusBadRequest, fmt.Errorf("invalid precision %q, the precision must be one of ns, us, ms and s", precision))
+		return
+	}
+
+	db, rp, err := splitBucket(values.Get("bucket"))
+	if err != nil {
+		writeV2Error(w, http.StatusBadRequest, err)
+		return
+	}
+	values.Set("db", db)
+	values.Set("rp", rp)
+	r.URL.RawQuery = values.Encode()
+	vw := &v2ResponseWriter{ResponseWriter: w}
+	h.serveWrite(vw, r, user)
+	vw.flush()
+}
+
+// v2ResponseWriter writes the errors of the v1 handlers with the body of the v2 API, {"code": "...", "message": "..."}.
+type v2ResponseWriter struct {
+	http.ResponseWriter
+	code int // the status code of the error, written with the error body
+}
+
+func (w *v2ResponseWriter) WriteHeader(code int) {
+	if code/100 != 2 {
+		w.code = code
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *v2ResponseWriter) Write(b []byte) (int, error) {
+	w.flush()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *v2ResponseWriter) WriteResponse(resp Response) (int, error) {
+	return w.writeError(resp.Err)
+}
+
+func (w *v2ResponseWriter) WritePromResponse(resp PromResponse) (int, error) {
+	return w.writeError(errors.New(resp.Error))
+}
+
+func (w *v2ResponseWriter) writeError(err error) (int, error) {
+	code := w.code
+	if code == 0 {
+		code = http.StatusInternalServerError
+	}
+	if err == nil {
+		err = errors.New(http.StatusText(code))
+	}
+	w.code = 0
+	writeV2Error(w.ResponseWriter, code, err)
+	return 0, nil
+}
+
+// flush writes the status code of the error written without the body.
+func (w *v2ResponseWriter) flush() {
+	if w.code != 0 {
+		w.ResponseWriter.WriteHeader(w.code)
+		w.code = 0
+	}
+}
+
+// authorizeV2Admin returns an error if the authentication is enabled and the user is not an admin.
+func (h *Handler) authorizeV2Admin(user meta2.User) error {
+	if h.Config.AuthEnabled && (user == nil || !user.AuthorizeUnrestricted()) {
+		return fmt.Errorf("admin privilege is required to manage buckets")
+	}
+	return nil
+}
+
+// v2BucketsOf returns the buckets the user is able to read, sorted by name.
+func (h *Handler) v2BucketsOf(user meta2.User) []*v2Bucket {
+	var buckets []*v2Bucket
+	for name, dbi := range h.MetaClient.Databases() {
+		if dbi.MarkDeleted {
+			continue
+		}
+		if h.Config.AuthEnabled && (user == nil || !user.AuthorizeDatabase(originql.ReadPrivilege, name)) {
+			continue
+		}
+		for _, rpi := range dbi.RetentionPolicies {
+			if rpi.MarkDeleted {
+				continue
+			}
+			buckets = append(buckets, newV2Bucket(name, rpi))
+		}
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Name < buckets[j].Name
+	})
+	return buckets
+}
+
+// findV2Bucket returns the database and retention policy of the bucket with the ID.
+func (h *Handler) findV2Bucket(id string) (*meta2.DatabaseInfo, *meta2.RetentionPolicyInfo, error) {
+	for name, dbi := range h.MetaClient.Databases() {
+		if dbi.MarkDeleted {
+			continue
+		}
+		for _, rpi := range dbi.RetentionPolicies {
+			if !rpi.MarkDeleted && v2ID(name+"/"+rpi.Name) == id {
+				return dbi, rpi, nil
+			}
+		}
+	}
+	return nil, nil, errV2BucketNotFound
+}
+
+// checkV2Org returns an error if the organization of the name or the ID is not the virtual one, which is
+// referred to by the empty name and ID as well.
+func checkV2Org(name, id string) error {
+	if (name != "" && name != v2DefaultOrgName) || (id != "" && id != v2DefaultOrgID) {
+		return errV2OrgNotFound
+	}
+	return nil
+}
+
+func (h *Handler) serveV2Buckets(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	q := r.URL.Query()
+	if err := checkV2Org(q.Get("org"), q.Get("orgID")); err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	limit, offset := v2DefaultBucketsLimit, 0
+	var err error
+	if s := q.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit < 1 || limit > v2MaxBucketsLimit {
+			writeV2Error(w, http.StatusBadRequest, fmt.Errorf("limit must be between 1 and %d", v2MaxBucketsLimit))
+			return
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		if offset, err = strconv.Atoi(s); err != nil || offset < 0 {
+			writeV2Error(w, http.StatusBadRequest, fmt.Errorf("offset must not be negative"))
+			return
+		}
+	}
+
+	name, id := q.Get("name"), q.Get("id")
+	if name != "" && !strings.Contains(name, "/") {
+		// the database name refers to its default retention policy
+		if dbi, err := h.MetaClient.Database(name); err == nil && dbi != nil {
+			name = name + "/" + dbi.DefaultRetentionPolicy
+		}
+	}
+
+	var buckets []*v2Bucket
+	for _, b := range h.v2BucketsOf(user) {
+		if (name == "" || b.Name == name) && (id == "" || b.ID == id) {
+			buckets = append(buckets, b)
+		}
+	}
+	if offset >= len(buckets) {
+		buckets = buckets[:0]
+	} else {
+		buckets = buckets[offset:]
+	}
+	if len(buckets) > limit {
+		buckets = buckets[:limit]
+	}
+	writeV2Response(w, http.StatusOK, &v2Buckets{
+		Links:   map[string]string{"self": "/api/v2/buckets"},
+		Buckets: append([]*v2Bucket{}, buckets...),
+	})
+}
+
+func (h *Handler) serveV2Bucket(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	dbi, rpi, err := h.findV2Bucket(mux.Vars(r)["id"])
+	if err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	if h.Config.AuthEnabled && (user == nil || !user.AuthorizeDatabase(originql.ReadPrivilege, dbi.Name)) {
+		writeV2Error(w, http.StatusNotFound, errV2BucketNotFound)
+		return
+	}
+	writeV2Response(w, http.StatusOK, newV2Bucket(dbi.Name, rpi))
+}
+
+// decodeV2BucketRequest decodes the request body, errTruncated is returned if the body exceeds maxBodySize.
+func decodeV2BucketRequest(r *http.Request, maxBodySize int64) (*v2BucketRequest, time.Duration, time.Duration, error) {
+	body, err := readAllLimited(r.Body, maxBodySize)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	req := &v2BucketRequest{}
+	if err = json2.Unmarshal(body, req); err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to decode request body: %w", err)
+	}
+	var duration, shardGroupDuration time.Duration
+	for _, rule := range req.RetentionRules {
+		if rule.Type != "" && rule.Type != "expire" {
+			return nil, 0, 0, fmt.Errorf("unsupported retention rule type %q", rule.Type)
+		}
+		if rule.EverySeconds < 0 || rule.ShardGroupDurationSeconds < 0 {
+			return nil, 0, 0, fmt.Errorf("retention rule durations must not be negative")
+		}
+		duration = time.Duration(rule.EverySeconds) * time.Second
+		shardGroupDuration = time.Duration(rule.ShardGroupDurationSeconds) * time.Second
+	}
+	return req, duration, shardGroupDuration, nil
+}
+
+// serveCreateV2Bucket creates the retention policy of the bucket, the database is created if it does not exist.
+// The bucket named by the database alone is its default retention policy, which is created as autogen if
+// the database does not exist or has no default retention policy.
+func (h *Handler) serveCreateV2Bucket(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if err := h.authorizeV2Admin(user); err != nil {
+		writeV2Error(w, http.StatusForbidden, err)
+		return
+	}
+	req, duration, shardGroupDuration, err := decodeV2BucketRequest(r, int64(h.Config.MaxBodySize))
+	if err != nil {
+		writeV2DecodeError(w, err)
+		return
+	}
+	if err = checkV2Org(r.URL.Query().Get("org"), req.OrgID); err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	db, rp, err := splitBucket(req.Name)
+	if err != nil {
+		writeV2Error(w, http.StatusBadRequest, err)
+		return
+	}
+	dbi, err := h.MetaClient.Database(db)
+	if err != nil && !errno.Equal(err, errno.DatabaseNotFound) {
+		writeV2Error(w, http.StatusInternalServerError, err)
+		return
+	}
+	if rp == "" {
+		rp = meta2.DefaultRetentionPolicyName
+		if dbi != nil && dbi.DefaultRetentionPolicy != "" {
+			rp = dbi.DefaultRetentionPolicy
+		}
+	}
+	if !meta2.ValidName(db) || !meta2.ValidName(rp) {
+		writeV2Error(w, http.StatusBadRequest, meta2.ErrInvalidName)
+		return
+	}
+
+	spec := &meta2.RetentionPolicySpec{Name: rp, Duration: &duration, ShardGroupDuration: shardGroupDuration}
+	switch {
+	case dbi == nil:
+		dbi, err = h.MetaClient.CreateDatabaseWithRetentionPolicy(db, spec, &meta2.ShardKeyInfo{}, false, 0)
+	case dbi.RetentionPolicy(rp) != nil:
+		writeV2Error(w, http.StatusUnprocessableEntity, fmt.Errorf("bucket with name %s/%s already exists", db, rp))
+		return
+	default:
+		_, err = h.MetaClient.CreateRetentionPolicy(db, spec, dbi.DefaultRetentionPolicy == "")
+		if err == nil {
+			dbi, err = h.MetaClient.Database(db)
+		}
+	}
+	if err != nil {
+		h.Logger.Error("create bucket failed", zap.Error(err), zap.String("db", db), zap.String("rp", rp))
+		writeV2Error(w, http.StatusBadRequest, err)
+		return
+	}
+	rpi := dbi.RetentionPolicy(rp)
+	if rpi == nil {
+		writeV2Error(w, http.StatusInternalServerError, fmt.Errorf("retention policy %s of database %s is not found after creating", rp, db))
+		return
+	}
+
+	b := newV2Bucket(db, rpi)
+	b.Description = req.Description
+	writeV2Response(w, http.StatusCreated, b)
+}
+
+// serveUpdateV2Bucket updates the retention rules of the bucket, buckets can not be renamed.
+func (h *Handler) serveUpdateV2Bucket(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if err := h.authorizeV2Admin(user); err != nil {
+		writeV2Error(w, http.StatusForbidden, err)
+		return
+	}
+	dbi, rpi, err := h.findV2Bucket(mux.Vars(r)["id"])
+	if err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	req, duration, shardGroupDuration, err := decodeV2BucketRequest(r, int64(h.Config.MaxBodySize))
+	if err != nil {
+		writeV2DecodeError(w, err)
+		return
+	}
+	if req.Name != "" && req.Name != dbi.Name+"/"+rpi.Name {
+		writeV2Error(w, http.StatusBadRequest, fmt.Errorf("renaming buckets is not supported"))
+		return
+	}
+
+	if req.RetentionRules != nil {
+		rpu := &meta2.RetentionPolicyUpdate{Duration: &duration}
+		if shardGroupDuration > 0 {
+			rpu.ShardGroupDuration = &shardGroupDuration
+		}
+		if err = h.MetaClient.UpdateRetentionPolicy(dbi.Name, rpi.Name, rpu, false); err != nil {
+			h.Logger.Error("update bucket failed", zap.Error(err), zap.String("db", dbi.Name), zap.String("rp", rpi.Name))
+			writeV2Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if rpi, err = h.MetaClient.RetentionPolicy(dbi.Name, rpi.Name); err != nil || rpi == nil {
+			writeV2Error(w, http.StatusNotFound, errV2BucketNotFound)
+			return
+		}
+	}
+	b := newV2Bucket(dbi.Name, rpi)
+	b.Description = req.Description
+	writeV2Response(w, http.StatusOK, b)
+}
+
+// serveDeleteV2Bucket drops the retention policy of the bucket.
+func (h *Handler) serveDeleteV2Bucket(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if err := h.authorizeV2Admin(user); err != nil {
+		writeV2Error(w, http.StatusForbidden, err)
+		return
+	}
+	dbi, rpi, err := h.findV2Bucket(mux.Vars(r)["id"])
+	if err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	if err = h.MetaClient.MarkRetentionPolicyDelete(dbi.Name, rpi.Name); err != nil {
+		h.Logger.Error("delete bucket failed", zap.Error(err), zap.String("db", dbi.Name), zap.String("rp", rpi.Name))
+		writeV2Error(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveV2Orgs lists the virtual organization, the other organizations are not found.
+func (h *Handler) serveV2Orgs(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	q := r.URL.Query()
+	if err := checkV2Org(q.Get("org"), q.Get("orgID")); err != nil {
+		writeV2Error(w, http.StatusNotFound, err)
+		return
+	}
+	writeV2Response(w, http.StatusOK, &v2Orgs{
+		Links: map[string]string{"self": "/api/v2/orgs"},
+		Orgs:  []*v2Org{newV2Org(v2DefaultOrgName)},
+	})
+}
+
+func (h *Handler) serveV2Org(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if mux.Vars(r)["id"] != v2DefaultOrgID {
+		writeV2Error(w, http.StatusNotFound, errV2OrgNotFound)
+		return
+	}
+	writeV2Response(w, http.StatusOK, newV2Org(v2DefaultOrgName))
+}
+
+// serveModifyV2Org rejects creating, updating or deleting organizations, there is only the virtual one.
+func (h *Handler) serveModifyV2Org(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	writeV2Error(w, http.StatusMethodNotAllowed, fmt.Errorf("organizations can not be created, modified or deleted"))
+}
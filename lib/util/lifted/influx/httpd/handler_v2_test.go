@@ -0,0 +1,331 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/influxdata/influxdb/services/httpd"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockV2MetaClient struct {
+	metaclient.MetaClient
+	databases map[string]*meta.DatabaseInfo
+}
+
+func (c *mockV2MetaClient) Databases() map[string]*meta.DatabaseInfo {
+	return c.databases
+}
+
+func (c *mockV2MetaClient) Database(name string) (*meta.DatabaseInfo, error) {
+	dbi, ok := c.databases[name]
+	if !ok {
+		return nil, errno.NewError(errno.DatabaseNotFound, name)
+	}
+	return dbi, nil
+}
+
+func (c *mockV2MetaClient) CreateDatabaseWithRetentionPolicy(name string, spec *meta.RetentionPolicySpec, _ *meta.ShardKeyInfo, _ bool, _ uint32) (*meta.DatabaseInfo, error) {
+	c.databases[name] = &meta.DatabaseInfo{
+		Name:                   name,
+		DefaultRetentionPolicy: spec.Name,
+		RetentionPolicies:      map[string]*meta.RetentionPolicyInfo{spec.Name: spec.NewRetentionPolicyInfo()},
+	}
+	return c.databases[name], nil
+}
+
+func (c *mockV2MetaClient) CreateRetentionPolicy(database string, spec *meta.RetentionPolicySpec, makeDefault bool) (*meta.RetentionPolicyInfo, error) {
+	rpi := spec.NewRetentionPolicyInfo()
+	c.databases[database].RetentionPolicies[spec.Name] = rpi
+	if makeDefault {
+		c.databases[database].DefaultRetentionPolicy = spec.Name
+	}
+	return rpi, nil
+}
+
+func (c *mockV2MetaClient) MarkRetentionPolicyDelete(database, name string) error {
+	c.databases[database].RetentionPolicies[name].MarkDeleted = true
+	return nil
+}
+
+func (c *mockV2MetaClient) GetShardGroupByTimeRange(_, _ string, _, _ time.Time) ([]*meta.ShardGroupInfo, error) {
+	return nil, nil
+}
+
+func (c *mockV2MetaClient) RevertRetentionPolicyDelete(_, _ string) error {
+	return nil
+}
+
+func (c *mockV2MetaClient) TagArrayEnabled(_ string) bool {
+	return false
+}
+
+func (c *mockV2MetaClient) UpdateMeasurement(_, _, _ string, _ *meta.Options) error {
+	return nil
+}
+
+func (c *mockV2MetaClient) User(_ string) (meta.User, error) {
+	return nil, nil
+}
+
+func newV2TestHandler() *Handler {
+	return &Handler{
+		Logger: logger.NewLogger(errno.ModuleHTTP),
+		Config: &config.Config{},
+		MetaClient: &mockV2MetaClient{databases: map[string]*meta.DatabaseInfo{
+			"db0": {
+				Name:                   "db0",
+				DefaultRetentionPolicy: "autogen",
+				RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+					"autogen": {Name: "autogen"},
+					"rp1":     {Name: "rp1", Duration: 72 * time.Hour, ShardGroupDuration: 24 * time.Hour},
+					"rp2":     {Name: "rp2", MarkDeleted: true},
+				},
+			},
+		}},
+	}
+}
+
+func TestSplitBucket(t *testing.T) {
+	db, rp, err := splitBucket("db0/rp1")
+	require.NoError(t, err)
+	assert.Equal(t, "db0", db)
+	assert.Equal(t, "rp1", rp)
+
+	db, rp, err = splitBucket("db0")
+	require.NoError(t, err)
+	assert.Equal(t, "db0", db)
+	assert.Equal(t, "", rp)
+
+	for _, bucket := range []string{"", "/rp", "db/rp/x"} {
+		_, _, err = splitBucket(bucket)
+		assert.Error(t, err, bucket)
+	}
+}
+
+func TestHandler_WriteV2_BadRequest(t *testing.T) {
+	h := newV2TestHandler()
+	var user meta.User
+
+	for query, msg := range map[string]string{
+		"/api/v2/write?org=openGemini":                        "bucket is required",
+		"/api/v2/write?org=openGemini&bucket=db0&precision=h": `invalid precision \"h\", the precision must be one of ns, us, ms and s`,
+	} {
+		w := httptest.NewRecorder()
+		h.serveWriteV2(w, httptest.NewRequest(http.MethodPost, query, strings.NewReader("m v=1")), user)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.JSONEq(t, `{"code":"invalid","message":"`+msg+`"}`, w.Body.String(), query)
+	}
+
+	w := httptest.NewRecorder()
+	h.serveWriteV2(w, httptest.NewRequest(http.MethodPost, "/api/v2/write?org=o&bucket=db0", strings.NewReader("m v=1")), user)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"code":"not found","message":"organization not found"}`, w.Body.String())
+
+	// the errors of the write are in the format of the v2 API, with or without the response writer of the routes
+	h.requestTracker = httpd.NewRequestTracker()
+	for _, c := range []struct {
+		query, body string
+		code        int
+		v2Code      string
+	}{
+		{"/api/v2/write?bucket=db1", "m v=1", http.StatusNotFound, "not found"},
+		{"/api/v2/write?bucket=db0", "m v=", http.StatusBadRequest, "invalid"},
+	} {
+		for _, wrap := range []bool{false, true} {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, c.query, strings.NewReader(c.body))
+			var w http.ResponseWriter = recorder
+			if wrap {
+				w = NewResponseWriter(recorder, req)
+			}
+			h.serveWriteV2(w, req, user)
+			assert.Equal(t, c.code, recorder.Code, c.query)
+			v2Err := &v2Error{}
+			require.NoError(t, json2.Unmarshal(recorder.Body.Bytes(), v2Err), recorder.Body.String())
+			assert.Equal(t, c.v2Code, v2Err.Code)
+			assert.NotEmpty(t, v2Err.Message)
+		}
+	}
+}
+
+func TestHandler_V2Buckets(t *testing.T) {
+	h := newV2TestHandler()
+	var user meta.User
+
+	w := httptest.NewRecorder()
+	h.serveV2Buckets(w, httptest.NewRequest(http.MethodGet, "/api/v2/buckets", nil), user)
+	require.Equal(t, http.StatusOK, w.Code)
+	buckets := &v2Buckets{}
+	require.NoError(t, json2.Unmarshal(w.Body.Bytes(), buckets))
+	require.Equal(t, 2, len(buckets.Buckets))
+	assert.Equal(t, "db0/autogen", buckets.Buckets[0].Name)
+	assert.Equal(t, v2DefaultOrgID, buckets.Buckets[0].OrgID)
+	assert.Empty(t, buckets.Buckets[0].RetentionRules)
+	assert.Equal(t, "db0/rp1", buckets.Buckets[1].Name)
+	assert.Equal(t, []v2RetentionRule{{Type: "expire", EverySeconds: 259200, ShardGroupDurationSeconds: 86400}}, buckets.Buckets[1].RetentionRules)
+
+	// the name of the database refers to the default retention policy
+	w = httptest.NewRecorder()
+	h.serveV2Buckets(w, httptest.NewRequest(http.MethodGet, "/api/v2/buckets?name=db0", nil), user)
+	buckets = &v2Buckets{}
+	require.NoError(t, json2.Unmarshal(w.Body.Bytes(), buckets))
+	require.Equal(t, 1, len(buckets.Buckets))
+	assert.Equal(t, "db0/autogen", buckets.Buckets[0].Name)
+
+	w = httptest.NewRecorder()
+	h.serveV2Buckets(w, httptest.NewRequest(http.MethodGet, "/api/v2/buckets?offset=1&limit=1", nil), user)
+	buckets = &v2Buckets{}
+	require.NoError(t, json2.Unmarshal(w.Body.Bytes(), buckets))
+	require.Equal(t, 1, len(buckets.Buckets))
+	assert.Equal(t, "db0/rp1", buckets.Buckets[0].Name)
+
+	w = httptest.NewRecorder()
+	h.serveV2Buckets(w, httptest.NewRequest(http.MethodGet, "/api/v2/buckets?limit=0", nil), user)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// all the buckets belong to the virtual organization
+	w = httptest.NewRecorder()
+	h.serveV2Buckets(w, httptest.NewRequest(http.MethodGet, "/api/v2/buckets?org=openGemini&orgID="+v2DefaultOrgID, nil), user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	for _, query := range []string{"org=my-org", "orgID=0000000000000001"} {
+		w = httptest.NewRecorder()
+		h.serveV2Buckets(w, httptest.NewRequest(http.MethodGet, "/api/v2/buckets?"+query, nil), user)
+		assert.Equal(t, http.StatusNotFound, w.Code, query)
+		assert.JSONEq(t, `{"code":"not found","message":"organization not found"}`, w.Body.String(), query)
+	}
+
+	w = httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v2/buckets/x", nil), map[string]string{"id": v2ID("db0/rp1")})
+	h.serveV2Bucket(w, req, user)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"db0/rp1"`)
+
+	w = httptest.NewRecorder()
+	req = mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v2/buckets/x", nil), map[string]string{"id": v2ID("db0/rp2")})
+	h.serveV2Bucket(w, req, user)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"code":"not found","message":"bucket not found"}`, w.Body.String())
+}
+
+func TestHandler_V2CreateDeleteBucket(t *testing.T) {
+	h := newV2TestHandler()
+	var user meta.User
+
+	w := httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets",
+		strings.NewReader(`{"orgID": "`+v2DefaultOrgID+`", "name": "db1", "retentionRules": [{"type": "expire", "everySeconds": 86400}]}`)), user)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	b := &v2Bucket{}
+	require.NoError(t, json2.Unmarshal(w.Body.Bytes(), b))
+	assert.Equal(t, "db1/autogen", b.Name)
+	assert.Equal(t, v2DefaultOrgID, b.OrgID)
+	assert.Equal(t, int64(86400), b.RetentionRules[0].EverySeconds)
+
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets",
+		strings.NewReader(`{"orgID": "0000000000000001", "name": "db2"}`)), user)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// the name of the database refers to its default retention policy
+	mc := h.MetaClient.(*mockV2MetaClient)
+	mc.databases["db0"].DefaultRetentionPolicy = "rp1"
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets", strings.NewReader(`{"name": "db0"}`)), user)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.JSONEq(t, `{"code":"conflict","message":"bucket with name db0/rp1 already exists"}`, w.Body.String())
+
+	mc.databases["db3"] = &meta.DatabaseInfo{Name: "db3", RetentionPolicies: map[string]*meta.RetentionPolicyInfo{}}
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets", strings.NewReader(`{"name": "db3"}`)), user)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"name":"db3/autogen"`)
+	assert.Equal(t, "autogen", mc.databases["db3"].DefaultRetentionPolicy)
+
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets", strings.NewReader(`{"name": "db0/rp3"}`)), user)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets", strings.NewReader(`{"name": "db0/rp1"}`)), user)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.JSONEq(t, `{"code":"conflict","message":"bucket with name db0/rp1 already exists"}`, w.Body.String())
+
+	h.Config.MaxBodySize = 10
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets", strings.NewReader(`{"name": "db0/rp4"}`)), user)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.JSONEq(t, `{"code":"request too large","message":"Request Entity Too Large"}`, w.Body.String())
+	h.Config.MaxBodySize = 0
+
+	w = httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/v2/buckets/x", nil), map[string]string{"id": v2ID("db0/rp3")})
+	h.serveDeleteV2Bucket(w, req, user)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	w = httptest.NewRecorder()
+	h.serveDeleteV2Bucket(w, req, user)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	h.Config.AuthEnabled = true
+	w = httptest.NewRecorder()
+	h.serveCreateV2Bucket(w, httptest.NewRequest(http.MethodPost, "/api/v2/buckets", strings.NewReader(`{"name": "db2"}`)), user)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestHandler_V2Orgs(t *testing.T) {
+	h := newV2TestHandler()
+	var user meta.User
+
+	w := httptest.NewRecorder()
+	h.serveV2Orgs(w, httptest.NewRequest(http.MethodGet, "/api/v2/orgs", nil), user)
+	require.Equal(t, http.StatusOK, w.Code)
+	orgs := &v2Orgs{}
+	require.NoError(t, json2.Unmarshal(w.Body.Bytes(), orgs))
+	require.Equal(t, 1, len(orgs.Orgs))
+	assert.Equal(t, v2DefaultOrgName, orgs.Orgs[0].Name)
+	assert.Equal(t, v2DefaultOrgID, orgs.Orgs[0].ID)
+
+	w = httptest.NewRecorder()
+	h.serveV2Orgs(w, httptest.NewRequest(http.MethodGet, "/api/v2/orgs?org=my-org", nil), user)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v2/orgs/x", nil), map[string]string{"id": v2ID(v2DefaultOrgName)})
+	h.serveV2Org(w, req, user)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"openGemini"`)
+
+	w = httptest.NewRecorder()
+	h.serveModifyV2Org(w, req, user)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = httptest.NewRecorder()
+	req = mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v2/orgs/x", nil), map[string]string{"id": v2ID("my-org")})
+	h.serveV2Org(w, req, user)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
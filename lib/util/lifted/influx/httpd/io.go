@@ -42,3 +42,12 @@ func (r *truncatedReader) Close() error {
 	}
 	return nil
 }
+
+// readAllLimited reads all the data from r, it stops with errTruncated after n bytes.
+// A non-positive n means no limit.
+func readAllLimited(r io.Reader, n int64) ([]byte, error) {
+	if n > 0 {
+		r = truncateReader(r, n)
+	}
+	return io.ReadAll(r)
+}
@@ -273,6 +273,7 @@ func (*Query) node()     {}
 func (Statements) node() {}
 
 func (*AlterRetentionPolicyStatement) node()       {}
+func (*BackfillContinuousQueryStatement) node()    {}
 func (*CreateContinuousQueryStatement) node()      {}
 func (*CreateDatabaseStatement) node()             {}
 func (*CreateMeasurementStatement) node()          {}
@@ -293,6 +294,12 @@ func (*DropSubscriptionStatement) node()           {}
 func (*DropUserStatement) node()                   {}
 func (*ExplainStatement) node()                    {}
 func (*GrantStatement) node()                      {}
+func (*GrantRoleStatement) node()                  {}
+func (*DenyStatement) node()                       {}
+func (*CreateRoleStatement) node()                 {}
+func (*DropRoleStatement) node()                   {}
+func (*ShowRolesStatement) node()                  {}
+func (*RevokeRoleStatement) node()                 {}
 func (*GrantAdminStatement) node()                 {}
 func (*KillQueryStatement) node()                  {}
 func (*RevokeStatement) node()                     {}
@@ -309,6 +316,7 @@ func (*ShowMeasurementCardinalityStatement) node() {}
 func (*ShowMeasurementsStatement) node()           {}
 func (*ShowMeasurementsDetailStatement) node()     {}
 func (*ShowQueriesStatement) node()                {}
+func (*ShowResourceGroupsStatement) node()         {}
 func (*ShowSeriesStatement) node()                 {}
 func (*ShowSeriesCardinalityStatement) node()      {}
 func (*ShowShardGroupsStatement) node()            {}
@@ -437,12 +445,23 @@ type ExecutionPrivilege struct {
 
 	// Database privilege required.
 	Privilege Privilege
+
+	// Name of the measurement, the privilege is required on the whole database if it is empty.
+	Measurement string
+
+	// The measurement is a regex, the privilege is required on all measurements of the database.
+	MeasurementRegex bool
+
+	// The result is filtered by the measurements authorized to the user, the privilege is required on
+	// the database or any of its measurements.
+	AnyMeasurement bool
 }
 
 // ExecutionPrivileges is a list of privileges required to execute a statement.
 type ExecutionPrivileges []ExecutionPrivilege
 
 func (*AlterRetentionPolicyStatement) stmt()       {}
+func (*BackfillContinuousQueryStatement) stmt()    {}
 func (*CreateContinuousQueryStatement) stmt()      {}
 func (*CreateDatabaseStatement) stmt()             {}
 func (*CreateMeasurementStatement) stmt()          {}
@@ -461,6 +480,12 @@ func (*DropSubscriptionStatement) stmt()           {}
 func (*DropUserStatement) stmt()                   {}
 func (*ExplainStatement) stmt()                    {}
 func (*GrantStatement) stmt()                      {}
+func (*GrantRoleStatement) stmt()                  {}
+func (*DenyStatement) stmt()                       {}
+func (*CreateRoleStatement) stmt()                 {}
+func (*DropRoleStatement) stmt()                   {}
+func (*ShowRolesStatement) stmt()                  {}
+func (*RevokeRoleStatement) stmt()                 {}
 func (*GrantAdminStatement) stmt()                 {}
 func (*KillQueryStatement) stmt()                  {}
 func (*ShowContinuousQueriesStatement) stmt()      {}
@@ -472,6 +497,7 @@ func (*ShowMeasurementCardinalityStatement) stmt() {}
 func (*ShowMeasurementsStatement) stmt()           {}
 func (*ShowMeasurementsDetailStatement) stmt()     {}
 func (*ShowQueriesStatement) stmt()                {}
+func (*ShowResourceGroupsStatement) stmt()         {}
 func (*ShowRetentionPoliciesStatement) stmt()      {}
 func (*ShowSeriesStatement) stmt()                 {}
 func (*ShowSeriesCardinalityStatement) stmt()      {}
@@ -663,6 +689,30 @@ func (a *Sources) UnmarshalBinary(buf []byte) error {
 	return nil
 }
 
+// showSourcesPrivileges returns the read privileges on the measurements of the SHOW statements,
+// the privilege is required on all measurements of the database if there is no source.
+func showSourcesPrivileges(database string, sources Sources) ExecutionPrivileges {
+	mms := sources.Measurements()
+	if len(mms) == 0 {
+		return ExecutionPrivileges{{Admin: false, Name: database, Rwuser: true, Privilege: ReadPrivilege, MeasurementRegex: true}}
+	}
+	ep := make(ExecutionPrivileges, 0, len(mms))
+	for _, mm := range mms {
+		db := mm.Database
+		if db == "" {
+			db = database
+		}
+		ep = append(ep, ExecutionPrivilege{
+			Name:             db,
+			Privilege:        ReadPrivilege,
+			Rwuser:           true,
+			Measurement:      mm.Name,
+			MeasurementRegex: mm.Regex != nil,
+		})
+	}
+	return ep
+}
+
 // RequiredPrivileges recursively returns a list of execution privileges required.
 func (a Sources) RequiredPrivileges() (ExecutionPrivileges, error) {
 	var ep ExecutionPrivileges
@@ -670,9 +720,11 @@ func (a Sources) RequiredPrivileges() (ExecutionPrivileges, error) {
 		switch source := source.(type) {
 		case *Measurement:
 			ep = append(ep, ExecutionPrivilege{
-				Name:      source.Database,
-				Privilege: ReadPrivilege,
-				Rwuser:    true,
+				Name:             source.Database,
+				Privilege:        ReadPrivilege,
+				Rwuser:           true,
+				Measurement:      source.Name,
+				MeasurementRegex: source.Regex != nil,
 			})
 		case *SubQuery:
 			privs, err := source.Statement.RequiredPrivileges()
@@ -1105,7 +1157,10 @@ type GrantStatement struct {
 	// Database to grant the privilege to.
 	On string
 
-	// Who to grant the privilege to.
+	// Measurement to grant the privilege to, the privilege is granted on the whole database if it is empty.
+	Measurement string
+
+	// Who to grant the privilege to, a user or a role.
 	User string
 }
 
@@ -1115,7 +1170,7 @@ func (s *GrantStatement) String() string {
 	_, _ = buf.WriteString("GRANT ")
 	_, _ = buf.WriteString(s.Privilege.String())
 	_, _ = buf.WriteString(" ON ")
-	_, _ = buf.WriteString(QuoteIdent(s.On))
+	writePrivilegeTarget(&buf, s.On, s.Measurement)
 	_, _ = buf.WriteString(" TO ")
 	_, _ = buf.WriteString(QuoteIdent(s.User))
 	return buf.String()
@@ -1131,6 +1186,151 @@ func (s *GrantStatement) DefaultDatabase() string {
 	return s.On
 }
 
+// writePrivilegeTarget writes the database or the database.measurement a privilege is granted on.
+func writePrivilegeTarget(buf *bytes.Buffer, database, measurement string) {
+	if measurement == "" {
+		_, _ = buf.WriteString(QuoteIdent(database))
+		return
+	}
+	_, _ = buf.WriteString(QuoteIdent(database, measurement))
+}
+
+// DenyStatement represents a command for denying a privilege.
+type DenyStatement struct {
+	// The privilege to be denied.
+	Privilege Privilege
+
+	// Database to deny the privilege on.
+	On string
+
+	// Measurement to deny the privilege on, the privilege is denied on the whole database if it is empty.
+	Measurement string
+
+	// Who to deny the privilege to, a user or a role.
+	User string
+}
+
+// String returns a string representation of the deny statement.
+func (s *DenyStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("DENY ")
+	_, _ = buf.WriteString(s.Privilege.String())
+	_, _ = buf.WriteString(" ON ")
+	writePrivilegeTarget(&buf, s.On, s.Measurement)
+	_, _ = buf.WriteString(" TO ")
+	_, _ = buf.WriteString(QuoteIdent(s.User))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a DenyStatement.
+func (s *DenyStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *DenyStatement) DefaultDatabase() string {
+	return s.On
+}
+
+// CreateRoleStatement represents a command for creating a new role.
+type CreateRoleStatement struct {
+	// Name of the role to be created.
+	Name string
+}
+
+// String returns a string representation of the create role statement.
+func (s *CreateRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("CREATE ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a CreateRoleStatement.
+func (s *CreateRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// DropRoleStatement represents a command for dropping a role.
+type DropRoleStatement struct {
+	// Name of the role to drop.
+	Name string
+}
+
+// String returns a string representation of the drop role statement.
+func (s *DropRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("DROP ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a DropRoleStatement.
+func (s *DropRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// ShowRolesStatement represents a command for listing roles.
+type ShowRolesStatement struct{}
+
+// String returns a string representation of the show roles statement.
+func (s *ShowRolesStatement) String() string {
+	return "SHOW ROLES"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowRolesStatement.
+func (s *ShowRolesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// GrantRoleStatement represents a command for assigning a role to a user.
+type GrantRoleStatement struct {
+	// The role to be assigned.
+	Role string
+
+	// Who to assign the role to.
+	User string
+}
+
+// String returns a string representation of the grant role statement.
+func (s *GrantRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("GRANT ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Role))
+	_, _ = buf.WriteString(" TO ")
+	_, _ = buf.WriteString(QuoteIdent(s.User))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a GrantRoleStatement.
+func (s *GrantRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
+// RevokeRoleStatement represents a command for removing a role from a user.
+type RevokeRoleStatement struct {
+	// The role to be removed.
+	Role string
+
+	// Who to remove the role from.
+	User string
+}
+
+// String returns a string representation of the revoke role statement.
+func (s *RevokeRoleStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("REVOKE ROLE ")
+	_, _ = buf.WriteString(QuoteIdent(s.Role))
+	_, _ = buf.WriteString(" FROM ")
+	_, _ = buf.WriteString(QuoteIdent(s.User))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a RevokeRoleStatement.
+func (s *RevokeRoleStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
 // GrantAdminStatement represents a command for granting admin privilege.
 type GrantAdminStatement struct {
 	// Who to grant the privilege to.
@@ -1208,7 +1408,10 @@ type RevokeStatement struct {
 	// Database to revoke the privilege from.
 	On string
 
-	// Who to revoke privilege from.
+	// Measurement to revoke the privilege from, the privilege is revoked on the whole database if it is empty.
+	Measurement string
+
+	// Who to revoke privilege from, a user or a role.
 	User string
 }
 
@@ -1218,7 +1421,7 @@ func (s *RevokeStatement) String() string {
 	_, _ = buf.WriteString("REVOKE ")
 	_, _ = buf.WriteString(s.Privilege.String())
 	_, _ = buf.WriteString(" ON ")
-	_, _ = buf.WriteString(QuoteIdent(s.On))
+	writePrivilegeTarget(&buf, s.On, s.Measurement)
 	_, _ = buf.WriteString(" FROM ")
 	_, _ = buf.WriteString(QuoteIdent(s.User))
 	return buf.String()
@@ -1543,6 +1746,9 @@ type SelectStatement struct {
 	PromSubCalls []*PromSubCall
 
 	SelectAllTags bool
+
+	// Union is not nil if the statement selects from the statements of the UNION.
+	Union *Union
 }
 
 func (s *SelectStatement) SetStmtId(id int) {
@@ -1629,6 +1835,7 @@ func (s *SelectStatement) Clone() *SelectStatement {
 	for _, f := range s.SortFields {
 		clone.SortFields = append(clone.SortFields, &SortField{Name: f.Name, Ascending: f.Ascending})
 	}
+	clone.Union = s.Union.Clone()
 	return &clone
 }
 
@@ -1659,6 +1866,8 @@ func cloneSource(s Source) Source {
 		c.LSrc = cloneSource(s.LSrc)
 		c.RSrc = cloneSource(s.RSrc)
 		c.Condition = CloneExpr(s.Condition)
+		c.JoinType = s.JoinType
+		c.Tolerance = s.Tolerance
 		return c
 	case *Unnest:
 		return s.Clone()
@@ -2887,6 +3096,9 @@ func (s *SelectStatement) Reduce(valuer Valuer) *SelectStatement {
 
 // String returns a string representation of the select statement.
 func (s *SelectStatement) String() string {
+	if s.Union != nil {
+		return s.Union.String()
+	}
 	var buf bytes.Buffer
 	_, _ = buf.WriteString("SELECT ")
 
@@ -2962,7 +3174,8 @@ func (s *SelectStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
 	}
 
 	if s.Target != nil {
-		ep = append(ep, ExecutionPrivilege{Admin: false, Name: s.Target.Measurement.Database, Rwuser: true, Privilege: WritePrivilege})
+		ep = append(ep, ExecutionPrivilege{Admin: false, Name: s.Target.Measurement.Database, Rwuser: true, Privilege: WritePrivilege,
+			Measurement: s.Target.Measurement.Name})
 	}
 	return ep, nil
 }
@@ -3345,7 +3558,7 @@ func (s *ShowSeriesStatement) String() string {
 
 // RequiredPrivileges returns the privilege required to execute a ShowSeriesStatement.
 func (s *ShowSeriesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
-	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+	return showSourcesPrivileges(s.Database, s.Sources), nil
 }
 
 // DefaultDatabase returns the default database from the statement.
@@ -3656,6 +3869,50 @@ func (s *DropContinuousQueryStatement) DefaultDatabase() string {
 	return s.Database
 }
 
+// BackfillContinuousQueryStatement represents a command for running a continuous query over a past time range.
+type BackfillContinuousQueryStatement struct {
+	Name     string
+	Database string
+
+	// The time range [StartTime, EndTime) to run the continuous query over.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// String returns a string representation of the statement.
+func (s *BackfillContinuousQueryStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("BACKFILL CONTINUOUS QUERY ")
+	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+	_, _ = buf.WriteString(" FROM ")
+	_, _ = buf.WriteString(QuoteString(s.StartTime.UTC().Format(time.RFC3339Nano)))
+	_, _ = buf.WriteString(" TO ")
+	_, _ = buf.WriteString(QuoteString(s.EndTime.UTC().Format(time.RFC3339Nano)))
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege(s) required to execute a BackfillContinuousQueryStatement
+func (s *BackfillContinuousQueryStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: WritePrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *BackfillContinuousQueryStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+func (s *BackfillContinuousQueryStatement) validate() error {
+	if !s.StartTime.Before(s.EndTime) {
+		return fmt.Errorf("backfill start time %s must be before the end time %s",
+			s.StartTime.UTC().Format(time.RFC3339Nano), s.EndTime.UTC().Format(time.RFC3339Nano))
+	}
+	return nil
+}
+
 // ShowMeasurementCardinalityStatement represents a command for listing measurement cardinality.
 type ShowMeasurementCardinalityStatement struct {
 	Exact         bool // If false then cardinality estimation will be used.
@@ -3777,7 +4034,7 @@ func (s *ShowMeasurementsStatement) String() string {
 
 // RequiredPrivileges returns the privilege(s) required to execute a ShowMeasurementsStatement.
 func (s *ShowMeasurementsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
-	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege, AnyMeasurement: true}}, nil
 }
 
 // DefaultDatabase returns the default database from the statement.
@@ -3864,6 +4121,19 @@ func (s *ShowQueriesStatement) RequiredPrivileges() (ExecutionPrivileges, error)
 	return ExecutionPrivileges{{Admin: false, Name: "", Rwuser: true, Privilege: ReadPrivilege}}, nil
 }
 
+// ShowResourceGroupsStatement represents a command for listing the resource groups and their usage.
+type ShowResourceGroupsStatement struct{}
+
+// String returns a string representation of the show resource groups statement.
+func (s *ShowResourceGroupsStatement) String() string {
+	return "SHOW RESOURCE GROUPS"
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowResourceGroupsStatement.
+func (s *ShowResourceGroupsStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: false, Privilege: AllPrivileges}}, nil
+}
+
 // ShowRetentionPoliciesStatement represents a command for listing retention policies.
 type ShowRetentionPoliciesStatement struct {
 	// Name of the database to list policies for.
@@ -4137,7 +4407,7 @@ func (s *ShowTagKeysStatement) String() string {
 
 // RequiredPrivileges returns the privilege(s) required to execute a ShowTagKeysStatement.
 func (s *ShowTagKeysStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
-	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+	return showSourcesPrivileges(s.Database, s.Sources), nil
 }
 
 // DefaultDatabase returns the default database from the statement.
@@ -4274,7 +4544,7 @@ func (s *ShowTagValuesStatement) String() string {
 
 // RequiredPrivileges returns the privilege(s) required to execute a ShowTagValuesStatement.
 func (s *ShowTagValuesStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
-	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+	return showSourcesPrivileges(s.Database, s.Sources), nil
 }
 
 // DefaultDatabase returns the default database from the statement.
@@ -4468,7 +4738,7 @@ func (s *ShowFieldKeysStatement) String() string {
 
 // RequiredPrivileges returns the privilege(s) required to execute a ShowFieldKeysStatement.
 func (s *ShowFieldKeysStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
-	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+	return showSourcesPrivileges(s.Database, s.Sources), nil
 }
 
 // DefaultDatabase returns the default database from the statement.
@@ -4882,14 +5152,255 @@ func (s *SubQuery) GetName() string {
 	return s.Alias
 }
 
+type JoinType int
+
+const (
+	FullJoin JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+	RightOuterJoin
+	// AsofJoin matches each row of the left source to the latest row at or before its time in the right source
+	AsofJoin
+	// LeftAsofJoin is the AsofJoin which also returns the left rows without any matched right row
+	LeftAsofJoin
+)
+
+func (t JoinType) String() string {
+	switch t {
+	case FullJoin:
+		return "full join"
+	case InnerJoin:
+		return "inner join"
+	case LeftOuterJoin:
+		return "left join"
+	case RightOuterJoin:
+		return "right join"
+	case AsofJoin:
+		return "asof join"
+	case LeftAsofJoin:
+		return "left asof join"
+	default:
+		return "unknown join"
+	}
+}
+
+// IsAsof returns true if the rows are matched to the latest row at or before their time rather than the row at the same time.
+func (t JoinType) IsAsof() bool {
+	return t == AsofJoin || t == LeftAsofJoin
+}
+
+// PreserveLeft returns true if the left rows without any matched right row are returned.
+func (t JoinType) PreserveLeft() bool {
+	return t == FullJoin || t == LeftOuterJoin || t == LeftAsofJoin
+}
+
+// PreserveRight returns true if the right rows without any matched left row are returned.
+func (t JoinType) PreserveRight() bool {
+	return t == FullJoin || t == RightOuterJoin
+}
+
+// Union represents the UNION of the SELECT statements.
+type Union struct {
+	// Distinct is true if the duplicate rows are removed, otherwise it is UNION ALL.
+	Distinct   bool
+	Statements []*SelectStatement
+}
+
+func (u *Union) String() string {
+	sep := " UNION ALL "
+	if u.Distinct {
+		sep = " UNION "
+	}
+	stmts := make([]string, len(u.Statements))
+	for i, stmt := range u.Statements {
+		stmts[i] = stmt.String()
+	}
+	return strings.Join(stmts, sep)
+}
+
+func (u *Union) Clone() *Union {
+	if u == nil {
+		return nil
+	}
+	clone := &Union{Distinct: u.Distinct, Statements: make([]*SelectStatement, len(u.Statements))}
+	for i, stmt := range u.Statements {
+		clone.Statements[i] = stmt.Clone()
+	}
+	return clone
+}
+
+// NewUnionStatement returns the statement selecting from the subqueries of the UNION. The columns of
+// the statements are aligned by their names, and the missing columns of a statement are filled with null.
+// The ORDER BY, LIMIT and OFFSET following the last statement apply to the whole UNION.
+func NewUnionStatement(u *Union) (*SelectStatement, error) {
+	stmt := &SelectStatement{
+		IsRawQuery: true,
+		Dedupe:     u.Distinct,
+		Union:      u.Clone(),
+	}
+
+	var names []string
+	seen := make(map[string]struct{})
+	wildcard := false
+	for i, branch := range u.Statements {
+		if branch.Target != nil {
+			return nil, errors.New("SELECT INTO is not supported in UNION")
+		}
+		if i < len(u.Statements)-1 && branch.hasOrderOrLimit() {
+			return nil, errors.New("ORDER BY, LIMIT and OFFSET are only allowed after the last statement of UNION")
+		}
+		if len(branch.Sources) == 0 {
+			return nil, errors.New("SELECT without FROM is not supported in UNION")
+		}
+		for _, f := range branch.Fields {
+			switch f.Expr.(type) {
+			case *Wildcard, *RegexLiteral:
+				wildcard = true
+			}
+		}
+		offset := 0
+		if !branch.OmitTime {
+			offset = 1
+		}
+		for _, name := range branch.ColumnNames()[offset:] {
+			if name == "time" {
+				continue
+			}
+			if _, ok := seen[name]; !ok {
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	if wildcard {
+		stmt.Fields = Fields{{Expr: &Wildcard{}}}
+	} else {
+		for _, name := range names {
+			stmt.Fields = append(stmt.Fields, &Field{Expr: &VarRef{Val: name}})
+		}
+	}
+
+	for _, branch := range u.Statements {
+		stmt.Sources = append(stmt.Sources, &SubQuery{Statement: branch.Clone()})
+	}
+	last := stmt.Sources[len(stmt.Sources)-1].(*SubQuery).Statement
+	stmt.SortFields, last.SortFields = last.SortFields, nil
+	stmt.Limit, last.Limit = last.Limit, 0
+	stmt.Offset, last.Offset = last.Offset, 0
+	stmt.SLimit, last.SLimit = last.SLimit, 0
+	stmt.SOffset, last.SOffset = last.SOffset, 0
+	stmt.Dimensions = unionDimensions(u.Statements)
+	return stmt, nil
+}
+
+func (s *SelectStatement) hasOrderOrLimit() bool {
+	return len(s.SortFields) > 0 || s.Limit > 0 || s.Offset > 0 || s.SLimit > 0 || s.SOffset > 0
+}
+
+// MergeUnionSources merges the subqueries of the UNION which only differ in the measurements, so the
+// measurements are read together by the store. The measurements are merged only if each selected column
+// has the same type in all of them.
+func MergeUnionSources(sources Sources, m TypeMapper) Sources {
+	var merged Sources
+	keys := make(map[string][]int)
+	for _, src := range sources {
+		subquery, ok := src.(*SubQuery)
+		if !ok || !onlyMeasurements(subquery.Statement.Sources) || subquery.Statement.HasWildcard() {
+			merged = append(merged, src)
+			continue
+		}
+		stmt := subquery.Statement.Clone()
+		stmt.Sources = nil
+		key := stmt.String()
+		if mergeSources(merged, keys[key], subquery.Statement.Sources, m) {
+			continue
+		}
+		stmt.Sources = subquery.Statement.Sources
+		keys[key] = append(keys[key], len(merged))
+		merged = append(merged, &SubQuery{Statement: stmt, Alias: subquery.Alias})
+	}
+	return merged
+}
+
+// mergeSources adds the measurements to the first of the candidate subqueries which they can be merged into.
+func mergeSources(merged Sources, candidates []int, src Sources, m TypeMapper) bool {
+	for _, i := range candidates {
+		dst := merged[i].(*SubQuery).Statement
+		if canMergeSources(dst, src, m) {
+			dst.Sources = append(dst.Sources, src...)
+			return true
+		}
+	}
+	return false
+}
+
+func canMergeSources(dst *SelectStatement, src Sources, m TypeMapper) bool {
+	// the rows of the same measurement selected by more than one statement are all returned
+	for _, s := range src {
+		for _, d := range dst.Sources {
+			if s.String() == d.String() {
+				return false
+			}
+		}
+	}
+	var refs []VarRef
+	for _, f := range dst.Fields {
+		refs = append(refs, ExprNames(f.Expr)...)
+	}
+	for _, ref := range refs {
+		typ := m.MapType(dst.Sources[0].(*Measurement), ref.Val)
+		for _, source := range append(dst.Sources[1:len(dst.Sources):len(dst.Sources)], src...) {
+			if t := m.MapType(source.(*Measurement), ref.Val); t == Unknown || t != typ {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func onlyMeasurements(sources Sources) bool {
+	for _, src := range sources {
+		if _, ok := src.(*Measurement); !ok {
+			return false
+		}
+	}
+	return len(sources) > 0
+}
+
+// unionDimensions returns the tags of GROUP BY if all the statements are grouped by the same tags.
+func unionDimensions(stmts []*SelectStatement) Dimensions {
+	var dims Dimensions
+	for i, stmt := range stmts {
+		var tags Dimensions
+		for _, d := range stmt.Dimensions {
+			if ref, ok := d.Expr.(*VarRef); ok {
+				tags = append(tags, &Dimension{Expr: &VarRef{Val: ref.Val}})
+			}
+		}
+		if i == 0 {
+			dims = tags
+		} else if tags.String() != dims.String() {
+			return nil
+		}
+	}
+	return dims
+}
+
 type Join struct {
 	LSrc      Source
 	RSrc      Source
 	Condition Expr
+	JoinType  JoinType
+	// Tolerance is the max time distance between the matched rows of the AsofJoin, 0 means unlimited.
+	Tolerance time.Duration
 }
 
 func (j *Join) String() string {
-	return fmt.Sprintf("%s full join %s on %s", "1", "2", j.Condition.String())
+	str := fmt.Sprintf("%s %s %s on %s", "1", j.JoinType, "2", j.Condition.String())
+	if j.Tolerance > 0 {
+		str += " tolerance " + FormatDuration(j.Tolerance)
+	}
+	return str
 }
 
 func (j *Join) GetName() string {
@@ -5059,6 +5570,9 @@ func (a VarRefs) Strings() []string {
 type Call struct {
 	Name string
 	Args []Expr
+
+	// Over is the window of a window function, such as lag(value) OVER (PARTITION BY host ORDER BY time)
+	Over *Window
 }
 
 func (c *Call) RewriteNameSpace(alias, mst string) {
@@ -5076,6 +5590,9 @@ func (c *Call) String() string {
 	}
 
 	// Write function name and args.
+	if c.Over != nil {
+		return fmt.Sprintf("%s(%s) OVER (%s)", c.Name, strings.Join(str, ", "), c.Over.String())
+	}
 	return fmt.Sprintf("%s(%s)", c.Name, strings.Join(str, ", "))
 }
 
@@ -5095,10 +5612,147 @@ func (c *Call) WriteString(b *bytes.Buffer) {
 		b.WriteString(arg.String())
 	}
 	b.WriteString(")")
+	if c.Over != nil {
+		b.WriteString(" OVER (")
+		b.WriteString(c.Over.String())
+		b.WriteString(")")
+	}
 	// Write function name and args.
 	return
 }
 
+// WindowFrameBoundType is the type of the boundary of a window frame.
+type WindowFrameBoundType int
+
+const (
+	UnboundedPreceding WindowFrameBoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+// WindowFrameBound is the boundary of a window frame. Offset is the number of rows for the ROWS frame,
+// and the nanoseconds of the time for the RANGE frame.
+type WindowFrameBound struct {
+	Type   WindowFrameBoundType
+	Offset int64
+}
+
+func (b WindowFrameBound) format(isRange bool) string {
+	switch b.Type {
+	case UnboundedPreceding:
+		return "UNBOUNDED PRECEDING"
+	case UnboundedFollowing:
+		return "UNBOUNDED FOLLOWING"
+	case CurrentRow:
+		return "CURRENT ROW"
+	}
+	offset := strconv.FormatInt(b.Offset, 10)
+	if isRange {
+		offset = FormatDuration(time.Duration(b.Offset))
+	}
+	if b.Type == Preceding {
+		return offset + " PRECEDING"
+	}
+	return offset + " FOLLOWING"
+}
+
+// WindowFrame is the set of rows of a partition a window function is evaluated on.
+type WindowFrame struct {
+	// Range is true for the RANGE frame, whose boundaries are the offsets of the time,
+	// otherwise the boundaries are the offsets of the rows.
+	Range bool
+	Start WindowFrameBound
+	End   WindowFrameBound
+}
+
+func (f *WindowFrame) String() string {
+	var buf bytes.Buffer
+	if f.Range {
+		_, _ = buf.WriteString("RANGE BETWEEN ")
+	} else {
+		_, _ = buf.WriteString("ROWS BETWEEN ")
+	}
+	_, _ = buf.WriteString(f.Start.format(f.Range))
+	_, _ = buf.WriteString(" AND ")
+	_, _ = buf.WriteString(f.End.format(f.Range))
+	return buf.String()
+}
+
+// Validate returns an error if the frame starts after the end.
+func (f *WindowFrame) Validate() error {
+	if f.Start.Type == UnboundedFollowing {
+		return errors.New("window frame cannot start with UNBOUNDED FOLLOWING")
+	}
+	if f.End.Type == UnboundedPreceding {
+		return errors.New("window frame cannot end with UNBOUNDED PRECEDING")
+	}
+	if f.Start.Type > f.End.Type {
+		return errors.New("window frame cannot start after the end")
+	}
+	if f.Start.Offset < 0 || f.End.Offset < 0 {
+		return errors.New("window frame offset must not be negative")
+	}
+	return nil
+}
+
+// Window is the OVER clause of a window function.
+type Window struct {
+	// Partition is the tag keys partitioning the rows.
+	Partition []string
+
+	// OrderBy is true if the rows are ordered by the time.
+	OrderBy   bool
+	Ascending bool
+
+	// Frame is nil if the frame is not specified.
+	Frame *WindowFrame
+}
+
+func (w *Window) String() string {
+	var buf bytes.Buffer
+	if len(w.Partition) > 0 {
+		_, _ = buf.WriteString("PARTITION BY ")
+		for i, key := range w.Partition {
+			if i > 0 {
+				_, _ = buf.WriteString(", ")
+			}
+			_, _ = buf.WriteString(QuoteIdent(key))
+		}
+	}
+	if w.OrderBy {
+		if buf.Len() > 0 {
+			_ = buf.WriteByte(' ')
+		}
+		_, _ = buf.WriteString("ORDER BY time")
+		if !w.Ascending {
+			_, _ = buf.WriteString(" DESC")
+		}
+	}
+	if w.Frame != nil {
+		if buf.Len() > 0 {
+			_ = buf.WriteByte(' ')
+		}
+		_, _ = buf.WriteString(w.Frame.String())
+	}
+	return buf.String()
+}
+
+// Clone returns a deep copy of the window.
+func (w *Window) Clone() *Window {
+	if w == nil {
+		return nil
+	}
+	clone := *w
+	clone.Partition = append([]string(nil), w.Partition...)
+	if w.Frame != nil {
+		frame := *w.Frame
+		clone.Frame = &frame
+	}
+	return &clone
+}
+
 // Distinct represents a DISTINCT expression.
 type Distinct struct {
 	// Identifier following DISTINCT
@@ -5427,7 +6081,7 @@ func CloneExpr(expr Expr) Expr {
 		for i, arg := range expr.Args {
 			args[i] = CloneExpr(arg)
 		}
-		return &Call{Name: expr.Name, Args: args}
+		return &Call{Name: expr.Name, Args: args, Over: expr.Over.Clone()}
 	case *Distinct:
 		return &Distinct{Val: expr.Val}
 	case *DurationLiteral:
@@ -7240,7 +7894,7 @@ func reduceCall(expr *Call, valuer Valuer) Expr {
 
 	// Evaluate a function call if the valuer is a CallValuer and
 	// the arguments are only literals.
-	if literalsOnly {
+	if literalsOnly && expr.Over == nil {
 		if valuer, ok := valuer.(CallValuer); ok {
 			argVals := make([]interface{}, len(args))
 			for i := range args {
@@ -7251,7 +7905,7 @@ func reduceCall(expr *Call, valuer Valuer) Expr {
 			}
 		}
 	}
-	return &Call{Name: expr.Name, Args: args}
+	return &Call{Name: expr.Name, Args: args, Over: expr.Over}
 }
 
 func reduceParenExpr(expr *ParenExpr, valuer Valuer) Expr {
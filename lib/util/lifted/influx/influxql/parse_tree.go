@@ -182,6 +182,12 @@ func init() {
 		show.Handle(USERS, func(p *Parser) (Statement, error) {
 			return p.parseShowUsersStatement()
 		})
+		show.Handle(ROLES, func(p *Parser) (Statement, error) {
+			return &ShowRolesStatement{}, nil
+		})
+		show.Group(RESOURCE).Handle(GROUPS, func(p *Parser) (Statement, error) {
+			return &ShowResourceGroupsStatement{}, nil
+		})
 	})
 	Language.Group(CREATE).With(func(create *ParseTree) {
 		create.Group(CONTINUOUS).Handle(QUERY, func(p *Parser) (Statement, error) {
@@ -193,6 +199,13 @@ func init() {
 		create.Handle(USER, func(p *Parser) (Statement, error) {
 			return p.parseCreateUserStatement()
 		})
+		create.Handle(ROLE, func(p *Parser) (Statement, error) {
+			name, err := p.ParseIdent()
+			if err != nil {
+				return nil, err
+			}
+			return &CreateRoleStatement{Name: name}, nil
+		})
 		create.Group(RETENTION).Handle(POLICY, func(p *Parser) (Statement, error) {
 			return p.parseCreateRetentionPolicyStatement()
 		})
@@ -228,6 +241,16 @@ func init() {
 		drop.Handle(USER, func(p *Parser) (Statement, error) {
 			return p.parseDropUserStatement()
 		})
+		drop.Handle(ROLE, func(p *Parser) (Statement, error) {
+			name, err := p.ParseIdent()
+			if err != nil {
+				return nil, err
+			}
+			return &DropRoleStatement{Name: name}, nil
+		})
+	})
+	Language.Group(BACKFILL, CONTINUOUS).Handle(QUERY, func(p *Parser) (Statement, error) {
+		return p.parseBackfillContinuousQueryStatement()
 	})
 	Language.Handle(EXPLAIN, func(p *Parser) (Statement, error) {
 		return p.parseExplainStatement()
@@ -238,6 +261,9 @@ func init() {
 	Language.Handle(REVOKE, func(p *Parser) (Statement, error) {
 		return p.parseRevokeStatement()
 	})
+	Language.Handle(DENY, func(p *Parser) (Statement, error) {
+		return p.parseDenyStatement()
+	})
 	Language.Group(ALTER).With(func(alter *ParseTree) {
 		alter.Group(RETENTION).Handle(POLICY, func(p *Parser) (Statement, error) {
 			return p.parseAlterRetentionPolicyStatement()
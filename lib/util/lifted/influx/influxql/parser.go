@@ -636,6 +636,15 @@ func (p *Parser) parseStringList() ([]string, error) {
 // parseRevokeStatement parses a string and returns a revoke statement.
 // This function assumes the REVOKE token has already been consumed.
 func (p *Parser) parseRevokeStatement() (Statement, error) {
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == ROLE {
+		role, user, err := p.parseRoleAssignment(FROM)
+		if err != nil {
+			return nil, err
+		}
+		return &RevokeRoleStatement{Role: role, User: user}, nil
+	}
+	p.Unscan()
+
 	// Parse the privilege to be revoked.
 	priv, err := p.parsePrivilege()
 	if err != nil {
@@ -671,12 +680,12 @@ func (p *Parser) parseRevokeStatement() (Statement, error) {
 func (p *Parser) parseRevokeOnStatement() (*RevokeStatement, error) {
 	stmt := &RevokeStatement{}
 
-	// Parse the name of the database.
-	lit, err := p.ParseIdent()
+	// Parse the name of the database and the optional measurement.
+	var err error
+	stmt.On, stmt.Measurement, err = p.parsePrivilegeTarget()
 	if err != nil {
 		return nil, err
 	}
-	stmt.On = lit
 
 	// Parse FROM clause.
 	tok, pos, lit := p.ScanIgnoreWhitespace()
@@ -715,6 +724,15 @@ func (p *Parser) parseRevokeAdminStatement() (*RevokeAdminStatement, error) {
 // parseGrantStatement parses a string and returns a grant statement.
 // This function assumes the GRANT token has already been consumed.
 func (p *Parser) parseGrantStatement() (Statement, error) {
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == ROLE {
+		role, user, err := p.parseRoleAssignment(TO)
+		if err != nil {
+			return nil, err
+		}
+		return &GrantRoleStatement{Role: role, User: user}, nil
+	}
+	p.Unscan()
+
 	// Parse the privilege to be granted.
 	priv, err := p.parsePrivilege()
 	if err != nil {
@@ -750,12 +768,12 @@ func (p *Parser) parseGrantStatement() (Statement, error) {
 func (p *Parser) parseGrantOnStatement() (*GrantStatement, error) {
 	stmt := &GrantStatement{}
 
-	// Parse the name of the database.
-	lit, err := p.ParseIdent()
+	// Parse the name of the database and the optional measurement.
+	var err error
+	stmt.On, stmt.Measurement, err = p.parsePrivilegeTarget()
 	if err != nil {
 		return nil, err
 	}
-	stmt.On = lit
 
 	// Parse TO clause.
 	tok, pos, lit := p.ScanIgnoreWhitespace()
@@ -775,6 +793,74 @@ func (p *Parser) parseGrantOnStatement() (*GrantStatement, error) {
 	return stmt, nil
 }
 
+// parseDenyStatement parses a string and returns a deny statement.
+// This function assumes the DENY token has already been consumed.
+func (p *Parser) parseDenyStatement() (*DenyStatement, error) {
+	stmt := &DenyStatement{}
+
+	// Parse the privilege to be denied.
+	priv, err := p.parsePrivilege()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Privilege = priv
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != ON {
+		return nil, newParseError(tokstr(tok, lit), []string{"ON"}, pos)
+	}
+
+	// Parse the name of the database and the optional measurement.
+	stmt.On, stmt.Measurement, err = p.parsePrivilegeTarget()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != TO {
+		return nil, newParseError(tokstr(tok, lit), []string{"TO"}, pos)
+	}
+
+	// Parse the name of the user.
+	stmt.User, err = p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parsePrivilegeTarget parses the database and the optional measurement a privilege is granted on.
+func (p *Parser) parsePrivilegeTarget() (string, string, error) {
+	db, err := p.ParseIdent()
+	if err != nil {
+		return "", "", err
+	}
+	if tok, _, _ := p.Scan(); tok != DOT {
+		p.Unscan()
+		return db, "", nil
+	}
+	mst, err := p.ParseIdent()
+	if err != nil {
+		return "", "", err
+	}
+	return db, mst, nil
+}
+
+// parseRoleAssignment parses the role and the user of a GRANT ROLE or REVOKE ROLE statement.
+// This function assumes the GRANT ROLE or REVOKE ROLE tokens have already been consumed.
+func (p *Parser) parseRoleAssignment(sep Token) (string, string, error) {
+	role, err := p.ParseIdent()
+	if err != nil {
+		return "", "", err
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != sep {
+		return "", "", newParseError(tokstr(tok, lit), []string{sep.String()}, pos)
+	}
+	user, err := p.ParseIdent()
+	if err != nil {
+		return "", "", err
+	}
+	return role, user, nil
+}
+
 // parseGrantAdminStatement parses a string and returns a grant admin statement.
 // This function assumes the ALL [PRVILEGES] TO tokens have already been consumed.
 func (p *Parser) parseGrantAdminStatement() (*GrantAdminStatement, error) {
@@ -2264,6 +2350,67 @@ func (p *Parser) parseDropContinuousQueryStatement() (*DropContinuousQueryStatem
 	return stmt, nil
 }
 
+// parseBackfillContinuousQueryStatement parses a string and returns a BackfillContinuousQueryStatement.
+// This function assumes the "BACKFILL CONTINUOUS QUERY" tokens have already been consumed.
+func (p *Parser) parseBackfillContinuousQueryStatement() (*BackfillContinuousQueryStatement, error) {
+	stmt := &BackfillContinuousQueryStatement{}
+
+	// Read the id of the query to backfill.
+	ident, err := p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name = ident
+
+	// Parse the optional "ON" clause, the default database is used if it is missing.
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok == ON {
+		if stmt.Database, err = p.ParseIdent(); err != nil {
+			return nil, err
+		}
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+	}
+
+	// Expect a "FROM" keyword followed by the start time.
+	if tok != FROM {
+		return nil, newParseError(tokstr(tok, lit), []string{"ON", "FROM"}, pos)
+	}
+	if stmt.StartTime, err = p.parseBackfillTime(); err != nil {
+		return nil, err
+	}
+
+	// Expect a "TO" keyword followed by the end time.
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != TO {
+		return nil, newParseError(tokstr(tok, lit), []string{"TO"}, pos)
+	}
+	if stmt.EndTime, err = p.parseBackfillTime(); err != nil {
+		return nil, err
+	}
+
+	if err := stmt.validate(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+func (p *Parser) parseBackfillTime() (time.Time, error) {
+	str, err := p.parseString()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return toBackfillTime(str)
+}
+
+// toBackfillTime parses the time of a BACKFILL CONTINUOUS QUERY statement,
+// which is either in RFC3339 format or in the format of the time literals.
+func toBackfillTime(str string) (time.Time, error) {
+	lit, err := (&StringLiteral{Val: str}).ToTimeLiteral(time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid backfill time %s", QuoteString(str))
+	}
+	return lit.Val, nil
+}
+
 // parseFields parses a list of one or more fields.
 func (p *Parser) parseFields() (Fields, error) {
 	var fields Fields
@@ -3046,7 +3193,7 @@ func (p *Parser) parseCall(name string) (*Call, error) {
 	} else {
 		// If there's a right paren then just return immediately.
 		if tok, _, _ := p.Scan(); tok == RPAREN {
-			return &Call{Name: name}, nil
+			return p.parseOver(&Call{Name: name})
 		}
 		p.Unscan()
 
@@ -3086,7 +3233,146 @@ func (p *Parser) parseCall(name string) (*Call, error) {
 		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
 	}
 
-	return &Call{Name: name, Args: args}, nil
+	return p.parseOver(&Call{Name: name, Args: args})
+}
+
+// parseOver parses the optional OVER clause of a window function.
+func (p *Parser) parseOver(call *Call) (*Call, error) {
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok != OVER {
+		p.Unscan()
+		return call, nil
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+	}
+
+	window := &Window{}
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok == PARTITION {
+		if err := p.parseTokens([]Token{BY}); err != nil {
+			return nil, err
+		}
+		keys, err := p.ParseIdentList()
+		if err != nil {
+			return nil, err
+		}
+		window.Partition = keys
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+	}
+	if tok == ORDER {
+		if err := p.parseTokens([]Token{BY}); err != nil {
+			return nil, err
+		}
+		if tok, pos, lit = p.ScanIgnoreWhitespace(); tok != IDENT || strings.ToLower(lit) != "time" {
+			return nil, &ParseError{Message: "only ORDER BY time is supported in window", Pos: pos}
+		}
+		window.OrderBy, window.Ascending = true, true
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+		if tok == ASC || tok == DESC {
+			window.Ascending = tok == ASC
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+		}
+	}
+	if tok == IDENT {
+		frame, err := p.parseWindowFrame(lit, pos)
+		if err != nil {
+			return nil, err
+		}
+		window.Frame = frame
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+	}
+	if tok != RPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+	}
+	call.Over = window
+	return call, nil
+}
+
+// parseWindowFrame parses the window frame. This function assumes ROWS or RANGE has already been consumed.
+func (p *Parser) parseWindowFrame(unit string, unitPos Pos) (*WindowFrame, error) {
+	frame := &WindowFrame{}
+	switch strings.ToLower(unit) {
+	case "rows":
+	case "range":
+		frame.Range = true
+	default:
+		return nil, newParseError(unit, []string{"ROWS", "RANGE"}, unitPos)
+	}
+
+	var err error
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok == IDENT && strings.ToLower(lit) == "between" {
+		if frame.Start, err = p.parseWindowFrameBound(frame.Range); err != nil {
+			return nil, err
+		}
+		if err = p.parseTokens([]Token{AND}); err != nil {
+			return nil, err
+		}
+		if frame.End, err = p.parseWindowFrameBound(frame.Range); err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+		if frame.Start, err = p.parseWindowFrameBound(frame.Range); err != nil {
+			return nil, err
+		}
+		frame.End = WindowFrameBound{Type: CurrentRow}
+	}
+	if err = frame.Validate(); err != nil {
+		return nil, &ParseError{Message: err.Error(), Pos: pos}
+	}
+	return frame, nil
+}
+
+func (p *Parser) parseWindowFrameBound(isRange bool) (WindowFrameBound, error) {
+	var bound WindowFrameBound
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	switch tok {
+	case IDENT:
+		_, _, lit2 := p.ScanIgnoreWhitespace()
+		switch strings.ToLower(lit + " " + lit2) {
+		case "unbounded preceding":
+			bound.Type = UnboundedPreceding
+		case "unbounded following":
+			bound.Type = UnboundedFollowing
+		case "current row":
+			bound.Type = CurrentRow
+		default:
+			return bound, &ParseError{Message: "invalid window frame bound: " + lit + " " + lit2, Pos: pos}
+		}
+		return bound, nil
+	case INTEGER:
+		if isRange {
+			return bound, &ParseError{Message: "RANGE window frame offset must be a duration", Pos: pos}
+		}
+		n, err := strconv.ParseInt(lit, 10, 64)
+		if err != nil {
+			return bound, &ParseError{Message: err.Error(), Pos: pos}
+		}
+		bound.Offset = n
+	case DURATIONVAL:
+		if !isRange {
+			return bound, &ParseError{Message: "ROWS window frame offset must be an integer", Pos: pos}
+		}
+		d, err := ParseDuration(lit)
+		if err != nil {
+			return bound, &ParseError{Message: err.Error(), Pos: pos}
+		}
+		bound.Offset = int64(d)
+	default:
+		return bound, newParseError(tokstr(tok, lit), []string{"UNBOUNDED", "CURRENT", "offset"}, pos)
+	}
+
+	tok, pos, lit = p.ScanIgnoreWhitespace()
+	switch strings.ToLower(lit) {
+	case "preceding":
+		bound.Type = Preceding
+	case "following":
+		bound.Type = Following
+	default:
+		return bound, newParseError(tokstr(tok, lit), []string{"PRECEDING", "FOLLOWING"}, pos)
+	}
+	return bound, nil
 }
 
 // parseResample parses a RESAMPLE [EVERY <duration>] [FOR <duration>].
@@ -59,11 +59,67 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 	}
 }
 
+func newBackfillContinuousQueryStatement(yylex yyLexer, name, db, start, end string) *BackfillContinuousQueryStatement {
+	stmt := &BackfillContinuousQueryStatement{Name: name, Database: db}
+	var err error
+	if stmt.StartTime, err = toBackfillTime(start); err != nil {
+		yylex.Error(err.Error())
+		return stmt
+	}
+	if stmt.EndTime, err = toBackfillTime(end); err != nil {
+		yylex.Error(err.Error())
+		return stmt
+	}
+	if err = stmt.validate(); err != nil {
+		yylex.Error(err.Error())
+	}
+	return stmt
+}
+
+type windowFrameBound struct {
+	WindowFrameBound
+	isDuration bool
+}
+
+func newWindowFrameOffset(yylex yyLexer, offset int64, typ string, isDuration bool) *windowFrameBound {
+	b := &windowFrameBound{WindowFrameBound: WindowFrameBound{Offset: offset}, isDuration: isDuration}
+	switch strings.ToLower(typ) {
+	case "preceding":
+		b.Type = Preceding
+	case "following":
+		b.Type = Following
+	default:
+		yylex.Error("expect PRECEDING or FOLLOWING for window frame offset")
+	}
+	return b
+}
+
+func newWindowFrame(yylex yyLexer, unit string, start, end *windowFrameBound) *WindowFrame {
+	frame := &WindowFrame{Start: start.WindowFrameBound, End: end.WindowFrameBound}
+	switch strings.ToLower(unit) {
+	case "rows":
+		if start.isDuration || end.isDuration {
+			yylex.Error("ROWS window frame offset must be an integer")
+		}
+	case "range":
+		frame.Range = true
+		if (start.Offset != 0 && !start.isDuration) || (end.Offset != 0 && !end.isDuration) {
+			yylex.Error("RANGE window frame offset must be a duration")
+		}
+	default:
+		yylex.Error("expect ROWS or RANGE for window frame")
+	}
+	if err := frame.Validate(); err != nil {
+		yylex.Error(err.Error())
+	}
+	return frame
+}
 
 %}
 
 %union{
     stmt                Statement
+    union               *Union
     stmts               Statements
     str                 string
     query               Query
@@ -101,6 +157,9 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
     indexOption         *IndexOption
     databasePolicy      DatabasePolicy
     cmOption            *CreateMeasurementStatementOption
+    window              *Window
+    windowFrame         *WindowFrame
+    windowFrameBound    *windowFrameBound
 }
 
 %token <str>    FROM MEASUREMENT INTO ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
@@ -115,6 +174,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                 TOKEN TOKENIZERS MATCH LIKE MATCHPHRASE CONFIG CONFIGS CLUSTER
                 REPLICAS DETAIL DESTINATIONS
                 SCHEMA INDEXES AUTO EXCEPT
+                ROLE ROLES DENY RESOURCE BACKFILL
+                INNER LEFT RIGHT ASOF TOLERANCE OVER UNION
 %token <bool>   DESC ASC
 %token <str>    COMMA SEMICOLON LPAREN RPAREN REGEX
 %token <int>    EQ NEQ LT LTE GT GTE DOT DOUBLECOLON NEQREGEX EQREGEX
@@ -147,6 +208,9 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                                     CREATE_STREAM_STATEMENT SHOW_STREAM_STATEMENT DROP_STREAM_STATEMENT COLUMN_LISTS SHOW_MEASUREMENT_KEYS_STATEMENT
                                     SHOW_QUERIES_STATEMENT KILL_QUERY_STATEMENT SHOW_CONFIGS_STATEMENT SET_CONFIG_STATEMENT SHOW_CLUSTER_STATEMENT
                                     CREATE_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT
+                                    DENY_STATEMENT CREATE_ROLE_STATEMENT DROP_ROLE_STATEMENT SHOW_ROLES_STATEMENT
+                                    GRANT_ROLE_STATEMENT REVOKE_ROLE_STATEMENT SHOW_RESOURCE_GROUPS_STATEMENT
+                                    BACKFILL_CONTINUOUS_QUERY_STATEMENT
 %type <fields>                      COLUMN_CLAUSES IDENTS
 %type <field>                       COLUMN_CLAUSE
 %type <stmts>                       ALL_QUERIES ALL_QUERY
@@ -155,7 +219,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <ment>                        TABLE_OPTION  TABLE_NAME_WITH_OPTION TABLE_CASE MEASUREMENT_WITH
 %type <expr>                        WHERE_CLAUSE OR_CONDITION AND_CONDITION CONDITION OPERATION_EQUAL COLUMN_VAREF COLUMN CONDITION_COLUMN TAG_KEYS
                                     CASE_WHEN_CASE CASE_WHEN_CASES
-%type <int>                         CONDITION_OPERATOR
+%type <int>                         CONDITION_OPERATOR PRIVILEGE JOIN_TYPE
 %type <dataType>                    COLUMN_VAREF_TYPE
 %type <sortfs>                      SORTFIELDS ORDER_CLAUSES
 %type <sortf>                       SORTFIELD
@@ -176,6 +240,12 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <bool>                        ALLOW_TAG_ARRAY
 %type <fieldOption>                 FIELD_OPTION FIELD_COLUMN
 %type <fieldOptions>                FIELD_OPTIONS
+%type <window>                      WINDOW_CLAUSE
+%type <union>                       UNION_CLAUSE
+%type <bool>                        UNION_TYPE
+%type <windowFrame>                 WINDOW_FRAME
+%type <windowFrameBound>            WINDOW_FRAME_BOUND
+%type <strSlice>                    WINDOW_PARTITION
 
 %type <databasePolicy>              DATABASE_POLICY
 %type <cmOption>                    CMOPTIONS_TS CMOPTIONS_CS
@@ -214,6 +284,14 @@ STATEMENT:
     {
         $$ = $1
     }
+    |UNION_CLAUSE
+    {
+        stmt, err := NewUnionStatement($1)
+        if err != nil {
+            yylex.Error(err.Error())
+        }
+        $$ = stmt
+    }
     |SHOW_DATABASES_STATEMENT
     {
         $$ = $1
@@ -286,6 +364,30 @@ STATEMENT:
     {
     	$$ = $1
     }
+    |DENY_STATEMENT
+    {
+    	$$ = $1
+    }
+    |CREATE_ROLE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |DROP_ROLE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_ROLES_STATEMENT
+    {
+    	$$ = $1
+    }
+    |GRANT_ROLE_STATEMENT
+    {
+    	$$ = $1
+    }
+    |REVOKE_ROLE_STATEMENT
+    {
+    	$$ = $1
+    }
     |DROP_USER_STATEMENT
     {
     	$$ = $1
@@ -402,6 +504,14 @@ STATEMENT:
     {
     	$$ = $1
     }
+    |SHOW_RESOURCE_GROUPS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |BACKFILL_CONTINUOUS_QUERY_STATEMENT
+    {
+    	$$ = $1
+    }
     |KILL_QUERY_STATEMENT
     {
     	$$ = $1
@@ -686,6 +796,18 @@ COLUMN:
         cols := &Call{Name: strings.ToLower($1)}
         $$ = cols
     }
+    |IDENT LPAREN COLUMN_CLAUSES RPAREN OVER LPAREN WINDOW_CLAUSE RPAREN
+    {
+        cols := &Call{Name: strings.ToLower($1), Args: []Expr{}, Over: $7}
+        for i := range $3 {
+            cols.Args = append(cols.Args, $3[i].Expr)
+        }
+        $$ = cols
+    }
+    |IDENT LPAREN RPAREN OVER LPAREN WINDOW_CLAUSE RPAREN
+    {
+        $$ = &Call{Name: strings.ToLower($1), Over: $6}
+    }
     |SUB COLUMN %prec UMINUS
     {
         switch s := $2.(type) {
@@ -719,6 +841,84 @@ COLUMN:
     	$$ = &VarRef{}
     }
 
+WINDOW_CLAUSE:
+    WINDOW_PARTITION WINDOW_FRAME
+    {
+        $$ = &Window{Partition: $1, Frame: $2}
+    }
+    |WINDOW_PARTITION ORDER BY IDENT WINDOW_FRAME
+    {
+        if strings.ToLower($4) != "time" {
+            yylex.Error("only ORDER BY time is supported in window")
+        }
+        $$ = &Window{Partition: $1, OrderBy: true, Ascending: true, Frame: $5}
+    }
+    |WINDOW_PARTITION ORDER BY IDENT ASC WINDOW_FRAME
+    {
+        if strings.ToLower($4) != "time" {
+            yylex.Error("only ORDER BY time is supported in window")
+        }
+        $$ = &Window{Partition: $1, OrderBy: true, Ascending: true, Frame: $6}
+    }
+    |WINDOW_PARTITION ORDER BY IDENT DESC WINDOW_FRAME
+    {
+        if strings.ToLower($4) != "time" {
+            yylex.Error("only ORDER BY time is supported in window")
+        }
+        $$ = &Window{Partition: $1, OrderBy: true, Ascending: false, Frame: $6}
+    }
+
+WINDOW_PARTITION:
+    PARTITION BY INDEX_LIST
+    {
+        $$ = $3
+    }
+    |
+    {
+        $$ = nil
+    }
+
+WINDOW_FRAME:
+    IDENT WINDOW_FRAME_BOUND
+    {
+        $$ = newWindowFrame(yylex, $1, $2, &windowFrameBound{WindowFrameBound: WindowFrameBound{Type: CurrentRow}})
+    }
+    |IDENT IDENT WINDOW_FRAME_BOUND AND WINDOW_FRAME_BOUND
+    {
+        if strings.ToLower($2) != "between" {
+            yylex.Error("expect BETWEEN for window frame")
+        }
+        $$ = newWindowFrame(yylex, $1, $3, $5)
+    }
+    |
+    {
+        $$ = nil
+    }
+
+WINDOW_FRAME_BOUND:
+    IDENT IDENT
+    {
+        $$ = &windowFrameBound{}
+        switch strings.ToLower($1 + " " + $2) {
+        case "unbounded preceding":
+            $$.Type = UnboundedPreceding
+        case "unbounded following":
+            $$.Type = UnboundedFollowing
+        case "current row":
+            $$.Type = CurrentRow
+        default:
+            yylex.Error("invalid window frame bound: " + $1 + " " + $2)
+        }
+    }
+    |INTEGER IDENT
+    {
+        $$ = newWindowFrameOffset(yylex, $1, $2, false)
+    }
+    |DURATIONVAL IDENT
+    {
+        $$ = newWindowFrameOffset(yylex, int64($1), $2, true)
+    }
+
 INTO_CLAUSE:
     INTO TABLE_NAMES
     {
@@ -729,6 +929,33 @@ INTO_CLAUSE:
     	$$ = nil
     }
 
+UNION_CLAUSE:
+    SELECT_STATEMENT UNION_TYPE SELECT_STATEMENT
+    {
+        $$ = &Union{
+            Distinct: $2,
+            Statements: []*SelectStatement{$1.(*SelectStatement), $3.(*SelectStatement)},
+        }
+    }
+    |UNION_CLAUSE UNION_TYPE SELECT_STATEMENT
+    {
+        if $1.Distinct != $2 {
+            yylex.Error("UNION and UNION ALL cannot be used together")
+        }
+        $1.Statements = append($1.Statements, $3.(*SelectStatement))
+        $$ = $1
+    }
+
+UNION_TYPE:
+    UNION ALL
+    {
+        $$ = false
+    }
+    |UNION
+    {
+        $$ = true
+    }
+
 FROM_CLAUSE:
     FROM TABLE_NAMES
     {
@@ -769,18 +996,76 @@ TABLE_NAMES:
     }
 
 JOIN_CLAUSE:
-    SUBQUERY_CLAUSE FULL JOIN TABLE_NAMES ON CONDITION
+    SUBQUERY_CLAUSE JOIN_TYPE TABLE_NAMES ON CONDITION
+    {
+        join := &Join{}
+        if len($1) != 1 || len($3) != 1{
+            yylex.Error("only support one query for join")
+        }
+        join.LSrc = $1[0]
+        join.RSrc = $3[0]
+        join.Condition = $5
+        join.JoinType = JoinType($2)
+        $$ = join
+    }
+    |SUBQUERY_CLAUSE JOIN_TYPE TABLE_NAMES ON CONDITION TOLERANCE DURATIONVAL
     {
         join := &Join{}
-        if len($1) != 1 || len($4) != 1{
+        if len($1) != 1 || len($3) != 1{
             yylex.Error("only support one query for join")
         }
+        if !JoinType($2).IsAsof() {
+            yylex.Error("tolerance is only supported for asof join")
+        }
+        if $7 <= 0 {
+            yylex.Error("tolerance must be greater than 0")
+        }
         join.LSrc = $1[0]
-        join.RSrc = $4[0]
-        join.Condition = $6
+        join.RSrc = $3[0]
+        join.Condition = $5
+        join.JoinType = JoinType($2)
+        join.Tolerance = $7
         $$ = join
     }
 
+JOIN_TYPE:
+    FULL JOIN
+    {
+        $$ = int(FullJoin)
+    }
+    |FULL OUTER JOIN
+    {
+        $$ = int(FullJoin)
+    }
+    |INNER JOIN
+    {
+        $$ = int(InnerJoin)
+    }
+    |LEFT JOIN
+    {
+        $$ = int(LeftOuterJoin)
+    }
+    |LEFT OUTER JOIN
+    {
+        $$ = int(LeftOuterJoin)
+    }
+    |RIGHT JOIN
+    {
+        $$ = int(RightOuterJoin)
+    }
+    |RIGHT OUTER JOIN
+    {
+        $$ = int(RightOuterJoin)
+    }
+    |ASOF JOIN
+    {
+        $$ = int(AsofJoin)
+    }
+    |LEFT ASOF JOIN
+    {
+        $$ = int(LeftAsofJoin)
+    }
+
 SUBQUERY_CLAUSE:
     LPAREN ALL_QUERY RPAREN
     {
@@ -1909,38 +2194,45 @@ DROP_RETENTION_POLICY_STATEMENT:
         $$ = stmt
     }
 
-GRANT_STATEMENT:
-    GRANT ALL ON IDENT TO IDENT
+PRIVILEGE:
+    ALL
     {
-    	stmt := &GrantStatement{}
-    	stmt.Privilege = AllPrivileges
-    	stmt.On = $4
-    	stmt.User = $6
-    	$$ = stmt
+    	$$ = int(AllPrivileges)
     }
-    |GRANT ALL PRIVILEGES ON IDENT TO IDENT
+    |ALL PRIVILEGES
     {
-    	stmt := &GrantStatement{}
-    	stmt.Privilege = AllPrivileges
-    	stmt.On = $5
-    	stmt.User = $7
-    	$$ = stmt
+    	$$ = int(AllPrivileges)
     }
-    |GRANT IDENT ON IDENT TO IDENT
+    |IDENT
     {
-    	stmt := &GrantStatement{}
-    	switch strings.ToLower($2){
+    	switch strings.ToLower($1){
     	case "read":
-    	    stmt.Privilege = ReadPrivilege
+    	    $$ = int(ReadPrivilege)
     	case "write":
-    	    stmt.Privilege = WritePrivilege
+    	    $$ = int(WritePrivilege)
     	default:
     	    yylex.Error("wrong Privilege")
     	}
+    }
+
+GRANT_STATEMENT:
+    GRANT PRIVILEGE ON IDENT TO IDENT
+    {
+    	stmt := &GrantStatement{}
+    	stmt.Privilege = Privilege($2)
     	stmt.On = $4
     	stmt.User = $6
     	$$ = stmt
     }
+    |GRANT PRIVILEGE ON IDENT DOT IDENT TO IDENT
+    {
+    	stmt := &GrantStatement{}
+    	stmt.Privilege = Privilege($2)
+    	stmt.On = $4
+    	stmt.Measurement = $6
+    	stmt.User = $8
+    	$$ = stmt
+    }
 
 GRANT_ADMIN_STATEMENT:
     GRANT ALL PRIVILEGES TO IDENT
@@ -1953,37 +2245,42 @@ GRANT_ADMIN_STATEMENT:
     }
 
 REVOKE_STATEMENT:
-    REVOKE ALL ON IDENT FROM IDENT
+    REVOKE PRIVILEGE ON IDENT FROM IDENT
     {
     	stmt := &RevokeStatement{}
-    	stmt.Privilege = AllPrivileges
+    	stmt.Privilege = Privilege($2)
     	stmt.On = $4
     	stmt.User = $6
     	$$ = stmt
     }
-    |REVOKE ALL PRIVILEGES ON IDENT FROM IDENT
+    |REVOKE PRIVILEGE ON IDENT DOT IDENT FROM IDENT
     {
     	stmt := &RevokeStatement{}
-    	stmt.Privilege = AllPrivileges
-    	stmt.On = $5
-    	stmt.User = $7
+    	stmt.Privilege = Privilege($2)
+    	stmt.On = $4
+    	stmt.Measurement = $6
+    	stmt.User = $8
     	$$ = stmt
     }
-    |REVOKE IDENT ON IDENT FROM IDENT
+
+DENY_STATEMENT:
+    DENY PRIVILEGE ON IDENT TO IDENT
     {
-    	stmt := &RevokeStatement{}
-    	switch strings.ToLower($2){
-    	case "read":
-    	    stmt.Privilege = ReadPrivilege
-    	case "write":
-    	    stmt.Privilege = WritePrivilege
-    	default:
-    	    yylex.Error("wrong Privilege")
-    	}
+    	stmt := &DenyStatement{}
+    	stmt.Privilege = Privilege($2)
     	stmt.On = $4
     	stmt.User = $6
     	$$ = stmt
     }
+    |DENY PRIVILEGE ON IDENT DOT IDENT TO IDENT
+    {
+    	stmt := &DenyStatement{}
+    	stmt.Privilege = Privilege($2)
+    	stmt.On = $4
+    	stmt.Measurement = $6
+    	stmt.User = $8
+    	$$ = stmt
+    }
 
 REVOKE_ADMIN_STATEMENT:
     REVOKE ALL PRIVILEGES FROM IDENT
@@ -2001,6 +2298,36 @@ DROP_USER_STATEMENT:
     	$$ = &DropUserStatement{Name:$3}
     }
 
+CREATE_ROLE_STATEMENT:
+    CREATE ROLE IDENT
+    {
+    	$$ = &CreateRoleStatement{Name:$3}
+    }
+
+DROP_ROLE_STATEMENT:
+    DROP ROLE IDENT
+    {
+    	$$ = &DropRoleStatement{Name:$3}
+    }
+
+SHOW_ROLES_STATEMENT:
+    SHOW ROLES
+    {
+    	$$ = &ShowRolesStatement{}
+    }
+
+GRANT_ROLE_STATEMENT:
+    GRANT ROLE IDENT TO IDENT
+    {
+    	$$ = &GrantRoleStatement{Role:$3, User:$5}
+    }
+
+REVOKE_ROLE_STATEMENT:
+    REVOKE ROLE IDENT FROM IDENT
+    {
+    	$$ = &RevokeRoleStatement{Role:$3, User:$5}
+    }
+
 SHOW_TAG_KEYS_STATEMENT:
   SHOW TAG KEYS ON_DATABASE FROM_CLAUSE WHERE_CLAUSE ORDER_CLAUSES OPTION_CLAUSES
   {
@@ -3185,6 +3512,16 @@ DROP_CONTINUOUS_QUERY_STATEMENT:
     	    Database: $6,
     	}
     }
+BACKFILL_CONTINUOUS_QUERY_STATEMENT:
+    BACKFILL CONTINUOUS QUERY IDENT FROM STRING TO STRING
+    {
+    	$$ = newBackfillContinuousQueryStatement(yylex, $4, "", $6, $8)
+    }
+    |BACKFILL CONTINUOUS QUERY IDENT ON IDENT FROM STRING TO STRING
+    {
+    	$$ = newBackfillContinuousQueryStatement(yylex, $4, $6, $8, $10)
+    }
+
 CREATE_DOWNSAMPLE_STATEMENT:
     CREATE DOWNSAMPLE ON IDENT LPAREN COLUMN_CLAUSES RPAREN WITH DOWNSAMPLE_INTERVALS
     {
@@ -3334,6 +3671,11 @@ SHOW_QUERIES_STATEMENT:
     {
         $$ = &ShowQueriesStatement{}
     }
+SHOW_RESOURCE_GROUPS_STATEMENT:
+    SHOW RESOURCE GROUPS
+    {
+        $$ = &ShowResourceGroupsStatement{}
+    }
 KILL_QUERY_STATEMENT:
     KILL QUERY INTEGER
     {
@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
@@ -454,6 +455,63 @@ func TestSingleParserError(t *testing.T) {
 	}
 }
 
+func TestPrivilegeStatements(t *testing.T) {
+	for sql, expected := range map[string]influxql.Statement{
+		"GRANT READ ON db0 TO jdoe":                &influxql.GrantStatement{Privilege: influxql.ReadPrivilege, On: "db0", User: "jdoe"},
+		"GRANT ALL ON db0 TO jdoe":                 &influxql.GrantStatement{Privilege: influxql.AllPrivileges, On: "db0", User: "jdoe"},
+		"GRANT write ON db0.cpu TO role0":          &influxql.GrantStatement{Privilege: influxql.WritePrivilege, On: "db0", Measurement: "cpu", User: "role0"},
+		"GRANT ALL PRIVILEGES ON db0.cpu TO role0": &influxql.GrantStatement{Privilege: influxql.AllPrivileges, On: "db0", Measurement: "cpu", User: "role0"},
+		"GRANT ALL PRIVILEGES TO jdoe":             &influxql.GrantAdminStatement{User: "jdoe"},
+		"REVOKE READ ON db0.\"c.p\" FROM jdoe":     &influxql.RevokeStatement{Privilege: influxql.ReadPrivilege, On: "db0", Measurement: "c.p", User: "jdoe"},
+		"REVOKE ALL ON db0 FROM jdoe":              &influxql.RevokeStatement{Privilege: influxql.AllPrivileges, On: "db0", User: "jdoe"},
+		"DENY READ ON db0.secret TO jdoe":          &influxql.DenyStatement{Privilege: influxql.ReadPrivilege, On: "db0", Measurement: "secret", User: "jdoe"},
+		"DENY ALL PRIVILEGES ON db0 TO role0":      &influxql.DenyStatement{Privilege: influxql.AllPrivileges, On: "db0", User: "role0"},
+		"CREATE ROLE role0":                        &influxql.CreateRoleStatement{Name: "role0"},
+		"DROP ROLE role0":                          &influxql.DropRoleStatement{Name: "role0"},
+		"SHOW ROLES":                               &influxql.ShowRolesStatement{},
+		"SHOW RESOURCE GROUPS":                     &influxql.ShowResourceGroupsStatement{},
+		"GRANT ROLE role0 TO jdoe":                 &influxql.GrantRoleStatement{Role: "role0", User: "jdoe"},
+		"REVOKE ROLE role0 FROM jdoe":              &influxql.RevokeRoleStatement{Role: "role0", User: "jdoe"},
+		"grant role \"role 1\" to \"jdoe\"":        &influxql.GrantRoleStatement{Role: "role 1", User: "jdoe"},
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+		if len(q.Statements) != 1 || !reflect.DeepEqual(q.Statements[0], expected) {
+			t.Fatalf("%s: got %#v, exp %#v", sql, q.Statements, expected)
+		}
+
+		// the statement can be parsed from its string
+		YyParser = &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(expected.String()))
+		YyParser.ParseTokens()
+		q, err = YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", expected.String(), err)
+		}
+		if !reflect.DeepEqual(q.Statements[0], expected) {
+			t.Fatalf("%s: got %#v, exp %#v", expected.String(), q.Statements[0], expected)
+		}
+	}
+
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("GRANT EXECUTE ON db0.cpu TO jdoe"))
+	YyParser.ParseTokens()
+	if _, err := YyParser.GetQuery(); err == nil || !strings.Contains(err.Error(), "wrong Privilege") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func BenchmarkNewParser(b *testing.B) {
 	YyParser := &influxql.YyParser{
 		Query: influxql.Query{},
@@ -477,3 +535,287 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestBackfillContinuousQueryStatement(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	for sql, expected := range map[string]influxql.Statement{
+		"BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-01T00:00:00Z' TO '2024-01-02T00:00:00Z'": &influxql.BackfillContinuousQueryStatement{
+			Name: "cq0", Database: "db0", StartTime: start, EndTime: end},
+		"backfill continuous query cq0 from '2024-01-01 00:00:00' to '2024-01-02'": &influxql.BackfillContinuousQueryStatement{
+			Name: "cq0", StartTime: start, EndTime: end},
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+		if len(q.Statements) != 1 || !reflect.DeepEqual(q.Statements[0], expected) {
+			t.Fatalf("%s: got %#v, exp %#v", sql, q.Statements, expected)
+		}
+
+		stmt, err := influxql.NewParser(strings.NewReader(expected.String())).ParseStatement()
+		if err != nil {
+			t.Fatalf("%s: %v", expected.String(), err)
+		}
+		if !reflect.DeepEqual(stmt, expected) {
+			t.Fatalf("%s: got %#v, exp %#v", expected.String(), stmt, expected)
+		}
+	}
+
+	for sql, expected := range map[string]string{
+		"BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM 'yesterday' TO '2024-01-02T00:00:00Z'":            "invalid backfill time 'yesterday'",
+		"BACKFILL CONTINUOUS QUERY cq0 ON db0 FROM '2024-01-02T00:00:00Z' TO '2024-01-01T00:00:00Z'": "backfill start time 2024-01-02T00:00:00Z must be before the end time 2024-01-01T00:00:00Z",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		_, err := YyParser.GetQuery()
+		if err == nil || err.Error() != expected {
+			t.Fatalf("%s: got %v, exp %s", sql, err, expected)
+		}
+
+		_, err = influxql.NewParser(strings.NewReader(sql)).ParseStatement()
+		if err == nil || err.Error() != expected {
+			t.Fatalf("%s: got %v, exp %s", sql, err, expected)
+		}
+	}
+}
+
+func TestJoinClause(t *testing.T) {
+	for sql, expected := range map[string]*influxql.Join{
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 full join (select f1 from mst2) as m2 on (m1.tk1 = m2.tk1) group by tk1": {
+			JoinType: influxql.FullJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 full outer join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 group by tk1": {
+			JoinType: influxql.FullJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 INNER JOIN (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 group by tk1": {
+			JoinType: influxql.InnerJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 left join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 group by tk1": {
+			JoinType: influxql.LeftOuterJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 left outer join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 group by tk1": {
+			JoinType: influxql.LeftOuterJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 right outer join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 group by tk1": {
+			JoinType: influxql.RightOuterJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 asof join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 group by tk1": {
+			JoinType: influxql.AsofJoin},
+		"select m1.f1, m2.f1 from (select f1 from mst1) as m1 left asof join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 tolerance 5m group by tk1": {
+			JoinType: influxql.LeftAsofJoin, Tolerance: 5 * time.Minute},
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+		join, ok := q.Statements[0].(*influxql.SelectStatement).Sources[0].(*influxql.Join)
+		if !ok {
+			t.Fatalf("%s: the source is not a join", sql)
+		}
+		if join.JoinType != expected.JoinType || join.Tolerance != expected.Tolerance {
+			t.Fatalf("%s: got %s, exp %s", sql, join, expected.JoinType)
+		}
+		if join.LSrc.(*influxql.SubQuery).Alias != "m1" || join.RSrc.(*influxql.SubQuery).Alias != "m2" {
+			t.Fatalf("%s: unexpected join sources", sql)
+		}
+		clone := influxql.CloneSource(join).(*influxql.Join)
+		if clone.JoinType != join.JoinType || clone.Tolerance != join.Tolerance {
+			t.Fatalf("%s: got %s, exp %s", sql, clone, join)
+		}
+	}
+
+	for sql, expected := range map[string]string{
+		"select * from (select f1 from mst1) as m1 left join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 tolerance 5m": "tolerance is only supported for asof join",
+		"select * from (select f1 from mst1) as m1 asof join (select f1 from mst2) as m2 on m1.tk1 = m2.tk1 tolerance 0s": "tolerance must be greater than 0",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		_, err := YyParser.GetQuery()
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("%s: got %v, exp %s", sql, err, expected)
+		}
+	}
+}
+
+func TestWindowClause(t *testing.T) {
+	for sql, expected := range map[string]string{
+		"select lag(f1, 1) over (partition by tk1 order by time) from mst":                                            "lag(f1, 1) OVER (PARTITION BY tk1 ORDER BY time)",
+		"select row_number() over (order by time desc) from mst":                                                      "row_number() OVER (ORDER BY time DESC)",
+		"select sum(f1) OVER (PARTITION BY tk1, tk2 ORDER BY time ROWS BETWEEN 2 PRECEDING AND CURRENT ROW) from mst": "sum(f1) OVER (PARTITION BY tk1, tk2 ORDER BY time ROWS BETWEEN 2 PRECEDING AND CURRENT ROW)",
+		"select mean(f1) over (order by time asc range 5m preceding) from mst":                                        "mean(f1) OVER (ORDER BY time RANGE BETWEEN 5m PRECEDING AND CURRENT ROW)",
+		"select last_value(f1) over (rows between current row and unbounded following) from mst":                      "last_value(f1) OVER (ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)",
+		"select count(f1) over () from mst":                                                                           "count(f1) OVER ()",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+		field := q.Statements[0].(*influxql.SelectStatement).Fields[0]
+		if got := field.Expr.String(); got != expected {
+			t.Fatalf("%s: got %s, exp %s", sql, got, expected)
+		}
+		if got := influxql.CloneExpr(field.Expr).String(); got != expected {
+			t.Fatalf("%s: got clone %s, exp %s", sql, got, expected)
+		}
+
+		// the fields are parsed again by the store nodes
+		stmt, err := influxql.NewParser(strings.NewReader("SELECT " + expected + " FROM mst")).ParseStatement()
+		if err != nil {
+			t.Fatalf("%s: %v", expected, err)
+		}
+		if got := stmt.(*influxql.SelectStatement).Fields[0].Expr.String(); got != expected {
+			t.Fatalf("%s: got %s, exp %s", sql, got, expected)
+		}
+	}
+
+	for sql, expected := range map[string]string{
+		"select lag(f1) over (order by f2) from mst":                                                    "only ORDER BY time is supported in window",
+		"select sum(f1) over (order by time rows 5m preceding) from mst":                                "ROWS window frame offset must be an integer",
+		"select sum(f1) over (order by time range 5 preceding) from mst":                                "RANGE window frame offset must be a duration",
+		"select sum(f1) over (order by time rows between unbounded following and current row) from mst": "window frame cannot start with UNBOUNDED FOLLOWING",
+		"select sum(f1) over (order by time rows between 1 following and 1 preceding) from mst":         "window frame cannot start after the end",
+		"select sum(f1) over (order by time lines 1 preceding) from mst":                                "expect ROWS or RANGE for window frame",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		_, err := YyParser.GetQuery()
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("%s: got %v, exp %s", sql, err, expected)
+		}
+		_, err = influxql.NewParser(strings.NewReader(sql)).ParseStatement()
+		if err == nil {
+			t.Fatalf("%s: expect error", sql)
+		}
+	}
+}
+
+type unionTypeMapper map[string]map[string]influxql.DataType
+
+func (m unionTypeMapper) MapType(measurement *influxql.Measurement, field string) influxql.DataType {
+	return m[measurement.Name][field]
+}
+
+func (m unionTypeMapper) MapTypeBatch(*influxql.Measurement, map[string]*influxql.FieldNameSpace, *influxql.Schema) error {
+	return nil
+}
+
+func TestUnionClause(t *testing.T) {
+	for sql, expected := range map[string]struct {
+		distinct bool
+		fields   string
+		sources  int
+	}{
+		"SELECT a FROM m1 UNION ALL SELECT b AS a FROM m2":                             {distinct: false, fields: "a", sources: 2},
+		"SELECT a FROM m1 UNION SELECT b AS a, c FROM m2 UNION SELECT c FROM m3":       {distinct: true, fields: "a, c", sources: 3},
+		"SELECT a, b FROM m1 WHERE a > 1 UNION ALL SELECT mean(b) FROM m2 GROUP BY tk": {distinct: false, fields: "a, b, mean", sources: 2},
+		"SELECT * FROM m1 UNION ALL SELECT a FROM m2":                                  {distinct: false, fields: "*", sources: 2},
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+		stmt := q.Statements[0].(*influxql.SelectStatement)
+		if stmt.Union == nil || stmt.Union.Distinct != expected.distinct || stmt.Dedupe != expected.distinct {
+			t.Fatalf("%s: unexpected union %v", sql, stmt.Union)
+		}
+		if stmt.Fields.String() != expected.fields || len(stmt.Sources) != expected.sources {
+			t.Fatalf("%s: got %s from %d sources, exp %s from %d sources", sql, stmt.Fields, len(stmt.Sources), expected.fields, expected.sources)
+		}
+		if stmt.String() != sql || stmt.Clone().String() != sql {
+			t.Fatalf("got %s, exp %s", stmt, sql)
+		}
+	}
+
+	for sql, expected := range map[string]string{
+		"SELECT a FROM m1 UNION SELECT a FROM m2 UNION ALL SELECT a FROM m3": "UNION and UNION ALL cannot be used together",
+		"SELECT a INTO m3 FROM m1 UNION ALL SELECT a FROM m2":                "SELECT INTO is not supported in UNION",
+		"SELECT a FROM m1 LIMIT 1 UNION ALL SELECT a FROM m2":                "ORDER BY, LIMIT and OFFSET are only allowed after the last statement of UNION",
+		"SELECT a FROM m1 ORDER BY time DESC UNION SELECT a FROM m2":         "ORDER BY, LIMIT and OFFSET are only allowed after the last statement of UNION",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		_, err := YyParser.GetQuery()
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("%s: got %v, exp %s", sql, err, expected)
+		}
+	}
+}
+
+func TestUnionClause_OrderAndLimit(t *testing.T) {
+	sql := "SELECT a FROM m1 UNION SELECT a FROM m2 ORDER BY time DESC LIMIT 10 OFFSET 2 SLIMIT 3 SOFFSET 1"
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	if err != nil {
+		t.Fatalf("%s: %v", sql, err)
+	}
+	stmt := q.Statements[0].(*influxql.SelectStatement)
+	if stmt.TimeAscending() || stmt.Limit != 10 || stmt.Offset != 2 || stmt.SLimit != 3 || stmt.SOffset != 1 {
+		t.Fatalf("the order and the limit are not applied to the union: %s", stmt.SortFields)
+	}
+	if got, exp := stmt.Sources.String(), "(SELECT a FROM m1), (SELECT a FROM m2)"; got != exp {
+		t.Fatalf("got sources %s, exp %s", got, exp)
+	}
+	if stmt.String() != sql {
+		t.Fatalf("got %s, exp %s", stmt, sql)
+	}
+}
+
+func TestMergeUnionSources(t *testing.T) {
+	mapper := unionTypeMapper{
+		"m1": {"a": influxql.Integer},
+		"m2": {"a": influxql.Integer},
+		"m3": {"a": influxql.Float},
+	}
+	for sql, expected := range map[string]string{
+		"SELECT a FROM m1 UNION ALL SELECT a FROM m2":                            "(SELECT a FROM m1, m2)",
+		"SELECT a FROM m1 UNION ALL SELECT a FROM m3":                            "(SELECT a FROM m1), (SELECT a FROM m3)",
+		"SELECT a FROM m1 UNION ALL SELECT a FROM m1":                            "(SELECT a FROM m1), (SELECT a FROM m1)",
+		"SELECT a FROM m1 UNION ALL SELECT a FROM m3 UNION ALL SELECT a FROM m2": "(SELECT a FROM m1, m2), (SELECT a FROM m3)",
+		"SELECT a FROM m1 WHERE a > 1 UNION ALL SELECT a FROM m2":                "(SELECT a FROM m1 WHERE a > 1), (SELECT a FROM m2)",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+		stmt := q.Statements[0].(*influxql.SelectStatement)
+		if got := influxql.MergeUnionSources(stmt.Sources, mapper).String(); got != expected {
+			t.Fatalf("%s: got %s, exp %s", sql, got, expected)
+		}
+	}
+}
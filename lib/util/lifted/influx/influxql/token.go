@@ -339,6 +339,18 @@ var tokens = [...]string{
 	COMPACT:        "COMPACT",
 	AUTO:           "AUTO",
 	EXCEPT:         "EXCEPT",
+	ROLE:           "ROLE",
+	ROLES:          "ROLES",
+	DENY:           "DENY",
+	RESOURCE:       "RESOURCE",
+	BACKFILL:       "BACKFILL",
+	INNER:          "INNER",
+	LEFT:           "LEFT",
+	RIGHT:          "RIGHT",
+	ASOF:           "ASOF",
+	TOLERANCE:      "TOLERANCE",
+	OVER:           "OVER",
+	UNION:          "UNION",
 }
 
 var keywords map[string]int
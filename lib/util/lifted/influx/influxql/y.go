@@ -65,10 +65,67 @@ func deal_Fill(fill interface{}) (FillOption, interface{}, bool) {
 	}
 }
 
-//line sql.y:65
+func newBackfillContinuousQueryStatement(yylex yyLexer, name, db, start, end string) *BackfillContinuousQueryStatement {
+	stmt := &BackfillContinuousQueryStatement{Name: name, Database: db}
+	var err error
+	if stmt.StartTime, err = toBackfillTime(start); err != nil {
+		yylex.Error(err.Error())
+		return stmt
+	}
+	if stmt.EndTime, err = toBackfillTime(end); err != nil {
+		yylex.Error(err.Error())
+		return stmt
+	}
+	if err = stmt.validate(); err != nil {
+		yylex.Error(err.Error())
+	}
+	return stmt
+}
+
+type windowFrameBound struct {
+	WindowFrameBound
+	isDuration bool
+}
+
+func newWindowFrameOffset(yylex yyLexer, offset int64, typ string, isDuration bool) *windowFrameBound {
+	b := &windowFrameBound{WindowFrameBound: WindowFrameBound{Offset: offset}, isDuration: isDuration}
+	switch strings.ToLower(typ) {
+	case "preceding":
+		b.Type = Preceding
+	case "following":
+		b.Type = Following
+	default:
+		yylex.Error("expect PRECEDING or FOLLOWING for window frame offset")
+	}
+	return b
+}
+
+func newWindowFrame(yylex yyLexer, unit string, start, end *windowFrameBound) *WindowFrame {
+	frame := &WindowFrame{Start: start.WindowFrameBound, End: end.WindowFrameBound}
+	switch strings.ToLower(unit) {
+	case "rows":
+		if start.isDuration || end.isDuration {
+			yylex.Error("ROWS window frame offset must be an integer")
+		}
+	case "range":
+		frame.Range = true
+		if (start.Offset != 0 && !start.isDuration) || (end.Offset != 0 && !end.isDuration) {
+			yylex.Error("RANGE window frame offset must be a duration")
+		}
+	default:
+		yylex.Error("expect ROWS or RANGE for window frame")
+	}
+	if err := frame.Validate(); err != nil {
+		yylex.Error(err.Error())
+	}
+	return frame
+}
+
+//line sql.y:120
 type yySymType struct {
 	yys              int
 	stmt             Statement
+	union            *Union
 	stmts            Statements
 	str              string
 	query            Query
@@ -106,6 +163,9 @@ type yySymType struct {
 	indexOption      *IndexOption
 	databasePolicy   DatabasePolicy
 	cmOption         *CreateMeasurementStatementOption
+	window           *Window
+	windowFrame      *WindowFrame
+	windowFrameBound *windowFrameBound
 }
 
 const FROM = 57346
@@ -229,41 +289,53 @@ const SCHEMA = 57463
 const INDEXES = 57464
 const AUTO = 57465
 const EXCEPT = 57466
-const DESC = 57467
-const ASC = 57468
-const COMMA = 57469
-const SEMICOLON = 57470
-const LPAREN = 57471
-const RPAREN = 57472
-const REGEX = 57473
-const EQ = 57474
-const NEQ = 57475
-const LT = 57476
-const LTE = 57477
-const GT = 57478
-const GTE = 57479
-const DOT = 57480
-const DOUBLECOLON = 57481
-const NEQREGEX = 57482
-const EQREGEX = 57483
-const IDENT = 57484
-const INTEGER = 57485
-const DURATIONVAL = 57486
-const STRING = 57487
-const NUMBER = 57488
-const HINT = 57489
-const BOUNDPARAM = 57490
-const AND = 57491
-const OR = 57492
-const ADD = 57493
-const SUB = 57494
-const BITWISE_OR = 57495
-const BITWISE_XOR = 57496
-const MUL = 57497
-const DIV = 57498
-const MOD = 57499
-const BITWISE_AND = 57500
-const UMINUS = 57501
+const ROLE = 57467
+const ROLES = 57468
+const DENY = 57469
+const RESOURCE = 57470
+const BACKFILL = 57471
+const INNER = 57472
+const LEFT = 57473
+const RIGHT = 57474
+const ASOF = 57475
+const TOLERANCE = 57476
+const OVER = 57477
+const UNION = 57478
+const DESC = 57479
+const ASC = 57480
+const COMMA = 57481
+const SEMICOLON = 57482
+const LPAREN = 57483
+const RPAREN = 57484
+const REGEX = 57485
+const EQ = 57486
+const NEQ = 57487
+const LT = 57488
+const LTE = 57489
+const GT = 57490
+const GTE = 57491
+const DOT = 57492
+const DOUBLECOLON = 57493
+const NEQREGEX = 57494
+const EQREGEX = 57495
+const IDENT = 57496
+const INTEGER = 57497
+const DURATIONVAL = 57498
+const STRING = 57499
+const NUMBER = 57500
+const HINT = 57501
+const BOUNDPARAM = 57502
+const AND = 57503
+const OR = 57504
+const ADD = 57505
+const SUB = 57506
+const BITWISE_OR = 57507
+const BITWISE_XOR = 57508
+const MUL = 57509
+const DIV = 57510
+const MOD = 57511
+const BITWISE_AND = 57512
+const UMINUS = 57513
 
 var yyToknames = [...]string{
 	"$end",
@@ -390,6 +462,18 @@ var yyToknames = [...]string{
 	"INDEXES",
 	"AUTO",
 	"EXCEPT",
+	"ROLE",
+	"ROLES",
+	"DENY",
+	"RESOURCE",
+	"BACKFILL",
+	"INNER",
+	"LEFT",
+	"RIGHT",
+	"ASOF",
+	"TOLERANCE",
+	"OVER",
+	"UNION",
 	"DESC",
 	"ASC",
 	"COMMA",
@@ -433,313 +517,342 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3505
+//line sql.y:3847
 
 //line yacctab:1
 var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 71,
-	4, 93,
-	-2, 140,
-	-1, 473,
-	113, 157,
-	132, 157,
-	133, 157,
-	134, 157,
-	135, 157,
-	136, 157,
-	137, 157,
-	140, 157,
-	141, 157,
-	-2, 146,
+	-1, 85,
+	4, 116,
+	-2, 177,
+	-1, 521,
+	113, 194,
+	144, 194,
+	145, 194,
+	146, 194,
+	147, 194,
+	148, 194,
+	149, 194,
+	152, 194,
+	153, 194,
+	-2, 183,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1169
+const yyLast = 1298
 
 var yyAct = [...]int16{
-	499, 906, 932, 514, 876, 778, 696, 426, 805, 897,
-	717, 264, 395, 513, 795, 746, 649, 700, 710, 837,
-	4, 555, 633, 637, 495, 723, 776, 237, 556, 386,
-	75, 424, 139, 207, 508, 445, 497, 324, 247, 233,
-	2, 235, 71, 174, 281, 231, 154, 321, 724, 725,
-	81, 856, 726, 676, 138, 888, 85, 86, 727, 857,
-	505, 179, 351, 352, 675, 163, 164, 168, 165, 161,
-	162, 166, 167, 163, 164, 168, 165, 161, 162, 166,
-	167, 161, 162, 166, 167, 634, 89, 907, 149, 393,
-	635, 610, 715, 574, 473, 214, 942, 500, 215, 567,
-	215, 904, 614, 615, 155, 890, 872, 169, 89, 173,
-	501, 351, 352, 351, 352, 157, 76, 283, 89, 578,
-	214, 271, 208, 215, 272, 213, 216, 880, 652, 77,
-	83, 80, 84, 82, 847, 88, 227, 160, 229, 78,
-	846, 793, 74, 163, 164, 168, 165, 161, 162, 166,
-	167, 792, 236, 773, 89, 183, 730, 681, 680, 679,
-	219, 206, 678, 204, 214, 205, 59, 215, 208, 259,
-	89, 230, 248, 351, 352, 214, 612, 450, 215, 613,
-	551, 449, 218, 870, 208, 250, 874, 548, 549, 781,
-	859, 735, 273, 274, 275, 276, 277, 278, 279, 280,
-	318, 268, 266, 89, 267, 292, 875, 734, 248, 263,
-	206, 563, 294, 282, 205, 59, 299, 208, 554, 290,
-	291, 81, 509, 510, 286, 781, 287, 85, 86, 565,
-	512, 511, 552, 177, 437, 650, 651, 316, 298, 355,
-	356, 262, 295, 654, 653, 222, 536, 301, 302, 303,
-	535, 337, 310, 414, 936, 334, 315, 413, 780, 309,
-	335, 877, 384, 308, 146, 144, 806, 354, 871, 938,
-	353, 748, 711, 557, 639, 803, 350, 349, 163, 164,
-	168, 165, 161, 162, 166, 167, 770, 76, 769, 89,
-	761, 720, 719, 706, 784, 285, 385, 665, 664, 627,
-	77, 83, 80, 84, 82, 370, 88, 626, 609, 607,
-	78, 175, 400, 74, 606, 604, 602, 589, 588, 587,
-	391, 564, 389, 416, 362, 363, 364, 365, 366, 367,
-	448, 399, 369, 368, 403, 405, 582, 458, 580, 566,
-	553, 538, 506, 170, 463, 464, 490, 489, 421, 711,
-	486, 485, 172, 171, 466, 460, 402, 404, 406, 398,
-	478, 479, 401, 383, 451, 415, 382, 409, 423, 411,
-	420, 147, 145, 381, 418, 378, 419, 377, 476, 471,
-	472, 376, 248, 248, 373, 371, 342, 341, 340, 338,
-	333, 465, 248, 467, 332, 331, 326, 494, 319, 317,
-	480, 313, 296, 288, 520, 261, 223, 221, 217, 203,
-	201, 622, 519, 620, 586, 524, 159, 170, 526, 663,
-	540, 454, 504, 590, 507, 503, 172, 171, 539, 576,
-	455, 537, 585, 547, 522, 523, 462, 525, 452, 412,
-	339, 330, 833, 832, 534, 689, 493, 448, 492, 575,
-	422, 543, 545, 546, 89, 810, 521, 550, 809, 572,
-	943, 87, 573, 70, 530, 469, 533, 921, 909, 908,
-	529, 903, 532, 542, 544, 562, 889, 863, 584, 541,
-	849, 807, 571, 802, 577, 841, 579, 801, 799, 798,
-	712, 708, 707, 694, 611, 595, 597, 581, 598, 470,
-	456, 390, 211, 935, 884, 603, 855, 750, 617, 601,
-	695, 621, 353, 618, 592, 596, 623, 594, 844, 477,
-	474, 360, 359, 641, 616, 357, 329, 718, 645, 346,
-	70, 937, 348, 922, 643, 644, 899, 677, 646, 636,
-	647, 625, 852, 666, 819, 800, 662, 738, 739, 178,
-	59, 674, 737, 640, 642, 670, 619, 672, 673, 600,
-	60, 61, 599, 591, 158, 660, 661, 387, 794, 325,
-	66, 322, 63, 181, 668, 669, 438, 671, 774, 698,
-	224, 150, 64, 209, 655, 210, 699, 659, 152, 928,
-	850, 703, 789, 693, 688, 65, 667, 842, 677, 68,
-	713, 714, 209, 841, 62, 209, 686, 196, 228, 197,
-	691, 325, 838, 931, 926, 918, 323, 709, 209, 67,
-	902, 59, 777, 181, 212, 181, 483, 311, 312, 306,
-	307, 722, 417, 788, 716, 410, 81, 704, 733, 347,
-	69, 721, 85, 86, 193, 194, 345, 741, 742, 408,
-	314, 300, 775, 732, 740, 821, 728, 209, 323, 151,
-	743, 186, 187, 188, 755, 744, 760, 749, 190, 754,
-	191, 236, 758, 759, 765, 756, 767, 768, 658, 648,
-	763, 764, 745, 766, 528, 180, 269, 690, 270, 439,
-	731, 148, 757, 729, 783, 304, 305, 184, 185, 325,
-	762, 796, 76, 881, 89, 3, 771, 624, 787, 392,
-	289, 177, 834, 882, 782, 77, 83, 80, 84, 82,
-	72, 88, 260, 433, 436, 78, 434, 435, 74, 192,
-	718, 772, 697, 797, 804, 683, 248, 561, 791, 560,
-	559, 558, 249, 220, 816, 131, 202, 812, 182, 143,
-	701, 702, 140, 808, 441, 786, 785, 811, 818, 814,
-	570, 815, 826, 827, 883, 141, 820, 829, 830, 825,
-	831, 822, 823, 140, 828, 136, 153, 817, 140, 656,
-	790, 129, 753, 684, 126, 840, 128, 293, 657, 824,
-	142, 130, 209, 583, 527, 444, 397, 848, 372, 839,
-	531, 127, 843, 327, 496, 407, 358, 209, 845, 209,
-	475, 251, 851, 605, 374, 487, 484, 468, 854, 853,
-	257, 836, 861, 255, 858, 252, 132, 835, 253, 868,
-	860, 375, 869, 137, 813, 862, 867, 256, 864, 631,
-	632, 133, 134, 515, 516, 135, 736, 878, 873, 502,
-	502, 140, 796, 796, 879, 865, 866, 396, 517, 265,
-	396, 388, 593, 887, 892, 140, 885, 886, 81, 141,
-	141, 896, 891, 156, 85, 86, 141, 894, 895, 200,
-	898, 59, 380, 705, 181, 379, 482, 461, 459, 457,
-	905, 453, 440, 344, 343, 336, 297, 893, 912, 913,
-	910, 258, 254, 226, 915, 911, 898, 919, 914, 920,
-	225, 199, 209, 198, 209, 923, 156, 518, 394, 608,
-	491, 488, 140, 927, 929, 99, 195, 934, 121, 569,
-	209, 189, 568, 443, 76, 442, 89, 939, 934, 941,
-	940, 447, 446, 692, 687, 685, 779, 77, 83, 80,
-	84, 82, 113, 88, 924, 925, 933, 78, 916, 900,
-	74, 917, 94, 90, 120, 91, 92, 118, 901, 119,
-	930, 101, 96, 628, 629, 747, 425, 242, 241, 98,
-	630, 93, 498, 638, 284, 361, 176, 79, 81, 246,
-	245, 95, 238, 97, 85, 86, 232, 234, 1, 73,
-	55, 112, 109, 110, 111, 116, 102, 54, 105, 122,
-	100, 53, 106, 58, 81, 57, 125, 56, 429, 430,
-	85, 86, 103, 52, 123, 51, 50, 104, 124, 427,
-	431, 433, 436, 328, 434, 435, 107, 108, 209, 81,
-	428, 114, 115, 49, 48, 85, 86, 47, 46, 45,
-	44, 43, 42, 209, 76, 41, 89, 40, 39, 38,
-	37, 432, 117, 243, 36, 244, 35, 77, 83, 80,
-	84, 82, 34, 88, 33, 32, 31, 78, 59, 30,
-	239, 502, 89, 29, 28, 27, 26, 25, 60, 61,
-	24, 21, 20, 240, 83, 80, 84, 82, 66, 88,
-	63, 22, 19, 78, 23, 481, 18, 89, 17, 16,
-	64, 14, 15, 13, 12, 751, 752, 682, 77, 83,
-	80, 84, 82, 65, 88, 7, 11, 68, 78, 10,
-	9, 8, 62, 320, 6, 5, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 67, 0, 0,
+	551, 568, 1034, 997, 896, 1005, 851, 883, 465, 297,
+	892, 760, 567, 868, 713, 774, 802, 781, 764, 4,
+	923, 609, 547, 790, 819, 697, 701, 85, 849, 610,
+	685, 549, 267, 463, 484, 424, 157, 261, 562, 89,
+	357, 263, 206, 277, 236, 2, 354, 265, 201, 188,
+	189, 193, 194, 181, 989, 944, 314, 740, 434, 698,
+	156, 791, 792, 945, 699, 793, 95, 739, 870, 432,
+	966, 794, 99, 100, 389, 390, 559, 190, 191, 195,
+	192, 188, 189, 193, 194, 190, 191, 195, 192, 188,
+	189, 193, 194, 982, 103, 389, 390, 172, 243, 1044,
+	779, 244, 178, 980, 180, 552, 521, 881, 244, 95,
+	103, 1013, 894, 895, 184, 99, 100, 489, 553, 389,
+	390, 488, 182, 880, 237, 389, 390, 674, 628, 668,
+	196, 621, 200, 68, 672, 673, 1006, 243, 1003, 266,
+	244, 103, 242, 245, 90, 316, 103, 984, 235, 973,
+	210, 101, 234, 939, 257, 237, 259, 91, 97, 94,
+	98, 96, 187, 102, 964, 389, 390, 92, 103, 933,
+	88, 190, 191, 195, 192, 188, 189, 193, 194, 632,
+	932, 248, 237, 949, 894, 895, 233, 90, 95, 103,
+	866, 291, 865, 260, 99, 100, 893, 894, 895, 278,
+	91, 97, 94, 98, 96, 247, 102, 988, 987, 280,
+	92, 243, 304, 301, 244, 305, 968, 846, 797, 243,
+	670, 299, 244, 671, 804, 315, 854, 351, 306, 307,
+	308, 309, 310, 311, 312, 313, 745, 325, 300, 716,
+	744, 743, 296, 327, 278, 742, 605, 332, 969, 953,
+	328, 323, 324, 563, 564, 334, 335, 336, 602, 603,
+	343, 566, 565, 167, 348, 319, 90, 320, 103, 367,
+	349, 331, 808, 854, 163, 807, 803, 617, 68, 91,
+	97, 94, 98, 96, 370, 102, 103, 608, 619, 92,
+	238, 606, 88, 235, 421, 590, 368, 234, 1038, 589,
+	237, 476, 392, 295, 252, 408, 170, 853, 388, 391,
+	238, 204, 387, 238, 897, 190, 191, 195, 192, 188,
+	189, 193, 194, 453, 393, 394, 342, 452, 990, 804,
+	341, 884, 965, 423, 821, 238, 400, 401, 402, 403,
+	404, 405, 951, 950, 407, 406, 1045, 947, 318, 775,
+	611, 703, 879, 168, 857, 878, 877, 876, 714, 715,
+	843, 427, 430, 487, 164, 842, 718, 717, 438, 834,
+	497, 442, 444, 770, 238, 729, 440, 502, 503, 728,
+	691, 448, 165, 450, 690, 460, 675, 667, 457, 666,
+	458, 439, 618, 165, 665, 441, 443, 445, 526, 527,
+	462, 202, 455, 664, 454, 663, 662, 660, 490, 459,
+	643, 642, 641, 636, 634, 524, 504, 804, 506, 507,
+	620, 607, 519, 520, 775, 165, 592, 560, 539, 278,
+	278, 538, 535, 546, 534, 505, 499, 437, 422, 278,
+	197, 574, 420, 528, 419, 417, 415, 413, 411, 199,
+	198, 409, 578, 540, 375, 374, 373, 594, 555, 371,
+	366, 365, 364, 359, 556, 352, 350, 346, 329, 321,
+	601, 293, 561, 288, 284, 253, 251, 576, 577, 250,
+	579, 246, 232, 231, 229, 682, 487, 588, 629, 680,
+	583, 536, 586, 532, 597, 599, 600, 573, 604, 595,
+	186, 575, 727, 580, 644, 640, 630, 591, 501, 584,
+	491, 587, 451, 593, 197, 238, 638, 616, 596, 598,
+	625, 635, 493, 199, 198, 372, 363, 1040, 919, 103,
+	238, 494, 238, 238, 631, 639, 633, 918, 753, 543,
+	542, 461, 653, 888, 669, 656, 887, 1023, 1008, 626,
+	652, 382, 627, 661, 81, 1007, 517, 1002, 983, 957,
+	935, 890, 659, 927, 885, 875, 683, 874, 872, 391,
+	871, 240, 801, 776, 772, 705, 771, 554, 554, 541,
+	709, 758, 676, 655, 518, 495, 707, 708, 428, 1037,
+	977, 943, 823, 711, 759, 730, 700, 684, 726, 689,
+	681, 678, 654, 738, 558, 525, 677, 734, 930, 736,
+	737, 704, 706, 522, 398, 397, 395, 362, 782, 533,
+	379, 81, 1039, 724, 725, 1024, 425, 741, 952, 938,
+	905, 873, 732, 733, 810, 735, 679, 537, 710, 658,
+	763, 238, 657, 238, 719, 767, 645, 723, 811, 812,
+	83, 185, 557, 512, 511, 429, 731, 867, 777, 778,
+	355, 383, 384, 385, 386, 358, 208, 205, 477, 687,
+	380, 294, 847, 173, 773, 755, 254, 239, 176, 769,
+	762, 1030, 936, 768, 862, 757, 278, 928, 927, 227,
+	789, 752, 750, 223, 780, 258, 924, 95, 175, 788,
+	358, 741, 224, 99, 100, 241, 1033, 1028, 1020, 814,
+	815, 795, 356, 456, 1001, 800, 813, 692, 693, 799,
+	850, 531, 449, 816, 3, 861, 447, 817, 833, 347,
+	208, 333, 208, 162, 831, 832, 838, 829, 840, 841,
+	822, 68, 836, 837, 907, 839, 848, 356, 806, 378,
+	818, 174, 344, 345, 339, 340, 220, 221, 828, 856,
+	830, 827, 722, 68, 513, 869, 712, 217, 835, 218,
+	582, 844, 754, 69, 70, 90, 478, 103, 207, 302,
+	855, 303, 238, 75, 798, 72, 796, 864, 91, 97,
+	94, 98, 96, 86, 102, 73, 860, 238, 92, 358,
+	974, 88, 337, 338, 211, 212, 177, 688, 74, 166,
+	169, 171, 78, 431, 322, 902, 204, 71, 886, 898,
+	920, 975, 889, 213, 214, 215, 472, 475, 292, 473,
+	474, 554, 77, 912, 913, 900, 219, 901, 915, 916,
+	911, 917, 908, 909, 782, 914, 845, 882, 906, 761,
+	747, 468, 469, 80, 903, 615, 614, 613, 612, 926,
+	279, 209, 466, 470, 472, 475, 910, 473, 474, 824,
+	825, 249, 925, 467, 230, 934, 929, 904, 161, 480,
+	931, 179, 76, 158, 79, 624, 937, 765, 766, 940,
+	138, 859, 858, 159, 471, 942, 266, 158, 158, 976,
+	863, 826, 748, 721, 948, 637, 955, 581, 483, 436,
+	720, 410, 360, 962, 396, 326, 963, 548, 650, 160,
+	961, 523, 958, 941, 585, 446, 136, 786, 785, 134,
+	956, 135, 970, 971, 282, 783, 414, 283, 869, 869,
+	412, 967, 515, 514, 649, 959, 960, 972, 946, 648,
+	981, 978, 979, 509, 508, 647, 992, 991, 516, 985,
+	510, 922, 954, 996, 986, 287, 921, 290, 899, 994,
+	995, 139, 695, 696, 809, 998, 569, 570, 142, 435,
+	891, 114, 286, 158, 805, 571, 140, 426, 1004, 298,
+	141, 651, 435, 1009, 158, 993, 1015, 1016, 183, 159,
+	1012, 159, 1017, 1014, 1010, 1011, 1021, 998, 129, 1022,
+	137, 159, 228, 68, 544, 646, 1025, 545, 108, 104,
+	208, 105, 106, 530, 500, 1029, 498, 116, 496, 1036,
+	1031, 492, 95, 272, 271, 113, 479, 107, 99, 100,
+	1036, 1043, 1042, 1041, 377, 376, 369, 110, 330, 112,
+	289, 285, 281, 256, 255, 226, 225, 128, 125, 126,
+	127, 132, 117, 183, 120, 82, 115, 572, 122, 433,
+	95, 84, 787, 784, 158, 418, 99, 100, 118, 416,
+	222, 216, 623, 119, 622, 482, 481, 686, 95, 5,
+	486, 485, 123, 124, 99, 100, 756, 130, 131, 751,
+	749, 852, 109, 1026, 121, 1027, 1035, 1018, 999, 1019,
+	90, 1000, 103, 149, 1032, 111, 820, 464, 694, 273,
+	550, 274, 702, 91, 97, 94, 98, 96, 317, 102,
+	133, 381, 399, 92, 203, 93, 88, 276, 275, 268,
+	262, 264, 1, 154, 87, 60, 59, 29, 269, 146,
+	103, 28, 143, 27, 145, 26, 25, 24, 64, 148,
+	63, 270, 97, 94, 98, 96, 529, 102, 103, 144,
+	62, 92, 67, 66, 65, 61, 68, 58, 57, 91,
+	97, 94, 98, 96, 361, 102, 69, 70, 56, 92,
+	55, 54, 53, 52, 150, 51, 75, 50, 72, 49,
+	48, 155, 47, 46, 45, 44, 43, 42, 73, 151,
+	152, 41, 40, 153, 39, 38, 37, 36, 35, 34,
+	33, 74, 32, 31, 22, 78, 21, 23, 20, 30,
+	71, 19, 18, 147, 17, 15, 16, 14, 13, 746,
+	8, 12, 11, 10, 9, 77, 353, 7, 6, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 80, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 69,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 76, 0, 79,
 }
 
 var yyPact = [...]int16{
-	1070, -1000, 402, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1168, -1000, 481, -1000, 514, 514, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 573,
-	920, 923, 740, 861, 744, 230, 229, 613, 544, 480,
-	1070, 867, 805, 437, 277, 127, 925, 288, 925, -1000,
-	-1000, 169, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	430, 566, 701, 618, -1000, 587, 927, 594, 671, 565,
-	922, 513, 521, 906, 904, -1000, -1000, -1000, 870, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 268, 698,
-	267, 72, 477, 495, -22, -22, 266, 861, 695, 265,
-	102, 264, 472, 903, 896, -22, 516, -22, 860, -1000,
-	23, 951, 694, 72, 804, 895, 816, 894, 873, -1000,
-	664, 263, 98, -1000, 918, 848, 23, 910, 805, 615,
-	-21, 925, 925, 925, 925, 925, 925, 925, 925, -86,
-	-13, 153, 261, -1000, 644, 647, 647, 951, -1000, 756,
-	877, 260, 889, 861, 571, 877, 877, 616, 550, 121,
-	877, 548, 259, 570, 877, 72, -1000, -1000, 257, -22,
-	256, 540, 254, 772, 397, 303, 253, -1000, -1000, -1000,
-	252, 248, 805, 910, -1000, -1000, 888, -1000, 860, -1000,
-	247, -1000, -1000, 302, 246, 245, 244, -1000, 887, 886,
-	-1000, -1000, 519, 512, -1000, -1000, 542, -87, -1000, 951,
-	214, 396, 779, 393, 392, -1000, -1000, 192, -78, 243,
-	767, 242, 807, 239, 235, 233, 878, 231, 224, -1000,
-	221, -22, -1000, 860, 443, 849, -1000, 918, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -74, -74, -74, -1000, -1000,
-	-74, -1000, 371, -1000, -1000, -1000, -1000, -1000, -1000, 925,
-	643, -1000, 24, 913, 844, 765, -1000, 217, 860, 844,
-	877, 861, 861, 774, 569, 877, 555, 877, 301, 115,
-	847, 552, 877, -1000, 877, 861, -1000, -1000, -1000, 318,
-	498, -1000, 980, 91, 458, 617, 885, 717, 764, -22,
-	39, 300, 884, 292, 370, 882, -22, -1000, 881, 213,
-	880, 298, -1000, -22, -22, 23, 212, 23, 794, 335,
-	369, 951, 951, -86, -36, 391, 785, 873, 390, -22,
-	-22, 976, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 879, 545, 792, 209, 208, -1000, 791, 917, 205,
-	204, -1000, 916, 316, 314, 848, 775, -45, -45, 860,
-	-1000, -8, 200, 925, 90, 829, 846, 912, -1000, 844,
-	829, 861, 860, 848, 860, 844, 763, 608, 877, 769,
-	877, 861, 108, 293, 199, 844, 829, 877, 861, 861,
-	860, 848, 45, -1000, -1000, 980, -1000, 36, 89, 198,
-	75, -1000, 131, 692, 691, 690, 688, 628, 68, 179,
-	197, -46, -1000, -1000, 728, -1000, -22, 332, 22, 291,
-	-23, -1000, -23, 196, 805, 194, 762, 873, 294, 177,
-	-1000, 176, 175, -1000, 285, -1000, 436, -1000, 23, 852,
-	-1000, -1000, -1000, -1000, 158, 386, 366, 873, 435, 432,
-	-1000, 951, 174, 131, 173, 789, -1000, 172, 167, 915,
-	-1000, 166, -54, 33, 443, 844, 384, -1000, 429, 274,
-	382, 272, -1000, -1000, 848, -1000, 639, -78, 860, 165,
-	157, 323, 323, -1000, 823, -58, -58, 132, 90, 829,
-	-1000, 860, 848, 848, 829, 844, 829, 603, 103, 748,
-	757, 602, 861, 860, 848, 281, 156, 155, -1000, 829,
-	-1000, 861, 860, 848, 860, 848, 848, 829, -85, -96,
-	-1000, -1000, -1000, -1000, -1000, 410, -1000, -1000, 18, 15,
-	14, 13, -1000, -1000, -1000, -1000, 686, 752, 511, 499,
-	313, -1000, -1000, -1000, -1000, 614, -23, -1000, -1000, -1000,
-	493, 363, 381, 683, 473, -22, 715, -1000, -1000, -1000,
-	-22, 23, 876, 151, 362, 361, 207, -1000, 360, -22,
-	-22, -38, 980, 471, -1000, 150, -1000, -1000, 149, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 775, 829, -94, -45,
-	622, 12, 619, 443, -1000, 844, -1000, -1000, -1000, -1000,
-	-1000, 64, 48, 831, -1000, -1000, -1000, -1000, 425, 422,
-	-1000, -1000, 848, 829, 829, -1000, 829, -1000, 103, 860,
-	129, 129, 378, 323, 323, 751, 593, 588, 103, 860,
-	848, 848, 829, 148, -1000, -1000, -1000, 860, 848, 848,
-	829, 848, 829, 829, -1000, 146, 144, 131, -1000, -1000,
-	-1000, -1000, 681, 9, 543, 541, 116, 541, 152, 722,
-	-1000, -1000, 641, 534, 749, 805, -1000, 7, -3, 448,
-	-22, -1000, -1000, -1000, -1000, 951, -1000, -1000, -1000, 359,
-	358, 418, -1000, 357, 353, -1000, -1000, -1000, 133, -1000,
-	-1000, 844, 124, 351, -1000, -1000, -1000, -94, -1000, -1000,
-	328, -1000, 775, 829, 817, -1000, -58, 132, -1000, -1000,
-	829, -1000, -1000, -1000, 860, 844, -1000, 417, -1000, -1000,
-	129, -1000, -1000, 579, 103, 103, 860, 848, 829, 829,
-	-1000, -1000, 848, 829, 829, -1000, 829, -1000, -1000, 311,
-	310, -1000, -1000, 652, 806, 800, 522, 131, -1000, 116,
-	507, 501, 522, -1000, 389, -1000, -1000, 873, -4, -10,
-	683, 350, 487, -1000, 715, -1000, 415, -87, -1000, -1000,
-	130, -1000, -1000, -1000, 829, -1000, 377, -1000, -1000, -1000,
-	-93, 844, -1000, 47, -1000, -1000, -1000, 844, 829, 129,
-	347, 103, 860, 860, 848, 829, -1000, -1000, 829, -1000,
-	-1000, -1000, 40, 126, -37, -1000, -1000, 674, 63, 410,
-	-1000, 119, 119, 674, -17, 635, 655, -1000, -1000, 733,
-	375, -22, -22, -1000, 124, -90, 346, -39, 829, -1000,
-	829, -1000, -1000, -1000, 860, 848, 848, 829, -1000, -1000,
-	-1000, -1000, 672, -1000, -1000, -1000, -1000, 409, -1000, 538,
-	341, -1000, -43, 683, -57, -1000, -1000, -1000, 339, -1000,
-	338, 124, -1000, 848, 829, 829, -1000, -1000, 672, 119,
-	532, -1000, 119, 116, -1000, -1000, 337, 406, -1000, -1000,
-	-1000, 829, -1000, -1000, -1000, -1000, 530, -1000, 119, -1000,
-	-1000, 485, -57, -1000, 528, -1000, -22, -1000, 374, -1000,
-	-1000, 112, -1000, 404, 137, -57, -1000, -22, -47, 330,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 634, 976,
+	885, 1108, 990, 873, 239, 228, 271, 733, 636, 612,
+	570, 1168, 1005, 846, 1005, 992, 969, 512, 349, 152,
+	46, 373, 46, -1000, -1000, 247, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 548, 659, 814, 725, -1000, -1000,
+	749, 1077, 693, 778, 677, 1076, 599, 614, 1049, 1048,
+	-1000, 595, -1000, -1000, 1003, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 330, 826, 329, 328, 143, 569,
+	564, -17, -17, 327, 990, 823, 325, 322, 149, 321,
+	568, 1047, 1046, -17, 603, -17, 1002, -1000, -2, 1007,
+	812, 143, 1045, 913, 320, -1000, 1044, 961, 319, 1043,
+	946, 1005, -1000, 770, 317, 563, 148, -1000, -1000, -1000,
+	-1000, 1070, 978, -2, 1057, 969, 708, 58, 46, 46,
+	46, 46, 46, 46, 46, 46, -86, 3, 194, 315,
+	-1000, 748, 752, 752, 1007, -1000, 884, 1013, 314, 1041,
+	990, 651, 1013, 1013, 723, 675, 176, 1013, 673, 313,
+	649, 1013, 143, -1000, -1000, 312, -17, -1000, 311, 629,
+	309, 881, -1000, 476, 376, 308, -1000, -1000, -1000, 307,
+	306, 969, 1057, -1000, -1000, 1039, -1000, 1002, -1000, 305,
+	-1000, -1000, -1000, 375, 302, 301, 300, -1000, 1038, 1037,
+	-1000, -1000, 610, 531, -1000, -1000, 755, -87, -1000, 1007,
+	299, 475, 887, 474, 473, -1000, -1000, 192, -78, 297,
+	880, 294, 916, 293, 912, 292, 1075, 291, 1071, 290,
+	-1000, -1000, 288, -17, 284, -1000, 1002, 502, 975, -1000,
+	1070, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -118, -118,
+	-118, -1000, -1000, -118, -1000, 446, 520, -1000, -1000, -1000,
+	-1000, -1000, 46, 747, -1000, 4, 1064, 966, 878, -1000,
+	283, 1002, 966, 1013, 990, 990, 894, 646, 1013, 642,
+	1013, 362, 173, 979, 633, 1013, -1000, 1013, 990, -1000,
+	-1000, -1000, 397, 594, -1000, 813, 146, 550, 704, 1029,
+	842, 877, -17, -33, 360, 1024, 381, 443, 1021, -17,
+	-1000, 1019, 282, 1017, 358, -1000, -17, -17, -2, 281,
+	-2, -2, 931, 937, 631, 920, 935, 414, 442, 1007,
+	1007, -86, -36, 472, 896, 1005, 464, -17, -17, 1025,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1016,
+	640, 469, 280, -1000, 278, 487, 277, -1000, 274, 429,
+	396, 395, 1010, 978, 888, -49, -49, 1002, 517, 463,
+	8, 273, 46, 109, 962, 973, 1062, -1000, 966, 962,
+	990, 1002, 978, 1002, 966, 876, 694, 1013, 893, 1013,
+	990, 145, 357, 272, 966, 962, 1013, 990, 990, 1002,
+	978, 104, -1000, -1000, 813, -1000, 90, 136, 267, 132,
+	-1000, 196, 809, 808, 807, 806, 728, 122, 238, 266,
+	-26, -1000, -1000, 853, -1000, -17, 410, 57, 356, 25,
+	-1000, 25, 260, 969, 259, 874, 1005, 385, 258, -1000,
+	257, 256, -1000, 354, -1000, 507, -1000, 1008, -1000, 932,
+	-1000, -1000, 926, 921, -1000, 895, -1000, 981, -1000, -1000,
+	-1000, -1000, 125, 461, 441, 1005, 503, 500, -1000, 1007,
+	253, 196, 252, 251, -1000, -1000, 249, 240, -1000, -1000,
+	235, 233, -28, 65, -30, 232, 502, 966, 460, -1000,
+	497, 338, 459, 334, -1000, -1000, 978, 456, 560, -1000,
+	739, -78, 1002, 230, 226, 386, 386, -1000, 956, -96,
+	-96, 197, 109, 962, -1000, 1002, 978, 978, 962, 966,
+	962, 690, 214, 879, 872, 686, 990, 1002, 978, 352,
+	225, 221, -1000, 962, -1000, 990, 1002, 978, 1002, 978,
+	978, 962, -94, -104, -1000, -1000, -1000, -1000, -1000, 488,
+	-1000, -1000, 89, 85, 84, 80, -1000, -1000, -1000, -1000,
+	801, 871, 597, 596, 394, -1000, -1000, -1000, -1000, 699,
+	25, -1000, -1000, -1000, 585, 439, 453, 800, 574, -17,
+	852, -1000, -1000, -1000, -17, -2, 1007, -1000, -1000, -1000,
+	-1000, 219, 434, 432, 270, -1000, 431, -17, -17, -42,
+	813, 562, -1000, 911, -1000, 1069, -1000, 904, -1000, -1000,
+	-1000, -1000, -1000, -1000, 903, 1068, 888, 962, -93, -49,
+	715, 62, 713, 502, 560, 430, 263, 972, -1000, 966,
+	-1000, -1000, -1000, -1000, -1000, 120, 117, 959, -1000, -1000,
+	-1000, -1000, 495, 511, -1000, -1000, 978, 962, 962, -1000,
+	962, -1000, 214, 1002, 180, 180, 451, 386, 386, 870,
+	685, 682, 214, 1002, 978, 978, 962, 215, -1000, -1000,
+	-1000, 1002, 978, 978, 962, 978, 962, 962, -1000, 211,
+	206, 196, -1000, -1000, -1000, -1000, 796, 61, 637, 639,
+	153, 639, 200, 858, -1000, -1000, 729, 626, 869, 969,
+	-1000, 36, 34, 537, -17, -1000, -1000, -1000, -1000, -66,
+	-1000, -1000, -1000, 428, 426, 492, -1000, 425, 423, -1000,
+	-1000, -1000, 203, 202, 201, 198, -34, -50, 966, 177,
+	422, -1000, -1000, -1000, -93, -1000, -1000, 404, -1000, 888,
+	419, -1000, -1000, 968, 42, 160, 962, 951, -1000, -96,
+	197, -1000, -1000, 962, -1000, -1000, -1000, 1002, 966, -1000,
+	491, -1000, -1000, 180, -1000, -1000, 668, 214, 214, 1002,
+	978, 962, 962, -1000, -1000, 978, 962, 962, -1000, 962,
+	-1000, -1000, 393, 384, -1000, -1000, 760, 945, 940, 606,
+	196, -1000, 153, 592, 591, 606, -1000, 467, -1000, -1000,
+	1005, 24, 13, 800, 418, 579, -1000, 852, -1000, 490,
+	-3, -1000, -1000, 195, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 899, 962, -1000, 450, -1000, -1000, -1000, -101, 966,
+	-1000, 193, -1000, 29, 189, 188, -1000, 489, -1000, 94,
+	-1000, -1000, -1000, 966, 962, 180, 417, 214, 1002, 1002,
+	978, 962, -1000, -1000, 962, -1000, -1000, -1000, 9, 178,
+	-85, -1000, -1000, 788, 93, 488, -1000, 160, 160, 788,
+	-7, 732, 763, -1000, -1000, 868, 449, -17, -17, -1000,
+	-1000, -54, 177, -64, 416, -9, 962, 70, -107, 174,
+	-1000, -1000, 160, -1000, 962, -1000, -1000, -1000, 1002, 978,
+	978, 962, -1000, -1000, -1000, -1000, 775, -1000, -1000, -1000,
+	-1000, -1000, 632, 415, -1000, -18, 800, -20, -1000, -1000,
+	-1000, -1000, 413, -1000, 406, 177, -1000, 175, 175, -43,
+	-1000, -1000, -1000, 978, 962, 962, -1000, -1000, 775, 625,
+	-1000, 160, 153, -1000, -1000, 405, 486, -1000, -1000, -1000,
+	-1000, -1000, -1000, 174, 962, -1000, -1000, -1000, 623, -1000,
+	160, -1000, -1000, 577, -20, -1000, 621, -1000, -17, -1000,
+	448, -1000, -1000, 144, -1000, 483, 383, -20, -1000, -17,
+	-56, 204, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 705, 1135, 1134, 1133, 1131, 20, 1130, 1129, 1126,
-	1125, 1117, 1114, 1113, 1112, 1111, 1109, 1108, 1106, 1104,
-	1102, 1101, 1092, 1091, 1090, 1087, 1086, 16, 1085, 1084,
-	1083, 1079, 1076, 1075, 1074, 1072, 1066, 1064, 1060, 1059,
-	1058, 1057, 1055, 1052, 1051, 1050, 6, 1049, 1048, 1047,
-	1044, 1043, 1033, 1026, 1025, 1023, 1017, 1015, 1013, 1011,
-	1007, 1000, 42, 18, 999, 998, 40, 54, 45, 39,
-	46, 997, 33, 996, 41, 34, 32, 992, 990, 27,
-	989, 987, 30, 38, 15, 986, 43, 985, 984, 23,
-	12, 983, 11, 29, 36, 982, 13, 3, 980, 24,
-	25, 9, 7, 976, 31, 461, 975, 61, 10, 28,
-	0, 972, 17, 970, 21, 26, 4, 968, 961, 14,
-	959, 958, 2, 956, 955, 954, 8, 946, 5, 945,
-	944, 943, 1, 22, 19, 37, 942, 941, 35, 47,
-	935, 933, 932, 929,
+	0, 724, 1248, 1247, 1246, 1244, 19, 1243, 1242, 1241,
+	1240, 1239, 1238, 1237, 1236, 1235, 1234, 1232, 1231, 1229,
+	1228, 1227, 1226, 1224, 1223, 1222, 1220, 14, 1219, 1218,
+	1217, 1216, 1215, 1214, 1212, 1211, 1207, 1206, 1205, 1204,
+	1203, 1202, 1200, 1199, 1197, 1195, 11, 1193, 1192, 1191,
+	1190, 1188, 1184, 1178, 1177, 1175, 1174, 1173, 1172, 1170,
+	1160, 1158, 1157, 1156, 1155, 1153, 1151, 1147, 1146, 1145,
+	27, 15, 1144, 1142, 45, 60, 37, 41, 53, 1141,
+	44, 1140, 47, 38, 36, 1139, 1138, 32, 1137, 1135,
+	39, 43, 24, 1134, 48, 1132, 733, 1131, 1128, 26,
+	58, 1122, 9, 35, 31, 1120, 12, 1, 1118, 22,
+	23, 3, 8, 1117, 33, 151, 1116, 42, 17, 29,
+	0, 1115, 18, 1114, 21, 28, 4, 1111, 1109, 13,
+	1108, 1107, 2, 1106, 1105, 1103, 7, 1101, 6, 1100,
+	1099, 1096, 5, 25, 20, 40, 1091, 1090, 34, 30,
+	1089, 1065, 16, 10, 1087, 46, 1086, 1085, 1084, 1082,
 }
 
 var yyR1 = [...]uint8{
-	0, 65, 66, 66, 66, 1, 1, 1, 1, 1,
+	0, 73, 74, 74, 74, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	6, 6, 6, 62, 62, 64, 64, 64, 64, 64,
-	64, 86, 86, 85, 63, 63, 82, 82, 82, 82,
-	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
-	82, 82, 70, 70, 67, 68, 68, 68, 68, 68,
-	68, 68, 71, 69, 69, 69, 73, 74, 74, 74,
-	74, 74, 72, 72, 72, 92, 92, 93, 93, 94,
-	94, 110, 110, 95, 95, 95, 95, 95, 95, 95,
-	95, 126, 126, 99, 99, 100, 100, 100, 100, 76,
-	76, 78, 78, 77, 77, 79, 79, 79, 79, 79,
-	79, 79, 79, 79, 79, 80, 83, 83, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 105, 81, 81,
-	81, 81, 81, 81, 81, 81, 81, 81, 88, 88,
-	88, 90, 90, 89, 89, 91, 91, 91, 96, 133,
-	133, 97, 97, 97, 97, 98, 98, 98, 98, 2,
-	2, 3, 3, 139, 139, 139, 139, 139, 135, 135,
-	4, 104, 104, 103, 103, 103, 103, 103, 103, 103,
-	7, 7, 8, 8, 75, 75, 75, 75, 9, 9,
-	10, 10, 5, 5, 5, 11, 11, 101, 101, 102,
-	102, 102, 102, 12, 12, 13, 15, 14, 14, 16,
-	16, 17, 18, 20, 20, 20, 22, 22, 21, 21,
-	21, 23, 23, 19, 24, 24, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 53, 53, 53, 53, 53,
-	107, 107, 25, 25, 26, 26, 27, 27, 27, 27,
-	27, 84, 84, 106, 28, 28, 29, 29, 29, 29,
-	30, 30, 30, 30, 31, 31, 31, 31, 32, 32,
-	140, 140, 141, 129, 129, 130, 130, 130, 115, 115,
-	134, 134, 134, 142, 142, 143, 120, 120, 121, 121,
-	125, 125, 113, 113, 52, 52, 138, 138, 136, 136,
-	137, 137, 137, 127, 127, 128, 128, 116, 116, 108,
-	108, 117, 118, 122, 122, 124, 123, 123, 123, 114,
-	114, 109, 33, 34, 35, 36, 36, 36, 36, 37,
-	37, 37, 37, 38, 38, 39, 39, 40, 41, 41,
-	42, 131, 131, 131, 131, 43, 44, 45, 45, 45,
-	47, 47, 47, 47, 48, 48, 46, 132, 132, 49,
-	49, 50, 50, 51, 54, 55, 119, 119, 112, 112,
-	59, 59, 60, 61, 61, 61, 61, 56, 57, 57,
-	57, 57, 57, 58, 58, 58, 58, 58,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 6,
+	6, 6, 70, 70, 72, 72, 72, 72, 72, 72,
+	94, 94, 93, 71, 71, 90, 90, 90, 90, 90,
+	90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
+	90, 90, 90, 149, 149, 149, 149, 154, 154, 152,
+	152, 152, 153, 153, 153, 78, 78, 150, 150, 151,
+	151, 75, 76, 76, 76, 76, 76, 76, 76, 79,
+	79, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	77, 77, 77, 81, 82, 82, 82, 82, 82, 80,
+	80, 80, 102, 102, 103, 103, 104, 104, 120, 120,
+	105, 105, 105, 105, 105, 105, 105, 105, 136, 136,
+	109, 109, 110, 110, 110, 110, 84, 84, 86, 86,
+	85, 85, 87, 87, 87, 87, 87, 87, 87, 87,
+	87, 87, 88, 91, 91, 95, 95, 95, 95, 95,
+	95, 95, 95, 95, 115, 89, 89, 89, 89, 89,
+	89, 89, 89, 89, 89, 98, 98, 98, 100, 100,
+	99, 99, 101, 101, 101, 106, 143, 143, 107, 107,
+	107, 107, 108, 108, 108, 108, 2, 2, 3, 3,
+	155, 155, 155, 155, 155, 145, 145, 4, 114, 114,
+	113, 113, 113, 113, 113, 113, 113, 7, 7, 8,
+	8, 83, 83, 83, 83, 9, 9, 10, 10, 5,
+	5, 5, 11, 11, 111, 111, 112, 112, 112, 112,
+	12, 12, 13, 15, 14, 14, 16, 16, 17, 18,
+	96, 96, 96, 20, 20, 22, 22, 21, 21, 62,
+	62, 23, 23, 19, 63, 64, 65, 66, 67, 24,
+	24, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	53, 53, 53, 53, 53, 117, 117, 25, 25, 26,
+	26, 27, 27, 27, 27, 27, 92, 92, 116, 28,
+	28, 29, 29, 29, 29, 30, 30, 30, 30, 31,
+	31, 31, 31, 32, 32, 156, 156, 157, 139, 139,
+	140, 140, 140, 125, 125, 144, 144, 144, 158, 158,
+	159, 130, 130, 131, 131, 135, 135, 123, 123, 52,
+	52, 148, 148, 146, 146, 147, 147, 147, 137, 137,
+	138, 138, 126, 126, 118, 118, 127, 128, 132, 132,
+	134, 133, 133, 133, 124, 124, 119, 33, 34, 35,
+	36, 36, 36, 36, 37, 37, 37, 37, 38, 38,
+	39, 39, 40, 41, 41, 42, 141, 141, 141, 141,
+	43, 44, 69, 69, 45, 45, 45, 47, 47, 47,
+	47, 48, 48, 46, 142, 142, 49, 49, 50, 50,
+	51, 54, 68, 55, 129, 129, 122, 122, 59, 59,
+	60, 61, 61, 61, 61, 56, 57, 57, 57, 57,
+	57, 58, 58, 58, 58, 58,
 }
 
 var yyR2 = [...]int8{
@@ -749,141 +862,156 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	11, 12, 9, 1, 3, 1, 3, 3, 1, 3,
-	3, 1, 2, 4, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 3, 2, 1, 1,
-	5, 6, 2, 0, 2, 1, 3, 1, 3, 3,
-	5, 1, 6, 3, 5, 3, 1, 5, 4, 4,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 1,
-	3, 1, 1, 1, 3, 4, 6, 7, 1, 3,
-	1, 4, 0, 4, 0, 1, 1, 1, 2, 2,
-	0, 1, 3, 1, 3, 1, 3, 5, 5, 4,
-	6, 6, 5, 6, 6, 3, 1, 3, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 11,
+	12, 9, 1, 3, 1, 3, 3, 1, 3, 3,
+	1, 2, 4, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 4, 3, 8, 7, 2, 1,
+	1, 5, 6, 2, 5, 6, 6, 3, 0, 2,
+	5, 0, 2, 2, 2, 2, 0, 3, 3, 2,
+	1, 2, 1, 3, 1, 3, 3, 5, 1, 5,
+	7, 2, 3, 2, 2, 3, 2, 3, 2, 3,
+	3, 5, 3, 1, 5, 4, 4, 3, 1, 1,
+	1, 1, 3, 0, 2, 0, 1, 3, 1, 1,
+	1, 3, 4, 6, 7, 1, 3, 1, 4, 0,
+	4, 0, 1, 1, 1, 2, 2, 0, 1, 3,
+	1, 3, 1, 3, 5, 5, 4, 6, 6, 5,
+	6, 6, 3, 1, 3, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	1, 3, 0, 1, 3, 1, 2, 2, 2, 1,
-	1, 4, 2, 2, 0, 4, 2, 2, 0, 2,
-	3, 5, 4, 2, 1, 3, 3, 0, 3, 3,
-	2, 1, 2, 1, 2, 2, 2, 2, 1, 2,
-	9, 6, 7, 4, 2, 2, 2, 2, 5, 3,
-	7, 8, 6, 9, 9, 5, 4, 1, 2, 3,
-	3, 3, 3, 7, 6, 2, 3, 4, 3, 3,
-	2, 7, 6, 6, 7, 6, 5, 4, 6, 7,
-	6, 5, 4, 3, 8, 7, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 8, 7, 7, 6,
-	2, 0, 7, 6, 11, 10, 2, 2, 4, 2,
-	2, 1, 3, 1, 3, 2, 10, 9, 9, 8,
-	13, 12, 12, 11, 10, 9, 9, 8, 5, 5,
-	0, 6, 10, 0, 2, 0, 2, 6, 0, 2,
-	0, 2, 2, 0, 3, 3, 0, 1, 0, 1,
-	0, 1, 0, 2, 2, 0, 2, 1, 2, 2,
-	2, 3, 2, 3, 3, 2, 0, 1, 3, 2,
-	0, 2, 2, 3, 1, 2, 3, 3, 0, 1,
-	3, 1, 3, 6, 4, 9, 8, 8, 7, 9,
-	8, 8, 7, 2, 4, 7, 3, 3, 3, 5,
-	10, 3, 3, 5, 0, 3, 6, 9, 11, 7,
-	4, 6, 2, 4, 2, 4, 10, 1, 3, 8,
-	6, 2, 4, 3, 2, 3, 1, 3, 1, 1,
-	10, 8, 2, 3, 5, 7, 5, 2, 6, 6,
-	6, 6, 6, 2, 6, 6, 10, 10,
+	1, 1, 1, 3, 1, 1, 1, 1, 3, 0,
+	1, 3, 1, 2, 2, 2, 1, 1, 4, 2,
+	2, 0, 4, 2, 2, 0, 2, 3, 5, 4,
+	2, 1, 3, 3, 0, 3, 3, 2, 1, 2,
+	1, 2, 2, 2, 2, 1, 2, 9, 6, 7,
+	4, 2, 2, 2, 2, 5, 3, 7, 8, 6,
+	9, 9, 5, 4, 1, 2, 3, 3, 3, 3,
+	7, 6, 2, 3, 4, 3, 3, 2, 7, 6,
+	1, 2, 1, 6, 8, 5, 4, 6, 8, 6,
+	8, 5, 4, 3, 3, 3, 2, 5, 5, 8,
+	7, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	4, 8, 7, 7, 6, 2, 0, 7, 6, 11,
+	10, 2, 2, 4, 2, 2, 1, 3, 1, 3,
+	2, 10, 9, 9, 8, 13, 12, 12, 11, 10,
+	9, 9, 8, 5, 5, 0, 6, 10, 0, 2,
+	0, 2, 6, 0, 2, 0, 2, 2, 0, 3,
+	3, 0, 1, 0, 1, 0, 1, 0, 2, 2,
+	0, 2, 1, 2, 2, 2, 3, 2, 3, 3,
+	2, 0, 1, 3, 2, 0, 2, 2, 3, 1,
+	2, 3, 3, 0, 1, 3, 1, 3, 6, 4,
+	9, 8, 8, 7, 9, 8, 8, 7, 2, 4,
+	7, 3, 3, 3, 5, 10, 3, 3, 5, 0,
+	3, 6, 8, 10, 9, 11, 7, 4, 6, 2,
+	4, 2, 4, 10, 1, 3, 8, 6, 2, 4,
+	3, 2, 3, 3, 1, 3, 1, 1, 10, 8,
+	2, 3, 5, 7, 5, 2, 6, 6, 6, 6,
+	6, 2, 6, 6, 10, 10,
 }
 
 var yyChk = [...]int16{
-	-1000, -65, -66, -1, -6, -2, -3, -10, -5, -7,
-	-8, -9, -12, -13, -15, -14, -16, -17, -18, -20,
-	-22, -23, -21, -19, -24, -25, -26, -28, -29, -30,
-	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	-41, -42, -43, -44, -45, -47, -48, -49, -50, -51,
-	-53, -54, -55, -59, -60, -61, -56, -57, -58, 8,
-	18, 19, 62, 30, 40, 53, 28, 77, 57, 98,
-	128, -62, 147, -64, 155, -82, 129, 142, 152, -81,
-	144, 63, 146, 143, 145, 69, 70, -105, 148, 131,
-	43, 45, 46, 61, 42, 71, -111, 73, 59, 5,
-	90, 51, 86, 102, 107, 88, 92, 116, 117, 82,
-	83, 84, 81, 32, 121, 122, 85, 142, 44, 46,
-	41, 5, 86, 101, 105, 93, 44, 61, 46, 41,
-	51, 5, 86, 101, 102, 105, 35, 93, -67, -76,
-	4, 9, 46, 5, 35, 142, 35, 142, 78, -6,
-	37, 115, 108, -1, -70, -76, 6, -62, 127, 139,
-	10, 155, 156, 151, 152, 154, 157, 158, 153, -82,
-	129, 139, 138, -82, -86, 142, -85, 64, 119, -107,
-	119, 7, 47, -107, 79, 80, 74, 75, 76, 4,
-	74, 76, 58, 79, 80, 4, 94, 88, 7, 7,
-	9, 142, 48, 142, -74, 142, 138, -72, 145, -105,
-	108, 7, 129, -110, 142, 145, -110, 142, -67, -76,
-	48, 142, 143, 142, 108, 7, 7, -110, 92, -110,
-	-76, -68, -73, -69, -71, -74, 129, -79, -77, 129,
-	142, 27, 26, 112, 114, -78, -80, -83, -82, 48,
-	-74, 7, 21, 24, 7, 7, 21, 4, 7, -6,
-	58, 142, 143, -67, -92, 11, -68, -70, -62, 71,
-	73, 142, 145, -82, -82, -82, -82, -82, -82, -82,
-	-82, 130, -62, 130, -88, 142, 71, 73, 142, 66,
-	-86, -86, -79, 31, -76, -107, 142, 7, -67, -76,
-	80, -107, -107, -107, 79, 80, 79, 80, 142, 138,
-	-107, 79, 80, 142, 80, -107, -74, 142, -110, 142,
-	-4, -139, 31, 118, -135, 71, 142, 31, -52, 129,
-	138, 142, 142, 142, -62, -70, 7, -76, 142, 138,
-	142, 142, 142, 7, 7, 127, 10, 127, 20, -66,
-	-69, 149, 150, -82, -79, 25, 26, 129, 27, 129,
-	129, -87, 132, 133, 134, 135, 136, 137, 141, 140,
-	113, 142, 31, 142, 7, 24, 142, 142, 142, 7,
-	4, 142, 142, 142, -110, -76, -93, 124, 12, -67,
-	130, -82, 66, 65, 5, -90, 13, 31, 142, -76,
-	-90, -107, -67, -76, -67, -76, -67, 31, 80, -107,
-	80, -107, 138, 142, 138, -67, -90, 80, -107, -107,
-	-67, -76, 132, -139, -104, -103, -102, 49, 60, 38,
-	39, 50, 81, 51, 54, 55, 52, 143, 118, 72,
-	7, 37, -140, -141, 31, -138, -136, -137, -110, 142,
-	138, -72, 138, 7, 129, 138, 130, 7, -110, 7,
-	142, 7, 138, -110, -110, -68, 142, -68, 23, 130,
-	130, -79, -79, 130, 129, 25, -6, 129, -110, -110,
-	-83, 129, 7, 81, 24, 142, 142, 24, 4, 142,
-	142, 4, 132, 132, -92, -99, 29, -94, -95, -110,
-	142, 155, -105, -94, -76, 68, 142, -82, -75, 132,
-	133, 141, 140, -96, -97, 14, 15, 12, 5, -90,
-	-97, -67, -76, -76, -92, -76, -90, 31, 76, -107,
-	-67, 31, -107, -67, -76, 142, 138, 138, 142, -90,
-	-97, -107, -67, -76, -67, -76, -76, -92, 142, 143,
-	-104, 144, 143, 142, 143, -114, -109, 142, 49, 49,
-	49, 49, -135, 143, 142, 50, 142, 145, -142, -143,
-	32, -138, 127, 130, 71, -110, 138, -72, 142, -72,
-	142, -62, 142, 31, -6, 138, 120, 142, 142, 142,
-	138, 127, -68, 10, -62, -6, 129, 130, -6, 127,
-	127, -79, 142, -114, 142, 24, 142, 142, 4, 142,
-	145, -110, 143, 146, 69, 70, -93, -90, 129, 127,
-	139, 129, 139, -92, 68, -76, 142, 142, -105, -105,
-	-98, 16, 17, -133, 143, 148, -133, -89, -91, 142,
-	-75, -97, -76, -92, -92, -97, -90, -96, 76, -27,
-	132, 133, 25, 141, 140, -67, 31, 31, 76, -67,
-	-76, -76, -92, 138, 142, 142, -97, -67, -76, -76,
-	-92, -76, -92, -92, -97, 149, 149, 127, 144, 144,
-	144, 144, -11, 49, 31, -129, 95, -130, 95, 132,
-	73, -72, -131, 100, 130, 129, -46, 49, 106, -110,
-	-112, 35, 36, -110, -68, 7, 142, 130, 130, -6,
-	-63, 142, 130, -110, -110, 130, -104, -108, 56, 142,
-	142, -99, -96, -100, 142, 143, 146, 152, -94, 71,
-	144, 71, -93, -90, 143, 143, 15, 127, 125, 126,
-	-92, -97, -97, -96, -27, -76, -84, -106, 142, -84,
-	129, -105, -105, 31, 76, 76, -27, -76, -92, -92,
-	-97, 142, -76, -92, -92, -97, -92, -97, -97, 142,
-	142, -109, 50, 144, 35, 109, -115, 81, -128, -127,
-	142, 73, -115, -128, 142, 34, 33, 67, 99, 58,
-	31, -62, 144, 144, 120, -119, -110, -79, 130, 130,
-	127, 130, 130, 142, -90, -126, 142, 130, -100, 130,
-	127, -99, -96, 17, -133, -89, -97, -76, -90, 127,
-	-84, 76, -27, -27, -76, -92, -97, -97, -92, -97,
-	-97, -97, 132, 132, 60, 21, 21, -134, 90, -114,
-	-128, 96, 96, -134, 129, -6, 144, 144, -46, 130,
-	103, -112, 127, -63, -96, 129, 144, 152, -90, 143,
-	-90, -97, -84, 130, -27, -76, -76, -92, -97, -97,
-	143, 142, 143, -108, 123, 143, -116, 142, -116, -108,
-	144, 68, 58, 31, 129, -119, -119, -126, 145, 130,
-	144, -96, -97, -76, -92, -92, -97, -101, -102, 127,
-	-120, -117, 82, 130, 144, -46, -132, 144, 130, 130,
-	-126, -92, -97, -97, -101, -116, -121, -118, 83, -116,
-	-128, 130, 127, -97, -125, -124, 84, -116, 104, -132,
-	-113, 85, -122, -123, -110, 129, 142, 127, 132, -132,
-	-122, -110, 143, 130,
+	-1000, -73, -74, -1, -6, -150, -2, -3, -10, -5,
+	-7, -8, -9, -12, -13, -15, -14, -16, -17, -18,
+	-20, -22, -23, -21, -62, -63, -64, -65, -66, -67,
+	-19, -24, -25, -26, -28, -29, -30, -31, -32, -33,
+	-34, -35, -36, -37, -38, -39, -40, -41, -42, -43,
+	-44, -45, -47, -48, -49, -50, -51, -53, -54, -68,
+	-69, -55, -59, -60, -61, -56, -57, -58, 8, 18,
+	19, 62, 30, 40, 53, 28, 127, 77, 57, 129,
+	98, 140, -151, 136, -151, -70, 159, -72, 167, -90,
+	141, 154, 164, -89, 156, 63, 158, 155, 157, 69,
+	70, -115, 160, 143, 43, 45, 46, 61, 42, 126,
+	71, -121, 73, 59, 5, 90, 51, 86, 102, 107,
+	88, 128, 92, 116, 117, 82, 83, 84, 81, 32,
+	121, 122, 85, 154, 44, 46, 41, 125, 5, 86,
+	101, 105, 93, 44, 61, 46, 41, 125, 51, 5,
+	86, 101, 102, 105, 35, 93, -75, -84, 4, 9,
+	46, 5, -96, 35, 125, 154, -96, 35, 125, -96,
+	35, 78, -6, 37, 115, 86, 108, -1, -6, 35,
+	-6, -78, -84, 6, -70, 139, 151, 10, 167, 168,
+	163, 164, 166, 169, 170, 165, -90, 141, 151, 150,
+	-90, -94, 154, -93, 64, 119, -117, 119, 7, 47,
+	-117, 79, 80, 74, 75, 76, 4, 74, 76, 58,
+	79, 80, 4, 94, 88, 7, 7, 94, 9, 154,
+	48, 154, 154, -82, 154, 150, -80, 157, -115, 108,
+	7, 141, -120, 154, 157, -120, 154, -75, -84, 48,
+	154, 154, 155, 154, 108, 7, 7, -120, 92, -120,
+	-84, -76, -81, -77, -79, -82, 141, -87, -85, 141,
+	154, 27, 26, 112, 114, -86, -88, -91, -90, 48,
+	-82, 7, 21, 24, 154, 7, 21, 4, 154, 7,
+	21, -6, 58, 154, 108, 155, -75, -102, 11, -76,
+	-78, -70, 71, 73, 154, 157, -90, -90, -90, -90,
+	-90, -90, -90, -90, 142, -70, 142, -98, 154, 71,
+	73, 154, 66, -94, -94, -87, 31, -84, -117, 154,
+	7, -75, -84, 80, -117, -117, -117, 79, 80, 79,
+	80, 154, 150, -117, 79, 80, 154, 80, -117, -82,
+	154, -120, 154, -4, -155, 31, 118, -145, 71, 154,
+	31, -52, 141, 150, 154, 154, 154, -70, -78, 7,
+	-84, 154, 150, 154, 154, 154, 7, 7, 139, 10,
+	139, -97, 20, 130, 131, 132, 133, -74, -77, 161,
+	162, -90, -87, 25, 26, 141, 27, 141, 141, -95,
+	144, 145, 146, 147, 148, 149, 153, 152, 113, 154,
+	31, 154, 24, 154, 24, 154, 4, 154, 4, 154,
+	154, -120, 154, -84, -103, 124, 12, -75, 142, 135,
+	-90, 66, 65, 5, -100, 13, 31, 154, -84, -100,
+	-117, -75, -84, -75, -84, -75, 31, 80, -117, 80,
+	-117, 150, 154, 150, -75, -100, 80, -117, -117, -75,
+	-84, 144, -155, -114, -113, -112, 49, 60, 38, 39,
+	50, 81, 51, 54, 55, 52, 155, 118, 72, 7,
+	37, -156, -157, 31, -148, -146, -147, -120, 154, 150,
+	-80, 150, 7, 141, 150, 142, 7, -120, 7, 154,
+	7, 150, -120, -120, -76, 154, -76, -76, 23, 22,
+	23, 23, 22, 133, 23, 22, 23, 142, 142, -87,
+	-87, 142, 141, 25, -6, 141, -120, -120, -91, 141,
+	7, 81, 24, 150, 154, 154, 4, 150, 154, 154,
+	24, 150, 144, 144, 4, 7, -102, -109, 29, -104,
+	-105, -120, 154, 167, -115, -104, -84, 135, 141, 68,
+	154, -90, -83, 144, 145, 153, 152, -106, -107, 14,
+	15, 12, 5, -100, -107, -75, -84, -84, -102, -84,
+	-100, 31, 76, -117, -75, 31, -117, -75, -84, 154,
+	150, 150, 154, -100, -107, -117, -75, -84, -75, -84,
+	-84, -102, 154, 155, -114, 156, 155, 154, 155, -124,
+	-119, 154, 49, 49, 49, 49, -145, 155, 154, 50,
+	154, 157, -158, -159, 32, -148, 139, 142, 71, -120,
+	150, -80, 154, -80, 154, -70, 154, 31, -6, 150,
+	120, 154, 154, 154, 150, 139, 7, 23, 23, 23,
+	23, 10, -70, -6, 141, 142, -6, 139, 139, -87,
+	154, -124, 154, 154, 154, 154, 154, 154, 157, -120,
+	155, 158, 69, 70, 157, 154, -103, -100, 141, 139,
+	151, 141, 151, -102, 141, -149, -154, 109, 68, -84,
+	154, 154, -115, -115, -108, 16, 17, -143, 155, 160,
+	-143, -99, -101, 154, -83, -107, -84, -102, -102, -107,
+	-100, -106, 76, -27, 144, 145, 25, 153, 152, -75,
+	31, 31, 76, -75, -84, -84, -102, 150, 154, 154,
+	-107, -75, -84, -84, -102, -84, -102, -102, -107, 161,
+	161, 139, 156, 156, 156, 156, -11, 49, 31, -139,
+	95, -140, 95, 144, 73, -80, -141, 100, 142, 141,
+	-46, 49, 106, -120, -122, 35, 36, -120, -76, -87,
+	154, 142, 142, -6, -71, 154, 142, -120, -120, 142,
+	-114, -118, 56, 24, 4, 24, 24, 4, -109, -106,
+	-110, 154, 155, 158, 164, -104, 71, 156, 71, -103,
+	-149, 142, -152, 13, 154, 12, -100, 155, 155, 15,
+	139, 137, 138, -102, -107, -107, -106, -27, -84, -92,
+	-116, 154, -92, 141, -115, -115, 31, 76, 76, -27,
+	-84, -102, -102, -107, 154, -84, -102, -102, -107, -102,
+	-107, -107, 154, 154, -119, 50, 156, 35, 109, -125,
+	81, -138, -137, 154, 73, -125, -138, 154, 34, 33,
+	67, 99, 58, 31, -70, 156, 156, 120, -129, -120,
+	134, 142, 142, 139, 142, 142, 154, 154, 154, 154,
+	157, 157, -100, -136, 154, 142, -110, 142, 139, -109,
+	142, 12, -153, 154, 155, 156, -126, 154, -106, 17,
+	-143, -99, -107, -84, -100, 139, -92, 76, -27, -27,
+	-84, -102, -107, -107, -102, -107, -107, -107, 144, 144,
+	60, 21, 21, -144, 90, -124, -138, 96, 96, -144,
+	141, -6, 156, 156, -46, 142, 103, -122, 139, 156,
+	-71, 24, -106, 141, 156, 164, -100, 154, -153, 154,
+	154, 154, 139, 155, -100, -107, -92, 142, -27, -84,
+	-84, -102, -107, -107, 155, 154, 155, -118, 123, 155,
+	-126, -126, -118, 156, 68, 58, 31, 141, -129, -129,
+	157, -136, 157, 142, 156, -106, -152, 138, 137, 161,
+	154, -126, -107, -84, -102, -102, -107, -111, -112, -130,
+	-127, 82, 142, 156, -46, -142, 156, 142, 142, -136,
+	-152, -152, -153, 154, -102, -107, -107, -111, -131, -128,
+	83, -126, -138, 142, 139, -107, -135, -134, 84, -126,
+	104, -142, -123, 85, -132, -133, -120, 141, 154, 139,
+	144, -142, -132, -120, 155, 142,
 }
 
 var yyDef = [...]int16{
@@ -892,96 +1020,106 @@ var yyDef = [...]int16{
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
 	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
-	51, 52, 53, 54, 55, 56, 57, 58, 59, 0,
-	0, 0, 0, 140, 0, 0, 0, 0, 0, 0,
-	3, -2, 0, 63, 65, 68, 0, 168, 0, 88,
-	89, 0, 170, 171, 172, 173, 174, 175, 177, 167,
-	199, 281, 0, 281, 245, 0, 0, 0, 0, 0,
-	373, 0, 0, 394, 401, 404, 412, 417, 423, 266,
-	267, 268, 269, 270, 271, 272, 273, 274, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 140, 0, 0,
-	0, 0, 0, 0, 392, 0, 0, 0, 140, 250,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
-	0, 0, 0, 4, 0, 116, 0, 93, 0, 0,
+	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	61, 62, 63, 64, 65, 66, 67, 68, 0, 0,
+	0, 0, 177, 0, 0, 0, 0, 0, 0, 0,
+	0, 3, 0, 120, 0, -2, 0, 72, 74, 77,
+	0, 205, 0, 99, 100, 0, 207, 208, 209, 210,
+	211, 212, 214, 204, 236, 326, 0, 326, 282, 306,
+	0, 0, 0, 0, 0, 418, 0, 0, 441, 448,
+	451, 0, 460, 465, 471, 311, 312, 313, 314, 315,
+	316, 317, 318, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
+	0, 0, 439, 0, 0, 0, 177, 287, 0, 0,
+	0, 0, 0, 290, 0, 292, 0, 290, 0, 0,
+	290, 0, 340, 0, 0, 0, 0, 4, 117, 119,
+	118, 0, 153, 0, 116, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 87, 0, 0, 71, 0, 200, 140,
-	281, 0, 229, 140, 0, 281, 281, 281, 0, 0,
-	281, 0, 0, 0, 281, 0, 377, 385, 0, 0,
-	0, 207, 0, 0, 335, 112, 0, 111, 113, 114,
-	0, 0, 0, 93, 121, 122, 0, 246, 140, 248,
-	0, 263, 362, 378, 0, 0, 0, 403, 413, 0,
-	249, 94, 95, 97, 101, 106, 0, 139, 145, 0,
-	168, 0, 0, 0, 0, 143, 141, 0, 156, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 294,
-	0, 0, 405, 140, 118, 0, 92, 0, 64, 66,
-	67, 69, 70, 76, 77, 78, 79, 80, 81, 82,
-	83, 84, 0, 86, 169, 178, 179, 180, 176, 0,
-	0, 72, 0, 0, 182, 223, 280, 0, 140, 182,
-	281, 140, 140, 0, 0, 281, 0, 281, 275, 0,
-	182, 0, 281, 364, 281, 140, 374, 395, 402, 0,
-	207, 202, 0, 0, 204, 0, 0, 0, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
-	0, 390, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 0, 0, 0, 0, 0, 257, 0, 0, 0,
-	0, 262, 0, 0, 0, 116, 134, 0, 0, 140,
-	85, 0, 0, 0, 0, 194, 0, 0, 228, 182,
-	194, 140, 140, 116, 140, 182, 0, 0, 281, 0,
-	281, 140, 0, 0, 0, 182, 194, 281, 140, 140,
-	140, 116, 0, 201, 210, 211, 213, 0, 0, 0,
-	0, 218, 0, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 308, 309, 323, 334, 337, 0, 0, 112,
-	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
-	379, 0, 0, 414, 416, 96, 99, 98, 0, 103,
-	105, 142, 144, -2, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 0, 0, 256, 0, 0, 0,
-	261, 0, 0, 0, 118, 182, 0, 117, 119, 123,
-	121, 128, 130, 115, 116, 90, 0, 73, 140, 0,
-	0, 0, 0, 221, 198, 0, 0, 0, 0, 194,
-	244, 140, 116, 116, 194, 182, 194, 0, 0, 0,
-	0, 0, 140, 140, 116, 0, 0, 0, 279, 194,
-	283, 140, 140, 116, 140, 116, 116, 194, 424, 425,
-	212, 214, 215, 216, 217, 219, 359, 361, 0, 0,
-	0, 0, 205, 206, 208, 209, 0, 232, 313, 315,
-	0, 336, 338, 339, 340, 342, 0, 109, 112, 108,
-	384, 0, 0, 0, 400, 0, 0, 252, 386, 391,
-	0, 0, 0, 0, 0, 0, 0, 149, 0, 0,
-	0, 0, 0, 350, 253, 0, 255, 258, 0, 260,
-	363, 418, 419, 420, 421, 422, 134, 194, 0, 0,
-	0, 0, 0, 118, 91, 182, 224, 225, 226, 227,
-	188, 0, 0, 192, 189, 190, 193, 181, 183, 185,
-	222, 243, 116, 194, 194, 372, 194, 265, 0, 140,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
-	116, 116, 194, 0, 277, 278, 282, 140, 116, 116,
-	194, 116, 194, 194, 368, 0, 0, 0, 239, 240,
-	241, 242, 230, 0, 0, 318, 346, 318, 346, 0,
-	341, 107, 0, 0, 0, 0, 389, 0, 0, 0,
-	0, 408, 409, 415, 100, 0, 104, 147, 148, 0,
-	0, 74, 152, 0, 0, 157, 251, 375, 0, 254,
-	259, 182, 132, 0, 135, 136, 137, 0, 120, 124,
-	0, 129, 134, 194, 196, 197, 0, 0, 186, 187,
-	194, 370, 371, 264, 140, 182, 286, 291, 293, 287,
-	0, 289, 290, 0, 0, 0, 140, 116, 194, 194,
-	299, 276, 116, 194, 194, 307, 194, 366, 367, 0,
-	0, 360, 231, 0, 0, 0, 320, 0, 314, 346,
-	0, 0, 320, 316, 0, 324, 325, 0, 0, 0,
-	0, 0, 0, 399, 0, 411, 406, 102, 150, 151,
-	0, 153, 154, 349, 194, 62, 0, 133, 138, 125,
-	0, 182, 220, 0, 191, 184, 369, 182, 194, 0,
-	0, 0, 140, 140, 116, 194, 297, 298, 194, 305,
-	306, 365, 0, 0, 0, 233, 234, 350, 0, 319,
-	345, 0, 0, 350, 0, 0, 381, 382, 387, 0,
-	0, 0, 0, 75, 132, 0, 0, 0, 194, 195,
-	194, 285, 292, 288, 140, 116, 116, 194, 296, 304,
-	427, 426, 236, 311, 321, 322, 343, 347, 344, 326,
-	0, 380, 0, 0, 0, 410, 407, 60, 0, 126,
-	0, 132, 284, 116, 194, 194, 303, 235, 237, 0,
-	328, 327, 0, 346, 383, 388, 0, 397, 131, 127,
-	61, 194, 301, 302, 238, 348, 330, 329, 0, 351,
-	317, 0, 0, 300, 332, 331, 358, 352, 0, 398,
-	312, 0, 355, 354, 0, 0, 333, 358, 0, 0,
-	353, 356, 357, 396,
+	98, 0, 0, 80, 0, 237, 177, 326, 0, 266,
+	177, 0, 326, 326, 326, 0, 0, 326, 0, 0,
+	0, 326, 0, 422, 430, 0, 0, 452, 0, 244,
+	0, 0, 304, 380, 149, 0, 148, 150, 151, 0,
+	0, 0, 116, 158, 159, 0, 283, 177, 285, 0,
+	303, 305, 407, 423, 0, 0, 0, 450, 461, 0,
+	286, 121, 122, 124, 128, 143, 0, 176, 182, 0,
+	205, 0, 0, 0, 0, 180, 178, 0, 193, 0,
+	421, 0, 291, 0, 0, 0, 291, 0, 0, 0,
+	291, 339, 0, 0, 0, 453, 177, 155, 0, 115,
+	0, 73, 75, 76, 78, 79, 85, 86, 87, 88,
+	89, 90, 91, 92, 93, 0, 95, 206, 215, 216,
+	217, 213, 0, 0, 81, 0, 0, 219, 260, 325,
+	0, 177, 219, 326, 177, 177, 0, 0, 326, 0,
+	326, 320, 0, 219, 0, 326, 409, 326, 177, 419,
+	442, 449, 0, 244, 239, 0, 0, 241, 0, 0,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	284, 0, 0, 0, 437, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 193, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 0,
+	0, 0, 0, 296, 0, 0, 0, 302, 0, 0,
+	0, 0, 0, 153, 171, 0, 0, 177, 94, 0,
+	0, 0, 0, 0, 231, 0, 0, 265, 219, 231,
+	177, 177, 153, 177, 219, 0, 0, 326, 0, 326,
+	177, 0, 0, 0, 219, 231, 326, 177, 177, 177,
+	153, 0, 238, 247, 248, 250, 0, 0, 0, 0,
+	255, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 353, 354, 368, 379, 382, 0, 0, 149, 0,
+	147, 0, 0, 0, 0, 0, 0, 0, 0, 424,
+	0, 0, 462, 464, 123, 126, 125, 0, 131, 0,
+	133, 134, 0, 0, 136, 0, 138, 140, 142, 179,
+	181, -2, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 0, 0, 295, 307, 0, 0, 301, 308,
+	0, 0, 0, 0, 0, 0, 155, 219, 0, 154,
+	156, 160, 158, 165, 167, 152, 153, 0, 108, 101,
+	0, 82, 177, 0, 0, 0, 0, 258, 235, 0,
+	0, 0, 0, 231, 281, 177, 153, 153, 231, 219,
+	231, 0, 0, 0, 0, 0, 177, 177, 153, 0,
+	0, 0, 324, 231, 328, 177, 177, 153, 177, 153,
+	153, 231, 472, 473, 249, 251, 252, 253, 254, 256,
+	404, 406, 0, 0, 0, 0, 242, 243, 245, 246,
+	0, 269, 358, 360, 0, 381, 383, 384, 385, 387,
+	0, 146, 149, 145, 429, 0, 0, 0, 447, 0,
+	0, 289, 431, 438, 0, 0, 0, 132, 135, 139,
+	137, 0, 0, 0, 0, 186, 0, 0, 0, 0,
+	0, 395, 293, 0, 297, 0, 299, 0, 408, 466,
+	467, 468, 469, 470, 0, 0, 171, 231, 0, 0,
+	0, 0, 0, 155, 108, 0, 111, 0, 102, 219,
+	261, 262, 263, 264, 225, 0, 0, 229, 226, 227,
+	230, 218, 220, 222, 259, 280, 153, 231, 231, 417,
+	231, 310, 0, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 153, 153, 231, 0, 322, 323,
+	327, 177, 153, 153, 231, 153, 231, 231, 413, 0,
+	0, 0, 276, 277, 278, 279, 267, 0, 0, 363,
+	391, 363, 391, 0, 386, 144, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 456, 457, 463, 127, 129,
+	141, 184, 185, 0, 0, 83, 189, 0, 0, 194,
+	288, 420, 0, 0, 0, 0, 0, 0, 219, 169,
+	0, 172, 173, 174, 0, 157, 161, 0, 166, 171,
+	0, 97, 103, 0, 0, 0, 231, 233, 234, 0,
+	0, 223, 224, 231, 415, 416, 309, 177, 219, 331,
+	336, 338, 332, 0, 334, 335, 0, 0, 0, 177,
+	153, 231, 231, 344, 321, 153, 231, 231, 352, 231,
+	411, 412, 0, 0, 405, 268, 0, 0, 0, 365,
+	0, 359, 391, 0, 0, 365, 361, 0, 369, 370,
+	0, 0, 0, 0, 0, 0, 446, 0, 459, 454,
+	0, 187, 188, 0, 190, 191, 394, 294, 298, 300,
+	432, 0, 231, 71, 0, 170, 175, 162, 0, 219,
+	96, 0, 109, 0, 0, 0, 107, 392, 257, 0,
+	228, 221, 414, 219, 231, 0, 0, 0, 177, 177,
+	153, 231, 342, 343, 231, 350, 351, 410, 0, 0,
+	0, 270, 271, 395, 0, 364, 390, 0, 0, 395,
+	0, 0, 426, 427, 434, 0, 0, 0, 0, 130,
+	84, 0, 169, 0, 0, 0, 231, 111, 0, 112,
+	113, 114, 0, 232, 231, 330, 337, 333, 177, 153,
+	153, 231, 341, 349, 475, 474, 273, 356, 366, 367,
+	388, 389, 371, 0, 425, 0, 0, 0, 458, 455,
+	433, 69, 0, 163, 0, 169, 104, 111, 111, 0,
+	112, 393, 329, 153, 231, 231, 348, 272, 274, 373,
+	372, 0, 391, 428, 435, 0, 444, 168, 164, 70,
+	105, 106, 110, 0, 231, 346, 347, 275, 375, 374,
+	0, 396, 362, 0, 0, 345, 377, 376, 403, 397,
+	0, 445, 357, 0, 400, 399, 0, 0, 378, 403,
+	0, 0, 398, 401, 402, 443,
 }
 
 var yyTok1 = [...]int8{
@@ -1004,7 +1142,8 @@ var yyTok2 = [...]uint8{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157, 158, 159,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
 }
 
 var yyTok3 = [...]int8{
@@ -1350,19 +1489,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:188
+//line sql.y:258
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:194
+//line sql.y:264
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:198
+//line sql.y:268
 		{
 			if len(yyDollar[1].stmts) >= 1 {
 				yyVAL.stmts = yyDollar[1].stmts
@@ -1372,343 +1511,401 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:206
+//line sql.y:276
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:214
+//line sql.y:284
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:218
+//line sql.y:288
 		{
-			yyVAL.stmt = yyDollar[1].stmt
+			stmt, err := NewUnionStatement(yyDollar[1].union)
+			if err != nil {
+				yylex.Error(err.Error())
+			}
+			yyVAL.stmt = stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:222
+//line sql.y:296
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:226
+//line sql.y:300
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:230
+//line sql.y:304
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:234
+//line sql.y:308
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:238
+//line sql.y:312
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:242
+//line sql.y:316
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:246
+//line sql.y:320
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:250
+//line sql.y:324
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:254
+//line sql.y:328
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:258
+//line sql.y:332
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:262
+//line sql.y:336
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:266
+//line sql.y:340
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:270
+//line sql.y:344
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:274
+//line sql.y:348
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:278
+//line sql.y:352
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:282
+//line sql.y:356
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:286
+//line sql.y:360
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:290
+//line sql.y:364
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:294
+//line sql.y:368
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:298
+//line sql.y:372
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:302
+//line sql.y:376
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:306
+//line sql.y:380
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:310
+//line sql.y:384
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:314
+//line sql.y:388
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:318
+//line sql.y:392
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:322
+//line sql.y:396
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:326
+//line sql.y:400
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:404
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:408
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:338
+//line sql.y:412
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:342
+//line sql.y:416
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:420
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:424
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:354
+//line sql.y:428
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:358
+//line sql.y:432
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:362
+//line sql.y:436
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:366
+//line sql.y:440
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:370
+//line sql.y:444
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:374
+//line sql.y:448
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:378
+//line sql.y:452
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:382
+//line sql.y:456
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:386
+//line sql.y:460
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:390
+//line sql.y:464
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:394
+//line sql.y:468
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:398
+//line sql.y:472
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+//line sql.y:476
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:406
+//line sql.y:480
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:484
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:414
+//line sql.y:488
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:418
+//line sql.y:492
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:422
+//line sql.y:496
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:426
+//line sql.y:500
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:430
+//line sql.y:504
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:508
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:512
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:516
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:520
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:524
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:528
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:532
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:536
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:540
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 69:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:436
+//line sql.y:546
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1749,9 +1946,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 61:
+	case 70:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:477
+//line sql.y:587
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1793,9 +1990,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 62:
+	case 71:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:519
+//line sql.y:629
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1823,147 +2020,147 @@ yydefault:
 			stmt.Location = yyDollar[9].location
 			yyVAL.stmt = stmt
 		}
-	case 63:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:550
+//line sql.y:660
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
-	case 64:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:554
+//line sql.y:664
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 65:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:560
+//line sql.y:670
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 66:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:564
+//line sql.y:674
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
-	case 67:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:568
+//line sql.y:678
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
-	case 68:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:572
+//line sql.y:682
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
-	case 69:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:686
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 70:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:580
+//line sql.y:690
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 71:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:586
+//line sql.y:696
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 72:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:700
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 73:
+	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:599
+//line sql.y:709
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
 			c.Assigners = []Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 74:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:608
+//line sql.y:718
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
-	case 75:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:612
+//line sql.y:722
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 76:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:618
+//line sql.y:728
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 77:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:622
+//line sql.y:732
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 78:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:626
+//line sql.y:736
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 79:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:630
+//line sql.y:740
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 80:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:634
+//line sql.y:744
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 81:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:638
+//line sql.y:748
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 82:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:642
+//line sql.y:752
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 83:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:646
+//line sql.y:756
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 84:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:650
+//line sql.y:760
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 85:
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:654
+//line sql.y:764
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -1994,16 +2191,32 @@ yydefault:
 				yyVAL.expr = cols
 			}
 		}
-	case 86:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:685
+//line sql.y:795
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 87:
+	case 96:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:800
+		{
+			cols := &Call{Name: strings.ToLower(yyDollar[1].str), Args: []Expr{}, Over: yyDollar[7].window}
+			for i := range yyDollar[3].fields {
+				cols.Args = append(cols.Args, yyDollar[3].fields[i].Expr)
+			}
+			yyVAL.expr = cols
+		}
+	case 97:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:808
+		{
+			yyVAL.expr = &Call{Name: strings.ToLower(yyDollar[1].str), Over: yyDollar[6].window}
+		}
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:690
+//line sql.y:812
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -2017,111 +2230,312 @@ yydefault:
 			}
 
 		}
-	case 88:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:704
+//line sql.y:826
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 89:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:708
+//line sql.y:830
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 90:
+	case 101:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:712
+//line sql.y:834
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 91:
+	case 102:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:718
+//line sql.y:840
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 92:
+	case 103:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:846
+		{
+			yyVAL.window = &Window{Partition: yyDollar[1].strSlice, Frame: yyDollar[2].windowFrame}
+		}
+	case 104:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:850
+		{
+			if strings.ToLower(yyDollar[4].str) != "time" {
+				yylex.Error("only ORDER BY time is supported in window")
+			}
+			yyVAL.window = &Window{Partition: yyDollar[1].strSlice, OrderBy: true, Ascending: true, Frame: yyDollar[5].windowFrame}
+		}
+	case 105:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:857
+		{
+			if strings.ToLower(yyDollar[4].str) != "time" {
+				yylex.Error("only ORDER BY time is supported in window")
+			}
+			yyVAL.window = &Window{Partition: yyDollar[1].strSlice, OrderBy: true, Ascending: true, Frame: yyDollar[6].windowFrame}
+		}
+	case 106:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:864
+		{
+			if strings.ToLower(yyDollar[4].str) != "time" {
+				yylex.Error("only ORDER BY time is supported in window")
+			}
+			yyVAL.window = &Window{Partition: yyDollar[1].strSlice, OrderBy: true, Ascending: false, Frame: yyDollar[6].windowFrame}
+		}
+	case 107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:873
+		{
+			yyVAL.strSlice = yyDollar[3].strSlice
+		}
+	case 108:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:877
+		{
+			yyVAL.strSlice = nil
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:883
+		{
+			yyVAL.windowFrame = newWindowFrame(yylex, yyDollar[1].str, yyDollar[2].windowFrameBound, &windowFrameBound{WindowFrameBound: WindowFrameBound{Type: CurrentRow}})
+		}
+	case 110:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:887
+		{
+			if strings.ToLower(yyDollar[2].str) != "between" {
+				yylex.Error("expect BETWEEN for window frame")
+			}
+			yyVAL.windowFrame = newWindowFrame(yylex, yyDollar[1].str, yyDollar[3].windowFrameBound, yyDollar[5].windowFrameBound)
+		}
+	case 111:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:894
+		{
+			yyVAL.windowFrame = nil
+		}
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:900
+		{
+			yyVAL.windowFrameBound = &windowFrameBound{}
+			switch strings.ToLower(yyDollar[1].str + " " + yyDollar[2].str) {
+			case "unbounded preceding":
+				yyVAL.windowFrameBound.Type = UnboundedPreceding
+			case "unbounded following":
+				yyVAL.windowFrameBound.Type = UnboundedFollowing
+			case "current row":
+				yyVAL.windowFrameBound.Type = CurrentRow
+			default:
+				yylex.Error("invalid window frame bound: " + yyDollar[1].str + " " + yyDollar[2].str)
+			}
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:914
+		{
+			yyVAL.windowFrameBound = newWindowFrameOffset(yylex, yyDollar[1].int64, yyDollar[2].str, false)
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:918
+		{
+			yyVAL.windowFrameBound = newWindowFrameOffset(yylex, int64(yyDollar[1].tdur), yyDollar[2].str, true)
+		}
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:724
+//line sql.y:924
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 93:
+	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:728
+//line sql.y:928
 		{
 			yyVAL.sources = nil
 		}
-	case 94:
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:934
+		{
+			yyVAL.union = &Union{
+				Distinct:   yyDollar[2].bool,
+				Statements: []*SelectStatement{yyDollar[1].stmt.(*SelectStatement), yyDollar[3].stmt.(*SelectStatement)},
+			}
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:941
+		{
+			if yyDollar[1].union.Distinct != yyDollar[2].bool {
+				yylex.Error("UNION and UNION ALL cannot be used together")
+			}
+			yyDollar[1].union.Statements = append(yyDollar[1].union.Statements, yyDollar[3].stmt.(*SelectStatement))
+			yyVAL.union = yyDollar[1].union
+		}
+	case 119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:951
+		{
+			yyVAL.bool = false
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:955
+		{
+			yyVAL.bool = true
+		}
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:734
+//line sql.y:961
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 95:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:740
+//line sql.y:967
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 96:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:744
+//line sql.y:971
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 97:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:748
+//line sql.y:975
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 98:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:753
+//line sql.y:980
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 99:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:757
+//line sql.y:984
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 100:
+	case 127:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:762
+//line sql.y:989
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 101:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:767
+//line sql.y:994
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 102:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:773
+	case 129:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1000
 		{
 			join := &Join{}
-			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
+			if len(yyDollar[1].sources) != 1 || len(yyDollar[3].sources) != 1 {
 				yylex.Error("only support one query for join")
 			}
 			join.LSrc = yyDollar[1].sources[0]
-			join.RSrc = yyDollar[4].sources[0]
-			join.Condition = yyDollar[6].expr
+			join.RSrc = yyDollar[3].sources[0]
+			join.Condition = yyDollar[5].expr
+			join.JoinType = JoinType(yyDollar[2].int)
 			yyVAL.source = join
 		}
-	case 103:
+	case 130:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1012
+		{
+			join := &Join{}
+			if len(yyDollar[1].sources) != 1 || len(yyDollar[3].sources) != 1 {
+				yylex.Error("only support one query for join")
+			}
+			if !JoinType(yyDollar[2].int).IsAsof() {
+				yylex.Error("tolerance is only supported for asof join")
+			}
+			if yyDollar[7].tdur <= 0 {
+				yylex.Error("tolerance must be greater than 0")
+			}
+			join.LSrc = yyDollar[1].sources[0]
+			join.RSrc = yyDollar[3].sources[0]
+			join.Condition = yyDollar[5].expr
+			join.JoinType = JoinType(yyDollar[2].int)
+			join.Tolerance = yyDollar[7].tdur
+			yyVAL.source = join
+		}
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1033
+		{
+			yyVAL.int = int(FullJoin)
+		}
+	case 132:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1037
+		{
+			yyVAL.int = int(FullJoin)
+		}
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1041
+		{
+			yyVAL.int = int(InnerJoin)
+		}
+	case 134:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1045
+		{
+			yyVAL.int = int(LeftOuterJoin)
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1049
+		{
+			yyVAL.int = int(LeftOuterJoin)
+		}
+	case 136:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1053
+		{
+			yyVAL.int = int(RightOuterJoin)
+		}
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:786
+//line sql.y:1057
+		{
+			yyVAL.int = int(RightOuterJoin)
+		}
+	case 138:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1061
+		{
+			yyVAL.int = int(AsofJoin)
+		}
+	case 139:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1065
+		{
+			yyVAL.int = int(LeftAsofJoin)
+		}
+	case 140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1071
 		{
 			all_subquerys := []Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -2134,9 +2548,9 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 104:
+	case 141:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:799
+//line sql.y:1084
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2153,72 +2567,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 105:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:816
+//line sql.y:1101
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 106:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:822
+//line sql.y:1107
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 107:
+	case 144:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:828
+//line sql.y:1113
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 108:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:835
+//line sql.y:1120
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:841
+//line sql.y:1126
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:847
+//line sql.y:1132
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 111:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:853
+//line sql.y:1138
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 112:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:1144
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 113:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:863
+//line sql.y:1148
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 114:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:867
+//line sql.y:1152
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2227,69 +2641,69 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 115:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:878
+//line sql.y:1163
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 116:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:882
+//line sql.y:1167
 		{
 			yyVAL.dimens = nil
 		}
-	case 117:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:888
+//line sql.y:1173
 		{
 			yyVAL.dimens = yyDollar[2].dimens
 		}
-	case 118:
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:892
+//line sql.y:1177
 		{
 			yyVAL.dimens = nil
 		}
-	case 119:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:1183
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 120:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:902
+//line sql.y:1187
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 121:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:1193
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 122:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:912
+//line sql.y:1197
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 123:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:918
+//line sql.y:1203
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 124:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:922
+//line sql.y:1207
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 125:
+	case 162:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:926
+//line sql.y:1211
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2297,9 +2711,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 126:
+	case 163:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:934
+//line sql.y:1219
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2307,9 +2721,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 127:
+	case 164:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:942
+//line sql.y:1227
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2317,21 +2731,21 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 128:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:950
+//line sql.y:1235
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 129:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:954
+//line sql.y:1239
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 130:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:958
+//line sql.y:1243
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2339,9 +2753,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 131:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:969
+//line sql.y:1254
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2352,45 +2766,45 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 132:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:980
+//line sql.y:1265
 		{
 			yyVAL.location = nil
 		}
-	case 133:
+	case 170:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:986
+//line sql.y:1271
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 134:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:990
+//line sql.y:1275
 		{
 			yyVAL.inter = "null"
 		}
-	case 135:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:996
+//line sql.y:1281
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 136:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1000
+//line sql.y:1285
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 137:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1004
+//line sql.y:1289
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 138:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1008
+//line sql.y:1293
 		{
 			switch s := yyDollar[2].inter.(type) {
 			case int64:
@@ -2401,57 +2815,57 @@ yydefault:
 				yyVAL.inter = yyDollar[2].inter
 			}
 		}
-	case 139:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1021
+//line sql.y:1306
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 140:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1025
+//line sql.y:1310
 		{
 			yyVAL.expr = nil
 		}
-	case 141:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1316
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 142:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1035
+//line sql.y:1320
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 143:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1041
+//line sql.y:1326
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 144:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1045
+//line sql.y:1330
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 145:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1051
+//line sql.y:1336
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 146:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1055
+//line sql.y:1340
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 147:
+	case 184:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1059
+//line sql.y:1344
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2465,39 +2879,39 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 148:
+	case 185:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1073
+//line sql.y:1358
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 149:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1077
+//line sql.y:1362
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 150:
+	case 187:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1081
+//line sql.y:1366
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 151:
+	case 188:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1085
+//line sql.y:1370
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 152:
+	case 189:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1089
+//line sql.y:1374
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 153:
+	case 190:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1093
+//line sql.y:1378
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2505,9 +2919,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 154:
+	case 191:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1101
+//line sql.y:1386
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2515,9 +2929,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 155:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1111
+//line sql.y:1396
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2528,123 +2942,123 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 156:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1124
+//line sql.y:1409
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 157:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1128
+//line sql.y:1413
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 158:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1134
+//line sql.y:1419
 		{
 			yyVAL.int = EQ
 		}
-	case 159:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1138
+//line sql.y:1423
 		{
 			yyVAL.int = NEQ
 		}
-	case 160:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1142
+//line sql.y:1427
 		{
 			yyVAL.int = LT
 		}
-	case 161:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1146
+//line sql.y:1431
 		{
 			yyVAL.int = LTE
 		}
-	case 162:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1150
+//line sql.y:1435
 		{
 			yyVAL.int = GT
 		}
-	case 163:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1154
+//line sql.y:1439
 		{
 			yyVAL.int = GTE
 		}
-	case 164:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1158
+//line sql.y:1443
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 165:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1162
+//line sql.y:1447
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 166:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1166
+//line sql.y:1451
 		{
 			yyVAL.int = LIKE
 		}
-	case 167:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1172
+//line sql.y:1457
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 168:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1178
+//line sql.y:1463
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 169:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1182
+//line sql.y:1467
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 170:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1471
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 171:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1475
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 172:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1479
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 173:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1483
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 174:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1487
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 175:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1491
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2652,21 +3066,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 176:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1214
+//line sql.y:1499
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 177:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1503
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 178:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1224
+//line sql.y:1509
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2687,75 +3101,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 179:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1245
+//line sql.y:1530
 		{
 			yyVAL.dataType = Tag
 		}
-	case 180:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1534
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 181:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1255
+//line sql.y:1540
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 182:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1259
+//line sql.y:1544
 		{
 			yyVAL.sortfs = nil
 		}
-	case 183:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1265
+//line sql.y:1550
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 184:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1269
+//line sql.y:1554
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 185:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1560
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 186:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1564
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 187:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1283
+//line sql.y:1568
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 188:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1289
+//line sql.y:1574
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 189:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1295
+//line sql.y:1580
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 190:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1300
+//line sql.y:1585
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2763,69 +3177,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 191:
+	case 228:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1310
+//line sql.y:1595
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 192:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1314
+//line sql.y:1599
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 193:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1318
+//line sql.y:1603
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 194:
+	case 231:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1322
+//line sql.y:1607
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 195:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1328
+//line sql.y:1613
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 196:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1332
+//line sql.y:1617
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 197:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1336
+//line sql.y:1621
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 198:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1340
+//line sql.y:1625
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 199:
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1346
+//line sql.y:1631
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
 		}
-	case 200:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1350
+//line sql.y:1635
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
 		}
-	case 201:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1356
+//line sql.y:1641
 		{
 			sms := yyDollar[4].stmt
 
@@ -2833,9 +3247,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 202:
+	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1364
+//line sql.y:1649
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2843,54 +3257,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 203:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1374
+//line sql.y:1659
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 204:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1379
+//line sql.y:1664
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 205:
+	case 242:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1384
+//line sql.y:1669
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 206:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1389
+//line sql.y:1674
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 207:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1393
+//line sql.y:1678
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 208:
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1399
+//line sql.y:1684
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 209:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1691
 		{
 			yyVAL.bool = false
 		}
-	case 210:
+	case 247:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1413
+//line sql.y:1698
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2929,15 +3343,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 211:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1456
+//line sql.y:1741
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 212:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1460
+//line sql.y:1745
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3010,56 +3424,56 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 213:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1535
+//line sql.y:1820
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 214:
+	case 251:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1539
+//line sql.y:1824
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 215:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1544
+//line sql.y:1829
 		{
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 216:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1549
+//line sql.y:1834
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 217:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1838
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 218:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1557
+//line sql.y:1842
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 219:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1561
+//line sql.y:1846
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 220:
+	case 257:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1572
+//line sql.y:1857
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3070,9 +3484,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 221:
+	case 258:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1583
+//line sql.y:1868
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3082,38 +3496,38 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 222:
+	case 259:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1595
+//line sql.y:1880
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			sms.Source = yyDollar[7].ment
 			yyVAL.stmt = sms
 		}
-	case 223:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1602
+//line sql.y:1887
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			yyVAL.stmt = sms
 		}
-	case 224:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1611
+//line sql.y:1896
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 225:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1900
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 226:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1904
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3121,9 +3535,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 227:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1627
+//line sql.y:1912
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3131,32 +3545,32 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 228:
+	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1639
+//line sql.y:1924
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 229:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1645
+//line sql.y:1930
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 230:
+	case 267:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1652
+//line sql.y:1937
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 231:
+	case 268:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1659
+//line sql.y:1944
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3164,18 +3578,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 232:
+	case 269:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1669
+//line sql.y:1954
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 233:
+	case 270:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1676
+//line sql.y:1961
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3183,9 +3597,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 234:
+	case 271:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1684
+//line sql.y:1969
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3193,9 +3607,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 235:
+	case 272:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1695
+//line sql.y:1980
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3227,24 +3641,24 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 236:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1727
+//line sql.y:2012
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 237:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:2022
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 238:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1741
+//line sql.y:2026
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3279,33 +3693,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 239:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1779
+//line sql.y:2064
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 240:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1783
+//line sql.y:2068
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 241:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1787
+//line sql.y:2072
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 242:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1791
+//line sql.y:2076
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 243:
+	case 280:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1799
+//line sql.y:2084
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3316,9 +3730,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 244:
+	case 281:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1810
+//line sql.y:2095
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3328,57 +3742,57 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1822
+//line sql.y:2107
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 246:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1828
+//line sql.y:2113
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1836
+//line sql.y:2121
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1843
+//line sql.y:2128
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 249:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1851
+//line sql.y:2136
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 250:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1858
+//line sql.y:2143
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 251:
+	case 288:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1867
+//line sql.y:2152
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3412,122 +3826,166 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 252:
+	case 289:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1905
+//line sql.y:2190
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 253:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1914
+	case 290:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2199
 		{
-			stmt := &GrantStatement{}
-			stmt.Privilege = AllPrivileges
-			stmt.On = yyDollar[4].str
-			stmt.User = yyDollar[6].str
-			yyVAL.stmt = stmt
+			yyVAL.int = int(AllPrivileges)
 		}
-	case 254:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1922
+	case 291:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2203
 		{
-			stmt := &GrantStatement{}
-			stmt.Privilege = AllPrivileges
-			stmt.On = yyDollar[5].str
-			stmt.User = yyDollar[7].str
-			yyVAL.stmt = stmt
+			yyVAL.int = int(AllPrivileges)
 		}
-	case 255:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1930
+	case 292:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2207
 		{
-			stmt := &GrantStatement{}
-			switch strings.ToLower(yyDollar[2].str) {
+			switch strings.ToLower(yyDollar[1].str) {
 			case "read":
-				stmt.Privilege = ReadPrivilege
+				yyVAL.int = int(ReadPrivilege)
 			case "write":
-				stmt.Privilege = WritePrivilege
+				yyVAL.int = int(WritePrivilege)
 			default:
 				yylex.Error("wrong Privilege")
 			}
+		}
+	case 293:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2220
+		{
+			stmt := &GrantStatement{}
+			stmt.Privilege = Privilege(yyDollar[2].int)
 			stmt.On = yyDollar[4].str
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 256:
+	case 294:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2228
+		{
+			stmt := &GrantStatement{}
+			stmt.Privilege = Privilege(yyDollar[2].int)
+			stmt.On = yyDollar[4].str
+			stmt.Measurement = yyDollar[6].str
+			stmt.User = yyDollar[8].str
+			yyVAL.stmt = stmt
+		}
+	case 295:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1947
+//line sql.y:2239
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 257:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1951
+//line sql.y:2243
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 258:
+	case 297:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1957
+//line sql.y:2249
 		{
 			stmt := &RevokeStatement{}
-			stmt.Privilege = AllPrivileges
+			stmt.Privilege = Privilege(yyDollar[2].int)
 			stmt.On = yyDollar[4].str
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 259:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1965
+	case 298:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2257
 		{
 			stmt := &RevokeStatement{}
-			stmt.Privilege = AllPrivileges
-			stmt.On = yyDollar[5].str
-			stmt.User = yyDollar[7].str
+			stmt.Privilege = Privilege(yyDollar[2].int)
+			stmt.On = yyDollar[4].str
+			stmt.Measurement = yyDollar[6].str
+			stmt.User = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 260:
+	case 299:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1973
+//line sql.y:2268
 		{
-			stmt := &RevokeStatement{}
-			switch strings.ToLower(yyDollar[2].str) {
-			case "read":
-				stmt.Privilege = ReadPrivilege
-			case "write":
-				stmt.Privilege = WritePrivilege
-			default:
-				yylex.Error("wrong Privilege")
-			}
+			stmt := &DenyStatement{}
+			stmt.Privilege = Privilege(yyDollar[2].int)
 			stmt.On = yyDollar[4].str
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 261:
+	case 300:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2276
+		{
+			stmt := &DenyStatement{}
+			stmt.Privilege = Privilege(yyDollar[2].int)
+			stmt.On = yyDollar[4].str
+			stmt.Measurement = yyDollar[6].str
+			stmt.User = yyDollar[8].str
+			yyVAL.stmt = stmt
+		}
+	case 301:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1990
+//line sql.y:2287
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 262:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1994
+//line sql.y:2291
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 263:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2000
+//line sql.y:2297
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 264:
+	case 304:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2303
+		{
+			yyVAL.stmt = &CreateRoleStatement{Name: yyDollar[3].str}
+		}
+	case 305:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2309
+		{
+			yyVAL.stmt = &DropRoleStatement{Name: yyDollar[3].str}
+		}
+	case 306:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2315
+		{
+			yyVAL.stmt = &ShowRolesStatement{}
+		}
+	case 307:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2321
+		{
+			yyVAL.stmt = &GrantRoleStatement{Role: yyDollar[3].str, User: yyDollar[5].str}
+		}
+	case 308:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2327
+		{
+			yyVAL.stmt = &RevokeRoleStatement{Role: yyDollar[3].str, User: yyDollar[5].str}
+		}
+	case 309:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2006
+//line sql.y:2333
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3541,9 +3999,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 265:
+	case 310:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2020
+//line sql.y:2347
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3555,72 +4013,72 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 266:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2034
+//line sql.y:2361
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 267:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2038
+//line sql.y:2365
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 268:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2042
+//line sql.y:2369
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 269:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2046
+//line sql.y:2373
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 270:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2050
+//line sql.y:2377
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 271:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2054
+//line sql.y:2381
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 272:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2058
+//line sql.y:2385
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 273:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2062
+//line sql.y:2389
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 274:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2066
+//line sql.y:2393
 		{
 			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
 		}
-	case 275:
+	case 320:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2072
+//line sql.y:2399
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 276:
+	case 321:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2079
+//line sql.y:2406
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3629,9 +4087,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 277:
+	case 322:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2088
+//line sql.y:2415
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3639,9 +4097,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 278:
+	case 323:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2096
+//line sql.y:2423
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3649,30 +4107,30 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 279:
+	case 324:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2104
+//line sql.y:2431
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2113
+//line sql.y:2440
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 281:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2117
+//line sql.y:2444
 		{
 			yyVAL.str = ""
 		}
-	case 282:
+	case 327:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2123
+//line sql.y:2450
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3682,9 +4140,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 328:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2133
+//line sql.y:2460
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3693,9 +4151,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 284:
+	case 329:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2145
+//line sql.y:2472
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3708,9 +4166,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 285:
+	case 330:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2158
+//line sql.y:2485
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3721,36 +4179,36 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 286:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2171
+//line sql.y:2498
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 287:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2178
+//line sql.y:2505
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 288:
+	case 333:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2185
+//line sql.y:2512
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 289:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2192
+//line sql.y:2519
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3761,9 +4219,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 290:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2203
+//line sql.y:2530
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3774,47 +4232,47 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2217
+//line sql.y:2544
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 292:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2222
+//line sql.y:2549
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 293:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2229
+//line sql.y:2556
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 294:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2237
+//line sql.y:2564
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 295:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2244
+//line sql.y:2571
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 341:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2254
+//line sql.y:2581
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3826,9 +4284,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 297:
+	case 342:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2266
+//line sql.y:2593
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3839,9 +4297,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 298:
+	case 343:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2277
+//line sql.y:2604
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3853,9 +4311,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 299:
+	case 344:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2289
+//line sql.y:2616
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3866,9 +4324,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 300:
+	case 345:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2305
+//line sql.y:2632
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3885,9 +4343,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 301:
+	case 346:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2322
+//line sql.y:2649
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3902,9 +4360,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 347:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2337
+//line sql.y:2664
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3921,9 +4379,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 303:
+	case 348:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2354
+//line sql.y:2681
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3938,9 +4396,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 349:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2372
+//line sql.y:2699
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3952,9 +4410,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 305:
+	case 350:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2384
+//line sql.y:2711
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3965,9 +4423,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 306:
+	case 351:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2395
+//line sql.y:2722
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3979,9 +4437,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 307:
+	case 352:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2407
+//line sql.y:2734
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3992,9 +4450,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 308:
+	case 353:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2421
+//line sql.y:2748
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4017,9 +4475,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 309:
+	case 354:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2444
+//line sql.y:2771
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4108,18 +4566,18 @@ yydefault:
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
 			yyVAL.stmt = stmt
 		}
-	case 310:
+	case 355:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2534
+//line sql.y:2861
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 311:
+	case 356:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2541
+//line sql.y:2868
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4134,9 +4592,9 @@ yydefault:
 			option.EngineType = yyDollar[2].str
 			yyVAL.cmOption = option
 		}
-	case 312:
+	case 357:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2558
+//line sql.y:2885
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4167,15 +4625,15 @@ yydefault:
 			option.CompactType = yyDollar[10].str
 			yyVAL.cmOption = option
 		}
-	case 313:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2590
+//line sql.y:2917
 		{
 			yyVAL.indexType = nil
 		}
-	case 314:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2921
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4191,15 +4649,15 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 315:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2611
+//line sql.y:2938
 		{
 			yyVAL.indexType = nil
 		}
-	case 316:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2615
+//line sql.y:2942
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
@@ -4216,9 +4674,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 317:
+	case 362:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2632
+//line sql.y:2959
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4246,104 +4704,104 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 318:
+	case 363:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2661
+//line sql.y:2988
 		{
 			yyVAL.strSlice = nil
 		}
-	case 319:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2665
+//line sql.y:2992
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 320:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2672
+//line sql.y:2999
 		{
 			yyVAL.int64 = 0
 		}
-	case 321:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2676
+//line sql.y:3003
 		{
 			yyVAL.int64 = -1
 		}
-	case 322:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:3007
 		{
 			if yyDollar[2].int64 == 0 {
 				yylex.Error("syntax error: NUM OF SHARDS SHOULD LARGER THAN 0")
 			}
 			yyVAL.int64 = yyDollar[2].int64
 		}
-	case 323:
+	case 368:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2688
+//line sql.y:3015
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 324:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2692
+//line sql.y:3019
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 325:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2698
+//line sql.y:3025
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 326:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2703
+//line sql.y:3030
 		{
 			yyVAL.strSlice = nil
 		}
-	case 327:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2706
+//line sql.y:3033
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 328:
+	case 373:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2711
+//line sql.y:3038
 		{
 			yyVAL.strSlice = nil
 		}
-	case 329:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2714
+//line sql.y:3041
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 330:
+	case 375:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2719
+//line sql.y:3046
 		{
 			yyVAL.strSlices = nil
 		}
-	case 331:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2722
+//line sql.y:3049
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 332:
+	case 377:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2727
+//line sql.y:3054
 		{
 			yyVAL.str = "row"
 		}
-	case 333:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2731
+//line sql.y:3058
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4352,9 +4810,9 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 334:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2742
+//line sql.y:3069
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4383,40 +4841,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 335:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2771
+//line sql.y:3098
 		{
 			yyVAL.stmt = nil
 		}
-	case 336:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2777
+//line sql.y:3104
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 337:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2783
+//line sql.y:3110
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 338:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2789
+//line sql.y:3116
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 339:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2794
+//line sql.y:3121
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 340:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2800
+//line sql.y:3127
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4424,9 +4882,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 341:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2809
+//line sql.y:3136
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4434,9 +4892,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 342:
+	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2818
+//line sql.y:3145
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4444,27 +4902,27 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 343:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2828
+//line sql.y:3155
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 344:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2836
+//line sql.y:3163
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 345:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2845
+//line sql.y:3172
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4473,28 +4931,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 346:
+	case 391:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2854
+//line sql.y:3181
 		{
 			yyVAL.indexType = nil
 		}
-	case 347:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2860
+//line sql.y:3187
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 348:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2864
+//line sql.y:3191
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 349:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2871
+//line sql.y:3198
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4503,27 +4961,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 350:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2880
+//line sql.y:3207
 		{
 			yyVAL.str = "hash"
 		}
-	case 351:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2886
+//line sql.y:3213
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 352:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2892
+//line sql.y:3219
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 353:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2898
+//line sql.y:3225
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4532,82 +4990,82 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 354:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2908
+//line sql.y:3235
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 355:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2914
+//line sql.y:3241
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 356:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2920
+//line sql.y:3247
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 357:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2924
+//line sql.y:3251
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 358:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:3255
 		{
 			yyVAL.strSlices = nil
 		}
-	case 359:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2934
+//line sql.y:3261
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 360:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2938
+//line sql.y:3265
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 361:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2943
+//line sql.y:3270
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 362:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2949
+//line sql.y:3276
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 363:
+	case 408:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2957
+//line sql.y:3284
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 364:
+	case 409:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2968
+//line sql.y:3295
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 365:
+	case 410:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2976
+//line sql.y:3303
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4619,9 +5077,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 366:
+	case 411:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2988
+//line sql.y:3315
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4632,9 +5090,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 367:
+	case 412:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2999
+//line sql.y:3326
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4646,9 +5104,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 368:
+	case 413:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3011
+//line sql.y:3338
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4659,9 +5117,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 414:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3025
+//line sql.y:3352
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4673,9 +5131,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 415:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3037
+//line sql.y:3364
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4686,9 +5144,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 371:
+	case 416:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3048
+//line sql.y:3375
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4700,9 +5158,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 372:
+	case 417:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3060
+//line sql.y:3387
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4713,23 +5171,23 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 373:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3074
+//line sql.y:3401
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 374:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3079
+//line sql.y:3406
 		{
 			stmt := &ShowShardsStatement{mstInfo: yyDollar[4].ment}
 			yyVAL.stmt = stmt
 		}
-	case 375:
+	case 420:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3087
+//line sql.y:3414
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4740,9 +5198,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 376:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3098
+//line sql.y:3425
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4751,34 +5209,34 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 377:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3112
+//line sql.y:3439
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 378:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3119
+//line sql.y:3446
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.RpName = ""
 			yyVAL.stmt = stmt
 		}
-	case 379:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3126
+//line sql.y:3453
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[5].str
 			stmt.RpName = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 380:
+	case 425:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3136
+//line sql.y:3463
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4791,64 +5249,76 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 381:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3151
+//line sql.y:3478
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 382:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3157
+//line sql.y:3484
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 383:
+	case 428:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3163
+//line sql.y:3490
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 384:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3170
+//line sql.y:3497
 		{
 			yyVAL.cqsp = nil
 		}
-	case 385:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3503
 		{
 			yyVAL.stmt = &ShowContinuousQueriesStatement{}
 		}
-	case 386:
+	case 431:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3182
+//line sql.y:3509
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 387:
+	case 432:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3517
+		{
+			yyVAL.stmt = newBackfillContinuousQueryStatement(yylex, yyDollar[4].str, "", yyDollar[6].str, yyDollar[8].str)
+		}
+	case 433:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3521
+		{
+			yyVAL.stmt = newBackfillContinuousQueryStatement(yylex, yyDollar[4].str, yyDollar[6].str, yyDollar[8].str, yyDollar[10].str)
+		}
+	case 434:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3190
+//line sql.y:3527
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 388:
+	case 435:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3197
+//line sql.y:3534
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4856,65 +5326,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 389:
+	case 436:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3205
+//line sql.y:3542
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 390:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3213
+//line sql.y:3550
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 391:
+	case 438:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3219
+//line sql.y:3556
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 392:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3226
+//line sql.y:3563
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 393:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3232
+//line sql.y:3569
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 394:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3241
+//line sql.y:3578
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 395:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3245
+//line sql.y:3582
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 396:
+	case 443:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3253
+//line sql.y:3590
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -4922,21 +5392,21 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 397:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3263
+//line sql.y:3600
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 398:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3267
+//line sql.y:3604
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 399:
+	case 446:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3274
+//line sql.y:3611
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4958,9 +5428,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 400:
+	case 447:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3296
+//line sql.y:3633
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -4981,112 +5451,118 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 401:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3319
+//line sql.y:3656
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 402:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3323
+//line sql.y:3660
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 403:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3329
+//line sql.y:3666
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 404:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3334
+//line sql.y:3671
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 405:
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3676
+		{
+			yyVAL.stmt = &ShowResourceGroupsStatement{}
+		}
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3339
+//line sql.y:3681
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 406:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3345
+//line sql.y:3687
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 407:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3349
+//line sql.y:3691
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 408:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3355
+//line sql.y:3697
 		{
 			yyVAL.str = "ALL"
 		}
-	case 409:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3359
+//line sql.y:3701
 		{
 			yyVAL.str = "ANY"
 		}
-	case 410:
+	case 458:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3365
+//line sql.y:3707
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 411:
+	case 459:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3369
+//line sql.y:3711
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 412:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3375
+//line sql.y:3717
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 413:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3381
+//line sql.y:3723
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 414:
+	case 462:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3385
+//line sql.y:3727
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 415:
+	case 463:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3389
+//line sql.y:3731
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 416:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3393
+//line sql.y:3735
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 417:
+	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3399
+//line sql.y:3741
 		{
 			stmt := &ShowConfigsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 418:
+	case 466:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3406
+//line sql.y:3748
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5094,9 +5570,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 419:
+	case 467:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3414
+//line sql.y:3756
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5104,9 +5580,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 420:
+	case 468:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3422
+//line sql.y:3764
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5114,9 +5590,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 421:
+	case 469:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3430
+//line sql.y:3772
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5124,9 +5600,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 422:
+	case 470:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3438
+//line sql.y:3780
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5134,17 +5610,17 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 423:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3448
+//line sql.y:3790
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 424:
+	case 472:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3454
+//line sql.y:3796
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5155,9 +5631,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 425:
+	case 473:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3465
+//line sql.y:3807
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5167,9 +5643,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 426:
+	case 474:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3475
+//line sql.y:3817
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5184,9 +5660,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 427:
+	case 475:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3490
+//line sql.y:3832
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
@@ -178,6 +178,81 @@ func ApplySetAdminPrivilege(data *Data, cmd *proto2.Command) error {
 	return err
 }
 
+func ApplyCreateRole(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateRoleCommand_Command)
+	v, ok := ext.(*proto2.CreateRoleCommand)
+	if !ok {
+		DataLogger.Error("applyCreateRole err")
+	}
+	err := data.CreateRole(v.GetName())
+	DataLogger.Info("apply create role command", zap.String("role", v.GetName()), zap.Error(err))
+	return err
+}
+
+func ApplyDropRole(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropRoleCommand_Command)
+	v, ok := ext.(*proto2.DropRoleCommand)
+	if !ok {
+		DataLogger.Error("applyDropRole err")
+	}
+	err := data.DropRole(v.GetName())
+	DataLogger.Info("apply drop role command", zap.String("role", v.GetName()), zap.Error(err))
+	return err
+}
+
+func ApplySetMeasurementPrivilege(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetMeasurementPrivilegeCommand_Command)
+	v, ok := ext.(*proto2.SetMeasurementPrivilegeCommand)
+	if !ok {
+		DataLogger.Error("applySetMeasurementPrivilege err")
+	}
+	err := data.SetMeasurementPrivilege(v.GetName(), v.GetDatabase(), v.GetMeasurement(), originql.Privilege(v.GetPrivilege()), v.GetDeny())
+	DataLogger.Info("apply set measurement privilege command", zap.String("name", v.GetName()),
+		zap.String("db", v.GetDatabase()), zap.String("mst", v.GetMeasurement()), zap.Int32("privilege", v.GetPrivilege()),
+		zap.Bool("deny", v.GetDeny()), zap.Error(err))
+	return err
+}
+
+func ApplyRevokePrivilege(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_RevokePrivilegeCommand_Command)
+	v, ok := ext.(*proto2.RevokePrivilegeCommand)
+	if !ok {
+		DataLogger.Error("applyRevokePrivilege err")
+	}
+	err := data.RevokePrivilege(v.GetName(), v.GetDatabase(), v.GetMeasurement(), originql.Privilege(v.GetPrivilege()))
+	DataLogger.Info("apply revoke privilege command", zap.String("name", v.GetName()),
+		zap.String("db", v.GetDatabase()), zap.String("mst", v.GetMeasurement()), zap.Int32("privilege", v.GetPrivilege()), zap.Error(err))
+	return err
+}
+
+func ApplySetUserRole(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_SetUserRoleCommand_Command)
+	v, ok := ext.(*proto2.SetUserRoleCommand)
+	if !ok {
+		DataLogger.Error("applySetUserRole err")
+	}
+	err := data.SetUserRole(v.GetUsername(), v.GetRole(), v.GetRevoke())
+	DataLogger.Info("apply set user role command", zap.String("userID", v.GetUsername()), zap.String("role", v.GetRole()),
+		zap.Bool("revoke", v.GetRevoke()), zap.Error(err))
+	return err
+}
+
+func ApplyUpdatePromMetadata(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdatePromMetadataCommand_Command)
+	v, ok := ext.(*proto2.UpdatePromMetadataCommand)
+	if !ok {
+		DataLogger.Error("applyUpdatePromMetadata err")
+	}
+	metadata := make([]PromMetricMetadata, len(v.GetMetadata()))
+	for i, m := range v.GetMetadata() {
+		metadata[i].unmarshal(m)
+	}
+	err := data.UpdatePromMetadata(v.GetDatabase(), v.GetRetentionPolicy(), metadata)
+	DataLogger.Info("apply update prom metadata command", zap.String("db", v.GetDatabase()),
+		zap.String("rp", v.GetRetentionPolicy()), zap.Int("metadata", len(metadata)), zap.Error(err))
+	return err
+}
+
 func ApplyCreateMetaNode(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_CreateMetaNodeCommand_Command)
 	v, ok := ext.(*proto2.CreateMetaNodeCommand)
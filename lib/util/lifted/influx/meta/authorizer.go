@@ -21,6 +21,7 @@ import (
 // rwusers can set password for none sys users
 // rwusers can grant [READ,WRITE,ALL] ON <db> TO <user> for none sys users
 // rwusers can revoke [READ,WRITE,ALL] ON <db> TO <user> for none sys users
+// rwusers can deny [READ,WRITE,ALL] ON <db>[.<measurement>] TO <user> for none sys users
 // rwusers can show grants for none sys users
 // rwusers can not drop _internal
 func (u *UserInfo) AuthorizeQueryForRwUser(database string, query *influxql.Query) error {
@@ -53,6 +54,8 @@ func (u *UserInfo) AuthorizeQueryForRwUser(database string, query *influxql.Quer
 			continue
 		case *influxql.RevokeStatement:
 			continue
+		case *influxql.DenyStatement:
+			continue
 		case *influxql.ShowGrantsForUserStatement:
 			continue
 		case *influxql.DropDatabaseStatement:
@@ -127,12 +130,16 @@ func (u *UserInfo) AuthorizeQuery(database string, query *influxql.Query) error
 			if db == "" {
 				db = database
 			}
-			if !u.AuthorizeDatabase(originql.Privilege(p.Privilege), db) {
+			if !u.authorizePrivilege(p, db) {
+				on := db
+				if p.Measurement != "" && !p.MeasurementRegex {
+					on = influxql.QuoteIdent(db, p.Measurement)
+				}
 				return &ErrAuthorize{
 					Query:    query,
 					User:     u.Name,
 					Database: database,
-					Message:  fmt.Sprintf("statement '%s', requires %s on %s", stmt, p.Privilege.String(), db),
+					Message:  fmt.Sprintf("statement '%s', requires %s on %s", stmt, p.Privilege.String(), on),
 				}
 			}
 		}
@@ -140,6 +147,22 @@ func (u *UserInfo) AuthorizeQuery(database string, query *influxql.Query) error
 	return nil
 }
 
+// authorizePrivilege checks the execution privilege on the measurement if it is required on a measurement,
+// on any measurement if the result is filtered by the measurements, otherwise on the database.
+func (u *UserInfo) authorizePrivilege(p influxql.ExecutionPrivilege, database string) bool {
+	privilege := originql.Privilege(p.Privilege)
+	switch {
+	case p.AnyMeasurement:
+		return u.AuthorizeAnyMeasurement(privilege, database)
+	case p.MeasurementRegex:
+		return u.AuthorizeAllMeasurements(privilege, database)
+	case p.Measurement != "":
+		return u.AuthorizeMeasurement(privilege, database, p.Measurement)
+	default:
+		return u.AuthorizeDatabase(privilege, database)
+	}
+}
+
 // ErrAuthorize represents an authorization error.
 type ErrAuthorize struct {
 	Query    *influxql.Query
@@ -155,3 +178,8 @@ func (e ErrAuthorize) Error() string {
 	}
 	return fmt.Sprintf("%s not authorized to execute %s", e.User, e.Message)
 }
+
+// AuthorizationFailed allows the error to be recognized as an authorization error.
+func (e ErrAuthorize) AuthorizationFailed() bool {
+	return true
+}
@@ -193,6 +193,7 @@ type Data struct {
 	Databases     map[string]*DatabaseInfo
 	Streams       map[string]*StreamInfo
 	Users         []UserInfo
+	Roles         map[string]*RoleInfo
 	MigrateEvents map[string]*MigrateEventInfo
 
 	// Query ID range segment allocated by all sql nodes
@@ -386,6 +387,12 @@ func init() {
 		proto2.Command_RemoveNodeCommand:                {},
 		proto2.Command_UpdateReplicationCommand:         {},
 		proto2.Command_UpdateMeasurementCommand:         {},
+		proto2.Command_CreateRoleCommand:                {},
+		proto2.Command_DropRoleCommand:                  {},
+		proto2.Command_SetMeasurementPrivilegeCommand:   {},
+		proto2.Command_RevokePrivilegeCommand:           {},
+		proto2.Command_SetUserRoleCommand:               {},
+		proto2.Command_UpdatePromMetadataCommand:        {},
 	}
 }
 
@@ -1687,6 +1694,11 @@ func (data *Data) DropDatabase(name string) {
 
 	for i := range data.Users {
 		delete(data.Users[i].Privileges, name)
+		data.Users[i].dropDatabase(name)
+	}
+	for _, role := range data.Roles {
+		delete(role.Privileges, name)
+		role.dropDatabase(name)
 	}
 
 	if data.PtView != nil {
@@ -1825,6 +1837,7 @@ func (data *Data) DropMeasurement(database, policy, nameWithVer string) error {
 	for k, m := range rpi.Measurements {
 		if k == nameWithVer && m.MarkDeleted {
 			delete(rpi.Measurements, nameWithVer)
+			rpi.dropPromMetadata(influx.GetOriginMstName(nameWithVer))
 			break
 		}
 	}
@@ -2765,6 +2778,8 @@ func (data *Data) CreateUser(name, hash string, admin, rwuser bool) error {
 		return ErrUsernameRequired
 	} else if data.User(name) != nil {
 		return ErrUserExists
+	} else if data.Roles[name] != nil {
+		return ErrRoleNameConflict
 	}
 
 	if admin && data.HasAdminUser() {
@@ -2832,22 +2847,22 @@ func (data *Data) CloneUsers() []UserInfo {
 	return users
 }
 
-// SetPrivilege sets a privilege for a GetUser on a database.
+// SetPrivilege sets a privilege for a GetUser or role on a database.
 func (data *Data) SetPrivilege(name, database string, p originql.Privilege) error {
-	ui := data.GetUser(name)
-	if ui == nil {
-		return ErrUserNotFound
+	privs, _, err := data.grantee(name)
+	if err != nil {
+		return err
 	}
 
-	_, err := data.GetDatabase(database)
+	_, err = data.GetDatabase(database)
 	if err != nil {
 		return err
 	}
 
-	if ui.Privileges == nil {
-		ui.Privileges = make(map[string]originql.Privilege)
+	if *privs == nil {
+		*privs = make(map[string]originql.Privilege)
 	}
-	ui.Privileges[database] = p
+	(*privs)[database] = p
 
 	return nil
 }
@@ -2893,6 +2908,156 @@ func (data *Data) UserPrivilege(name, database string) (*originql.Privilege, err
 	return originql.NewPrivilege(originql.NoPrivileges), nil
 }
 
+// grantee returns the database privileges and the measurement grants of the GetUser or role with the name.
+func (data *Data) grantee(name string) (*map[string]originql.Privilege, *MeasurementGrants, error) {
+	if ui := data.GetUser(name); ui != nil {
+		return &ui.Privileges, &ui.MeasurementGrants, nil
+	}
+	if role := data.Roles[name]; role != nil {
+		return &role.Privileges, &role.MeasurementGrants, nil
+	}
+	return nil, nil, ErrUserNotFound
+}
+
+// SetMeasurementPrivilege grants or denies a privilege for a GetUser or role on a measurement.
+// The empty measurement name denies the privilege on the whole database.
+func (data *Data) SetMeasurementPrivilege(name, database, measurement string, p originql.Privilege, deny bool) error {
+	if measurement == "" && !deny {
+		return data.SetPrivilege(name, database, p)
+	}
+
+	_, grants, err := data.grantee(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = data.GetDatabase(database); err != nil {
+		return err
+	}
+
+	grants.set(database, measurement, p, deny)
+	return nil
+}
+
+// RevokePrivilege revokes a privilege for a GetUser or role on a database or measurement.
+// Both the granted and denied privilege are revoked.
+func (data *Data) RevokePrivilege(name, database, measurement string, p originql.Privilege) error {
+	privs, grants, err := data.grantee(name)
+	if err != nil {
+		return err
+	}
+
+	if measurement == "" {
+		if granted, ok := (*privs)[database]; ok {
+			(*privs)[database] = granted &^ p
+		}
+	}
+	grants.revoke(database, measurement, p)
+	return nil
+}
+
+// Role returns a role by name.
+func (data *Data) Role(name string) *RoleInfo {
+	return data.Roles[name]
+}
+
+// CreateRole creates a new role.
+func (data *Data) CreateRole(name string) error {
+	if name == "" {
+		return ErrRoleNameRequired
+	} else if data.Roles[name] != nil {
+		return ErrRoleExists
+	} else if data.User(name) != nil {
+		return ErrRoleNameConflict
+	}
+
+	if data.Roles == nil {
+		data.Roles = make(map[string]*RoleInfo)
+	}
+	data.Roles[name] = &RoleInfo{Name: name}
+	return nil
+}
+
+// DropRole removes an existing role by name, and unassigns it from the users.
+func (data *Data) DropRole(name string) error {
+	if data.Roles[name] == nil {
+		return ErrRoleNotFound
+	}
+
+	delete(data.Roles, name)
+	for i := range data.Users {
+		u := &data.Users[i]
+		for j := range u.Roles {
+			if u.Roles[j] == name {
+				u.Roles = append(u.Roles[:j], u.Roles[j+1:]...)
+				break
+			}
+		}
+	}
+	data.resolveRoles()
+	return nil
+}
+
+// SetUserRole assigns the role to the GetUser, or unassigns it if revoke is true.
+func (data *Data) SetUserRole(username, role string, revoke bool) error {
+	ui := data.GetUser(username)
+	if ui == nil {
+		return ErrUserNotFound
+	}
+	if data.Roles[role] == nil {
+		return ErrRoleNotFound
+	}
+
+	roles := make([]string, 0, len(ui.Roles)+1)
+	for _, name := range ui.Roles {
+		if name != role {
+			roles = append(roles, name)
+		}
+	}
+	if !revoke {
+		roles = append(roles, role)
+	}
+	ui.Roles = roles
+	data.resolveRoles()
+	return nil
+}
+
+// Grants returns the privileges and deny rules of a GetUser or role.
+func (data *Data) Grants(name string) ([]Grant, error) {
+	if ui := data.GetUser(name); ui != nil {
+		return ui.Grants(), nil
+	}
+	if role := data.Roles[name]; role != nil {
+		return role.Grants(), nil
+	}
+	return nil, ErrUserNotFound
+}
+
+// CloneRoles returns a copy of the roles.
+func (data *Data) CloneRoles() map[string]*RoleInfo {
+	if data.Roles == nil {
+		return nil
+	}
+	roles := make(map[string]*RoleInfo, len(data.Roles))
+	for name, role := range data.Roles {
+		roles[name] = role.clone()
+	}
+	return roles
+}
+
+// resolveRoles refers the roles of the users to the RoleInfo of data.
+func (data *Data) resolveRoles() {
+	for i := range data.Users {
+		u := &data.Users[i]
+		u.roles = u.roles[:0:0]
+		for _, name := range u.Roles {
+			if role := data.Roles[name]; role != nil {
+				u.roles = append(u.roles, role)
+			}
+		}
+	}
+}
+
 // Clone returns a copy of data with a new version.
 func (data *Data) Clone() *Data {
 	other := *data
@@ -2904,6 +3069,8 @@ func (data *Data) Clone() *Data {
 	other.Databases = data.CloneDatabases()
 	other.Streams = data.CloneStreams()
 	other.Users = data.CloneUsers()
+	other.Roles = data.CloneRoles()
+	other.resolveRoles()
 	other.PtView = data.CloneDBPtView()
 	other.MigrateEvents = data.CloneMigrateEvents()
 
@@ -3000,6 +3167,7 @@ func (data *Data) MarshalBase(snapshot bool) *proto2.Data {
 	for i := range data.Users {
 		pb.Users[i] = data.Users[i].marshal()
 	}
+	pb.Roles = data.marshalRoles()
 
 	pb.QueryIDInit = make(map[string]uint64, len(data.QueryIDInit))
 	for host := range data.QueryIDInit {
@@ -3098,6 +3266,17 @@ func (data *Data) Unmarshal(pb *proto2.Data) {
 		data.Users[i].unmarshal(x)
 	}
 
+	data.Roles = nil
+	if roles := pb.GetRoles(); len(roles) > 0 {
+		data.Roles = make(map[string]*RoleInfo, len(roles))
+		for _, x := range roles {
+			role := &RoleInfo{}
+			role.unmarshal(x)
+			data.Roles[role.Name] = role
+		}
+	}
+	data.resolveRoles()
+
 	data.MigrateEvents = make(map[string]*MigrateEventInfo, len(pb.GetMigrateEvents()))
 	for _, me := range pb.GetMigrateEvents() {
 		mei := &MigrateEventInfo{}
@@ -3681,9 +3860,21 @@ func (data *Data) MarshalUsers() *proto2.Data {
 	for i := range data.Users {
 		pb.Users[i] = data.Users[i].marshal()
 	}
+	pb.Roles = data.marshalRoles()
 	return pb
 }
 
+func (data *Data) marshalRoles() []*proto2.RoleInfo {
+	if len(data.Roles) == 0 {
+		return nil
+	}
+	roles := make([]*proto2.RoleInfo, 0, len(data.Roles))
+	for _, role := range data.Roles {
+		roles = append(roles, role.marshal())
+	}
+	return roles
+}
+
 // MarshalBinary encodes the metadata to a binary format.
 func (data *Data) MarshalBinaryUser() ([]byte, error) {
 	return proto.Marshal(data.MarshalUsers())
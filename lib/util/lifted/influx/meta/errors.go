@@ -93,6 +93,10 @@ var (
 
 	ErrMeasurementIsBeingDelete = errors.New("measurement is being delete")
 
+	// ErrPromMetadataExceeded is returned when the Prometheus metric metadata of a retention policy
+	// exceeds MaxPromMetadataNum.
+	ErrPromMetadataExceeded = fmt.Errorf("the number of prometheus metric metadata exceeds %d", MaxPromMetadataNum)
+
 	// ErrRetentionPolicyNameExists is returned when renaming a policy to
 	// the same name as another existing policy.
 	ErrRetentionPolicyNameExists = errors.New("retention policy name already exists")
@@ -203,6 +207,18 @@ var (
 	// ErrUsernameRequired is returned when creating a GetUser without a username.
 	ErrUsernameRequired = errors.New("username required")
 
+	// ErrRoleExists is returned when creating an already existing role.
+	ErrRoleExists = errors.New("role already exists")
+
+	// ErrRoleNotFound is returned when mutating a role that doesn't exist.
+	ErrRoleNotFound = errors.New("role not found")
+
+	// ErrRoleNameRequired is returned when creating a role without a name.
+	ErrRoleNameRequired = errors.New("role name required")
+
+	// ErrRoleNameConflict is returned when a user and a role have the same name.
+	ErrRoleNameConflict = errors.New("user and role can not have the same name")
+
 	// ErrAuthenticate is returned when authentication fails.
 	ErrAuthenticate = errors.New("authentication failed")
 
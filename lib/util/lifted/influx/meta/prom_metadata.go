@@ -0,0 +1,195 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+const (
+	// MaxPromMetadataNum is the max number of the Prometheus metric metadata kept in a retention policy,
+	// the metadata is replicated by raft with the meta data, the new metadata beyond it is dropped.
+	MaxPromMetadataNum = 10000
+
+	// MaxPromMetadataHelpLen is the max length of the help of the Prometheus metric metadata,
+	// the longer help is truncated.
+	MaxPromMetadataHelpLen = 1024
+)
+
+// PromMetricMetadata is the type, help and unit of a Prometheus metric family,
+// received by the remote write of the metric store.
+type PromMetricMetadata struct {
+	MetricStore      string
+	MetricFamilyName string
+	Type             string
+	Help             string
+	Unit             string
+}
+
+// Marshal serializes the metadata to the protobuf message.
+func (m *PromMetricMetadata) Marshal() *proto2.PromMetricMetadata {
+	return &proto2.PromMetricMetadata{
+		MetricStore:      proto.String(m.MetricStore),
+		MetricFamilyName: proto.String(m.MetricFamilyName),
+		Type:             proto.String(m.Type),
+		Help:             proto.String(m.Help),
+		Unit:             proto.String(m.Unit),
+	}
+}
+
+// truncateHelp truncates the help to MaxPromMetadataHelpLen bytes, without splitting a multi-byte character.
+func (m *PromMetricMetadata) truncateHelp() {
+	if len(m.Help) <= MaxPromMetadataHelpLen {
+		return
+	}
+	n := MaxPromMetadataHelpLen
+	for n > 0 && !utf8.RuneStart(m.Help[n]) {
+		n--
+	}
+	m.Help = m.Help[:n]
+}
+
+func (m *PromMetricMetadata) unmarshal(pb *proto2.PromMetricMetadata) {
+	m.MetricStore = pb.GetMetricStore()
+	m.MetricFamilyName = pb.GetMetricFamilyName()
+	m.Type = pb.GetType()
+	m.Help = pb.GetHelp()
+	m.Unit = pb.GetUnit()
+}
+
+// PromMetadataChanged returns true if the metadata differs from the one stored in the retention policy.
+func (rpi *RetentionPolicyInfo) PromMetadataChanged(m *PromMetricMetadata) bool {
+	old, ok := rpi.PromMetadata[m.MetricStore][m.MetricFamilyName]
+	return !ok || old != *m
+}
+
+// PromMetadataToUpdate returns the metadata which is new or changed, with the help truncated to MaxPromMetadataHelpLen.
+// The new metadata beyond MaxPromMetadataNum of the retention policy is dropped, and the number of it is returned.
+func (rpi *RetentionPolicyInfo) PromMetadataToUpdate(metadata []PromMetricMetadata) ([]PromMetricMetadata, int) {
+	var changed []PromMetricMetadata
+	num, dropped := rpi.promMetadataNum(), 0
+	for i := range metadata {
+		m := metadata[i]
+		m.truncateHelp()
+		if !rpi.PromMetadataChanged(&m) {
+			continue
+		}
+		if _, ok := rpi.PromMetadata[m.MetricStore][m.MetricFamilyName]; !ok {
+			if num >= MaxPromMetadataNum {
+				dropped++
+				continue
+			}
+			num++
+		}
+		changed = append(changed, m)
+	}
+	return changed, dropped
+}
+
+func (rpi *RetentionPolicyInfo) promMetadataNum() int {
+	num := 0
+	for _, mst := range rpi.PromMetadata {
+		num += len(mst)
+	}
+	return num
+}
+
+// GetPromMetadata returns the metadata of the metric store sorted by the metric family name.
+func (rpi *RetentionPolicyInfo) GetPromMetadata(mst string) []PromMetricMetadata {
+	metadata := make([]PromMetricMetadata, 0, len(rpi.PromMetadata[mst]))
+	for _, m := range rpi.PromMetadata[mst] {
+		metadata = append(metadata, m)
+	}
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].MetricFamilyName < metadata[j].MetricFamilyName
+	})
+	return metadata
+}
+
+// updatePromMetadata adds or replaces the metadata, it returns the number of the new metadata dropped
+// because the retention policy already holds MaxPromMetadataNum metadata.
+func (rpi *RetentionPolicyInfo) updatePromMetadata(metadata []PromMetricMetadata) int {
+	if rpi.PromMetadata == nil {
+		rpi.PromMetadata = make(map[string]map[string]PromMetricMetadata)
+	}
+	num, dropped := rpi.promMetadataNum(), 0
+	for _, m := range metadata {
+		m.truncateHelp()
+		mst := rpi.PromMetadata[m.MetricStore]
+		if _, ok := mst[m.MetricFamilyName]; !ok {
+			if num >= MaxPromMetadataNum {
+				dropped++
+				continue
+			}
+			num++
+		}
+		if mst == nil {
+			mst = make(map[string]PromMetricMetadata)
+			rpi.PromMetadata[m.MetricStore] = mst
+		}
+		mst[m.MetricFamilyName] = m
+	}
+	return dropped
+}
+
+// marshalPromMetadata serializes the metadata sorted by the metric store and the metric family name,
+// so the same metadata is always serialized to the same bytes.
+func (rpi *RetentionPolicyInfo) marshalPromMetadata() []*proto2.PromMetricMetadata {
+	if len(rpi.PromMetadata) == 0 {
+		return nil
+	}
+	msts := make([]string, 0, len(rpi.PromMetadata))
+	for mst := range rpi.PromMetadata {
+		msts = append(msts, mst)
+	}
+	sort.Strings(msts)
+
+	pb := make([]*proto2.PromMetricMetadata, 0, rpi.promMetadataNum())
+	for _, mst := range msts {
+		for _, m := range rpi.GetPromMetadata(mst) {
+			pb = append(pb, m.Marshal())
+		}
+	}
+	return pb
+}
+
+// dropPromMetadata removes the metadata of the dropped measurement, which is either a metric store,
+// or a metric written without the metric store.
+func (rpi *RetentionPolicyInfo) dropPromMetadata(measurement string) {
+	delete(rpi.PromMetadata, measurement)
+	if mst := rpi.PromMetadata[""]; mst != nil {
+		delete(mst, measurement)
+		if len(mst) == 0 {
+			delete(rpi.PromMetadata, "")
+		}
+	}
+}
+
+// UpdatePromMetadata adds or replaces the Prometheus metric metadata of the retention policy,
+// ErrPromMetadataExceeded is returned if some of the new metadata is dropped.
+func (data *Data) UpdatePromMetadata(database, policy string, metadata []PromMetricMetadata) error {
+	rpi, err := data.RetentionPolicy(database, policy)
+	if err != nil {
+		return err
+	}
+	if dropped := rpi.updatePromMetadata(metadata); dropped > 0 {
+		return ErrPromMetadataExceeded
+	}
+	return nil
+}
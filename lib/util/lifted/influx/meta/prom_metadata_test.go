@@ -0,0 +1,144 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestData_UpdatePromMetadata(t *testing.T) {
+	data := &Data{}
+	data.Databases = map[string]*DatabaseInfo{
+		"prometheus": {
+			Name:                   "prometheus",
+			DefaultRetentionPolicy: "autogen",
+			RetentionPolicies:      map[string]*RetentionPolicyInfo{"autogen": {Name: "autogen"}},
+		},
+	}
+
+	counter := PromMetricMetadata{MetricStore: "mst", MetricFamilyName: "http_requests_total", Type: "counter", Help: "Total HTTP requests."}
+	gauge := PromMetricMetadata{MetricStore: "mst", MetricFamilyName: "go_goroutines", Type: "gauge"}
+	require.Error(t, data.UpdatePromMetadata("db_notfound", "autogen", []PromMetricMetadata{counter}))
+	require.NoError(t, data.UpdatePromMetadata("prometheus", "autogen", []PromMetricMetadata{counter, gauge}))
+
+	rpi := data.Database("prometheus").RetentionPolicy("autogen")
+	require.Equal(t, []PromMetricMetadata{gauge, counter}, rpi.GetPromMetadata("mst"))
+	require.Empty(t, rpi.GetPromMetadata("mst_notfound"))
+
+	require.False(t, rpi.PromMetadataChanged(&counter))
+	changed := counter
+	changed.Help = "The total number of HTTP requests."
+	require.True(t, rpi.PromMetadataChanged(&changed))
+	require.NoError(t, data.UpdatePromMetadata("prometheus", "autogen", []PromMetricMetadata{changed}))
+	require.Equal(t, []PromMetricMetadata{gauge, changed}, rpi.GetPromMetadata("mst"))
+
+	// the metadata is kept in the snapshot of the retention policy
+	other := &RetentionPolicyInfo{}
+	other.unmarshal(rpi.Marshal(true))
+	require.Equal(t, rpi.PromMetadata, other.PromMetadata)
+
+	clone := rpi.Clone()
+	clone.PromMetadata["mst"]["go_goroutines"] = counter
+	require.Equal(t, gauge, rpi.PromMetadata["mst"]["go_goroutines"])
+}
+
+func TestData_DropMeasurement_PromMetadata(t *testing.T) {
+	rpi := &RetentionPolicyInfo{Name: "autogen", Measurements: map[string]*MeasurementInfo{
+		"mst_0000":           {Name: "mst_0000", MarkDeleted: true},
+		"go_goroutines_0000": {Name: "go_goroutines_0000", MarkDeleted: true},
+		"cpu_0000":           {Name: "cpu_0000"},
+	}}
+	data := &Data{}
+	data.Databases = map[string]*DatabaseInfo{
+		"prometheus": {
+			Name:                   "prometheus",
+			DefaultRetentionPolicy: "autogen",
+			RetentionPolicies:      map[string]*RetentionPolicyInfo{"autogen": rpi},
+		},
+	}
+
+	counter := PromMetricMetadata{MetricFamilyName: "http_requests_total", Type: "counter"}
+	require.NoError(t, data.UpdatePromMetadata("prometheus", "autogen", []PromMetricMetadata{
+		{MetricStore: "mst", MetricFamilyName: "node_memory_bytes", Type: "gauge"},
+		{MetricFamilyName: "go_goroutines", Type: "gauge"},
+		counter,
+	}))
+
+	// the metadata of the metric store and of the metric written without it are dropped with the measurement
+	require.NoError(t, data.DropMeasurement("prometheus", "autogen", "mst_0000"))
+	require.NoError(t, data.DropMeasurement("prometheus", "autogen", "go_goroutines_0000"))
+	require.Empty(t, rpi.GetPromMetadata("mst"))
+	require.Equal(t, []PromMetricMetadata{counter}, rpi.GetPromMetadata(""))
+
+	// the measurement is not dropped if it is not marked deleted
+	rpi.PromMetadata["cpu"] = map[string]PromMetricMetadata{"cpu_usage": {MetricStore: "cpu", MetricFamilyName: "cpu_usage"}}
+	require.NoError(t, data.DropMeasurement("prometheus", "autogen", "cpu_0000"))
+	require.Len(t, rpi.GetPromMetadata("cpu"), 1)
+}
+
+func TestRetentionPolicyInfo_PromMetadataLimit(t *testing.T) {
+	rpi := &RetentionPolicyInfo{Name: "autogen"}
+
+	// the help is truncated without splitting a character
+	long := PromMetricMetadata{MetricFamilyName: "long_help", Type: "gauge", Help: "a" + strings.Repeat("é", MaxPromMetadataHelpLen)}
+	changed, dropped := rpi.PromMetadataToUpdate([]PromMetricMetadata{long})
+	require.Equal(t, 0, dropped)
+	require.Equal(t, 1, len(changed))
+	require.Equal(t, MaxPromMetadataHelpLen-1, len(changed[0].Help))
+	require.True(t, utf8.ValidString(changed[0].Help))
+	require.Equal(t, 0, rpi.updatePromMetadata([]PromMetricMetadata{long}))
+	require.Equal(t, changed[0], rpi.PromMetadata[""]["long_help"])
+	changed, _ = rpi.PromMetadataToUpdate([]PromMetricMetadata{long})
+	require.Empty(t, changed)
+
+	metadata := make([]PromMetricMetadata, MaxPromMetadataNum)
+	for i := range metadata {
+		metadata[i] = PromMetricMetadata{MetricStore: "mst", MetricFamilyName: fmt.Sprintf("metric_%d", i), Type: "counter"}
+	}
+	changed, dropped = rpi.PromMetadataToUpdate(metadata)
+	require.Equal(t, MaxPromMetadataNum-1, len(changed))
+	require.Equal(t, 1, dropped)
+	require.Equal(t, 1, rpi.updatePromMetadata(metadata))
+	require.Equal(t, MaxPromMetadataNum, rpi.promMetadataNum())
+
+	// the stored metadata is still updated beyond the limit
+	update := metadata[0]
+	update.Help = "updated"
+	changed, dropped = rpi.PromMetadataToUpdate([]PromMetricMetadata{update, {MetricFamilyName: "new", Type: "gauge"}})
+	require.Equal(t, []PromMetricMetadata{update}, changed)
+	require.Equal(t, 1, dropped)
+}
+
+func TestRetentionPolicyInfo_MarshalPromMetadata(t *testing.T) {
+	rpi := &RetentionPolicyInfo{Name: "autogen"}
+	rpi.updatePromMetadata([]PromMetricMetadata{
+		{MetricStore: "mst", MetricFamilyName: "b", Type: "gauge"},
+		{MetricFamilyName: "c", Type: "counter"},
+		{MetricStore: "mst", MetricFamilyName: "a", Type: "gauge"},
+		{MetricStore: "mst0", MetricFamilyName: "a", Type: "gauge"},
+	})
+
+	// the metadata is marshalled in order, so the replicas serialize the same bytes
+	var names []string
+	for _, m := range rpi.Marshal(true).GetPromMetadata() {
+		names = append(names, m.GetMetricStore()+"."+m.GetMetricFamilyName())
+	}
+	require.Equal(t, []string{".c", "mst.a", "mst.b", "mst0.a"}, names)
+}
@@ -99,6 +99,12 @@ const (
 	Command_UpdateMeasurementCommand              Command_Type = 101
 	Command_UpdateMetaNodeStatusCommand           Command_Type = 102
 	Command_ShowClusterCommand                    Command_Type = 103
+	Command_CreateRoleCommand                     Command_Type = 104
+	Command_DropRoleCommand                       Command_Type = 105
+	Command_SetMeasurementPrivilegeCommand        Command_Type = 106
+	Command_RevokePrivilegeCommand                Command_Type = 107
+	Command_SetUserRoleCommand                    Command_Type = 108
+	Command_UpdatePromMetadataCommand             Command_Type = 109
 )
 
 var Command_Type_name = map[int32]string{
@@ -178,6 +184,12 @@ var Command_Type_name = map[int32]string{
 	101: "UpdateMeasurementCommand",
 	102: "UpdateMetaNodeStatusCommand",
 	103: "ShowClusterCommand",
+	104: "CreateRoleCommand",
+	105: "DropRoleCommand",
+	106: "SetMeasurementPrivilegeCommand",
+	107: "RevokePrivilegeCommand",
+	108: "SetUserRoleCommand",
+	109: "UpdatePromMetadataCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -257,6 +269,12 @@ var Command_Type_value = map[string]int32{
 	"UpdateMeasurementCommand":              101,
 	"UpdateMetaNodeStatusCommand":           102,
 	"ShowClusterCommand":                    103,
+	"CreateRoleCommand":                     104,
+	"DropRoleCommand":                       105,
+	"SetMeasurementPrivilegeCommand":        106,
+	"RevokePrivilegeCommand":                107,
+	"SetUserRoleCommand":                    108,
+	"UpdatePromMetadataCommand":             109,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -279,7 +297,7 @@ func (x *Command_Type) UnmarshalJSON(data []byte) error {
 }
 
 func (Command_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{35, 0}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{38, 0}
 }
 
 type Data struct {
@@ -315,6 +333,7 @@ type Data struct {
 	IsSQLiteEnabled      *bool                    `protobuf:"varint,32,opt,name=IsSQLiteEnabled" json:"IsSQLiteEnabled,omitempty"`
 	SqlNodes             []*DataNode              `protobuf:"bytes,33,rep,name=SqlNodes" json:"SqlNodes,omitempty"`
 	MaxMstID             *uint64                  `protobuf:"varint,34,opt,name=MaxMstID" json:"MaxMstID,omitempty"`
+	Roles                []*RoleInfo              `protobuf:"bytes,35,rep,name=Roles" json:"Roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -568,6 +587,13 @@ func (m *Data) GetMaxMstID() uint64 {
 	return 0
 }
 
+func (m *Data) GetRoles() []*RoleInfo {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type Replications struct {
 	Groups               []*ReplicaGroup `protobuf:"bytes,1,rep,name=Groups" json:"Groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1399,6 +1425,7 @@ type RetentionPolicyInfo struct {
 	IndexGroupDuration   *int64                `protobuf:"varint,11,req,name=IndexGroupDuration" json:"IndexGroupDuration,omitempty"`
 	IndexGroups          []*IndexGroupInfo     `protobuf:"bytes,12,rep,name=IndexGroups" json:"IndexGroups,omitempty"`
 	DownSamplePolicyInfo *DownSamplePolicyInfo `protobuf:"bytes,13,opt,name=DownSamplePolicyInfo" json:"DownSamplePolicyInfo,omitempty"`
+	PromMetadata         []*PromMetricMetadata `protobuf:"bytes,15,rep,name=PromMetadata" json:"PromMetadata,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1526,6 +1553,83 @@ func (m *RetentionPolicyInfo) GetDownSamplePolicyInfo() *DownSamplePolicyInfo {
 	return nil
 }
 
+func (m *RetentionPolicyInfo) GetPromMetadata() []*PromMetricMetadata {
+	if m != nil {
+		return m.PromMetadata
+	}
+	return nil
+}
+
+type PromMetricMetadata struct {
+	MetricStore          *string  `protobuf:"bytes,1,req,name=MetricStore" json:"MetricStore,omitempty"`
+	MetricFamilyName     *string  `protobuf:"bytes,2,req,name=MetricFamilyName" json:"MetricFamilyName,omitempty"`
+	Type                 *string  `protobuf:"bytes,3,req,name=Type" json:"Type,omitempty"`
+	Help                 *string  `protobuf:"bytes,4,opt,name=Help" json:"Help,omitempty"`
+	Unit                 *string  `protobuf:"bytes,5,opt,name=Unit" json:"Unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromMetricMetadata) Reset()         { *m = PromMetricMetadata{} }
+func (m *PromMetricMetadata) String() string { return proto.CompactTextString(m) }
+func (*PromMetricMetadata) ProtoMessage()    {}
+func (*PromMetricMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{14}
+}
+func (m *PromMetricMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PromMetricMetadata.Unmarshal(m, b)
+}
+func (m *PromMetricMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PromMetricMetadata.Marshal(b, m, deterministic)
+}
+func (m *PromMetricMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromMetricMetadata.Merge(m, src)
+}
+func (m *PromMetricMetadata) XXX_Size() int {
+	return xxx_messageInfo_PromMetricMetadata.Size(m)
+}
+func (m *PromMetricMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromMetricMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromMetricMetadata proto.InternalMessageInfo
+
+func (m *PromMetricMetadata) GetMetricStore() string {
+	if m != nil && m.MetricStore != nil {
+		return *m.MetricStore
+	}
+	return ""
+}
+
+func (m *PromMetricMetadata) GetMetricFamilyName() string {
+	if m != nil && m.MetricFamilyName != nil {
+		return *m.MetricFamilyName
+	}
+	return ""
+}
+
+func (m *PromMetricMetadata) GetType() string {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ""
+}
+
+func (m *PromMetricMetadata) GetHelp() string {
+	if m != nil && m.Help != nil {
+		return *m.Help
+	}
+	return ""
+}
+
+func (m *PromMetricMetadata) GetUnit() string {
+	if m != nil && m.Unit != nil {
+		return *m.Unit
+	}
+	return ""
+}
+
 type ContinuousQueryInfo struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	Query                *string  `protobuf:"bytes,2,req,name=Query" json:"Query,omitempty"`
@@ -1539,7 +1643,7 @@ func (m *ContinuousQueryInfo) Reset()         { *m = ContinuousQueryInfo{} }
 func (m *ContinuousQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*ContinuousQueryInfo) ProtoMessage()    {}
 func (*ContinuousQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{14}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{15}
 }
 func (m *ContinuousQueryInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContinuousQueryInfo.Unmarshal(m, b)
@@ -1598,7 +1702,7 @@ func (m *ShardGroupInfo) Reset()         { *m = ShardGroupInfo{} }
 func (m *ShardGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardGroupInfo) ProtoMessage()    {}
 func (*ShardGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{15}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{16}
 }
 func (m *ShardGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardGroupInfo.Unmarshal(m, b)
@@ -1694,7 +1798,7 @@ func (m *ShardInfo) Reset()         { *m = ShardInfo{} }
 func (m *ShardInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardInfo) ProtoMessage()    {}
 func (*ShardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{16}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{17}
 }
 func (m *ShardInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardInfo.Unmarshal(m, b)
@@ -1798,7 +1902,7 @@ func (m *ShardKeyInfo) Reset()         { *m = ShardKeyInfo{} }
 func (m *ShardKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardKeyInfo) ProtoMessage()    {}
 func (*ShardKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{17}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{18}
 }
 func (m *ShardKeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardKeyInfo.Unmarshal(m, b)
@@ -1850,7 +1954,7 @@ func (m *Idxes) Reset()         { *m = Idxes{} }
 func (m *Idxes) String() string { return proto.CompactTextString(m) }
 func (*Idxes) ProtoMessage()    {}
 func (*Idxes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{18}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{19}
 }
 func (m *Idxes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Idxes.Unmarshal(m, b)
@@ -1890,7 +1994,7 @@ func (m *SubscriptionInfo) Reset()         { *m = SubscriptionInfo{} }
 func (m *SubscriptionInfo) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionInfo) ProtoMessage()    {}
 func (*SubscriptionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{19}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{20}
 }
 func (m *SubscriptionInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriptionInfo.Unmarshal(m, b)
@@ -1942,7 +2046,7 @@ func (m *ShardOwner) Reset()         { *m = ShardOwner{} }
 func (m *ShardOwner) String() string { return proto.CompactTextString(m) }
 func (*ShardOwner) ProtoMessage()    {}
 func (*ShardOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{20}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
 }
 func (m *ShardOwner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardOwner.Unmarshal(m, b)
@@ -1970,21 +2074,24 @@ func (m *ShardOwner) GetNodeID() uint64 {
 }
 
 type UserInfo struct {
-	Name                 *string          `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Hash                 *string          `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
-	Admin                *bool            `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
-	RwUser               *bool            `protobuf:"varint,4,opt,name=RwUser" json:"RwUser,omitempty"`
-	Privileges           []*UserPrivilege `protobuf:"bytes,5,rep,name=Privileges" json:"Privileges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Name                  *string                 `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Hash                  *string                 `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
+	Admin                 *bool                   `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
+	RwUser                *bool                   `protobuf:"varint,4,opt,name=RwUser" json:"RwUser,omitempty"`
+	Privileges            []*UserPrivilege        `protobuf:"bytes,5,rep,name=Privileges" json:"Privileges,omitempty"`
+	MeasurementPrivileges []*MeasurementPrivilege `protobuf:"bytes,6,rep,name=MeasurementPrivileges" json:"MeasurementPrivileges,omitempty"`
+	Denies                []*MeasurementPrivilege `protobuf:"bytes,7,rep,name=Denies" json:"Denies,omitempty"`
+	Roles                 []string                `protobuf:"bytes,8,rep,name=Roles" json:"Roles,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                `json:"-"`
+	XXX_unrecognized      []byte                  `json:"-"`
+	XXX_sizecache         int32                   `json:"-"`
 }
 
 func (m *UserInfo) Reset()         { *m = UserInfo{} }
 func (m *UserInfo) String() string { return proto.CompactTextString(m) }
 func (*UserInfo) ProtoMessage()    {}
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
 }
 func (m *UserInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInfo.Unmarshal(m, b)
@@ -2039,6 +2146,27 @@ func (m *UserInfo) GetPrivileges() []*UserPrivilege {
 	return nil
 }
 
+func (m *UserInfo) GetMeasurementPrivileges() []*MeasurementPrivilege {
+	if m != nil {
+		return m.MeasurementPrivileges
+	}
+	return nil
+}
+
+func (m *UserInfo) GetDenies() []*MeasurementPrivilege {
+	if m != nil {
+		return m.Denies
+	}
+	return nil
+}
+
+func (m *UserInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type UserPrivilege struct {
 	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
 	Privilege            *int32   `protobuf:"varint,2,req,name=Privilege" json:"Privilege,omitempty"`
@@ -2051,7 +2179,7 @@ func (m *UserPrivilege) Reset()         { *m = UserPrivilege{} }
 func (m *UserPrivilege) String() string { return proto.CompactTextString(m) }
 func (*UserPrivilege) ProtoMessage()    {}
 func (*UserPrivilege) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
 }
 func (m *UserPrivilege) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserPrivilege.Unmarshal(m, b)
@@ -2085,6 +2213,122 @@ func (m *UserPrivilege) GetPrivilege() int32 {
 	return 0
 }
 
+type MeasurementPrivilege struct {
+	Database             *string  `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Measurement          *string  `protobuf:"bytes,2,req,name=Measurement" json:"Measurement,omitempty"`
+	Privilege            *int32   `protobuf:"varint,3,req,name=Privilege" json:"Privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeasurementPrivilege) Reset()         { *m = MeasurementPrivilege{} }
+func (m *MeasurementPrivilege) String() string { return proto.CompactTextString(m) }
+func (*MeasurementPrivilege) ProtoMessage()    {}
+func (*MeasurementPrivilege) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
+}
+func (m *MeasurementPrivilege) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeasurementPrivilege.Unmarshal(m, b)
+}
+func (m *MeasurementPrivilege) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeasurementPrivilege.Marshal(b, m, deterministic)
+}
+func (m *MeasurementPrivilege) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeasurementPrivilege.Merge(m, src)
+}
+func (m *MeasurementPrivilege) XXX_Size() int {
+	return xxx_messageInfo_MeasurementPrivilege.Size(m)
+}
+func (m *MeasurementPrivilege) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeasurementPrivilege.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeasurementPrivilege proto.InternalMessageInfo
+
+func (m *MeasurementPrivilege) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *MeasurementPrivilege) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *MeasurementPrivilege) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+type RoleInfo struct {
+	Name                  *string                 `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Privileges            []*UserPrivilege        `protobuf:"bytes,2,rep,name=Privileges" json:"Privileges,omitempty"`
+	MeasurementPrivileges []*MeasurementPrivilege `protobuf:"bytes,3,rep,name=MeasurementPrivileges" json:"MeasurementPrivileges,omitempty"`
+	Denies                []*MeasurementPrivilege `protobuf:"bytes,4,rep,name=Denies" json:"Denies,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                `json:"-"`
+	XXX_unrecognized      []byte                  `json:"-"`
+	XXX_sizecache         int32                   `json:"-"`
+}
+
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
+}
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInfo.Unmarshal(m, b)
+}
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
+}
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
+}
+func (m *RoleInfo) XXX_Size() int {
+	return xxx_messageInfo_RoleInfo.Size(m)
+}
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
+
+func (m *RoleInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetPrivileges() []*UserPrivilege {
+	if m != nil {
+		return m.Privileges
+	}
+	return nil
+}
+
+func (m *RoleInfo) GetMeasurementPrivileges() []*MeasurementPrivilege {
+	if m != nil {
+		return m.MeasurementPrivileges
+	}
+	return nil
+}
+
+func (m *RoleInfo) GetDenies() []*MeasurementPrivilege {
+	if m != nil {
+		return m.Denies
+	}
+	return nil
+}
+
 type IndexRelation struct {
 	Rid                  *uint32         `protobuf:"varint,1,req,name=Rid" json:"Rid,omitempty"`
 	Oid                  []uint32        `protobuf:"varint,2,rep,name=Oid" json:"Oid,omitempty"`
@@ -2100,7 +2344,7 @@ func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
 func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
 func (*IndexRelation) ProtoMessage()    {}
 func (*IndexRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
 }
 func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
@@ -2166,7 +2410,7 @@ func (m *IndexList) Reset()         { *m = IndexList{} }
 func (m *IndexList) String() string { return proto.CompactTextString(m) }
 func (*IndexList) ProtoMessage()    {}
 func (*IndexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
 }
 func (m *IndexList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexList.Unmarshal(m, b)
@@ -2204,7 +2448,7 @@ func (m *RpMeasurementsFieldsInfo) Reset()         { *m = RpMeasurementsFieldsIn
 func (m *RpMeasurementsFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*RpMeasurementsFieldsInfo) ProtoMessage()    {}
 func (*RpMeasurementsFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
 }
 func (m *RpMeasurementsFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpMeasurementsFieldsInfo.Unmarshal(m, b)
@@ -2243,7 +2487,7 @@ func (m *MeasurementFieldsInfo) Reset()         { *m = MeasurementFieldsInfo{} }
 func (m *MeasurementFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementFieldsInfo) ProtoMessage()    {}
 func (*MeasurementFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
 }
 func (m *MeasurementFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementFieldsInfo.Unmarshal(m, b)
@@ -2289,7 +2533,7 @@ func (m *MeasurementTypeFields) Reset()         { *m = MeasurementTypeFields{} }
 func (m *MeasurementTypeFields) String() string { return proto.CompactTextString(m) }
 func (*MeasurementTypeFields) ProtoMessage()    {}
 func (*MeasurementTypeFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
 }
 func (m *MeasurementTypeFields) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementTypeFields.Unmarshal(m, b)
@@ -2341,7 +2585,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -2428,7 +2672,7 @@ func (m *StreamInfos) Reset()         { *m = StreamInfos{} }
 func (m *StreamInfos) String() string { return proto.CompactTextString(m) }
 func (*StreamInfos) ProtoMessage()    {}
 func (*StreamInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
 }
 func (m *StreamInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfos.Unmarshal(m, b)
@@ -2468,7 +2712,7 @@ func (m *StreamMeasurementInfo) Reset()         { *m = StreamMeasurementInfo{} }
 func (m *StreamMeasurementInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamMeasurementInfo) ProtoMessage()    {}
 func (*StreamMeasurementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
 }
 func (m *StreamMeasurementInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeasurementInfo.Unmarshal(m, b)
@@ -2522,7 +2766,7 @@ func (m *StreamCall) Reset()         { *m = StreamCall{} }
 func (m *StreamCall) String() string { return proto.CompactTextString(m) }
 func (*StreamCall) ProtoMessage()    {}
 func (*StreamCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
 }
 func (m *StreamCall) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamCall.Unmarshal(m, b)
@@ -2579,7 +2823,7 @@ func (m *ColStoreInfo) Reset()         { *m = ColStoreInfo{} }
 func (m *ColStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*ColStoreInfo) ProtoMessage()    {}
 func (*ColStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
 }
 func (m *ColStoreInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ColStoreInfo.Unmarshal(m, b)
@@ -2654,7 +2898,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexOption.Unmarshal(m, b)
@@ -2706,7 +2950,7 @@ func (m *IndexOptions) Reset()         { *m = IndexOptions{} }
 func (m *IndexOptions) String() string { return proto.CompactTextString(m) }
 func (*IndexOptions) ProtoMessage()    {}
 func (*IndexOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
 }
 func (m *IndexOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexOptions.Unmarshal(m, b)
@@ -2745,7 +2989,7 @@ func (m *Command) Reset()         { *m = Command{} }
 func (m *Command) String() string { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()    {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
 }
 
 var extRange_Command = []proto.ExtensionRange{
@@ -2797,7 +3041,7 @@ func (m *CreateDatabaseCommand) Reset()         { *m = CreateDatabaseCommand{} }
 func (m *CreateDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabaseCommand) ProtoMessage()    {}
 func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
 }
 func (m *CreateDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDatabaseCommand.Unmarshal(m, b)
@@ -2879,7 +3123,7 @@ func (m *DropDatabaseCommand) Reset()         { *m = DropDatabaseCommand{} }
 func (m *DropDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDatabaseCommand) ProtoMessage()    {}
 func (*DropDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
 }
 func (m *DropDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDatabaseCommand.Unmarshal(m, b)
@@ -2928,7 +3172,7 @@ func (m *CreateRetentionPolicyCommand) Reset()         { *m = CreateRetentionPol
 func (m *CreateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateRetentionPolicyCommand) ProtoMessage()    {}
 func (*CreateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
 }
 func (m *CreateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRetentionPolicyCommand.Unmarshal(m, b)
@@ -2990,7 +3234,7 @@ func (m *DropRetentionPolicyCommand) Reset()         { *m = DropRetentionPolicyC
 func (m *DropRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropRetentionPolicyCommand) ProtoMessage()    {}
 func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
 }
 func (m *DropRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropRetentionPolicyCommand.Unmarshal(m, b)
@@ -3045,7 +3289,7 @@ func (m *SetDefaultRetentionPolicyCommand) Reset()         { *m = SetDefaultRete
 func (m *SetDefaultRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRetentionPolicyCommand) ProtoMessage()    {}
 func (*SetDefaultRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
 }
 func (m *SetDefaultRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDefaultRetentionPolicyCommand.Unmarshal(m, b)
@@ -3108,7 +3352,7 @@ func (m *UpdateRetentionPolicyCommand) Reset()         { *m = UpdateRetentionPol
 func (m *UpdateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateRetentionPolicyCommand) ProtoMessage()    {}
 func (*UpdateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
 }
 func (m *UpdateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRetentionPolicyCommand.Unmarshal(m, b)
@@ -3223,7 +3467,7 @@ func (m *CreateShardGroupCommand) Reset()         { *m = CreateShardGroupCommand
 func (m *CreateShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateShardGroupCommand) ProtoMessage()    {}
 func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
 }
 func (m *CreateShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateShardGroupCommand.Unmarshal(m, b)
@@ -3309,7 +3553,7 @@ func (m *DeleteShardGroupCommand) Reset()         { *m = DeleteShardGroupCommand
 func (m *DeleteShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardGroupCommand) ProtoMessage()    {}
 func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
 }
 func (m *DeleteShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteShardGroupCommand.Unmarshal(m, b)
@@ -3387,7 +3631,7 @@ func (m *CreateUserCommand) Reset()         { *m = CreateUserCommand{} }
 func (m *CreateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateUserCommand) ProtoMessage()    {}
 func (*CreateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
 }
 func (m *CreateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserCommand.Unmarshal(m, b)
@@ -3455,7 +3699,7 @@ func (m *DropUserCommand) Reset()         { *m = DropUserCommand{} }
 func (m *DropUserCommand) String() string { return proto.CompactTextString(m) }
 func (*DropUserCommand) ProtoMessage()    {}
 func (*DropUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
 }
 func (m *DropUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropUserCommand.Unmarshal(m, b)
@@ -3503,7 +3747,7 @@ func (m *UpdateUserCommand) Reset()         { *m = UpdateUserCommand{} }
 func (m *UpdateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserCommand) ProtoMessage()    {}
 func (*UpdateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
 }
 func (m *UpdateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUserCommand.Unmarshal(m, b)
@@ -3559,7 +3803,7 @@ func (m *SetPrivilegeCommand) Reset()         { *m = SetPrivilegeCommand{} }
 func (m *SetPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetPrivilegeCommand) ProtoMessage()    {}
 func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
 }
 func (m *SetPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPrivilegeCommand.Unmarshal(m, b)
@@ -3620,7 +3864,7 @@ func (m *SetDataCommand) Reset()         { *m = SetDataCommand{} }
 func (m *SetDataCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDataCommand) ProtoMessage()    {}
 func (*SetDataCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
 }
 func (m *SetDataCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDataCommand.Unmarshal(m, b)
@@ -3668,7 +3912,7 @@ func (m *SetAdminPrivilegeCommand) Reset()         { *m = SetAdminPrivilegeComma
 func (m *SetAdminPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetAdminPrivilegeCommand) ProtoMessage()    {}
 func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
 }
 func (m *SetAdminPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAdminPrivilegeCommand.Unmarshal(m, b)
@@ -3726,7 +3970,7 @@ func (m *CreateSubscriptionCommand) Reset()         { *m = CreateSubscriptionCom
 func (m *CreateSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSubscriptionCommand) ProtoMessage()    {}
 func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
 }
 func (m *CreateSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSubscriptionCommand.Unmarshal(m, b)
@@ -3803,7 +4047,7 @@ func (m *DropSubscriptionCommand) Reset()         { *m = DropSubscriptionCommand
 func (m *DropSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*DropSubscriptionCommand) ProtoMessage()    {}
 func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
 }
 func (m *DropSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropSubscriptionCommand.Unmarshal(m, b)
@@ -3867,7 +4111,7 @@ func (m *CreateMetaNodeCommand) Reset()         { *m = CreateMetaNodeCommand{} }
 func (m *CreateMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMetaNodeCommand) ProtoMessage()    {}
 func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
 }
 func (m *CreateMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMetaNodeCommand.Unmarshal(m, b)
@@ -3938,7 +4182,7 @@ func (m *CreateDataNodeCommand) Reset()         { *m = CreateDataNodeCommand{} }
 func (m *CreateDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDataNodeCommand) ProtoMessage()    {}
 func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
 }
 func (m *CreateDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDataNodeCommand.Unmarshal(m, b)
@@ -4008,7 +4252,7 @@ func (m *DataNodeEvent) Reset()         { *m = DataNodeEvent{} }
 func (m *DataNodeEvent) String() string { return proto.CompactTextString(m) }
 func (*DataNodeEvent) ProtoMessage()    {}
 func (*DataNodeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
 }
 func (m *DataNodeEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataNodeEvent.Unmarshal(m, b)
@@ -4060,7 +4304,7 @@ func (m *DeleteMetaNodeCommand) Reset()         { *m = DeleteMetaNodeCommand{} }
 func (m *DeleteMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetaNodeCommand) ProtoMessage()    {}
 func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
 }
 func (m *DeleteMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMetaNodeCommand.Unmarshal(m, b)
@@ -4107,7 +4351,7 @@ func (m *DeleteDataNodeCommand) Reset()         { *m = DeleteDataNodeCommand{} }
 func (m *DeleteDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteDataNodeCommand) ProtoMessage()    {}
 func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
 }
 func (m *DeleteDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDataNodeCommand.Unmarshal(m, b)
@@ -4156,7 +4400,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -4213,7 +4457,7 @@ func (m *SetMetaNodeCommand) Reset()         { *m = SetMetaNodeCommand{} }
 func (m *SetMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetMetaNodeCommand) ProtoMessage()    {}
 func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
 }
 func (m *SetMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMetaNodeCommand.Unmarshal(m, b)
@@ -4281,7 +4525,7 @@ func (m *DropShardCommand) Reset()         { *m = DropShardCommand{} }
 func (m *DropShardCommand) String() string { return proto.CompactTextString(m) }
 func (*DropShardCommand) ProtoMessage()    {}
 func (*DropShardCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
 }
 func (m *DropShardCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropShardCommand.Unmarshal(m, b)
@@ -4328,7 +4572,7 @@ func (m *MarkDatabaseDeleteCommand) Reset()         { *m = MarkDatabaseDeleteCom
 func (m *MarkDatabaseDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkDatabaseDeleteCommand) ProtoMessage()    {}
 func (*MarkDatabaseDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
 }
 func (m *MarkDatabaseDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkDatabaseDeleteCommand.Unmarshal(m, b)
@@ -4378,7 +4622,7 @@ func (m *UpdateShardOwnerCommand) Reset()         { *m = UpdateShardOwnerCommand
 func (m *UpdateShardOwnerCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardOwnerCommand) ProtoMessage()    {}
 func (*UpdateShardOwnerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
 }
 func (m *UpdateShardOwnerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardOwnerCommand.Unmarshal(m, b)
@@ -4447,7 +4691,7 @@ func (m *MarkRetentionPolicyDeleteCommand) Reset()         { *m = MarkRetentionP
 func (m *MarkRetentionPolicyDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkRetentionPolicyDeleteCommand) ProtoMessage()    {}
 func (*MarkRetentionPolicyDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
 }
 func (m *MarkRetentionPolicyDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkRetentionPolicyDeleteCommand.Unmarshal(m, b)
@@ -4510,7 +4754,7 @@ func (m *CreateMeasurementCommand) Reset()         { *m = CreateMeasurementComma
 func (m *CreateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMeasurementCommand) ProtoMessage()    {}
 func (*CreateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
 }
 func (m *CreateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMeasurementCommand.Unmarshal(m, b)
@@ -4623,7 +4867,7 @@ func (m *AlterShardKeyCmd) Reset()         { *m = AlterShardKeyCmd{} }
 func (m *AlterShardKeyCmd) String() string { return proto.CompactTextString(m) }
 func (*AlterShardKeyCmd) ProtoMessage()    {}
 func (*AlterShardKeyCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
 }
 func (m *AlterShardKeyCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlterShardKeyCmd.Unmarshal(m, b)
@@ -4695,7 +4939,7 @@ func (m *UpdateDbPtStatusCommand) Reset()         { *m = UpdateDbPtStatusCommand
 func (m *UpdateDbPtStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateDbPtStatusCommand) ProtoMessage()    {}
 func (*UpdateDbPtStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
 }
 func (m *UpdateDbPtStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDbPtStatusCommand.Unmarshal(m, b)
@@ -4774,7 +5018,7 @@ func (m *ReShardingCommand) Reset()         { *m = ReShardingCommand{} }
 func (m *ReShardingCommand) String() string { return proto.CompactTextString(m) }
 func (*ReShardingCommand) ProtoMessage()    {}
 func (*ReShardingCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
 }
 func (m *ReShardingCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReShardingCommand.Unmarshal(m, b)
@@ -4852,7 +5096,7 @@ func (m *UpdateSchemaCommand) Reset()         { *m = UpdateSchemaCommand{} }
 func (m *UpdateSchemaCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSchemaCommand) ProtoMessage()    {}
 func (*UpdateSchemaCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
 }
 func (m *UpdateSchemaCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSchemaCommand.Unmarshal(m, b)
@@ -4922,7 +5166,7 @@ func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
 func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
 func (*FieldSchema) ProtoMessage()    {}
 func (*FieldSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
 }
 func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FieldSchema.Unmarshal(m, b)
@@ -4976,7 +5220,7 @@ func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
 func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexInfo) ProtoMessage()    {}
 func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
 }
 func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
@@ -5033,7 +5277,7 @@ func (m *IndexGroupInfo) Reset()         { *m = IndexGroupInfo{} }
 func (m *IndexGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexGroupInfo) ProtoMessage()    {}
 func (*IndexGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
 }
 func (m *IndexGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexGroupInfo.Unmarshal(m, b)
@@ -5109,7 +5353,7 @@ func (m *ShardStatus) Reset()         { *m = ShardStatus{} }
 func (m *ShardStatus) String() string { return proto.CompactTextString(m) }
 func (*ShardStatus) ProtoMessage()    {}
 func (*ShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
 }
 func (m *ShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardStatus.Unmarshal(m, b)
@@ -5169,7 +5413,7 @@ func (m *RpShardStatus) Reset()         { *m = RpShardStatus{} }
 func (m *RpShardStatus) String() string { return proto.CompactTextString(m) }
 func (*RpShardStatus) ProtoMessage()    {}
 func (*RpShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
 }
 func (m *RpShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpShardStatus.Unmarshal(m, b)
@@ -5216,7 +5460,7 @@ func (m *DBPtStatus) Reset()         { *m = DBPtStatus{} }
 func (m *DBPtStatus) String() string { return proto.CompactTextString(m) }
 func (*DBPtStatus) ProtoMessage()    {}
 func (*DBPtStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
 }
 func (m *DBPtStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DBPtStatus.Unmarshal(m, b)
@@ -5268,7 +5512,7 @@ func (m *ReportShardsLoadCommand) Reset()         { *m = ReportShardsLoadCommand
 func (m *ReportShardsLoadCommand) String() string { return proto.CompactTextString(m) }
 func (*ReportShardsLoadCommand) ProtoMessage()    {}
 func (*ReportShardsLoadCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
 }
 func (m *ReportShardsLoadCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportShardsLoadCommand.Unmarshal(m, b)
@@ -5318,7 +5562,7 @@ func (m *DownSamplePolicyInfo) Reset()         { *m = DownSamplePolicyInfo{} }
 func (m *DownSamplePolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicyInfo) ProtoMessage()    {}
 func (*DownSamplePolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
 }
 func (m *DownSamplePolicyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicyInfo.Unmarshal(m, b)
@@ -5379,7 +5623,7 @@ func (m *DownSamplePolicy) Reset()         { *m = DownSamplePolicy{} }
 func (m *DownSamplePolicy) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicy) ProtoMessage()    {}
 func (*DownSamplePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
 }
 func (m *DownSamplePolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicy.Unmarshal(m, b)
@@ -5432,7 +5676,7 @@ func (m *DownSampleOperators) Reset()         { *m = DownSampleOperators{} }
 func (m *DownSampleOperators) String() string { return proto.CompactTextString(m) }
 func (*DownSampleOperators) ProtoMessage()    {}
 func (*DownSampleOperators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
 }
 func (m *DownSampleOperators) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSampleOperators.Unmarshal(m, b)
@@ -5479,7 +5723,7 @@ func (m *DownSamplePolicyInfoWithDbRp) Reset()         { *m = DownSamplePolicyIn
 func (m *DownSamplePolicyInfoWithDbRp) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicyInfoWithDbRp) ProtoMessage()    {}
 func (*DownSamplePolicyInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
 }
 func (m *DownSamplePolicyInfoWithDbRp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Unmarshal(m, b)
@@ -5531,7 +5775,7 @@ func (m *DownSamplePoliciesInfoWithDbRp) Reset()         { *m = DownSamplePolici
 func (m *DownSamplePoliciesInfoWithDbRp) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePoliciesInfoWithDbRp) ProtoMessage()    {}
 func (*DownSamplePoliciesInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
 }
 func (m *DownSamplePoliciesInfoWithDbRp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Unmarshal(m, b)
@@ -5569,7 +5813,7 @@ func (m *ShardDownSampleUpdateInfos) Reset()         { *m = ShardDownSampleUpdat
 func (m *ShardDownSampleUpdateInfos) String() string { return proto.CompactTextString(m) }
 func (*ShardDownSampleUpdateInfos) ProtoMessage()    {}
 func (*ShardDownSampleUpdateInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
 }
 func (m *ShardDownSampleUpdateInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDownSampleUpdateInfos.Unmarshal(m, b)
@@ -5608,7 +5852,7 @@ func (m *ShardDownSampleUpdateInfo) Reset()         { *m = ShardDownSampleUpdate
 func (m *ShardDownSampleUpdateInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDownSampleUpdateInfo) ProtoMessage()    {}
 func (*ShardDownSampleUpdateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
 }
 func (m *ShardDownSampleUpdateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDownSampleUpdateInfo.Unmarshal(m, b)
@@ -5654,7 +5898,7 @@ func (m *PruneGroupsCommand) Reset()         { *m = PruneGroupsCommand{} }
 func (m *PruneGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*PruneGroupsCommand) ProtoMessage()    {}
 func (*PruneGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
 }
 func (m *PruneGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PruneGroupsCommand.Unmarshal(m, b)
@@ -5710,7 +5954,7 @@ func (m *MarkMeasurementDeleteCommand) Reset()         { *m = MarkMeasurementDel
 func (m *MarkMeasurementDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkMeasurementDeleteCommand) ProtoMessage()    {}
 func (*MarkMeasurementDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
 }
 func (m *MarkMeasurementDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkMeasurementDeleteCommand.Unmarshal(m, b)
@@ -5773,7 +6017,7 @@ func (m *DropMeasurementCommand) Reset()         { *m = DropMeasurementCommand{}
 func (m *DropMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*DropMeasurementCommand) ProtoMessage()    {}
 func (*DropMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
 }
 func (m *DropMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropMeasurementCommand.Unmarshal(m, b)
@@ -5840,7 +6084,7 @@ func (m *NodeStartInfo) Reset()         { *m = NodeStartInfo{} }
 func (m *NodeStartInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeStartInfo) ProtoMessage()    {}
 func (*NodeStartInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
 }
 func (m *NodeStartInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStartInfo.Unmarshal(m, b)
@@ -5922,7 +6166,7 @@ func (m *TimeRangeCommand) Reset()         { *m = TimeRangeCommand{} }
 func (m *TimeRangeCommand) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeCommand) ProtoMessage()    {}
 func (*TimeRangeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
 }
 func (m *TimeRangeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeCommand.Unmarshal(m, b)
@@ -5985,7 +6229,7 @@ func (m *ShardDurationCommand) Reset()         { *m = ShardDurationCommand{} }
 func (m *ShardDurationCommand) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationCommand) ProtoMessage()    {}
 func (*ShardDurationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
 }
 func (m *ShardDurationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationCommand.Unmarshal(m, b)
@@ -6048,7 +6292,7 @@ func (m *DurationDescriptor) Reset()         { *m = DurationDescriptor{} }
 func (m *DurationDescriptor) String() string { return proto.CompactTextString(m) }
 func (*DurationDescriptor) ProtoMessage()    {}
 func (*DurationDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
 }
 func (m *DurationDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DurationDescriptor.Unmarshal(m, b)
@@ -6109,7 +6353,7 @@ func (m *ShardIdentifier) Reset()         { *m = ShardIdentifier{} }
 func (m *ShardIdentifier) String() string { return proto.CompactTextString(m) }
 func (*ShardIdentifier) ProtoMessage()    {}
 func (*ShardIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
 }
 func (m *ShardIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardIdentifier.Unmarshal(m, b)
@@ -6211,7 +6455,7 @@ func (m *TimeRangeInfo) Reset()         { *m = TimeRangeInfo{} }
 func (m *TimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeInfo) ProtoMessage()    {}
 func (*TimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
 }
 func (m *TimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeInfo.Unmarshal(m, b)
@@ -6258,7 +6502,7 @@ func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
 func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
 func (*IndexDescriptor) ProtoMessage()    {}
 func (*IndexDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
 }
 func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexDescriptor.Unmarshal(m, b)
@@ -6312,7 +6556,7 @@ func (m *ShardDurationInfo) Reset()         { *m = ShardDurationInfo{} }
 func (m *ShardDurationInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationInfo) ProtoMessage()    {}
 func (*ShardDurationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
 }
 func (m *ShardDurationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationInfo.Unmarshal(m, b)
@@ -6367,7 +6611,7 @@ func (m *ShardTimeRangeInfo) Reset()         { *m = ShardTimeRangeInfo{} }
 func (m *ShardTimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardTimeRangeInfo) ProtoMessage()    {}
 func (*ShardTimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
 }
 func (m *ShardTimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardTimeRangeInfo.Unmarshal(m, b)
@@ -6427,7 +6671,7 @@ func (m *ShardDurationResponse) Reset()         { *m = ShardDurationResponse{} }
 func (m *ShardDurationResponse) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationResponse) ProtoMessage()    {}
 func (*ShardDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
 }
 func (m *ShardDurationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationResponse.Unmarshal(m, b)
@@ -6474,7 +6718,7 @@ func (m *DeleteIndexGroupCommand) Reset()         { *m = DeleteIndexGroupCommand
 func (m *DeleteIndexGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteIndexGroupCommand) ProtoMessage()    {}
 func (*DeleteIndexGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
 }
 func (m *DeleteIndexGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteIndexGroupCommand.Unmarshal(m, b)
@@ -6538,7 +6782,7 @@ func (m *UpdateShardInfoTierCommand) Reset()         { *m = UpdateShardInfoTierC
 func (m *UpdateShardInfoTierCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardInfoTierCommand) ProtoMessage()    {}
 func (*UpdateShardInfoTierCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
 }
 func (m *UpdateShardInfoTierCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardInfoTierCommand.Unmarshal(m, b)
@@ -6607,7 +6851,7 @@ func (m *CardinalityInfo) Reset()         { *m = CardinalityInfo{} }
 func (m *CardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*CardinalityInfo) ProtoMessage()    {}
 func (*CardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
 }
 func (m *CardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityInfo.Unmarshal(m, b)
@@ -6653,7 +6897,7 @@ func (m *MeasurementCardinalityInfo) Reset()         { *m = MeasurementCardinali
 func (m *MeasurementCardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementCardinalityInfo) ProtoMessage()    {}
 func (*MeasurementCardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
 }
 func (m *MeasurementCardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementCardinalityInfo.Unmarshal(m, b)
@@ -6699,7 +6943,7 @@ func (m *CardinalityResponse) Reset()         { *m = CardinalityResponse{} }
 func (m *CardinalityResponse) String() string { return proto.CompactTextString(m) }
 func (*CardinalityResponse) ProtoMessage()    {}
 func (*CardinalityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
 }
 func (m *CardinalityResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityResponse.Unmarshal(m, b)
@@ -6747,7 +6991,7 @@ func (m *UpdateNodeStatusCommand) Reset()         { *m = UpdateNodeStatusCommand
 func (m *UpdateNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
 }
 func (m *UpdateNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeStatusCommand.Unmarshal(m, b)
@@ -6818,7 +7062,7 @@ func (m *DbPt) Reset()         { *m = DbPt{} }
 func (m *DbPt) String() string { return proto.CompactTextString(m) }
 func (*DbPt) ProtoMessage()    {}
 func (*DbPt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
 }
 func (m *DbPt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DbPt.Unmarshal(m, b)
@@ -6886,7 +7130,7 @@ func (m *MigrateEventInfo) Reset()         { *m = MigrateEventInfo{} }
 func (m *MigrateEventInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrateEventInfo) ProtoMessage()    {}
 func (*MigrateEventInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
 }
 func (m *MigrateEventInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MigrateEventInfo.Unmarshal(m, b)
@@ -6987,7 +7231,7 @@ func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
 func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateEventCommand) ProtoMessage()    {}
 func (*CreateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
 }
 func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
@@ -7034,7 +7278,7 @@ func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
 func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateEventCommand) ProtoMessage()    {}
 func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
 }
 func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
@@ -7084,7 +7328,7 @@ func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
 func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtInfoCommand) ProtoMessage()    {}
 func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
 }
 func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
@@ -7152,7 +7396,7 @@ func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
 func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveEventCommand) ProtoMessage()    {}
 func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
 }
 func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
@@ -7201,7 +7445,7 @@ func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSampleP
 func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
 func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
 }
 func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7264,7 +7508,7 @@ func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolic
 func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
 func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
 }
 func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7324,7 +7568,7 @@ func (m *GetDownSamplePolicyCommand) Reset()         { *m = GetDownSamplePolicyC
 func (m *GetDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*GetDownSamplePolicyCommand) ProtoMessage()    {}
 func (*GetDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
 }
 func (m *GetDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7365,7 +7609,7 @@ func (m *CreateDbPtViewCommand) Reset()         { *m = CreateDbPtViewCommand{} }
 func (m *CreateDbPtViewCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDbPtViewCommand) ProtoMessage()    {}
 func (*CreateDbPtViewCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
 }
 func (m *CreateDbPtViewCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDbPtViewCommand.Unmarshal(m, b)
@@ -7421,7 +7665,7 @@ func (m *GetMeasurementInfoWithinSameRpCommand) Reset()         { *m = GetMeasur
 func (m *GetMeasurementInfoWithinSameRpCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoWithinSameRpCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoWithinSameRpCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
 }
 func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Unmarshal(m, b)
@@ -7482,7 +7726,7 @@ func (m *UpdateShardDownSampleInfoCommand) Reset()         { *m = UpdateShardDow
 func (m *UpdateShardDownSampleInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardDownSampleInfoCommand) ProtoMessage()    {}
 func (*UpdateShardDownSampleInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
 }
 func (m *UpdateShardDownSampleInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Unmarshal(m, b)
@@ -7529,7 +7773,7 @@ func (m *MarkTakeoverCommand) Reset()         { *m = MarkTakeoverCommand{} }
 func (m *MarkTakeoverCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkTakeoverCommand) ProtoMessage()    {}
 func (*MarkTakeoverCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
 }
 func (m *MarkTakeoverCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkTakeoverCommand.Unmarshal(m, b)
@@ -7576,7 +7820,7 @@ func (m *MarkBalancerCommand) Reset()         { *m = MarkBalancerCommand{} }
 func (m *MarkBalancerCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkBalancerCommand) ProtoMessage()    {}
 func (*MarkBalancerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
 }
 func (m *MarkBalancerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkBalancerCommand.Unmarshal(m, b)
@@ -7623,7 +7867,7 @@ func (m *CreateStreamCommand) Reset()         { *m = CreateStreamCommand{} }
 func (m *CreateStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateStreamCommand) ProtoMessage()    {}
 func (*CreateStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
 }
 func (m *CreateStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStreamCommand.Unmarshal(m, b)
@@ -7670,7 +7914,7 @@ func (m *DropStreamCommand) Reset()         { *m = DropStreamCommand{} }
 func (m *DropStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*DropStreamCommand) ProtoMessage()    {}
 func (*DropStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
 }
 func (m *DropStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropStreamCommand.Unmarshal(m, b)
@@ -7719,7 +7963,7 @@ func (m *GetMeasurementInfoStoreCommand) Reset()         { *m = GetMeasurementIn
 func (m *GetMeasurementInfoStoreCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoStoreCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoStoreCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
 }
 func (m *GetMeasurementInfoStoreCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Unmarshal(m, b)
@@ -7780,7 +8024,7 @@ func (m *VerifyDataNodeCommand) Reset()         { *m = VerifyDataNodeCommand{} }
 func (m *VerifyDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*VerifyDataNodeCommand) ProtoMessage()    {}
 func (*VerifyDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
 }
 func (m *VerifyDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyDataNodeCommand.Unmarshal(m, b)
@@ -7826,7 +8070,7 @@ func (m *ExpandGroupsCommand) Reset()         { *m = ExpandGroupsCommand{} }
 func (m *ExpandGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*ExpandGroupsCommand) ProtoMessage()    {}
 func (*ExpandGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
 }
 func (m *ExpandGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpandGroupsCommand.Unmarshal(m, b)
@@ -7867,7 +8111,7 @@ func (m *UpdatePtVersionCommand) Reset()         { *m = UpdatePtVersionCommand{}
 func (m *UpdatePtVersionCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtVersionCommand) ProtoMessage()    {}
 func (*UpdatePtVersionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
 }
 func (m *UpdatePtVersionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtVersionCommand.Unmarshal(m, b)
@@ -7922,7 +8166,7 @@ func (m *GetMeasurementsInfoCommand) Reset()         { *m = GetMeasurementsInfoC
 func (m *GetMeasurementsInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementsInfoCommand) ProtoMessage()    {}
 func (*GetMeasurementsInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
 }
 func (m *GetMeasurementsInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementsInfoCommand.Unmarshal(m, b)
@@ -7978,7 +8222,7 @@ func (m *DatabaseBriefInfo) Reset()         { *m = DatabaseBriefInfo{} }
 func (m *DatabaseBriefInfo) String() string { return proto.CompactTextString(m) }
 func (*DatabaseBriefInfo) ProtoMessage()    {}
 func (*DatabaseBriefInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
 }
 func (m *DatabaseBriefInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DatabaseBriefInfo.Unmarshal(m, b)
@@ -8030,7 +8274,7 @@ func (m *MeasurementsInfo) Reset()         { *m = MeasurementsInfo{} }
 func (m *MeasurementsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementsInfo) ProtoMessage()    {}
 func (*MeasurementsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
 }
 func (m *MeasurementsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementsInfo.Unmarshal(m, b)
@@ -8068,7 +8312,7 @@ func (m *RegisterQueryIDOffsetCommand) Reset()         { *m = RegisterQueryIDOff
 func (m *RegisterQueryIDOffsetCommand) String() string { return proto.CompactTextString(m) }
 func (*RegisterQueryIDOffsetCommand) ProtoMessage()    {}
 func (*RegisterQueryIDOffsetCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
 }
 func (m *RegisterQueryIDOffsetCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Unmarshal(m, b)
@@ -8117,7 +8361,7 @@ func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQu
 func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateContinuousQueryCommand) ProtoMessage()    {}
 func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
 }
 func (m *CreateContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateContinuousQueryCommand.Unmarshal(m, b)
@@ -8178,7 +8422,7 @@ func (m *Sql2MetaHeartbeatCommand) Reset()         { *m = Sql2MetaHeartbeatComma
 func (m *Sql2MetaHeartbeatCommand) String() string { return proto.CompactTextString(m) }
 func (*Sql2MetaHeartbeatCommand) ProtoMessage()    {}
 func (*Sql2MetaHeartbeatCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
 }
 func (m *Sql2MetaHeartbeatCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Unmarshal(m, b)
@@ -8225,7 +8469,7 @@ func (m *ContinuousQueryReportCommand) Reset()         { *m = ContinuousQueryRep
 func (m *ContinuousQueryReportCommand) String() string { return proto.CompactTextString(m) }
 func (*ContinuousQueryReportCommand) ProtoMessage()    {}
 func (*ContinuousQueryReportCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
 }
 func (m *ContinuousQueryReportCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContinuousQueryReportCommand.Unmarshal(m, b)
@@ -8273,7 +8517,7 @@ func (m *CQState) Reset()         { *m = CQState{} }
 func (m *CQState) String() string { return proto.CompactTextString(m) }
 func (*CQState) ProtoMessage()    {}
 func (*CQState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
 }
 func (m *CQState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CQState.Unmarshal(m, b)
@@ -8318,7 +8562,7 @@ func (m *GetContinuousQueryLeaseCommand) Reset()         { *m = GetContinuousQue
 func (m *GetContinuousQueryLeaseCommand) String() string { return proto.CompactTextString(m) }
 func (*GetContinuousQueryLeaseCommand) ProtoMessage()    {}
 func (*GetContinuousQueryLeaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
 }
 func (m *GetContinuousQueryLeaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Unmarshal(m, b)
@@ -8366,7 +8610,7 @@ func (m *DropContinuousQueryCommand) Reset()         { *m = DropContinuousQueryC
 func (m *DropContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*DropContinuousQueryCommand) ProtoMessage()    {}
 func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
 }
 func (m *DropContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropContinuousQueryCommand.Unmarshal(m, b)
@@ -8419,7 +8663,7 @@ func (m *NotifyCQLeaseChangedCommand) Reset()         { *m = NotifyCQLeaseChange
 func (m *NotifyCQLeaseChangedCommand) String() string { return proto.CompactTextString(m) }
 func (*NotifyCQLeaseChangedCommand) ProtoMessage()    {}
 func (*NotifyCQLeaseChangedCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
 }
 func (m *NotifyCQLeaseChangedCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyCQLeaseChangedCommand.Unmarshal(m, b)
@@ -8460,7 +8704,7 @@ func (m *SetNodeSegregateStatusCommand) Reset()         { *m = SetNodeSegregateS
 func (m *SetNodeSegregateStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*SetNodeSegregateStatusCommand) ProtoMessage()    {}
 func (*SetNodeSegregateStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
 }
 func (m *SetNodeSegregateStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetNodeSegregateStatusCommand.Unmarshal(m, b)
@@ -8514,7 +8758,7 @@ func (m *RemoveNodeCommand) Reset()         { *m = RemoveNodeCommand{} }
 func (m *RemoveNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveNodeCommand) ProtoMessage()    {}
 func (*RemoveNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
 }
 func (m *RemoveNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveNodeCommand.Unmarshal(m, b)
@@ -8564,7 +8808,7 @@ func (m *UpdateReplicationCommand) Reset()         { *m = UpdateReplicationComma
 func (m *UpdateReplicationCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateReplicationCommand) ProtoMessage()    {}
 func (*UpdateReplicationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
 }
 func (m *UpdateReplicationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateReplicationCommand.Unmarshal(m, b)
@@ -8637,7 +8881,7 @@ func (m *ObsOptions) Reset()         { *m = ObsOptions{} }
 func (m *ObsOptions) String() string { return proto.CompactTextString(m) }
 func (*ObsOptions) ProtoMessage()    {}
 func (*ObsOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
 }
 func (m *ObsOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObsOptions.Unmarshal(m, b)
@@ -8717,7 +8961,7 @@ func (m *Options) Reset()         { *m = Options{} }
 func (m *Options) String() string { return proto.CompactTextString(m) }
 func (*Options) ProtoMessage()    {}
 func (*Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
 }
 func (m *Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Options.Unmarshal(m, b)
@@ -8807,7 +9051,7 @@ func (m *UpdateMeasurementCommand) Reset()         { *m = UpdateMeasurementComma
 func (m *UpdateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateMeasurementCommand) ProtoMessage()    {}
 func (*UpdateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
 }
 func (m *UpdateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateMeasurementCommand.Unmarshal(m, b)
@@ -8879,7 +9123,7 @@ func (m *DataOps) Reset()         { *m = DataOps{} }
 func (m *DataOps) String() string { return proto.CompactTextString(m) }
 func (*DataOps) ProtoMessage()    {}
 func (*DataOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
 }
 func (m *DataOps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataOps.Unmarshal(m, b)
@@ -8946,7 +9190,7 @@ func (m *CreateSqlNodeCommand) Reset()         { *m = CreateSqlNodeCommand{} }
 func (m *CreateSqlNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSqlNodeCommand) ProtoMessage()    {}
 func (*CreateSqlNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
 }
 func (m *CreateSqlNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSqlNodeCommand.Unmarshal(m, b)
@@ -9003,7 +9247,7 @@ func (m *UpdateSqlNodeStatusCommand) Reset()         { *m = UpdateSqlNodeStatusC
 func (m *UpdateSqlNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSqlNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateSqlNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
 }
 func (m *UpdateSqlNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSqlNodeStatusCommand.Unmarshal(m, b)
@@ -9073,7 +9317,7 @@ func (m *UpdateNodeTmpIndexCommand) Reset()         { *m = UpdateNodeTmpIndexCom
 func (m *UpdateNodeTmpIndexCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeTmpIndexCommand) ProtoMessage()    {}
 func (*UpdateNodeTmpIndexCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
 }
 func (m *UpdateNodeTmpIndexCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeTmpIndexCommand.Unmarshal(m, b)
@@ -9145,7 +9389,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileInfo.Unmarshal(m, b)
@@ -9260,7 +9504,7 @@ func (m *InsertFilesCommand) Reset()         { *m = InsertFilesCommand{} }
 func (m *InsertFilesCommand) String() string { return proto.CompactTextString(m) }
 func (*InsertFilesCommand) ProtoMessage()    {}
 func (*InsertFilesCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
 }
 func (m *InsertFilesCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertFilesCommand.Unmarshal(m, b)
@@ -9308,7 +9552,7 @@ func (m *ShowClusterCommand) Reset()         { *m = ShowClusterCommand{} }
 func (m *ShowClusterCommand) String() string { return proto.CompactTextString(m) }
 func (*ShowClusterCommand) ProtoMessage()    {}
 func (*ShowClusterCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{147}
 }
 func (m *ShowClusterCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowClusterCommand.Unmarshal(m, b)
@@ -9351,6 +9595,376 @@ var E_ShowClusterCommand_Command = &proto.ExtensionDesc{
 	Filename:      "meta.proto",
 }
 
+type CreateRoleCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleCommand) Reset()         { *m = CreateRoleCommand{} }
+func (m *CreateRoleCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleCommand) ProtoMessage()    {}
+func (*CreateRoleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{148}
+}
+func (m *CreateRoleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleCommand.Unmarshal(m, b)
+}
+func (m *CreateRoleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleCommand.Merge(m, src)
+}
+func (m *CreateRoleCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleCommand.Size(m)
+}
+func (m *CreateRoleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleCommand proto.InternalMessageInfo
+
+func (m *CreateRoleCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_CreateRoleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateRoleCommand)(nil),
+	Field:         201,
+	Name:          "proto.CreateRoleCommand.command",
+	Tag:           "bytes,201,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type DropRoleCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropRoleCommand) Reset()         { *m = DropRoleCommand{} }
+func (m *DropRoleCommand) String() string { return proto.CompactTextString(m) }
+func (*DropRoleCommand) ProtoMessage()    {}
+func (*DropRoleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{149}
+}
+func (m *DropRoleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleCommand.Unmarshal(m, b)
+}
+func (m *DropRoleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleCommand.Marshal(b, m, deterministic)
+}
+func (m *DropRoleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleCommand.Merge(m, src)
+}
+func (m *DropRoleCommand) XXX_Size() int {
+	return xxx_messageInfo_DropRoleCommand.Size(m)
+}
+func (m *DropRoleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleCommand proto.InternalMessageInfo
+
+func (m *DropRoleCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_DropRoleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropRoleCommand)(nil),
+	Field:         202,
+	Name:          "proto.DropRoleCommand.command",
+	Tag:           "bytes,202,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type SetMeasurementPrivilegeCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
+	Measurement          *string  `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
+	Privilege            *int32   `protobuf:"varint,4,req,name=Privilege" json:"Privilege,omitempty"`
+	Deny                 *bool    `protobuf:"varint,5,req,name=Deny" json:"Deny,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetMeasurementPrivilegeCommand) Reset()         { *m = SetMeasurementPrivilegeCommand{} }
+func (m *SetMeasurementPrivilegeCommand) String() string { return proto.CompactTextString(m) }
+func (*SetMeasurementPrivilegeCommand) ProtoMessage()    {}
+func (*SetMeasurementPrivilegeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{150}
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMeasurementPrivilegeCommand.Unmarshal(m, b)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMeasurementPrivilegeCommand.Marshal(b, m, deterministic)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMeasurementPrivilegeCommand.Merge(m, src)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_Size() int {
+	return xxx_messageInfo_SetMeasurementPrivilegeCommand.Size(m)
+}
+func (m *SetMeasurementPrivilegeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMeasurementPrivilegeCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMeasurementPrivilegeCommand proto.InternalMessageInfo
+
+func (m *SetMeasurementPrivilegeCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+func (m *SetMeasurementPrivilegeCommand) GetDeny() bool {
+	if m != nil && m.Deny != nil {
+		return *m.Deny
+	}
+	return false
+}
+
+var E_SetMeasurementPrivilegeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetMeasurementPrivilegeCommand)(nil),
+	Field:         203,
+	Name:          "proto.SetMeasurementPrivilegeCommand.command",
+	Tag:           "bytes,203,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type RevokePrivilegeCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Database             *string  `protobuf:"bytes,2,req,name=Database" json:"Database,omitempty"`
+	Measurement          *string  `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
+	Privilege            *int32   `protobuf:"varint,4,req,name=Privilege" json:"Privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokePrivilegeCommand) Reset()         { *m = RevokePrivilegeCommand{} }
+func (m *RevokePrivilegeCommand) String() string { return proto.CompactTextString(m) }
+func (*RevokePrivilegeCommand) ProtoMessage()    {}
+func (*RevokePrivilegeCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{151}
+}
+func (m *RevokePrivilegeCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokePrivilegeCommand.Unmarshal(m, b)
+}
+func (m *RevokePrivilegeCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokePrivilegeCommand.Marshal(b, m, deterministic)
+}
+func (m *RevokePrivilegeCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokePrivilegeCommand.Merge(m, src)
+}
+func (m *RevokePrivilegeCommand) XXX_Size() int {
+	return xxx_messageInfo_RevokePrivilegeCommand.Size(m)
+}
+func (m *RevokePrivilegeCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokePrivilegeCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokePrivilegeCommand proto.InternalMessageInfo
+
+func (m *RevokePrivilegeCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *RevokePrivilegeCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *RevokePrivilegeCommand) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *RevokePrivilegeCommand) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+var E_RevokePrivilegeCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*RevokePrivilegeCommand)(nil),
+	Field:         204,
+	Name:          "proto.RevokePrivilegeCommand.command",
+	Tag:           "bytes,204,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type SetUserRoleCommand struct {
+	Username             *string  `protobuf:"bytes,1,req,name=Username" json:"Username,omitempty"`
+	Role                 *string  `protobuf:"bytes,2,req,name=Role" json:"Role,omitempty"`
+	Revoke               *bool    `protobuf:"varint,3,req,name=Revoke" json:"Revoke,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserRoleCommand) Reset()         { *m = SetUserRoleCommand{} }
+func (m *SetUserRoleCommand) String() string { return proto.CompactTextString(m) }
+func (*SetUserRoleCommand) ProtoMessage()    {}
+func (*SetUserRoleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{152}
+}
+func (m *SetUserRoleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRoleCommand.Unmarshal(m, b)
+}
+func (m *SetUserRoleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRoleCommand.Marshal(b, m, deterministic)
+}
+func (m *SetUserRoleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRoleCommand.Merge(m, src)
+}
+func (m *SetUserRoleCommand) XXX_Size() int {
+	return xxx_messageInfo_SetUserRoleCommand.Size(m)
+}
+func (m *SetUserRoleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRoleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRoleCommand proto.InternalMessageInfo
+
+func (m *SetUserRoleCommand) GetUsername() string {
+	if m != nil && m.Username != nil {
+		return *m.Username
+	}
+	return ""
+}
+
+func (m *SetUserRoleCommand) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
+func (m *SetUserRoleCommand) GetRevoke() bool {
+	if m != nil && m.Revoke != nil {
+		return *m.Revoke
+	}
+	return false
+}
+
+var E_SetUserRoleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*SetUserRoleCommand)(nil),
+	Field:         205,
+	Name:          "proto.SetUserRoleCommand.command",
+	Tag:           "bytes,205,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type UpdatePromMetadataCommand struct {
+	Database             *string               `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	RetentionPolicy      *string               `protobuf:"bytes,2,req,name=RetentionPolicy" json:"RetentionPolicy,omitempty"`
+	Metadata             []*PromMetricMetadata `protobuf:"bytes,3,rep,name=Metadata" json:"Metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdatePromMetadataCommand) Reset()         { *m = UpdatePromMetadataCommand{} }
+func (m *UpdatePromMetadataCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdatePromMetadataCommand) ProtoMessage()    {}
+func (*UpdatePromMetadataCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{153}
+}
+func (m *UpdatePromMetadataCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePromMetadataCommand.Unmarshal(m, b)
+}
+func (m *UpdatePromMetadataCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePromMetadataCommand.Marshal(b, m, deterministic)
+}
+func (m *UpdatePromMetadataCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePromMetadataCommand.Merge(m, src)
+}
+func (m *UpdatePromMetadataCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdatePromMetadataCommand.Size(m)
+}
+func (m *UpdatePromMetadataCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePromMetadataCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePromMetadataCommand proto.InternalMessageInfo
+
+func (m *UpdatePromMetadataCommand) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *UpdatePromMetadataCommand) GetRetentionPolicy() string {
+	if m != nil && m.RetentionPolicy != nil {
+		return *m.RetentionPolicy
+	}
+	return ""
+}
+
+func (m *UpdatePromMetadataCommand) GetMetadata() []*PromMetricMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+var E_UpdatePromMetadataCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdatePromMetadataCommand)(nil),
+	Field:         206,
+	Name:          "proto.UpdatePromMetadataCommand.command",
+	Tag:           "bytes,206,opt,name=command",
+	Filename:      "meta.proto",
+}
+
 type NodeRow struct {
 	Timestamp            *int64   `protobuf:"varint,1,req,name=timestamp" json:"timestamp,omitempty"`
 	Status               *string  `protobuf:"bytes,2,req,name=status" json:"status,omitempty"`
@@ -9366,7 +9980,7 @@ func (m *NodeRow) Reset()         { *m = NodeRow{} }
 func (m *NodeRow) String() string { return proto.CompactTextString(m) }
 func (*NodeRow) ProtoMessage()    {}
 func (*NodeRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{154}
 }
 func (m *NodeRow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRow.Unmarshal(m, b)
@@ -9439,7 +10053,7 @@ func (m *EventRow) Reset()         { *m = EventRow{} }
 func (m *EventRow) String() string { return proto.CompactTextString(m) }
 func (*EventRow) ProtoMessage()    {}
 func (*EventRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{155}
 }
 func (m *EventRow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventRow.Unmarshal(m, b)
@@ -9527,7 +10141,7 @@ func (m *ShowClusterInfo) Reset()         { *m = ShowClusterInfo{} }
 func (m *ShowClusterInfo) String() string { return proto.CompactTextString(m) }
 func (*ShowClusterInfo) ProtoMessage()    {}
 func (*ShowClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{147}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{156}
 }
 func (m *ShowClusterInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowClusterInfo.Unmarshal(m, b)
@@ -9584,6 +10198,7 @@ func init() {
 	proto.RegisterType((*SchemaVal)(nil), "proto.SchemaVal")
 	proto.RegisterType((*RetentionPolicyInfo)(nil), "proto.RetentionPolicyInfo")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.RetentionPolicyInfo.MstVersionsEntry")
+	proto.RegisterType((*PromMetricMetadata)(nil), "proto.PromMetricMetadata")
 	proto.RegisterType((*ContinuousQueryInfo)(nil), "proto.ContinuousQueryInfo")
 	proto.RegisterType((*ShardGroupInfo)(nil), "proto.ShardGroupInfo")
 	proto.RegisterType((*ShardInfo)(nil), "proto.ShardInfo")
@@ -9593,6 +10208,8 @@ func init() {
 	proto.RegisterType((*ShardOwner)(nil), "proto.ShardOwner")
 	proto.RegisterType((*UserInfo)(nil), "proto.UserInfo")
 	proto.RegisterType((*UserPrivilege)(nil), "proto.UserPrivilege")
+	proto.RegisterType((*MeasurementPrivilege)(nil), "proto.MeasurementPrivilege")
+	proto.RegisterType((*RoleInfo)(nil), "proto.RoleInfo")
 	proto.RegisterType((*IndexRelation)(nil), "proto.IndexRelation")
 	proto.RegisterType((*IndexList)(nil), "proto.IndexList")
 	proto.RegisterType((*RpMeasurementsFieldsInfo)(nil), "proto.RpMeasurementsFieldsInfo")
@@ -9790,6 +10407,18 @@ func init() {
 	proto.RegisterType((*InsertFilesCommand)(nil), "proto.InsertFilesCommand")
 	proto.RegisterExtension(E_ShowClusterCommand_Command)
 	proto.RegisterType((*ShowClusterCommand)(nil), "proto.ShowClusterCommand")
+	proto.RegisterExtension(E_CreateRoleCommand_Command)
+	proto.RegisterType((*CreateRoleCommand)(nil), "proto.CreateRoleCommand")
+	proto.RegisterExtension(E_DropRoleCommand_Command)
+	proto.RegisterType((*DropRoleCommand)(nil), "proto.DropRoleCommand")
+	proto.RegisterExtension(E_SetMeasurementPrivilegeCommand_Command)
+	proto.RegisterType((*SetMeasurementPrivilegeCommand)(nil), "proto.SetMeasurementPrivilegeCommand")
+	proto.RegisterExtension(E_RevokePrivilegeCommand_Command)
+	proto.RegisterType((*RevokePrivilegeCommand)(nil), "proto.RevokePrivilegeCommand")
+	proto.RegisterExtension(E_SetUserRoleCommand_Command)
+	proto.RegisterType((*SetUserRoleCommand)(nil), "proto.SetUserRoleCommand")
+	proto.RegisterExtension(E_UpdatePromMetadataCommand_Command)
+	proto.RegisterType((*UpdatePromMetadataCommand)(nil), "proto.UpdatePromMetadataCommand")
 	proto.RegisterType((*NodeRow)(nil), "proto.NodeRow")
 	proto.RegisterType((*EventRow)(nil), "proto.EventRow")
 	proto.RegisterType((*ShowClusterInfo)(nil), "proto.ShowClusterInfo")
@@ -9798,464 +10427,485 @@ func init() {
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 7335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x6b, 0x8c, 0x5c, 0xc9,
-	0x55, 0xb0, 0x6e, 0x3f, 0x66, 0x7a, 0x6a, 0xdc, 0xe3, 0x71, 0xf9, 0xb1, 0xed, 0x59, 0xdb, 0x3b,
-	0xbe, 0xf1, 0xee, 0x3a, 0xbb, 0x59, 0x6f, 0x76, 0x94, 0xec, 0x6e, 0x36, 0xc9, 0x26, 0x9e, 0x69,
-	0x3f, 0x3a, 0xeb, 0xf1, 0xb4, 0x6f, 0xcf, 0xda, 0xdf, 0x97, 0x0d, 0x61, 0xef, 0x4c, 0x97, 0x67,
-	0x6e, 0xa6, 0xa7, 0xbb, 0xf7, 0xde, 0x3b, 0xb6, 0xc7, 0x0a, 0xca, 0x26, 0x91, 0x12, 0x85, 0x08,
-	0x21, 0x84, 0xc8, 0x4b, 0x10, 0x20, 0x24, 0x01, 0x02, 0x01, 0x12, 0x12, 0xf2, 0x60, 0x13, 0xc8,
-	0x53, 0x11, 0x42, 0xfc, 0x03, 0xf1, 0x0b, 0x89, 0x5f, 0x48, 0x08, 0x10, 0xfc, 0xe1, 0x21, 0x81,
-	0x84, 0xce, 0xa9, 0xf7, 0xbd, 0x75, 0xef, 0xd8, 0x16, 0xce, 0xaf, 0xbe, 0x75, 0x4e, 0x3d, 0x4e,
-	0x9d, 0x3a, 0x75, 0xea, 0xd4, 0xa9, 0x53, 0xd5, 0x84, 0x6c, 0xb3, 0x34, 0x3c, 0x33, 0x8e, 0x47,
-	0xe9, 0x88, 0xd6, 0xf1, 0xc7, 0xff, 0xcf, 0x69, 0x52, 0x6b, 0x87, 0x69, 0x48, 0x29, 0xa9, 0xad,
-	0xb2, 0x78, 0xbb, 0xe5, 0xcd, 0x57, 0x4e, 0xd7, 0x02, 0xfc, 0xa6, 0x87, 0x48, 0xbd, 0x33, 0xec,
-	0xb3, 0x5b, 0xad, 0x0a, 0x02, 0x79, 0x82, 0x1e, 0x23, 0x53, 0x4b, 0x83, 0x9d, 0x24, 0x65, 0x71,
-	0xa7, 0xdd, 0xaa, 0x22, 0x46, 0x03, 0xe8, 0xc3, 0xa4, 0x7e, 0x79, 0xd4, 0x67, 0x49, 0xab, 0x36,
-	0x5f, 0x3d, 0x3d, 0xbd, 0xb0, 0x9f, 0x37, 0x77, 0x06, 0x60, 0x9d, 0xe1, 0xf5, 0x51, 0xc0, 0xb1,
-	0xf4, 0x29, 0x32, 0x05, 0xcd, 0xae, 0x85, 0x09, 0x4b, 0x5a, 0x75, 0xcc, 0x7a, 0x50, 0x64, 0x95,
-	0x70, 0xcc, 0xae, 0x73, 0x41, 0xcd, 0x2f, 0x26, 0x2c, 0x4e, 0x5a, 0x13, 0x56, 0xcd, 0x00, 0xe3,
-	0x35, 0x23, 0x16, 0xc8, 0x5b, 0x0e, 0x6f, 0x61, 0x7b, 0xed, 0xd6, 0x24, 0x27, 0x4f, 0x01, 0xe8,
-	0x69, 0xb2, 0x7f, 0x39, 0xbc, 0xd5, 0xdb, 0x0c, 0xe3, 0xfe, 0x85, 0x78, 0xb4, 0x33, 0xee, 0xb4,
-	0x5b, 0x0d, 0xcc, 0x93, 0x05, 0xd3, 0x13, 0x84, 0x48, 0x50, 0xa7, 0xdd, 0x9a, 0xc2, 0x4c, 0x06,
-	0x84, 0x3e, 0xc1, 0x7b, 0xc0, 0x3b, 0x4b, 0x2c, 0x92, 0x24, 0x3c, 0xd0, 0x39, 0x20, 0xfb, 0x32,
-	0x93, 0xd9, 0xa7, 0xdd, 0xbc, 0xd1, 0x39, 0xa8, 0x4f, 0xf6, 0x09, 0x9e, 0x76, 0xd3, 0xcb, 0x3b,
-	0xdb, 0xad, 0x99, 0xf9, 0xca, 0xe9, 0x66, 0x60, 0xc1, 0xe8, 0x93, 0x64, 0xa2, 0x9b, 0x5e, 0x8d,
-	0xd8, 0xcd, 0xd6, 0x7e, 0xac, 0xef, 0x01, 0xa3, 0xf9, 0x33, 0x1c, 0x73, 0x6e, 0x98, 0xc6, 0xbb,
-	0x81, 0xc8, 0x06, 0x95, 0x62, 0xc9, 0x2e, 0x8b, 0xa1, 0x95, 0xd6, 0xec, 0xbc, 0x07, 0x95, 0x9a,
-	0x30, 0xc1, 0x20, 0x1c, 0x69, 0xc9, 0xa0, 0x03, 0x8a, 0x41, 0x26, 0x58, 0x30, 0x08, 0x41, 0x9d,
-	0x76, 0x8b, 0x2a, 0x06, 0x09, 0x08, 0xb4, 0xb6, 0x1c, 0xde, 0x3a, 0x77, 0x83, 0x0d, 0xd3, 0x95,
-	0x71, 0xa7, 0xdf, 0x3a, 0x38, 0xef, 0x9d, 0xae, 0x05, 0x16, 0x0c, 0x5a, 0x5b, 0x0d, 0xb7, 0xd8,
-	0xca, 0x0d, 0x16, 0x9f, 0x1b, 0x86, 0x6b, 0x03, 0xd6, 0x6f, 0x1d, 0x9a, 0xf7, 0x4e, 0x37, 0x82,
-	0x2c, 0x98, 0xbe, 0x9d, 0x34, 0x97, 0xa3, 0x8d, 0x38, 0x4c, 0x19, 0x96, 0x4e, 0x5a, 0x87, 0xad,
-	0x3e, 0x9b, 0x38, 0xe4, 0xa5, 0x9d, 0x1b, 0x1a, 0x5a, 0x0c, 0x07, 0xe1, 0x70, 0x5d, 0x37, 0x74,
-	0x84, 0x37, 0x94, 0x01, 0x0b, 0x06, 0xb4, 0x47, 0x37, 0x87, 0xbd, 0x70, 0x7b, 0x3c, 0x00, 0x29,
-	0x7a, 0x00, 0x29, 0xcf, 0x82, 0xe9, 0xe3, 0x64, 0xb2, 0x97, 0xc6, 0x2c, 0xdc, 0x4e, 0x5a, 0x2d,
-	0x24, 0xe6, 0x80, 0x20, 0x86, 0x43, 0x91, 0x0c, 0x99, 0x83, 0xce, 0x93, 0x69, 0x10, 0x1e, 0x8e,
-	0x69, 0xb7, 0x8e, 0x62, 0x95, 0x26, 0x48, 0x08, 0xee, 0xd2, 0x68, 0x38, 0xec, 0xf4, 0x5b, 0x73,
-	0x88, 0xd7, 0x00, 0xfa, 0x3c, 0x99, 0xbe, 0xb2, 0xc3, 0xe2, 0xdd, 0x4e, 0xbb, 0x33, 0x8c, 0xd2,
-	0xd6, 0x83, 0xd8, 0xe0, 0x31, 0x73, 0xc4, 0x0d, 0x34, 0x1f, 0x76, 0xb3, 0x00, 0x6d, 0x93, 0x66,
-	0xc0, 0xc6, 0x83, 0x68, 0x3d, 0xc4, 0xf1, 0x4b, 0x5a, 0xc7, 0xb0, 0x86, 0x13, 0x66, 0x0d, 0x56,
-	0x06, 0x5e, 0x87, 0x5d, 0x88, 0xbe, 0x81, 0x1c, 0x00, 0x92, 0x77, 0xd6, 0x92, 0xf5, 0x38, 0x1a,
-	0xa7, 0xd1, 0x68, 0xd8, 0x69, 0xb7, 0x8e, 0x23, 0xad, 0x79, 0x04, 0x3d, 0x45, 0x9a, 0xd0, 0x81,
-	0x2b, 0x4b, 0x9b, 0xe1, 0x70, 0x03, 0x18, 0x79, 0x02, 0x73, 0xda, 0x40, 0xe0, 0xcc, 0xe5, 0x9d,
-	0xed, 0x95, 0xeb, 0x38, 0xb1, 0x92, 0xd6, 0x43, 0xf3, 0xde, 0xe9, 0x7a, 0x60, 0x82, 0x60, 0x48,
-	0x3a, 0x49, 0xef, 0xca, 0xa5, 0x28, 0x65, 0x72, 0xf0, 0xe6, 0xf9, 0xe0, 0x65, 0xc0, 0xf4, 0x71,
-	0xd2, 0xe8, 0xbd, 0x32, 0xe0, 0x93, 0xec, 0xa4, 0x7b, 0x4e, 0xaa, 0x0c, 0x74, 0x8e, 0x34, 0x96,
-	0xc3, 0x5b, 0xcb, 0x49, 0xda, 0x69, 0xb7, 0x7c, 0xa4, 0x4c, 0xa5, 0xe7, 0xde, 0x45, 0xa6, 0x8d,
-	0x19, 0x44, 0x67, 0x49, 0x75, 0x8b, 0xed, 0xb6, 0xbc, 0x79, 0xef, 0xf4, 0x54, 0x00, 0x9f, 0xa0,
-	0x8d, 0x6e, 0x84, 0x83, 0x1d, 0xd6, 0xaa, 0xcc, 0x7b, 0x66, 0x33, 0x8b, 0x5d, 0x2e, 0x7f, 0x1c,
-	0xfb, 0x5c, 0xe5, 0x59, 0x6f, 0xee, 0x79, 0x32, 0x9b, 0x1d, 0x1b, 0x47, 0x85, 0x87, 0xcc, 0x0a,
-	0x6b, 0x66, 0xf9, 0x17, 0x09, 0xcd, 0x8f, 0x8c, 0xa3, 0x86, 0xd7, 0xdb, 0x24, 0x49, 0x7d, 0x2a,
-	0xca, 0xc2, 0x98, 0x24, 0x46, 0xb5, 0xfe, 0x5b, 0xc9, 0x3e, 0x13, 0x45, 0x1f, 0x27, 0x13, 0x42,
-	0x34, 0x3c, 0x4b, 0x1f, 0x9b, 0x6d, 0x07, 0x22, 0x8b, 0xff, 0x31, 0x4f, 0x95, 0x46, 0x08, 0x9d,
-	0x21, 0x95, 0x4e, 0x1b, 0x57, 0x8f, 0x66, 0x50, 0xe9, 0xb4, 0x39, 0x73, 0xc5, 0x22, 0x51, 0x41,
-	0xa8, 0x4a, 0xd3, 0x93, 0xa4, 0xde, 0x65, 0xa0, 0xc9, 0xab, 0xd8, 0xd0, 0xb4, 0x68, 0x08, 0x60,
-	0x01, 0xc7, 0xd0, 0x23, 0x64, 0xa2, 0x97, 0x86, 0xe9, 0x0e, 0xac, 0x23, 0x50, 0x58, 0xa4, 0xd4,
-	0x32, 0x55, 0xd7, 0xcb, 0x94, 0xff, 0x18, 0xa9, 0x41, 0xa1, 0x1c, 0x09, 0x94, 0xd4, 0x82, 0xd1,
-	0x80, 0x89, 0xe6, 0xf1, 0xdb, 0x3f, 0x49, 0x26, 0xbb, 0xe9, 0xca, 0xcd, 0x21, 0x8b, 0xa1, 0x09,
-	0xb1, 0x4a, 0xf0, 0x35, 0x4f, 0xa4, 0xfc, 0x57, 0x3d, 0xd0, 0xab, 0x30, 0x88, 0xf4, 0x14, 0xa9,
-	0x63, 0x5e, 0xcc, 0x31, 0xbd, 0x30, 0x23, 0x09, 0xe5, 0x35, 0x04, 0x75, 0x55, 0x91, 0xa0, 0xb5,
-	0x92, 0xa5, 0xb5, 0x9b, 0x76, 0xfa, 0xb8, 0x46, 0x36, 0x03, 0xfc, 0x86, 0x51, 0xbb, 0xca, 0xe2,
-	0x56, 0x0d, 0xc7, 0x18, 0x3e, 0x91, 0xca, 0x0b, 0x9d, 0x76, 0xab, 0x8e, 0xca, 0x18, 0xbf, 0xfd,
-	0x27, 0x48, 0x43, 0x0a, 0x12, 0x3d, 0x49, 0x6a, 0xed, 0xb5, 0x6e, 0x2a, 0x06, 0xa5, 0xa9, 0x48,
-	0x40, 0x29, 0x43, 0x94, 0xff, 0x2f, 0x1e, 0x69, 0xc8, 0x45, 0xc4, 0xe0, 0x42, 0x4d, 0x72, 0xe1,
-	0xe2, 0x28, 0x49, 0x91, 0xb6, 0xa9, 0x00, 0xbf, 0x69, 0x8b, 0x4c, 0x06, 0xdd, 0xa5, 0xb3, 0xfd,
-	0x7e, 0x8c, 0xcd, 0x4e, 0x05, 0x32, 0x09, 0x98, 0xd5, 0xa5, 0x2e, 0x16, 0xa8, 0x72, 0x8c, 0x48,
-	0x66, 0x46, 0xa4, 0xaa, 0x7a, 0x79, 0x88, 0xd4, 0x2f, 0xad, 0x46, 0xdb, 0xac, 0x35, 0xc1, 0x8d,
-	0x04, 0x4c, 0xc0, 0xe2, 0x70, 0x61, 0x94, 0x24, 0xd1, 0x18, 0x1b, 0x99, 0xc4, 0xb6, 0x0d, 0x08,
-	0x4c, 0xe9, 0x1e, 0xdb, 0x88, 0xd9, 0x46, 0x98, 0x32, 0x51, 0x6d, 0x83, 0x6b, 0xd9, 0x0c, 0x58,
-	0x8d, 0x22, 0x41, 0x72, 0xf8, 0x28, 0xee, 0x90, 0x86, 0x9c, 0xcf, 0xf4, 0x21, 0x52, 0xb9, 0x1c,
-	0x89, 0x01, 0xca, 0xad, 0xa8, 0x95, 0xcb, 0x11, 0x10, 0x8e, 0x3a, 0xb4, 0x2d, 0x66, 0x96, 0x48,
-	0x81, 0xde, 0x39, 0x3b, 0x88, 0x6e, 0x30, 0x81, 0xac, 0x72, 0x8d, 0x6c, 0x80, 0x80, 0x95, 0x67,
-	0x6f, 0xe3, 0x58, 0x4d, 0x05, 0x95, 0xb3, 0xb7, 0xfd, 0xaf, 0x56, 0xc9, 0x3e, 0xd3, 0x3a, 0x01,
-	0xda, 0x2e, 0x87, 0xdb, 0x0c, 0x5b, 0x9f, 0x0a, 0xf0, 0x9b, 0x3e, 0x4d, 0x8e, 0xb4, 0xd9, 0xf5,
-	0x70, 0x67, 0x90, 0x06, 0x2c, 0x65, 0x43, 0x98, 0x5b, 0xdd, 0xd1, 0x20, 0x5a, 0xdf, 0x15, 0x23,
-	0x50, 0x80, 0xa5, 0x17, 0xc9, 0x01, 0x1b, 0x14, 0x31, 0x39, 0x41, 0xe6, 0xd4, 0x4c, 0xb4, 0x8a,
-	0x60, 0x0f, 0xf3, 0x85, 0xa0, 0xa6, 0xa5, 0xd1, 0x30, 0x8d, 0x86, 0x3b, 0xa3, 0x9d, 0x04, 0x34,
-	0x4f, 0xa4, 0xcc, 0x31, 0x59, 0x93, 0x8d, 0x17, 0x35, 0xe5, 0x0a, 0xf1, 0x45, 0x2b, 0xde, 0x6a,
-	0xb3, 0x01, 0x4b, 0x59, 0x1f, 0x65, 0xa5, 0x11, 0x98, 0x20, 0xfa, 0x24, 0x69, 0xa0, 0x92, 0x7e,
-	0x81, 0xed, 0xb6, 0x26, 0x2c, 0xb5, 0x23, 0xc1, 0x58, 0xb7, 0xca, 0x44, 0x1f, 0x21, 0x33, 0x5c,
-	0x59, 0xaf, 0x86, 0x1b, 0x67, 0xe3, 0x38, 0xdc, 0x6d, 0x4d, 0x62, 0xad, 0x19, 0x28, 0xe8, 0x0f,
-	0xa1, 0x5f, 0x2e, 0xa3, 0x64, 0x54, 0x03, 0x95, 0x86, 0x85, 0x77, 0x05, 0xd7, 0x18, 0xb0, 0x02,
-	0x3c, 0x63, 0xe1, 0x5d, 0x59, 0x4b, 0x04, 0x22, 0x90, 0x39, 0xfc, 0xaf, 0x7b, 0xe4, 0x60, 0x86,
-	0x71, 0xbd, 0x31, 0x5b, 0x37, 0xc6, 0xce, 0x53, 0x63, 0x37, 0x47, 0x1a, 0xed, 0x9d, 0x18, 0xf5,
-	0x21, 0x0a, 0x4b, 0x35, 0x50, 0x69, 0x7a, 0x86, 0x50, 0x6d, 0x1f, 0xaa, 0x5c, 0x55, 0xcc, 0xe5,
-	0xc0, 0x58, 0x1d, 0xa8, 0xe1, 0xdc, 0xd6, 0x1d, 0xf0, 0xc9, 0xbe, 0x6b, 0x61, 0xbc, 0xad, 0x6a,
-	0xa9, 0x63, 0x2d, 0x16, 0xcc, 0xff, 0x87, 0x09, 0xb2, 0x7f, 0x99, 0x85, 0xc9, 0x4e, 0xcc, 0xb6,
-	0x85, 0x51, 0xe3, 0x94, 0xb7, 0xa7, 0xc8, 0x94, 0x64, 0x2e, 0x28, 0xa0, 0x6a, 0xd1, 0x10, 0xe8,
-	0x5c, 0xf4, 0x39, 0x32, 0xd1, 0x5b, 0xdf, 0x64, 0xdb, 0xa1, 0x90, 0x2f, 0x5f, 0x1a, 0x51, 0x76,
-	0x73, 0x67, 0x78, 0x26, 0x61, 0x43, 0xf2, 0x44, 0x56, 0x24, 0x6a, 0x79, 0x91, 0x78, 0x8e, 0x34,
-	0x23, 0x30, 0x01, 0x03, 0x36, 0xd0, 0xbd, 0x9b, 0x5e, 0x38, 0x24, 0x1a, 0xe9, 0x98, 0xb8, 0xc0,
-	0xce, 0x0a, 0x6a, 0xe3, 0xdc, 0x70, 0x23, 0x1a, 0xb2, 0xd5, 0xdd, 0x31, 0x43, 0x81, 0x6a, 0x06,
-	0x06, 0x84, 0x3e, 0x43, 0xf6, 0x2d, 0x8d, 0x06, 0xbd, 0x74, 0x14, 0xe3, 0x04, 0x44, 0xd9, 0xd1,
-	0xfd, 0x35, 0x51, 0x81, 0x95, 0x91, 0x3e, 0x45, 0x88, 0x16, 0x0e, 0x14, 0x28, 0xa7, 0xd4, 0x18,
-	0x99, 0xe8, 0x79, 0x42, 0xb8, 0xad, 0xdf, 0xbf, 0xc5, 0x92, 0xd6, 0x14, 0x72, 0xea, 0x91, 0x22,
-	0x4e, 0xa9, 0x8c, 0x9c, 0x5b, 0x46, 0x49, 0xb4, 0x5e, 0x86, 0x51, 0x6a, 0xda, 0x38, 0x04, 0x6d,
-	0x9c, 0x2c, 0x58, 0xa8, 0xee, 0x69, 0x54, 0x44, 0x15, 0xdc, 0xac, 0x64, 0xe4, 0x5c, 0x2e, 0x40,
-	0x59, 0x21, 0xa7, 0x2f, 0x91, 0x03, 0x7c, 0x7c, 0x5e, 0x4c, 0xd8, 0xf9, 0x51, 0xbc, 0x34, 0x60,
-	0xe1, 0xb0, 0x75, 0x04, 0x49, 0x7e, 0xa2, 0x74, 0x70, 0x8d, 0xfc, 0x9c, 0xf2, 0x7c, 0x3d, 0x73,
-	0x6f, 0x21, 0xd3, 0x86, 0x24, 0xec, 0x65, 0xba, 0xd4, 0x4d, 0xd3, 0xe5, 0x05, 0xb2, 0x3f, 0xc3,
-	0x1a, 0xb3, 0x78, 0x8d, 0x17, 0xf7, 0x6d, 0xbb, 0x65, 0x9f, 0x14, 0x14, 0x28, 0x63, 0x56, 0x76,
-	0x95, 0x1c, 0x71, 0x13, 0xed, 0x20, 0xe9, 0x11, 0xbb, 0xce, 0x59, 0x39, 0x23, 0xb0, 0xfc, 0xd5,
-	0x70, 0x60, 0x1a, 0x42, 0xcf, 0x90, 0x29, 0x05, 0x87, 0xaa, 0x56, 0x77, 0xc7, 0x38, 0xc3, 0xea,
-	0x01, 0x7c, 0xc2, 0x92, 0x78, 0x6e, 0xd8, 0xc7, 0x25, 0x8e, 0xf7, 0x4f, 0x26, 0xfd, 0x7f, 0xaf,
-	0xe7, 0x54, 0x4b, 0xe1, 0x34, 0xb5, 0x55, 0x4b, 0xe5, 0x8e, 0x54, 0x4b, 0xe5, 0x8e, 0x54, 0x4b,
-	0xc5, 0x52, 0x2d, 0xcf, 0x91, 0x7d, 0xc6, 0x48, 0xcb, 0xbd, 0xf5, 0x11, 0xb7, 0x10, 0x04, 0x56,
-	0x5e, 0xba, 0x4c, 0xa6, 0x97, 0x93, 0xf4, 0x2a, 0x8b, 0x13, 0x94, 0xb9, 0x19, 0x2c, 0xfa, 0x78,
-	0xf1, 0xe2, 0x73, 0xc6, 0xc8, 0x2d, 0xb6, 0x1c, 0x06, 0x84, 0x3e, 0x43, 0xa6, 0x35, 0xf1, 0x72,
-	0xdb, 0x7e, 0xd8, 0xd4, 0x4d, 0x7c, 0x2b, 0x09, 0x84, 0x98, 0x39, 0x61, 0xaf, 0x67, 0xee, 0x24,
-	0x92, 0xd6, 0xa4, 0xb5, 0xd7, 0xb3, 0x76, 0x19, 0xb8, 0xd7, 0xb3, 0x72, 0x67, 0x55, 0x54, 0x23,
-	0xaf, 0xa2, 0xe6, 0xc9, 0xf4, 0xc5, 0x51, 0xaa, 0x38, 0x3d, 0x85, 0x9c, 0x36, 0x41, 0x39, 0x0d,
-	0x4d, 0x30, 0x8b, 0x05, 0x83, 0x61, 0xd3, 0x1b, 0x62, 0x95, 0x73, 0x9a, 0x0f, 0x5b, 0x1e, 0x03,
-	0xfc, 0xd0, 0xd0, 0xa4, 0xb5, 0xcf, 0xe2, 0x87, 0xb1, 0xb5, 0x46, 0x7e, 0x18, 0x39, 0xe9, 0x0a,
-	0x39, 0xa4, 0x37, 0x9e, 0x9a, 0xfd, 0xad, 0x26, 0xca, 0xf6, 0x83, 0x72, 0xeb, 0xe1, 0xc8, 0x12,
-	0x38, 0x0b, 0xc2, 0x8e, 0x24, 0x3b, 0x74, 0x7b, 0x4d, 0xeb, 0xa6, 0x39, 0x63, 0x42, 0x72, 0xd0,
-	0x61, 0x41, 0x38, 0xe5, 0xfe, 0x10, 0xa9, 0x63, 0x06, 0x61, 0xfd, 0xf0, 0x04, 0x0c, 0xc0, 0xa5,
-	0x30, 0x49, 0x83, 0x9d, 0x21, 0xce, 0x2b, 0xbe, 0x8a, 0x9a, 0x20, 0xff, 0xbf, 0x3d, 0x32, 0x63,
-	0xcb, 0x48, 0xce, 0xb2, 0x3d, 0x46, 0xa6, 0x7a, 0x69, 0x18, 0xa7, 0x62, 0x6a, 0x02, 0xdb, 0x35,
-	0xc0, 0x9c, 0xb6, 0x7c, 0x26, 0xc9, 0x24, 0x94, 0x13, 0x82, 0x70, 0x36, 0x15, 0xc6, 0xac, 0x06,
-	0xd0, 0xd3, 0x64, 0x42, 0x68, 0x69, 0x3e, 0x75, 0x66, 0x4d, 0x81, 0x45, 0x9e, 0x0a, 0x3c, 0x74,
-	0x62, 0x35, 0xde, 0x19, 0xae, 0x87, 0xbc, 0xa6, 0x09, 0xde, 0x09, 0x03, 0x94, 0x59, 0xce, 0x26,
-	0x73, 0xcb, 0x59, 0x8b, 0x4c, 0xde, 0xe0, 0x83, 0xd0, 0xda, 0x87, 0x48, 0x99, 0xf4, 0x3f, 0x59,
-	0x11, 0xcb, 0xba, 0xb3, 0xe7, 0x27, 0x48, 0x03, 0xb7, 0x1e, 0x9d, 0x36, 0x5f, 0xf2, 0x9b, 0x8b,
-	0x95, 0x96, 0x17, 0x28, 0x18, 0x8c, 0xe5, 0x72, 0xc4, 0x35, 0xc8, 0x54, 0x00, 0x9f, 0x08, 0x09,
-	0x6f, 0x61, 0x6f, 0x01, 0x12, 0xde, 0xc2, 0x9d, 0x54, 0xc4, 0x62, 0xb5, 0x93, 0x8a, 0x18, 0x5a,
-	0xff, 0xd2, 0x9f, 0xc3, 0xad, 0x79, 0x99, 0x84, 0x45, 0x4c, 0x4b, 0xd2, 0x25, 0x76, 0x83, 0x0d,
-	0xd0, 0xa8, 0xaf, 0x06, 0x59, 0x30, 0xcc, 0x1c, 0xcb, 0x79, 0xc2, 0xcd, 0x7a, 0x0b, 0xc6, 0x15,
-	0x58, 0xd8, 0x5f, 0x19, 0x0e, 0x76, 0x5b, 0x53, 0x38, 0x3d, 0x55, 0x9a, 0xbb, 0x95, 0xe4, 0x54,
-	0xc5, 0x95, 0xb2, 0x11, 0x18, 0x10, 0x3f, 0x20, 0xfb, 0x4c, 0xbb, 0x06, 0xea, 0x52, 0x16, 0x28,
-	0xec, 0x91, 0xa6, 0x0c, 0x63, 0x13, 0xfa, 0x08, 0x9c, 0xaf, 0x70, 0x1b, 0x0f, 0x79, 0x4e, 0x49,
-	0xad, 0xb7, 0xa1, 0xec, 0x7d, 0xfc, 0xf6, 0x8f, 0x92, 0x3a, 0x5f, 0xab, 0x67, 0x49, 0xb5, 0xd3,
-	0xbf, 0x85, 0xf5, 0xd4, 0x03, 0xf8, 0xf4, 0xdf, 0x4b, 0x66, 0xb3, 0xfa, 0xc6, 0x29, 0xe7, 0x94,
-	0xd4, 0x96, 0x47, 0x7d, 0x26, 0xb7, 0x59, 0xf0, 0x8d, 0xac, 0x60, 0x49, 0x1a, 0x0d, 0xf9, 0x0e,
-	0x1b, 0xad, 0xad, 0xa9, 0xc0, 0x82, 0xf9, 0xa7, 0x84, 0x95, 0x51, 0xbe, 0x27, 0xfd, 0x84, 0x47,
-	0x1a, 0xd2, 0xd1, 0x59, 0xd4, 0xfc, 0xc5, 0x30, 0xd9, 0x54, 0xbb, 0xbc, 0x30, 0xd9, 0x84, 0xa9,
-	0x77, 0xb6, 0xbf, 0x2d, 0xe4, 0xa0, 0x11, 0xf0, 0x04, 0x34, 0x11, 0xdc, 0x84, 0xba, 0x84, 0xed,
-	0x26, 0x52, 0xf4, 0x4d, 0x84, 0x74, 0xe3, 0xe8, 0x46, 0x34, 0x60, 0x1b, 0xca, 0x25, 0x7b, 0xc8,
-	0xf0, 0xb1, 0x2a, 0x64, 0x60, 0xe4, 0xf3, 0x3b, 0xa4, 0x69, 0x21, 0x71, 0x9d, 0x13, 0x5b, 0x24,
-	0x41, 0xa0, 0x4a, 0xc3, 0xc4, 0x53, 0x19, 0x91, 0xd2, 0x7a, 0xa0, 0x01, 0xfe, 0x6b, 0x1e, 0x69,
-	0x5a, 0xc6, 0x21, 0x8c, 0x46, 0x10, 0xf5, 0xc5, 0x8e, 0x1e, 0x3e, 0x01, 0xb2, 0x12, 0xf5, 0xb9,
-	0xcc, 0x07, 0xf0, 0x09, 0x75, 0x62, 0x21, 0xe4, 0x08, 0x67, 0xb0, 0x06, 0xd0, 0x37, 0x12, 0x82,
-	0x89, 0x4b, 0x51, 0x92, 0xca, 0x3d, 0xd0, 0xac, 0xa9, 0x71, 0x01, 0x11, 0x18, 0x79, 0xc0, 0xc2,
-	0xc4, 0x94, 0x34, 0xbc, 0x6c, 0xdf, 0xb4, 0x89, 0x0a, 0xac, 0x8c, 0xfe, 0x49, 0x41, 0x08, 0x54,
-	0x83, 0x9e, 0x73, 0xf8, 0x10, 0x12, 0xc9, 0x13, 0x7e, 0x9f, 0xb4, 0x82, 0xb1, 0xb9, 0xe2, 0x9e,
-	0x8f, 0xd8, 0xa0, 0x9f, 0xe0, 0xa0, 0x5e, 0x24, 0xb3, 0x99, 0xc5, 0x59, 0xfa, 0x61, 0x8e, 0xe5,
-	0xd7, 0x6e, 0x5d, 0x2e, 0xc8, 0x95, 0xf2, 0x47, 0xe4, 0xb0, 0x33, 0x2b, 0xcc, 0xee, 0xe5, 0x24,
-	0x35, 0x44, 0x47, 0x26, 0xe9, 0xdb, 0x08, 0x81, 0xb9, 0xc1, 0xf3, 0x8a, 0x4d, 0x84, 0xa3, 0x59,
-	0x9d, 0x27, 0x30, 0xf2, 0xfb, 0x4b, 0x56, 0x83, 0x1a, 0x01, 0xa2, 0x26, 0xaa, 0xe4, 0x6c, 0x10,
-	0x29, 0x63, 0x5a, 0x82, 0x06, 0xc1, 0x6f, 0xff, 0xe3, 0x15, 0x42, 0xb4, 0xdf, 0xd4, 0x29, 0xe3,
-	0x5c, 0x0b, 0x56, 0x94, 0x16, 0x7c, 0x13, 0x99, 0xe8, 0xc5, 0xeb, 0xcb, 0xe8, 0xaa, 0xa8, 0x18,
-	0x14, 0xf3, 0x6a, 0xb2, 0xa6, 0x8e, 0xc8, 0x0b, 0xa5, 0xda, 0x2c, 0x81, 0x52, 0xb5, 0x3b, 0x29,
-	0xc5, 0xf3, 0x82, 0x58, 0x77, 0x86, 0x29, 0x8b, 0x6f, 0x84, 0x03, 0xd4, 0x98, 0xd5, 0x40, 0xa5,
-	0x61, 0xb0, 0xdb, 0x6c, 0x10, 0xee, 0xa2, 0xce, 0xac, 0x06, 0x3c, 0x01, 0x3d, 0x68, 0x47, 0xdb,
-	0xdc, 0x76, 0x99, 0x0a, 0xf0, 0x9b, 0x3e, 0x4a, 0xea, 0x4b, 0xe1, 0x60, 0x00, 0x1b, 0x90, 0xbc,
-	0xbf, 0x18, 0x30, 0x01, 0xc7, 0xfb, 0x4f, 0x93, 0x69, 0xcd, 0x0c, 0x2c, 0x67, 0x4a, 0x84, 0xc3,
-	0xcf, 0xcc, 0xf1, 0xfe, 0x2b, 0xe4, 0xb0, 0xb3, 0x1f, 0x85, 0x26, 0xa9, 0x9c, 0xaa, 0x95, 0xcc,
-	0x54, 0x3d, 0x4d, 0xf6, 0x67, 0xdd, 0x17, 0x7c, 0x35, 0xc9, 0x82, 0xfd, 0x4b, 0x72, 0xdc, 0x80,
-	0x72, 0x68, 0x07, 0x7e, 0x65, 0x3b, 0x08, 0x3b, 0x44, 0xea, 0x38, 0xf0, 0xd2, 0x04, 0xc0, 0x04,
-	0x6a, 0xa7, 0x41, 0x14, 0x26, 0xa2, 0x5e, 0x9e, 0xf0, 0xff, 0xd1, 0xb3, 0x77, 0x78, 0xb0, 0x1c,
-	0x74, 0xe3, 0x68, 0x3b, 0x8c, 0x77, 0xb5, 0x82, 0x37, 0x20, 0x20, 0xd4, 0xbd, 0x51, 0x9c, 0x02,
-	0xb2, 0x82, 0x48, 0x99, 0x84, 0xe5, 0xb9, 0x1b, 0x8f, 0xc6, 0x2c, 0x4e, 0xb1, 0x28, 0xd7, 0x0d,
-	0x26, 0x88, 0x9e, 0x22, 0x4d, 0x99, 0xbc, 0x8a, 0x86, 0x4e, 0x0d, 0xf3, 0xd8, 0x40, 0xfa, 0x46,
-	0x72, 0x10, 0xcc, 0x06, 0x71, 0xf4, 0x92, 0xd9, 0xb3, 0xbb, 0x50, 0xf4, 0x11, 0x32, 0xb3, 0x34,
-	0xda, 0x1e, 0x87, 0xeb, 0x90, 0x52, 0x3b, 0xd9, 0x7a, 0x90, 0x81, 0xfa, 0x37, 0x85, 0x41, 0xc8,
-	0x55, 0x08, 0x4c, 0x97, 0xd5, 0xd1, 0x16, 0x1b, 0x26, 0xc2, 0x08, 0x13, 0x29, 0x60, 0x01, 0x7e,
-	0x45, 0xb7, 0x59, 0x9c, 0x88, 0xb5, 0xcc, 0x80, 0x14, 0x11, 0x58, 0x2d, 0x24, 0xd0, 0x7f, 0xd6,
-	0x56, 0x72, 0xf4, 0xb4, 0x2d, 0x5f, 0x34, 0xaf, 0xed, 0xa4, 0x80, 0xfd, 0xf8, 0x00, 0x99, 0x5c,
-	0x1a, 0x6d, 0x6f, 0x87, 0xc3, 0x3e, 0x7d, 0x94, 0xd4, 0x52, 0xe8, 0x1c, 0x8c, 0xf5, 0x8c, 0xb1,
-	0x09, 0x47, 0xec, 0x19, 0xe8, 0x61, 0x80, 0x19, 0xfc, 0x8f, 0x1e, 0xe0, 0x13, 0x9e, 0x1e, 0x25,
-	0x87, 0x97, 0x62, 0x16, 0xa6, 0x4c, 0xca, 0x99, 0xc8, 0x3c, 0x5b, 0xa5, 0x0f, 0x90, 0x83, 0xed,
-	0x78, 0x34, 0xce, 0x22, 0x6a, 0x74, 0x9e, 0x1c, 0xe3, 0x65, 0x32, 0x82, 0x27, 0x73, 0xd4, 0xe9,
-	0x09, 0x32, 0x07, 0x45, 0x0b, 0xf0, 0x13, 0xf4, 0x14, 0x99, 0xef, 0xb1, 0xd4, 0xed, 0x76, 0x93,
-	0xb9, 0x26, 0xa1, 0x9d, 0x17, 0xc7, 0xfd, 0xe2, 0x76, 0x1a, 0xf4, 0x41, 0xf2, 0x00, 0xa7, 0x44,
-	0xdb, 0xa5, 0x12, 0x39, 0x05, 0x48, 0x6e, 0xa0, 0xe4, 0x91, 0x84, 0x1e, 0x26, 0x07, 0x78, 0x49,
-	0x58, 0x2b, 0x25, 0xb8, 0x49, 0x0f, 0x92, 0xfd, 0x40, 0xb8, 0x09, 0x9c, 0x81, 0xbc, 0x9c, 0x0e,
-	0x13, 0xbc, 0x1f, 0xf8, 0xd3, 0x63, 0xa9, 0x5a, 0x2d, 0x25, 0x62, 0x96, 0x52, 0x32, 0x03, 0xbd,
-	0x0b, 0xd3, 0x50, 0xc2, 0x0e, 0xd0, 0x63, 0xa4, 0xd5, 0x63, 0x29, 0xae, 0xf7, 0xb9, 0x12, 0x94,
-	0x1e, 0x27, 0x47, 0x45, 0x3f, 0x0c, 0xc3, 0x46, 0xa2, 0x0f, 0x63, 0x4f, 0xe2, 0xd1, 0xd8, 0x85,
-	0x3c, 0xa2, 0x47, 0x50, 0x1e, 0x55, 0x4a, 0x54, 0xcb, 0x1e, 0x5c, 0x13, 0x75, 0x14, 0x50, 0xbc,
-	0x4f, 0x59, 0xd4, 0x1c, 0xa0, 0x38, 0xdf, 0xb2, 0x15, 0x3e, 0xa8, 0x51, 0xd9, 0x52, 0xc7, 0xe8,
-	0x11, 0x42, 0x7b, 0x2c, 0xcd, 0x16, 0x39, 0x4e, 0x0f, 0x91, 0x59, 0xa4, 0x1d, 0xc6, 0x40, 0x42,
-	0x4f, 0x40, 0x87, 0xd1, 0x80, 0x14, 0xb2, 0xc5, 0x2b, 0x95, 0xe8, 0x87, 0xa0, 0xc3, 0x9c, 0x3a,
-	0x6d, 0x88, 0x49, 0xe4, 0xeb, 0x40, 0x78, 0xa0, 0x6c, 0x46, 0x28, 0xec, 0x2a, 0x1e, 0x05, 0x86,
-	0x4b, 0xb6, 0x28, 0xbd, 0x2b, 0xb1, 0x4f, 0x01, 0x55, 0x67, 0x07, 0x29, 0x8b, 0xa5, 0x5d, 0xba,
-	0xb4, 0xdd, 0x9f, 0x5d, 0x80, 0x81, 0x0e, 0x78, 0x93, 0xd1, 0x70, 0x43, 0x66, 0x7e, 0x13, 0x0c,
-	0xb4, 0xa0, 0x06, 0x7d, 0x12, 0x12, 0xf1, 0x66, 0x40, 0x04, 0x6c, 0x3c, 0x8a, 0x53, 0xbe, 0xfd,
-	0x90, 0x88, 0xa7, 0x81, 0x19, 0xdd, 0x78, 0x67, 0xc8, 0xf8, 0x6e, 0x51, 0xc2, 0xdf, 0x02, 0x12,
-	0x0d, 0xa4, 0x1b, 0x24, 0xd9, 0x64, 0x3f, 0x47, 0xe7, 0xc8, 0x11, 0x60, 0x97, 0x83, 0xe8, 0xb7,
-	0x02, 0xd1, 0xa0, 0x3a, 0x82, 0x70, 0xa8, 0x65, 0xe7, 0x6d, 0xb4, 0x45, 0x0e, 0x61, 0xf3, 0x52,
-	0x95, 0x48, 0xcc, 0xdb, 0xf5, 0x04, 0xd0, 0x3b, 0x57, 0x89, 0x7c, 0x1e, 0xa6, 0xa8, 0xc1, 0x62,
-	0x50, 0x25, 0xb0, 0xdf, 0x90, 0xf8, 0x77, 0xe8, 0x21, 0x80, 0xe1, 0xe4, 0x8e, 0x7f, 0x89, 0x7c,
-	0x27, 0xf4, 0x8f, 0x33, 0x17, 0xcf, 0x72, 0x25, 0xfc, 0x2c, 0xc0, 0x79, 0x21, 0x0b, 0xbe, 0xa8,
-	0x39, 0xc8, 0x0f, 0x49, 0x24, 0x62, 0x09, 0x0a, 0x04, 0x6c, 0x7b, 0x74, 0xc3, 0x2e, 0xd0, 0xa6,
-	0x27, 0xc9, 0x71, 0x21, 0xb9, 0x99, 0xcd, 0xb2, 0xcc, 0x72, 0x8e, 0x3e, 0x44, 0x1e, 0x44, 0xf5,
-	0x54, 0x90, 0xe1, 0x3c, 0xf4, 0xf0, 0x02, 0x4b, 0x8b, 0xf0, 0x17, 0x8c, 0xd9, 0xb1, 0xc6, 0x0f,
-	0x16, 0x25, 0xea, 0x22, 0x7d, 0x3d, 0x79, 0xf8, 0x02, 0x08, 0xb3, 0xb5, 0x62, 0x5f, 0x8b, 0xd2,
-	0xcd, 0x08, 0xea, 0x62, 0x81, 0xe2, 0x63, 0x07, 0xa4, 0xd1, 0xe0, 0xa3, 0xb1, 0xa7, 0x32, 0xfa,
-	0xf9, 0x2e, 0x60, 0x00, 0x0c, 0xfc, 0x6a, 0xb8, 0xc5, 0x46, 0x37, 0x34, 0x9b, 0x5f, 0x90, 0x08,
-	0x79, 0xe4, 0x2d, 0x11, 0x97, 0x00, 0x21, 0x54, 0x02, 0x5f, 0xca, 0x05, 0x62, 0x19, 0x84, 0x14,
-	0x27, 0x94, 0x05, 0xbe, 0x4c, 0x7d, 0x72, 0x22, 0x4f, 0x32, 0x2e, 0xda, 0x32, 0xcf, 0x0a, 0xf4,
-	0xf8, 0x2a, 0x8b, 0xa3, 0xeb, 0xbb, 0xd9, 0xe9, 0xdb, 0x85, 0xe6, 0xce, 0xdd, 0x1a, 0x87, 0xc3,
-	0xbe, 0x2d, 0xb2, 0x57, 0x40, 0x20, 0xe5, 0xd0, 0x09, 0xef, 0x84, 0xc4, 0x05, 0x50, 0x1f, 0x70,
-	0x78, 0x71, 0x31, 0x8e, 0xd8, 0x75, 0xb3, 0xc3, 0x3d, 0xc1, 0x7c, 0xd3, 0xb2, 0x36, 0xf1, 0xab,
-	0x30, 0x13, 0x02, 0xb6, 0x11, 0xc1, 0x1a, 0x28, 0x4e, 0x62, 0x57, 0xae, 0x5f, 0x4f, 0x98, 0x12,
-	0x81, 0x17, 0xf5, 0x2a, 0x93, 0xf1, 0x6b, 0xc8, 0x1c, 0x57, 0x51, 0xa7, 0xbe, 0x32, 0x58, 0x00,
-	0x9d, 0x73, 0x91, 0x85, 0x71, 0xba, 0xc6, 0x42, 0x55, 0xfe, 0x1a, 0x96, 0xb7, 0x4b, 0xf2, 0xb9,
-	0x2a, 0x73, 0xfc, 0x3f, 0xc1, 0xb2, 0x4c, 0xa6, 0x4b, 0xcc, 0x58, 0xeb, 0xfe, 0xbf, 0x5c, 0xc9,
-	0x0a, 0x68, 0x78, 0x37, 0x48, 0xe1, 0xe5, 0x51, 0x1a, 0x5d, 0xdf, 0x5d, 0xba, 0xc2, 0x4b, 0xe2,
-	0x19, 0xba, 0xd2, 0x74, 0x2f, 0x81, 0x24, 0xf7, 0x58, 0x8a, 0x93, 0xc8, 0x3e, 0x46, 0x93, 0x59,
-	0xde, 0xc3, 0xd5, 0x0e, 0x4c, 0x02, 0x73, 0x48, 0x7e, 0x06, 0xba, 0x27, 0x97, 0x3f, 0x75, 0x26,
-	0x2c, 0xb1, 0xef, 0x05, 0x0d, 0xaa, 0xe7, 0xe7, 0xea, 0xf6, 0x18, 0xe7, 0xb8, 0x44, 0xff, 0x2c,
-	0x68, 0x05, 0x21, 0x3e, 0xfc, 0x6c, 0x5d, 0x62, 0x5e, 0x36, 0x26, 0x3e, 0xc7, 0xd8, 0xd4, 0x84,
-	0x30, 0x25, 0x3b, 0xc3, 0x84, 0xc5, 0xe9, 0xf9, 0x68, 0xc0, 0x14, 0x7c, 0x4d, 0x93, 0xe3, 0xd0,
-	0x4d, 0x0c, 0xf8, 0x20, 0xb1, 0x5c, 0xb4, 0xec, 0x6a, 0xaf, 0xe3, 0xfa, 0xb0, 0x39, 0xba, 0x29,
-	0xec, 0x1e, 0x09, 0xdf, 0x78, 0xac, 0xd1, 0xe8, 0xcf, 0xbe, 0xfa, 0xea, 0xab, 0xaf, 0x56, 0xfc,
-	0xbf, 0xae, 0x14, 0xd8, 0x22, 0x4e, 0x53, 0xb9, 0x9d, 0x37, 0x87, 0xb9, 0x63, 0xb9, 0xec, 0x68,
-	0x2e, 0x5b, 0x04, 0x0c, 0x39, 0xe9, 0xa7, 0xdd, 0xd9, 0x46, 0xfb, 0xac, 0x19, 0x18, 0x10, 0xfa,
-	0x30, 0xa9, 0xf6, 0xb6, 0x22, 0xdc, 0x97, 0x17, 0x1c, 0xe2, 0x00, 0xde, 0x71, 0x84, 0x56, 0x77,
-	0x1e, 0xa1, 0xdd, 0xcd, 0x31, 0xd9, 0xc2, 0x79, 0x32, 0xb9, 0x2e, 0x18, 0x30, 0x63, 0x5b, 0x72,
-	0xad, 0x0d, 0x2c, 0x2c, 0xf7, 0x49, 0x4e, 0xa6, 0x05, 0xb2, 0xb0, 0x3f, 0x72, 0xda, 0x71, 0x2e,
-	0xa6, 0x2e, 0xb4, 0x8b, 0x9b, 0xdc, 0xb4, 0x98, 0xeb, 0xa8, 0x50, 0x37, 0xf8, 0xcf, 0x5e, 0xb9,
-	0x81, 0x58, 0xea, 0x91, 0x70, 0x8e, 0x6b, 0xe5, 0x6e, 0xc7, 0x15, 0x1d, 0x8a, 0xdc, 0xba, 0xec,
-	0x0a, 0x67, 0x8b, 0x06, 0x2c, 0x2c, 0x17, 0x77, 0x33, 0xc2, 0x6e, 0xbe, 0xce, 0xe2, 0xac, 0xbb,
-	0x17, 0xba, 0xbf, 0x9f, 0xf6, 0xca, 0xcc, 0xdd, 0xd2, 0xde, 0xca, 0x41, 0xa8, 0x18, 0x83, 0xf0,
-	0x42, 0x31, 0x75, 0xef, 0x43, 0xea, 0x4e, 0x1a, 0x83, 0xb0, 0x17, 0x6d, 0x5f, 0xf0, 0xf6, 0x36,
-	0xb5, 0xef, 0x9a, 0xc2, 0x2b, 0xc5, 0x14, 0x6e, 0x21, 0x85, 0x8f, 0xca, 0x99, 0xb2, 0x47, 0xcb,
-	0x9a, 0xce, 0x6f, 0x54, 0xcb, 0x8d, 0xfd, 0xbb, 0xa5, 0x11, 0x76, 0xa1, 0x97, 0xd9, 0x4d, 0xe1,
-	0x83, 0xc2, 0xb0, 0x09, 0x91, 0xb4, 0xce, 0x7d, 0x6a, 0x99, 0x23, 0x65, 0xf3, 0x1c, 0xa7, 0x9e,
-	0x39, 0x22, 0x76, 0x9f, 0x09, 0x4d, 0x14, 0x1e, 0x37, 0xe3, 0xa1, 0xc7, 0x16, 0x13, 0x0c, 0x40,
-	0xe7, 0x2c, 0x1e, 0x7a, 0x28, 0x50, 0xfe, 0xd0, 0xc3, 0xdb, 0xfb, 0xd0, 0xc3, 0xbb, 0xe3, 0x43,
-	0x0f, 0xcf, 0x7d, 0xe8, 0x51, 0x26, 0xfd, 0x03, 0x4b, 0xfa, 0xcb, 0xc6, 0x43, 0x8f, 0xdc, 0x2f,
-	0x54, 0x0a, 0x37, 0x61, 0xa5, 0x83, 0x76, 0x84, 0x4c, 0x58, 0x51, 0x18, 0x13, 0x7a, 0xea, 0x82,
-	0x95, 0x9b, 0xa4, 0xe1, 0xf6, 0x58, 0x9c, 0x13, 0x68, 0x00, 0x9e, 0x30, 0x40, 0x33, 0xe8, 0x28,
-	0xaf, 0xf1, 0x58, 0x52, 0x05, 0xc8, 0x78, 0xf7, 0xeb, 0x2e, 0xef, 0xbe, 0x30, 0x62, 0x90, 0x3f,
-	0xcd, 0x40, 0x26, 0x17, 0x2e, 0x16, 0x33, 0x65, 0x1b, 0x99, 0x72, 0xc2, 0x52, 0x09, 0xb9, 0xae,
-	0x6a, 0x7e, 0xfc, 0x97, 0x57, 0xb8, 0xef, 0xbc, 0x27, 0x7e, 0xf8, 0xc2, 0xbb, 0x2e, 0x63, 0x3f,
-	0x79, 0x7c, 0xaf, 0x05, 0xb3, 0xcf, 0x4f, 0xb8, 0x44, 0x1a, 0xe7, 0x27, 0x27, 0x08, 0xe1, 0x09,
-	0x75, 0xe6, 0x51, 0x0f, 0x0c, 0x48, 0x59, 0xdf, 0x87, 0x56, 0xdf, 0x0b, 0xba, 0xa5, 0xfb, 0xfe,
-	0x65, 0xcf, 0xb1, 0xad, 0xbe, 0x3f, 0xde, 0xf1, 0x85, 0xc5, 0x62, 0xaa, 0x5f, 0x41, 0xaa, 0x5b,
-	0xd6, 0x88, 0x19, 0x04, 0x69, 0x7a, 0x37, 0x72, 0xdb, 0x7d, 0xe7, 0xb2, 0xf8, 0xce, 0xe2, 0xa6,
-	0x62, 0x6c, 0xea, 0x88, 0xa1, 0x91, 0x9d, 0x0d, 0x7d, 0xc0, 0xe1, 0x42, 0xb8, 0x53, 0xbe, 0x94,
-	0xf5, 0x34, 0xb1, 0x7a, 0x9a, 0x6b, 0x42, 0x13, 0xf0, 0x15, 0xcf, 0xe9, 0xad, 0x00, 0x89, 0x84,
-	0xfc, 0x43, 0x4d, 0x87, 0x4a, 0x97, 0x7a, 0x23, 0xad, 0x83, 0x83, 0x6a, 0xe6, 0xe0, 0xa0, 0xcc,
-	0x8e, 0x48, 0x2d, 0x3b, 0xc2, 0x41, 0x92, 0xa6, 0x39, 0xce, 0xfa, 0x51, 0xe8, 0x43, 0x3c, 0x34,
-	0x5e, 0xc4, 0x96, 0x4d, 0x1b, 0x81, 0xa4, 0x01, 0x22, 0x16, 0xde, 0x51, 0xdc, 0xf0, 0x0e, 0x36,
-	0x7c, 0xd8, 0x58, 0x99, 0x74, 0xc5, 0xba, 0xcd, 0x4f, 0x7a, 0xc5, 0x8e, 0x9a, 0x52, 0x66, 0x29,
-	0xe1, 0xad, 0x18, 0xc2, 0xbb, 0xd0, 0x29, 0xa6, 0xe7, 0x06, 0xd2, 0xf3, 0x90, 0xa6, 0xc7, 0xd9,
-	0xa6, 0xa5, 0x57, 0x8a, 0x9d, 0x44, 0xf7, 0xcf, 0x9b, 0xac, 0x8e, 0xd1, 0x6a, 0x25, 0xc7, 0x68,
-	0xf5, 0xfc, 0x31, 0xda, 0xc2, 0xbb, 0x8a, 0xbb, 0xbe, 0x8b, 0x5d, 0x9f, 0xb7, 0x35, 0x6a, 0xbe,
-	0x53, 0xba, 0xef, 0xdf, 0xf1, 0x0a, 0x3d, 0x60, 0xf7, 0xaf, 0xe7, 0x65, 0x7a, 0xf1, 0xb6, 0xad,
-	0x17, 0xdd, 0xa4, 0x69, 0xfa, 0x7f, 0xe0, 0x15, 0x38, 0xe9, 0x80, 0xd2, 0x8b, 0xab, 0xab, 0x5d,
-	0x8c, 0xc9, 0x14, 0x22, 0x25, 0xd3, 0x66, 0x4c, 0x28, 0x67, 0x7e, 0x26, 0x26, 0x14, 0x31, 0xbc,
-	0x7b, 0x32, 0x89, 0xb1, 0x99, 0x40, 0x20, 0x5f, 0x25, 0xf0, 0xbb, 0x6c, 0x23, 0xf1, 0x7e, 0xc7,
-	0x46, 0x22, 0x43, 0xa2, 0xee, 0xc5, 0xb7, 0xbc, 0x02, 0x7f, 0xe2, 0x5e, 0xbd, 0x28, 0xa1, 0x35,
-	0x13, 0x47, 0x2a, 0x02, 0x3c, 0xa7, 0x65, 0x80, 0x67, 0x19, 0xed, 0x3f, 0x57, 0xb0, 0x09, 0x72,
-	0xd2, 0x7e, 0x8d, 0x34, 0x25, 0x0e, 0x5d, 0x4d, 0x2a, 0x08, 0x17, 0xc8, 0xdd, 0x27, 0x82, 0x70,
-	0x8f, 0x91, 0x29, 0x44, 0x1a, 0x47, 0x61, 0x1a, 0xa0, 0xc3, 0x6a, 0xab, 0x46, 0x58, 0xad, 0x3f,
-	0x2a, 0xf0, 0x96, 0x66, 0x23, 0x04, 0xca, 0x7a, 0xf2, 0x01, 0xab, 0x27, 0xce, 0xea, 0x74, 0x4f,
-	0xc6, 0x05, 0x3e, 0xd8, 0x5c, 0x83, 0x17, 0x8a, 0x1b, 0x7c, 0xd5, 0x73, 0xb4, 0x58, 0xc8, 0xbb,
-	0xf3, 0x60, 0x14, 0x27, 0xe3, 0xd1, 0x30, 0xc1, 0xf1, 0x59, 0x79, 0x01, 0x1b, 0x69, 0x04, 0x95,
-	0x95, 0x17, 0x80, 0x29, 0xe7, 0xe2, 0x78, 0x14, 0x8b, 0x43, 0x10, 0x9e, 0xd0, 0xd7, 0x94, 0xf8,
-	0x91, 0x3e, 0x4f, 0xf8, 0xdf, 0xf5, 0x5c, 0x3e, 0xe2, 0x9f, 0xca, 0x14, 0x28, 0x59, 0x90, 0x3e,
-	0xc8, 0x79, 0x71, 0x54, 0x2b, 0xe2, 0x42, 0xd6, 0x5f, 0xcf, 0xfb, 0xb2, 0x73, 0x5c, 0x2f, 0x59,
-	0xac, 0x3f, 0xc4, 0x5b, 0x7a, 0xc0, 0xd4, 0x1a, 0x46, 0x55, 0xba, 0x9d, 0xf7, 0x97, 0x78, 0xc7,
-	0x9d, 0x06, 0x4a, 0xc9, 0x96, 0xf1, 0xc3, 0x9e, 0xa5, 0x6c, 0x0b, 0xeb, 0xd5, 0xad, 0xff, 0xc4,
-	0x2b, 0xf4, 0xbe, 0xe3, 0xd9, 0x1e, 0x8f, 0x1e, 0xc4, 0xf6, 0xab, 0x81, 0x4c, 0x02, 0x86, 0x07,
-	0xbb, 0xf4, 0xc5, 0xcc, 0x91, 0x49, 0x30, 0xe0, 0xda, 0x6b, 0x62, 0x23, 0x86, 0x86, 0x2d, 0x4f,
-	0xa1, 0x61, 0x37, 0x46, 0x38, 0x1f, 0x5a, 0x91, 0x2a, 0x5b, 0x33, 0x3f, 0xea, 0x59, 0x7a, 0xb7,
-	0x80, 0x4a, 0xdd, 0x95, 0x2f, 0x7a, 0x7b, 0x9f, 0x15, 0xdc, 0xf5, 0xee, 0x37, 0x28, 0xa6, 0xef,
-	0xe3, 0x9e, 0xb5, 0xfd, 0xdd, 0xab, 0x69, 0x4d, 0xe8, 0xdf, 0x56, 0x8b, 0x8f, 0x2b, 0x90, 0x81,
-	0x8b, 0xc6, 0x98, 0x8b, 0x94, 0xc1, 0xc0, 0x8a, 0xc9, 0x40, 0x45, 0x74, 0xd5, 0x58, 0x11, 0xef,
-	0xd0, 0x91, 0x75, 0x8a, 0x54, 0x3a, 0x41, 0x69, 0x78, 0x70, 0xa5, 0x13, 0xdc, 0xbf, 0x98, 0xe0,
-	0x05, 0x42, 0xf8, 0x19, 0x0b, 0x16, 0x6b, 0x58, 0x47, 0x9f, 0x78, 0x46, 0xcd, 0xb1, 0x81, 0x91,
-	0xcb, 0x0c, 0xc9, 0x9d, 0x2a, 0x0f, 0xc9, 0xbd, 0xe3, 0xb0, 0xdf, 0x32, 0xdb, 0xe5, 0x57, 0x3c,
-	0xcb, 0x6e, 0x2b, 0x1a, 0x34, 0x3d, 0xb4, 0xdf, 0xf3, 0xf2, 0x67, 0x4d, 0x3f, 0xc5, 0x21, 0x2d,
-	0x53, 0x48, 0x9f, 0xb0, 0x15, 0x52, 0x96, 0x4a, 0xdd, 0x87, 0xbf, 0x50, 0x2a, 0xa1, 0xbd, 0xd6,
-	0x4d, 0x2d, 0xd7, 0x2e, 0x9e, 0x91, 0x87, 0xc9, 0x96, 0x0e, 0x90, 0xe2, 0x29, 0x15, 0x38, 0xd5,
-	0x17, 0xf1, 0x21, 0x22, 0x05, 0x0a, 0xb3, 0xbd, 0x28, 0x3a, 0x52, 0x69, 0x2f, 0x42, 0xba, 0xbb,
-	0x2a, 0x82, 0x66, 0x2b, 0xdd, 0x55, 0xbd, 0xa2, 0xd4, 0x8d, 0x15, 0xa5, 0x4c, 0x29, 0x7c, 0xd2,
-	0xa5, 0x14, 0x72, 0x74, 0xea, 0xce, 0xfc, 0xab, 0xe7, 0x38, 0xe6, 0xdb, 0x6b, 0x6b, 0xee, 0x1c,
-	0x95, 0x3b, 0xdc, 0x9a, 0xf7, 0xc6, 0x83, 0x88, 0x87, 0x44, 0x8a, 0xd0, 0x46, 0x05, 0xa0, 0xf3,
-	0x22, 0x20, 0x77, 0x71, 0xb4, 0x33, 0xec, 0x4b, 0x3b, 0xda, 0x04, 0x2d, 0x2c, 0x15, 0x77, 0xfc,
-	0x53, 0x9e, 0xb5, 0xfb, 0xcb, 0xf5, 0x49, 0x77, 0xf9, 0x9f, 0x3c, 0xe7, 0x11, 0xe6, 0x3d, 0x75,
-	0x7a, 0x9e, 0x4c, 0x1b, 0xe2, 0x2e, 0x06, 0xd2, 0x04, 0xd1, 0x67, 0x49, 0x13, 0x27, 0xeb, 0xea,
-	0x88, 0xcf, 0x0e, 0x11, 0xe5, 0xe5, 0x9a, 0xc8, 0x76, 0xc6, 0x85, 0x73, 0xc5, 0x9d, 0xfd, 0xb4,
-	0x67, 0x6d, 0x1c, 0x1d, 0xbd, 0xd1, 0xdd, 0x5d, 0x27, 0xd3, 0x46, 0x23, 0x30, 0x04, 0x98, 0x34,
-	0xe6, 0x9b, 0x06, 0x28, 0xac, 0x32, 0xfa, 0xea, 0x81, 0x06, 0xd8, 0x31, 0xab, 0x56, 0xa8, 0xf9,
-	0x35, 0x11, 0x5d, 0xe6, 0x0c, 0x07, 0x9d, 0xcb, 0x86, 0x83, 0x1a, 0xa1, 0xa0, 0x76, 0x38, 0x65,
-	0x35, 0x17, 0x4e, 0xf9, 0x7d, 0x8f, 0xcc, 0xd8, 0xb1, 0xc7, 0x3f, 0xa5, 0x38, 0xdb, 0xc7, 0x44,
-	0xac, 0x29, 0xcb, 0x06, 0xda, 0xaa, 0x7e, 0x06, 0x32, 0xc3, 0x5e, 0x4b, 0x80, 0xff, 0x41, 0x4f,
-	0x48, 0xb6, 0xb8, 0x33, 0xa6, 0x0c, 0x07, 0xd9, 0x0d, 0x99, 0x54, 0x1e, 0xbd, 0x5e, 0x74, 0x9b,
-	0x09, 0x55, 0xa1, 0x01, 0x38, 0x41, 0xf0, 0xe6, 0xd3, 0xd2, 0x68, 0x47, 0x48, 0x5b, 0x3d, 0x30,
-	0x41, 0x18, 0x43, 0x17, 0xde, 0x32, 0xa6, 0x97, 0x4c, 0xfa, 0x2f, 0x91, 0x66, 0x30, 0x36, 0x89,
-	0xd0, 0x22, 0xed, 0x59, 0x22, 0xbd, 0x20, 0x22, 0x3e, 0x21, 0x5b, 0x22, 0x8e, 0x1b, 0xa8, 0xa9,
-	0x50, 0x79, 0xf9, 0xc0, 0xc8, 0xe5, 0xbf, 0x4c, 0x48, 0x7b, 0x51, 0xea, 0x18, 0xa1, 0xd4, 0x3c,
-	0xa5, 0xd4, 0xf8, 0x45, 0x43, 0x79, 0xcf, 0x12, 0xbf, 0xe9, 0x19, 0x32, 0x19, 0x8c, 0x79, 0x13,
-	0x55, 0x2b, 0x96, 0xd3, 0x22, 0x32, 0x90, 0x99, 0xfc, 0x5f, 0xf6, 0xc8, 0x03, 0x66, 0x78, 0xc1,
-	0xa5, 0x51, 0xa8, 0xac, 0x4e, 0x7e, 0x1d, 0x71, 0x15, 0x32, 0x66, 0x22, 0xd0, 0x34, 0x51, 0x81,
-	0xca, 0x52, 0xa6, 0x3d, 0x3f, 0x63, 0x6b, 0xcf, 0x82, 0x06, 0xf5, 0xdc, 0xfa, 0x91, 0xe7, 0x0e,
-	0x7d, 0xa7, 0x6f, 0x94, 0x91, 0x74, 0x9e, 0x75, 0xaf, 0x4d, 0xe7, 0x5d, 0x19, 0xb3, 0x38, 0x4c,
-	0x47, 0x71, 0x22, 0x42, 0xea, 0xe8, 0x05, 0x42, 0x33, 0x35, 0x45, 0x4c, 0xc6, 0x3a, 0x3e, 0x50,
-	0x10, 0x42, 0x1f, 0x38, 0x8a, 0x58, 0x1e, 0xfd, 0x6a, 0xe6, 0x26, 0x87, 0x5e, 0x9e, 0xf8, 0x0d,
-	0x4f, 0x91, 0xf2, 0xdf, 0x4f, 0x66, 0xb3, 0x75, 0xd3, 0x47, 0xc8, 0x8c, 0x3c, 0xbc, 0x17, 0x81,
-	0x85, 0xdc, 0xc8, 0xcd, 0x40, 0x41, 0xef, 0x83, 0x80, 0xa9, 0x5c, 0x7c, 0x06, 0x5a, 0x30, 0x10,
-	0xeb, 0x6b, 0x61, 0xca, 0x62, 0x98, 0xd8, 0xd2, 0x8d, 0xad, 0x00, 0x7e, 0x87, 0x1c, 0x74, 0x30,
-	0x06, 0x88, 0x3d, 0xbb, 0xb1, 0xb1, 0x32, 0x56, 0xe1, 0x99, 0x3c, 0x25, 0xf5, 0xb4, 0xb1, 0x2f,
-	0x55, 0x69, 0xff, 0x03, 0xe4, 0x98, 0x6b, 0x3c, 0xae, 0x45, 0xe9, 0x66, 0x7b, 0x2d, 0x18, 0xd3,
-	0x27, 0x49, 0x0d, 0xad, 0x29, 0xee, 0x33, 0x2b, 0xbd, 0x9a, 0x80, 0x19, 0x0d, 0x7b, 0xbd, 0x52,
-	0x60, 0xaf, 0x57, 0xcd, 0xd9, 0xe3, 0xbf, 0x44, 0x4e, 0xe4, 0xc7, 0xc4, 0x22, 0xe1, 0x2d, 0x76,
-	0x30, 0xdb, 0xeb, 0x4a, 0x68, 0x90, 0x65, 0x64, 0x74, 0xdb, 0x2a, 0x99, 0xcb, 0x04, 0x56, 0x70,
-	0xcd, 0xcf, 0xa3, 0x30, 0x9f, 0xb6, 0x2b, 0x9e, 0x37, 0xe7, 0xac, 0xab, 0x84, 0xac, 0x75, 0x44,
-	0x8e, 0x16, 0xe6, 0xa1, 0x6f, 0x20, 0xf5, 0x4e, 0x1f, 0x96, 0x36, 0xce, 0xb1, 0x23, 0xd6, 0x6d,
-	0x03, 0x40, 0x44, 0xd7, 0x23, 0x16, 0x07, 0x3c, 0x13, 0x3d, 0x45, 0x9a, 0x46, 0xbc, 0xfd, 0x0d,
-	0x29, 0x0c, 0x36, 0xd0, 0xff, 0x79, 0xcf, 0x15, 0x11, 0x04, 0x5a, 0x54, 0x1b, 0x0b, 0x62, 0x57,
-	0x6d, 0x40, 0x54, 0x7c, 0xad, 0xb8, 0x7e, 0x56, 0xb6, 0x8d, 0xfd, 0x35, 0x7b, 0x1b, 0x9b, 0x6f,
-	0x4c, 0x4f, 0xe1, 0x1f, 0x7a, 0xe5, 0x61, 0x48, 0xf7, 0x74, 0x4c, 0xb1, 0xa7, 0x59, 0xb0, 0x70,
-	0xb9, 0x98, 0xf8, 0xcf, 0x7a, 0xd6, 0xc1, 0x53, 0x19, 0x71, 0xba, 0x1b, 0xdf, 0xf4, 0x8a, 0x62,
-	0xa5, 0xee, 0x53, 0x07, 0x4a, 0xfc, 0x81, 0xbf, 0xce, 0x3b, 0x70, 0xdc, 0xd8, 0xda, 0x97, 0xed,
-	0x09, 0xfe, 0xc7, 0x23, 0x4d, 0x11, 0x24, 0x11, 0xf3, 0x68, 0xe0, 0x63, 0xfc, 0xed, 0x12, 0xee,
-	0x35, 0xe1, 0x2b, 0xa4, 0x06, 0x18, 0x97, 0x10, 0x4c, 0x5b, 0xba, 0x0d, 0xb6, 0x72, 0x37, 0xed,
-	0xf4, 0xf9, 0x82, 0xd2, 0x0c, 0x78, 0x82, 0x3e, 0x4d, 0xa6, 0xa4, 0xfa, 0x93, 0x11, 0xf6, 0x2d,
-	0x6b, 0x66, 0x08, 0xa4, 0x78, 0xce, 0x45, 0x66, 0xd5, 0x0e, 0xae, 0xba, 0x79, 0x6f, 0xfc, 0x39,
-	0x32, 0x6d, 0x44, 0xf8, 0x88, 0x3b, 0x63, 0xad, 0xcc, 0xcb, 0x30, 0x0a, 0x1f, 0x98, 0x99, 0x81,
-	0xee, 0x75, 0xfe, 0x7a, 0xc6, 0x24, 0x57, 0xbe, 0x3c, 0xe5, 0x7f, 0xde, 0xcb, 0x87, 0xb2, 0xdd,
-	0xd3, 0xa0, 0x19, 0x66, 0x45, 0xd5, 0x32, 0x2b, 0xca, 0xb6, 0x3d, 0xbf, 0x61, 0x6f, 0x7b, 0xb2,
-	0x84, 0xe8, 0x61, 0xfa, 0xac, 0xe7, 0x8e, 0xad, 0xd3, 0xfe, 0x2d, 0xcf, 0x7c, 0x86, 0x67, 0x96,
-	0x54, 0xbb, 0xa9, 0xb4, 0xf7, 0xe0, 0x13, 0xc8, 0x1e, 0xf2, 0x3d, 0x10, 0x77, 0x84, 0x89, 0x54,
-	0x99, 0x2f, 0xf0, 0x37, 0x3d, 0xeb, 0x0a, 0x99, 0xab, 0x79, 0xd3, 0x17, 0x48, 0x25, 0xae, 0xcd,
-	0xb8, 0xfb, 0x79, 0x14, 0x03, 0x23, 0x57, 0x23, 0x16, 0xaf, 0xca, 0x48, 0xe0, 0x5a, 0xa0, 0xd2,
-	0x7c, 0xe9, 0x32, 0x42, 0x92, 0xd5, 0xd2, 0x65, 0x04, 0x4b, 0x97, 0x2c, 0xa7, 0xfe, 0x0f, 0x2a,
-	0xea, 0xfe, 0xa8, 0xd4, 0x84, 0x25, 0xb6, 0x5d, 0x76, 0x83, 0x54, 0x71, 0x6c, 0x90, 0xa4, 0xe3,
-	0xa8, 0xbd, 0x26, 0xe6, 0x9c, 0x4c, 0x2a, 0x4c, 0x37, 0x15, 0xdb, 0x43, 0x99, 0x34, 0xc4, 0xa1,
-	0x9e, 0x3d, 0x3b, 0xe6, 0x87, 0xc1, 0xdc, 0x28, 0x45, 0x4b, 0x5f, 0x01, 0xdc, 0x37, 0xa6, 0xbc,
-	0xfb, 0x74, 0x63, 0xca, 0xb0, 0x8e, 0x49, 0xce, 0x3a, 0xbe, 0x40, 0x9a, 0x4a, 0xea, 0xe4, 0xf4,
-	0xd7, 0x06, 0xbd, 0x57, 0x62, 0xd0, 0x57, 0x2c, 0x83, 0xde, 0xff, 0xb0, 0x47, 0xf6, 0xa3, 0xf0,
-	0x19, 0xc3, 0x6f, 0x5c, 0x19, 0xf3, 0xec, 0x2b, 0x63, 0xbe, 0x08, 0x32, 0xcf, 0x0c, 0x87, 0xf5,
-	0x86, 0xd0, 0x02, 0x3f, 0x7e, 0x47, 0xd2, 0xc4, 0x2d, 0x8e, 0x43, 0xd9, 0x89, 0xc2, 0x15, 0x87,
-	0x4a, 0xc2, 0x8e, 0xe5, 0x40, 0x4e, 0xb3, 0x98, 0xeb, 0xa8, 0xb7, 0xf7, 0x3a, 0xfa, 0x76, 0xb2,
-	0xcf, 0x2c, 0x2d, 0xac, 0x70, 0xb9, 0x9c, 0xe5, 0xa5, 0x3c, 0xb0, 0xb2, 0xd3, 0x77, 0xe6, 0xae,
-	0xe6, 0x0b, 0x23, 0xbb, 0xe8, 0x9e, 0x6d, 0x36, 0xbb, 0xff, 0x77, 0x9e, 0x88, 0xef, 0xb0, 0x47,
-	0xc6, 0xe2, 0x87, 0x77, 0x47, 0xfc, 0xa0, 0x4f, 0x13, 0xc2, 0x77, 0x7b, 0xea, 0xa9, 0x2e, 0x4d,
-	0x47, 0x66, 0xb4, 0x02, 0x23, 0x27, 0x7d, 0x9e, 0x34, 0x2d, 0x36, 0x0a, 0xfe, 0x17, 0x2b, 0x6f,
-	0x3b, 0xbb, 0x2d, 0xfe, 0xfc, 0x91, 0x0c, 0x0d, 0xf0, 0xb7, 0xc9, 0x61, 0x2b, 0xbb, 0xf2, 0xe9,
-	0x97, 0xaf, 0x3d, 0xd6, 0x6a, 0x52, 0xb9, 0xe3, 0xd5, 0xc4, 0x7f, 0xcd, 0x2b, 0x0c, 0x3f, 0xbe,
-	0xd7, 0x38, 0x08, 0x4b, 0x78, 0xab, 0x79, 0xe1, 0x2d, 0xdb, 0xe7, 0x7c, 0xce, 0x73, 0x84, 0x32,
-	0xe4, 0x28, 0xb3, 0xbc, 0xe0, 0x25, 0x01, 0xd2, 0x25, 0x3a, 0x4f, 0xde, 0xe2, 0xac, 0x18, 0xb7,
-	0x38, 0xef, 0xd6, 0x05, 0x7e, 0xa9, 0xb8, 0x1f, 0xbf, 0xe5, 0x59, 0x31, 0x60, 0xc5, 0x24, 0x5a,
-	0x51, 0x0e, 0x4b, 0xe8, 0x18, 0x0a, 0x07, 0x51, 0xba, 0x7b, 0xcf, 0x52, 0x3d, 0x4f, 0xa6, 0x8d,
-	0x6a, 0x44, 0xff, 0x4c, 0x90, 0xff, 0x3e, 0x32, 0x67, 0x5a, 0x3d, 0x99, 0x36, 0x5d, 0x07, 0xb5,
-	0xcf, 0x66, 0xeb, 0x34, 0xa7, 0x6c, 0xa6, 0x02, 0xbb, 0xad, 0x97, 0xc9, 0x41, 0x23, 0xa9, 0x64,
-	0xf9, 0x19, 0x7b, 0x47, 0x70, 0x32, 0x3f, 0xfb, 0xb3, 0xb5, 0xf2, 0xfc, 0xb0, 0x78, 0x9f, 0x8b,
-	0xe5, 0x31, 0x16, 0x7c, 0x82, 0x56, 0x2b, 0x0a, 0x81, 0xcf, 0x39, 0x64, 0xec, 0x07, 0x87, 0xea,
-	0xd6, 0x53, 0x3c, 0xa9, 0x79, 0x66, 0x98, 0xe6, 0x9f, 0xe2, 0xa9, 0x65, 0x9f, 0xe2, 0x29, 0x13,
-	0xe3, 0xcf, 0xbb, 0x9c, 0x9d, 0x39, 0xfa, 0xf4, 0xd8, 0xff, 0x87, 0xc7, 0x1f, 0x2b, 0x42, 0x0f,
-	0xc5, 0x9a, 0xf2, 0x50, 0xac, 0xd1, 0xe3, 0xa4, 0xd2, 0x4d, 0x85, 0x6e, 0xca, 0x3c, 0x61, 0x54,
-	0xe9, 0xa6, 0xf4, 0x49, 0x75, 0xe7, 0xba, 0x6a, 0xef, 0xc7, 0xd7, 0xba, 0x29, 0x9f, 0xf7, 0x89,
-	0x7c, 0x85, 0x84, 0x5f, 0xbd, 0xce, 0x98, 0x89, 0x35, 0xcb, 0x35, 0x59, 0x6e, 0x26, 0xce, 0xf5,
-	0x84, 0xaf, 0xa8, 0xf0, 0x3d, 0x8a, 0x33, 0xf6, 0xdb, 0x11, 0xc5, 0xfa, 0xc7, 0xb8, 0x11, 0xff,
-	0x85, 0x0a, 0x99, 0xcd, 0xbe, 0x41, 0x07, 0xd3, 0x96, 0x61, 0xa2, 0x2f, 0x6e, 0x74, 0xc9, 0x24,
-	0x28, 0x41, 0x66, 0x9c, 0xfd, 0x7a, 0xa7, 0xeb, 0x81, 0x06, 0x80, 0xec, 0x8e, 0xc6, 0xca, 0x8c,
-	0xc3, 0x6f, 0x7a, 0x9c, 0x54, 0xc7, 0xa9, 0xf4, 0xbf, 0x4f, 0x1b, 0xfc, 0x09, 0x00, 0x0e, 0x15,
-	0xae, 0xef, 0xc4, 0x31, 0x8c, 0x0b, 0x0f, 0x45, 0xab, 0x07, 0x1a, 0x00, 0x1a, 0x70, 0x1c, 0x33,
-	0x8e, 0xe4, 0x57, 0xd1, 0x54, 0x1a, 0xfa, 0x9f, 0xc4, 0xeb, 0xc2, 0x64, 0x86, 0x4f, 0x68, 0xbe,
-	0xcf, 0x92, 0x54, 0xd8, 0x21, 0xf8, 0x0d, 0x1b, 0xcf, 0xf5, 0x4d, 0xb6, 0xbe, 0xb5, 0x34, 0x1a,
-	0x5e, 0x1f, 0x44, 0xeb, 0xa9, 0x30, 0x42, 0x6c, 0x20, 0x4c, 0xda, 0x50, 0xbd, 0x9f, 0xd4, 0x47,
-	0x53, 0xa4, 0x16, 0x98, 0x20, 0xff, 0x97, 0x3c, 0xd7, 0x65, 0x0e, 0xfa, 0x66, 0xc1, 0x0f, 0xc3,
-	0x77, 0x50, 0xf8, 0xb2, 0x9f, 0xce, 0x59, 0xb6, 0x43, 0xfd, 0x82, 0xbd, 0x43, 0xcd, 0xb7, 0xa9,
-	0xa5, 0x16, 0x68, 0xca, 0x5f, 0x24, 0xb9, 0x0f, 0x34, 0x7d, 0xd1, 0xa6, 0x29, 0xdf, 0xa6, 0x75,
-	0x8e, 0xe3, 0xba, 0xc4, 0x72, 0xb7, 0x13, 0xeb, 0x18, 0x99, 0xc2, 0x15, 0x1f, 0x9f, 0x7b, 0xe4,
-	0xe2, 0xa4, 0x01, 0xd6, 0x93, 0x5e, 0x9e, 0x7e, 0xb8, 0xac, 0xcc, 0x31, 0xfe, 0xdb, 0x2e, 0xc7,
-	0xb8, 0x45, 0xa2, 0xee, 0x43, 0xea, 0xba, 0x6e, 0x63, 0x4f, 0x8a, 0x8a, 0x31, 0x29, 0xca, 0x38,
-	0xf7, 0x3b, 0x36, 0xe7, 0xf2, 0xd5, 0xea, 0x56, 0xff, 0xcd, 0xdb, 0xe3, 0x36, 0x4f, 0xe1, 0x73,
-	0x1a, 0x77, 0xe0, 0xb3, 0x72, 0x3b, 0x23, 0xcb, 0x02, 0x80, 0x28, 0xa9, 0x0d, 0x8d, 0xb3, 0x34,
-	0xf8, 0x5e, 0x58, 0x29, 0xee, 0xe8, 0xef, 0xf2, 0x8e, 0x9e, 0xb2, 0xe3, 0x4c, 0xdc, 0x1d, 0xd1,
-	0x7d, 0xfe, 0xb6, 0x57, 0x7a, 0x3d, 0x69, 0x2f, 0x0b, 0x28, 0xb6, 0x4e, 0x5e, 0x78, 0x0a, 0xc6,
-	0xa9, 0x1f, 0x8f, 0xc6, 0x67, 0x07, 0x03, 0x71, 0x6a, 0x20, 0x93, 0x65, 0x21, 0xbd, 0x5f, 0xe2,
-	0xe4, 0xfb, 0x66, 0xe0, 0xfe, 0x5e, 0xc4, 0xbf, 0xaf, 0xec, 0xe6, 0x54, 0x99, 0x71, 0xf2, 0x7b,
-	0xb6, 0x71, 0x52, 0x5c, 0x89, 0x6e, 0xeb, 0x53, 0x5e, 0xc1, 0x35, 0x2c, 0xc3, 0x68, 0xf2, 0x2c,
-	0xa3, 0xe9, 0x04, 0x21, 0xb1, 0xbe, 0xb3, 0xc1, 0x5f, 0x42, 0x31, 0x20, 0x65, 0x71, 0x2f, 0xbf,
-	0xef, 0xb9, 0x62, 0x86, 0xec, 0x76, 0x35, 0x69, 0x7f, 0xe5, 0xdd, 0xe1, 0x35, 0xb0, 0x42, 0x52,
-	0x8b, 0xce, 0xd0, 0x84, 0xc5, 0x0d, 0x4b, 0x0b, 0x5f, 0x60, 0xab, 0x81, 0x06, 0x2c, 0x5c, 0x2b,
-	0xee, 0xc0, 0x97, 0x79, 0x07, 0xde, 0xa0, 0x19, 0xbc, 0x37, 0x75, 0xba, 0x43, 0x9f, 0xf7, 0xf6,
-	0xbe, 0xac, 0x76, 0x77, 0xee, 0xcf, 0xb2, 0x60, 0x88, 0x3f, 0xb0, 0x83, 0x21, 0xf6, 0x6a, 0xd8,
-	0xd4, 0x52, 0xae, 0xcb, 0x72, 0xc0, 0x4c, 0x86, 0xd7, 0x69, 0x84, 0xa3, 0x54, 0xa4, 0xca, 0x74,
-	0xe3, 0x1f, 0xda, 0xba, 0xd1, 0x51, 0x6b, 0xae, 0xd5, 0xcc, 0x4d, 0xbc, 0x7b, 0x69, 0xf5, 0x8f,
-	0xf2, 0xad, 0x66, 0x6a, 0xd5, 0xad, 0xfe, 0xa2, 0xe7, 0xbc, 0xe7, 0x47, 0x9f, 0x32, 0xdf, 0x5e,
-	0x10, 0x43, 0xe1, 0x78, 0x64, 0xc0, 0xc8, 0x54, 0x46, 0xd1, 0x57, 0x6c, 0x8a, 0x1c, 0x0d, 0x6a,
-	0x8a, 0x06, 0x8e, 0xfb, 0x85, 0xce, 0xa0, 0xa3, 0x92, 0x93, 0xe9, 0xaf, 0xda, 0x27, 0xd3, 0xb9,
-	0xfa, 0x74, 0x6b, 0xaf, 0x79, 0x7b, 0xdd, 0x5b, 0xbc, 0xeb, 0xc9, 0x65, 0x3c, 0xaa, 0x51, 0xb5,
-	0x1e, 0xd5, 0x58, 0xe8, 0x16, 0x53, 0xfc, 0xc7, 0x9c, 0xe2, 0x87, 0x0b, 0x27, 0x96, 0x49, 0x92,
-	0x26, 0xff, 0x56, 0xc1, 0x8d, 0xca, 0xa2, 0x67, 0x63, 0xca, 0x94, 0xd3, 0xd7, 0x6c, 0xe5, 0xe4,
-	0xac, 0x57, 0xb7, 0xfc, 0x1e, 0xe7, 0x85, 0xcd, 0x32, 0x21, 0xf8, 0xba, 0x2d, 0x04, 0x8e, 0xd2,
-	0xba, 0xf6, 0x0f, 0x79, 0x45, 0xd7, 0x3e, 0x73, 0xf6, 0xce, 0x8c, 0xb2, 0x77, 0x9a, 0x60, 0xe0,
-	0x94, 0x79, 0xc9, 0xff, 0xc4, 0xf6, 0x92, 0xbb, 0x1b, 0xd0, 0x44, 0x7c, 0xc6, 0x2b, 0xbb, 0x44,
-	0x7a, 0xb7, 0x72, 0x51, 0xb6, 0x6e, 0x7d, 0x23, 0xb7, 0x6e, 0x15, 0x34, 0xaa, 0x89, 0xdb, 0x22,
-	0x07, 0x72, 0xbb, 0x1a, 0xe7, 0x16, 0x37, 0x7f, 0x37, 0x90, 0x47, 0x88, 0x3b, 0x9e, 0xd7, 0x14,
-	0x8b, 0x58, 0x22, 0x42, 0x0d, 0x54, 0xda, 0xbf, 0x6a, 0x3d, 0x45, 0xc3, 0xdf, 0x8e, 0x59, 0xcc,
-	0xc3, 0xc4, 0xa6, 0xb7, 0xc8, 0xe5, 0x95, 0xcb, 0x0f, 0xc3, 0x5c, 0x7a, 0x0d, 0xd7, 0x8a, 0x92,
-	0x15, 0x4f, 0xd5, 0x96, 0x9d, 0xe3, 0x7c, 0xd3, 0x3e, 0xc7, 0x29, 0xab, 0x5a, 0x73, 0xf2, 0x6b,
-	0x5e, 0xf9, 0x4d, 0xdf, 0xbb, 0xbe, 0xfa, 0xa5, 0x1e, 0x38, 0xab, 0x1a, 0x0f, 0x9c, 0x95, 0x91,
-	0xfd, 0x2d, 0xcf, 0x71, 0xeb, 0xcf, 0x4d, 0x8c, 0x26, 0xfb, 0x76, 0xf1, 0xed, 0x63, 0x27, 0xdb,
-	0x4a, 0x62, 0xca, 0xbe, 0x6d, 0xc7, 0x94, 0x15, 0x55, 0x6b, 0xcd, 0x8c, 0xd2, 0xcb, 0xcd, 0xf4,
-	0x31, 0xd2, 0x58, 0xba, 0x82, 0xbb, 0x49, 0xe9, 0x09, 0x51, 0x6d, 0x72, 0x70, 0xa0, 0xf0, 0x65,
-	0x8c, 0xf9, 0xd3, 0x0c, 0x63, 0x4a, 0x9a, 0xd4, 0xc4, 0xbd, 0x83, 0x4c, 0x8a, 0xba, 0x9d, 0xf3,
-	0x21, 0xf3, 0xd0, 0x1c, 0x77, 0x68, 0x5b, 0x0f, 0xcd, 0x7d, 0xc4, 0xdb, 0xeb, 0x62, 0xb6, 0x93,
-	0xc1, 0x25, 0xda, 0xfd, 0xb5, 0x9c, 0x76, 0x2f, 0xa9, 0xdc, 0x56, 0x40, 0xc5, 0xb7, 0xbf, 0xef,
-	0xf6, 0xe6, 0x41, 0x99, 0x02, 0xfa, 0x8e, 0x97, 0xbb, 0xd9, 0xb9, 0x97, 0xfc, 0x0d, 0x4a, 0x6f,
-	0x9e, 0x97, 0x6d, 0x09, 0xbe, 0x6b, 0x6f, 0x09, 0x4a, 0x6a, 0xd1, 0xad, 0x7d, 0xce, 0xdb, 0xe3,
-	0x1e, 0x3b, 0xa8, 0xdd, 0x84, 0x6f, 0x5d, 0x41, 0xe0, 0x6a, 0x81, 0x48, 0xc1, 0x72, 0xcc, 0x4f,
-	0xbd, 0xb8, 0xf7, 0xb8, 0x16, 0xc8, 0x64, 0xd9, 0xa6, 0xeb, 0xcf, 0xec, 0x4d, 0x57, 0x69, 0xcb,
-	0xe6, 0x85, 0xa1, 0xfc, 0x45, 0x7a, 0xb3, 0x7d, 0xcf, 0x6e, 0xbf, 0xc4, 0x80, 0xf9, 0xf3, 0x6c,
-	0x68, 0x5d, 0xa6, 0x56, 0xdd, 0xe6, 0xdf, 0x7b, 0xc5, 0xd7, 0xf4, 0x41, 0x1a, 0xfa, 0x19, 0xcd,
-	0x25, 0xd3, 0x62, 0x1b, 0xc3, 0x3d, 0xd7, 0x7d, 0xb1, 0x7e, 0x1a, 0x10, 0x28, 0xbb, 0xcd, 0x9f,
-	0x67, 0xef, 0x8b, 0x8b, 0xe9, 0x2a, 0xad, 0x9f, 0x6b, 0xaf, 0x15, 0x3d, 0xd7, 0x5e, 0xa6, 0x6e,
-	0xbe, 0x67, 0xab, 0x9b, 0x22, 0xea, 0xad, 0x73, 0x50, 0xf3, 0x19, 0x5e, 0x3c, 0x8e, 0xe2, 0x8f,
-	0xfe, 0x7b, 0x7c, 0x1f, 0x2a, 0x1f, 0xfb, 0x3f, 0x41, 0xc8, 0xe2, 0xce, 0xfa, 0x16, 0x4b, 0x85,
-	0x4e, 0xc6, 0x77, 0x91, 0x34, 0x04, 0x6f, 0x77, 0x6c, 0x89, 0xfb, 0xb8, 0x95, 0xb3, 0x5b, 0x90,
-	0xee, 0x6d, 0xc9, 0xe7, 0xbc, 0x7b, 0x5b, 0xd0, 0xe7, 0x73, 0xc3, 0xfe, 0x78, 0x14, 0x0d, 0x53,
-	0x11, 0xfe, 0xa9, 0xd2, 0x80, 0x5b, 0x0c, 0x13, 0xd6, 0x0d, 0xd3, 0x4d, 0xf4, 0x98, 0x4d, 0x05,
-	0x2a, 0xed, 0x7f, 0xba, 0x42, 0xcc, 0x28, 0xdf, 0x25, 0x7c, 0x0d, 0xbc, 0xc7, 0x86, 0x49, 0x94,
-	0x46, 0x37, 0x98, 0xa0, 0x32, 0x0b, 0x06, 0x6a, 0xcf, 0x8e, 0xc7, 0x6c, 0xd8, 0x07, 0x65, 0x8b,
-	0xd4, 0x36, 0x02, 0x03, 0x02, 0x2b, 0xf7, 0xb5, 0x38, 0x4a, 0xd9, 0xea, 0x66, 0xcc, 0x92, 0xcd,
-	0xd1, 0xa0, 0x2f, 0xd6, 0xe5, 0x0c, 0x94, 0x9e, 0x22, 0xcd, 0x80, 0x85, 0x7d, 0x9d, 0xad, 0x86,
-	0xd9, 0x6c, 0x20, 0xbe, 0xaf, 0x9e, 0x8e, 0xe2, 0x70, 0x83, 0x2d, 0x85, 0xe3, 0x70, 0x3d, 0x4a,
-	0x77, 0x85, 0x57, 0x30, 0x0b, 0x56, 0x21, 0xa3, 0x4b, 0x9b, 0x61, 0x2c, 0xba, 0xaa, 0x01, 0xf8,
-	0x1c, 0x6e, 0x2a, 0x4f, 0x2e, 0xe1, 0x13, 0x6f, 0xcc, 0x86, 0x1b, 0x09, 0x66, 0x11, 0x97, 0x69,
-	0x34, 0xc0, 0xff, 0xbe, 0x57, 0xfc, 0x70, 0x83, 0xcb, 0x98, 0x0b, 0xc6, 0x42, 0x71, 0x55, 0x82,
-	0x31, 0x3e, 0x52, 0x99, 0xa4, 0xea, 0xd9, 0xca, 0x24, 0x35, 0xc3, 0xad, 0x6b, 0xd6, 0x13, 0xfc,
-	0xb9, 0xf7, 0x0b, 0x4a, 0x24, 0xf0, 0xfb, 0x2e, 0x09, 0x2c, 0x0b, 0x98, 0xf8, 0x55, 0x8f, 0x4c,
-	0x82, 0x1e, 0x5d, 0x19, 0x63, 0xac, 0xdd, 0xca, 0x58, 0x04, 0x48, 0x55, 0x56, 0xc6, 0x20, 0x18,
-	0x43, 0x76, 0x53, 0x9e, 0xb5, 0xe1, 0x7d, 0x6e, 0x99, 0xce, 0xff, 0xdf, 0x05, 0x7f, 0x82, 0x2b,
-	0xf3, 0x7f, 0x17, 0x27, 0x08, 0xb9, 0xc0, 0xd2, 0x95, 0x31, 0x77, 0xc7, 0xf2, 0xd1, 0x33, 0x20,
-	0xea, 0xda, 0x61, 0xdd, 0x76, 0xf5, 0xaa, 0x6b, 0x87, 0xb0, 0x50, 0x38, 0x9f, 0xdb, 0x28, 0xbd,
-	0xdb, 0x62, 0x9f, 0x02, 0x88, 0xc9, 0x62, 0x9c, 0x02, 0x94, 0x04, 0x09, 0xfc, 0xc0, 0x0e, 0x12,
-	0x70, 0x35, 0xed, 0x3c, 0xc9, 0x72, 0xbc, 0xf8, 0xf1, 0x7f, 0x7c, 0x94, 0x91, 0xed, 0x44, 0xc9,
-	0x9a, 0xf7, 0x43, 0xe7, 0x49, 0x96, 0x83, 0x44, 0xdd, 0x95, 0x2f, 0x79, 0x25, 0xaf, 0x9e, 0xa8,
-	0xfb, 0x64, 0xfc, 0xa5, 0x68, 0x7e, 0x9f, 0xcc, 0xfd, 0x87, 0x49, 0x3a, 0x36, 0xbd, 0x6a, 0xc6,
-	0xa6, 0x97, 0xdd, 0xa3, 0xf9, 0x91, 0x7d, 0x8f, 0xa6, 0x90, 0x0a, 0x4d, 0xec, 0x5f, 0x56, 0x48,
-	0xe3, 0x7c, 0xc4, 0x7d, 0x1c, 0x20, 0x08, 0x09, 0x7b, 0x65, 0x87, 0x0d, 0xd7, 0x99, 0x38, 0xd8,
-	0x50, 0x69, 0xa0, 0x71, 0x80, 0xd1, 0x08, 0xe2, 0x55, 0x5f, 0x4c, 0x00, 0x74, 0x9b, 0xc5, 0x1b,
-	0x4c, 0x28, 0x7f, 0x9e, 0x40, 0x77, 0xc4, 0xad, 0x94, 0x0d, 0x53, 0xe9, 0x20, 0xe6, 0x29, 0xcc,
-	0x8d, 0x7f, 0x9b, 0x52, 0xe7, 0x37, 0xae, 0x30, 0x01, 0x9a, 0x3a, 0x11, 0xa7, 0x94, 0x13, 0x08,
-	0x97, 0x49, 0xd0, 0x19, 0x7d, 0x15, 0x09, 0xcc, 0x75, 0x89, 0x06, 0xe0, 0xd9, 0x05, 0xca, 0x14,
-	0x60, 0xf9, 0x5b, 0xff, 0x1a, 0x00, 0xb5, 0x6e, 0x47, 0xdc, 0x7a, 0xe3, 0x4f, 0x16, 0xc8, 0x24,
-	0x62, 0x44, 0x2c, 0x2e, 0x11, 0x18, 0x9e, 0xc4, 0xdd, 0xcd, 0xe8, 0x26, 0x0f, 0xe2, 0xe5, 0x4f,
-	0x13, 0xa8, 0x34, 0x4c, 0xd2, 0xeb, 0xd1, 0x80, 0xf5, 0xa2, 0xdb, 0x6c, 0x71, 0x17, 0x2c, 0xd6,
-	0x7d, 0x7c, 0x92, 0x5a, 0x40, 0xff, 0x63, 0x9e, 0xeb, 0x61, 0x1a, 0xfa, 0x04, 0x99, 0x92, 0x4c,
-	0x96, 0xa6, 0xee, 0x7e, 0x15, 0x68, 0x3e, 0x10, 0x87, 0x98, 0x2a, 0x47, 0x99, 0x47, 0xfb, 0xc7,
-	0xb6, 0x47, 0x3b, 0xdf, 0x96, 0x1e, 0xda, 0x8f, 0x78, 0xae, 0xd7, 0x6c, 0x50, 0x13, 0x81, 0x44,
-	0xc8, 0xc0, 0x9b, 0xa9, 0x40, 0xa5, 0xb3, 0x4f, 0x65, 0x96, 0x11, 0xf2, 0x93, 0xcc, 0x8d, 0xb4,
-	0x5c, 0x43, 0x96, 0xfb, 0x68, 0x12, 0xff, 0x43, 0x67, 0x74, 0x13, 0x06, 0x2d, 0x55, 0x0f, 0x27,
-	0x88, 0x18, 0x12, 0x05, 0x30, 0xcc, 0x33, 0xb1, 0x2b, 0x16, 0xe6, 0xd9, 0x1c, 0x69, 0x6c, 0x8e,
-	0x2c, 0x77, 0x89, 0x4a, 0xab, 0xf0, 0xa5, 0xb6, 0x78, 0x69, 0x41, 0xa4, 0xac, 0x7e, 0xd6, 0xed,
-	0x7e, 0xfa, 0x7f, 0xe3, 0x91, 0x06, 0x1e, 0x03, 0x00, 0x49, 0xf2, 0xd8, 0x4c, 0xfc, 0x85, 0x19,
-	0x1e, 0x9b, 0x65, 0x0e, 0xda, 0x30, 0x46, 0x47, 0x1f, 0xb4, 0xcd, 0x90, 0x4a, 0x5f, 0x06, 0x02,
-	0x55, 0xfa, 0x6b, 0x50, 0xc3, 0x38, 0xed, 0xf4, 0x45, 0x00, 0x10, 0x7e, 0x43, 0x0d, 0x49, 0xbc,
-	0x2e, 0x26, 0x30, 0x8f, 0x55, 0xd3, 0x00, 0x94, 0xec, 0x24, 0x15, 0x58, 0xfe, 0x66, 0xb2, 0x06,
-	0xd8, 0xa7, 0x72, 0xfc, 0x4f, 0x50, 0x0a, 0x4e, 0xe5, 0x1a, 0xbc, 0x63, 0x32, 0xed, 0xbf, 0x4c,
-	0xf6, 0x1b, 0x23, 0x21, 0xff, 0x8c, 0x66, 0x88, 0x7f, 0x6c, 0x64, 0x6f, 0xb1, 0xc4, 0x80, 0x04,
-	0x1c, 0x49, 0x1f, 0x25, 0x13, 0x8c, 0xff, 0x41, 0x56, 0xc5, 0x12, 0x4f, 0xc9, 0xa5, 0x40, 0xa0,
-	0x17, 0xc9, 0xbb, 0x1b, 0x67, 0xce, 0x3c, 0x89, 0xc8, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0xa9, 0xf9, 0x7d, 0x19, 0x6e, 0x00, 0x00,
+	// 7674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3d, 0x5b, 0x6c, 0x65, 0xd7,
+	0x55, 0x3a, 0xf7, 0x61, 0x5f, 0x6f, 0xdb, 0x33, 0x9e, 0x33, 0x8f, 0xdc, 0x99, 0x4c, 0x26, 0xce,
+	0x49, 0xd2, 0x4c, 0x93, 0x76, 0xd2, 0x98, 0x36, 0x49, 0xd3, 0x26, 0xad, 0xed, 0x3b, 0x8f, 0xdb,
+	0x8c, 0xc7, 0x77, 0xf6, 0x75, 0x66, 0xa0, 0x29, 0x25, 0xc7, 0xbe, 0x7b, 0xec, 0x13, 0xdf, 0x57,
+	0xce, 0x39, 0x9e, 0x19, 0x47, 0x45, 0x4d, 0x5b, 0xa9, 0x08, 0x2a, 0x84, 0x10, 0x82, 0x3e, 0x04,
+	0x2d, 0x94, 0xb6, 0x40, 0xa1, 0x40, 0x4b, 0x4b, 0x1f, 0xa4, 0x85, 0xa6, 0x2d, 0x2a, 0xa5, 0xf4,
+	0x03, 0x09, 0xc4, 0x07, 0x42, 0xe2, 0x03, 0x21, 0x10, 0x20, 0xf8, 0x01, 0x21, 0x81, 0x84, 0xd6,
+	0xda, 0xef, 0x73, 0xf6, 0x39, 0x1e, 0x87, 0x26, 0x5f, 0xf7, 0xac, 0xb5, 0xf6, 0x63, 0xed, 0xd7,
+	0xda, 0x6b, 0xaf, 0xb5, 0xf6, 0xbe, 0x84, 0x0c, 0x58, 0x1a, 0x9e, 0x19, 0xc7, 0xa3, 0x74, 0xe4,
+	0xd7, 0xf1, 0x27, 0xf8, 0xc4, 0x0c, 0xa9, 0xb5, 0xc2, 0x34, 0xf4, 0x7d, 0x52, 0x5b, 0x63, 0xf1,
+	0xa0, 0xe9, 0xcd, 0x57, 0x4e, 0xd7, 0x28, 0x7e, 0xfb, 0x47, 0x48, 0xbd, 0x3d, 0xec, 0xb1, 0x9b,
+	0xcd, 0x0a, 0x22, 0x39, 0xe0, 0x9f, 0x24, 0x53, 0xcb, 0xfd, 0x9d, 0x24, 0x65, 0x71, 0xbb, 0xd5,
+	0xac, 0x22, 0x45, 0x23, 0xfc, 0x7b, 0x49, 0xfd, 0xd2, 0xa8, 0xc7, 0x92, 0x66, 0x6d, 0xbe, 0x7a,
+	0x7a, 0x7a, 0xe1, 0x20, 0xaf, 0xee, 0x0c, 0xe0, 0xda, 0xc3, 0x6b, 0x23, 0xca, 0xa9, 0xfe, 0x43,
+	0x64, 0x0a, 0xaa, 0x5d, 0x0f, 0x13, 0x96, 0x34, 0xeb, 0x98, 0xf4, 0xb0, 0x48, 0x2a, 0xf1, 0x98,
+	0x5c, 0xa7, 0x82, 0x92, 0x9f, 0x4a, 0x58, 0x9c, 0x34, 0x27, 0xac, 0x92, 0x01, 0xc7, 0x4b, 0x46,
+	0x2a, 0xb0, 0xb7, 0x12, 0xde, 0xc4, 0xfa, 0x5a, 0xcd, 0x49, 0xce, 0x9e, 0x42, 0xf8, 0xa7, 0xc9,
+	0xc1, 0x95, 0xf0, 0x66, 0x77, 0x2b, 0x8c, 0x7b, 0xe7, 0xe3, 0xd1, 0xce, 0xb8, 0xdd, 0x6a, 0x36,
+	0x30, 0x4d, 0x16, 0xed, 0x9f, 0x22, 0x44, 0xa2, 0xda, 0xad, 0xe6, 0x14, 0x26, 0x32, 0x30, 0xfe,
+	0xeb, 0x79, 0x0b, 0x78, 0x63, 0x89, 0xc5, 0x92, 0xc4, 0x53, 0x9d, 0x02, 0x92, 0xaf, 0x30, 0x99,
+	0x7c, 0xda, 0xdd, 0x37, 0x3a, 0x85, 0x1f, 0x90, 0x19, 0xd1, 0xa7, 0x9d, 0xf4, 0xd2, 0xce, 0xa0,
+	0x79, 0x60, 0xbe, 0x72, 0x7a, 0x96, 0x5a, 0x38, 0xff, 0x41, 0x32, 0xd1, 0x49, 0xaf, 0x44, 0xec,
+	0x46, 0xf3, 0x20, 0x96, 0x77, 0x9b, 0x51, 0xfd, 0x19, 0x4e, 0x39, 0x3b, 0x4c, 0xe3, 0x5d, 0x2a,
+	0x92, 0x41, 0xa1, 0x98, 0xb3, 0xc3, 0x62, 0xa8, 0xa5, 0x39, 0x37, 0xef, 0x41, 0xa1, 0x26, 0x4e,
+	0x74, 0x10, 0x8e, 0xb4, 0xec, 0xa0, 0x43, 0xaa, 0x83, 0x4c, 0xb4, 0xe8, 0x20, 0x44, 0xb5, 0x5b,
+	0x4d, 0x5f, 0x75, 0x90, 0xc0, 0x40, 0x6d, 0x2b, 0xe1, 0xcd, 0xb3, 0xd7, 0xd9, 0x30, 0x5d, 0x1d,
+	0xb7, 0x7b, 0xcd, 0xc3, 0xf3, 0xde, 0xe9, 0x1a, 0xb5, 0x70, 0x50, 0xdb, 0x5a, 0xb8, 0xcd, 0x56,
+	0xaf, 0xb3, 0xf8, 0xec, 0x30, 0x5c, 0xef, 0xb3, 0x5e, 0xf3, 0xc8, 0xbc, 0x77, 0xba, 0x41, 0xb3,
+	0x68, 0xff, 0x71, 0x32, 0xbb, 0x12, 0x6d, 0xc6, 0x61, 0xca, 0x30, 0x77, 0xd2, 0x3c, 0x6a, 0xb5,
+	0xd9, 0xa4, 0x61, 0x5f, 0xda, 0xa9, 0xa1, 0xa2, 0xa5, 0xb0, 0x1f, 0x0e, 0x37, 0x74, 0x45, 0xc7,
+	0x78, 0x45, 0x19, 0xb4, 0xe8, 0x80, 0xd6, 0xe8, 0xc6, 0xb0, 0x1b, 0x0e, 0xc6, 0x7d, 0x98, 0x45,
+	0xb7, 0x21, 0xe7, 0x59, 0xb4, 0xff, 0x00, 0x99, 0xec, 0xa6, 0x31, 0x0b, 0x07, 0x49, 0xb3, 0x89,
+	0xcc, 0x1c, 0x12, 0xcc, 0x70, 0x2c, 0xb2, 0x21, 0x53, 0xf8, 0xf3, 0x64, 0x1a, 0x26, 0x0f, 0xa7,
+	0xb4, 0x9a, 0xc7, 0xb1, 0x48, 0x13, 0x25, 0x26, 0xee, 0xf2, 0x68, 0x38, 0x6c, 0xf7, 0x9a, 0x27,
+	0x90, 0xae, 0x11, 0xfe, 0x13, 0x64, 0xfa, 0xf2, 0x0e, 0x8b, 0x77, 0xdb, 0xad, 0xf6, 0x30, 0x4a,
+	0x9b, 0xb7, 0x63, 0x85, 0x27, 0xcd, 0x11, 0x37, 0xc8, 0x7c, 0xd8, 0xcd, 0x0c, 0x7e, 0x8b, 0xcc,
+	0x52, 0x36, 0xee, 0x47, 0x1b, 0x21, 0x8e, 0x5f, 0xd2, 0x3c, 0x89, 0x25, 0x9c, 0x32, 0x4b, 0xb0,
+	0x12, 0xf0, 0x32, 0xec, 0x4c, 0xfe, 0xeb, 0xc8, 0x21, 0x60, 0x79, 0x67, 0x3d, 0xd9, 0x88, 0xa3,
+	0x71, 0x1a, 0x8d, 0x86, 0xed, 0x56, 0xf3, 0x0e, 0xe4, 0x35, 0x4f, 0xf0, 0xef, 0x21, 0xb3, 0xd0,
+	0x80, 0xcb, 0xcb, 0x5b, 0xe1, 0x70, 0x13, 0x3a, 0xf2, 0x14, 0xa6, 0xb4, 0x91, 0xd0, 0x33, 0x97,
+	0x76, 0x06, 0xab, 0xd7, 0x70, 0x61, 0x25, 0xcd, 0x3b, 0xe7, 0xbd, 0xd3, 0x75, 0x6a, 0xa2, 0x60,
+	0x48, 0xda, 0x49, 0xf7, 0xf2, 0xc5, 0x28, 0x65, 0x72, 0xf0, 0xe6, 0xf9, 0xe0, 0x65, 0xd0, 0xfe,
+	0x03, 0xa4, 0xd1, 0x7d, 0xae, 0xcf, 0x17, 0xd9, 0x5d, 0xee, 0x35, 0xa9, 0x12, 0xf8, 0x27, 0x48,
+	0x63, 0x25, 0xbc, 0xb9, 0x92, 0xa4, 0xed, 0x56, 0x33, 0x40, 0xce, 0x14, 0x0c, 0xc2, 0x86, 0x8e,
+	0xfa, 0x2c, 0x69, 0xde, 0x6d, 0x95, 0x02, 0x38, 0x2e, 0x6c, 0x90, 0x7a, 0xe2, 0x1d, 0x64, 0xda,
+	0x58, 0x68, 0xfe, 0x1c, 0xa9, 0x6e, 0xb3, 0xdd, 0xa6, 0x37, 0xef, 0x9d, 0x9e, 0xa2, 0xf0, 0x09,
+	0xe5, 0x5c, 0x0f, 0xfb, 0x3b, 0xac, 0x59, 0x99, 0xf7, 0x4c, 0x6e, 0x96, 0x3a, 0x7c, 0x9a, 0x72,
+	0xea, 0x63, 0x95, 0x47, 0xbd, 0x13, 0x4f, 0x90, 0xb9, 0xec, 0x10, 0x3a, 0x0a, 0x3c, 0x62, 0x16,
+	0x58, 0x33, 0xf3, 0x3f, 0x45, 0xfc, 0xfc, 0x00, 0x3a, 0x4a, 0x78, 0xad, 0xcd, 0x92, 0x14, 0xbb,
+	0x22, 0x2f, 0x0c, 0x5d, 0x62, 0x14, 0x1b, 0xbc, 0x85, 0xcc, 0x98, 0x24, 0xff, 0x01, 0x32, 0x21,
+	0x66, 0x90, 0x67, 0x89, 0x6d, 0xb3, 0x6e, 0x2a, 0x92, 0x04, 0x3f, 0xed, 0xa9, 0xdc, 0x88, 0xf1,
+	0x0f, 0x90, 0x4a, 0xbb, 0x85, 0x9b, 0xcc, 0x2c, 0xad, 0xb4, 0x5b, 0x7c, 0x0c, 0xc4, 0x5e, 0x52,
+	0x41, 0xac, 0x82, 0xfd, 0xbb, 0x48, 0xbd, 0xc3, 0x40, 0xe0, 0x57, 0xb1, 0xa2, 0x69, 0x51, 0x11,
+	0xe0, 0x28, 0xa7, 0xf8, 0xc7, 0xc8, 0x44, 0x37, 0x0d, 0xd3, 0x1d, 0xd8, 0x6e, 0x20, 0xb3, 0x80,
+	0xd4, 0x6e, 0x56, 0xd7, 0xbb, 0x59, 0x70, 0x3f, 0xa9, 0x41, 0xa6, 0x1c, 0x0b, 0x3e, 0xa9, 0xc1,
+	0x60, 0x8a, 0xea, 0xf1, 0x3b, 0xb8, 0x8b, 0x4c, 0x76, 0xd2, 0xd5, 0x1b, 0x43, 0x16, 0x43, 0x15,
+	0x62, 0x33, 0xe1, 0x5b, 0xa3, 0x80, 0x82, 0x17, 0x3c, 0x32, 0xc1, 0x07, 0xd1, 0xbf, 0x87, 0xd4,
+	0x31, 0x2d, 0xa6, 0x98, 0x5e, 0x38, 0x20, 0x19, 0xe5, 0x25, 0xd0, 0xba, 0x2a, 0x48, 0xf0, 0x5a,
+	0xc9, 0xf2, 0xda, 0x49, 0xdb, 0x3d, 0xdc, 0x4a, 0x67, 0x29, 0x7e, 0xc3, 0xa8, 0x5d, 0x61, 0x71,
+	0xb3, 0x86, 0x63, 0x0c, 0x9f, 0xc8, 0xe5, 0xf9, 0x76, 0xab, 0x59, 0x47, 0x99, 0x8d, 0xdf, 0xc1,
+	0xeb, 0x49, 0x43, 0x4e, 0x24, 0xff, 0x2e, 0x52, 0x6b, 0xad, 0x77, 0x52, 0x31, 0x28, 0xb3, 0x8a,
+	0x05, 0x20, 0x52, 0x24, 0x05, 0xff, 0xe6, 0x91, 0x86, 0xdc, 0x6b, 0x8c, 0x5e, 0xa8, 0xc9, 0x5e,
+	0xb8, 0x30, 0x4a, 0x52, 0xe4, 0x6d, 0x8a, 0xe2, 0xb7, 0xdf, 0x24, 0x93, 0xb4, 0xb3, 0xbc, 0xd8,
+	0xeb, 0xc5, 0x58, 0xed, 0x14, 0x95, 0x20, 0x50, 0xd6, 0x96, 0x3b, 0x98, 0xa1, 0xca, 0x29, 0x02,
+	0xcc, 0x8c, 0x48, 0x55, 0xb5, 0xf2, 0x08, 0xa9, 0x5f, 0x5c, 0x8b, 0x06, 0xac, 0x39, 0xc1, 0x75,
+	0x09, 0x04, 0x60, 0x0f, 0x39, 0x3f, 0x4a, 0x92, 0x68, 0x8c, 0x95, 0x4c, 0x62, 0xdd, 0x06, 0x06,
+	0x56, 0x7e, 0x97, 0x6d, 0xc6, 0x6c, 0x33, 0x4c, 0x99, 0x28, 0xb6, 0xc1, 0x85, 0x71, 0x06, 0xad,
+	0x46, 0x91, 0x20, 0x3b, 0x7c, 0x14, 0x77, 0x48, 0x43, 0x2e, 0x7b, 0xff, 0x4e, 0x52, 0xb9, 0x14,
+	0x89, 0x01, 0xca, 0x6d, 0xbc, 0x95, 0x4b, 0x11, 0x30, 0x8e, 0xa2, 0xb6, 0x25, 0x56, 0x96, 0x80,
+	0x40, 0x3c, 0x2d, 0xf6, 0xa3, 0xeb, 0x4c, 0x10, 0xab, 0x5c, 0x70, 0x1b, 0x28, 0xe8, 0xca, 0xc5,
+	0xe7, 0x71, 0xac, 0xa6, 0x68, 0x65, 0xf1, 0xf9, 0xe0, 0x0b, 0x55, 0x32, 0x63, 0x2a, 0x31, 0xc0,
+	0xdb, 0xa5, 0x70, 0xc0, 0xb0, 0xf6, 0x29, 0x8a, 0xdf, 0xfe, 0xc3, 0xe4, 0x58, 0x8b, 0x5d, 0x0b,
+	0x77, 0xfa, 0x29, 0x65, 0x29, 0x1b, 0xc2, 0xda, 0xea, 0x8c, 0xfa, 0xd1, 0xc6, 0xae, 0x18, 0x81,
+	0x02, 0xaa, 0x7f, 0x81, 0x1c, 0xb2, 0x51, 0x11, 0x93, 0x0b, 0xe4, 0x84, 0x5a, 0x89, 0x56, 0x16,
+	0x6c, 0x61, 0x3e, 0x13, 0x94, 0xb4, 0x3c, 0x1a, 0xa6, 0xd1, 0x70, 0x67, 0xb4, 0x93, 0x80, 0xe4,
+	0x89, 0x94, 0xd6, 0x26, 0x4b, 0xb2, 0xe9, 0xa2, 0xa4, 0x5c, 0x26, 0xbe, 0xb7, 0xc5, 0xdb, 0x2d,
+	0xd6, 0x67, 0x29, 0xeb, 0xe1, 0x5c, 0x69, 0x50, 0x13, 0xe5, 0x3f, 0x48, 0x1a, 0x28, 0xcb, 0x9f,
+	0x64, 0xbb, 0xcd, 0x09, 0x4b, 0xec, 0x48, 0x34, 0x96, 0xad, 0x12, 0xf9, 0xaf, 0x21, 0x07, 0xb8,
+	0x4c, 0x5f, 0x0b, 0x37, 0x17, 0xe3, 0x38, 0xdc, 0x6d, 0x4e, 0x62, 0xa9, 0x19, 0x2c, 0xc8, 0x0f,
+	0x21, 0x5f, 0x2e, 0xe1, 0xcc, 0xa8, 0x52, 0x05, 0xc3, 0xfe, 0xbc, 0x8a, 0x5b, 0x11, 0x28, 0x0b,
+	0x9e, 0xb1, 0x3f, 0xaf, 0xae, 0x27, 0x82, 0x40, 0x65, 0x8a, 0xe0, 0x4b, 0x1e, 0x39, 0x9c, 0xe9,
+	0xb8, 0xee, 0x98, 0x6d, 0x18, 0x63, 0xe7, 0xa9, 0xb1, 0x3b, 0x41, 0x1a, 0xad, 0x9d, 0x18, 0xe5,
+	0x21, 0x4e, 0x96, 0x2a, 0x55, 0xb0, 0x7f, 0x86, 0xf8, 0x5a, 0x8d, 0x54, 0xa9, 0xaa, 0x98, 0xca,
+	0x41, 0xb1, 0x1a, 0x50, 0xc3, 0xb5, 0xad, 0x1b, 0x10, 0x90, 0x99, 0xab, 0x61, 0x3c, 0x50, 0xa5,
+	0xd4, 0xb1, 0x14, 0x0b, 0x17, 0xfc, 0xe3, 0x04, 0x39, 0xb8, 0xc2, 0xc2, 0x64, 0x27, 0x66, 0x03,
+	0xa1, 0xfb, 0x38, 0xe7, 0xdb, 0x43, 0x64, 0x4a, 0x76, 0x2e, 0x08, 0xa0, 0x6a, 0xd1, 0x10, 0xe8,
+	0x54, 0xfe, 0x63, 0x64, 0xa2, 0xbb, 0xb1, 0xc5, 0x06, 0xa1, 0x98, 0x5f, 0x81, 0xd4, 0xb5, 0xec,
+	0xea, 0xce, 0xf0, 0x44, 0x42, 0xd5, 0xe4, 0x40, 0x76, 0x4a, 0xd4, 0xf2, 0x53, 0xe2, 0x31, 0x32,
+	0x1b, 0x81, 0xa6, 0x48, 0x59, 0x5f, 0xb7, 0x6e, 0x7a, 0xe1, 0x88, 0xa8, 0xa4, 0x6d, 0xd2, 0xa8,
+	0x9d, 0x14, 0xc4, 0xc6, 0xd9, 0xe1, 0x66, 0x34, 0x64, 0x6b, 0xbb, 0x63, 0x86, 0x13, 0x6a, 0x96,
+	0x1a, 0x18, 0xff, 0x11, 0x32, 0xb3, 0x3c, 0xea, 0x77, 0xd3, 0x51, 0x8c, 0x0b, 0x10, 0xe7, 0x8e,
+	0x6e, 0xaf, 0x49, 0xa2, 0x56, 0x42, 0xff, 0x21, 0x42, 0xf4, 0xe4, 0x68, 0x36, 0x8a, 0x66, 0x8d,
+	0x91, 0xc8, 0x3f, 0x47, 0x08, 0x3f, 0x12, 0xf4, 0x6e, 0xb2, 0xa4, 0x39, 0x85, 0x3d, 0xf5, 0x9a,
+	0xa2, 0x9e, 0x52, 0x09, 0x79, 0x6f, 0x19, 0x39, 0x51, 0xc9, 0x19, 0x46, 0xa9, 0xa9, 0x0a, 0x11,
+	0x54, 0x85, 0xb2, 0x68, 0x21, 0xba, 0xa7, 0xe7, 0x3d, 0x21, 0xba, 0x4f, 0x67, 0xe7, 0xb9, 0xdc,
+	0x80, 0xb2, 0x93, 0xdc, 0x7f, 0x9a, 0x1c, 0xe2, 0xe3, 0xf3, 0x54, 0xc2, 0xce, 0x8d, 0xe2, 0xe5,
+	0x3e, 0x0b, 0x87, 0xcd, 0x63, 0xc8, 0xf2, 0xeb, 0x4b, 0x07, 0xd7, 0x48, 0xcf, 0x39, 0xcf, 0x97,
+	0x73, 0xe2, 0xcd, 0x64, 0xda, 0x98, 0x09, 0x7b, 0xa9, 0x2e, 0x75, 0x53, 0x75, 0x79, 0x92, 0x1c,
+	0xcc, 0x74, 0x8d, 0x99, 0xbd, 0xc6, 0xb3, 0x07, 0xb6, 0xde, 0x32, 0x23, 0x27, 0x0a, 0xe4, 0x31,
+	0x0b, 0xbb, 0x42, 0x8e, 0xb9, 0x99, 0x76, 0xb0, 0xf4, 0x1a, 0xbb, 0xcc, 0x39, 0xb9, 0x22, 0x30,
+	0xff, 0x95, 0xb0, 0x6f, 0x2a, 0x42, 0x8f, 0x90, 0x29, 0x85, 0x87, 0xa2, 0xd6, 0x76, 0xc7, 0xb8,
+	0xc2, 0xea, 0x14, 0x3e, 0x61, 0x4b, 0x3c, 0x3b, 0xec, 0xe1, 0x16, 0xc7, 0xdb, 0x27, 0xc1, 0xe0,
+	0x4b, 0x13, 0x39, 0xd1, 0x52, 0xb8, 0x4c, 0x6d, 0xd1, 0x52, 0xb9, 0x25, 0xd1, 0x52, 0xb9, 0x25,
+	0xd1, 0x52, 0xb1, 0x44, 0xcb, 0x63, 0x64, 0xc6, 0x18, 0x69, 0x79, 0x04, 0x3f, 0xe6, 0x9e, 0x04,
+	0xd4, 0x4a, 0xeb, 0xaf, 0x90, 0xe9, 0x95, 0x24, 0xbd, 0xc2, 0xe2, 0x04, 0xe7, 0xdc, 0x01, 0xcc,
+	0xfa, 0x40, 0xf1, 0xe6, 0x73, 0xc6, 0x48, 0x2d, 0x4e, 0x26, 0x06, 0xc6, 0x7f, 0x84, 0x4c, 0x6b,
+	0xe6, 0xe5, 0xe9, 0xfe, 0xa8, 0x29, 0x9b, 0x90, 0x82, 0x8c, 0x98, 0x29, 0xe1, 0x48, 0x68, 0x1e,
+	0x38, 0x92, 0xe6, 0xa4, 0x75, 0x24, 0x34, 0x69, 0xfc, 0x48, 0x68, 0xa5, 0xce, 0x8a, 0xa8, 0x46,
+	0x5e, 0x44, 0xcd, 0x93, 0xe9, 0x0b, 0xa3, 0x54, 0xf5, 0xf4, 0x14, 0xf6, 0xb4, 0x89, 0xca, 0x49,
+	0x68, 0x82, 0x49, 0x2c, 0x1c, 0x0c, 0x9b, 0x3e, 0x37, 0xab, 0x94, 0xd3, 0x7c, 0xd8, 0xf2, 0x14,
+	0xe8, 0x0f, 0x8d, 0x4d, 0x9a, 0x33, 0x56, 0x7f, 0x68, 0x0a, 0xef, 0x0f, 0x23, 0xa5, 0xbf, 0x4a,
+	0x8e, 0xe8, 0xf3, 0xa9, 0xee, 0xfe, 0xe6, 0x2c, 0xce, 0xed, 0xdb, 0xe5, 0xd1, 0xc3, 0x91, 0x84,
+	0x3a, 0x33, 0xfa, 0x8f, 0x93, 0x99, 0x4e, 0x3c, 0x1a, 0x80, 0x55, 0xa2, 0x17, 0xa6, 0xa1, 0x30,
+	0x33, 0x1c, 0x97, 0xba, 0x25, 0x27, 0xc5, 0xd1, 0x86, 0x4c, 0x40, 0xad, 0xe4, 0x70, 0xa0, 0xc9,
+	0x8e, 0xfc, 0x5e, 0x52, 0x61, 0xd6, 0x5c, 0x70, 0x1f, 0xf7, 0x88, 0x9f, 0xaf, 0x04, 0xc7, 0x0d,
+	0x31, 0x28, 0xb6, 0xc5, 0xea, 0x31, 0x51, 0xfe, 0xfd, 0x64, 0x8e, 0x83, 0xe7, 0xc2, 0x41, 0xd4,
+	0xdf, 0xc5, 0x45, 0xc6, 0xb5, 0xaa, 0x1c, 0x1e, 0x4f, 0x0a, 0xb0, 0x89, 0x54, 0xf9, 0x22, 0x84,
+	0x6f, 0xd4, 0x85, 0x59, 0x7f, 0x2c, 0x54, 0x3a, 0xfc, 0x06, 0xdc, 0x53, 0x70, 0xf0, 0xe6, 0x8a,
+	0x30, 0x7e, 0x07, 0x21, 0x39, 0xec, 0xd0, 0x90, 0x9c, 0xeb, 0xfa, 0x08, 0xa9, 0x63, 0x02, 0xc1,
+	0x07, 0x07, 0xa0, 0x29, 0x17, 0xc3, 0x24, 0xa5, 0x3b, 0x43, 0x94, 0x1b, 0x5c, 0x4b, 0x30, 0x51,
+	0xc1, 0xff, 0x78, 0xe4, 0x80, 0xbd, 0x06, 0x72, 0x9a, 0xfb, 0x49, 0x32, 0xd5, 0x4d, 0xc3, 0x38,
+	0x15, 0xa2, 0x07, 0xa6, 0x95, 0x46, 0x98, 0x62, 0x89, 0x4b, 0x0a, 0x09, 0x42, 0x3e, 0x31, 0xd1,
+	0x17, 0x53, 0xa1, 0xac, 0x6b, 0x84, 0x7f, 0x9a, 0x4c, 0x88, 0x5d, 0x88, 0x8b, 0x86, 0x39, 0x73,
+	0x41, 0xe2, 0x9c, 0x11, 0x74, 0x68, 0xc4, 0x5a, 0xbc, 0x33, 0xdc, 0x08, 0x79, 0x49, 0x13, 0xbc,
+	0x11, 0x06, 0x2a, 0xb3, 0x5d, 0x4f, 0xe6, 0xb6, 0xeb, 0x26, 0x99, 0xbc, 0xce, 0x67, 0x49, 0x73,
+	0x06, 0x89, 0x12, 0x0c, 0x3e, 0x5c, 0x21, 0x53, 0xaa, 0xc6, 0x5c, 0xcb, 0x4f, 0x91, 0x06, 0x1e,
+	0xad, 0xda, 0x2d, 0xae, 0xd2, 0xcc, 0x2e, 0x55, 0x9a, 0x1e, 0x55, 0x38, 0x98, 0x6c, 0x2b, 0xd1,
+	0x50, 0x0c, 0x2d, 0x7c, 0x22, 0x26, 0xbc, 0xd9, 0xac, 0x09, 0x4c, 0x78, 0x13, 0xc7, 0x3f, 0x62,
+	0xb1, 0x3a, 0x29, 0x46, 0x0c, 0x4f, 0x37, 0xd2, 0xac, 0xc5, 0x4f, 0x2b, 0x12, 0x84, 0x4d, 0x5a,
+	0xaf, 0x94, 0x8b, 0xec, 0x3a, 0xeb, 0xe3, 0xa1, 0xa5, 0x4a, 0xb3, 0x68, 0x90, 0x0c, 0x96, 0x0d,
+	0x89, 0x1f, 0x5b, 0x2c, 0x1c, 0x17, 0xd0, 0x61, 0x6f, 0x75, 0xd8, 0xdf, 0x6d, 0x4e, 0xa1, 0xf8,
+	0x51, 0x30, 0xb7, 0xae, 0x49, 0x51, 0x84, 0x9a, 0x40, 0x83, 0x1a, 0x98, 0x80, 0x92, 0x19, 0x53,
+	0x6f, 0x83, 0xb2, 0x24, 0x8c, 0x67, 0xc0, 0x29, 0x43, 0x99, 0x96, 0x73, 0xbc, 0xc2, 0xe7, 0xae,
+	0x9c, 0xe3, 0xdd, 0x4d, 0x75, 0x9e, 0xc1, 0xef, 0xe0, 0x38, 0xa9, 0x73, 0x5d, 0x64, 0x8e, 0x54,
+	0xdb, 0xbd, 0x9b, 0x58, 0x4e, 0x9d, 0xc2, 0x67, 0xf0, 0x6e, 0x32, 0x97, 0x95, 0xa7, 0xce, 0x79,
+	0xee, 0x93, 0xda, 0xca, 0xa8, 0x27, 0x97, 0x1b, 0x7e, 0x63, 0x57, 0xb0, 0x24, 0x8d, 0x86, 0xdc,
+	0x82, 0x80, 0xda, 0xe4, 0x14, 0xb5, 0x70, 0xc1, 0x3d, 0x42, 0x8b, 0x2a, 0x3f, 0x73, 0x7f, 0xb1,
+	0x42, 0x1a, 0xd2, 0xde, 0x5b, 0x54, 0xfd, 0x85, 0x30, 0xd9, 0x52, 0xa7, 0xd8, 0x30, 0xd9, 0x82,
+	0xa5, 0xb7, 0xd8, 0x1b, 0x88, 0x79, 0xd0, 0xa0, 0x1c, 0x80, 0x2a, 0xe8, 0x0d, 0x28, 0x4b, 0xe8,
+	0xa6, 0x02, 0xf2, 0xdf, 0x48, 0x48, 0x27, 0x8e, 0xae, 0x47, 0x7d, 0xb6, 0xa9, 0x2c, 0xd3, 0x47,
+	0x0c, 0x53, 0xb3, 0x22, 0x52, 0x23, 0x9d, 0x7f, 0x99, 0x1c, 0x35, 0xb6, 0x48, 0xa3, 0x00, 0xbe,
+	0x9b, 0xdd, 0x9e, 0xdf, 0x57, 0x75, 0x39, 0xee, 0x9c, 0xfe, 0x8f, 0x90, 0x89, 0x16, 0x1b, 0x46,
+	0x4c, 0x6e, 0x6b, 0xa5, 0x65, 0x88, 0xa4, 0xd0, 0x56, 0x6e, 0xb6, 0x6a, 0x60, 0x1f, 0x73, 0x20,
+	0x68, 0x93, 0x59, 0x8b, 0x75, 0xd4, 0x32, 0xc4, 0x01, 0x55, 0x74, 0x9f, 0x82, 0x41, 0x2c, 0xa8,
+	0x84, 0xd8, 0x8f, 0x75, 0xaa, 0x11, 0x41, 0x4c, 0x8e, 0xb8, 0x18, 0x28, 0x2d, 0x11, 0x05, 0xb6,
+	0xca, 0x23, 0xc6, 0xc6, 0x44, 0xd9, 0x75, 0x56, 0xb3, 0x75, 0xfe, 0xad, 0x47, 0x1a, 0xd2, 0xf0,
+	0xe6, 0x1c, 0x75, 0x7b, 0xcc, 0x2a, 0xff, 0xdf, 0x31, 0xab, 0xfe, 0x10, 0xc6, 0xac, 0x76, 0xcb,
+	0x63, 0x16, 0xbc, 0xe8, 0x91, 0x59, 0xeb, 0xb4, 0x03, 0xcb, 0x8f, 0x46, 0x3d, 0x61, 0xa2, 0x82,
+	0x4f, 0xc0, 0xac, 0x46, 0x3d, 0x2e, 0xe4, 0x28, 0x7c, 0x42, 0x97, 0x61, 0x26, 0xec, 0x0c, 0xbe,
+	0xa2, 0x34, 0xc2, 0x7f, 0x03, 0x21, 0x08, 0x5c, 0x8c, 0x92, 0x54, 0x32, 0x33, 0x67, 0xaa, 0x10,
+	0x40, 0xa0, 0x46, 0x1a, 0x38, 0x32, 0x21, 0x24, 0x4f, 0x12, 0xb6, 0x4f, 0xc6, 0x24, 0x51, 0x2b,
+	0x61, 0x70, 0x17, 0x99, 0x52, 0xc5, 0xa0, 0xc7, 0x08, 0x3e, 0x84, 0x08, 0xe2, 0x40, 0xd0, 0x23,
+	0x4d, 0x3a, 0x36, 0x55, 0xc8, 0x73, 0x11, 0xeb, 0xf7, 0x12, 0x1c, 0xcf, 0x0b, 0x64, 0xce, 0xa0,
+	0x00, 0x4a, 0x1a, 0x16, 0x4f, 0xe6, 0x3b, 0x4f, 0xe7, 0xa3, 0xb9, 0x5c, 0xc1, 0x88, 0x1c, 0x75,
+	0x26, 0x05, 0x71, 0xbe, 0x92, 0xa4, 0xc6, 0xac, 0x91, 0xa0, 0xff, 0x56, 0x42, 0x40, 0x18, 0xf2,
+	0xb4, 0xcd, 0x4a, 0x51, 0xb5, 0x3a, 0x0d, 0x35, 0xd2, 0x07, 0xcb, 0x56, 0x85, 0x9a, 0x00, 0xb2,
+	0x45, 0x14, 0xc9, 0xbb, 0x41, 0x40, 0x86, 0x1c, 0x86, 0x2d, 0x03, 0xbf, 0x83, 0x0f, 0x55, 0x08,
+	0xd1, 0xfe, 0x02, 0xe7, 0xf4, 0xe6, 0xdb, 0x5e, 0x45, 0x6d, 0x7b, 0x6f, 0x24, 0x13, 0xdd, 0x78,
+	0x63, 0x05, 0x6d, 0x6f, 0x15, 0x83, 0x63, 0x5e, 0x4c, 0x56, 0x77, 0x17, 0x69, 0x21, 0x57, 0x8b,
+	0x25, 0x90, 0xab, 0x76, 0x2b, 0xb9, 0x78, 0x5a, 0x58, 0xd7, 0xed, 0x61, 0xca, 0xe2, 0xeb, 0x61,
+	0x1f, 0xb7, 0xc8, 0x2a, 0x55, 0x30, 0x0c, 0x76, 0x8b, 0xf5, 0xc3, 0x5d, 0xdc, 0x24, 0xab, 0x94,
+	0x03, 0xd0, 0x82, 0x56, 0x34, 0xe0, 0x52, 0x6b, 0x8a, 0xe2, 0xb7, 0x7f, 0x1f, 0xa9, 0x2f, 0x87,
+	0xfd, 0x3e, 0x17, 0x4b, 0x59, 0x3f, 0x09, 0x50, 0x28, 0xa7, 0x07, 0x0f, 0x93, 0x69, 0xdd, 0x19,
+	0x98, 0xcf, 0x9c, 0x11, 0x0e, 0xff, 0x0a, 0xa7, 0x07, 0xcf, 0x91, 0xa3, 0xce, 0x76, 0x14, 0x9e,
+	0xb1, 0xa4, 0xac, 0xaa, 0x64, 0x64, 0xd5, 0x69, 0x72, 0x30, 0x6b, 0x8f, 0xe3, 0xea, 0x43, 0x16,
+	0x1d, 0x5c, 0x94, 0xe3, 0x06, 0x9c, 0x43, 0x3d, 0xf0, 0x2b, 0xeb, 0x41, 0xdc, 0x11, 0x52, 0xc7,
+	0x81, 0x97, 0x3a, 0x1f, 0x02, 0xb8, 0x1d, 0xf5, 0xa3, 0x30, 0x11, 0xe5, 0x72, 0x20, 0xf8, 0x67,
+	0xcf, 0x36, 0x59, 0xc0, 0xfe, 0xdf, 0x89, 0xa3, 0x41, 0x18, 0xef, 0xea, 0x1d, 0xdd, 0xc0, 0xc0,
+	0xa4, 0xee, 0x8e, 0xe2, 0x14, 0x88, 0x15, 0x24, 0x4a, 0x10, 0xc4, 0x6d, 0x27, 0x1e, 0x8d, 0x59,
+	0x9c, 0x62, 0x56, 0x2e, 0x1b, 0x4c, 0x14, 0xf8, 0x65, 0x24, 0x78, 0x05, 0x55, 0xef, 0x1a, 0xa6,
+	0xb1, 0x91, 0xfe, 0x1b, 0xc8, 0x61, 0xd0, 0x13, 0x85, 0xcb, 0x31, 0x63, 0x84, 0x72, 0x91, 0xc0,
+	0x68, 0xb7, 0x3c, 0x1a, 0x8c, 0xc3, 0x0d, 0x80, 0x94, 0x69, 0xa6, 0x4e, 0x33, 0xd8, 0xe0, 0x06,
+	0x99, 0x36, 0x44, 0x08, 0x2c, 0x97, 0xb5, 0xd1, 0x36, 0x1b, 0x26, 0xe2, 0x58, 0x20, 0x20, 0xe8,
+	0x02, 0xfc, 0x8a, 0x9e, 0x07, 0x27, 0x00, 0x57, 0x5e, 0x0c, 0x4c, 0x11, 0x83, 0xd5, 0x42, 0x06,
+	0x83, 0x47, 0x6d, 0x21, 0xe7, 0x9f, 0xb6, 0xe7, 0x97, 0x9f, 0x97, 0x76, 0x72, 0x82, 0xfd, 0x93,
+	0x4f, 0x26, 0x97, 0x47, 0x83, 0x41, 0x38, 0xec, 0xf9, 0xf7, 0x91, 0x5a, 0x0a, 0x8d, 0x83, 0xb1,
+	0x3e, 0x60, 0x58, 0x95, 0x90, 0x7a, 0x06, 0x5a, 0x48, 0x31, 0x41, 0xf0, 0x59, 0xb1, 0xe0, 0xfd,
+	0xe3, 0xe4, 0xe8, 0x72, 0xcc, 0xc2, 0x94, 0xc9, 0x79, 0x26, 0x12, 0xcf, 0x55, 0xfd, 0xdb, 0xc8,
+	0xe1, 0x56, 0x3c, 0x1a, 0x67, 0x09, 0x35, 0x7f, 0x9e, 0x9c, 0xe4, 0x79, 0x32, 0x13, 0x4f, 0xa6,
+	0xa8, 0xfb, 0xa7, 0xc8, 0x09, 0xc8, 0x5a, 0x40, 0x9f, 0xf0, 0xef, 0x21, 0xf3, 0x5d, 0x96, 0xba,
+	0xed, 0xc8, 0x32, 0xd5, 0x24, 0xd4, 0xf3, 0xd4, 0xb8, 0x57, 0x5c, 0x4f, 0xc3, 0xbf, 0x9d, 0xdc,
+	0xc6, 0x39, 0xd1, 0x07, 0x11, 0x49, 0x9c, 0x02, 0x22, 0xd7, 0x48, 0xf3, 0x44, 0xe2, 0x1f, 0x25,
+	0x87, 0x78, 0x4e, 0xd8, 0x85, 0x25, 0x7a, 0xd6, 0x3f, 0x4c, 0x0e, 0x02, 0xe3, 0x26, 0xf2, 0x00,
+	0xa4, 0xe5, 0x7c, 0x98, 0xe8, 0x83, 0xd0, 0x3f, 0x5d, 0xa6, 0x77, 0x4d, 0x49, 0x98, 0xf3, 0x7d,
+	0x72, 0x00, 0x5a, 0x17, 0xa6, 0xa1, 0xc4, 0x1d, 0xf2, 0x4f, 0x92, 0x66, 0x97, 0xa5, 0xa8, 0xe0,
+	0xe5, 0x72, 0xf8, 0xfe, 0x1d, 0xe4, 0xb8, 0x68, 0x87, 0xa1, 0xc9, 0x4a, 0xf2, 0x51, 0x6c, 0x49,
+	0x3c, 0x1a, 0xbb, 0x88, 0xc7, 0xf4, 0x08, 0x4a, 0x17, 0xbd, 0x24, 0x35, 0xed, 0xc1, 0x35, 0x49,
+	0xc7, 0x81, 0xc4, 0xdb, 0x94, 0x25, 0x9d, 0x00, 0x12, 0xef, 0xb7, 0x6c, 0x81, 0xb7, 0x6b, 0x52,
+	0x36, 0xd7, 0x49, 0xff, 0x18, 0xf1, 0xbb, 0x2c, 0xcd, 0x66, 0xb9, 0xc3, 0x3f, 0x42, 0xe6, 0x90,
+	0x77, 0x18, 0x03, 0x89, 0x3d, 0x05, 0x0d, 0xc6, 0x13, 0x83, 0x98, 0x5b, 0xbc, 0x50, 0x49, 0xbe,
+	0x13, 0x1a, 0xcc, 0xb9, 0xd3, 0x9a, 0xb7, 0x24, 0xde, 0x0d, 0x93, 0x07, 0xf2, 0x66, 0x26, 0x85,
+	0x5d, 0xc4, 0x7d, 0xd0, 0xe1, 0xb2, 0x5b, 0x94, 0xdc, 0x95, 0xd4, 0x87, 0x80, 0xab, 0xc5, 0x7e,
+	0xca, 0x62, 0x79, 0x10, 0x59, 0x1e, 0xf4, 0xe6, 0x16, 0x60, 0xa0, 0x29, 0xaf, 0x32, 0x1a, 0x6e,
+	0xca, 0xc4, 0x6f, 0x84, 0x81, 0x16, 0xdc, 0xa0, 0x91, 0x4d, 0x12, 0xde, 0x04, 0x04, 0xca, 0xc6,
+	0xa3, 0x38, 0xc5, 0x3c, 0x89, 0x24, 0x3c, 0x0c, 0x9d, 0xd1, 0x89, 0x77, 0x86, 0x8c, 0x9b, 0x3f,
+	0x24, 0xfe, 0xcd, 0x30, 0xa3, 0x81, 0x75, 0x83, 0x25, 0x9b, 0xed, 0xc7, 0xfc, 0x13, 0xe4, 0x18,
+	0x74, 0x97, 0x83, 0xe9, 0xb7, 0x00, 0xd3, 0x20, 0x3a, 0x28, 0x78, 0xa7, 0x25, 0xf6, 0xad, 0x7e,
+	0x93, 0x1c, 0xc1, 0xea, 0xa5, 0x28, 0x91, 0x94, 0xc7, 0xf5, 0x02, 0xd0, 0xa6, 0x18, 0x49, 0x7c,
+	0x02, 0x96, 0xa8, 0xd1, 0xc5, 0x20, 0x4a, 0xe0, 0x80, 0x29, 0xe9, 0x6f, 0xd3, 0x43, 0x00, 0xc3,
+	0xc9, 0x3d, 0x59, 0x92, 0xf8, 0x76, 0x68, 0x1f, 0xef, 0x5c, 0x8c, 0x61, 0x90, 0xf8, 0x45, 0xc0,
+	0xf3, 0x4c, 0x16, 0x7e, 0x49, 0xf7, 0x20, 0xf7, 0xfa, 0x49, 0xc2, 0x32, 0x64, 0xa0, 0x6c, 0x30,
+	0xba, 0x6e, 0x67, 0x00, 0x07, 0xeb, 0x1d, 0x62, 0xe6, 0x66, 0xac, 0x3f, 0x32, 0xc9, 0x59, 0xff,
+	0x4e, 0x72, 0x3b, 0x8a, 0xa7, 0x82, 0x04, 0xe7, 0xa0, 0x85, 0xe7, 0x59, 0x5a, 0x44, 0x3f, 0x6f,
+	0xac, 0x8e, 0x75, 0xee, 0x29, 0x97, 0xa4, 0x0b, 0xfe, 0x6b, 0xc9, 0xbd, 0xe7, 0x59, 0x6a, 0x0c,
+	0x02, 0x70, 0x7d, 0x35, 0x4a, 0xb7, 0x22, 0x28, 0x8b, 0x51, 0xd5, 0x8f, 0x6d, 0x98, 0x8d, 0x46,
+	0x3f, 0xea, 0xda, 0xcc, 0x76, 0xbe, 0x03, 0x3a, 0x00, 0x06, 0x1e, 0x42, 0x47, 0x46, 0xd7, 0x75,
+	0x37, 0x3f, 0x29, 0x09, 0x32, 0xd4, 0x43, 0x12, 0x2e, 0x02, 0x41, 0x88, 0x04, 0xbe, 0x95, 0x0b,
+	0xc2, 0x0a, 0x4c, 0x52, 0x5c, 0x50, 0x16, 0x1a, 0x3c, 0x32, 0xa7, 0xf2, 0x2c, 0xe3, 0xa6, 0x2d,
+	0xd3, 0xac, 0x42, 0x8b, 0xaf, 0xb0, 0x38, 0xba, 0xb6, 0x9b, 0x5d, 0xbe, 0x1d, 0xa8, 0xee, 0xec,
+	0xcd, 0x71, 0x38, 0xec, 0xd9, 0x53, 0xf6, 0x32, 0x4c, 0x48, 0x39, 0x74, 0xc2, 0x5e, 0x26, 0x69,
+	0x14, 0xca, 0x83, 0x1e, 0x5e, 0x5a, 0x8a, 0x23, 0x76, 0xcd, 0x6c, 0x70, 0x57, 0x74, 0xbe, 0xa9,
+	0x59, 0x9b, 0xf4, 0x35, 0x58, 0x09, 0x94, 0x6d, 0x46, 0xb0, 0x07, 0x8a, 0xd0, 0x82, 0xd5, 0x6b,
+	0xd7, 0x12, 0xa6, 0xa6, 0xc0, 0x53, 0x7a, 0x97, 0xc9, 0x18, 0xb2, 0x64, 0x8a, 0x2b, 0x28, 0x53,
+	0x9f, 0xeb, 0x2f, 0x80, 0xcc, 0xb9, 0xc0, 0xc2, 0x38, 0x5d, 0x67, 0xa1, 0xca, 0x7f, 0x15, 0xf3,
+	0xdb, 0x39, 0xf9, 0x5a, 0x95, 0x29, 0x7e, 0x54, 0x74, 0x59, 0x26, 0xd1, 0x45, 0x66, 0xec, 0x75,
+	0x3f, 0x26, 0x77, 0xb2, 0x02, 0x1e, 0xde, 0x09, 0xb3, 0xf0, 0xd2, 0x28, 0x8d, 0xae, 0xed, 0x2e,
+	0x5f, 0xe6, 0x39, 0x31, 0x76, 0x44, 0x49, 0xba, 0xa7, 0x61, 0x26, 0x77, 0x59, 0x8a, 0x8b, 0xc8,
+	0xf6, 0x0b, 0xcb, 0x24, 0xef, 0xe2, 0x62, 0x07, 0x16, 0x81, 0x39, 0x24, 0x3f, 0x0e, 0xcd, 0x93,
+	0xdb, 0x9f, 0x0a, 0x72, 0x90, 0xd4, 0x77, 0x83, 0x04, 0xd5, 0xeb, 0x73, 0x6d, 0x30, 0xc6, 0x35,
+	0x2e, 0xc9, 0x3f, 0x01, 0x52, 0x41, 0x4c, 0x1f, 0x1e, 0x53, 0x22, 0x29, 0xcf, 0x18, 0x0b, 0x9f,
+	0x53, 0x6c, 0x6e, 0x42, 0x58, 0x92, 0xed, 0x61, 0xc2, 0xe2, 0xf4, 0x5c, 0xd4, 0x67, 0x0a, 0xbf,
+	0xae, 0xd9, 0x71, 0xc8, 0x26, 0x70, 0x62, 0xdf, 0x2e, 0xa9, 0x69, 0x98, 0x2f, 0xf6, 0x1a, 0xee,
+	0x0f, 0x5b, 0xa3, 0x1b, 0x42, 0xef, 0x91, 0xf8, 0x4d, 0xbd, 0x11, 0xc3, 0x39, 0x5a, 0xa2, 0xb7,
+	0xe4, 0x46, 0x6c, 0x22, 0x23, 0x18, 0xb0, 0xae, 0x35, 0xa9, 0x72, 0x5b, 0xe9, 0xb3, 0x30, 0x5f,
+	0x29, 0xbb, 0x3e, 0xda, 0x66, 0x39, 0xda, 0xb6, 0xd8, 0xa3, 0x60, 0x17, 0x37, 0xcb, 0xed, 0xeb,
+	0xbe, 0x34, 0x8d, 0xc4, 0x92, 0x3c, 0xb8, 0xbf, 0xd1, 0xe8, 0xcd, 0xbd, 0xf0, 0xc2, 0x0b, 0x2f,
+	0x54, 0x82, 0xbf, 0xaa, 0x14, 0xa8, 0x4b, 0x4e, 0x6d, 0xbe, 0x95, 0xd7, 0xd8, 0xb9, 0x33, 0xa7,
+	0xcc, 0x1d, 0x9e, 0xcd, 0x02, 0xba, 0xa6, 0xf4, 0x8d, 0xec, 0x0c, 0x50, 0x85, 0x9c, 0xa5, 0x06,
+	0xc6, 0xbf, 0x97, 0x54, 0xbb, 0xdb, 0x11, 0xda, 0x8a, 0x0a, 0x1c, 0xa7, 0x40, 0x77, 0xb8, 0xad,
+	0xeb, 0x4e, 0xb7, 0xf5, 0x7e, 0x5c, 0xd3, 0x0b, 0xe7, 0xc8, 0xe4, 0x86, 0xe8, 0x80, 0x03, 0xb6,
+	0xb2, 0xd9, 0xdc, 0x9c, 0xf7, 0x8c, 0xa3, 0x9c, 0xb3, 0xd3, 0xa8, 0xcc, 0x1c, 0x8c, 0x9c, 0xaa,
+	0xa6, 0xab, 0x53, 0x17, 0x5a, 0xc5, 0x55, 0x6e, 0x59, 0x9d, 0xeb, 0x28, 0x50, 0x57, 0xf8, 0xaf,
+	0x5e, 0xb9, 0x0e, 0x5b, 0x6a, 0x35, 0x72, 0x8e, 0x6b, 0x65, 0xbf, 0xe3, 0x8a, 0x46, 0x6e, 0xae,
+	0x00, 0x77, 0x84, 0x01, 0x50, 0x23, 0x16, 0x56, 0x8a, 0x9b, 0x19, 0x61, 0x33, 0xef, 0xb6, 0x7a,
+	0xd6, 0xdd, 0x0a, 0xdd, 0xde, 0x8f, 0x7a, 0x65, 0x1a, 0x79, 0x69, 0x6b, 0xe5, 0x20, 0x54, 0x8c,
+	0x41, 0x78, 0xb2, 0x98, 0xbb, 0x67, 0x91, 0xbb, 0xbb, 0x8c, 0x41, 0xd8, 0x8b, 0xb7, 0x4f, 0x7b,
+	0x7b, 0x9f, 0x06, 0xf6, 0xcd, 0xe1, 0xe5, 0x62, 0x0e, 0xb7, 0x91, 0xc3, 0xfb, 0xe4, 0x4a, 0xd9,
+	0xa3, 0x66, 0xcd, 0xe7, 0x97, 0xab, 0xe5, 0xe7, 0x91, 0xfd, 0xf2, 0x08, 0x07, 0xe5, 0x4b, 0xec,
+	0x86, 0x30, 0x93, 0x61, 0xa8, 0x92, 0x00, 0x2d, 0x5f, 0x6b, 0x2d, 0x13, 0xc6, 0x61, 0xfa, 0x4e,
+	0xeb, 0x99, 0xb0, 0x0c, 0xb7, 0x1f, 0x76, 0xa2, 0x30, 0xc4, 0x03, 0x1d, 0x8d, 0xdb, 0x4c, 0x74,
+	0x00, 0x3a, 0x0c, 0x1a, 0xd4, 0x44, 0xe5, 0x1d, 0x8d, 0xde, 0xde, 0x8e, 0x46, 0xef, 0x96, 0x1d,
+	0x8d, 0x9e, 0xdb, 0xd1, 0x58, 0x36, 0xfb, 0xfb, 0xd6, 0xec, 0x2f, 0x1b, 0x0f, 0x3d, 0x72, 0x3f,
+	0x5b, 0x29, 0x3c, 0x27, 0x96, 0x0e, 0xda, 0x31, 0x32, 0x61, 0x45, 0x3e, 0x4d, 0xe8, 0xa5, 0x0b,
+	0x8a, 0x78, 0x92, 0x86, 0x83, 0xb1, 0xf0, 0x5d, 0x69, 0x04, 0x50, 0xb1, 0x1a, 0x74, 0xde, 0xd4,
+	0x78, 0x98, 0xb7, 0x42, 0x64, 0x3c, 0x4e, 0x75, 0x97, 0xc7, 0x49, 0xe8, 0x59, 0xd8, 0x3f, 0xb3,
+	0x54, 0x82, 0x0b, 0x17, 0x8a, 0x3b, 0x65, 0x30, 0xef, 0x19, 0x11, 0xb3, 0x05, 0x4d, 0xd5, 0xfd,
+	0xf1, 0xdf, 0x5e, 0xe1, 0xd1, 0xf8, 0x65, 0xf5, 0x47, 0x40, 0x66, 0x74, 0x41, 0x2a, 0xf4, 0xde,
+	0xc2, 0xd9, 0x3e, 0x3d, 0x3e, 0x23, 0x35, 0x02, 0x7a, 0x85, 0x03, 0xca, 0x0f, 0x57, 0xa7, 0x06,
+	0xa6, 0xac, 0xed, 0x43, 0xab, 0xed, 0x05, 0xcd, 0xd2, 0x6d, 0xff, 0x9c, 0xe7, 0x38, 0xf9, 0xbf,
+	0x32, 0x1e, 0x9b, 0x85, 0xa5, 0x62, 0xae, 0x9f, 0x43, 0xae, 0x9b, 0xd6, 0x88, 0x19, 0x0c, 0x69,
+	0x7e, 0x37, 0x73, 0x16, 0x09, 0xe7, 0xb6, 0xf8, 0xf6, 0xe2, 0xaa, 0x62, 0xac, 0xea, 0x98, 0x21,
+	0x91, 0x9d, 0x15, 0xbd, 0xd7, 0x61, 0xe5, 0xb8, 0xd5, 0x7e, 0x29, 0x6b, 0x69, 0x62, 0xb5, 0x34,
+	0x57, 0x85, 0x66, 0xe0, 0xf3, 0x9e, 0xd3, 0xa0, 0x02, 0x33, 0x12, 0xd2, 0x0f, 0x35, 0x1f, 0x0a,
+	0x2e, 0x35, 0x98, 0x96, 0xba, 0x6e, 0xca, 0xf4, 0x88, 0xd4, 0xd2, 0x23, 0x1c, 0x2c, 0x69, 0x9e,
+	0xe3, 0xac, 0xa9, 0xc7, 0xbf, 0x93, 0xdf, 0x5a, 0x11, 0xf1, 0x9c, 0xd3, 0x46, 0x8c, 0x37, 0x45,
+	0xc2, 0xc2, 0xdb, 0x8a, 0x2b, 0xde, 0x99, 0xf7, 0x8c, 0x80, 0x0a, 0xbb, 0x60, 0x5d, 0xe7, 0x87,
+	0xbd, 0x62, 0x5b, 0x52, 0x69, 0x67, 0xa9, 0xc9, 0x5b, 0x31, 0x26, 0xef, 0x42, 0xbb, 0x98, 0x9f,
+	0xeb, 0xc8, 0xcf, 0x9d, 0x9a, 0x1f, 0x67, 0x9d, 0x96, 0x5c, 0x29, 0xb6, 0x63, 0xbd, 0x72, 0x06,
+	0x6f, 0xe5, 0xda, 0xad, 0x95, 0xb8, 0x76, 0xeb, 0x79, 0xd7, 0xee, 0xc2, 0x3b, 0x8a, 0x9b, 0xbe,
+	0x8b, 0x4d, 0x9f, 0xb7, 0x25, 0x6a, 0xbe, 0x51, 0xba, 0xed, 0x5f, 0xf7, 0x0a, 0x8d, 0x74, 0xaf,
+	0x5c, 0xcb, 0xcb, 0xe4, 0xe2, 0xf3, 0xb6, 0x5c, 0x74, 0xb3, 0xa6, 0xf9, 0xff, 0x96, 0x57, 0x60,
+	0x47, 0x04, 0x4e, 0x2f, 0xac, 0xad, 0x75, 0x30, 0x0e, 0x5a, 0x4c, 0x29, 0x09, 0x9b, 0x71, 0xd8,
+	0xbc, 0xf3, 0x33, 0x71, 0xd8, 0x48, 0xe1, 0xcd, 0x93, 0x20, 0xf4, 0x06, 0x05, 0x06, 0xf9, 0x2e,
+	0x81, 0xdf, 0x65, 0x07, 0x89, 0xf7, 0x38, 0x0e, 0x12, 0x19, 0x16, 0x75, 0x2b, 0xbe, 0xea, 0x15,
+	0x98, 0x3c, 0xf7, 0x6a, 0x45, 0x09, 0xaf, 0x99, 0xd8, 0x6d, 0x11, 0x54, 0x3d, 0x2d, 0x83, 0xaa,
+	0xcb, 0x78, 0xff, 0xc9, 0x82, 0x43, 0x90, 0x93, 0xf7, 0xab, 0x64, 0x56, 0xd2, 0xd0, 0x1a, 0xa6,
+	0x02, 0xdf, 0x81, 0xdd, 0x19, 0x11, 0xf8, 0x7e, 0x92, 0x4c, 0x21, 0xd1, 0xf0, 0xd6, 0x69, 0x84,
+	0x0e, 0x65, 0xaf, 0x1a, 0xa1, 0xec, 0xe0, 0x7e, 0x74, 0x1a, 0x74, 0xb3, 0x51, 0x2b, 0x65, 0x2d,
+	0x79, 0xaf, 0xd5, 0x12, 0x67, 0x71, 0xba, 0x25, 0xe3, 0x02, 0x33, 0x71, 0xae, 0xc2, 0xf3, 0xc5,
+	0x15, 0xbe, 0xe0, 0x39, 0x6a, 0x2c, 0xec, 0xbb, 0x73, 0xa0, 0x14, 0x27, 0xe3, 0xd1, 0x30, 0xc1,
+	0xf1, 0x59, 0x7d, 0x12, 0x2b, 0x69, 0xd0, 0xca, 0xea, 0x93, 0xd0, 0x29, 0x67, 0xe3, 0x78, 0x14,
+	0x0b, 0x3f, 0x0d, 0x07, 0xf4, 0x0d, 0x42, 0x1e, 0x66, 0xc2, 0x81, 0xe0, 0x1b, 0x9e, 0xcb, 0x8c,
+	0xfd, 0xaa, 0x2c, 0x81, 0x92, 0x0d, 0xe9, 0x7d, 0xbc, 0x2f, 0x8e, 0x6b, 0x41, 0x5c, 0xd8, 0xf5,
+	0xd7, 0xf2, 0xe6, 0xf6, 0x5c, 0xaf, 0x97, 0x6c, 0xd6, 0xef, 0xe7, 0x35, 0xdd, 0x66, 0x4a, 0x0d,
+	0xa3, 0x28, 0x5d, 0xcf, 0x7b, 0x4a, 0x0c, 0xf8, 0x4e, 0x05, 0xa5, 0xe4, 0xc8, 0xf8, 0x01, 0xcf,
+	0x12, 0xb6, 0x85, 0xe5, 0xea, 0xda, 0xbf, 0xeb, 0x15, 0x3a, 0x08, 0xd0, 0xfd, 0xc8, 0x23, 0x76,
+	0xb1, 0xfe, 0x2a, 0x95, 0x20, 0x50, 0x30, 0x65, 0xbb, 0x27, 0x56, 0x8e, 0x04, 0x41, 0x81, 0x6b,
+	0xad, 0x8b, 0x83, 0x18, 0x2a, 0xb6, 0x1c, 0x02, 0x3c, 0x1d, 0x23, 0x9e, 0x0f, 0xad, 0x80, 0xca,
+	0xf6, 0xcc, 0x9f, 0xf2, 0x2c, 0xb9, 0x5b, 0xc0, 0xa5, 0x6e, 0xca, 0x67, 0xbc, 0xbd, 0xdd, 0x19,
+	0xfb, 0x3e, 0xfd, 0xd2, 0x62, 0xfe, 0x3e, 0xe4, 0x59, 0xc7, 0xdf, 0xbd, 0xaa, 0xd6, 0x8c, 0xfe,
+	0x4d, 0xb5, 0xd8, 0xa3, 0x82, 0x1d, 0xb8, 0x64, 0x8c, 0xb9, 0x80, 0x8c, 0x0e, 0xac, 0x98, 0x1d,
+	0xa8, 0x98, 0xae, 0x1a, 0x3b, 0xe2, 0x2d, 0x1a, 0xb2, 0xee, 0x21, 0x95, 0x36, 0x2d, 0x0d, 0xc9,
+	0xaf, 0xb4, 0xe9, 0x2b, 0x17, 0x87, 0xbf, 0x40, 0x08, 0x77, 0x03, 0x61, 0xb6, 0x86, 0xe5, 0x9d,
+	0x45, 0x37, 0x3a, 0xa7, 0x52, 0x23, 0x95, 0x19, 0x06, 0x3f, 0x55, 0x1e, 0x06, 0x7f, 0xcb, 0xa1,
+	0xf6, 0x65, 0xba, 0xcb, 0x2f, 0x7a, 0x96, 0xde, 0x56, 0x34, 0x68, 0x7a, 0x68, 0xbf, 0xe9, 0xe5,
+	0xdd, 0x61, 0xaf, 0xe2, 0x90, 0x96, 0x09, 0xa4, 0x5f, 0xb2, 0x05, 0x52, 0x96, 0x4b, 0xdd, 0x86,
+	0xef, 0x29, 0x91, 0x00, 0xee, 0x1c, 0xcb, 0xfa, 0x8c, 0x6e, 0xfc, 0x30, 0xd9, 0xd6, 0x41, 0x7b,
+	0x1c, 0x52, 0xc1, 0x7c, 0x3d, 0x11, 0xc2, 0x22, 0x20, 0x10, 0x98, 0xad, 0x25, 0xd1, 0x90, 0x4a,
+	0x6b, 0x09, 0xe0, 0xce, 0x9a, 0x08, 0x54, 0xaf, 0x74, 0xd6, 0xf4, 0x8e, 0x52, 0x37, 0x76, 0x94,
+	0x32, 0xa1, 0xf0, 0x61, 0x97, 0x50, 0xc8, 0xf1, 0xa9, 0x1b, 0xf3, 0xef, 0x9e, 0xc3, 0x13, 0xb9,
+	0xd7, 0xd1, 0xdc, 0x39, 0x2a, 0xb7, 0x78, 0x34, 0xef, 0x8e, 0xfb, 0x11, 0x0f, 0xd3, 0x15, 0xe1,
+	0xb6, 0x0a, 0x01, 0x16, 0x20, 0x4c, 0xbd, 0x34, 0xda, 0x19, 0xf6, 0xa4, 0x1e, 0x6d, 0xa2, 0x16,
+	0x96, 0x8b, 0x1b, 0xfe, 0x11, 0xcf, 0x3a, 0xfd, 0xe5, 0xda, 0xa4, 0x9b, 0xfc, 0x2f, 0x9e, 0xd3,
+	0xcb, 0xfa, 0xb2, 0x1a, 0x9d, 0x09, 0xeb, 0xab, 0xe6, 0xc3, 0xfa, 0x1e, 0x25, 0xb3, 0xb8, 0x58,
+	0xd7, 0x46, 0x7c, 0x75, 0x34, 0x6b, 0x85, 0x0b, 0xd9, 0x4e, 0xb8, 0x70, 0xb6, 0xb8, 0xb1, 0x1f,
+	0xf5, 0xac, 0x83, 0xa3, 0xa3, 0x35, 0xba, 0xb9, 0x1b, 0x64, 0xda, 0xa8, 0x04, 0x86, 0x00, 0x41,
+	0x63, 0xbd, 0x69, 0x84, 0xa2, 0x2a, 0xa5, 0xaf, 0x4e, 0x35, 0xc2, 0x8e, 0xa3, 0xb6, 0xae, 0x77,
+	0x5c, 0x15, 0x01, 0x70, 0xce, 0x10, 0xe5, 0x13, 0xd9, 0x10, 0x65, 0x23, 0x3c, 0xd9, 0x0e, 0xf1,
+	0xad, 0xe6, 0x42, 0x7c, 0x5f, 0xf2, 0xc8, 0x01, 0x3b, 0xde, 0xff, 0x55, 0x8a, 0xfd, 0xbe, 0x5f,
+	0xc4, 0x3f, 0xb3, 0x6c, 0xf0, 0xb7, 0x6a, 0x27, 0x95, 0x09, 0xf6, 0xda, 0x02, 0x82, 0xf7, 0x79,
+	0x62, 0x66, 0x8b, 0x7b, 0x9a, 0x4a, 0x71, 0x90, 0xcd, 0x90, 0xa0, 0xb2, 0xe8, 0x75, 0xa3, 0xe7,
+	0x99, 0x10, 0x15, 0x1a, 0x81, 0x0b, 0x04, 0x6f, 0x1b, 0x2e, 0x8f, 0x76, 0xc4, 0x6c, 0xab, 0x53,
+	0x13, 0x05, 0x25, 0xaf, 0x84, 0x37, 0x8d, 0xe5, 0x25, 0xc1, 0xe0, 0x69, 0x32, 0x4b, 0xc7, 0x26,
+	0x13, 0x7a, 0x4a, 0x7b, 0xd6, 0x94, 0x5e, 0x20, 0x44, 0x25, 0x4b, 0x84, 0xbb, 0xc1, 0x37, 0x05,
+	0x2a, 0xcf, 0x4f, 0x8d, 0x54, 0xc1, 0x33, 0x84, 0xc0, 0x25, 0x5c, 0x51, 0x32, 0x17, 0x6a, 0x9e,
+	0x12, 0x6a, 0xfc, 0x72, 0xaf, 0xbc, 0xdb, 0x8c, 0xdf, 0xfe, 0x19, 0x32, 0x49, 0xc7, 0xbc, 0x8a,
+	0xaa, 0x15, 0xab, 0x6a, 0x31, 0x49, 0x65, 0xa2, 0xe0, 0x17, 0x3c, 0x72, 0x9b, 0x19, 0x01, 0x71,
+	0x71, 0x14, 0x2a, 0xad, 0x93, 0x5f, 0x01, 0x5e, 0x83, 0x84, 0x99, 0x20, 0x39, 0xcd, 0x14, 0x55,
+	0x49, 0xca, 0xa4, 0xe7, 0xc7, 0x6c, 0xe9, 0x59, 0x50, 0xa1, 0x5e, 0x5b, 0xdf, 0xf1, 0xdc, 0xd7,
+	0x4d, 0xfc, 0x37, 0xc8, 0x60, 0x3f, 0xcf, 0xba, 0x4b, 0xaa, 0xd3, 0xae, 0x8e, 0x59, 0x1c, 0xa6,
+	0xa3, 0x38, 0x11, 0x51, 0x7f, 0xfe, 0x79, 0xe2, 0x67, 0x4a, 0x8a, 0x54, 0x1c, 0xef, 0x6d, 0x05,
+	0xd7, 0x56, 0xa8, 0x23, 0x8b, 0x65, 0xd1, 0xaf, 0x66, 0x6e, 0x4f, 0xe9, 0xed, 0x89, 0xdf, 0xaa,
+	0x16, 0x50, 0xf0, 0x1e, 0x32, 0x97, 0x2d, 0x1b, 0xdc, 0x78, 0x32, 0xbe, 0x40, 0xc4, 0x3e, 0x72,
+	0x25, 0x37, 0x83, 0x05, 0xb9, 0x0f, 0x13, 0x4c, 0xa5, 0xe2, 0x2b, 0xd0, 0xc2, 0xc1, 0xb4, 0xbe,
+	0x1a, 0xa6, 0x2c, 0x86, 0x85, 0x2d, 0xcd, 0xd8, 0x0a, 0x11, 0xb4, 0xc9, 0x61, 0x47, 0xc7, 0x00,
+	0xb3, 0x8b, 0x9b, 0x9b, 0xab, 0x63, 0x15, 0x41, 0xca, 0x21, 0x29, 0xa7, 0x8d, 0x73, 0xa9, 0x82,
+	0x83, 0xf7, 0x92, 0x93, 0xae, 0xf1, 0x80, 0x80, 0x8a, 0xd6, 0x3a, 0x1d, 0xfb, 0x0f, 0x92, 0x1a,
+	0xc0, 0xc2, 0x66, 0x56, 0x7a, 0x1d, 0x08, 0x13, 0x1a, 0xfa, 0x7a, 0xa5, 0x40, 0x5f, 0xaf, 0x9a,
+	0xab, 0x27, 0x78, 0x9a, 0x9c, 0xca, 0x8f, 0x89, 0xc5, 0xc2, 0x9b, 0xed, 0x78, 0xbb, 0xbb, 0x4b,
+	0x78, 0x90, 0x79, 0x64, 0x00, 0xde, 0x1a, 0x39, 0x91, 0x89, 0xfd, 0xe0, 0x92, 0x1f, 0xa9, 0xfe,
+	0xc3, 0x76, 0xc1, 0xf3, 0xe6, 0x9a, 0x75, 0xe5, 0x90, 0xa5, 0x8e, 0xc8, 0xf1, 0xc2, 0x34, 0xfe,
+	0xeb, 0xe0, 0x3a, 0x04, 0x6c, 0x6d, 0xbc, 0xc7, 0x8e, 0x99, 0x85, 0x22, 0x21, 0xba, 0x16, 0xc1,
+	0xf5, 0x7e, 0xfc, 0x86, 0xa0, 0x4a, 0xe3, 0x0e, 0xc8, 0x75, 0x39, 0x19, 0x6c, 0x64, 0xf0, 0x33,
+	0x9e, 0x2b, 0x68, 0x09, 0xa4, 0xa8, 0x56, 0x16, 0xc4, 0xa9, 0xda, 0xc0, 0xa8, 0x10, 0x60, 0x71,
+	0xe5, 0xb3, 0xec, 0x18, 0xfb, 0x2b, 0xf6, 0x31, 0x36, 0x5f, 0x99, 0x5e, 0xc2, 0xdf, 0xf6, 0xca,
+	0x23, 0xa5, 0x5e, 0x96, 0x9b, 0x62, 0x4f, 0xb5, 0x60, 0xe1, 0x52, 0x31, 0xf3, 0x1f, 0xf7, 0x2c,
+	0xc7, 0x53, 0x19, 0x73, 0xba, 0x19, 0x5f, 0xf1, 0x8a, 0xc2, 0xb9, 0x5e, 0xa1, 0x06, 0x94, 0xd8,
+	0x03, 0x3f, 0xc1, 0x1b, 0x70, 0x87, 0x71, 0xb4, 0x2f, 0x3b, 0x13, 0xfc, 0xaf, 0x47, 0x66, 0x45,
+	0x1c, 0x47, 0xcc, 0x03, 0x96, 0x4f, 0xf2, 0x67, 0x85, 0xb8, 0xd5, 0x84, 0xef, 0x90, 0x1a, 0x61,
+	0x5c, 0x8c, 0x31, 0x75, 0xe9, 0x16, 0xe8, 0xca, 0xf0, 0x6e, 0x04, 0xdf, 0x50, 0x66, 0x29, 0x07,
+	0xfc, 0x87, 0xc9, 0x94, 0x14, 0x7f, 0xf2, 0x12, 0x40, 0xd3, 0x5a, 0x19, 0x82, 0x28, 0x5e, 0x5a,
+	0x92, 0x49, 0xb5, 0x81, 0xab, 0x6e, 0xbe, 0xd5, 0xf0, 0x18, 0x99, 0x36, 0x82, 0x90, 0x9a, 0x13,
+	0x56, 0x79, 0xb2, 0x57, 0x15, 0x9d, 0x9a, 0x89, 0x81, 0xef, 0x0d, 0xfe, 0xb0, 0xcd, 0x24, 0x17,
+	0xbe, 0x1c, 0x0a, 0x3e, 0xe5, 0xe5, 0xa3, 0xed, 0x5e, 0xd6, 0xa0, 0x19, 0x6a, 0x45, 0xd5, 0x52,
+	0x2b, 0xca, 0x8e, 0x3d, 0xbf, 0x6a, 0x1f, 0x7b, 0xb2, 0x8c, 0xe8, 0x61, 0xfa, 0xb8, 0xe7, 0x0e,
+	0xff, 0xd3, 0xf6, 0x2d, 0xcf, 0x7c, 0x21, 0x6b, 0x8e, 0x54, 0x3b, 0xa9, 0xd4, 0xf7, 0xe0, 0x13,
+	0xd8, 0x1e, 0xf2, 0x33, 0x10, 0x37, 0x84, 0x09, 0xa8, 0xcc, 0x16, 0xf8, 0x6b, 0x9e, 0x75, 0x6d,
+	0xd3, 0x55, 0xbd, 0x69, 0x0b, 0xf4, 0x25, 0xad, 0xc5, 0xb8, 0xf9, 0x79, 0x14, 0x43, 0x47, 0x82,
+	0x37, 0x74, 0x4d, 0x06, 0x2b, 0xd7, 0xa8, 0x82, 0xf9, 0xd6, 0x65, 0x44, 0x4d, 0xab, 0xad, 0x4b,
+	0xe3, 0xca, 0xb6, 0xd3, 0xe0, 0x5b, 0x15, 0x72, 0x30, 0x23, 0x09, 0x4b, 0x74, 0xbb, 0xec, 0x01,
+	0xa9, 0xe2, 0x38, 0x20, 0x49, 0xc3, 0x51, 0x6b, 0x5d, 0xac, 0x39, 0x09, 0x2a, 0x4a, 0x27, 0x15,
+	0xc7, 0x43, 0x09, 0x1a, 0xd3, 0xa1, 0x9e, 0xf5, 0x1d, 0x73, 0x67, 0x30, 0x57, 0x4a, 0x81, 0xa4,
+	0x11, 0xee, 0x5b, 0x7c, 0xde, 0x2b, 0x74, 0x8b, 0xcf, 0xd0, 0x8e, 0x49, 0x4e, 0x3b, 0x3e, 0x4f,
+	0x66, 0xd5, 0xac, 0x93, 0xcb, 0x5f, 0x2b, 0xf4, 0x5e, 0x89, 0x42, 0x5f, 0xb1, 0x14, 0xfa, 0xe0,
+	0x03, 0x1e, 0xd8, 0x34, 0x7a, 0xec, 0xa6, 0x31, 0xfc, 0xc6, 0x35, 0x46, 0xcf, 0xbe, 0xc6, 0x18,
+	0x88, 0x38, 0xf8, 0xcc, 0x70, 0x98, 0x38, 0x7f, 0x81, 0x4c, 0x29, 0xd6, 0xc4, 0x45, 0x93, 0x23,
+	0xd9, 0x85, 0xc2, 0x05, 0x87, 0x02, 0xe1, 0xc4, 0x72, 0x28, 0x27, 0x59, 0xcc, 0x7d, 0xd4, 0xdb,
+	0x7b, 0x1f, 0x7d, 0x9c, 0xcc, 0x98, 0xb9, 0x85, 0x16, 0x2e, 0xb7, 0xb3, 0xfc, 0x2c, 0xa7, 0x56,
+	0x72, 0xff, 0xed, 0xb9, 0xe7, 0x30, 0x84, 0x92, 0x5d, 0x74, 0xb7, 0x3d, 0x9b, 0x3c, 0xf8, 0x3b,
+	0x4f, 0xc4, 0x77, 0xd8, 0x23, 0x63, 0xf5, 0x87, 0x77, 0x4b, 0xfd, 0xe1, 0x3f, 0x4c, 0x08, 0x3f,
+	0xed, 0xa9, 0x57, 0xf4, 0x34, 0x1f, 0x99, 0xd1, 0xa2, 0x46, 0x4a, 0xff, 0x09, 0x32, 0x6b, 0x75,
+	0xa3, 0xe8, 0xff, 0x62, 0xe1, 0x6d, 0x27, 0xb7, 0xa7, 0x3f, 0xbf, 0xc5, 0xac, 0x11, 0xc1, 0x80,
+	0x1c, 0xb5, 0x92, 0x2b, 0x9b, 0x7e, 0xf9, 0xde, 0x63, 0xed, 0x26, 0x95, 0x5b, 0xde, 0x4d, 0x82,
+	0x17, 0x55, 0x1c, 0x44, 0x2e, 0x42, 0xfa, 0xe5, 0xc6, 0x41, 0x58, 0x93, 0xb7, 0x9a, 0x9f, 0xbc,
+	0x65, 0xe7, 0x9c, 0x4f, 0x7a, 0x8e, 0x50, 0x86, 0x1c, 0x67, 0x96, 0x15, 0xbc, 0x24, 0x86, 0xbb,
+	0x44, 0xe6, 0xc9, 0x9b, 0xc5, 0x15, 0xe3, 0x66, 0xf1, 0x7e, 0x4d, 0xe0, 0x17, 0x8b, 0xdb, 0xf1,
+	0xeb, 0x9e, 0x15, 0x03, 0x56, 0xcc, 0xa2, 0x15, 0xe5, 0xb0, 0x8c, 0x86, 0xa1, 0xb0, 0x1f, 0xa5,
+	0xbb, 0x2f, 0x7b, 0x56, 0xcf, 0x93, 0x69, 0xa3, 0x18, 0xd1, 0x3e, 0x13, 0x15, 0x3c, 0x4b, 0x4e,
+	0x98, 0x5a, 0x4f, 0xa6, 0x4e, 0x97, 0xa3, 0xf6, 0xd1, 0x6c, 0x99, 0xe6, 0x92, 0xcd, 0x14, 0x60,
+	0xd7, 0xf5, 0x0c, 0x39, 0x6c, 0x80, 0x6a, 0x2e, 0x3f, 0x62, 0x9f, 0x08, 0xee, 0xca, 0xaf, 0xfe,
+	0x6c, 0xa9, 0x3c, 0x3d, 0x6c, 0xde, 0x67, 0x63, 0xe9, 0xc6, 0x82, 0xcf, 0xe0, 0x25, 0x65, 0xf4,
+	0xcc, 0x85, 0xdc, 0xe6, 0x0c, 0x32, 0xf6, 0x23, 0x5f, 0x75, 0xeb, 0xf9, 0xab, 0xd4, 0xf4, 0x19,
+	0xa6, 0xf9, 0xe7, 0xaf, 0x6a, 0xd9, 0xe7, 0xaf, 0xca, 0xa6, 0xf1, 0xa7, 0x5c, 0xc6, 0xce, 0x1c,
+	0x7f, 0x7a, 0xec, 0xff, 0xcb, 0xe3, 0x0f, 0x84, 0xa1, 0x85, 0x62, 0x5d, 0x59, 0x28, 0xd6, 0xfd,
+	0x3b, 0x48, 0xa5, 0x93, 0x0a, 0xd9, 0x94, 0x79, 0x36, 0xac, 0xd2, 0x49, 0xe1, 0x91, 0x49, 0x61,
+	0x22, 0xaf, 0xda, 0xe7, 0xf1, 0xf5, 0x4e, 0xca, 0xd7, 0x7d, 0x22, 0x5f, 0xfe, 0x41, 0x20, 0xab,
+	0x26, 0xd6, 0x2c, 0xd3, 0x64, 0xb9, 0x9a, 0x78, 0xa2, 0x4b, 0xa6, 0x8d, 0x22, 0x1d, 0x6f, 0xc0,
+	0x9c, 0xb1, 0xdf, 0x6b, 0x29, 0x96, 0x3f, 0xc6, 0x33, 0x12, 0x9f, 0xae, 0x90, 0xb9, 0xec, 0xf3,
+	0x90, 0xb0, 0x6c, 0x19, 0x02, 0x3d, 0x71, 0xe9, 0x4c, 0x82, 0x20, 0x04, 0x99, 0xe1, 0xfb, 0x05,
+	0x53, 0x9f, 0x46, 0xc0, 0xdc, 0x1d, 0x8d, 0x95, 0x1a, 0x87, 0xdf, 0xfe, 0x1d, 0xa4, 0x3a, 0x4e,
+	0xa5, 0xfd, 0x7d, 0xda, 0xe8, 0x1f, 0x0a, 0x78, 0x28, 0x70, 0x63, 0x27, 0x8e, 0x61, 0x5c, 0x78,
+	0x28, 0x5a, 0x9d, 0x6a, 0x04, 0x48, 0xc0, 0x71, 0xcc, 0x38, 0x91, 0xdf, 0x96, 0x53, 0x30, 0xb4,
+	0x3f, 0x89, 0x37, 0x84, 0xca, 0x0c, 0x9f, 0x50, 0x7d, 0x8f, 0x25, 0xa9, 0xd0, 0x43, 0xf0, 0x1b,
+	0x0e, 0x9e, 0x1b, 0x5b, 0x6c, 0x63, 0x7b, 0x79, 0x34, 0xbc, 0xd6, 0x8f, 0x36, 0x52, 0xa1, 0x84,
+	0xd8, 0x48, 0x58, 0xb4, 0xa1, 0x7a, 0xb3, 0xac, 0x87, 0xaa, 0x48, 0x8d, 0x9a, 0xa8, 0xe0, 0xe7,
+	0x3d, 0xd7, 0x7d, 0x13, 0xff, 0x4d, 0xa2, 0x3f, 0x0c, 0xdb, 0x41, 0xe1, 0xa3, 0x9b, 0x3a, 0x65,
+	0xd9, 0x09, 0xf5, 0xd3, 0xf6, 0x09, 0x35, 0x5f, 0xa7, 0x9e, 0xb5, 0xc0, 0x53, 0xfe, 0xae, 0xcb,
+	0x2b, 0xc0, 0xd3, 0x67, 0x6c, 0x9e, 0xf2, 0x75, 0x5a, 0x7e, 0x1c, 0xd7, 0x3d, 0x9b, 0xfd, 0x2e,
+	0xac, 0x93, 0x64, 0x0a, 0x77, 0x7c, 0x58, 0xb3, 0x62, 0x3a, 0x69, 0x84, 0xf5, 0x8c, 0x9e, 0xa7,
+	0x1f, 0x0b, 0x2c, 0x33, 0x8c, 0xff, 0x86, 0xcb, 0x30, 0x6e, 0xb1, 0xa8, 0xdb, 0x90, 0xba, 0x6e,
+	0x04, 0xd9, 0x8b, 0xa2, 0x62, 0x2c, 0x8a, 0xb2, 0x9e, 0xfb, 0x4d, 0xbb, 0xe7, 0xf2, 0xc5, 0xea,
+	0x5a, 0xff, 0xc3, 0xdb, 0xe3, 0xc2, 0x51, 0xe1, 0x13, 0x36, 0xb7, 0x60, 0xb3, 0x72, 0x66, 0x2c,
+	0x0d, 0x00, 0xf2, 0x49, 0x6d, 0x68, 0xf8, 0xd2, 0xe0, 0x7b, 0x61, 0xb5, 0xb8, 0xa1, 0xbf, 0xc5,
+	0x1b, 0x7a, 0x8f, 0x1d, 0x67, 0xe2, 0x6e, 0x88, 0x6e, 0xf3, 0xd7, 0xbc, 0xd2, 0x1b, 0x54, 0x7b,
+	0x69, 0x40, 0xb1, 0xe5, 0x79, 0xe1, 0x10, 0x8c, 0x53, 0x2f, 0x1e, 0x8d, 0x17, 0xfb, 0x7d, 0xe1,
+	0x35, 0x90, 0x60, 0x59, 0x48, 0xef, 0x67, 0x39, 0xfb, 0x81, 0x19, 0xb8, 0xbf, 0x17, 0xf3, 0xcf,
+	0x96, 0x5d, 0xee, 0x2a, 0x53, 0x4e, 0x7e, 0xdb, 0x56, 0x4e, 0x8a, 0x0b, 0xd1, 0x75, 0x7d, 0xc4,
+	0x2b, 0xb8, 0x29, 0x66, 0x28, 0x4d, 0x9e, 0xa5, 0x34, 0x9d, 0x22, 0x24, 0xd6, 0x77, 0x36, 0xf8,
+	0xf3, 0x41, 0x06, 0xa6, 0x2c, 0xee, 0xe5, 0x77, 0x3c, 0x57, 0xcc, 0x90, 0x5d, 0xaf, 0x66, 0xed,
+	0x07, 0xde, 0x2d, 0xde, 0x54, 0x2b, 0x64, 0xb5, 0xc8, 0x87, 0x26, 0x34, 0x6e, 0xd8, 0x5a, 0xf8,
+	0x06, 0x5b, 0xa5, 0x1a, 0xb1, 0x70, 0xb5, 0xb8, 0x01, 0x9f, 0xe3, 0x0d, 0x78, 0x9d, 0xee, 0xe0,
+	0xbd, 0xb9, 0xd3, 0x0d, 0xfa, 0x94, 0xb7, 0xf7, 0x7d, 0xba, 0xfd, 0x99, 0x3f, 0xcb, 0x82, 0x21,
+	0x7e, 0xd7, 0x0e, 0x86, 0xd8, 0xab, 0x62, 0x53, 0x4a, 0xb9, 0xee, 0xf3, 0x41, 0x67, 0x32, 0xbc,
+	0x4e, 0x23, 0x0c, 0xa5, 0x02, 0x2a, 0x93, 0x8d, 0xbf, 0x67, 0xcb, 0x46, 0x47, 0xa9, 0xb9, 0x5a,
+	0x33, 0x97, 0x05, 0x5f, 0x4e, 0xad, 0xbf, 0x9f, 0xaf, 0x35, 0x53, 0xaa, 0xae, 0xf5, 0xe7, 0x3c,
+	0xe7, 0x55, 0x44, 0x78, 0x91, 0x50, 0x3f, 0x77, 0x20, 0x86, 0xc2, 0xf1, 0x0e, 0x82, 0x91, 0xa8,
+	0x8c, 0xa3, 0xcf, 0xdb, 0x1c, 0x39, 0x2a, 0xd4, 0x1c, 0xf5, 0x1d, 0x57, 0x20, 0x9d, 0x41, 0x47,
+	0x25, 0x9e, 0xe9, 0x2f, 0xd8, 0x9e, 0xe9, 0x5c, 0x79, 0xba, 0xb6, 0x17, 0xbd, 0xbd, 0xae, 0x56,
+	0xee, 0x7b, 0x71, 0x19, 0xef, 0x7e, 0x54, 0xad, 0x77, 0x3f, 0x16, 0x3a, 0xc5, 0x1c, 0xff, 0x01,
+	0xe7, 0xf8, 0xde, 0xc2, 0x85, 0x65, 0xb2, 0xa4, 0xd9, 0xbf, 0x59, 0x70, 0xe9, 0xb3, 0xe8, 0x29,
+	0xa3, 0x32, 0xe1, 0xf4, 0x45, 0x5b, 0x38, 0x39, 0xcb, 0xd5, 0x35, 0xbf, 0xcb, 0x79, 0xa7, 0xb4,
+	0x6c, 0x12, 0x7c, 0xc9, 0x9e, 0x04, 0x8e, 0xdc, 0xba, 0xf4, 0xf7, 0x7b, 0x45, 0x37, 0x53, 0x73,
+	0xfa, 0xce, 0x01, 0xa5, 0xef, 0x40, 0xfc, 0x46, 0xa9, 0x95, 0xfc, 0x0f, 0x6d, 0x2b, 0xb9, 0xbb,
+	0x02, 0xcd, 0xc4, 0xc7, 0xbc, 0xb2, 0x7b, 0xae, 0xfb, 0x9d, 0x17, 0x65, 0xfb, 0xd6, 0x97, 0x73,
+	0xfb, 0x56, 0x41, 0xa5, 0x9a, 0xb9, 0x6d, 0x72, 0x28, 0x77, 0xaa, 0x71, 0x1e, 0x71, 0xf3, 0x77,
+	0x03, 0x79, 0x84, 0xb8, 0xe3, 0x49, 0x5b, 0xb1, 0x89, 0x25, 0x22, 0xd4, 0x40, 0xc1, 0xc1, 0x15,
+	0x32, 0x97, 0x65, 0xc8, 0x5f, 0xca, 0xe3, 0xc4, 0xa1, 0xb7, 0xc8, 0xe4, 0x95, 0x4b, 0x0f, 0xc3,
+	0x5c, 0x7a, 0x53, 0xd8, 0x8a, 0x92, 0x15, 0xcf, 0x43, 0x97, 0xf9, 0x71, 0xbe, 0x62, 0xfb, 0x71,
+	0xca, 0x8a, 0xd6, 0x3d, 0xf9, 0x45, 0xaf, 0xfc, 0x32, 0xf2, 0xbe, 0xaf, 0x7e, 0xa9, 0x47, 0xf7,
+	0xaa, 0xc6, 0xa3, 0x7b, 0x65, 0x6c, 0x7f, 0xd5, 0x73, 0xdc, 0xfa, 0x73, 0x33, 0xa3, 0xd9, 0x7e,
+	0xbe, 0xf8, 0x82, 0xb4, 0xb3, 0xdb, 0x4a, 0x62, 0xca, 0xbe, 0x66, 0xc7, 0x94, 0x15, 0x15, 0x6b,
+	0xad, 0x8c, 0xd2, 0xfb, 0xd7, 0xfe, 0xfd, 0xa4, 0xb1, 0x7c, 0x19, 0x4f, 0x93, 0xd2, 0x12, 0xa2,
+	0xea, 0xe4, 0x68, 0xaa, 0xe8, 0x65, 0x1d, 0xf3, 0x47, 0x99, 0x8e, 0x29, 0xa9, 0x52, 0x33, 0xf7,
+	0x36, 0x32, 0x29, 0xca, 0x76, 0xae, 0x87, 0xcc, 0xe3, 0x87, 0xdc, 0xa0, 0x6d, 0xa2, 0x82, 0x0f,
+	0x7a, 0x7b, 0xdd, 0x1d, 0x77, 0x76, 0x70, 0x89, 0x74, 0x7f, 0x31, 0x27, 0xdd, 0x4b, 0x0a, 0xb7,
+	0x05, 0x50, 0xf1, 0x05, 0xf5, 0xfd, 0xde, 0x3c, 0x28, 0x13, 0x40, 0x5f, 0xf7, 0x72, 0x37, 0x3b,
+	0xf7, 0x9a, 0x7f, 0xfd, 0xd2, 0xcb, 0xf1, 0x65, 0x47, 0x82, 0x6f, 0xd8, 0x47, 0x82, 0x92, 0x52,
+	0x74, 0x6d, 0x9f, 0xf4, 0xf6, 0xb8, 0x6a, 0x0f, 0x62, 0x37, 0x41, 0x04, 0x4e, 0xb8, 0x1a, 0x15,
+	0x10, 0x6c, 0xc7, 0xdc, 0xeb, 0xc5, 0xad, 0xc7, 0x35, 0x2a, 0xc1, 0xb2, 0x43, 0xd7, 0x1f, 0xdb,
+	0x87, 0xae, 0xd2, 0x9a, 0xcd, 0x0b, 0x43, 0xf9, 0xbb, 0xfe, 0x66, 0xfd, 0x9e, 0x5d, 0x7f, 0x89,
+	0x02, 0xf3, 0x27, 0xd9, 0xd0, 0xba, 0x4c, 0xa9, 0xba, 0xce, 0xbf, 0xf7, 0x8a, 0x5f, 0x12, 0x80,
+	0xd9, 0xd0, 0xcb, 0x48, 0x2e, 0x09, 0x8b, 0x63, 0x0c, 0xb7, 0x5c, 0xf7, 0xc4, 0xfe, 0x69, 0x60,
+	0x20, 0xef, 0x80, 0xff, 0x25, 0x42, 0x4f, 0x5c, 0x4c, 0x57, 0xb0, 0xfe, 0x8b, 0x84, 0x5a, 0xd1,
+	0x5f, 0x24, 0x94, 0x89, 0x9b, 0x6f, 0xda, 0xe2, 0xa6, 0x88, 0x7b, 0xcb, 0x0f, 0x6a, 0x3e, 0x7d,
+	0x8d, 0xee, 0x28, 0xfe, 0x7f, 0x1c, 0x1e, 0x3f, 0x87, 0x0a, 0x10, 0xda, 0xb4, 0xb4, 0xb3, 0xb1,
+	0xcd, 0x52, 0x21, 0x93, 0xf1, 0xe9, 0x26, 0x8d, 0xc1, 0xdb, 0x1d, 0xdb, 0xe2, 0x3e, 0x6e, 0x65,
+	0x71, 0x1b, 0xe0, 0xee, 0xb6, 0x7c, 0x42, 0xbf, 0xbb, 0x0d, 0x6d, 0x3e, 0x3b, 0xec, 0x8d, 0x47,
+	0xd1, 0x50, 0xbe, 0xb8, 0xaa, 0x60, 0xa0, 0x2d, 0x85, 0x09, 0xeb, 0x84, 0xe9, 0x16, 0x5a, 0xcc,
+	0xa6, 0xa8, 0x82, 0x83, 0x8f, 0x56, 0x88, 0x19, 0xe5, 0xbb, 0x8c, 0x2f, 0xf0, 0x77, 0xd9, 0x30,
+	0x89, 0xd2, 0xe8, 0x3a, 0x13, 0x5c, 0x66, 0xd1, 0xc0, 0xed, 0xe2, 0x78, 0xcc, 0x86, 0x3d, 0x10,
+	0xb6, 0xc8, 0x6d, 0x83, 0x1a, 0x18, 0xd8, 0xb9, 0xaf, 0xc6, 0x51, 0xca, 0xd6, 0xb6, 0x62, 0x96,
+	0x6c, 0x8d, 0xfa, 0x3d, 0xb1, 0x2f, 0x67, 0xb0, 0x60, 0x89, 0xa3, 0x2c, 0xec, 0xe9, 0x64, 0x35,
+	0x4c, 0x66, 0x23, 0x81, 0x2f, 0xd0, 0x21, 0xc3, 0x4d, 0xb6, 0x1c, 0x8e, 0xc3, 0x0d, 0x30, 0x77,
+	0x73, 0xab, 0x60, 0x16, 0xad, 0x42, 0x46, 0x97, 0xb7, 0xc2, 0x58, 0x34, 0x55, 0x23, 0xf0, 0x09,
+	0xea, 0x54, 0x7a, 0x2e, 0xe1, 0x13, 0xd2, 0xaf, 0x85, 0x9b, 0x09, 0x26, 0x11, 0x97, 0x69, 0x34,
+	0x22, 0x78, 0x49, 0x4d, 0x50, 0x47, 0xa0, 0x84, 0x43, 0x99, 0xa3, 0x63, 0x21, 0xb8, 0x2a, 0x74,
+	0x0c, 0x95, 0xc9, 0x07, 0xe7, 0xe0, 0xe1, 0xd4, 0x24, 0x35, 0xc3, 0xad, 0x6b, 0xd6, 0xdf, 0x5e,
+	0xe4, 0xde, 0x2f, 0x28, 0x99, 0x81, 0x2f, 0xb9, 0x66, 0x60, 0x59, 0xc0, 0xc4, 0x2f, 0x7b, 0x64,
+	0x12, 0xe4, 0x28, 0x04, 0x43, 0xc1, 0x15, 0x94, 0xb1, 0x08, 0x90, 0xaa, 0xac, 0x8e, 0x61, 0x62,
+	0x0c, 0xd9, 0x0d, 0xe9, 0x6b, 0xc3, 0xfb, 0xdc, 0x12, 0xce, 0xff, 0x15, 0x0d, 0x7f, 0x25, 0xcc,
+	0x46, 0xa2, 0x3d, 0x9e, 0xa5, 0xab, 0x63, 0x6e, 0x8e, 0xe5, 0xa3, 0x67, 0x60, 0xd4, 0xb5, 0xc3,
+	0xfa, 0xbc, 0xe7, 0xbc, 0x76, 0x08, 0x1b, 0x85, 0xf3, 0x45, 0x90, 0xd2, 0xbb, 0x2d, 0xb6, 0x17,
+	0x40, 0x2c, 0x16, 0x8d, 0x29, 0x0b, 0x12, 0xf8, 0x96, 0x1d, 0x24, 0xe0, 0xaa, 0xda, 0xe9, 0xc9,
+	0x72, 0x3c, 0x4a, 0xf2, 0x43, 0x76, 0x65, 0x64, 0x1b, 0x51, 0xb2, 0xe7, 0x7d, 0xdb, 0xe9, 0xc9,
+	0x72, 0xb0, 0xa8, 0x9b, 0xf2, 0x59, 0xaf, 0xe4, 0x61, 0x16, 0x75, 0x9f, 0x8c, 0xbf, 0xce, 0x8e,
+	0xdf, 0x05, 0xff, 0x65, 0xa6, 0x63, 0xd3, 0xab, 0x66, 0x6c, 0x7a, 0xd9, 0x3d, 0x9a, 0xef, 0xd8,
+	0xf7, 0x68, 0x0a, 0xb9, 0xd0, 0xcc, 0x7e, 0xbf, 0x42, 0x1a, 0xf0, 0xcc, 0x8b, 0x34, 0x48, 0x26,
+	0xec, 0xb9, 0x1d, 0x36, 0xdc, 0x60, 0xc2, 0xb1, 0xa1, 0x60, 0xe0, 0xb1, 0x8f, 0xd1, 0x08, 0xe2,
+	0x29, 0x6c, 0x04, 0x00, 0x3b, 0x60, 0xf1, 0x26, 0x13, 0xc2, 0x9f, 0x03, 0xc0, 0x39, 0xbb, 0x99,
+	0xb2, 0x61, 0x2a, 0x0d, 0xc4, 0x1c, 0xc2, 0xd4, 0xf8, 0x8f, 0x46, 0x75, 0x7e, 0xe3, 0x0a, 0x01,
+	0x90, 0xd4, 0x89, 0xf0, 0x52, 0x4e, 0x20, 0x5e, 0x82, 0x20, 0x33, 0x7a, 0x2a, 0x12, 0x98, 0xcb,
+	0x12, 0x8d, 0x00, 0xea, 0x06, 0xce, 0xa9, 0xde, 0x22, 0x77, 0x3a, 0x54, 0xa9, 0x46, 0x40, 0xa9,
+	0x83, 0x88, 0x6b, 0x6f, 0xfc, 0xc9, 0x02, 0x09, 0x22, 0x45, 0xc4, 0xe2, 0x12, 0x41, 0xe1, 0x20,
+	0x9e, 0x6e, 0x46, 0x37, 0x78, 0x10, 0x2f, 0x7f, 0x9a, 0x40, 0xc1, 0xb0, 0x48, 0xaf, 0x45, 0x7d,
+	0x06, 0xf1, 0xbe, 0x4b, 0xbb, 0xa0, 0xb1, 0xce, 0xf0, 0x45, 0x6a, 0x21, 0xe1, 0x3f, 0x85, 0x1c,
+	0x6f, 0xe7, 0xc0, 0x1f, 0xac, 0xc9, 0x4e, 0x96, 0xaa, 0xee, 0x41, 0x15, 0x68, 0xde, 0x17, 0x4e,
+	0x4c, 0x95, 0xa2, 0xcc, 0xa2, 0xfd, 0xa7, 0xb6, 0x45, 0x3b, 0x5f, 0x97, 0x1e, 0xda, 0x0f, 0x7a,
+	0xae, 0x07, 0x77, 0x50, 0x12, 0xc1, 0x8c, 0x90, 0x81, 0x37, 0x53, 0x54, 0xc1, 0xd9, 0xd7, 0x3c,
+	0xcb, 0x18, 0xf9, 0xae, 0xcd, 0x48, 0xbe, 0x22, 0xcb, 0x58, 0x93, 0x7b, 0xe0, 0x67, 0xbf, 0xc6,
+	0x9a, 0x3f, 0xf3, 0x1c, 0xd7, 0xe5, 0x8d, 0xf2, 0x74, 0x6d, 0x5b, 0xb9, 0x77, 0x83, 0x9c, 0x75,
+	0x2d, 0x16, 0xd7, 0xf5, 0x3d, 0x2f, 0x77, 0x5f, 0xde, 0x59, 0xd3, 0x7f, 0x7a, 0x7b, 0xbd, 0x46,
+	0xb4, 0xef, 0x7b, 0xbf, 0x7b, 0xdf, 0x5b, 0xb0, 0xee, 0xb4, 0xd7, 0x32, 0x77, 0xda, 0xa1, 0xbe,
+	0x16, 0x1b, 0xf2, 0x88, 0xa3, 0x06, 0xc5, 0xef, 0xb2, 0x23, 0xc7, 0x9f, 0xdb, 0x47, 0x8e, 0xf2,
+	0xc6, 0xe8, 0x86, 0xff, 0xa5, 0x57, 0xf4, 0xc4, 0xd2, 0xab, 0xdd, 0xe0, 0x32, 0x43, 0xce, 0xf7,
+	0x6d, 0x43, 0x8e, 0x9b, 0x61, 0xeb, 0xf0, 0xe0, 0x78, 0x1b, 0xaa, 0xf4, 0x3a, 0xbd, 0xf9, 0xef,
+	0x5c, 0xf2, 0x6e, 0x30, 0x18, 0x76, 0xb0, 0x26, 0xf1, 0x40, 0x84, 0x80, 0xca, 0x96, 0xd2, 0x5f,
+	0xe4, 0x2e, 0x77, 0x66, 0x98, 0xd0, 0x4c, 0xfe, 0x83, 0x57, 0xf2, 0x50, 0x55, 0xa9, 0x0d, 0xe2,
+	0xb4, 0xfb, 0xc9, 0x22, 0xc7, 0x5d, 0xfa, 0x37, 0x91, 0x86, 0x2c, 0x58, 0xf8, 0xd5, 0x4b, 0xfe,
+	0x55, 0x41, 0x25, 0x2d, 0xdb, 0x96, 0x7e, 0xe0, 0xda, 0x96, 0x1c, 0x0d, 0xb0, 0x2c, 0xce, 0x93,
+	0xf8, 0x8f, 0x78, 0xa3, 0x1b, 0x30, 0x01, 0x52, 0xf5, 0xd6, 0x8a, 0x08, 0x3b, 0x53, 0x08, 0xe3,
+	0x44, 0x27, 0x0c, 0x69, 0x1c, 0x82, 0xbe, 0xd8, 0x1a, 0x59, 0x16, 0x56, 0x05, 0xab, 0x88, 0xc7,
+	0x96, 0x78, 0x9c, 0x45, 0x40, 0x96, 0x68, 0xac, 0xdb, 0xa2, 0x31, 0xf8, 0x6b, 0x8f, 0x34, 0xd0,
+	0x73, 0x08, 0x2c, 0x49, 0x4f, 0xbb, 0xf8, 0x43, 0x52, 0xf8, 0xce, 0xfa, 0xe6, 0x21, 0xb7, 0x46,
+	0x80, 0x64, 0xed, 0xc9, 0xd8, 0xc1, 0x4a, 0x6f, 0x1d, 0x4a, 0x18, 0x83, 0x2f, 0x93, 0xc7, 0x0c,
+	0xe2, 0x37, 0x94, 0x90, 0xc4, 0x1b, 0x62, 0xcf, 0xe7, 0xe1, 0xad, 0x1a, 0x01, 0xd4, 0x5e, 0x92,
+	0x0a, 0x2a, 0x7f, 0xfa, 0x5f, 0x23, 0x6c, 0x47, 0x3e, 0xff, 0xaf, 0xb2, 0x02, 0x47, 0x7e, 0x83,
+	0x37, 0x4c, 0xc2, 0xc1, 0x33, 0xe4, 0xa0, 0x21, 0xbc, 0xe5, 0x7f, 0xc6, 0x0d, 0xf1, 0x6f, 0x0a,
+	0x6d, 0xab, 0x8c, 0x18, 0x10, 0xca, 0x89, 0xfe, 0x7d, 0x64, 0x82, 0xf1, 0xbf, 0xbb, 0xac, 0x58,
+	0x3b, 0x9a, 0xec, 0x25, 0x2a, 0xc8, 0x4b, 0xe4, 0x9d, 0x8d, 0x33, 0x67, 0x1e, 0x44, 0xe2, 0xff,
+	0x0d, 0x00, 0x1f, 0x02, 0x2f, 0xf0, 0xe7, 0x75, 0x00, 0x00,
 }
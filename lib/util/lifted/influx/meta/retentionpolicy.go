@@ -46,6 +46,8 @@ type RetentionPolicyInfo struct {
 	Subscriptions        []SubscriptionInfo
 	DownSamplePolicyInfo *DownSamplePolicyInfo
 	MarkDeleted          bool
+
+	PromMetadata map[string]map[string]PromMetricMetadata // {"metric_store": {"http_requests_total": PromMetricMetadata}}
 }
 
 // NewRetentionPolicyInfo returns a new instance of RetentionPolicyInfo
@@ -356,6 +358,8 @@ func (rpi *RetentionPolicyInfo) Marshal(snapshot bool) *proto2.RetentionPolicyIn
 		pb.DownSamplePolicyInfo = rpi.DownSamplePolicyInfo.Marshal()
 	}
 
+	pb.PromMetadata = rpi.marshalPromMetadata()
+
 	return pb
 }
 
@@ -423,6 +427,14 @@ func (rpi *RetentionPolicyInfo) unmarshal(pb *proto2.RetentionPolicyInfo) {
 		rpi.DownSamplePolicyInfo = &DownSamplePolicyInfo{}
 		rpi.DownSamplePolicyInfo.Unmarshal(pb.GetDownSamplePolicyInfo())
 	}
+
+	if len(pb.GetPromMetadata()) > 0 {
+		metadata := make([]PromMetricMetadata, len(pb.GetPromMetadata()))
+		for i, x := range pb.GetPromMetadata() {
+			metadata[i].unmarshal(x)
+		}
+		rpi.updatePromMetadata(metadata)
+	}
 }
 
 // Clone returns a deep copy of rpi.
@@ -455,6 +467,15 @@ func (rpi RetentionPolicyInfo) Clone() *RetentionPolicyInfo {
 			other.MstVersions[k] = *mstv.clone()
 		}
 	}
+	if rpi.PromMetadata != nil {
+		other.PromMetadata = make(map[string]map[string]PromMetricMetadata, len(rpi.PromMetadata))
+		for k, mst := range rpi.PromMetadata {
+			other.PromMetadata[k] = make(map[string]PromMetricMetadata, len(mst))
+			for name, m := range mst {
+				other.PromMetadata[k][name] = m
+			}
+		}
+	}
 	return &other
 }
 
@@ -0,0 +1,264 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sort"
+
+	originql "github.com/influxdata/influxql"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// MeasurementGrants holds the privileges granted on measurements and the deny rules of a user or role.
+type MeasurementGrants struct {
+	// Map of database name to the privileges granted on its measurements.
+	MeasurementPrivileges map[string]map[string]originql.Privilege
+
+	// Map of database name to the privileges denied on its measurements.
+	// The empty measurement name denies the privilege on the whole database.
+	Denies map[string]map[string]originql.Privilege
+}
+
+// RoleInfo represents a named set of privileges which can be assigned to users.
+type RoleInfo struct {
+	Name string
+
+	// Map of database name to granted privilege.
+	Privileges map[string]originql.Privilege
+
+	MeasurementGrants
+}
+
+// Grant is a privilege or deny rule of a user, which is shown by SHOW GRANTS.
+type Grant struct {
+	Database    string
+	Measurement string // empty for the privilege on the whole database
+	Privilege   originql.Privilege
+	Deny        bool
+	Role        string // the role the grant comes from, empty for the grant of the user itself
+}
+
+// privilegeCovers returns true if the granted privilege includes the required privilege.
+func privilegeCovers(granted, required originql.Privilege) bool {
+	return granted == required || granted == originql.AllPrivileges
+}
+
+// denyCovers returns true if the deny rule forbids the required privilege.
+func denyCovers(denied, required originql.Privilege) bool {
+	return denied&required != 0
+}
+
+func (g *MeasurementGrants) granted(privilege originql.Privilege, database, measurement string) bool {
+	p, ok := g.MeasurementPrivileges[database][measurement]
+	return ok && privilegeCovers(p, privilege)
+}
+
+func (g *MeasurementGrants) denied(privilege originql.Privilege, database, measurement string) bool {
+	return denyCovers(g.Denies[database][measurement], privilege)
+}
+
+// deniedAny returns true if the privilege is denied on the database or any of its measurements.
+func (g *MeasurementGrants) deniedAny(privilege originql.Privilege, database string) bool {
+	for _, p := range g.Denies[database] {
+		if denyCovers(p, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *MeasurementGrants) set(database, measurement string, p originql.Privilege, deny bool) {
+	if deny {
+		g.Denies = setMeasurementPrivilege(g.Denies, database, measurement, p)
+		return
+	}
+	g.MeasurementPrivileges = setMeasurementPrivilege(g.MeasurementPrivileges, database, measurement, p)
+}
+
+// revoke removes the privilege from both the grants and the deny rules on the measurement.
+func (g *MeasurementGrants) revoke(database, measurement string, p originql.Privilege) {
+	if granted, ok := g.MeasurementPrivileges[database][measurement]; ok {
+		g.MeasurementPrivileges = setMeasurementPrivilege(g.MeasurementPrivileges, database, measurement, granted&^p)
+	}
+	if denied, ok := g.Denies[database][measurement]; ok {
+		g.Denies = setMeasurementPrivilege(g.Denies, database, measurement, denied&^p)
+	}
+}
+
+// dropDatabase removes all the grants and deny rules on the database.
+func (g *MeasurementGrants) dropDatabase(database string) {
+	delete(g.MeasurementPrivileges, database)
+	delete(g.Denies, database)
+}
+
+// grants returns the grants and deny rules sorted by database and measurement.
+func (g *MeasurementGrants) grants(role string) []Grant {
+	var grants []Grant
+	for _, deny := range []bool{false, true} {
+		privs := g.MeasurementPrivileges
+		if deny {
+			privs = g.Denies
+		}
+		for db, msts := range privs {
+			for mst, p := range msts {
+				grants = append(grants, Grant{Database: db, Measurement: mst, Privilege: p, Deny: deny, Role: role})
+			}
+		}
+	}
+	sortGrants(grants)
+	return grants
+}
+
+func (g MeasurementGrants) clone() MeasurementGrants {
+	return MeasurementGrants{
+		MeasurementPrivileges: cloneMeasurementPrivileges(g.MeasurementPrivileges),
+		Denies:                cloneMeasurementPrivileges(g.Denies),
+	}
+}
+
+func (g *MeasurementGrants) marshal() ([]*proto2.MeasurementPrivilege, []*proto2.MeasurementPrivilege) {
+	return marshalMeasurementPrivileges(g.MeasurementPrivileges), marshalMeasurementPrivileges(g.Denies)
+}
+
+func (g *MeasurementGrants) unmarshal(privileges, denies []*proto2.MeasurementPrivilege) {
+	g.MeasurementPrivileges = unmarshalMeasurementPrivileges(privileges)
+	g.Denies = unmarshalMeasurementPrivileges(denies)
+}
+
+// setMeasurementPrivilege sets the privilege on the measurement, NoPrivileges removes it.
+func setMeasurementPrivilege(privs map[string]map[string]originql.Privilege, database, measurement string,
+	p originql.Privilege) map[string]map[string]originql.Privilege {
+	if p == originql.NoPrivileges {
+		delete(privs[database], measurement)
+		if len(privs[database]) == 0 {
+			delete(privs, database)
+		}
+		return privs
+	}
+
+	if privs == nil {
+		privs = make(map[string]map[string]originql.Privilege)
+	}
+	if privs[database] == nil {
+		privs[database] = make(map[string]originql.Privilege)
+	}
+	privs[database][measurement] = p
+	return privs
+}
+
+func cloneMeasurementPrivileges(privs map[string]map[string]originql.Privilege) map[string]map[string]originql.Privilege {
+	if privs == nil {
+		return nil
+	}
+	other := make(map[string]map[string]originql.Privilege, len(privs))
+	for db, msts := range privs {
+		other[db] = make(map[string]originql.Privilege, len(msts))
+		for mst, p := range msts {
+			other[db][mst] = p
+		}
+	}
+	return other
+}
+
+func marshalMeasurementPrivileges(privs map[string]map[string]originql.Privilege) []*proto2.MeasurementPrivilege {
+	var pb []*proto2.MeasurementPrivilege
+	for db, msts := range privs {
+		for mst, p := range msts {
+			pb = append(pb, &proto2.MeasurementPrivilege{
+				Database:    proto.String(db),
+				Measurement: proto.String(mst),
+				Privilege:   proto.Int32(int32(p)),
+			})
+		}
+	}
+	return pb
+}
+
+func unmarshalMeasurementPrivileges(pb []*proto2.MeasurementPrivilege) map[string]map[string]originql.Privilege {
+	var privs map[string]map[string]originql.Privilege
+	for _, p := range pb {
+		privs = setMeasurementPrivilege(privs, p.GetDatabase(), p.GetMeasurement(), originql.Privilege(p.GetPrivilege()))
+	}
+	return privs
+}
+
+func databaseGrants(privs map[string]originql.Privilege, role string) []Grant {
+	var grants []Grant
+	for db, p := range privs {
+		grants = append(grants, Grant{Database: db, Privilege: p, Role: role})
+	}
+	sortGrants(grants)
+	return grants
+}
+
+func sortGrants(grants []Grant) {
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].Deny != grants[j].Deny {
+			return !grants[i].Deny
+		}
+		if grants[i].Database != grants[j].Database {
+			return grants[i].Database < grants[j].Database
+		}
+		return grants[i].Measurement < grants[j].Measurement
+	})
+}
+
+// Grants returns the privileges and deny rules of the role.
+func (r *RoleInfo) Grants() []Grant {
+	return append(databaseGrants(r.Privileges, r.Name), r.MeasurementGrants.grants(r.Name)...)
+}
+
+// clone returns a deep copy of the role.
+func (r *RoleInfo) clone() *RoleInfo {
+	other := &RoleInfo{
+		Name:              r.Name,
+		MeasurementGrants: r.MeasurementGrants.clone(),
+	}
+	if r.Privileges != nil {
+		other.Privileges = make(map[string]originql.Privilege, len(r.Privileges))
+		for k, v := range r.Privileges {
+			other.Privileges[k] = v
+		}
+	}
+	return other
+}
+
+// marshal serializes to a protobuf representation.
+func (r *RoleInfo) marshal() *proto2.RoleInfo {
+	pb := &proto2.RoleInfo{
+		Name: proto.String(r.Name),
+	}
+	for database, privilege := range r.Privileges {
+		pb.Privileges = append(pb.Privileges, &proto2.UserPrivilege{
+			Database:  proto.String(database),
+			Privilege: proto.Int32(int32(privilege)),
+		})
+	}
+	pb.MeasurementPrivileges, pb.Denies = r.MeasurementGrants.marshal()
+	return pb
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (r *RoleInfo) unmarshal(pb *proto2.RoleInfo) {
+	r.Name = pb.GetName()
+	if len(pb.GetPrivileges()) > 0 {
+		r.Privileges = make(map[string]originql.Privilege, len(pb.GetPrivileges()))
+	}
+	for _, p := range pb.GetPrivileges() {
+		r.Privileges[p.GetDatabase()] = originql.Privilege(p.GetPrivilege())
+	}
+	r.MeasurementGrants.unmarshal(pb.GetMeasurementPrivileges(), pb.GetDenies())
+}
@@ -0,0 +1,222 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func newRoleTestData(t *testing.T) *Data {
+	data := &Data{PtNumPerNode: 1}
+	data.Databases = map[string]*DatabaseInfo{
+		"db0": {Name: "db0"},
+		"db1": {Name: "db1"},
+	}
+	require.NoError(t, data.CreateUser("admin", "xxxxhashxxxx", true, false))
+	require.NoError(t, data.CreateUser("user1", "xxxxhashxxxx", false, false))
+	return data
+}
+
+func TestData_RoleCmd(t *testing.T) {
+	data := newRoleTestData(t)
+	require.EqualError(t, data.CreateRole(""), ErrRoleNameRequired.Error())
+	require.EqualError(t, data.CreateRole("user1"), ErrRoleNameConflict.Error())
+	require.NoError(t, data.CreateRole("reader"))
+	require.EqualError(t, data.CreateRole("reader"), ErrRoleExists.Error())
+	require.EqualError(t, data.CreateUser("reader", "xxxxhashxxxx", false, false), ErrRoleNameConflict.Error())
+
+	require.EqualError(t, data.SetUserRole("user_notfound", "reader", false), ErrUserNotFound.Error())
+	require.EqualError(t, data.SetUserRole("user1", "role_notfound", false), ErrRoleNotFound.Error())
+	require.NoError(t, data.SetUserRole("user1", "reader", false))
+	require.NoError(t, data.SetUserRole("user1", "reader", false))
+	require.Equal(t, []string{"reader"}, data.GetUser("user1").Roles)
+
+	require.EqualError(t, data.SetMeasurementPrivilege("role_notfound", "db0", "cpu", originql.ReadPrivilege, false), ErrUserNotFound.Error())
+	require.EqualError(t, data.SetMeasurementPrivilege("reader", "db_notfound", "cpu", originql.ReadPrivilege, false), errno.NewError(errno.DatabaseNotFound, "db_notfound").Error())
+	require.NoError(t, data.SetMeasurementPrivilege("reader", "db0", "cpu", originql.ReadPrivilege, false))
+	require.NoError(t, data.SetPrivilege("reader", "db1", originql.AllPrivileges))
+
+	user := data.GetUser("user1")
+	require.True(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "cpu"))
+	require.False(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "mem"))
+	require.False(t, user.AuthorizeDatabase(originql.ReadPrivilege, "db0"))
+	require.True(t, user.AuthorizeDatabase(originql.WritePrivilege, "db1"))
+
+	require.NoError(t, data.SetUserRole("user1", "reader", true))
+	require.False(t, data.GetUser("user1").AuthorizeMeasurement(originql.ReadPrivilege, "db0", "cpu"))
+
+	require.NoError(t, data.SetUserRole("user1", "reader", false))
+	require.EqualError(t, data.DropRole("role_notfound"), ErrRoleNotFound.Error())
+	require.NoError(t, data.DropRole("reader"))
+	require.Empty(t, data.GetUser("user1").Roles)
+	require.False(t, data.GetUser("user1").AuthorizeDatabase(originql.WritePrivilege, "db1"))
+}
+
+func TestUserInfo_AuthorizeMeasurement(t *testing.T) {
+	data := newRoleTestData(t)
+	require.NoError(t, data.CreateRole("writer"))
+	require.NoError(t, data.SetPrivilege("writer", "db0", originql.AllPrivileges))
+	require.NoError(t, data.SetUserRole("user1", "writer", false))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "secret", originql.ReadPrivilege, true))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db1", "cpu", originql.WritePrivilege, false))
+
+	user := data.GetUser("user1")
+	// the deny rule wins over the privilege granted by the role
+	require.False(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "secret"))
+	require.True(t, user.AuthorizeMeasurement(originql.WritePrivilege, "db0", "secret"))
+	require.True(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "cpu"))
+	require.True(t, user.AuthorizeDatabase(originql.ReadPrivilege, "db0"))
+	require.False(t, user.AuthorizeAllMeasurements(originql.ReadPrivilege, "db0"))
+	require.True(t, user.AuthorizeAllMeasurements(originql.WritePrivilege, "db0"))
+
+	require.True(t, user.AuthorizeMeasurement(originql.WritePrivilege, "db1", "cpu"))
+	require.False(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db1", "cpu"))
+	require.False(t, user.AuthorizeDatabase(originql.WritePrivilege, "db1"))
+	require.True(t, user.AuthorizeAnyMeasurement(originql.WritePrivilege, "db1"))
+	require.False(t, user.AuthorizeAnyMeasurement(originql.ReadPrivilege, "db1"))
+
+	// deny on the whole database
+	require.NoError(t, data.SetMeasurementPrivilege("writer", "db0", "", originql.WritePrivilege, true))
+	require.False(t, user.AuthorizeDatabase(originql.WritePrivilege, "db0"))
+	require.False(t, user.AuthorizeMeasurement(originql.WritePrivilege, "db0", "cpu"))
+	require.False(t, user.AuthorizeAnyMeasurement(originql.WritePrivilege, "db0"))
+	require.True(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "cpu"))
+
+	require.NoError(t, data.RevokePrivilege("writer", "db0", "", originql.WritePrivilege))
+	require.NoError(t, data.RevokePrivilege("user1", "db0", "secret", originql.AllPrivileges))
+	require.True(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "secret"))
+	require.False(t, user.AuthorizeMeasurement(originql.WritePrivilege, "db0", "secret"))
+	require.NoError(t, data.RevokePrivilege("writer", "db0", "", originql.ReadPrivilege))
+	require.False(t, user.AuthorizeMeasurement(originql.ReadPrivilege, "db0", "cpu"))
+	require.False(t, user.AuthorizeAnyMeasurement(originql.ReadPrivilege, "db0"))
+
+	data.DropDatabase("db1")
+	require.False(t, user.AuthorizeAnyMeasurement(originql.WritePrivilege, "db1"))
+}
+
+func TestUserInfo_AuthorizeQueryMeasurement(t *testing.T) {
+	data := newRoleTestData(t)
+	require.NoError(t, data.SetPrivilege("user1", "db0", originql.ReadPrivilege))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "secret", originql.ReadPrivilege, true))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db1", "cpu", originql.ReadPrivilege, false))
+	user := data.GetUser("user1")
+
+	for sql, authorized := range map[string]bool{
+		`SELECT * FROM db0..cpu`:                    true,
+		`SELECT * FROM db0..secret`:                 false,
+		`SELECT * FROM db0../.*/`:                   false,
+		`SELECT * FROM db1..cpu`:                    true,
+		`SELECT * FROM db1..mem`:                    false,
+		`SELECT * FROM db1../.*/`:                   false,
+		`SELECT * FROM (SELECT * FROM db0..secret)`: false,
+		`SELECT * INTO db1..cpu FROM db0..cpu`:      false,
+	} {
+		q, err := influxql.ParseQuery(sql)
+		require.NoError(t, err)
+		err = user.AuthorizeQuery("db0", q)
+		if authorized {
+			require.NoError(t, err, sql)
+			continue
+		}
+		require.Error(t, err, sql)
+		_, ok := err.(*ErrAuthorize)
+		require.True(t, ok, sql)
+	}
+}
+
+func TestUserInfo_AuthorizeShowStatements(t *testing.T) {
+	data := newRoleTestData(t)
+	require.NoError(t, data.SetPrivilege("user1", "db0", originql.ReadPrivilege))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "secret", originql.ReadPrivilege, true))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db1", "cpu", originql.ReadPrivilege, false))
+	user := data.GetUser("user1")
+
+	for sql, authorized := range map[string]bool{
+		// the measurement is denied
+		`SHOW SERIES ON db0 FROM secret`:                  false,
+		`SHOW TAG KEYS ON db0 FROM secret`:                false,
+		`SHOW TAG VALUES ON db0 FROM secret WITH KEY = a`: false,
+		`SHOW FIELD KEYS ON db0 FROM secret`:              false,
+		`SHOW TAG KEYS ON db0`:                            false,
+		`SHOW FIELD KEYS ON db0 FROM /.*/`:                false,
+		`SHOW TAG KEYS ON db0 FROM cpu`:                   true,
+		`SHOW MEASUREMENTS ON db0`:                        true,
+
+		// only the measurement is granted
+		`SHOW SERIES ON db1 FROM cpu`:                  true,
+		`SHOW TAG KEYS ON db1 FROM cpu`:                true,
+		`SHOW TAG VALUES ON db1 FROM cpu WITH KEY = a`: true,
+		`SHOW FIELD KEYS ON db1 FROM cpu`:              true,
+		`SHOW FIELD KEYS ON db1 FROM mem`:              false,
+		`SHOW SERIES ON db1`:                           false,
+		`SHOW MEASUREMENTS ON db1`:                     true,
+	} {
+		q, err := influxql.ParseQuery(sql)
+		require.NoError(t, err)
+		err = user.AuthorizeQuery("db0", q)
+		if authorized {
+			require.NoError(t, err, sql)
+			continue
+		}
+		require.Error(t, err, sql)
+	}
+
+	require.NoError(t, data.RevokePrivilege("user1", "db1", "cpu", originql.ReadPrivilege))
+	q, err := influxql.ParseQuery(`SHOW MEASUREMENTS ON db1`)
+	require.NoError(t, err)
+	require.Error(t, user.AuthorizeQuery("db0", q))
+}
+
+func TestData_GrantsMarshal(t *testing.T) {
+	data := newRoleTestData(t)
+	require.NoError(t, data.CreateRole("reader"))
+	require.NoError(t, data.SetPrivilege("reader", "db1", originql.ReadPrivilege))
+	require.NoError(t, data.SetMeasurementPrivilege("reader", "db0", "cpu", originql.ReadPrivilege, false))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "mem", originql.WritePrivilege, false))
+	require.NoError(t, data.SetMeasurementPrivilege("user1", "db0", "secret", originql.AllPrivileges, true))
+	require.NoError(t, data.SetUserRole("user1", "reader", false))
+
+	expected := []Grant{
+		{Database: "db0", Measurement: "mem", Privilege: originql.WritePrivilege},
+		{Database: "db0", Measurement: "secret", Privilege: originql.AllPrivileges, Deny: true},
+		{Database: "db1", Privilege: originql.ReadPrivilege, Role: "reader"},
+		{Database: "db0", Measurement: "cpu", Privilege: originql.ReadPrivilege, Role: "reader"},
+	}
+	grants, err := data.Grants("user1")
+	require.NoError(t, err)
+	require.Equal(t, expected, grants)
+	grants, err = data.Grants("reader")
+	require.NoError(t, err)
+	require.Equal(t, expected[2:], grants)
+	_, err = data.Grants("notfound")
+	require.EqualError(t, err, ErrUserNotFound.Error())
+
+	other := &Data{}
+	other.Unmarshal(data.Marshal(false))
+	grants, err = other.Grants("user1")
+	require.NoError(t, err)
+	require.Equal(t, expected, grants)
+	require.True(t, other.GetUser("user1").AuthorizeMeasurement(originql.ReadPrivilege, "db0", "cpu"))
+
+	clone := data.Clone()
+	require.NoError(t, clone.SetPrivilege("reader", "db0", originql.AllPrivileges))
+	require.True(t, clone.GetUser("user1").AuthorizeMeasurement(originql.WritePrivilege, "db0", "cpu"))
+	require.False(t, data.GetUser("user1").AuthorizeMeasurement(originql.WritePrivilege, "db0", "cpu"))
+}
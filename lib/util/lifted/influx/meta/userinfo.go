@@ -32,12 +32,23 @@ type UserInfo struct {
 
 	// Map of database name to granted privilege.
 	Privileges map[string]originql.Privilege
+
+	MeasurementGrants
+
+	// Names of the roles assigned to the user.
+	Roles []string
+
+	// roles are resolved from Roles by the Data which holds the user.
+	roles []*RoleInfo
 }
 
 type User interface {
 	// AuthorizeDatabase indicates whether the given Privilege is authorized on the database with the given name.
 	AuthorizeDatabase(p originql.Privilege, name string) bool
 
+	// AuthorizeMeasurement indicates whether the given Privilege is authorized on the measurement of the database.
+	AuthorizeMeasurement(p originql.Privilege, database, measurement string) bool
+
 	// AuthorizeQuery returns an error if the query cannot be executed
 	AuthorizeQuery(database string, query *influxql.Query) error
 
@@ -51,12 +62,101 @@ func (u *UserInfo) ID() string {
 }
 
 // AuthorizeDatabase returns true if the GetUser is authorized for the given privilege on the given database.
+// The privilege is granted to the user or its roles, and is not denied on the database.
 func (u *UserInfo) AuthorizeDatabase(privilege originql.Privilege, database string) bool {
 	if u.Admin || u.Rwuser || privilege == originql.NoPrivileges {
 		return true
 	}
-	p, ok := u.Privileges[database]
-	return ok && (p == privilege || p == originql.AllPrivileges)
+	if u.anyGrants(func(_ map[string]originql.Privilege, g *MeasurementGrants) bool {
+		return g.denied(privilege, database, "")
+	}) {
+		return false
+	}
+	return u.anyGrants(func(privs map[string]originql.Privilege, _ *MeasurementGrants) bool {
+		p, ok := privs[database]
+		return ok && privilegeCovers(p, privilege)
+	})
+}
+
+// AuthorizeMeasurement returns true if the GetUser is authorized for the given privilege on the measurement.
+// The privilege is granted on the database or the measurement, and the deny rules on either of them win.
+func (u *UserInfo) AuthorizeMeasurement(privilege originql.Privilege, database, measurement string) bool {
+	if u.Admin || u.Rwuser || privilege == originql.NoPrivileges {
+		return true
+	}
+	if u.anyGrants(func(_ map[string]originql.Privilege, g *MeasurementGrants) bool {
+		return g.denied(privilege, database, "") || g.denied(privilege, database, measurement)
+	}) {
+		return false
+	}
+	return u.anyGrants(func(privs map[string]originql.Privilege, g *MeasurementGrants) bool {
+		p, ok := privs[database]
+		return (ok && privilegeCovers(p, privilege)) || g.granted(privilege, database, measurement)
+	})
+}
+
+// AuthorizeAllMeasurements returns true if the GetUser is authorized for the given privilege on every
+// measurement of the database, which is required by the measurement regex.
+func (u *UserInfo) AuthorizeAllMeasurements(privilege originql.Privilege, database string) bool {
+	if !u.AuthorizeDatabase(privilege, database) {
+		return false
+	}
+	return u.Admin || u.Rwuser || !u.anyGrants(func(_ map[string]originql.Privilege, g *MeasurementGrants) bool {
+		return g.deniedAny(privilege, database)
+	})
+}
+
+// AuthorizeAnyMeasurement returns true if the GetUser is authorized for the given privilege on the database
+// or on some of its measurements, each measurement must be checked by AuthorizeMeasurement later.
+func (u *UserInfo) AuthorizeAnyMeasurement(privilege originql.Privilege, database string) bool {
+	if u.AuthorizeDatabase(privilege, database) {
+		return true
+	}
+	if u.anyGrants(func(_ map[string]originql.Privilege, g *MeasurementGrants) bool {
+		return g.denied(privilege, database, "")
+	}) {
+		return false
+	}
+	return u.anyGrants(func(_ map[string]originql.Privilege, g *MeasurementGrants) bool {
+		for _, p := range g.MeasurementPrivileges[database] {
+			if privilegeCovers(p, privilege) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// anyGrants returns true if fn returns true for the grants of the user itself or any of its roles.
+func (u *UserInfo) anyGrants(fn func(privs map[string]originql.Privilege, g *MeasurementGrants) bool) bool {
+	if fn(u.Privileges, &u.MeasurementGrants) {
+		return true
+	}
+	for _, r := range u.roles {
+		if fn(r.Privileges, &r.MeasurementGrants) {
+			return true
+		}
+	}
+	return false
+}
+
+// Grants returns the privileges and deny rules of the user, including the ones from its roles.
+func (u *UserInfo) Grants() []Grant {
+	grants := append(databaseGrants(u.Privileges, ""), u.MeasurementGrants.grants("")...)
+	for _, r := range u.roles {
+		grants = append(grants, r.Grants()...)
+	}
+	return grants
+}
+
+// HasRole returns true if the role is assigned to the user.
+func (u *UserInfo) HasRole(role string) bool {
+	for _, name := range u.Roles {
+		if name == role {
+			return true
+		}
+	}
+	return false
 }
 
 func (u *UserInfo) IsOpen() bool {
@@ -88,6 +188,10 @@ func (u UserInfo) clone() UserInfo {
 			other.Privileges[k] = v
 		}
 	}
+	other.MeasurementGrants = u.MeasurementGrants.clone()
+	if u.Roles != nil {
+		other.Roles = append([]string{}, u.Roles...)
+	}
 
 	return other
 }
@@ -107,6 +211,8 @@ func (u UserInfo) marshal() *proto2.UserInfo {
 			Privilege: proto.Int32(int32(privilege)),
 		})
 	}
+	pb.MeasurementPrivileges, pb.Denies = u.MeasurementGrants.marshal()
+	pb.Roles = u.Roles
 
 	return pb
 }
@@ -125,4 +231,6 @@ func (u *UserInfo) unmarshal(pb *proto2.UserInfo) {
 	for _, p := range pb.GetPrivileges() {
 		u.Privileges[p.GetDatabase()] = originql.Privilege(p.GetPrivilege())
 	}
+	u.MeasurementGrants.unmarshal(pb.GetMeasurementPrivileges(), pb.GetDenies())
+	u.Roles = pb.GetRoles()
 }
@@ -34,6 +34,14 @@ func ValidMeasurementName(name string) bool {
 	return validName(name, unsupportedCharsInMstName)
 }
 
+// ValidSubscriptionName checks to see if the given name would be valid for subscription name
+func ValidSubscriptionName(name string) bool {
+	if name == "." || name == ".." {
+		return false
+	}
+	return validName(name, `/\`)
+}
+
 func validName(name string, unsupported string) bool {
 	for _, r := range name {
 		if !unicode.IsPrint(r) {
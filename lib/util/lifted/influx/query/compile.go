@@ -88,6 +88,11 @@ type compiledStatement struct {
 	// HasAuxiliaryFields is true when the function requires auxiliary fields.
 	HasAuxiliaryFields bool
 
+	// WindowCalls holds the window functions with the OVER clause, and WindowPartition
+	// holds the tags of their PARTITION BY.
+	WindowCalls     []*influxql.Call
+	WindowPartition map[string]struct{}
+
 	// Fields holds all of the fields that will be used.
 	Fields []*compiledField
 
@@ -215,6 +220,9 @@ func (c *compiledStatement) compile(stmt *influxql.SelectStatement) error {
 	if err := c.validateFields(); err != nil {
 		return err
 	}
+	if err := c.validateWindows(stmt); err != nil {
+		return err
+	}
 
 	if err := c.validateUnnestSource(); err != nil {
 		return err
@@ -232,6 +240,9 @@ func (c *compiledStatement) compile(stmt *influxql.SelectStatement) error {
 			}
 			stmt.SubQueryHasDifferentAscending = source.Statement.SubQueryHasDifferentAscending
 		case *influxql.Join:
+			if source.JoinType.IsAsof() && !c.Ascending {
+				return errors.New("asof join only supports ascending time order")
+			}
 			if lsrc, ok := source.LSrc.(*influxql.SubQuery); ok {
 				lsrc.Statement.OmitTime = true
 				if err := c.subquery(lsrc.Statement); err != nil {
@@ -291,6 +302,12 @@ func (c *compiledStatement) compileFields(stmt *influxql.SelectStatement) error
 			AllowWildcard: true,
 		}
 		c.Fields = append(c.Fields, field)
+		if call, ok := field.Field.Expr.(*influxql.Call); ok && IsWindowCall(call) {
+			if err := field.compileWindow(call); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := field.compileExpr(field.Field.Expr); err != nil {
 			return err
 		}
@@ -332,6 +349,10 @@ func (c *compiledField) compileExpr(expr influxql.Expr) error {
 		c.global.HasAuxiliaryFields = true
 		return nil
 	case *influxql.Call:
+		if IsWindowCall(expr) {
+			return fmt.Errorf("window function %s() must be the whole expression of a field", expr.Name)
+		}
+
 		if op.IsProjectOp(expr) {
 			return c.compileProjectOp(expr)
 		}
@@ -711,6 +732,10 @@ func (c *compiledField) compileAggregateOp(expr *influxql.Call) error {
 }
 
 func (c *compiledField) compileCall(expr *influxql.Call) error {
+	if IsWindowCall(expr) {
+		return fmt.Errorf("window function %s() must be the whole expression of a field", expr.Name)
+	}
+
 	if op.IsProjectOp(expr) {
 		return c.compileProjectOp(expr)
 	}
@@ -1120,6 +1145,9 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 	mapper := FieldMapper{FieldMapper: shards}
 	c.RewriteJoinSource()
 	c.RewriteBinOpSource()
+	if c.stmt.Union != nil {
+		c.stmt.Sources = influxql.MergeUnionSources(c.stmt.Sources, mapper)
+	}
 	stmt, err := c.stmt.RewriteFields(mapper, batchEn, false)
 	if err != nil {
 		shards.Close()
@@ -1131,6 +1159,12 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 		shards.Close()
 		return nil, err
 	}
+	if err := validateUnionTypes(stmt, mapper); err != nil {
+		shards.Close()
+		return nil, err
+	}
+	// The UNION is expanded into the subqueries, so the statement is displayed as it is executed.
+	stmt.Union = nil
 
 	// Determine base options for iterators.
 	opt, err := NewProcessorOptionsStmt(stmt, sopt)
@@ -159,6 +159,15 @@ func (a openAuthorizer) AuthorizeQuery(_ string, _ *influxql.Query) error { retu
 type RowsChan struct {
 	Rows    models.Rows // models.Rows of data
 	Partial bool        // is partial of rows
+	Schema  *RowsSchema // schema of the rows, nil if unknown
+}
+
+// RowsSchema is the schema of the rows of a select statement, which is known before any row is produced.
+// The clients which keep the data types, e.g. arrow flight, build their schema with it instead of the values.
+type RowsSchema struct {
+	Dimensions []string            // the sorted tag keys of the series
+	Columns    []string            // the columns of the rows, except time
+	Types      []influxql.DataType // the types of the columns
 }
 
 // ExecutionOptions contains the options for executing a query.
@@ -169,6 +178,10 @@ type ExecutionOptions struct {
 	// The retention policy the query is running against.
 	RetentionPolicy string
 
+	// The user running the query, empty if the authentication is disabled.
+	// It is used to assign the query to its resource group.
+	UserName string
+
 	// Authorizer handles series-level authorization
 	Authorizer FineAuthorizer
 
@@ -627,6 +640,7 @@ func (e *Executor) recover(query *influxql.Query, results chan *Result) {
 type Task struct {
 	query     string
 	database  string
+	group     string
 	status    TaskStatus
 	startTime time.Time
 	closing   chan struct{}
@@ -677,6 +691,21 @@ func (q *Task) close() {
 	q.mu.Unlock()
 }
 
+// setRunning sets the status of the queued task to running, the killed task is not changed.
+func (q *Task) setRunning() {
+	q.mu.Lock()
+	if q.status == QueuedTask {
+		q.status = RunningTask
+	}
+	q.mu.Unlock()
+}
+
+func (q *Task) getStatus() TaskStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.status
+}
+
 func (q *Task) kill() error {
 	q.mu.Lock()
 	if q.status == KilledTask {
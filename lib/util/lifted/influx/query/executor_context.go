@@ -101,6 +101,9 @@ func (ctx *ExecutionContext) Value(key interface{}) interface{} {
 	case monitorContextKey{}:
 		return ctx.task
 	}
+	if ctx.Context == nil {
+		return nil
+	}
 	return ctx.Context.Value(key)
 }
 
@@ -81,10 +81,11 @@ func (m FunctionTypeMapper) CallType(name string, args []influxql.DataType) (inf
 		"holt_winters", "holt_winters_with_fit",
 		"rate", "irate":
 		return influxql.Float, nil
-	case "elapsed", "absent":
+	case "elapsed", "absent", "row_number", "rank", "dense_rank":
 		return influxql.Integer, nil
 	case "percentile", "percentile_ogsketch", "percentile_approx", "histogram", "distinct", "top", "bottom",
-		"difference", "non_negative_difference", "mode", "spread", "sample", "cumulative_sum":
+		"difference", "non_negative_difference", "mode", "spread", "sample", "cumulative_sum",
+		"lag", "lead", "first_value", "last_value":
 		return args[0], nil
 	case "ogsketch_percentile":
 		return influxql.Float, nil
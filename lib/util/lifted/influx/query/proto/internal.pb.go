@@ -112,6 +112,7 @@ const (
 	LogicPlanType_LogicalJoin              LogicPlanType = 37
 	LogicPlanType_LogicalBinOp             LogicPlanType = 38
 	LogicPlanType_LogicalPromSubquery      LogicPlanType = 39
+	LogicPlanType_LogicalWindow            LogicPlanType = 40
 )
 
 // Enum value maps for LogicPlanType.
@@ -157,6 +158,7 @@ var (
 		37: "LogicalJoin",
 		38: "LogicalBinOp",
 		39: "LogicalPromSubquery",
+		40: "LogicalWindow",
 	}
 	LogicPlanType_value = map[string]int32{
 		"LogicalExchange":          0,
@@ -199,6 +201,7 @@ var (
 		"LogicalJoin":              37,
 		"LogicalBinOp":             38,
 		"LogicalPromSubquery":      39,
+		"LogicalWindow":            40,
 	}
 )
 
@@ -2287,7 +2290,7 @@ var file_internal_proto_rawDesc = []byte{
 	0x73, 0x2a, 0x34, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
 	0x54, 0x61, 0x67, 0x53, 0x65, 0x74, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e,
 	0x74, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
-	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x10, 0x02, 0x2a, 0xef, 0x06, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x10, 0x02, 0x2a, 0x82, 0x07, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
 	0x63, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x67,
 	0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x00, 0x12, 0x10,
 	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x01,
@@ -2342,8 +2345,10 @@ var file_internal_proto_rawDesc = []byte{
 	0x0b, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x10, 0x25, 0x12, 0x10,
 	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x69, 0x6e, 0x4f, 0x70, 0x10, 0x26,
 	0x12, 0x17, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x53,
-	0x75, 0x62, 0x71, 0x75, 0x65, 0x72, 0x79, 0x10, 0x27, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x62, 0x71, 0x75, 0x65, 0x72, 0x79, 0x10, 0x27, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x67,
+	0x69, 0x63, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x10, 0x28, 0x42, 0x0d, 0x5a, 0x0b,
+	0x2e, 0x2f, 0x3b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
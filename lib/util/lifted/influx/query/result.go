@@ -100,6 +100,9 @@ type Result struct {
 	Messages    []*Message
 	Partial     bool
 	Err         error
+
+	// Schema is the schema of the series of a select statement, it is not encoded.
+	Schema *RowsSchema
 }
 
 // MarshalJSON encodes the result into JSON.
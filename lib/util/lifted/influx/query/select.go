@@ -872,6 +872,54 @@ func validateTypes(stmt *influxql.SelectStatement) error {
 	return nil
 }
 
+// validateUnionTypes checks the column types of the UNION statements are compatible. The integer column
+// of a statement is cast to float if the same column of another statement is float.
+func validateUnionTypes(stmt *influxql.SelectStatement, mapper influxql.TypeMapper) error {
+	if stmt.Union == nil {
+		return nil
+	}
+	typmap := influxql.MultiTypeMapper(
+		mapper,
+		FunctionTypeMapper{},
+		MathTypeMapper{},
+		StringFunctionTypeMapper{},
+	)
+	for _, f := range stmt.Fields {
+		ref, ok := f.Expr.(*influxql.VarRef)
+		if !ok {
+			continue
+		}
+		for _, src := range stmt.Sources {
+			subquery, ok := src.(*influxql.SubQuery)
+			if !ok {
+				continue
+			}
+			i, expr := subquery.Statement.FieldExprByName(ref.Val)
+			if i < 0 {
+				continue
+			}
+			typ := influxql.EvalType(expr, subquery.Statement.Sources, typmap)
+			if typ == ref.Type || typ == influxql.Unknown {
+				continue
+			}
+			switch {
+			case ref.Type == influxql.Float && typ == influxql.Integer:
+				field := subquery.Statement.Fields[i]
+				field.Alias = field.Name()
+				field.Expr = &influxql.Call{Name: "cast_float64", Args: []influxql.Expr{field.Expr}}
+			case isStringType(ref.Type) && isStringType(typ):
+			default:
+				return fmt.Errorf("UNION column %s has incompatible types %s and %s", ref.Val, ref.Type, typ)
+			}
+		}
+	}
+	return nil
+}
+
+func isStringType(typ influxql.DataType) bool {
+	return typ == influxql.String || typ == influxql.Tag
+}
+
 type StmtBuilder interface {
 }
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/pingcap/failpoint"
@@ -40,6 +42,9 @@ const (
 	// KilledTask is set when the task is killed, but resources are still
 	// being used.
 	KilledTask
+
+	// QueuedTask is set when the task waits in the queue of its resource group.
+	QueuedTask
 )
 
 func (t TaskStatus) String() string {
@@ -48,6 +53,8 @@ func (t TaskStatus) String() string {
 		return "running"
 	case KilledTask:
 		return "killed"
+	case QueuedTask:
+		return "queued"
 	default:
 		return "unknown"
 	}
@@ -63,6 +70,8 @@ func (t *TaskStatus) UnmarshalJSON(data []byte) error {
 		*t = RunningTask
 	} else if bytes.Equal(data, []byte("killed")) {
 		*t = KilledTask
+	} else if bytes.Equal(data, []byte("queued")) {
+		*t = QueuedTask
 	} else if bytes.Equal(data, []byte("unknown")) {
 		*t = TaskStatus(0)
 	} else {
@@ -87,6 +96,9 @@ type TaskManager struct {
 	// Maximum number of concurrent queries.
 	MaxConcurrentQueries int
 
+	// Resource groups limit the queries of the assigned users and databases.
+	ResourceGroups *resourcegroup.Manager
+
 	// Logger to use for all logging.
 	// Defaults to discarding all log output.
 	Logger *logger.Logger
@@ -127,6 +139,10 @@ func (t *TaskManager) ExecuteStatement(stmt influxql.Statement, ctx *ExecutionCo
 		ctx.Send(&Result{
 			Series: rows,
 		}, seq)
+	case *influxql.ShowResourceGroupsStatement:
+		ctx.Send(&Result{
+			Series: t.executeShowResourceGroupsStatement(),
+		}, seq)
 	case *influxql.KillQueryStatement:
 		var messages []*Message
 		if ctx.ReadOnly {
@@ -168,15 +184,41 @@ func (t *TaskManager) executeShowQueriesStatement(q *influxql.ShowQueriesStateme
 			d = d - (d % time.Microsecond)
 		}
 
-		values = append(values, []interface{}{id, qi.query, qi.database, d.String(), qi.status.String()})
+		values = append(values, []interface{}{id, qi.query, qi.database, d.String(), qi.getStatus().String(), qi.group})
 	}
 
 	return []*models.Row{{
-		Columns: []string{"qid", "query", "database", "duration", "status"},
+		Columns: []string{"qid", "query", "database", "duration", "status", "resource_group"},
 		Values:  values,
 	}}, nil
 }
 
+func (t *TaskManager) executeShowResourceGroupsStatement() models.Rows {
+	groups := t.ResourceGroups.Groups()
+	values := make([][]interface{}, 0, len(groups))
+	for _, g := range groups {
+		stat := g.Stat()
+		values = append(values, []interface{}{stat.Name, stat.MaxConcurrentQueries, stat.MaxQueuedQueries, int64(stat.MaxQueryMem),
+			stat.CPUShare, strings.Join(stat.Users, ","), strings.Join(stat.Databases, ","), stat.Running, stat.Queued, stat.MemUsed,
+			stat.Admitted, stat.Rejected, stat.MemExceeded})
+	}
+	return models.Rows{{
+		Columns: []string{"name", "max_concurrent_queries", "max_queued_queries", "max_query_mem", "cpu_share", "users", "databases",
+			"running", "queued", "mem_used", "admitted", "rejected", "mem_exceeded"},
+		Values: values,
+	}}
+}
+
+// ResourceGroupOf returns the resource group of the query attached to the TaskManager, empty if it has no group
+func (t *TaskManager) ResourceGroupOf(qid uint64) string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if query := t.queries[qid]; query != nil {
+		return query.group
+	}
+	return ""
+}
+
 func (t *TaskManager) queryError(qid uint64, err error) {
 	t.mu.RLock()
 	query := t.queries[qid]
@@ -211,6 +253,18 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		return nil, nil, err
 	}
 
+	// the statements managing the queries, such as SHOW QUERIES and KILL QUERY, are not limited by the resource groups
+	var group *resourcegroup.Group
+	var groupName string
+	status := RunningTask
+	if needsAdmission(q) {
+		group = t.ResourceGroups.Match(opt.UserName, opt.Database)
+	}
+	if group != nil {
+		groupName = group.Name()
+		status = QueuedTask
+	}
+
 	queries := make([]*Task, 0, len(q.Statements))
 	qids := make([]uint64, 0, len(q.Statements))
 	for i := 0; i < len(q.Statements); i++ {
@@ -218,7 +272,8 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		qids = append(qids, qid)
 		query := &Task{
 			database:  opt.Database,
-			status:    RunningTask,
+			group:     groupName,
+			status:    status,
 			startTime: time.Now(),
 			closing:   make(chan struct{}),
 			monitorCh: make(chan error),
@@ -248,9 +303,23 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		}
 	}
 
+	// the queued query is shown by SHOW QUERIES and waits until it is admitted, killed or interrupted
+	var memTracker *resourcegroup.MemTracker
+	if group != nil {
+		if err := group.Acquire(abortOnClose(interrupt, queries), t.QueryTimeout); err != nil {
+			t.DetachQuery(qids)
+			return nil, nil, err
+		}
+		for _, query := range queries {
+			query.setRunning()
+		}
+		memTracker = group.NewMemTracker()
+	}
+
 	qCtx := context.Background()
 	qCtx = context.WithValue(qCtx, QueryIDKey, qids)
 	qCtx = context.WithValue(qCtx, QueryDurationKey, qStat)
+	qCtx = resourcegroup.NewContextWithMemTracker(qCtx, memTracker)
 	ctx := &ExecutionContext{
 		Context:          qCtx,
 		QueryID:          qids,
@@ -258,7 +327,13 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		ExecutionOptions: opt,
 	}
 	ctx.watch()
-	return ctx, func() { t.DetachQuery(qids) }, nil
+	return ctx, func() {
+		t.DetachQuery(qids)
+		if group != nil {
+			memTracker.Close()
+			group.Release()
+		}
+	}, nil
 }
 
 // KillQuery enters a query into the killed state and closes the channel
@@ -293,6 +368,37 @@ func (t *TaskManager) DetachQuery(qids []uint64) {
 	}
 }
 
+// needsAdmission returns true if the query reads the data, which is limited by its resource group.
+func needsAdmission(q *influxql.Query) bool {
+	for _, stmt := range q.Statements {
+		switch stmt.(type) {
+		case *influxql.SelectStatement, *influxql.ExplainStatement:
+			return true
+		}
+	}
+	return false
+}
+
+// abortOnClose returns a channel closed when the interrupt or the closing channel of any of the tasks is closed.
+func abortOnClose(interrupt <-chan struct{}, queries []*Task) <-chan struct{} {
+	abort := make(chan struct{})
+	var once sync.Once
+	abortFn := func(closing <-chan struct{}) {
+		select {
+		case <-closing:
+			once.Do(func() { close(abort) })
+		case <-abort:
+		}
+	}
+	if interrupt != nil {
+		go abortFn(interrupt)
+	}
+	for _, query := range queries {
+		go abortFn(query.closing)
+	}
+	return abort
+}
+
 func (t *TaskManager) tryRegisterQueryIDOffset() error {
 	// Ensure that only one goroutine can register.
 	// And if registration is failed, return the error directly.
@@ -314,11 +420,12 @@ func (t *TaskManager) tryRegisterQueryIDOffset() error {
 
 // QueryInfo represents the information for a query.
 type QueryInfo struct {
-	ID       uint64        `json:"id"`
-	Query    string        `json:"query"`
-	Database string        `json:"database"`
-	Duration time.Duration `json:"duration"`
-	Status   TaskStatus    `json:"status"`
+	ID            uint64        `json:"id"`
+	Query         string        `json:"query"`
+	Database      string        `json:"database"`
+	Duration      time.Duration `json:"duration"`
+	Status        TaskStatus    `json:"status"`
+	ResourceGroup string        `json:"resource_group,omitempty"`
 }
 
 // Queries returns a list of all running queries with information about them.
@@ -330,11 +437,12 @@ func (t *TaskManager) Queries() []QueryInfo {
 	queries := make([]QueryInfo, 0, len(t.queries))
 	for id, qi := range t.queries {
 		queries = append(queries, QueryInfo{
-			ID:       id,
-			Query:    qi.query,
-			Database: qi.database,
-			Duration: now.Sub(qi.startTime),
-			Status:   qi.status,
+			ID:            id,
+			Query:         qi.query,
+			Database:      qi.database,
+			Duration:      now.Sub(qi.startTime),
+			Status:        qi.getStatus(),
+			ResourceGroup: qi.group,
 		})
 	}
 	return queries
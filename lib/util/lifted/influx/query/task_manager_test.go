@@ -1,14 +1,18 @@
 package query
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/influxdata/influxdb/pkg/testing/assert"
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/resourcegroup"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
 func TestTaskManager_AssignQueryID(t1 *testing.T) {
@@ -140,3 +144,78 @@ func TestTaskManager_tryRegisterQueryIDOffset(t1 *testing.T) {
 	assert.Equal(t1, t.nextID, t.queryIDOffset+20)
 	assert.Equal(t1, t.queryIDOffset, uint64(100000))
 }
+
+func TestTaskManager_ResourceGroup(t1 *testing.T) {
+	t := NewTaskManager()
+	t.registerOnce = 1
+	t.ResourceGroups = resourcegroup.NewManager(config.ResourceGroups{
+		{Name: "dashboard", MaxConcurrentQueries: 1, MaxQueuedQueries: 1, Users: []string{"grafana"}},
+	})
+	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
+	assert.NoError(t1, err)
+
+	ctx, detach, err := t.AttachQuery(q, ExecutionOptions{Database: "db0", UserName: "grafana"}, nil, nil)
+	assert.NoError(t1, err)
+	assert.Equal(t1, t.ResourceGroupOf(ctx.QueryID[0]), "dashboard")
+	tracker := resourcegroup.MemTrackerFromContext(ctx)
+	assert.Equal(t1, tracker.Group().Name(), "dashboard")
+	assert.NoError(t1, tracker.Grow(100))
+
+	// the running slot of the group is taken, the query waits until the interrupt
+	interrupt := make(chan struct{})
+	close(interrupt)
+	_, _, err = t.AttachQuery(q, ExecutionOptions{Database: "db0", UserName: "grafana"}, interrupt, nil)
+	assert.Equal(t1, errno.Equal(err, errno.QueryAborted), true)
+
+	// the queued query is shown by SHOW QUERIES and can be killed
+	errCh := make(chan error, 1)
+	go func() {
+		_, _, err := t.AttachQuery(q, ExecutionOptions{Database: "db0", UserName: "grafana"}, nil, nil)
+		errCh <- err
+	}()
+	var queued []interface{}
+	for i := 0; i < 500 && queued == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		rows, err := t.executeShowQueriesStatement(nil)
+		assert.NoError(t1, err)
+		for _, row := range rows[0].Values {
+			if row[4] == "queued" {
+				queued = row
+			}
+		}
+	}
+	assert.Equal(t1, len(queued) > 0, true)
+	assert.Equal(t1, queued[5], "dashboard")
+	assert.NoError(t1, t.KillQuery(queued[0].(uint64)))
+	assert.Equal(t1, errno.Equal(<-errCh, errno.QueryAborted), true)
+	assert.Equal(t1, t.ResourceGroupOf(queued[0].(uint64)), "")
+
+	// the statements managing the queries are not limited by the group
+	for _, stmt := range []string{`SHOW QUERIES`, fmt.Sprintf(`KILL QUERY %d`, ctx.QueryID[0])} {
+		mq, err := influxql.ParseQuery(stmt)
+		assert.NoError(t1, err)
+		mctx, mdetach, err := t.AttachQuery(mq, ExecutionOptions{Database: "db0", UserName: "grafana"}, nil, nil)
+		assert.NoError(t1, err, stmt)
+		assert.Equal(t1, t.ResourceGroupOf(mctx.QueryID[0]), "")
+		mdetach()
+	}
+
+	// the query of other users is not limited by the group
+	ctx2, detach2, err := t.AttachQuery(q, ExecutionOptions{Database: "db0", UserName: "admin"}, nil, nil)
+	assert.NoError(t1, err)
+	assert.Equal(t1, t.ResourceGroupOf(ctx2.QueryID[0]), "")
+	assert.Equal(t1, resourcegroup.MemTrackerFromContext(ctx2) == nil, true)
+	detach2()
+
+	rows := t.executeShowResourceGroupsStatement()
+	assert.Equal(t1, len(rows[0].Values), 1)
+	assert.Equal(t1, rows[0].Values[0][0], "dashboard")
+	assert.Equal(t1, rows[0].Values[0][7], 1)
+	assert.Equal(t1, rows[0].Values[0][9], int64(100))
+
+	detach()
+	assert.Equal(t1, t.ResourceGroupOf(ctx.QueryID[0]), "")
+	stat := t.ResourceGroups.Groups()[0].Stat()
+	assert.Equal(t1, stat.Running, 0)
+	assert.Equal(t1, stat.MemUsed, int64(0))
+}
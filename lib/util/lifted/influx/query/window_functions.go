@@ -0,0 +1,234 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+type windowFunction struct {
+	minArgs int
+	maxArgs int
+	// frame is true if the function is evaluated over the window frame,
+	// otherwise the frame of the window is not allowed.
+	frame bool
+}
+
+var windowFunctions = map[string]windowFunction{
+	"row_number":  {minArgs: 0, maxArgs: 0},
+	"rank":        {minArgs: 0, maxArgs: 0},
+	"dense_rank":  {minArgs: 0, maxArgs: 0},
+	"lag":         {minArgs: 1, maxArgs: 3},
+	"lead":        {minArgs: 1, maxArgs: 3},
+	"first_value": {minArgs: 1, maxArgs: 1, frame: true},
+	"last_value":  {minArgs: 1, maxArgs: 1, frame: true},
+	"count":       {minArgs: 1, maxArgs: 1, frame: true},
+	"sum":         {minArgs: 1, maxArgs: 1, frame: true},
+	"mean":        {minArgs: 1, maxArgs: 1, frame: true},
+	"min":         {minArgs: 1, maxArgs: 1, frame: true},
+	"max":         {minArgs: 1, maxArgs: 1, frame: true},
+}
+
+// IsWindowCall returns true if the call is a window function with the OVER clause.
+func IsWindowCall(call *influxql.Call) bool {
+	return call.Over != nil
+}
+
+// WindowFunctionType returns the data type of the result of the window function,
+// whose argument is the type of arg.
+func WindowFunctionType(name string, arg influxql.DataType) influxql.DataType {
+	switch name {
+	case "row_number", "rank", "dense_rank", "count":
+		return influxql.Integer
+	case "mean":
+		return influxql.Float
+	default:
+		return arg
+	}
+}
+
+// WindowFrame returns the frame of the window function. The default frame is from the start
+// of the partition to the last row having the same time as the current row if the rows
+// are ordered, otherwise the whole partition.
+func WindowFrame(w *influxql.Window) influxql.WindowFrame {
+	if w.Frame != nil {
+		return *w.Frame
+	}
+	frame := influxql.WindowFrame{
+		Range: true,
+		Start: influxql.WindowFrameBound{Type: influxql.UnboundedPreceding},
+		End:   influxql.WindowFrameBound{Type: influxql.UnboundedFollowing},
+	}
+	if w.OrderBy {
+		frame.End.Type = influxql.CurrentRow
+	}
+	return frame
+}
+
+// windowLookahead returns true if the window function needs the rows following the current row.
+func windowLookahead(call *influxql.Call) bool {
+	if call.Name == "lead" {
+		return true
+	}
+	if !windowFunctions[call.Name].frame {
+		return false
+	}
+	end := WindowFrame(call.Over).End.Type
+	return end == influxql.Following || end == influxql.UnboundedFollowing
+}
+
+// compileWindow validates the window function, which must be the whole expression of the field.
+func (c *compiledField) compileWindow(call *influxql.Call) error {
+	fn, ok := windowFunctions[call.Name]
+	if !ok {
+		return fmt.Errorf("%s() is not a window function", call.Name)
+	}
+	if got := len(call.Args); got < fn.minArgs || got > fn.maxArgs {
+		if fn.minArgs == fn.maxArgs {
+			return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", call.Name, fn.minArgs, got)
+		}
+		return fmt.Errorf("invalid number of arguments for %s, expected at least %d but no more than %d, got %d", call.Name, fn.minArgs, fn.maxArgs, got)
+	}
+
+	if call.Over.Frame != nil {
+		if !fn.frame {
+			return fmt.Errorf("window frame is not supported by %s()", call.Name)
+		}
+		if call.Over.Frame.Range && !call.Over.OrderBy && (call.Over.Frame.Start.Offset > 0 || call.Over.Frame.End.Offset > 0) {
+			return errors.New("RANGE window frame with offset requires ORDER BY time")
+		}
+	}
+	if call.Over.OrderBy && call.Over.Ascending != c.global.Ascending {
+		return errors.New("window ORDER BY time must be the same as the order of the query")
+	}
+
+	if call.Name == "lag" || call.Name == "lead" {
+		if len(call.Args) > 1 {
+			if n, ok := call.Args[1].(*influxql.IntegerLiteral); !ok || n.Val < 0 {
+				return fmt.Errorf("second argument of %s() must be a non-negative integer", call.Name)
+			}
+		}
+		if len(call.Args) > 2 {
+			if _, ok := call.Args[2].(influxql.Literal); !ok {
+				return fmt.Errorf("third argument of %s() must be a literal", call.Name)
+			}
+		}
+	}
+
+	if err := c.global.addWindow(call); err != nil {
+		return err
+	}
+	if len(call.Args) == 0 {
+		return nil
+	}
+	switch arg := call.Args[0].(type) {
+	case *influxql.VarRef, *influxql.Wildcard, *influxql.RegexLiteral:
+		return c.compileExpr(arg)
+	case *influxql.Call:
+		if arg.Over != nil {
+			return errors.New("window functions cannot be nested")
+		}
+		switch arg.Name {
+		case "top", "bottom", "distinct", "sample", "holt_winters", "holt_winters_with_fit", "sliding_window":
+			return fmt.Errorf("%s() cannot be used in window function %s()", arg.Name, call.Name)
+		}
+		return c.compileExpr(arg)
+	default:
+		return fmt.Errorf("expected field argument in %s()", call.Name)
+	}
+}
+
+// addWindow records the window of the statement. All the window functions must be partitioned
+// by the same tags.
+func (c *compiledStatement) addWindow(call *influxql.Call) error {
+	partition := make(map[string]struct{}, len(call.Over.Partition))
+	for _, key := range call.Over.Partition {
+		partition[key] = struct{}{}
+	}
+	if c.WindowCalls == nil {
+		c.WindowPartition = partition
+	} else if !samePartition(c.WindowPartition, partition) {
+		return errors.New("window functions must have the same PARTITION BY")
+	}
+	c.WindowCalls = append(c.WindowCalls, call)
+	return nil
+}
+
+func hasWindowArgs(calls []*influxql.Call) bool {
+	for _, call := range calls {
+		if len(call.Args) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func samePartition(a, b map[string]struct{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key := range a {
+		if _, ok := b[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// validateWindows groups the rows by the partition of the window functions. The rows of a partition are
+// the rows of a group, so the tags of PARTITION BY are added to GROUP BY, or they must be the same.
+func (c *compiledStatement) validateWindows(stmt *influxql.SelectStatement) error {
+	if len(c.WindowCalls) == 0 {
+		return nil
+	}
+	if len(c.WindowCalls) == len(c.Fields) && !hasWindowArgs(c.WindowCalls) {
+		return fmt.Errorf("at least one field must be queried with %s()", c.WindowCalls[0].Name)
+	}
+
+	// the rows are cut by LIMIT and OFFSET before the window functions are evaluated
+	if stmt.Offset > 0 {
+		return errors.New("window functions cannot be used with OFFSET")
+	}
+	if stmt.Limit > 0 {
+		for _, call := range c.WindowCalls {
+			if windowLookahead(call) {
+				return fmt.Errorf("window function %s() with the following rows cannot be used with LIMIT", call.Name)
+			}
+		}
+	}
+
+	tags := make(map[string]struct{})
+	for _, d := range stmt.Dimensions {
+		switch expr := d.Expr.(type) {
+		case *influxql.VarRef:
+			tags[expr.Val] = struct{}{}
+		case *influxql.Wildcard, *influxql.RegexLiteral:
+			return errors.New("window functions cannot be used with GROUP BY wildcard or regex")
+		}
+	}
+	if len(tags) == 0 {
+		for _, key := range c.WindowCalls[0].Over.Partition {
+			stmt.Dimensions = append(stmt.Dimensions, &influxql.Dimension{Expr: &influxql.VarRef{Val: key}})
+		}
+		return nil
+	}
+	if !samePartition(tags, c.WindowPartition) {
+		return errors.New("window PARTITION BY must be the same as the tags of GROUP BY")
+	}
+	return nil
+}
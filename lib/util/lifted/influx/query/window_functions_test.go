@@ -0,0 +1,73 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileWindow(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		dims string
+		err  string
+	}{
+		{s: `SELECT value, lag(value) OVER (PARTITION BY host ORDER BY time) FROM cpu`, dims: "host"},
+		{s: `SELECT row_number() OVER (PARTITION BY host ORDER BY time), value FROM cpu GROUP BY host`, dims: "host"},
+		{s: `SELECT sum(value) OVER (ORDER BY time ROWS BETWEEN 2 PRECEDING AND CURRENT ROW) FROM cpu LIMIT 10`},
+		{s: `SELECT mean(value) OVER (ORDER BY time DESC RANGE BETWEEN 1m PRECEDING AND CURRENT ROW) FROM cpu ORDER BY time DESC`},
+		{s: `SELECT lag(mean(value)) OVER (ORDER BY time) FROM cpu WHERE time >= now() - 1h GROUP BY time(1m)`},
+		{s: `SELECT row_number() OVER (ORDER BY time) FROM cpu`, err: `at least one field must be queried with row_number()`},
+		{s: `SELECT median(value) OVER (ORDER BY time) FROM cpu`, err: `median() is not a window function`},
+		{s: `SELECT lag(value, -1) OVER (ORDER BY time) FROM cpu`, err: `second argument of lag() must be a non-negative integer`},
+		{s: `SELECT lag(value) OVER (ORDER BY time ROWS 1 PRECEDING) FROM cpu`, err: `window frame is not supported by lag()`},
+		{s: `SELECT sum(value) OVER (RANGE BETWEEN 1m PRECEDING AND CURRENT ROW) FROM cpu`, err: `RANGE window frame with offset requires ORDER BY time`},
+		{s: `SELECT sum(value) OVER (ORDER BY time DESC) FROM cpu`, err: `window ORDER BY time must be the same as the order of the query`},
+		{s: `SELECT lag(value) OVER (PARTITION BY host), lead(value) OVER () FROM cpu`, err: `window functions must have the same PARTITION BY`},
+		{s: `SELECT lag(value) OVER (PARTITION BY host) FROM cpu GROUP BY region`, err: `window PARTITION BY must be the same as the tags of GROUP BY`},
+		{s: `SELECT lag(value) OVER () FROM cpu GROUP BY *`, err: `window functions cannot be used with GROUP BY wildcard or regex`},
+		{s: `SELECT lead(value) OVER (ORDER BY time) FROM cpu LIMIT 1`, err: `window function lead() with the following rows cannot be used with LIMIT`},
+		{s: `SELECT lag(value) OVER (ORDER BY time) FROM cpu OFFSET 1`, err: `window functions cannot be used with OFFSET`},
+		{s: `SELECT lag(value) OVER () + 1 FROM cpu`, err: `window function lag() must be the whole expression of a field`},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			stmt, err := influxql.ParseStatement(tt.s)
+			require.NoError(t, err)
+			s := stmt.(*influxql.SelectStatement)
+			_, err = query.Compile(s, query.CompileOptions{})
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			var dims []string
+			for _, d := range s.Dimensions {
+				if ref, ok := d.Expr.(*influxql.VarRef); ok {
+					dims = append(dims, ref.Val)
+				}
+			}
+			if tt.dims == "" {
+				assert.Empty(t, dims)
+			} else {
+				assert.Equal(t, []string{tt.dims}, dims)
+			}
+		})
+	}
+}
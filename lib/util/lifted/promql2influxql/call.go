@@ -368,12 +368,38 @@ func (t *Transpiler) transpilePromSubqueryFunc(subExpr *parser.SubqueryExpr, agg
 		}
 		subCall.Interval = interval
 		statement.PromSubCalls = append(statement.PromSubCalls, &subCall)
-		return statement, nil
+		return t.wrapPromSubqueryStatement(statement, subExpr.Offset), nil
 	default:
 		return nil, errno.NewError(errno.UnsupportedPromExpr)
 	}
 }
 
+// wrapPromSubqueryStatement wraps the statement evaluating a range function over a subquery
+// as an InfluxQL sub-query. The range function is evaluated after the fields of the statement,
+// so the outer expressions must not be pushed down into it, e.g. sum(max_over_time(rate(x[5m])[1h:1m])).
+// The time condition is shifted by the offset of the subquery, the offset gives the evaluation time back.
+func (t *Transpiler) wrapPromSubqueryStatement(statement *influxql.SelectStatement, offset time.Duration) *influxql.SelectStatement {
+	selectStatement := &influxql.SelectStatement{
+		Sources:     []influxql.Source{&influxql.SubQuery{Statement: statement}},
+		Step:        t.Step,
+		IsPromQuery: true,
+		QueryOffset: offset,
+	}
+	fieldExpr := &influxql.VarRef{Val: statement.Fields[len(statement.Fields)-1].Name()}
+	selectStatement.Fields = append(selectStatement.Fields, &influxql.Field{Expr: fieldExpr, Alias: DefaultFieldKey})
+	t.setTimeCondition(selectStatement)
+	if len(statement.Dimensions) > 0 {
+		selectStatement.Without = statement.Without
+		dims := statement.Dimensions
+		if _, ok := dims[len(dims)-1].Expr.(*influxql.Call); ok {
+			dims = dims[:len(dims)-1]
+		}
+		// the dimensions are copied, the aggregation over the statement rewrites them in place
+		selectStatement.Dimensions = append(make(influxql.Dimensions, 0, len(dims)), dims...)
+	}
+	return selectStatement
+}
+
 func (t *Transpiler) transpilePromFunc(aggFn aggregateFn, inArgs []influxql.Node, setFieldsFunc SetFieldsFunc) (influxql.Node, error) {
 	table, parameter := t.transpileParameter(aggFn.vectorPosition, inArgs)
 
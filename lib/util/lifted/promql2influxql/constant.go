@@ -26,3 +26,7 @@ const (
 )
 
 const DefaultLookBackDelta = 5 * time.Minute
+
+// DefaultSubqueryStep is the resolution of a subquery without step, e.g. rate(x[5m])[1h:].
+// It is the same as the default evaluation interval of Prometheus.
+const DefaultSubqueryStep = 1 * time.Minute
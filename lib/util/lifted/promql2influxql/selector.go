@@ -166,7 +166,7 @@ func (t *Transpiler) findStartEndTime(v *parser.VectorSelector) (startTime, endT
 		end = timestamp.FromTime(*t.End)
 	}
 
-	// TODO: to support the subquery
+	// findStartEndTime only serves meta queries, which never contain a subquery.
 	if start >= 0 && v.StartOrEnd == parser.START {
 		v.Timestamp = makeInt64Pointer(start)
 	}
@@ -23,6 +23,7 @@ type Transpiler struct {
 	minT, maxT        int64
 	timeCondition     influxql.Expr
 	isStepVariantExpr bool
+	inSubquery        bool
 }
 
 func (t *Transpiler) rewriteMinMaxTime() {
@@ -64,7 +65,6 @@ func (t *Transpiler) transpile(expr parser.Expr) (influxql.Node, error) {
 }
 
 // transpileExpr recursively transpile PromQL expression.
-// TODO It doesn't support PromQL SubqueryExpr and StepInvariantExpr yet.
 func (t *Transpiler) transpileExpr(expr parser.Expr) (influxql.Node, error) {
 	switch e := expr.(type) {
 	case *parser.ParenExpr:
@@ -110,7 +110,12 @@ func (t *Transpiler) setTimeInterval(statement *influxql.SelectStatement) {
 	if t.Start == nil || t.Step == 0 {
 		return
 	}
-	remain := t.Start.UnixNano() % t.Step.Nanoseconds()
+	start := t.Start.UnixNano()
+	if t.inSubquery {
+		// The evaluation steps of a subquery are aligned to its own start time instead of the query start time.
+		start = t.minT * int64(time.Millisecond)
+	}
+	remain := start % t.Step.Nanoseconds()
 	offset := time.Duration(remain) * time.Nanosecond
 	interval.Expr.(*influxql.Call).Args = append(
 		interval.Expr.(*influxql.Call).Args,
@@ -277,11 +282,12 @@ func (t *Transpiler) transpileSubqueryExpr(e *parser.SubqueryExpr) (influxql.Nod
 	preMinT := t.minT
 	preMaxT := t.maxT
 	preInterval := t.Step
+	preInSubquery := t.inSubquery
 
 	offsetMills := durationMilliseconds(e.Offset)
 	rangeMillis := durationMilliseconds(e.Range)
 	newEndTime := t.maxT - offsetMills
-	newInterval := rangeMillis
+	newInterval := durationMilliseconds(DefaultSubqueryStep)
 	if e.Step != 0 {
 		newInterval = durationMilliseconds(e.Step)
 	}
@@ -297,9 +303,10 @@ func (t *Transpiler) transpileSubqueryExpr(e *parser.SubqueryExpr) (influxql.Nod
 	}
 
 	t.minT, t.maxT, t.Step = newStartTime, newEndTime, time.Duration(newInterval*int64(time.Millisecond/time.Nanosecond))
+	t.inSubquery = true
 
 	node, err := t.transpileExpr(e.Expr)
-	t.minT, t.maxT, t.Step = preMinT, preMaxT, preInterval
+	t.minT, t.maxT, t.Step, t.inSubquery = preMinT, preMaxT, preInterval, preInSubquery
 	return node, err
 }
 
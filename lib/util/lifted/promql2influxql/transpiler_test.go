@@ -624,6 +624,76 @@ func Test_SubQueryAndBinOp(t1 *testing.T) {
 			},
 			want: "SELECT sum_over_time(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(10m) fill(none)",
 		},
+		{
+			name: "subquery function",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				expr: ParseExpr(`max_over_time(rate(go_gc_duration_seconds_count[5m])[1h:10m])`),
+			},
+			want: "SELECT value AS value FROM (SELECT rate_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(10m) fill(none)) WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *",
+		},
+		{
+			name: "subquery function with default step",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				expr: ParseExpr(`min_over_time(go_gc_duration_seconds_count[1h:])`),
+			},
+			want: "SELECT value AS value FROM (SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *",
+		},
+		{
+			name: "aggregate of subquery function",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				expr: ParseExpr(`sum by (job) (max_over_time(rate(go_gc_duration_seconds_count[5m])[1h:10m]))`),
+			},
+			want: "SELECT sum(value) AS value FROM (SELECT rate_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(10m) fill(none)) WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY job",
+		},
+		{
+			name: "binop of subquery function",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				expr: ParseExpr(`avg_over_time(go_gc_duration_seconds_count[1h:10m]) * 2`),
+			},
+			want: "SELECT value * 2 AS value FROM (SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *",
+		},
+		{
+			name: "nested subquery",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				expr: ParseExpr(`rate(sum_over_time(go_gc_duration_seconds_count[30m:10m])[1h:10m])`),
+			},
+			want: "SELECT value AS value FROM (SELECT value AS value FROM (SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:25:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) WHERE time >= '2023-01-06T05:25:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) WHERE time >= '2023-01-06T05:25:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *",
+		},
+		{
+			name: "subquery with offset",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				expr: ParseExpr(`sum_over_time(go_gc_duration_seconds_count[1h:10m] offset 3m)`),
+			},
+			want: "SELECT value AS value FROM (SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T06:57:00Z' GROUP BY *) WHERE time >= '2023-01-06T05:55:00Z' AND time <= '2023-01-06T06:57:00Z' GROUP BY *",
+		},
+		{
+			name: "aggregate of subquery function in range query",
+			fields: fields{
+				Start: &startTime2, End: &endTime2, Step: step,
+			},
+			args: args{
+				expr: ParseExpr(`sum(max_over_time(go_gc_duration_seconds_count[1h:10m]))`),
+			},
+			want: "SELECT sum(value) AS value FROM (SELECT value AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T02:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *) WHERE time >= '2023-01-06T02:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY time(1m, 0s) fill(none)",
+		},
 	}
 	for _, tt := range tests {
 		if tt.skip {
@@ -1460,3 +1530,33 @@ func TestPreprocessAndWrapWithStepInvariantExpr(t *testing.T) {
 		})
 	}
 }
+
+func Test_SubQueryPromSubCalls(t1 *testing.T) {
+	t := &Transpiler{
+		PromCommand: PromCommand{
+			Start:         &startTime2,
+			End:           &endTime2,
+			Step:          step,
+			LookBackDelta: DefaultLookBackDelta,
+		},
+	}
+	got, err := t.Transpile(ParseExpr(`quantile_over_time(0.9, rate(go_gc_duration_seconds_count[5m])[1h:10m] offset 3m)`))
+	require.NoError(t1, err)
+	outer, ok := got.(*influxql.SelectStatement)
+	require.True(t1, ok)
+	require.Equal(t1, 1, len(outer.Sources))
+	inner, ok := outer.Sources[0].(*influxql.SubQuery)
+	require.True(t1, ok)
+	require.Equal(t1, 1, len(inner.Statement.PromSubCalls))
+	// the results are reported at the evaluation time, not at the time shifted by the offset
+	require.Equal(t1, 3*time.Minute, outer.QueryOffset)
+
+	call := inner.Statement.PromSubCalls[0]
+	require.Equal(t1, "quantile_over_time_prom", call.Name)
+	require.Equal(t1, startTime2.UnixNano(), call.StartTime)
+	require.Equal(t1, endTime2.UnixNano(), call.EndTime)
+	require.Equal(t1, step.Nanoseconds(), call.Interval)
+	require.Equal(t1, time.Hour, call.Range)
+	require.Equal(t1, 3*time.Minute, call.Offset)
+	require.Equal(t1, []influxql.Expr{&influxql.NumberLiteral{Val: 0.9}}, call.InArgs)
+}
@@ -0,0 +1,176 @@
+package promremotewrite
+
+/*
+Copyright 2019-2021 VictoriaMetrics, Inc.
+This code is originally from: https://github.com/VictoriaMetrics/VictoriaMetrics/tree/v1.67.0/lib/protoparser/promremotewrite/streamparser.go
+
+2024.11.20 Parse the metric metadata of the remote write request.
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+*/
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/bytesutil"
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/lib/cpu"
+	prompb2 "github.com/prometheus/prometheus/prompb"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The maximum size in bytes of a single Prometheus remote_write API request
+const maxInsertRequestSize = 32 * 1024 * 1024
+
+// The field number of the metadata in prometheus.WriteRequest
+const metadataFieldNum = 3
+
+// ParseStream parses Prometheus remote_write message from reader and calls callback for the parsed timeseries
+// and the metric metadata.
+//
+// callback shouldn't hold tss and mms after returning.
+func ParseStream(r io.Reader, callback func(tss []prompb.TimeSeries, mms []prompb2.MetricMetadata) error) error {
+	ctx := getPushCtx(r)
+	defer putPushCtx(ctx)
+	if err := ctx.Read(); err != nil {
+		return err
+	}
+
+	// Synchronously process the request in order to properly return errors to ParseStream caller,
+	// so it could properly return HTTP 503 status code in response.
+	// See https://github.com/VictoriaMetrics/VictoriaMetrics/issues/896
+	bb := bodyBufferPool.Get()
+	defer bodyBufferPool.Put(bb)
+	var err error
+	bb.B, err = snappy.Decode(bb.B[:cap(bb.B)], ctx.reqBuf.B)
+	if err != nil {
+		return fmt.Errorf("cannot decompress request with length %d: %w", len(ctx.reqBuf.B), err)
+	}
+	if len(bb.B) > maxInsertRequestSize {
+		return fmt.Errorf("too big unpacked request; mustn't exceed `maxInsertRequestSize=%d` bytes; got %d bytes", maxInsertRequestSize, len(bb.B))
+	}
+	wr := getWriteRequest()
+	defer putWriteRequest(wr)
+	if err := wr.Unmarshal(bb.B); err != nil {
+		return fmt.Errorf("cannot unmarshal prompb.WriteRequest with size %d bytes: %w", len(bb.B), err)
+	}
+
+	// the metadata is skipped by prompb.WriteRequest, unmarshal it separately
+	ctx.mms, err = unmarshalMetadata(ctx.mms[:0], bb.B)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal prompb.MetricMetadata with size %d bytes: %w", len(bb.B), err)
+	}
+
+	if err := callback(wr.Timeseries, ctx.mms); err != nil {
+		return fmt.Errorf("error when processing imported data: %w", err)
+	}
+	return nil
+}
+
+// unmarshalMetadata appends the metric metadata of the marshaled prometheus.WriteRequest to dst.
+func unmarshalMetadata(dst []prompb2.MetricMetadata, src []byte) ([]prompb2.MetricMetadata, error) {
+	for len(src) > 0 {
+		num, typ, n := protowire.ConsumeTag(src)
+		if n < 0 {
+			return dst, protowire.ParseError(n)
+		}
+		src = src[n:]
+
+		if num != metadataFieldNum || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, src)
+			if n < 0 {
+				return dst, protowire.ParseError(n)
+			}
+			src = src[n:]
+			continue
+		}
+
+		b, n := protowire.ConsumeBytes(src)
+		if n < 0 {
+			return dst, protowire.ParseError(n)
+		}
+		src = src[n:]
+
+		dst = append(dst, prompb2.MetricMetadata{})
+		if err := dst[len(dst)-1].Unmarshal(b); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+var bodyBufferPool bytesutil.ByteBufferPool
+
+type pushCtx struct {
+	br     *bufio.Reader
+	reqBuf bytesutil.ByteBuffer
+	mms    []prompb2.MetricMetadata
+}
+
+func (ctx *pushCtx) reset() {
+	ctx.br.Reset(nil)
+	ctx.reqBuf.Reset()
+	for i := range ctx.mms {
+		ctx.mms[i] = prompb2.MetricMetadata{}
+	}
+	ctx.mms = ctx.mms[:0]
+}
+
+func (ctx *pushCtx) Read() error {
+	lr := io.LimitReader(ctx.br, int64(maxInsertRequestSize)+1)
+	reqLen, err := ctx.reqBuf.ReadFrom(lr)
+	if err != nil {
+		return fmt.Errorf("cannot read compressed request: %w", err)
+	}
+	if reqLen > int64(maxInsertRequestSize) {
+		return fmt.Errorf("too big packed request; mustn't exceed `maxInsertRequestSize=%d` bytes", maxInsertRequestSize)
+	}
+	return nil
+}
+
+func getPushCtx(r io.Reader) *pushCtx {
+	select {
+	case ctx := <-pushCtxPoolCh:
+		ctx.br.Reset(r)
+		return ctx
+	default:
+		if v := pushCtxPool.Get(); v != nil {
+			ctx := v.(*pushCtx)
+			ctx.br.Reset(r)
+			return ctx
+		}
+		return &pushCtx{
+			br: bufio.NewReaderSize(r, 64*1024),
+		}
+	}
+}
+
+func putPushCtx(ctx *pushCtx) {
+	ctx.reset()
+	select {
+	case pushCtxPoolCh <- ctx:
+	default:
+		pushCtxPool.Put(ctx)
+	}
+}
+
+var pushCtxPool sync.Pool
+var pushCtxPoolCh = make(chan *pushCtx, cpu.GetCpuNum())
+
+func getWriteRequest() *prompb.WriteRequest {
+	v := writeRequestPool.Get()
+	if v == nil {
+		return &prompb.WriteRequest{}
+	}
+	return v.(*prompb.WriteRequest)
+}
+
+func putWriteRequest(wr *prompb.WriteRequest) {
+	wr.Reset()
+	writeRequestPool.Put(wr)
+}
+
+var writeRequestPool sync.Pool
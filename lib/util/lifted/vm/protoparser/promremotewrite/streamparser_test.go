@@ -0,0 +1,71 @@
+package promremotewrite_test
+
+import (
+	"bytes"
+	"testing"
+
+	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/promremotewrite"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/require"
+)
+
+func marshalWriteRequest(t *testing.T, wr *prompb.WriteRequest) []byte {
+	b, err := wr.Marshal()
+	require.NoError(t, err)
+	return snappy.Encode(nil, b)
+}
+
+func TestParseStream(t *testing.T) {
+	wr := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "http_requests_total"}, {Name: "job", Value: "api"}},
+			Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}, {Value: 2, Timestamp: 2000}},
+		}},
+		Metadata: []prompb.MetricMetadata{
+			{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: "Total HTTP requests."},
+			{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "node_memory_bytes", Help: "Memory in use.", Unit: "bytes"},
+		},
+	}
+
+	var series, samples int
+	var mms []prompb.MetricMetadata
+	err := promremotewrite.ParseStream(bytes.NewReader(marshalWriteRequest(t, wr)), func(tss []prompb2.TimeSeries, metadata []prompb.MetricMetadata) error {
+		series = len(tss)
+		for _, ts := range tss {
+			samples += len(ts.Samples)
+		}
+		mms = append(mms, metadata...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, series)
+	require.Equal(t, 2, samples)
+	require.Equal(t, 2, len(mms))
+	require.Equal(t, prompb.MetricMetadata_COUNTER, mms[0].Type)
+	require.Equal(t, "http_requests_total", mms[0].MetricFamilyName)
+	require.Equal(t, "Total HTTP requests.", mms[0].Help)
+	require.Equal(t, "node_memory_bytes", mms[1].MetricFamilyName)
+	require.Equal(t, "bytes", mms[1].Unit)
+
+	// the metadata of the previous request should not be leaked into the pooled context
+	err = promremotewrite.ParseStream(bytes.NewReader(marshalWriteRequest(t, &prompb.WriteRequest{})), func(tss []prompb2.TimeSeries, metadata []prompb.MetricMetadata) error {
+		require.Equal(t, 0, len(tss))
+		require.Equal(t, 0, len(metadata))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestParseStreamError(t *testing.T) {
+	callback := func(tss []prompb2.TimeSeries, mms []prompb.MetricMetadata) error {
+		return nil
+	}
+	err := promremotewrite.ParseStream(bytes.NewReader([]byte("not snappy")), callback)
+	require.ErrorContains(t, err, "cannot decompress request")
+
+	// a metadata field with the truncated length
+	err = promremotewrite.ParseStream(bytes.NewReader(snappy.Encode(nil, []byte{0x1a, 0x10, 0x08})), callback)
+	require.Error(t, err)
+}
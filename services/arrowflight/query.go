@@ -0,0 +1,236 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowflight
+
+import (
+	"context"
+	json2 "encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultQueryChunkSize = 10000
+
+type QueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result
+}
+
+// QueryTicket is carried by the ticket of DoGet and by the command of the descriptor of GetFlightInfo.
+// The query must hold exactly one InfluxQL statement so that the results stream with a single schema.
+type QueryTicket struct {
+	DataBase        string `json:"db"`
+	RetentionPolicy string `json:"rp"`
+	Query           string `json:"query"`
+}
+
+type readServer struct {
+	QueryExecutor
+	authEnabled bool
+	client      FlightMetaClient
+	logger      *logger.Logger
+}
+
+func NewReadServer(logger *logger.Logger, authEnabled bool) *readServer {
+	return &readServer{authEnabled: authEnabled, logger: logger}
+}
+
+func (r *readServer) SetExecutor(executor QueryExecutor) {
+	r.QueryExecutor = executor
+}
+
+func (r *readServer) SetMetaClient(client FlightMetaClient) {
+	r.client = client
+}
+
+// GetFlightInfo validates the query of the command and returns the only endpoint which serves it,
+// the schema is left empty because it is only known once the query runs.
+func (r *readServer) GetFlightInfo(ctx context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if desc.GetType() != flight.DescriptorCMD {
+		return nil, status.Error(codes.InvalidArgument, "flight descriptor must be a command carrying the query ticket")
+	}
+	ticket, q, err := parseQueryTicket(desc.Cmd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.authorize(ctx, q, ticket.DataBase); err != nil {
+		return nil, err
+	}
+	return &flight.FlightInfo{
+		FlightDescriptor: desc,
+		Endpoint:         []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: desc.Cmd}}},
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+	}, nil
+}
+
+// DoGet runs the query of the ticket and streams every series of the results as an arrow record.
+func (r *readServer) DoGet(tkt *flight.Ticket, server flight.FlightService_DoGetServer) error {
+	ticket, q, err := parseQueryTicket(tkt.GetTicket())
+	if err != nil {
+		return err
+	}
+	ctx := server.Context()
+	authorizer, err := r.authorize(ctx, q, ticket.DataBase)
+	if err != nil {
+		return err
+	}
+	if r.QueryExecutor == nil {
+		return status.Error(codes.Unavailable, "arrow flight query is not available")
+	}
+
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	defer func(start time.Time) {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}(time.Now())
+
+	r.logger.Info("arrow flight DoGet starting", zap.String("db", ticket.DataBase), zap.String("rp", ticket.RetentionPolicy), zap.String("query", ticket.Query))
+	closing := make(chan struct{})
+	defer close(closing)
+	opts := query.ExecutionOptions{
+		Database:        ticket.DataBase,
+		RetentionPolicy: ticket.RetentionPolicy,
+		ChunkSize:       DefaultQueryChunkSize,
+		ReadOnly:        true,
+		Quiet:           true,
+		Authorizer:      authorizer,
+		AbortCh:         closing,
+	}
+	results := r.ExecuteQuery(q, opts, closing, nil)
+
+	// the schema of the stream is fixed by the first result: the schema the executor reports for the rows of
+	// the select statements, or the one inferred from the series of the results of the other statements
+	var wr *flight.Writer
+	var rs *executor.RowsArrowSchema
+	defer func() {
+		if wr != nil {
+			_ = wr.Close()
+		}
+	}()
+	for {
+		var result *query.Result
+		var ok bool
+		select {
+		case result, ok = <-results:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if !ok {
+			break
+		}
+		if result.Err != nil {
+			return status.Error(codes.InvalidArgument, result.Err.Error())
+		}
+		if rs == nil {
+			if result.Schema != nil {
+				rs = executor.NewRowsArrowSchema(result.Schema)
+			} else if len(result.Series) > 0 {
+				if rs, err = executor.InferRowsArrowSchema(result.Series); err != nil {
+					return status.Error(codes.Internal, err.Error())
+				}
+			} else {
+				continue
+			}
+			wr = flight.NewRecordWriter(server, ipc.WithSchema(rs.Schema), ipc.WithAllocator(memory.NewGoAllocator()))
+		}
+		if len(result.Series) == 0 {
+			continue
+		}
+
+		records, err := rs.Records(result.Series)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		err = writeRecords(wr, records)
+		if err != nil {
+			return err
+		}
+	}
+
+	if wr == nil {
+		// no series returned, the stream still carries an empty schema
+		wr = flight.NewRecordWriter(server, ipc.WithSchema(arrow.NewSchema(nil, nil)))
+	}
+	return nil
+}
+
+func writeRecords(wr *flight.Writer, records []arrow.Record) error {
+	var err error
+	for _, rec := range records {
+		if err == nil {
+			err = wr.Write(rec)
+		}
+		rec.Release()
+	}
+	return err
+}
+
+// authorize checks the query with the user of the auth token, the token must be issued for reads.
+func (r *readServer) authorize(ctx context.Context, q *influxql.Query, database string) (query.FineAuthorizer, error) {
+	if !r.authEnabled {
+		return query.OpenAuthorizer, nil
+	}
+	token, ok := flight.AuthFromContext(ctx).(*AuthToken)
+	if !ok || token.Privilege != AuthPrivilegeRead {
+		return nil, status.Error(codes.PermissionDenied, "auth token is not issued for reads")
+	}
+	u, err := r.client.User(token.Username)
+	if err != nil || u == nil {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("user %s not found", token.Username))
+	}
+	if err = u.AuthorizeQuery(database, q); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if u.AuthorizeUnrestricted() {
+		return query.OpenAuthorizer, nil
+	}
+	return u, nil
+}
+
+func parseQueryTicket(b []byte) (*QueryTicket, *influxql.Query, error) {
+	ticket := &QueryTicket{}
+	if err := json2.Unmarshal(b, ticket); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid query ticket: %s", err))
+	}
+
+	p := influxql.NewParser(strings.NewReader(ticket.Query))
+	defer p.Release()
+	YyParser := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "error parsing query: "+err.Error())
+	}
+	if len(q.Statements) != 1 {
+		return nil, nil, status.Error(codes.InvalidArgument, "query ticket must carry exactly one statement")
+	}
+	return ticket, q, nil
+}
@@ -48,6 +48,9 @@ const (
 	WriteAuthSuccess      string = "ArrowFlightWriteSuccessfully"
 	WriteAuthTokenSalty   int64  = 1e9
 	WriteAuthTokenTimeOut        = 24 * time.Hour
+
+	AuthPrivilegeRead  = "read"
+	AuthPrivilegeWrite = "write"
 )
 
 type RecordWriter interface {
@@ -70,6 +73,7 @@ type FlightMetaClient interface {
 type Service struct {
 	server           flight.Server
 	writer           *writeServer
+	reader           *readServer
 	authHandler      *authServer
 	Config           *config.Config
 	Logger           *logger.Logger
@@ -81,11 +85,20 @@ type Service struct {
 	RecordWriter interface {
 		RetryWriteRecord(database, retentionPolicy, measurement string, rec arrow.Record) error
 	}
+
+	QueryExecutor QueryExecutor
+}
+
+// flightServer serves DoPut with the writeServer, GetFlightInfo and DoGet with the readServer.
+type flightServer struct {
+	*writeServer
+	*readServer
 }
 
 func NewService(c config.Config) (*Service, error) {
 	sLogger := logger.NewLogger(errno.ModuleHTTP)
 	writer := NewWriteServer(sLogger)
+	reader := NewReadServer(sLogger, c.FlightAuthEnabled)
 	authHandler := NewAuthServer(c.FlightAuthEnabled)
 	var maxRecvMsgSize int
 	if c.MaxBodySize <= 0 {
@@ -95,8 +108,8 @@ func NewService(c config.Config) (*Service, error) {
 	}
 
 	server := flight.NewServerWithMiddleware(nil, grpc.MaxRecvMsgSize(maxRecvMsgSize))
-	writer.SetAuthHandler(authHandler)
-	server.RegisterFlightService(writer)
+	writer.SetAuthServer(authHandler)
+	server.RegisterFlightService(&flightServer{writeServer: writer, readServer: reader})
 	if err := server.Init(c.FlightAddress); err != nil {
 		sLogger.Error("arrow flight service start failed", zap.Error(err))
 		return nil, err
@@ -105,6 +118,7 @@ func NewService(c config.Config) (*Service, error) {
 	return &Service{
 		server:      server,
 		writer:      writer,
+		reader:      reader,
 		authHandler: authHandler,
 		err:         make(chan error),
 		Logger:      sLogger,
@@ -121,6 +135,10 @@ func (s *Service) Open() error {
 	}()
 	s.authHandler.SetMetaClient(s.MetaClient)
 	s.writer.SetWriter(s.RecordWriter)
+	s.reader.SetMetaClient(s.MetaClient)
+	if s.QueryExecutor != nil {
+		s.reader.SetExecutor(s.QueryExecutor)
+	}
 	return nil
 }
 
@@ -139,13 +157,19 @@ func (s *Service) Err() <-chan error {
 	return s.err
 }
 
+// AuthInfo is sent by the client in the handshake, Privilege is AuthPrivilegeRead for a token used by
+// GetFlightInfo and DoGet, otherwise the token is used by DoPut.
 type AuthInfo struct {
-	UserName string `json:"username"`
-	DataBase string `json:"db"`
+	UserName  string `json:"username"`
+	DataBase  string `json:"db"`
+	Privilege string `json:"privilege,omitempty"`
 }
 
+// AuthToken is issued for the database of the handshake, the measurements written with it are checked by DoPut.
 type AuthToken struct {
 	Username  string `json:"username"`
+	Database  string `json:"db"`
+	Privilege string `json:"privilege"`
 	Timestamp int64  `json:"timestamp"`
 	Salty     int64  `json:"salty"`
 }
@@ -195,7 +219,7 @@ func (a *authServer) Authenticate(c flight.AuthConn) error {
 		return status.Error(codes.FailedPrecondition, "error reading auth handshake")
 	}
 
-	// auth whether user has permission to write to the database.
+	// auth whether user has permission to write to or read from the database.
 	authInfo := &AuthInfo{}
 	err = json2.Unmarshal(in, authInfo)
 	if err != nil {
@@ -203,7 +227,13 @@ func (a *authServer) Authenticate(c flight.AuthConn) error {
 	}
 	username, database := authInfo.UserName, authInfo.DataBase
 	u, err := a.client.User(username)
-	if err != nil || u == nil || !u.AuthorizeDatabase(influxql.WritePrivilege, database) {
+	privilege := AuthPrivilegeWrite
+	if authInfo.Privilege == AuthPrivilegeRead {
+		privilege = AuthPrivilegeRead
+		if err != nil || u == nil || !u.AuthorizeDatabase(influxql.ReadPrivilege, database) {
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to read from %s", username, database))
+		}
+	} else if err != nil || u == nil || !authorizeWriteDatabase(u, database) {
 		return status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to write to %s", username, database))
 	}
 
@@ -212,7 +242,7 @@ func (a *authServer) Authenticate(c flight.AuthConn) error {
 	if err != nil {
 		return err
 	}
-	authToken := &AuthToken{Username: username, Timestamp: time.Now().UnixNano(), Salty: salty.Int64()}
+	authToken := &AuthToken{Username: username, Database: database, Privilege: privilege, Timestamp: time.Now().UnixNano(), Salty: salty.Int64()}
 	authHashID, err := HashAuthToken(authToken)
 	if err != nil {
 		return err
@@ -223,6 +253,28 @@ func (a *authServer) Authenticate(c flight.AuthConn) error {
 	return c.Send([]byte(authHashID))
 }
 
+// authorizeWriteDatabase returns true if the user has permission to write to the database or some of its measurements,
+// the measurement written is checked by authorizeWrite.
+func authorizeWriteDatabase(u meta.User, database string) bool {
+	if ui, ok := u.(*meta.UserInfo); ok && ui.AuthorizeAnyMeasurement(influxql.WritePrivilege, database) {
+		return true
+	}
+	return u.AuthorizeDatabase(influxql.WritePrivilege, database)
+}
+
+// authorizeWrite returns nil if the user of the token has permission to write to the measurement,
+// the token must be issued for the writes to the database.
+func (a *authServer) authorizeWrite(token *AuthToken, database, measurement string) error {
+	if token.Database != database {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("auth token is not issued for database %s", database))
+	}
+	u, err := a.client.User(token.Username)
+	if err != nil || u == nil || !u.AuthorizeMeasurement(influxql.WritePrivilege, database, measurement) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to write to %s", token.Username, influxql.QuoteIdent(database, measurement)))
+	}
+	return nil
+}
+
 func (a *authServer) IsValid(authHashID string) (interface{}, error) {
 	if !a.authEnabled {
 		return WriteAuthSuccess, nil
@@ -239,7 +291,7 @@ func (a *authServer) IsValid(authHashID string) (interface{}, error) {
 		a.mu.Unlock()
 		return "", status.Error(codes.PermissionDenied, "auth token time out")
 	}
-	return WriteAuthSuccess, nil
+	return token, nil
 }
 
 func (a *authServer) Close() {
@@ -257,6 +309,8 @@ type writeServer struct {
 	RecordWriter
 	mem    memory.Allocator
 	logger *logger.Logger
+	// auth checks the measurements written with the auth token
+	auth *authServer
 	flight.BaseFlightServer
 }
 
@@ -273,6 +327,11 @@ func (w *writeServer) SetWriter(writer RecordWriter) {
 	w.RecordWriter = writer
 }
 
+func (w *writeServer) SetAuthServer(auth *authServer) {
+	w.auth = auth
+	w.SetAuthHandler(auth)
+}
+
 func (w *writeServer) DoPut(server flight.FlightService_DoPutServer) error {
 	metaData := &MetaData{}
 	wr, err := flight.NewRecordReader(server, ipc.WithAllocator(memory.NewGoAllocator()))
@@ -288,11 +347,21 @@ func (w *writeServer) DoPut(server flight.FlightService_DoPutServer) error {
 		wr.Release()
 	}(time.Now())
 
+	token, authEnabled := flight.AuthFromContext(server.Context()).(*AuthToken)
+	if authEnabled && token.Privilege != AuthPrivilegeWrite {
+		return status.Error(codes.PermissionDenied, "auth token is not issued for writes")
+	}
+
 	err = json2.Unmarshal(util.Str2bytes(wr.LatestFlightDescriptor().Path[0]), metaData)
 	if err != nil {
 		w.logger.Error("arrow flight DoPut get metadata err", zap.Error(err))
 		return err
 	}
+	if authEnabled {
+		if err = w.auth.authorizeWrite(token, metaData.DataBase, metaData.Measurement); err != nil {
+			return err
+		}
+	}
 
 	w.logger.Info("arrow flight DoPut starting", zap.String("db", metaData.DataBase), zap.String("rp", metaData.RetentionPolicy), zap.String("mst", metaData.Measurement))
 	for wr.Next() {
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/array"
@@ -31,12 +32,16 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	influxql2 "github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/services"
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -78,6 +83,15 @@ func NewMockFlightMetaClient() *MockFlightMetaClient {
 			"xiaoming": &meta.UserInfo{
 				Admin:      true,
 				Privileges: map[string]influxql.Privilege{"db0": influxql.AllPrivileges}},
+			"xiaohong": &meta.UserInfo{
+				Privileges: map[string]influxql.Privilege{"db0": influxql.ReadPrivilege}},
+			"xiaogang": &meta.UserInfo{
+				Privileges: map[string]influxql.Privilege{"db0": influxql.WritePrivilege},
+				MeasurementGrants: meta.MeasurementGrants{
+					Denies: map[string]map[string]influxql.Privilege{"db0": {"secret": influxql.WritePrivilege}}}},
+			"xiaoli": &meta.UserInfo{
+				MeasurementGrants: meta.MeasurementGrants{
+					MeasurementPrivileges: map[string]map[string]influxql.Privilege{"db0": {"cpu": influxql.WritePrivilege}}}},
 		},
 	}
 
@@ -332,3 +346,217 @@ func TestArrowFlightServiceErr(t *testing.T) {
 	err = writer.DoPut(NewDoPutServer())
 	assert.Equal(t, err == io.EOF, true)
 }
+
+type MockQueryExecutor struct {
+	opt query.ExecutionOptions
+}
+
+func (e *MockQueryExecutor) ExecuteQuery(_ *influxql2.Query, opt query.ExecutionOptions, _ chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	e.opt = opt
+	results := make(chan *query.Result, 2)
+	results <- &query.Result{Series: models.Rows{
+		{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "value"},
+			Values: [][]interface{}{{time.Unix(0, 1), 1.5}, {time.Unix(0, 2), nil}}},
+		{Name: "cpu", Tags: map[string]string{"host": "b"}, Columns: []string{"time", "value"},
+			Values: [][]interface{}{{time.Unix(0, 3), 2.5}}},
+	}}
+	results <- &query.Result{}
+	close(results)
+	return results
+}
+
+// SchemaQueryExecutor returns the chunks of a select statement with the schema reported by the executor,
+// the first chunk has no value of msg and no region.
+type SchemaQueryExecutor struct{}
+
+func (e *SchemaQueryExecutor) ExecuteQuery(_ *influxql2.Query, _ query.ExecutionOptions, _ chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	schema := &query.RowsSchema{
+		Dimensions: []string{"host", "region"},
+		Columns:    []string{"value", "msg"},
+		Types:      []influxql2.DataType{influxql2.Float, influxql2.String},
+	}
+	results := make(chan *query.Result, 2)
+	results <- &query.Result{Schema: schema, Partial: true, Series: models.Rows{
+		{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "value", "msg"},
+			Values: [][]interface{}{{time.Unix(0, 1), 1.5, nil}}},
+	}}
+	results <- &query.Result{Schema: schema, Series: models.Rows{
+		{Name: "cpu", Tags: map[string]string{"host": "b", "region": "r1"}, Columns: []string{"time", "value", "msg"},
+			Values: [][]interface{}{{time.Unix(0, 2), nil, "x"}}},
+	}}
+	close(results)
+	return results
+}
+
+func newFlightClient(t *testing.T, addr string, authInfo string) flight.Client {
+	authClient := &clientAuth{authEnabled: true}
+	client, err := flight.NewFlightClient(addr, authClient, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	require.NoError(t, client.Authenticate(context.WithValue(context.Background(), Token, []byte(authInfo))))
+	return client
+}
+
+func TestArrowFlightService_DoGet(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:0",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	executor := &MockQueryExecutor{}
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = executor
+	require.NoError(t, service.Open())
+	defer service.Close()
+	addr := service.GetServer().Addr().String()
+
+	ctx := context.Background()
+	cmd := []byte(`{"db": "db0", "rp": "rp0", "query": "SELECT value FROM cpu GROUP BY host"}`)
+	client := newFlightClient(t, addr, `{"username": "xiaohong", "db": "db0", "privilege": "read"}`)
+	info, err := client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(info.Endpoint))
+
+	stream, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	require.NoError(t, err)
+	reader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	defer reader.Release()
+	assert.Equal(t, []string{"host", "value", "time"}, []string{
+		reader.Schema().Field(0).Name, reader.Schema().Field(1).Name, reader.Schema().Field(2).Name})
+	var hosts []string
+	var values []string
+	var times []int64
+	for reader.Next() {
+		rec := reader.Record()
+		for i := 0; i < int(rec.NumRows()); i++ {
+			hosts = append(hosts, rec.Column(0).(*array.String).Value(i))
+			values = append(values, rec.Column(1).ValueStr(i))
+			times = append(times, rec.Column(2).(*array.Int64).Value(i))
+		}
+	}
+	require.NoError(t, reader.Err())
+	assert.Equal(t, []string{"a", "a", "b"}, hosts)
+	assert.Equal(t, []string{"1.5", array.NullValueStr, "2.5"}, values)
+	assert.Equal(t, []int64{1, 2, 3}, times)
+	assert.Equal(t, "db0", executor.opt.Database)
+	assert.Equal(t, "rp0", executor.opt.RetentionPolicy)
+	assert.True(t, executor.opt.ReadOnly)
+
+	// the query is authorized with the privileges of the user
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD,
+		Cmd: []byte(`{"db": "db1", "query": "SELECT value FROM cpu"}`)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD,
+		Cmd: []byte(`{"db": "db0", "query": "SELECT value FROM cpu; SELECT value FROM mem"}`)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorPATH, Path: []string{string(cmd)}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a read token can not be used to write
+	doPutClient, err := client.DoPut(ctx)
+	require.NoError(t, err)
+	wr := flight.NewRecordWriter(doPutClient, ipc.WithSchema(MockArrowRecord(1).Schema()))
+	wr.SetFlightDescriptor(&flight.FlightDescriptor{Path: []string{`{"db": "db0", "rp": "rp0", "mst": "mst0"}`}})
+	_ = wr.Write(MockArrowRecord(1))
+	_ = wr.Close()
+	_, err = doPutClient.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// a write token can not be used to read
+	client = newFlightClient(t, addr, `{"username": "xiaoming", "db": "db0"}`)
+	stream, err = client.DoGet(ctx, &flight.Ticket{Ticket: cmd})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the user is not authorized to read from the database
+	authClient := &clientAuth{authEnabled: true}
+	client, err = flight.NewFlightClient(addr, authClient, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Close()
+	err = client.Authenticate(context.WithValue(ctx, Token, []byte(`{"username": "xiaohong", "db": "db1", "privilege": "read"}`)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestArrowFlightService_DoPutMeasurementPrivilege(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:0",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	require.NoError(t, service.Open())
+	defer service.Close()
+	addr := service.GetServer().Addr().String()
+
+	doPut := func(client flight.Client, path string) error {
+		doPutClient, err := client.DoPut(context.Background())
+		require.NoError(t, err)
+		wr := flight.NewRecordWriter(doPutClient, ipc.WithSchema(MockArrowRecord(1).Schema()))
+		wr.SetFlightDescriptor(&flight.FlightDescriptor{Path: []string{path}})
+		_ = wr.Write(MockArrowRecord(1))
+		_ = wr.Close()
+		require.NoError(t, doPutClient.CloseSend())
+		_, err = doPutClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	// the measurement denied is not written, and the token is only used for the database of the handshake
+	client := newFlightClient(t, addr, `{"username": "xiaogang", "db": "db0"}`)
+	assert.NoError(t, doPut(client, `{"db": "db0", "rp": "rp0", "mst": "cpu"}`))
+	assert.Equal(t, codes.PermissionDenied, status.Code(doPut(client, `{"db": "db0", "rp": "rp0", "mst": "secret"}`)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(doPut(client, `{"db": "db1", "rp": "rp0", "mst": "cpu"}`)))
+
+	// the user granted the measurement only
+	client = newFlightClient(t, addr, `{"username": "xiaoli", "db": "db0"}`)
+	assert.NoError(t, doPut(client, `{"db": "db0", "rp": "rp0", "mst": "cpu"}`))
+	assert.Equal(t, codes.PermissionDenied, status.Code(doPut(client, `{"db": "db0", "rp": "rp0", "mst": "mem"}`)))
+}
+
+func TestArrowFlightService_DoGetStableSchema(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:0",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = &SchemaQueryExecutor{}
+	require.NoError(t, service.Open())
+	defer service.Close()
+
+	client := newFlightClient(t, service.GetServer().Addr().String(), `{"username": "xiaohong", "db": "db0", "privilege": "read"}`)
+	stream, err := client.DoGet(context.Background(), &flight.Ticket{
+		Ticket: []byte(`{"db": "db0", "query": "SELECT value, msg FROM cpu GROUP BY *"}`)})
+	require.NoError(t, err)
+	reader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	defer reader.Release()
+
+	var fields []string
+	for _, f := range reader.Schema().Fields() {
+		fields = append(fields, f.Name+":"+f.Type.String())
+	}
+	assert.Equal(t, []string{"host:utf8", "region:utf8", "value:float64", "msg:utf8", "time:int64"}, fields)
+	var regions, msgs []string
+	for reader.Next() {
+		rec := reader.Record()
+		regions = append(regions, rec.Column(1).ValueStr(0))
+		msgs = append(msgs, rec.Column(3).ValueStr(0))
+	}
+	require.NoError(t, reader.Err())
+	assert.Equal(t, []string{array.NullValueStr, "r1"}, regions)
+	assert.Equal(t, []string{array.NullValueStr, "x"}, msgs)
+}
@@ -0,0 +1,202 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package continuousquery
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// Backfill runs the continuous query over [startTime, endTime) in chunks of the backfill chunk size.
+// The time range is extended to the whole group by windows. It returns the number of the chunks run and
+// the number of the points written, the chunks run before an error are kept.
+func (s *Service) Backfill(database, name string, startTime, endTime time.Time, abort <-chan struct{}) (int, int64, error) {
+	dbi := s.MetaClient.Databases()[database]
+	if dbi == nil {
+		return 0, 0, query.ErrDatabaseNotFound(database)
+	}
+	cqi, ok := dbi.ContinuousQueries[name]
+	if !ok {
+		return 0, 0, meta.ErrContinuousQueryNotFound
+	}
+	// a new continuous query is used, so that the backfill does not disturb the scheduled runs
+	cq := NewContinuousQuery(dbi.DefaultRetentionPolicy, cqi.Query)
+	if cq == nil {
+		return 0, 0, fmt.Errorf("invalid continuous query %s", name)
+	}
+
+	startTime = cq.windowStart(startTime)
+	if end := cq.windowStart(endTime); end.Before(endTime) {
+		endTime = end.Add(cq.interval)
+	}
+	s.logger.Info("backfill continuous query", zap.String("name", name), zap.Time("start", startTime), zap.Time("end", endTime))
+	return s.runChunks(cq, runKindBackfill, startTime, endTime, abort)
+}
+
+// runChunks runs the continuous query over [startTime, endTime) in chunks of the backfill chunk size,
+// the chunk size is rounded up to the multiple of the group by interval.
+func (s *Service) runChunks(cq *ContinuousQuery, kind string, startTime, endTime time.Time, abort <-chan struct{}) (int, int64, error) {
+	step := s.backfillChunkSize
+	if cq.interval > 0 && step%cq.interval != 0 {
+		step += cq.interval - step%cq.interval
+	}
+
+	var chunks int
+	var written int64
+	for from := startTime; from.Before(endTime); from = from.Add(step) {
+		select {
+		case <-abort:
+			return chunks, written, query.ErrQueryAborted
+		default:
+		}
+
+		to := from.Add(step)
+		if to.After(endTime) {
+			to = endTime
+		}
+		n, err := s.runTimeRange(cq, kind, from, to)
+		chunks++
+		written += n
+		if err != nil {
+			return chunks, written, err
+		}
+	}
+	return chunks, written, nil
+}
+
+// reevaluateLateData re-evaluates the windows of the continuous query which have received the late data
+// since the last run. The windows are evaluated once, a failed re-evaluation is recorded in the history.
+func (s *Service) reevaluateLateData(cq *ContinuousQuery) {
+	earliest, ok := s.lateData.take(cq.name)
+	if !ok {
+		return
+	}
+	startTime, endTime := cq.windowStart(time.Unix(0, earliest)), cq.watermark()
+	if !startTime.Before(endTime) {
+		return
+	}
+	chunks, written, err := s.runChunks(cq, runKindLateData, startTime, endTime, nil)
+	s.logger.Info("re-evaluate the late data of continuous query", zap.String("name", cq.name),
+		zap.Time("start", startTime), zap.Time("end", endTime), zap.Int("chunks", chunks), zap.Int64("written", written), zap.Error(err))
+}
+
+// watchLateData starts to track the late data of the continuous queries, the watermarks of the continuous
+// queries have changed after they run.
+func (s *Service) watchLateData() {
+	if !s.lateDataEnabled {
+		return
+	}
+	watches := make(map[string][]lateDataWatch)
+	for _, cq := range s.ContinuousQueries {
+		if !cq.hasRun {
+			continue
+		}
+		w := lateDataWatch{cq: cq, watermark: cq.watermark().UnixNano()}
+		dbs := make(map[string]struct{}, len(cq.sources))
+		for i := range cq.sources {
+			db := cq.sources[i].database
+			if _, ok := dbs[db]; ok {
+				continue
+			}
+			dbs[db] = struct{}{}
+			watches[db] = append(watches[db], w)
+		}
+	}
+	s.lateData.watches.Store(watches)
+}
+
+// ObserveRows is called with the rows written into the database through the sql node,
+// it records the earliest time of the rows behind the watermarks of the continuous queries.
+// The late data written through the other sql nodes or by the record writers is not seen here.
+func (s *Service) ObserveRows(database string, rows []influx.Row) {
+	watches, _ := s.lateData.watches.Load().(map[string][]lateDataWatch)
+	ws := watches[database]
+	if len(ws) == 0 {
+		return
+	}
+
+	oldest := time.Now().Add(-s.lateDataMaxAge).UnixNano()
+	var found map[string]int64
+	for i := range rows {
+		t := rows[i].Timestamp
+		if t < oldest {
+			continue
+		}
+		for j := range ws {
+			w := &ws[j]
+			if t >= w.watermark {
+				continue
+			}
+			if earliest, ok := found[w.cq.name]; ok && earliest <= t {
+				continue
+			}
+			if !w.cq.sourceOf(database, rows[i].Name) {
+				continue
+			}
+			if found == nil {
+				found = make(map[string]int64)
+			}
+			found[w.cq.name] = t
+		}
+	}
+	s.lateData.add(found)
+}
+
+type lateDataWatch struct {
+	cq        *ContinuousQuery
+	watermark int64
+}
+
+// lateData is the earliest time of the late data of every continuous query
+type lateData struct {
+	// map[string][]lateDataWatch, the watches of the continuous queries reading from the database
+	watches atomic.Value
+
+	mu       sync.Mutex
+	earliest map[string]int64
+}
+
+func (l *lateData) add(found map[string]int64) {
+	if len(found) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.earliest == nil {
+		l.earliest = make(map[string]int64, len(found))
+	}
+	for name, t := range found {
+		if earliest, ok := l.earliest[name]; !ok || t < earliest {
+			l.earliest[name] = t
+		}
+	}
+}
+
+func (l *lateData) take(name string) (int64, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.earliest[name]
+	if ok {
+		delete(l.earliest, name)
+	}
+	return t, ok
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package continuousquery
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPointsWriter struct {
+	mu   sync.Mutex
+	rows []influx.Row
+}
+
+func (w *mockPointsWriter) RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rows = append(w.rows, rows...)
+	return nil
+}
+
+func (w *mockPointsWriter) kinds() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var kinds []string
+	for _, row := range w.rows {
+		kinds = append(kinds, row.Tags[1].Value)
+	}
+	return kinds
+}
+
+func newBackfillService(written int64, err error) (*Service, *mockPointsWriter, *int) {
+	s, _ := NewContinuousQueryService()
+	conf := config.NewContinuousQueryConfig()
+	conf.BackfillChunkSize = toml.Duration(90 * time.Minute)
+	s.WithConfig(conf)
+
+	runs := 0
+	s.QueryExecutor = &countingQueryExecutor{
+		QueryExecutor: &mockQueryExecutor{
+			ExecuteQueryFn: func(results chan *query.Result) {
+				results <- &query.Result{
+					Series: models.Rows{{Columns: []string{"time", "written"}, Values: [][]interface{}{{int64(0), written}}}},
+					Err:    err,
+				}
+			},
+		},
+		runs: &runs,
+	}
+
+	w := &mockPointsWriter{}
+	s.PointsWriter = w
+	return s, w, &runs
+}
+
+// countingQueryExecutor counts the queries, it is called by one goroutine at a time
+type countingQueryExecutor struct {
+	QueryExecutor
+	runs *int
+}
+
+func (e *countingQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	*e.runs++
+	return e.QueryExecutor.ExecuteQuery(q, opt, closing, qDuration)
+}
+
+func TestService_Backfill(t *testing.T) {
+	s, w, runs := newBackfillService(5, nil)
+	startTime := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	endTime := time.Date(2024, 1, 1, 5, 10, 0, 0, time.UTC)
+
+	// [00:00, 06:00) is run in the chunks of 2h
+	chunks, written, err := s.Backfill("db1", "cq1", startTime, endTime, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, chunks)
+	assert.Equal(t, int64(15), written)
+	assert.Equal(t, 3, *runs)
+	assert.Equal(t, []string{runKindBackfill, runKindBackfill, runKindBackfill}, w.kinds())
+	row := w.rows[0]
+	assert.Equal(t, config.DefaultHistoryMeasurement, row.Name)
+	assert.Equal(t, "cq1", row.Tags[0].Value)
+	assert.Equal(t, float64(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()), row.Fields[4].NumValue)
+	assert.Equal(t, float64(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC).UnixNano()), row.Fields[1].NumValue)
+	assert.Equal(t, float64(5), row.Fields[3].NumValue)
+
+	_, _, err = s.Backfill("db0", "cq1", startTime, endTime, nil)
+	assert.EqualError(t, err, "database not found: db0")
+	_, _, err = s.Backfill("db1", "cq0", startTime, endTime, nil)
+	assert.Equal(t, meta.ErrContinuousQueryNotFound, err)
+
+	abort := make(chan struct{})
+	close(abort)
+	chunks, _, err = s.Backfill("db1", "cq1", startTime, endTime, abort)
+	assert.Equal(t, query.ErrQueryAborted, err)
+	assert.Equal(t, 0, chunks)
+
+	// the backfill stops at the first failed chunk, which is recorded in the history
+	s, w, _ = newBackfillService(0, fmt.Errorf("mock error"))
+	chunks, _, err = s.Backfill("db1", "cq1", startTime, endTime, nil)
+	assert.EqualError(t, err, "mock error")
+	assert.Equal(t, 1, chunks)
+	assert.Equal(t, "mock error", w.rows[0].Fields[2].StrValue)
+}
+
+func TestService_LateData(t *testing.T) {
+	s, w, runs := newBackfillService(1, nil)
+	now := time.Now().UTC()
+	cq := NewContinuousQuery("default", `CREATE CONTINUOUS QUERY "cq1" ON "db1" BEGIN SELECT count(value) INTO "count_value" FROM "test" GROUP BY time(1h) END`)
+	cq.hasRun, cq.lastRun = true, now.Truncate(time.Hour)
+	s.ContinuousQueries = []*ContinuousQuery{cq}
+	s.watchLateData()
+
+	watermark := cq.watermark()
+	// the next run processes the window before the last run time
+	assert.Equal(t, now.Truncate(time.Hour).Add(-time.Hour), watermark)
+
+	late := watermark.Add(-3*time.Hour - time.Minute)
+	s.ObserveRows("db1", []influx.Row{
+		{Name: "test", Timestamp: watermark.Add(time.Minute).UnixNano()},
+		{Name: "count_value", Timestamp: watermark.Add(-10 * time.Hour).UnixNano()},
+		{Name: "test", Timestamp: watermark.Add(-time.Hour).UnixNano()},
+		{Name: "test", Timestamp: late.UnixNano()},
+		{Name: "test", Timestamp: now.Add(-30 * 24 * time.Hour).UnixNano()},
+	})
+	s.ObserveRows("db2", []influx.Row{{Name: "test", Timestamp: watermark.Add(-10 * time.Hour).UnixNano()}})
+	s.ObserveRows("db1", []influx.Row{{Name: "other", Timestamp: watermark.Add(-10 * time.Hour).UnixNano()}})
+
+	// the windows in [late, watermark) are re-evaluated once in the chunks of 2h
+	s.reevaluateLateData(cq)
+	assert.Equal(t, 2, *runs)
+	assert.Equal(t, []string{runKindLateData, runKindLateData}, w.kinds())
+	assert.Equal(t, float64(cq.windowStart(late).UnixNano()), w.rows[0].Fields[4].NumValue)
+	assert.Equal(t, float64(watermark.UnixNano()), w.rows[1].Fields[1].NumValue)
+	s.reevaluateLateData(cq)
+	assert.Equal(t, 2, *runs)
+
+	// stop tracking the late data of the continuous queries which are not leased any more
+	s.ContinuousQueries = nil
+	s.watchLateData()
+	s.ObserveRows("db1", []influx.Row{{Name: "test", Timestamp: late.UnixNano()}})
+	s.reevaluateLateData(cq)
+	assert.Equal(t, 2, *runs)
+}
+
+func TestPointsWritten(t *testing.T) {
+	assert.Equal(t, int64(0), pointsWritten(nil))
+	assert.Equal(t, int64(3), pointsWritten(&query.Result{Series: models.Rows{
+		{Columns: []string{"written"}, Values: [][]interface{}{{int64(1)}, {int64(2)}}},
+		{Columns: []string{"time"}, Values: [][]interface{}{{int64(5)}}},
+	}}))
+}
@@ -15,6 +15,7 @@
 package continuousquery
 
 import (
+	"regexp"
 	"strings"
 	"time"
 
@@ -30,12 +31,15 @@ type ContinuousQuery struct {
 	query    string // The original sql of the continuous query.
 
 	hasRun        bool          // Is the first run for this continuous query.
+	interval      time.Duration // The group by interval of the source.
 	resampleEvery time.Duration // Interval to resample previous queries.
 	resampleFor   time.Duration // Maximum duration to resample previous queries.
 	groupByOffset time.Duration
 
 	lastRun time.Time                 // The time that the cq runs successfully.
 	source  *influxql.SelectStatement // The select into clause.
+	sources []sourceMeasurement       // The measurements the cq reads from.
+	target  sourceMeasurement         // The measurement the cq writes into.
 
 	reportInterval time.Duration // The report interval of uploading the continuous query's last run time.
 }
@@ -70,7 +74,7 @@ func NewContinuousQuery(rp string, query string) *ContinuousQuery {
 		logger.GetLogger().Warn("continuous query statement get group by interval error", zap.Error(err))
 		return nil
 	}
-	resampleFor := interval
+	groupByInterval, resampleFor := interval, interval
 	// check interval and ResampleFor/ResampleEvery
 	if q.ResampleFor != 0 && q.ResampleEvery != 0 && q.ResampleEvery > interval {
 		interval = q.ResampleEvery
@@ -89,6 +93,7 @@ func NewContinuousQuery(rp string, query string) *ContinuousQuery {
 		query:    query,
 		database: q.Database,
 
+		interval:      groupByInterval,
 		resampleEvery: interval,
 		resampleFor:   resampleFor,
 		groupByOffset: groupByOffset,
@@ -105,6 +110,12 @@ func NewContinuousQuery(rp string, query string) *ContinuousQuery {
 	if cq.getIntoRP() == "" {
 		cq.setIntoRP(rp)
 	}
+	for _, src := range q.Source.Sources {
+		if m, ok := src.(*influxql.Measurement); ok {
+			cq.sources = append(cq.sources, newSourceMeasurement(cq.database, m))
+		}
+	}
+	cq.target = newSourceMeasurement(cq.database, q.Source.Target.Measurement)
 	return cq
 }
 
@@ -116,6 +127,79 @@ func (cq *ContinuousQuery) setIntoRP(rp string) {
 	cq.source.Target.Measurement.RetentionPolicy = rp
 }
 
+// timeRange returns the time range the continuous query processes when it runs at runTime.
+// startTime should be earlier than runTime.
+func (cq *ContinuousQuery) timeRange(runTime time.Time) (time.Time, time.Time) {
+	startTime := runTime.Add(-cq.resampleEvery - cq.groupByOffset - 1).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
+	endTime := startTime.Add(cq.resampleEvery - cq.groupByOffset).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
+	return startTime, endTime
+}
+
+// windowStart returns the start time of the group by window containing t
+func (cq *ContinuousQuery) windowStart(t time.Time) time.Time {
+	if cq.interval <= 0 {
+		return t
+	}
+	start := t.Add(-cq.groupByOffset).Truncate(cq.interval).Add(cq.groupByOffset)
+	if start.After(t) {
+		start = start.Add(-cq.interval)
+	}
+	return start
+}
+
+// watermark returns the time before which the points are not processed by the next run of the continuous query,
+// the windows before it have to be re-evaluated if they receive the late data.
+func (cq *ContinuousQuery) watermark() time.Time {
+	if !cq.hasRun {
+		return time.Time{}
+	}
+	start, _ := cq.timeRange(cq.lastRun.Add(cq.resampleEvery))
+	return start
+}
+
+// sourceOf returns true if the points written into the measurement of the database are processed by the continuous query
+func (cq *ContinuousQuery) sourceOf(database, name string) bool {
+	if database == cq.target.database && name == cq.target.name {
+		// the results of the continuous query are not the late data of itself
+		return false
+	}
+	for _, src := range cq.sources {
+		if src.match(database, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceMeasurement is a copy of the source measurement of the continuous query, the source statement can not be
+// read while the continuous query is running
+type sourceMeasurement struct {
+	database string
+	name     string
+	regex    *regexp.Regexp
+}
+
+func newSourceMeasurement(database string, m *influxql.Measurement) sourceMeasurement {
+	src := sourceMeasurement{database: m.Database, name: m.Name}
+	if src.database == "" {
+		src.database = database
+	}
+	if m.Regex != nil {
+		src.regex = m.Regex.Val
+	}
+	return src
+}
+
+func (src *sourceMeasurement) match(database, name string) bool {
+	if database != src.database {
+		return false
+	}
+	if src.regex != nil {
+		return src.regex.MatchString(name)
+	}
+	return name == src.name
+}
+
 // shouldRunContinuousQuery returns true and next run time if the CQ should run.
 func (cq *ContinuousQuery) shouldRunContinuousQuery(now time.Time) (bool, time.Time) {
 	if cq.hasRun {
@@ -0,0 +1,93 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package continuousquery
+
+import (
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// The kinds of the continuous query runs recorded in the history.
+const (
+	runKindSchedule = "schedule"
+	runKindBackfill = "backfill"
+	runKindLateData = "late_data"
+)
+
+type PointsWriter interface {
+	RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
+}
+
+// writeHistory writes a run of the continuous query into the history measurement of the continuous query database.
+// The tags and the fields are sorted by key.
+func (s *Service) writeHistory(cq *ContinuousQuery, kind string, startTime, endTime time.Time, d time.Duration, written int64, runErr error) {
+	if !s.historyEnabled || s.PointsWriter == nil {
+		return
+	}
+
+	var errMsg string
+	if runErr != nil {
+		errMsg = runErr.Error()
+	}
+	row := influx.Row{
+		Name: s.historyMeasurement,
+		Tags: influx.PointTags{
+			{Key: "cq", Value: cq.name},
+			{Key: "kind", Value: kind},
+		},
+		Fields: influx.Fields{
+			{Key: "duration", NumValue: float64(d.Nanoseconds()), Type: influx.Field_Type_Int},
+			{Key: "end_time", NumValue: float64(endTime.UnixNano()), Type: influx.Field_Type_Int},
+			{Key: "error", StrValue: errMsg, Type: influx.Field_Type_String},
+			{Key: "points_written", NumValue: float64(written), Type: influx.Field_Type_Int},
+			{Key: "start_time", NumValue: float64(startTime.UnixNano()), Type: influx.Field_Type_Int},
+		},
+		Timestamp: time.Now().UnixNano(),
+	}
+	if err := s.PointsWriter.RetryWritePointRows(cq.database, "", []influx.Row{row}); err != nil {
+		s.logger.Warn("write continuous query history failed", zap.String("name", cq.name), zap.Error(err))
+	}
+}
+
+// pointsWritten returns the number of the points written by the SELECT INTO statement
+func pointsWritten(res *query.Result) int64 {
+	if res == nil {
+		return 0
+	}
+	var written int64
+	for _, row := range res.Series {
+		col := -1
+		for i, c := range row.Columns {
+			if c == "written" {
+				col = i
+				break
+			}
+		}
+		if col < 0 {
+			continue
+		}
+		for _, values := range row.Values {
+			if len(values) > col {
+				if n, ok := values[col].(int64); ok {
+					written += n
+				}
+			}
+		}
+	}
+	return written
+}
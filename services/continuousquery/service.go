@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
@@ -63,6 +64,8 @@ type Service struct {
 
 	QueryExecutor QueryExecutor // interface for QueryExecutor
 
+	PointsWriter PointsWriter // write the history of the continuous query runs
+
 	lastRunsLock sync.RWMutex
 	lastRuns     map[string]time.Time // continuous query last run time. e.g.{"cq_name1": time}
 
@@ -76,6 +79,13 @@ type Service struct {
 	maxCQChangedID uint64 // cache maxCQChangedID to check cq is changed
 	metaChangedCh  chan struct{}
 	cqLeaseChanged chan struct{} // last cq has changed, notify sql node to get cq lease
+
+	backfillChunkSize  time.Duration
+	lateDataEnabled    bool
+	lateDataMaxAge     time.Duration
+	lateData           lateData
+	historyEnabled     bool
+	historyMeasurement string
 }
 
 // NewService creates a new Service instance named continuousQuery
@@ -90,6 +100,12 @@ func NewService(hostname string, interval time.Duration, number int) *Service {
 
 		maxProcessCQNumber: number,
 		cqLeaseChanged:     make(chan struct{}),
+
+		backfillChunkSize:  config.DefaultBackfillChunkSize,
+		lateDataEnabled:    true,
+		lateDataMaxAge:     config.DefaultLateDataMaxAge,
+		historyEnabled:     true,
+		historyMeasurement: config.DefaultHistoryMeasurement,
 	}
 	s.base.Init("continuousQuery", interval, s.handle)
 	return s
@@ -99,6 +115,15 @@ func (s *Service) WithLogger(logger *logger.Logger) {
 	s.logger = logger.With(zap.String("service", "continuousQuery"))
 }
 
+// WithConfig applies the backfill, the late data and the history options of the config
+func (s *Service) WithConfig(c config.ContinuousQueryConfig) {
+	s.backfillChunkSize = time.Duration(c.BackfillChunkSize)
+	s.lateDataEnabled = c.LateDataEnabled
+	s.lateDataMaxAge = time.Duration(c.LateDataMaxAge)
+	s.historyEnabled = c.HistoryEnabled
+	s.historyMeasurement = c.HistoryMeasurement
+}
+
 func (s *Service) Open() error {
 	if err := s.base.Open(); err != nil {
 		return err
@@ -198,6 +223,8 @@ func (s *Service) handle() {
 	}
 
 	if len(s.ContinuousQueries) == 0 {
+		// stop tracking the late data of the continuous queries which are not leased any more
+		s.watchLateData()
 		return
 	}
 
@@ -213,11 +240,13 @@ func (s *Service) handle() {
 			tokens <- struct{}{}
 			ok, err := s.ExecuteContinuousQuery(cq, now)
 			s.logger.Debug("try to execute continuous query", zap.String("query", cq.source.String()), zap.Bool("ok", ok), zap.Error(err))
+			s.reevaluateLateData(cq)
 			<-tokens
 		}(cq)
 	}
 	wg.Wait()
 
+	s.watchLateData()
 	s.tryReportLastRunTime()
 }
 
@@ -238,18 +267,10 @@ func (s *Service) ExecuteContinuousQuery(cq *ContinuousQuery, now time.Time) (bo
 		return false, nil
 	}
 
-	// Calculate and set the time range for the query.
-	// startTime should be earlier than current time.
-	startTime := nextRun.Add(-cq.resampleEvery - cq.groupByOffset - 1).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
-	endTime := startTime.Add(cq.resampleEvery - cq.groupByOffset).Truncate(cq.resampleEvery).Add(cq.groupByOffset)
-	if err := cq.source.SetTimeRange(startTime, endTime); err != nil {
-		return false, fmt.Errorf("unable to set time range: %s", err)
-	}
-
-	// execute the query and write the results
-	res := s.runContinuousQueryAndWriteResult(cq)
-	if res.Err != nil {
-		return false, res.Err
+	// Calculate the time range for the query, execute the query and write the results.
+	startTime, endTime := cq.timeRange(nextRun)
+	if _, err := s.runTimeRange(cq, runKindSchedule, startTime, endTime); err != nil {
+		return false, err
 	}
 
 	// update cq.lastRun and s.lastRuns
@@ -260,6 +281,20 @@ func (s *Service) ExecuteContinuousQuery(cq *ContinuousQuery, now time.Time) (bo
 	return true, nil
 }
 
+// runTimeRange runs the continuous query over [startTime, endTime), records the run into the history,
+// and returns the number of the points written.
+func (s *Service) runTimeRange(cq *ContinuousQuery, kind string, startTime, endTime time.Time) (int64, error) {
+	if err := cq.source.SetTimeRange(startTime, endTime); err != nil {
+		return 0, fmt.Errorf("unable to set time range: %s", err)
+	}
+
+	begin := time.Now()
+	res := s.runContinuousQueryAndWriteResult(cq)
+	written := pointsWritten(res)
+	s.writeHistory(cq, kind, startTime, endTime, time.Since(begin), written, res.Err)
+	return written, res.Err
+}
+
 // runContinuousQueryAndWriteResult will run the query and write the results.
 func (s *Service) runContinuousQueryAndWriteResult(cq *ContinuousQuery) *query.Result {
 	// Wrap the CQ's inner SELECT statement in a Query for the Executor.
@@ -0,0 +1,211 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+const (
+	// connectTimeout is the time to wait for the CONNECT packet after the connection is accepted
+	connectTimeout = 10 * time.Second
+	writeTimeout   = 10 * time.Second
+	readBufferSize = 4096
+)
+
+var clientIDSeq uint64
+
+// conn is a network connection of a MQTT client
+type conn struct {
+	s *Service
+	c net.Conn
+	r *bufio.Reader
+
+	version   byte
+	clientID  string
+	keepAlive time.Duration
+	user      meta.User
+}
+
+func newConn(s *Service, c net.Conn) *conn {
+	return &conn{s: s, c: c, r: bufio.NewReaderSize(c, readBufferSize)}
+}
+
+func (c *conn) serve() {
+	if err := c.connect(); err != nil {
+		c.s.logger.Debug("mqtt connect failed", zap.String("remote", c.c.RemoteAddr().String()), zap.Error(err))
+		return
+	}
+
+	if c.keepAlive == 0 {
+		_ = c.c.SetReadDeadline(time.Time{})
+	}
+	for {
+		if c.keepAlive > 0 {
+			// the client is disconnected if no packet is received in one and a half times the keep alive
+			_ = c.c.SetReadDeadline(time.Now().Add(c.keepAlive * 3 / 2))
+		}
+		p, err := readPacket(c.r, c.s.maxPacketSize)
+		if err == nil {
+			err = c.handle(p)
+		}
+		if err != nil {
+			c.disconnect(err)
+			return
+		}
+	}
+}
+
+// connect reads the CONNECT packet and authenticates the client
+func (c *conn) connect() error {
+	_ = c.c.SetReadDeadline(time.Now().Add(connectTimeout))
+	p, err := readPacket(c.r, c.s.maxPacketSize)
+	if err != nil {
+		return err
+	}
+	if p.kind != packetConnect {
+		return fmt.Errorf("the first packet must be CONNECT, got %d", p.kind)
+	}
+
+	cp, err := parseConnect(p.body)
+	if errors.Is(err, errUnsupportedProtocol) {
+		_ = c.write(encodeConnack(protocolV311, codeUnsupportedProtocolVersion, "", 0))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	c.version = cp.version
+	c.keepAlive = time.Duration(cp.keepAlive) * time.Second
+
+	var assignedClientID string
+	c.clientID = cp.clientID
+	if c.clientID == "" {
+		assignedClientID = "opengemini-" + strconv.FormatUint(atomic.AddUint64(&clientIDSeq, 1), 10)
+		c.clientID = assignedClientID
+	}
+
+	var code byte
+	c.user, code = c.s.authenticate(cp)
+	if code != codeSuccess {
+		_ = c.write(encodeConnack(c.version, code, "", 0))
+		return fmt.Errorf("client %s is not authorized", c.clientID)
+	}
+	if c.version != protocolV5 {
+		assignedClientID = ""
+	}
+	return c.write(encodeConnack(c.version, codeSuccess, assignedClientID, c.s.maxPacketSize))
+}
+
+func (c *conn) handle(p *packet) error {
+	switch p.kind {
+	case packetPublish:
+		return c.publish(p)
+	case packetSubscribe, packetUnsubscribe:
+		id, n, err := parseSubscribe(p.kind, p.flags, p.body, c.version)
+		if err != nil {
+			return err
+		}
+		if p.kind == packetSubscribe {
+			return c.write(encodeSuback(c.version, id, n))
+		}
+		return c.write(encodeUnsuback(c.version, id, n))
+	case packetPingreq:
+		return c.write(encodePingresp())
+	case packetDisconnect:
+		return io.EOF
+	case packetConnect:
+		return errUnexpectedConnectPacket
+	default:
+		return fmt.Errorf("%w: unexpected packet type %d", errProtocolViolation, p.kind)
+	}
+}
+
+// publish writes the points of the message. The QoS 1 message is acknowledged after the points are written,
+// or rejected by a PUBACK with the reason code of MQTT 5. If the points may be written by publishing the
+// message again, the connection is closed without the PUBACK so that the client publishes it again.
+func (c *conn) publish(p *packet) error {
+	pp, err := parsePublish(p.flags, p.body, c.version)
+	if err != nil {
+		return err
+	}
+	if pp.qos > 1 {
+		return errQoSNotSupported
+	}
+
+	err = c.s.publish(c.user, pp.topic, pp.payload)
+	var reject *rejectError
+	if err != nil && !errors.As(err, &reject) {
+		c.s.logger.Error("mqtt write failed", zap.String("client", c.clientID), zap.String("topic", pp.topic), zap.Error(err))
+		return err
+	}
+	code := codeSuccess
+	if reject != nil {
+		code = reject.code
+		c.s.logger.Warn("mqtt message rejected", zap.String("client", c.clientID), zap.String("topic", pp.topic), zap.Error(reject.err))
+	}
+	if pp.qos == 0 {
+		return nil
+	}
+	return c.write(encodePuback(c.version, pp.packetID, code))
+}
+
+// disconnect sends the reason of closing the connection to the client of MQTT 5
+func (c *conn) disconnect(err error) {
+	if errors.Is(err, io.EOF) {
+		return
+	}
+	c.s.logger.Debug("mqtt connection closed", zap.String("client", c.clientID), zap.Error(err))
+	if c.version != protocolV5 {
+		return
+	}
+
+	var code byte
+	switch {
+	case errors.Is(err, errPacketTooLarge):
+		code = codePacketTooLarge
+	case errors.Is(err, errMalformedPacket):
+		code = codeMalformedPacket
+	case errors.Is(err, errProtocolViolation), errors.Is(err, errUnexpectedConnectPacket):
+		code = codeProtocolError
+	case errors.Is(err, errTopicNameInvalid):
+		code = codeTopicNameInvalid
+	case errors.Is(err, errQoSNotSupported):
+		code = codeQoSNotSupported
+	default:
+		var ne net.Error
+		if errors.As(err, &ne) {
+			return
+		}
+		code = codeUnspecifiedError
+	}
+	_ = c.write(encodeDisconnect(code))
+}
+
+func (c *conn) write(b []byte) error {
+	_ = c.c.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_, err := c.c.Write(b)
+	return err
+}
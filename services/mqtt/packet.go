@@ -0,0 +1,440 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+)
+
+// The control packet types of MQTT 3.1.1 and MQTT 5.
+const (
+	packetConnect     byte = 1
+	packetConnack     byte = 2
+	packetPublish     byte = 3
+	packetPuback      byte = 4
+	packetSubscribe   byte = 8
+	packetSuback      byte = 9
+	packetUnsubscribe byte = 10
+	packetUnsuback    byte = 11
+	packetPingreq     byte = 12
+	packetPingresp    byte = 13
+	packetDisconnect  byte = 14
+)
+
+const (
+	protocolV311 byte = 4
+	protocolV5   byte = 5
+)
+
+// The reason codes of MQTT 5, the CONNACK return codes of MQTT 3.1.1 are converted by connackCode.
+const (
+	codeSuccess                    byte = 0x00
+	codeNoSubscriptionExisted      byte = 0x11
+	codeUnspecifiedError           byte = 0x80
+	codeMalformedPacket            byte = 0x81
+	codeProtocolError              byte = 0x82
+	codeImplementationSpecific     byte = 0x83
+	codeUnsupportedProtocolVersion byte = 0x84
+	codeBadUsernameOrPassword      byte = 0x86
+	codeNotAuthorized              byte = 0x87
+	codeTopicNameInvalid           byte = 0x90
+	codePacketTooLarge             byte = 0x95
+	codePayloadFormatInvalid       byte = 0x99
+	codeQoSNotSupported            byte = 0x9B
+)
+
+// The properties of MQTT 5 sent in CONNACK.
+const (
+	propAssignedClientID     byte = 0x12
+	propMaximumQoS           byte = 0x24
+	propRetainAvailable      byte = 0x25
+	propMaximumPacketSize    byte = 0x27
+	propWildcardSubAvailable byte = 0x28
+	propSubIDAvailable       byte = 0x29
+	propSharedSubAvailable   byte = 0x2A
+)
+
+const (
+	protocolNameMQTT   = "MQTT"
+	protocolNameMQIsdp = "MQIsdp"
+
+	connectFlagReserved byte = 0x01
+	connectFlagWill     byte = 0x04
+	connectFlagPassword byte = 0x40
+	connectFlagUsername byte = 0x80
+
+	publishFlagQoSMask byte = 0x06
+	// the fixed header flags of SUBSCRIBE and UNSUBSCRIBE
+	subscribeFlags byte = 0x02
+
+	subackFailure  byte = 0x80
+	maxVarintBytes      = 4
+	topicWildcards      = "+#"
+)
+
+var (
+	errMalformedPacket         = errors.New("malformed packet")
+	errPacketTooLarge          = errors.New("packet too large")
+	errUnsupportedProtocol     = errors.New("unsupported protocol version")
+	errTopicNameInvalid        = errors.New("invalid topic name")
+	errProtocolViolation       = errors.New("protocol violation")
+	errQoSNotSupported         = errors.New("QoS 2 is not supported")
+	errUnexpectedConnectPacket = errors.New("unexpected CONNECT packet")
+)
+
+// packet is a control packet, the body is the variable header and the payload
+type packet struct {
+	kind  byte
+	flags byte
+	body  []byte
+}
+
+// readPacket reads a control packet whose remaining length is at most maxSize
+func readPacket(r *bufio.Reader, maxSize int) (*packet, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	n, err := readVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSize {
+		return nil, errPacketTooLarge
+	}
+	p := &packet{kind: b >> 4, flags: b & 0x0F, body: make([]byte, n)}
+	if _, err = io.ReadFull(r, p.body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func readVarint(r io.ByteReader) (int, error) {
+	var n, shift int
+	for i := 0; i < maxVarintBytes; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		n |= int(b&0x7F) << shift
+		if b&0x80 == 0 {
+			return n, nil
+		}
+		shift += 7
+	}
+	return 0, errMalformedPacket
+}
+
+func appendVarint(dst []byte, n int) []byte {
+	for {
+		b := byte(n & 0x7F)
+		n >>= 7
+		if n > 0 {
+			b |= 0x80
+		}
+		dst = append(dst, b)
+		if n == 0 {
+			return dst
+		}
+	}
+}
+
+func appendString(dst []byte, s string) []byte {
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(s)))
+	return append(dst, s...)
+}
+
+// appendPacket appends the fixed header and the body of a control packet
+func appendPacket(dst []byte, kind, flags byte, body []byte) []byte {
+	dst = append(dst, kind<<4|flags)
+	dst = appendVarint(dst, len(body))
+	return append(dst, body...)
+}
+
+// decoder reads the fields of a packet body, the first error is kept and the later reads return zero values
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil || len(d.buf) < 1 {
+		d.err = errMalformedPacket
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) uint16() uint16 {
+	if d.err != nil || len(d.buf) < 2 {
+		d.err = errMalformedPacket
+		return 0
+	}
+	v := binary.BigEndian.Uint16(d.buf)
+	d.buf = d.buf[2:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	n := int(d.uint16())
+	if d.err != nil || len(d.buf) < n {
+		d.err = errMalformedPacket
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) string() string {
+	return string(d.bytes())
+}
+
+func (d *decoder) varint() int {
+	if d.err != nil {
+		return 0
+	}
+	r := &byteReader{buf: d.buf}
+	n, err := readVarint(r)
+	if err != nil {
+		d.err = errMalformedPacket
+		return 0
+	}
+	d.buf = r.buf
+	return n
+}
+
+// skipProperties skips the properties of MQTT 5, none of the properties sent by the clients is used
+func (d *decoder) skipProperties(version byte) {
+	if version != protocolV5 {
+		return
+	}
+	n := d.varint()
+	if d.err != nil || len(d.buf) < n {
+		d.err = errMalformedPacket
+		return
+	}
+	d.buf = d.buf[n:]
+}
+
+type byteReader struct {
+	buf []byte
+}
+
+func (r *byteReader) ReadByte() (byte, error) {
+	if len(r.buf) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	b := r.buf[0]
+	r.buf = r.buf[1:]
+	return b, nil
+}
+
+type connectPacket struct {
+	version     byte
+	keepAlive   uint16
+	clientID    string
+	username    string
+	password    string
+	hasUsername bool
+	hasPassword bool
+}
+
+// parseConnect parses the CONNECT packet, the version is kept with errUnsupportedProtocol
+// so that the CONNACK can be sent in the format of the client.
+func parseConnect(body []byte) (*connectPacket, error) {
+	d := &decoder{buf: body}
+	c := &connectPacket{}
+	name := d.string()
+	c.version = d.byte()
+	if d.err != nil {
+		return nil, d.err
+	}
+	if name != protocolNameMQTT && name != protocolNameMQIsdp {
+		return nil, errProtocolViolation
+	}
+	if name != protocolNameMQTT || (c.version != protocolV311 && c.version != protocolV5) {
+		return c, errUnsupportedProtocol
+	}
+
+	flags := d.byte()
+	c.keepAlive = d.uint16()
+	d.skipProperties(c.version)
+	if d.err == nil && flags&connectFlagReserved != 0 {
+		return nil, errMalformedPacket
+	}
+	c.clientID = d.string()
+	if flags&connectFlagWill != 0 {
+		d.skipProperties(c.version)
+		d.string()
+		d.bytes()
+	}
+	if flags&connectFlagUsername != 0 {
+		c.hasUsername = true
+		c.username = d.string()
+	}
+	if flags&connectFlagPassword != 0 {
+		c.hasPassword = true
+		c.password = string(d.bytes())
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return c, nil
+}
+
+type publishPacket struct {
+	qos      byte
+	packetID uint16
+	topic    string
+	payload  []byte
+}
+
+func parsePublish(flags byte, body []byte, version byte) (*publishPacket, error) {
+	p := &publishPacket{qos: (flags & publishFlagQoSMask) >> 1}
+	if p.qos == 3 {
+		return nil, errMalformedPacket
+	}
+	d := &decoder{buf: body}
+	p.topic = d.string()
+	if p.qos > 0 {
+		p.packetID = d.uint16()
+	}
+	d.skipProperties(version)
+	if d.err != nil {
+		return nil, d.err
+	}
+	// the topic alias of MQTT 5 is not allowed, the topic alias maximum of the server is zero
+	if p.topic == "" || strings.ContainsAny(p.topic, topicWildcards) {
+		return nil, errTopicNameInvalid
+	}
+	p.payload = d.buf
+	return p, nil
+}
+
+// parseSubscribe parses the SUBSCRIBE or the UNSUBSCRIBE packet, it returns the packet identifier and
+// the number of the topic filters.
+func parseSubscribe(kind, flags byte, body []byte, version byte) (uint16, int, error) {
+	if flags != subscribeFlags {
+		return 0, 0, errMalformedPacket
+	}
+	d := &decoder{buf: body}
+	id := d.uint16()
+	d.skipProperties(version)
+	n := 0
+	for d.err == nil && len(d.buf) > 0 {
+		d.bytes()
+		if kind == packetSubscribe {
+			d.byte()
+		}
+		n++
+	}
+	if d.err != nil {
+		return 0, 0, d.err
+	}
+	if n == 0 {
+		return 0, 0, errProtocolViolation
+	}
+	return id, n, nil
+}
+
+// connackCode converts the reason code of MQTT 5 to the return code of MQTT 3.1.1
+func connackCode(version, code byte) byte {
+	if version == protocolV5 {
+		return code
+	}
+	switch code {
+	case codeSuccess:
+		return 0
+	case codeUnsupportedProtocolVersion:
+		return 1
+	case codeBadUsernameOrPassword:
+		return 4
+	case codeNotAuthorized:
+		return 5
+	default:
+		return 3
+	}
+}
+
+// encodeConnack encodes the CONNACK packet, a session is never present since the server keeps no session state.
+// The properties of MQTT 5 tell the client the features not supported by the server.
+func encodeConnack(version, code byte, assignedClientID string, maxPacketSize int) []byte {
+	body := []byte{0, connackCode(version, code)}
+	if version == protocolV5 {
+		var props []byte
+		if code == codeSuccess {
+			props = append(props, propMaximumQoS, 1, propRetainAvailable, 0,
+				propWildcardSubAvailable, 0, propSubIDAvailable, 0, propSharedSubAvailable, 0)
+			props = append(props, propMaximumPacketSize)
+			props = binary.BigEndian.AppendUint32(props, uint32(maxPacketSize))
+			if assignedClientID != "" {
+				props = append(props, propAssignedClientID)
+				props = appendString(props, assignedClientID)
+			}
+		}
+		body = appendVarint(body, len(props))
+		body = append(body, props...)
+	}
+	return appendPacket(nil, packetConnack, 0, body)
+}
+
+// encodePuback encodes the PUBACK packet, the reason code is only sent by MQTT 5
+func encodePuback(version byte, packetID uint16, code byte) []byte {
+	body := binary.BigEndian.AppendUint16(nil, packetID)
+	if version == protocolV5 && code != codeSuccess {
+		body = append(body, code)
+	}
+	return appendPacket(nil, packetPuback, 0, body)
+}
+
+// encodeSuback encodes the SUBACK packet which refuses all the subscriptions, the server does not
+// deliver messages to the clients.
+func encodeSuback(version byte, packetID uint16, n int) []byte {
+	body := binary.BigEndian.AppendUint16(nil, packetID)
+	if version == protocolV5 {
+		body = append(body, 0)
+	}
+	for i := 0; i < n; i++ {
+		body = append(body, subackFailure)
+	}
+	return appendPacket(nil, packetSuback, 0, body)
+}
+
+func encodeUnsuback(version byte, packetID uint16, n int) []byte {
+	body := binary.BigEndian.AppendUint16(nil, packetID)
+	if version == protocolV5 {
+		body = append(body, 0)
+		for i := 0; i < n; i++ {
+			body = append(body, codeNoSubscriptionExisted)
+		}
+	}
+	return appendPacket(nil, packetUnsuback, 0, body)
+}
+
+func encodePingresp() []byte {
+	return appendPacket(nil, packetPingresp, 0, nil)
+}
+
+// encodeDisconnect encodes the DISCONNECT packet sent by the server, which only exists in MQTT 5
+func encodeDisconnect(code byte) []byte {
+	return appendPacket(nil, packetDisconnect, 0, []byte{code})
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeConnect(version byte, clientID, username, password string, keepAlive uint16) []byte {
+	body := appendString(nil, protocolNameMQTT)
+	var flags byte
+	if username != "" {
+		flags |= connectFlagUsername | connectFlagPassword
+	}
+	body = append(body, version, flags)
+	body = binary.BigEndian.AppendUint16(body, keepAlive)
+	if version == protocolV5 {
+		// session expiry interval
+		body = append(body, 5, 0x11, 0, 0, 0, 10)
+	}
+	body = appendString(body, clientID)
+	if username != "" {
+		body = appendString(body, username)
+		body = appendString(body, password)
+	}
+	return appendPacket(nil, packetConnect, 0, body)
+}
+
+func encodePublish(version, qos byte, packetID uint16, topic string, payload string) []byte {
+	body := appendString(nil, topic)
+	if qos > 0 {
+		body = binary.BigEndian.AppendUint16(body, packetID)
+	}
+	if version == protocolV5 {
+		// payload format indicator
+		body = append(body, 2, 0x01, 1)
+	}
+	body = append(body, payload...)
+	return appendPacket(nil, packetPublish, qos<<1, body)
+}
+
+func TestVarint(t *testing.T) {
+	for _, n := range []int{0, 127, 128, 16383, 16384, 2097151, 2097152, 268435455} {
+		b := appendVarint(nil, n)
+		v, err := readVarint(bytes.NewReader(b))
+		require.NoError(t, err)
+		require.Equal(t, n, v)
+	}
+	_, err := readVarint(bytes.NewReader([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0x01}))
+	require.Equal(t, errMalformedPacket, err)
+}
+
+func TestReadPacket(t *testing.T) {
+	b := encodePublish(protocolV311, 1, 7, "a/b", "cpu value=1")
+	p, err := readPacket(bufio.NewReader(bytes.NewReader(b)), 1024)
+	require.NoError(t, err)
+	require.Equal(t, packetPublish, p.kind)
+	require.Equal(t, byte(2), p.flags)
+
+	_, err = readPacket(bufio.NewReader(bytes.NewReader(b)), 8)
+	require.Equal(t, errPacketTooLarge, err)
+
+	pp, err := parsePublish(p.flags, p.body, protocolV311)
+	require.NoError(t, err)
+	require.Equal(t, byte(1), pp.qos)
+	require.Equal(t, uint16(7), pp.packetID)
+	require.Equal(t, "a/b", pp.topic)
+	require.Equal(t, "cpu value=1", string(pp.payload))
+}
+
+func TestParseConnect(t *testing.T) {
+	for _, version := range []byte{protocolV311, protocolV5} {
+		b := encodeConnect(version, "client1", "user", "pass", 30)
+		p, err := readPacket(bufio.NewReader(bytes.NewReader(b)), 1024)
+		require.NoError(t, err)
+		cp, err := parseConnect(p.body)
+		require.NoError(t, err)
+		require.Equal(t, version, cp.version)
+		require.Equal(t, uint16(30), cp.keepAlive)
+		require.Equal(t, "client1", cp.clientID)
+		require.True(t, cp.hasUsername && cp.hasPassword)
+		require.Equal(t, "user", cp.username)
+		require.Equal(t, "pass", cp.password)
+	}
+
+	b := encodeConnect(3, "client1", "", "", 30)
+	cp, err := parseConnect(b[2:])
+	require.Equal(t, errUnsupportedProtocol, err)
+	require.Equal(t, byte(3), cp.version)
+
+	_, err = parseConnect(b[2:8])
+	require.Equal(t, errMalformedPacket, err)
+
+	body := appendString(nil, "HTTP")
+	_, err = parseConnect(append(body, protocolV311))
+	require.Equal(t, errProtocolViolation, err)
+}
+
+func TestParsePublish_Invalid(t *testing.T) {
+	_, err := parsePublish(6, nil, protocolV311)
+	require.Equal(t, errMalformedPacket, err)
+
+	b := encodePublish(protocolV5, 0, 0, "a/+", "1")
+	_, err = parsePublish(0, b[2:], protocolV5)
+	require.Equal(t, errTopicNameInvalid, err)
+
+	// the topic alias is not supported
+	b = encodePublish(protocolV5, 0, 0, "", "1")
+	_, err = parsePublish(0, b[2:], protocolV5)
+	require.Equal(t, errTopicNameInvalid, err)
+}
+
+func TestParseSubscribe(t *testing.T) {
+	body := binary.BigEndian.AppendUint16(nil, 9)
+	body = append(body, 0)
+	body = appendString(body, "a/#")
+	body = append(body, 1)
+	body = appendString(body, "b/+")
+	body = append(body, 0)
+
+	id, n, err := parseSubscribe(packetSubscribe, subscribeFlags, body, protocolV5)
+	require.NoError(t, err)
+	require.Equal(t, uint16(9), id)
+	require.Equal(t, 2, n)
+
+	_, _, err = parseSubscribe(packetSubscribe, 0, body, protocolV5)
+	require.Equal(t, errMalformedPacket, err)
+	_, _, err = parseSubscribe(packetSubscribe, subscribeFlags, body[:3], protocolV5)
+	require.Equal(t, errProtocolViolation, err)
+
+	require.Equal(t, []byte{0x90, 5, 0, 9, 0, 0x80, 0x80}, encodeSuback(protocolV5, 9, 2))
+	require.Equal(t, []byte{0x90, 3, 0, 9, 0x80}, encodeSuback(protocolV311, 9, 1))
+	require.Equal(t, []byte{0xB0, 4, 0, 9, 0, 0x11}, encodeUnsuback(protocolV5, 9, 1))
+	require.Equal(t, []byte{0xB0, 2, 0, 9}, encodeUnsuback(protocolV311, 9, 1))
+}
+
+func TestEncodeAck(t *testing.T) {
+	require.Equal(t, []byte{0x20, 2, 0, 0}, encodeConnack(protocolV311, codeSuccess, "", 1024))
+	require.Equal(t, []byte{0x20, 2, 0, 4}, encodeConnack(protocolV311, codeBadUsernameOrPassword, "", 1024))
+	require.Equal(t, []byte{0x20, 3, 0, 0x87, 0}, encodeConnack(protocolV5, codeNotAuthorized, "", 1024))
+
+	b := encodeConnack(protocolV5, codeSuccess, "id", 1024)
+	require.Equal(t, []byte{0x20, 23, 0, 0, 20, propMaximumQoS, 1}, b[:7])
+	require.Equal(t, []byte{propMaximumPacketSize, 0, 0, 4, 0, propAssignedClientID, 0, 2, 'i', 'd'}, b[15:])
+
+	require.Equal(t, []byte{0x40, 2, 0, 5}, encodePuback(protocolV311, 5, codePayloadFormatInvalid))
+	require.Equal(t, []byte{0x40, 2, 0, 5}, encodePuback(protocolV5, 5, codeSuccess))
+	require.Equal(t, []byte{0x40, 3, 0, 5, codePayloadFormatInvalid}, encodePuback(protocolV5, 5, codePayloadFormatInvalid))
+	require.Equal(t, []byte{0xD0, 0}, encodePingresp())
+	require.Equal(t, []byte{0xE0, 1, codePacketTooLarge}, encodeDisconnect(codePacketTooLarge))
+}
@@ -0,0 +1,244 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/valyala/fastjson"
+)
+
+var errEmptyPayload = errors.New("empty payload")
+
+var jsonParserPool fastjson.ParserPool
+
+// parse parses the payload of a message into the points, now is the time of the points without a timestamp
+func (t *topic) parse(payload []byte, dst *destination, now int64) ([]influx.Row, error) {
+	if len(strings.TrimSpace(string(payload))) == 0 {
+		return nil, errEmptyPayload
+	}
+
+	var rows []influx.Row
+	var err error
+	switch t.conf.Format {
+	case config.MQTTFormatJSON:
+		rows, err = t.parseJSON(payload, dst, now)
+	case config.MQTTFormatValue:
+		rows, err = t.parseValue(payload, dst, now)
+	default:
+		rows, err = t.parseLine(payload, dst, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err = rows[i].CheckValid(); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// parseLine parses the points in line protocol, the tags of the topic name are added to the points
+// which have no tags with the same keys.
+func (t *topic) parseLine(payload []byte, dst *destination, now int64) ([]influx.Row, error) {
+	pr := &influx.PointRows{}
+	if err := pr.Unmarshal(string(payload), false); err != nil {
+		return nil, err
+	}
+	rows := pr.Rows
+	for i := range rows {
+		r := &rows[i]
+		if r.Timestamp == influx.NoTimestamp {
+			r.Timestamp = now
+		} else {
+			r.Timestamp *= t.tsMultiplier
+		}
+		if len(dst.tags) == 0 {
+			continue
+		}
+		// the tags of the row are a part of the tags pool, they are copied before appending
+		tags := r.Tags[:len(r.Tags):len(r.Tags)]
+		for _, tag := range dst.tags {
+			if findTag(tags, tag.Key) < 0 {
+				tags = append(tags, tag)
+			}
+		}
+		r.Tags = tags
+		sort.Sort(&r.Tags)
+	}
+	return rows, nil
+}
+
+// parseJSON parses a JSON object, or an array of JSON objects. A number is a float field, a string is
+// a string field and a boolean is a boolean field, except the tag keys and the time key of the topic.
+func (t *topic) parseJSON(payload []byte, dst *destination, now int64) ([]influx.Row, error) {
+	p := jsonParserPool.Get()
+	defer jsonParserPool.Put(p)
+	v, err := p.ParseBytes(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []*fastjson.Value
+	switch v.Type() {
+	case fastjson.TypeObject:
+		objects = append(objects, v)
+	case fastjson.TypeArray:
+		objects, _ = v.Array()
+	default:
+		return nil, fmt.Errorf("json payload must be an object or an array of objects")
+	}
+
+	rows := make([]influx.Row, len(objects))
+	for i, o := range objects {
+		if err = t.jsonRow(&rows[i], o, dst, now); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (t *topic) jsonRow(r *influx.Row, v *fastjson.Value, dst *destination, now int64) error {
+	o, err := v.Object()
+	if err != nil {
+		return err
+	}
+	r.Name = dst.measurement
+	r.Timestamp = now
+	r.Tags = append(r.Tags, dst.tags...)
+	o.Visit(func(key []byte, value *fastjson.Value) {
+		if err != nil {
+			return
+		}
+		k := string(key)
+		switch {
+		case k == t.conf.TimeKey:
+			r.Timestamp, err = t.jsonTime(value)
+		case t.isTagKey(k):
+			if value.Type() == fastjson.TypeNull {
+				return
+			}
+			tag := influx.Tag{Key: k}
+			if value.Type() == fastjson.TypeString {
+				tag.Value = string(value.GetStringBytes())
+			} else {
+				tag.Value = value.String()
+			}
+			if j := findTag(r.Tags, k); j >= 0 {
+				r.Tags[j] = tag
+			} else {
+				r.Tags = append(r.Tags, tag)
+			}
+		default:
+			err = appendJSONField(r, k, value)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	sort.Sort(&r.Tags)
+	return nil
+}
+
+func findTag(tags influx.PointTags, key string) int {
+	for i := range tags {
+		if tags[i].Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *topic) isTagKey(key string) bool {
+	for _, k := range t.conf.TagKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTime returns the timestamp of a number in the precision of the topic, or a RFC3339 string
+func (t *topic) jsonTime(v *fastjson.Value) (int64, error) {
+	switch v.Type() {
+	case fastjson.TypeNumber:
+		ts, err := v.Int64()
+		if err != nil {
+			return 0, err
+		}
+		return ts * t.tsMultiplier, nil
+	case fastjson.TypeString:
+		ts, err := time.Parse(time.RFC3339Nano, string(v.GetStringBytes()))
+		if err != nil {
+			return 0, err
+		}
+		return ts.UnixNano(), nil
+	default:
+		return 0, fmt.Errorf("invalid time %s", v.String())
+	}
+}
+
+func appendJSONField(r *influx.Row, key string, v *fastjson.Value) error {
+	f := influx.Field{Key: key}
+	switch v.Type() {
+	case fastjson.TypeNull:
+		return nil
+	case fastjson.TypeNumber:
+		f.NumValue, f.Type = v.GetFloat64(), influx.Field_Type_Float
+	case fastjson.TypeString:
+		f.StrValue, f.Type = string(v.GetStringBytes()), influx.Field_Type_String
+	case fastjson.TypeTrue:
+		f.NumValue, f.Type = 1, influx.Field_Type_Boolean
+	case fastjson.TypeFalse:
+		f.NumValue, f.Type = 0, influx.Field_Type_Boolean
+	default:
+		return fmt.Errorf("unsupported value of the key %s: %s", key, v.String())
+	}
+	r.Fields = append(r.Fields, f)
+	return nil
+}
+
+// parseValue parses a single value, which is a float field if the payload is a number,
+// a boolean field if the payload is true or false, otherwise a string field.
+func (t *topic) parseValue(payload []byte, dst *destination, now int64) ([]influx.Row, error) {
+	s := strings.TrimSpace(string(payload))
+	f := influx.Field{Key: t.conf.Field}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		f.NumValue, f.Type = n, influx.Field_Type_Float
+	} else if s == "true" || s == "false" {
+		f.Type = influx.Field_Type_Boolean
+		if s == "true" {
+			f.NumValue = 1
+		}
+	} else {
+		f.StrValue, f.Type = s, influx.Field_Type_String
+	}
+
+	row := influx.Row{
+		Name:      dst.measurement,
+		Tags:      append(influx.PointTags(nil), dst.tags...),
+		Fields:    influx.Fields{f},
+		Timestamp: now,
+	}
+	return []influx.Row{row}, nil
+}
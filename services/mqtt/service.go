@@ -0,0 +1,301 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb"
+	"github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/crypto"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+type PointWriter interface {
+	RetryWritePointRows(database string, retentionPolicy string, rows []influx.Row) error
+}
+
+// Authorizer authenticates the username and the password sent by the client in CONNECT
+type Authorizer interface {
+	Authenticate(username, password string) (meta.User, error)
+}
+
+// Service is an embedded MQTT 3.1.1 and MQTT 5 server. The messages published by the clients are parsed
+// into the points by the topic templates and written through the points writer. A QoS 1 message is
+// acknowledged after the points are written, the server keeps no session state and delivers no message.
+type Service struct {
+	conf          config.MQTTConfig
+	topics        topics
+	tlsConfig     *tls.Config
+	maxPacketSize int
+
+	logger     *logger.Logger
+	writer     PointWriter
+	authorizer Authorizer
+
+	listener net.Listener
+	closing  chan struct{}
+	err      chan error
+	wg       sync.WaitGroup
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func NewService(c config.MQTTConfig) (*Service, error) {
+	ts, err := newTopics(c.Topics)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{
+		conf:          c,
+		topics:        ts,
+		maxPacketSize: int(c.MaxPacketSize),
+		logger:        logger.NewLogger(errno.ModuleUnknown).With(zap.String("service", "mqtt")),
+		err:           make(chan error, 1),
+		conns:         make(map[net.Conn]struct{}),
+	}
+	if c.TLS.Enabled {
+		cert, err := tls.X509KeyPair([]byte(crypto.DecryptFromFile(c.TLS.CertFile)),
+			[]byte(crypto.DecryptFromFile(c.TLS.KeyFile)))
+		if err != nil {
+			return nil, err
+		}
+		s.tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+		if c.TLS.ClientAuth {
+			pool := x509.NewCertPool()
+			pool.AppendCertsFromPEM([]byte(crypto.DecryptFromFile(c.TLS.CARoot)))
+			s.tlsConfig.ClientCAs = pool
+			s.tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return s, nil
+}
+
+func (s *Service) WithLogger(logger *logger.Logger) {
+	s.logger = logger.With(zap.String("service", "mqtt"))
+}
+
+func (s *Service) WithWriter(writer PointWriter) {
+	s.writer = writer
+}
+
+func (s *Service) WithAuthorizer(a Authorizer) {
+	s.authorizer = a
+}
+
+func (s *Service) Open() error {
+	if s.listener != nil {
+		return nil
+	}
+	ln, err := net.Listen("tcp", s.conf.BindAddress)
+	if err != nil {
+		return err
+	}
+	if s.tlsConfig != nil {
+		ln = tls.NewListener(ln, s.tlsConfig)
+	}
+	s.listener = ln
+	s.closing = make(chan struct{})
+
+	s.wg.Add(1)
+	go s.serve()
+	s.logger.Info("mqtt service started", zap.String("addr", ln.Addr().String()), zap.Bool("tls", s.tlsConfig != nil))
+	return nil
+}
+
+// Addr returns the address the service listens on
+func (s *Service) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+func (s *Service) Close() error {
+	if s.listener == nil {
+		return nil
+	}
+	close(s.closing)
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for c := range s.conns {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	s.listener = nil
+	return err
+}
+
+func (s *Service) Err() <-chan error {
+	return s.err
+}
+
+func (s *Service) serve() {
+	defer s.wg.Done()
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.closing:
+				return
+			default:
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			s.logger.Error("mqtt accept failed", zap.Error(err))
+			s.err <- err
+			return
+		}
+		if !s.track(c) {
+			s.logger.Warn("mqtt connection refused, too many connections", zap.String("remote", c.RemoteAddr().String()))
+			_ = c.Close()
+			continue
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.untrack(c)
+			newConn(s, c).serve()
+		}()
+	}
+}
+
+func (s *Service) track(c net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.conns) >= s.conf.MaxConnections {
+		return false
+	}
+	s.conns[c] = struct{}{}
+	return true
+}
+
+func (s *Service) untrack(c net.Conn) {
+	s.mu.Lock()
+	delete(s.conns, c)
+	s.mu.Unlock()
+	_ = c.Close()
+}
+
+// authenticate returns the user of the client, the user is nil if the authentication is disabled
+func (s *Service) authenticate(cp *connectPacket) (meta.User, byte) {
+	if !s.conf.AuthEnabled {
+		return nil, codeSuccess
+	}
+	if !cp.hasUsername || s.authorizer == nil {
+		return nil, codeNotAuthorized
+	}
+	u, err := s.authorizer.Authenticate(cp.username, cp.password)
+	if err != nil || u == nil {
+		s.logger.Warn("mqtt authentication failed", zap.String("user", cp.username), zap.Error(err))
+		return nil, codeBadUsernameOrPassword
+	}
+	return u, codeSuccess
+}
+
+// rejectError is the error of a message which is never written, the client should not publish it again
+type rejectError struct {
+	code byte
+	err  error
+}
+
+func (e *rejectError) Error() string {
+	return e.err.Error()
+}
+
+// publish writes the points of a message, it returns a *rejectError if the message can not be written,
+// or the error of the points writer if the message may be written if it is published again.
+func (s *Service) publish(user meta.User, name string, payload []byte) error {
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.WriteRequestBytesIn, int64(len(payload)))
+	defer func(start time.Time) {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveWriteRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.WriteRequestDuration, time.Since(start).Nanoseconds())
+	}(time.Now())
+
+	t, dst := s.topics.match(name)
+	if t == nil {
+		return &rejectError{code: codeTopicNameInvalid, err: fmt.Errorf("no topic template matches %s", name)}
+	}
+	rows, err := t.parse(payload, dst, time.Now().UnixNano())
+	if err != nil {
+		return &rejectError{code: codePayloadFormatInvalid, err: err}
+	}
+	if user != nil {
+		if err = authorizeWriteRows(user, dst.database, rows); err != nil {
+			return &rejectError{code: codeNotAuthorized, err: err}
+		}
+	}
+
+	err = s.writer.RetryWritePointRows(dst.database, dst.retentionPolicy, rows)
+	if err != nil {
+		atomic.AddInt64(&statistics.HandlerStat.PointsWrittenFail, int64(len(rows)))
+		if isPermanentWriteError(err) {
+			return &rejectError{code: codeImplementationSpecific, err: err}
+		}
+		return err
+	}
+	atomic.AddInt64(&statistics.HandlerStat.PointsWrittenOK, int64(len(rows)))
+	return nil
+}
+
+// isPermanentWriteError returns true if the points are never written however many times the message is published
+func isPermanentWriteError(err error) bool {
+	var partialErr netstorage.PartialWriteError
+	return influxdb.IsClientError(err) || influxdb.IsAuthorizationError(err) || errors.As(err, &partialErr) ||
+		errno.Equal(err, errno.DatabaseNotFound) || strings.HasPrefix(err.Error(), "retention policy not found")
+}
+
+func authorizeWriteRows(user meta.User, database string, rows []influx.Row) error {
+	var authorized string
+	for i := range rows {
+		name := rows[i].Name
+		if name == authorized {
+			continue
+		}
+		if !user.AuthorizeMeasurement(influxql.WritePrivilege, database, name) {
+			return fmt.Errorf("%s not authorized to write to %s", user.ID(), influxql.QuoteIdent(database, name))
+		}
+		authorized = name
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	originql "github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWriter struct {
+	mu   sync.Mutex
+	rows map[string][]influx.Row
+	err  error
+}
+
+func (w *mockWriter) RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return w.err
+	}
+	if w.rows == nil {
+		w.rows = make(map[string][]influx.Row)
+	}
+	w.rows[database] = append(w.rows[database], rows...)
+	return nil
+}
+
+func (w *mockWriter) written(database string) []influx.Row {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rows[database]
+}
+
+type mockAuthorizer struct{}
+
+func (a *mockAuthorizer) Authenticate(username, password string) (meta.User, error) {
+	if password != "pass" {
+		return nil, fmt.Errorf("authentication failed")
+	}
+	return &meta.UserInfo{Name: username, Privileges: map[string]originql.Privilege{"db0": originql.WritePrivilege}}, nil
+}
+
+func newTestService(t *testing.T, authEnabled bool) (*Service, *mockWriter) {
+	conf := config.NewMQTTConfig()
+	conf.Enabled = true
+	conf.BindAddress = "127.0.0.1:0"
+	conf.AuthEnabled = authEnabled
+	conf.MaxPacketSize = 1024
+	conf.Topics = []config.MQTTTopic{
+		{Template: "{database}/{measurement}/{device}", Format: config.MQTTFormatValue},
+		{Template: "line/{database}/#"},
+	}
+	s, err := NewService(conf)
+	require.NoError(t, err)
+	w := &mockWriter{}
+	s.WithWriter(w)
+	s.WithAuthorizer(&mockAuthorizer{})
+	require.NoError(t, s.Open())
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+	return s, w
+}
+
+type testClient struct {
+	t *testing.T
+	c net.Conn
+	r *bufio.Reader
+}
+
+func dial(t *testing.T, s *Service) *testClient {
+	c, err := net.Dial("tcp", s.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+	return &testClient{t: t, c: c, r: bufio.NewReader(c)}
+}
+
+func (c *testClient) send(b []byte) {
+	_, err := c.c.Write(b)
+	require.NoError(c.t, err)
+}
+
+func (c *testClient) receive() *packet {
+	_ = c.c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	p, err := readPacket(c.r, 1024)
+	require.NoError(c.t, err)
+	return p
+}
+
+func (c *testClient) closed() bool {
+	_ = c.c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err := c.r.ReadByte()
+	return err == io.EOF
+}
+
+func TestService_Publish(t *testing.T) {
+	s, w := newTestService(t, false)
+	for _, version := range []byte{protocolV311, protocolV5} {
+		c := dial(t, s)
+		c.send(encodeConnect(version, "client1", "", "", 60))
+		p := c.receive()
+		require.Equal(t, packetConnack, p.kind)
+		require.Equal(t, byte(0), p.body[1])
+
+		c.send(encodePublish(version, 0, 0, "db0/temperature/d1", "21.5"))
+		c.send(encodePublish(version, 1, 1, "line/db0/x/y", "cpu,host=h1 value=1\ncpu,host=h2 value=2"))
+		p = c.receive()
+		require.Equal(t, encodePuback(version, 1, codeSuccess), appendPacket(nil, p.kind, p.flags, p.body))
+
+		// the rejected messages are acknowledged, with the reason code of MQTT 5
+		c.send(encodePublish(version, 1, 2, "db0/temperature", "21.5"))
+		p = c.receive()
+		require.Equal(t, encodePuback(version, 2, codeTopicNameInvalid), appendPacket(nil, p.kind, p.flags, p.body))
+		c.send(encodePublish(version, 1, 3, "line/db0", "cpu value="))
+		p = c.receive()
+		require.Equal(t, encodePuback(version, 3, codePayloadFormatInvalid), appendPacket(nil, p.kind, p.flags, p.body))
+
+		c.send(appendPacket(nil, packetPingreq, 0, nil))
+		require.Equal(t, packetPingresp, c.receive().kind)
+
+		subscribe := binary16(4)
+		if version == protocolV5 {
+			subscribe = append(subscribe, 0)
+		}
+		subscribe = append(appendString(subscribe, "a/#"), 1)
+		c.send(appendPacket(nil, packetSubscribe, subscribeFlags, subscribe))
+		require.Equal(t, encodeSuback(version, 4, 1), func() []byte {
+			p := c.receive()
+			return appendPacket(nil, p.kind, p.flags, p.body)
+		}())
+
+		c.send(appendPacket(nil, packetDisconnect, 0, nil))
+		require.True(t, c.closed())
+	}
+
+	rows := w.written("db0")
+	require.Len(t, rows, 6)
+	require.Equal(t, "temperature", rows[0].Name)
+	require.Equal(t, influx.PointTags{{Key: "device", Value: "d1"}}, rows[0].Tags)
+	require.Equal(t, "cpu", rows[2].Name)
+}
+
+func TestService_WriteFailed(t *testing.T) {
+	s, w := newTestService(t, false)
+	w.err = fmt.Errorf("shard is not available")
+
+	// the message is not acknowledged so that the client publishes it again
+	c := dial(t, s)
+	c.send(encodeConnect(protocolV5, "", "", "", 0))
+	p := c.receive()
+	require.Equal(t, packetConnack, p.kind)
+	require.Contains(t, string(p.body), "opengemini-")
+	c.send(encodePublish(protocolV5, 1, 1, "db0/temperature/d1", "21.5"))
+	require.Equal(t, encodeDisconnect(codeUnspecifiedError), func() []byte {
+		p := c.receive()
+		return appendPacket(nil, p.kind, p.flags, p.body)
+	}())
+	require.True(t, c.closed())
+
+	c = dial(t, s)
+	c.send(encodeConnect(protocolV311, "client1", "", "", 0))
+	c.receive()
+	c.send(encodePublish(protocolV311, 1, 1, "db0/temperature/d1", "21.5"))
+	require.True(t, c.closed())
+
+	// QoS 2 is not supported
+	c = dial(t, s)
+	c.send(encodeConnect(protocolV5, "client1", "", "", 0))
+	c.receive()
+	c.send(encodePublish(protocolV5, 2, 1, "db0/temperature/d1", "21.5"))
+	require.Equal(t, []byte{codeQoSNotSupported}, c.receive().body)
+}
+
+func TestService_Auth(t *testing.T) {
+	s, w := newTestService(t, true)
+
+	for _, item := range []struct {
+		version  byte
+		username string
+		password string
+		code     byte
+	}{
+		{protocolV311, "", "", 5},
+		{protocolV311, "user", "wrong", 4},
+		{protocolV5, "user", "wrong", codeBadUsernameOrPassword},
+	} {
+		c := dial(t, s)
+		c.send(encodeConnect(item.version, "client1", item.username, item.password, 0))
+		p := c.receive()
+		require.Equal(t, packetConnack, p.kind)
+		require.Equal(t, item.code, p.body[1])
+		require.True(t, c.closed())
+	}
+
+	c := dial(t, s)
+	c.send(encodeConnect(protocolV5, "client1", "user", "pass", 0))
+	require.Equal(t, byte(0), c.receive().body[1])
+	c.send(encodePublish(protocolV5, 1, 1, "db0/temperature/d1", "21.5"))
+	require.Equal(t, encodePuback(protocolV5, 1, codeSuccess), func() []byte {
+		p := c.receive()
+		return appendPacket(nil, p.kind, p.flags, p.body)
+	}())
+	c.send(encodePublish(protocolV5, 1, 2, "db1/temperature/d1", "21.5"))
+	require.Equal(t, encodePuback(protocolV5, 2, codeNotAuthorized), func() []byte {
+		p := c.receive()
+		return appendPacket(nil, p.kind, p.flags, p.body)
+	}())
+	require.Len(t, w.written("db0"), 1)
+	require.Len(t, w.written("db1"), 0)
+}
+
+func TestService_Protocol(t *testing.T) {
+	s, _ := newTestService(t, false)
+
+	// unsupported protocol version
+	c := dial(t, s)
+	b := encodeConnect(protocolV311, "client1", "", "", 0)
+	b[8] = 3
+	c.send(b)
+	require.Equal(t, []byte{0, 1}, c.receive().body)
+	require.True(t, c.closed())
+
+	// the first packet must be CONNECT
+	c = dial(t, s)
+	c.send(appendPacket(nil, packetPingreq, 0, nil))
+	require.True(t, c.closed())
+
+	// the packet is too large
+	c = dial(t, s)
+	c.send(encodeConnect(protocolV5, "client1", "", "", 0))
+	c.receive()
+	c.send(encodePublish(protocolV5, 0, 0, "db0/temperature/d1", string(make([]byte, 2048))))
+	require.Equal(t, []byte{codePacketTooLarge}, c.receive().body)
+	require.True(t, c.closed())
+}
+
+func binary16(v uint16) []byte {
+	return []byte{byte(v >> 8), byte(v)}
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	placeholderDatabase        = "database"
+	placeholderRetentionPolicy = "retention_policy"
+	placeholderMeasurement     = "measurement"
+)
+
+type levelKind int
+
+const (
+	levelLiteral levelKind = iota
+	levelSingle            // the wildcard "+"
+	levelMulti             // the wildcard "#", which is the last level
+	levelDatabase
+	levelRetentionPolicy
+	levelMeasurement
+	levelTag
+)
+
+type level struct {
+	kind  levelKind
+	value string // the literal or the tag key
+}
+
+// topic is the compiled template of a configured topic
+type topic struct {
+	conf   config.MQTTTopic
+	levels []level
+
+	tsMultiplier int64
+}
+
+// destination is where the points of a message are written, and the tags taken from the topic name
+type destination struct {
+	database        string
+	retentionPolicy string
+	measurement     string
+	tags            influx.PointTags
+}
+
+func newTopic(conf config.MQTTTopic) (*topic, error) {
+	if conf.Format == "" {
+		conf.Format = config.MQTTFormatLine
+	}
+	if conf.Field == "" {
+		conf.Field = config.DefaultMQTTValueField
+	}
+	if conf.TimeKey == "" {
+		conf.TimeKey = config.DefaultMQTTTimeKey
+	}
+	t := &topic{conf: conf, tsMultiplier: precisionMultiplier(conf.Precision)}
+
+	parts := strings.Split(conf.Template, "/")
+	tagKeys := make(map[string]struct{})
+	for i, part := range parts {
+		l := level{kind: levelLiteral, value: part}
+		switch {
+		case part == "+":
+			l.kind = levelSingle
+		case part == "#":
+			if i != len(parts)-1 {
+				return nil, fmt.Errorf("mqtt topic %s: # must be the last level", conf.Template)
+			}
+			l.kind = levelMulti
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") && len(part) > 2:
+			l.kind, l.value = placeholderKind(part[1 : len(part)-1])
+			if l.kind == levelTag {
+				if _, ok := tagKeys[l.value]; ok {
+					return nil, fmt.Errorf("mqtt topic %s: placeholder {%s} is used more than once", conf.Template, l.value)
+				}
+				tagKeys[l.value] = struct{}{}
+			}
+		case strings.ContainsAny(part, "+#{}"):
+			return nil, fmt.Errorf("mqtt topic %s: invalid level %s", conf.Template, part)
+		}
+		t.levels = append(t.levels, l)
+	}
+	return t, nil
+}
+
+func placeholderKind(name string) (levelKind, string) {
+	switch name {
+	case placeholderDatabase:
+		return levelDatabase, ""
+	case placeholderRetentionPolicy:
+		return levelRetentionPolicy, ""
+	case placeholderMeasurement:
+		return levelMeasurement, ""
+	default:
+		return levelTag, name
+	}
+}
+
+// match returns the destination of the topic name, it returns false if the name does not match the template
+func (t *topic) match(name string) (*destination, bool) {
+	parts := strings.Split(name, "/")
+	dst := &destination{
+		database:        t.conf.Database,
+		retentionPolicy: t.conf.RetentionPolicy,
+		measurement:     t.conf.Measurement,
+	}
+	for i, l := range t.levels {
+		if l.kind == levelMulti {
+			break
+		}
+		if i >= len(parts) {
+			return nil, false
+		}
+		part := parts[i]
+		switch l.kind {
+		case levelLiteral:
+			if part != l.value {
+				return nil, false
+			}
+		case levelDatabase:
+			dst.database = part
+		case levelRetentionPolicy:
+			dst.retentionPolicy = part
+		case levelMeasurement:
+			dst.measurement = part
+		case levelTag:
+			if part != "" {
+				dst.tags = append(dst.tags, influx.Tag{Key: l.value, Value: part})
+			}
+		}
+	}
+	if last := t.levels[len(t.levels)-1]; last.kind != levelMulti && len(parts) != len(t.levels) {
+		return nil, false
+	}
+	if dst.database == "" {
+		return nil, false
+	}
+	sort.Sort(&dst.tags)
+	return dst, true
+}
+
+// topics are the configured topics, the first topic whose template matches the topic name is used
+type topics []*topic
+
+func newTopics(confs []config.MQTTTopic) (topics, error) {
+	ts := make(topics, 0, len(confs))
+	for i := range confs {
+		t, err := newTopic(confs[i])
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	return ts, nil
+}
+
+func (ts topics) match(name string) (*topic, *destination) {
+	for _, t := range ts {
+		if dst, ok := t.match(name); ok {
+			return t, dst
+		}
+	}
+	return nil, nil
+}
+
+func precisionMultiplier(precision string) int64 {
+	switch precision {
+	case "u", "us", "µ":
+		return 1e3
+	case "ms":
+		return 1e6
+	case "s":
+		return 1e9
+	case "m":
+		return 1e9 * 60
+	case "h":
+		return 1e9 * 3600
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopic_Match(t *testing.T) {
+	ts, err := newTopics([]config.MQTTTopic{
+		{Template: "sensors/{database}/{retention_policy}/{measurement}/{device}"},
+		{Template: "devices/+/{room}/#", Database: "iot", Measurement: "temperature"},
+	})
+	require.NoError(t, err)
+
+	tp, dst := ts.match("sensors/db0/rp0/cpu/d1")
+	require.Equal(t, ts[0], tp)
+	require.Equal(t, &destination{database: "db0", retentionPolicy: "rp0", measurement: "cpu",
+		tags: influx.PointTags{{Key: "device", Value: "d1"}}}, dst)
+
+	tp, dst = ts.match("devices/d1/kitchen")
+	require.Equal(t, ts[1], tp)
+	require.Equal(t, &destination{database: "iot", measurement: "temperature",
+		tags: influx.PointTags{{Key: "room", Value: "kitchen"}}}, dst)
+	tp, _ = ts.match("devices/d1/kitchen/floor/1")
+	require.Equal(t, ts[1], tp)
+
+	for _, name := range []string{"sensors/db0/rp0/cpu", "sensors/db0/rp0/cpu/d1/x", "devices/d1", "other/d1/kitchen", "sensors//rp0/cpu/d1"} {
+		tp, _ = ts.match(name)
+		require.Nil(t, tp, name)
+	}
+
+	for _, template := range []string{"a/#/b", "a/b+", "a/{x}/{x}"} {
+		_, err = newTopic(config.MQTTTopic{Template: template, Database: "db0"})
+		require.Error(t, err, template)
+	}
+}
+
+func TestTopic_Parse(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+	dst := &destination{database: "db0", measurement: "mst", tags: influx.PointTags{{Key: "device", Value: "d1"}}}
+
+	tp, err := newTopic(config.MQTTTopic{Template: "a", Precision: "s"})
+	require.NoError(t, err)
+	rows, err := tp.parse([]byte("cpu,host=h1 value=1 1700000000\ncpu,device=d2,host=h2 value=2"), dst, now)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "cpu", rows[0].Name)
+	require.Equal(t, int64(1700000000*1e9), rows[0].Timestamp)
+	require.Equal(t, influx.PointTags{{Key: "device", Value: "d1"}, {Key: "host", Value: "h1"}}, rows[0].Tags)
+	require.Equal(t, now, rows[1].Timestamp)
+	require.Equal(t, influx.PointTags{{Key: "device", Value: "d2"}, {Key: "host", Value: "h2"}}, rows[1].Tags)
+	_, err = tp.parse([]byte("cpu,host=h1"), dst, now)
+	require.Error(t, err)
+	_, err = tp.parse([]byte(" \n"), dst, now)
+	require.Equal(t, errEmptyPayload, err)
+
+	tp, err = newTopic(config.MQTTTopic{Template: "a", Format: config.MQTTFormatJSON, Precision: "ms", TagKeys: []string{"location", "device"}})
+	require.NoError(t, err)
+	rows, err = tp.parse([]byte(`[{"temperature": 21.5, "ok": true, "status": "up", "location": 3, "time": 1700000000000},
+		{"temperature": 22, "device": "d3", "time": "2024-01-01T00:00:01Z", "note": null}]`), dst, now)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, influx.Row{
+		Name:      "mst",
+		Tags:      influx.PointTags{{Key: "device", Value: "d1"}, {Key: "location", Value: "3"}},
+		Fields:    influx.Fields{{Key: "temperature", NumValue: 21.5, Type: influx.Field_Type_Float}, {Key: "ok", NumValue: 1, Type: influx.Field_Type_Boolean}, {Key: "status", StrValue: "up", Type: influx.Field_Type_String}},
+		Timestamp: 1700000000000 * 1e6,
+	}, rows[0])
+	require.Equal(t, influx.PointTags{{Key: "device", Value: "d3"}}, rows[1].Tags)
+	require.Equal(t, now+int64(time.Second), rows[1].Timestamp)
+	require.Len(t, rows[1].Fields, 1)
+
+	for _, payload := range []string{`{"a": 1`, `1`, `{"a": [1]}`, `{"time": 1}`, `{"a": 1, "time": true}`} {
+		_, err = tp.parse([]byte(payload), dst, now)
+		require.Error(t, err, payload)
+	}
+
+	tp, err = newTopic(config.MQTTTopic{Template: "a", Format: config.MQTTFormatValue})
+	require.NoError(t, err)
+	for payload, field := range map[string]influx.Field{
+		" 21.5\n": {Key: "value", NumValue: 21.5, Type: influx.Field_Type_Float},
+		"true":    {Key: "value", NumValue: 1, Type: influx.Field_Type_Boolean},
+		"false":   {Key: "value", NumValue: 0, Type: influx.Field_Type_Boolean},
+		"on":      {Key: "value", StrValue: "on", Type: influx.Field_Type_String},
+	} {
+		rows, err = tp.parse([]byte(payload), dst, now)
+		require.NoError(t, err)
+		require.Equal(t, []influx.Row{{Name: "mst", Tags: dst.tags, Fields: influx.Fields{field}, Timestamp: now}}, rows)
+	}
+}
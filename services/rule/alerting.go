@@ -0,0 +1,319 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+)
+
+const (
+	// AlertMetricName is the metric name of the synthetic series recording the active alerts.
+	AlertMetricName = "ALERTS"
+	// AlertStateLabel is the label name indicating the state of an alert.
+	AlertStateLabel = "alertstate"
+
+	// resolvedRetention is how long the resolved alerts are kept and sent to the alertmanager.
+	resolvedRetention = 15 * time.Minute
+)
+
+type AlertState int
+
+const (
+	StateInactive AlertState = iota
+	StatePending
+	StateFiring
+)
+
+func (s AlertState) String() string {
+	switch s {
+	case StateInactive:
+		return "inactive"
+	case StatePending:
+		return "pending"
+	case StateFiring:
+		return "firing"
+	}
+	return fmt.Sprintf("unknown state: %d", s)
+}
+
+// Alert is the state of an alert generated by an alerting rule for a labelset.
+type Alert struct {
+	State       AlertState
+	Labels      labels.Labels
+	Annotations labels.Labels
+	Value       float64
+
+	ActiveAt   time.Time
+	FiredAt    time.Time
+	ResolvedAt time.Time
+	LastSentAt time.Time
+	ValidUntil time.Time
+}
+
+func (a *Alert) needsSending(ts time.Time, resendDelay time.Duration) bool {
+	if a.State == StatePending {
+		return false
+	}
+	// the alert is resolved since the last notification
+	if a.ResolvedAt.After(a.LastSentAt) {
+		return true
+	}
+	return a.LastSentAt.Add(resendDelay).Before(ts)
+}
+
+// AlertingRule generates alerts from its expression. An alert is pending when the expression
+// returns a sample for its labelset, and becomes firing once it has been pending for the hold duration.
+type AlertingRule struct {
+	name         string
+	expr         string
+	holdDuration time.Duration
+	labels       labels.Labels
+	annotations  labels.Labels
+
+	mu     sync.RWMutex
+	active map[uint64]*Alert
+
+	evaluation
+}
+
+func NewAlertingRule(name, expr string, hold time.Duration, lset, annotations labels.Labels) *AlertingRule {
+	return &AlertingRule{
+		name:         name,
+		expr:         expr,
+		holdDuration: hold,
+		labels:       lset,
+		annotations:  annotations,
+		active:       make(map[uint64]*Alert),
+		evaluation:   newEvaluation(),
+	}
+}
+
+func (r *AlertingRule) Name() string {
+	return r.name
+}
+
+func (r *AlertingRule) Query() string {
+	return r.expr
+}
+
+func (r *AlertingRule) Type() string {
+	return TypeAlerting
+}
+
+func (r *AlertingRule) HoldDuration() time.Duration {
+	return r.holdDuration
+}
+
+func (r *AlertingRule) Labels() labels.Labels {
+	return r.labels
+}
+
+func (r *AlertingRule) Annotations() labels.Labels {
+	return r.annotations
+}
+
+// Eval updates the state of the alerts and returns the ALERTS series of the pending and firing alerts.
+func (r *AlertingRule) Eval(ctx context.Context, ts time.Time, querier Querier) (promql.Vector, error) {
+	res, err := querier.Query(ctx, r.expr, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make(map[uint64]*Alert, len(res))
+	for _, smpl := range res {
+		expand := func(text string) string {
+			return expandTemplate(r.name, text, smpl.Metric, smpl.V)
+		}
+
+		lb := labels.NewBuilder(smpl.Metric).Del(labels.MetricName)
+		for _, l := range r.labels {
+			lb.Set(l.Name, expand(l.Value))
+		}
+		lb.Set(labels.AlertName, r.name)
+
+		annotations := make(labels.Labels, 0, len(r.annotations))
+		for _, a := range r.annotations {
+			annotations = append(annotations, labels.Label{Name: a.Name, Value: expand(a.Value)})
+		}
+
+		lset := lb.Labels()
+		h := lset.Hash()
+		if _, ok := alerts[h]; ok {
+			return nil, fmt.Errorf("vector contains metrics with the same labelset after applying alert labels")
+		}
+		alerts[h] = &Alert{
+			State:       StatePending,
+			Labels:      lset,
+			Annotations: annotations,
+			Value:       smpl.V,
+			ActiveAt:    ts,
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for h, a := range alerts {
+		// an alert which is pending or firing keeps its active time
+		if alert, ok := r.active[h]; ok && alert.State != StateInactive {
+			alert.Value = a.Value
+			alert.Annotations = a.Annotations
+			continue
+		}
+		r.active[h] = a
+	}
+
+	var vector promql.Vector
+	for h, a := range r.active {
+		if _, ok := alerts[h]; !ok {
+			// the pending alert is dropped directly, and the resolved alert is kept for a while to be notified
+			if a.State == StatePending || (!a.ResolvedAt.IsZero() && ts.Sub(a.ResolvedAt) > resolvedRetention) {
+				delete(r.active, h)
+			}
+			if a.State != StateInactive {
+				a.State = StateInactive
+				a.ResolvedAt = ts
+			}
+			continue
+		}
+
+		if a.State == StatePending && ts.Sub(a.ActiveAt) >= r.holdDuration {
+			a.State = StateFiring
+			a.FiredAt = ts
+		}
+		vector = append(vector, alertSample(a, ts))
+	}
+	return vector, nil
+}
+
+func alertSample(a *Alert, ts time.Time) promql.Sample {
+	lb := labels.NewBuilder(a.Labels)
+	lb.Set(labels.MetricName, AlertMetricName)
+	lb.Set(AlertStateLabel, a.State.String())
+	return promql.Sample{
+		Metric: lb.Labels(),
+		Point:  promql.Point{T: ts.UnixMilli(), V: 1},
+	}
+}
+
+// reset drops the state of the alerts.
+func (r *AlertingRule) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.active) > 0 {
+		r.active = make(map[uint64]*Alert)
+	}
+}
+
+// ActiveAlerts returns the copies of the pending and firing alerts.
+func (r *AlertingRule) ActiveAlerts() []*Alert {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	alerts := make([]*Alert, 0, len(r.active))
+	for _, a := range r.active {
+		if a.State != StateInactive {
+			alert := *a
+			alerts = append(alerts, &alert)
+		}
+	}
+	sortAlerts(alerts)
+	return alerts
+}
+
+// State returns the maximum state of the alerts of the rule.
+func (r *AlertingRule) State() AlertState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	state := StateInactive
+	for _, a := range r.active {
+		if a.State > state {
+			state = a.State
+		}
+	}
+	return state
+}
+
+// alertsToSend returns the copies of the alerts that need to be sent to the alertmanager,
+// and marks them as sent.
+func (r *AlertingRule) alertsToSend(ts time.Time, resendDelay, interval time.Duration) []*Alert {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the firing alerts are considered resolved by the alertmanager if they are not resent in time
+	delta := resendDelay
+	if interval > resendDelay {
+		delta = interval
+	}
+
+	var alerts []*Alert
+	for _, a := range r.active {
+		if !a.needsSending(ts, resendDelay) {
+			continue
+		}
+		a.LastSentAt = ts
+		if a.ResolvedAt.IsZero() {
+			a.ValidUntil = ts.Add(4 * delta)
+		} else {
+			a.ValidUntil = a.ResolvedAt
+		}
+		alert := *a
+		alerts = append(alerts, &alert)
+	}
+	sortAlerts(alerts)
+	return alerts
+}
+
+func sortAlerts(alerts []*Alert) {
+	sort.Slice(alerts, func(i, j int) bool {
+		return labels.Compare(alerts[i].Labels, alerts[j].Labels) < 0
+	})
+}
+
+// expandTemplate expands the template of the labels and annotations of an alert.
+// The labels and value of the sample are available as $labels and $value, as same as Prometheus.
+func expandTemplate(name, text string, lset labels.Labels, value float64) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	defs := "{{$labels := .Labels}}{{$value := .Value}}"
+	tmpl, err := template.New("__alert_" + name).Option("missingkey=zero").Parse(defs + text)
+	if err != nil {
+		return fmt.Sprintf("<error expanding template: %v>", err)
+	}
+	data := struct {
+		Labels map[string]string
+		Value  float64
+	}{
+		Labels: lset.Map(),
+		Value:  value,
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return fmt.Sprintf("<error expanding template: %v>", err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,216 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/prometheus/prometheus/promql"
+	"go.uber.org/zap"
+)
+
+type PointsWriter interface {
+	RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
+}
+
+// GroupOptions are the dependencies shared by all rule groups.
+type GroupOptions struct {
+	Querier         Querier
+	PointsWriter    PointsWriter
+	Notifier        *Notifier // nil if no alertmanager is configured
+	Database        string
+	RetentionPolicy string
+	ResendDelay     time.Duration
+	Logger          *logger.Logger
+	IsOwner         func() bool // the groups are evaluated only if it returns true, nil means always
+}
+
+// Group is a set of rules evaluated sequentially at the same interval.
+type Group struct {
+	name     string
+	file     string
+	interval time.Duration
+	rules    []Rule
+	opts     *GroupOptions
+
+	mu                 sync.RWMutex
+	lastEvaluation     time.Time
+	evaluationDuration time.Duration
+}
+
+func NewGroup(name, file string, interval time.Duration, rules []Rule, opts *GroupOptions) *Group {
+	return &Group{
+		name:     name,
+		file:     file,
+		interval: interval,
+		rules:    rules,
+		opts:     opts,
+	}
+}
+
+func (g *Group) Name() string {
+	return g.name
+}
+
+func (g *Group) File() string {
+	return g.file
+}
+
+func (g *Group) Interval() time.Duration {
+	return g.interval
+}
+
+func (g *Group) Rules() []Rule {
+	return g.rules
+}
+
+func (g *Group) LastEvaluation() time.Time {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.lastEvaluation
+}
+
+func (g *Group) EvaluationDuration() time.Duration {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.evaluationDuration
+}
+
+// run evaluates the group at the multiples of its interval until ctx is done.
+func (g *Group) run(ctx context.Context) {
+	evalTimestamp := time.Now().Truncate(g.interval).Add(g.interval)
+	timer := time.NewTimer(time.Until(evalTimestamp))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if g.opts.IsOwner == nil || g.opts.IsOwner() {
+				g.Eval(ctx, evalTimestamp)
+			}
+
+			// skip the missed evaluations if the last one takes too long
+			evalTimestamp = evalTimestamp.Add(g.interval)
+			if now := time.Now(); evalTimestamp.Before(now) {
+				g.opts.Logger.Warn("rule group evaluation is slower than its interval",
+					zap.String("group", g.name), zap.Duration("interval", g.interval))
+				evalTimestamp = now.Truncate(g.interval).Add(g.interval)
+			}
+			timer.Reset(time.Until(evalTimestamp))
+		}
+	}
+}
+
+// Eval evaluates all rules of the group at ts, writes the results back and sends the alerts.
+func (g *Group) Eval(ctx context.Context, ts time.Time) {
+	start := time.Now()
+	var alerts []*Alert
+	for _, rule := range g.rules {
+		begin := time.Now()
+		vector, err := rule.Eval(ctx, ts, g.opts.Querier)
+		if err == nil && len(vector) > 0 {
+			err = g.opts.PointsWriter.RetryWritePointRows(g.opts.Database, g.opts.RetentionPolicy, vectorToRows(vector))
+		}
+		rule.SetEvaluation(ts, time.Since(begin), err)
+		if err != nil {
+			g.opts.Logger.Warn("evaluate rule failed", zap.String("group", g.name), zap.String("rule", rule.Name()), zap.Error(err))
+		}
+
+		if ar, ok := rule.(*AlertingRule); ok && g.opts.Notifier != nil {
+			alerts = append(alerts, ar.alertsToSend(ts, g.opts.ResendDelay, g.interval)...)
+		}
+	}
+
+	if len(alerts) > 0 {
+		if err := g.opts.Notifier.Send(ctx, alerts); err != nil {
+			g.opts.Logger.Error("send alerts failed", zap.String("group", g.name), zap.Int("alerts", len(alerts)), zap.Error(err))
+		}
+	}
+
+	g.mu.Lock()
+	g.lastEvaluation = ts
+	g.evaluationDuration = time.Since(start)
+	g.mu.Unlock()
+}
+
+// vectorToRows converts the samples to the rows in the same way as the Prometheus remote write.
+func vectorToRows(vector promql.Vector) []influx.Row {
+	rows := make([]influx.Row, 0, len(vector))
+	for _, s := range vector {
+		tags := make(influx.PointTags, 0, len(s.Metric))
+		for _, l := range s.Metric {
+			tags = append(tags, influx.Tag{Key: l.Name, Value: l.Value})
+		}
+		rows = append(rows, influx.Row{
+			Name:      s.Metric.Get(labels.MetricName),
+			Tags:      tags,
+			Timestamp: s.T * int64(time.Millisecond),
+			Fields: []influx.Field{
+				{
+					Type:     influx.Field_Type_Float,
+					Key:      promql2influxql.DefaultFieldKey,
+					NumValue: s.V,
+				},
+			},
+		})
+	}
+	return rows
+}
+
+// LoadGroups loads the rule groups from the Prometheus rule files.
+func LoadGroups(patterns []string, defaultInterval time.Duration, opts *GroupOptions) ([]*Group, error) {
+	var groups []*Group
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			rgs, errs := rulefmt.ParseFile(file)
+			if len(errs) > 0 {
+				return nil, fmt.Errorf("load rule file %s failed: %v", file, errs[0])
+			}
+			for _, rg := range rgs.Groups {
+				interval := time.Duration(rg.Interval)
+				if interval == 0 {
+					interval = defaultInterval
+				}
+
+				rules := make([]Rule, 0, len(rg.Rules))
+				for _, r := range rg.Rules {
+					if r.Alert.Value != "" {
+						rules = append(rules, NewAlertingRule(r.Alert.Value, r.Expr.Value, time.Duration(r.For),
+							labels.FromMap(r.Labels), labels.FromMap(r.Annotations)))
+						continue
+					}
+					rules = append(rules, NewRecordingRule(r.Record.Value, r.Expr.Value, labels.FromMap(r.Labels)))
+				}
+				groups = append(groups, NewGroup(rg.Name, file, interval, rules, opts))
+			}
+		}
+	}
+	return groups, nil
+}
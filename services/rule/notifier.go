@@ -0,0 +1,90 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// notifyAlert is the alert format of the Alertmanager API v2.
+type notifyAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt,omitempty"`
+	EndsAt       time.Time         `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// Notifier posts the alerts to an Alertmanager-compatible webhook.
+type Notifier struct {
+	url     string
+	timeout time.Duration
+	client  *http.Client
+}
+
+func NewNotifier(url string, timeout time.Duration) *Notifier {
+	return &Notifier{
+		url:     url,
+		timeout: timeout,
+		client:  &http.Client{},
+	}
+}
+
+func (n *Notifier) Send(ctx context.Context, alerts []*Alert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	payload := make([]notifyAlert, 0, len(alerts))
+	for _, a := range alerts {
+		payload = append(payload, notifyAlert{
+			Labels:      a.Labels.Map(),
+			Annotations: a.Annotations.Map(),
+			StartsAt:    a.FiredAt,
+			EndsAt:      a.ValidUntil,
+		})
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("bad response status %s", resp.Status)
+	}
+	return nil
+}
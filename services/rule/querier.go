@@ -0,0 +1,209 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/op"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+const (
+	DefaultChunkSize      = 10000
+	DefaultInnerChunkSize = 1024
+)
+
+type QueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result
+}
+
+// Querier evaluates a PromQL expression at the given time and returns an instant vector.
+type Querier interface {
+	Query(ctx context.Context, qs string, ts time.Time) (promql.Vector, error)
+}
+
+// PromQuerier evaluates the PromQL expressions through the same path as /api/v1/query:
+// the expression is transpiled to InfluxQL and executed by the query executor.
+type PromQuerier struct {
+	QueryExecutor   QueryExecutor
+	Database        string
+	RetentionPolicy string
+}
+
+func NewPromQuerier(executor QueryExecutor, database, rp string) *PromQuerier {
+	return &PromQuerier{
+		QueryExecutor:   executor,
+		Database:        database,
+		RetentionPolicy: rp,
+	}
+}
+
+func (q *PromQuerier) Query(ctx context.Context, qs string, ts time.Time) (promql.Vector, error) {
+	// the expression is parsed on every evaluation, because transpiling modifies the offsets of the selectors
+	expr, err := parser.ParseExpr(qs)
+	if err != nil {
+		return nil, err
+	}
+	promCommand := promql2influxql.PromCommand{
+		Cmd:             qs,
+		Database:        q.Database,
+		RetentionPolicy: q.RetentionPolicy,
+		Evaluation:      &ts,
+		LookBackDelta:   promql2influxql.DefaultLookBackDelta,
+	}
+	transpiler := &promql2influxql.Transpiler{
+		PromCommand: promCommand,
+	}
+	nodes, err := transpiler.Transpile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch statement := nodes.(type) {
+	case *influxql.SelectStatement:
+		return q.execute(ctx, statement, expr, transpiler)
+	case *influxql.Call, *influxql.BinaryExpr, *influxql.IntegerLiteral, *influxql.NumberLiteral:
+		return evalPromExpr(statement.(influxql.Expr), ts)
+	default:
+		return nil, fmt.Errorf("invalid the select statement for promql")
+	}
+}
+
+func (q *PromQuerier) execute(ctx context.Context, statement *influxql.SelectStatement, expr parser.Expr, transpiler *promql2influxql.Transpiler) (promql.Vector, error) {
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		close(closing)
+	}()
+
+	opts := query.ExecutionOptions{
+		Database:        q.Database,
+		RetentionPolicy: q.RetentionPolicy,
+		ChunkSize:       DefaultChunkSize,
+		InnerChunkSize:  DefaultInnerChunkSize,
+		ReadOnly:        true,
+		Quiet:           true,
+		IsPromQuery:     true,
+		AbortCh:         closing,
+	}
+	results := q.QueryExecutor.ExecuteQuery(&influxql.Query{Statements: []influxql.Statement{statement}}, opts, closing, nil)
+
+	// pull all results from the channel, the errors of all the results are returned together
+	var result *query.Result
+	var errs []error
+	for r := range results {
+		if r == nil {
+			continue
+		}
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+			continue
+		}
+		if result == nil {
+			result = r
+			continue
+		}
+		result.Series = append(result.Series, r.Series...)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	receiver := &promql2influxql.Receiver{
+		PromCommand:     transpiler.PromCommand,
+		DropMetric:      transpiler.DropMetric(),
+		RemoveTableName: transpiler.RemoveTableName(),
+		DuplicateResult: transpiler.DuplicateResult(),
+	}
+	data, err := receiver.InfluxResultToPromQLValue(result, expr, transpiler.PromCommand)
+	if err != nil {
+		return nil, err
+	}
+	switch v := data.Result.(type) {
+	case promql.Vector:
+		return v, nil
+	case promql.Scalar:
+		return promql.Vector{promql.Sample{Point: promql.Point{T: v.T, V: v.V}, Metric: labels.Labels{}}}, nil
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("rule result must be a vector or a scalar, got %s", data.ResultType)
+	}
+}
+
+// evalPromExpr evaluates the expression which needs no data, e.g. vector(1).
+func evalPromExpr(expr influxql.Expr, ts time.Time) (promql.Vector, error) {
+	valuer := influxql.ValuerEval{
+		Valuer: influxql.MultiValuer(
+			op.Valuer{},
+			query.MathValuer{},
+			query.StringValuer{},
+			&promTimeValuer{ts: ts.UnixMilli()},
+			executor.PromTimeValuer{},
+		),
+		IntegerFloatDivision: true,
+	}
+	var v float64
+	switch value := valuer.Eval(expr).(type) {
+	case float64:
+		v = value
+	case int64:
+		v = float64(value)
+	default:
+		return nil, fmt.Errorf("unsupported rule expression: %s", expr.String())
+	}
+	return promql.Vector{promql.Sample{Point: promql.Point{T: ts.UnixMilli(), V: v}, Metric: labels.Labels{}}}, nil
+}
+
+// promTimeValuer provides the evaluation time for the time functions, e.g. time().
+type promTimeValuer struct {
+	ts int64 // ms
+}
+
+func (t *promTimeValuer) Value(key string) (interface{}, bool) {
+	if key == promql2influxql.ArgNameOfTimeFunc {
+		return float64(t.ts / 1000), true
+	}
+	return nil, false
+}
+
+func (t *promTimeValuer) Call(name string, args []interface{}) (interface{}, bool) {
+	if timeFunc := executor.GetPromTimeFuncInstance()[name]; timeFunc != nil && name == "timestamp_prom" {
+		return timeFunc.CallFunc(name, []interface{}{float64(t.ts / 1000)})
+	}
+	return nil, false
+}
+
+func (t *promTimeValuer) SetValuer(_ influxql.Valuer, _ int) {
+}
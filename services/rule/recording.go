@@ -0,0 +1,84 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+)
+
+// RecordingRule records the result of its expression as a new series.
+type RecordingRule struct {
+	name   string
+	expr   string
+	labels labels.Labels
+
+	evaluation
+}
+
+func NewRecordingRule(name, expr string, lset labels.Labels) *RecordingRule {
+	return &RecordingRule{
+		name:       name,
+		expr:       expr,
+		labels:     lset,
+		evaluation: newEvaluation(),
+	}
+}
+
+func (r *RecordingRule) Name() string {
+	return r.name
+}
+
+func (r *RecordingRule) Query() string {
+	return r.expr
+}
+
+func (r *RecordingRule) Type() string {
+	return TypeRecording
+}
+
+func (r *RecordingRule) Labels() labels.Labels {
+	return r.labels
+}
+
+// Eval renames the result series to the record name and overrides their labels by the rule labels.
+func (r *RecordingRule) Eval(ctx context.Context, ts time.Time, querier Querier) (promql.Vector, error) {
+	vector, err := querier.Query(ctx, r.expr, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]struct{}, len(vector))
+	for i := range vector {
+		lb := labels.NewBuilder(vector[i].Metric)
+		lb.Set(labels.MetricName, r.name)
+		for _, l := range r.labels {
+			lb.Set(l.Name, l.Value)
+		}
+		vector[i].Metric = lb.Labels()
+		vector[i].T = ts.UnixMilli()
+
+		h := vector[i].Metric.Hash()
+		if _, ok := seen[h]; ok {
+			return nil, fmt.Errorf("vector contains metrics with the same labelset after applying rule labels")
+		}
+		seen[h] = struct{}{}
+	}
+	return vector, nil
+}
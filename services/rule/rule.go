@@ -0,0 +1,102 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/promql"
+)
+
+type Health string
+
+// The health of the rule.
+const (
+	HealthUnknown Health = "unknown"
+	HealthGood    Health = "ok"
+	HealthBad     Health = "err"
+)
+
+const (
+	TypeRecording = "recording"
+	TypeAlerting  = "alerting"
+)
+
+// Rule is a recording rule or an alerting rule.
+type Rule interface {
+	Name() string
+	Query() string
+	Type() string
+	// Eval evaluates the rule at the given time and returns the samples to be written back.
+	Eval(ctx context.Context, ts time.Time, querier Querier) (promql.Vector, error)
+	// SetEvaluation records the result of the last evaluation.
+	SetEvaluation(ts time.Time, duration time.Duration, err error)
+	Health() Health
+	LastError() error
+	LastEvaluation() time.Time
+	EvaluationDuration() time.Duration
+}
+
+// evaluation records the result of the last evaluation of a rule.
+type evaluation struct {
+	mu                 sync.RWMutex
+	health             Health
+	lastError          error
+	lastEvaluation     time.Time
+	evaluationDuration time.Duration
+}
+
+func newEvaluation() evaluation {
+	return evaluation{health: HealthUnknown}
+}
+
+func (e *evaluation) SetEvaluation(ts time.Time, duration time.Duration, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lastEvaluation = ts
+	e.evaluationDuration = duration
+	e.lastError = err
+	if err != nil {
+		e.health = HealthBad
+	} else {
+		e.health = HealthGood
+	}
+}
+
+func (e *evaluation) Health() Health {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.health
+}
+
+func (e *evaluation) LastError() error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.lastError
+}
+
+func (e *evaluation) LastEvaluation() time.Time {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.lastEvaluation
+}
+
+func (e *evaluation) EvaluationDuration() time.Duration {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.evaluationDuration
+}
@@ -0,0 +1,348 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"go.uber.org/zap"
+)
+
+// leaseInterval is the interval to renew the rule lease, it is less than the lease tolerance of the meta.
+var leaseInterval = time.Second
+
+// MetaClient grants the rule lease, only the ts-sql holding it evaluates the rules.
+type MetaClient interface {
+	// GetRuleLease returns the ts-sql holding the rule lease, it is granted to host if no other ts-sql holds it.
+	GetRuleLease(host string) (string, error)
+}
+
+// Service evaluates the Prometheus recording and alerting rules loaded from the rule files.
+// The state of the rules and alerts is kept in the memory of the ts-sql holding the rule lease, the other
+// ts-sql forward the requests of /api/v1/rules and /api/v1/alerts to it. When another ts-sql takes over
+// the lease, it evaluates the alerts from scratch: the pending alerts start their hold duration again,
+// and the firing alerts are pending until they have been active for the hold duration.
+type Service struct {
+	config   config.RuleConfig
+	hostname string
+	logger   *logger.Logger
+
+	// MetaClient makes the rules evaluated by a single ts-sql, every group is evaluated
+	// by this ts-sql if it is nil.
+	MetaClient    MetaClient
+	QueryExecutor QueryExecutor
+	PointsWriter  PointsWriter
+	// Querier evaluates the rule expressions, it queries through QueryExecutor by default.
+	Querier Querier
+
+	mu        sync.RWMutex
+	groups    []*Group
+	owner     bool   // holds the rule lease
+	leaseHost string // the ts-sql holding the rule lease, empty if unknown
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// NewService creates a new Service instance named rule
+func NewService(hostname string, c config.RuleConfig) *Service {
+	return &Service{
+		config:   c,
+		hostname: hostname,
+		logger:   logger.NewLogger(errno.ModuleUnknown).With(zap.String("service", "rule")),
+	}
+}
+
+func (s *Service) WithLogger(logger *logger.Logger) {
+	s.logger = logger.With(zap.String("service", "rule"))
+}
+
+func (s *Service) Open() error {
+	if s.cancel != nil {
+		return nil
+	}
+	if s.Querier == nil {
+		s.Querier = NewPromQuerier(s.QueryExecutor, s.config.Database, s.config.RetentionPolicy)
+	}
+
+	opts := &GroupOptions{
+		Querier:         s.Querier,
+		PointsWriter:    s.PointsWriter,
+		Database:        s.config.Database,
+		RetentionPolicy: s.config.RetentionPolicy,
+		ResendDelay:     time.Duration(s.config.ResendDelay),
+		Logger:          s.logger,
+		IsOwner:         s.IsOwner,
+	}
+	if s.config.AlertmanagerURL != "" {
+		opts.Notifier = NewNotifier(s.config.AlertmanagerURL, time.Duration(s.config.NotifyTimeout))
+	}
+	groups, err := LoadGroups(s.config.RuleFiles, time.Duration(s.config.EvaluationInterval), opts)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Starting rule service", zap.Int("groups", len(groups)))
+	s.mu.Lock()
+	s.groups = groups
+	s.owner = s.MetaClient == nil
+	s.mu.Unlock()
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	if s.MetaClient != nil {
+		s.wg.Add(1)
+		go s.renewLease(ctx)
+	}
+	for _, g := range groups {
+		s.wg.Add(1)
+		go func(g *Group) {
+			defer s.wg.Done()
+			g.run(ctx)
+		}(g)
+	}
+	return nil
+}
+
+func (s *Service) Close() error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.logger.Info("Closing rule service")
+	s.cancel()
+	s.wg.Wait()
+	s.cancel = nil
+	return nil
+}
+
+// renewLease acquires and renews the rule lease, the groups are evaluated only when this ts-sql holds it.
+func (s *Service) renewLease(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(leaseInterval)
+	defer ticker.Stop()
+
+	for {
+		leaseHost, err := s.MetaClient.GetRuleLease(s.hostname)
+		if err != nil {
+			s.logger.Warn("get rule lease failed", zap.Error(err))
+			leaseHost = ""
+		}
+		owner := leaseHost == s.hostname
+		s.mu.Lock()
+		if owner != s.owner {
+			s.logger.Info("rule lease changed", zap.String("host", s.hostname), zap.Bool("owner", owner))
+		}
+		s.owner = owner
+		s.leaseHost = leaseHost
+		s.mu.Unlock()
+
+		// the state of the alerts is outdated once another ts-sql evaluates them
+		if leaseHost != "" && !owner {
+			s.resetAlerts()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsOwner returns true if this ts-sql evaluates the rules.
+func (s *Service) IsOwner() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.owner
+}
+
+// RuleOwner returns the ts-sql holding the rule lease if it is not this one, otherwise an empty string.
+func (s *Service) RuleOwner() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.owner {
+		return ""
+	}
+	return s.leaseHost
+}
+
+func (s *Service) resetAlerts() {
+	for _, g := range s.Groups() {
+		for _, r := range g.Rules() {
+			if rule, ok := r.(*AlertingRule); ok {
+				rule.reset()
+			}
+		}
+	}
+}
+
+// Groups returns the loaded rule groups.
+func (s *Service) Groups() []*Group {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.groups
+}
+
+// RuleGroup is the rule group returned by /api/v1/rules.
+type RuleGroup struct {
+	Name           string        `json:"name"`
+	File           string        `json:"file"`
+	Rules          []interface{} `json:"rules"`
+	Interval       float64       `json:"interval"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+}
+
+// AlertingRuleDesc is the alerting rule returned by /api/v1/rules.
+type AlertingRuleDesc struct {
+	State          string            `json:"state"`
+	Name           string            `json:"name"`
+	Query          string            `json:"query"`
+	Duration       float64           `json:"duration"`
+	Labels         map[string]string `json:"labels"`
+	Annotations    map[string]string `json:"annotations"`
+	Alerts         []*AlertDesc      `json:"alerts"`
+	Health         Health            `json:"health"`
+	LastError      string            `json:"lastError,omitempty"`
+	EvaluationTime float64           `json:"evaluationTime"`
+	LastEvaluation time.Time         `json:"lastEvaluation"`
+	Type           string            `json:"type"`
+}
+
+// RecordingRuleDesc is the recording rule returned by /api/v1/rules.
+type RecordingRuleDesc struct {
+	Name           string            `json:"name"`
+	Query          string            `json:"query"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Health         Health            `json:"health"`
+	LastError      string            `json:"lastError,omitempty"`
+	EvaluationTime float64           `json:"evaluationTime"`
+	LastEvaluation time.Time         `json:"lastEvaluation"`
+	Type           string            `json:"type"`
+}
+
+// AlertDesc is the alert returned by /api/v1/alerts and /api/v1/rules.
+type AlertDesc struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	State       string            `json:"state"`
+	ActiveAt    *time.Time        `json:"activeAt,omitempty"`
+	Value       string            `json:"value"`
+}
+
+// RuleGroups returns the rule groups with the rules of the type, "alert" or "record". All rules are returned if typ is empty.
+func (s *Service) RuleGroups(typ string) []*RuleGroup {
+	returnAlerts := typ == "" || typ == "alert"
+	returnRecording := typ == "" || typ == "record"
+
+	groups := s.Groups()
+	res := make([]*RuleGroup, 0, len(groups))
+	for _, g := range groups {
+		rg := &RuleGroup{
+			Name:           g.Name(),
+			File:           g.File(),
+			Rules:          []interface{}{},
+			Interval:       g.Interval().Seconds(),
+			EvaluationTime: g.EvaluationDuration().Seconds(),
+			LastEvaluation: g.LastEvaluation(),
+		}
+		for _, r := range g.Rules() {
+			var lastError string
+			if err := r.LastError(); err != nil {
+				lastError = err.Error()
+			}
+			switch rule := r.(type) {
+			case *AlertingRule:
+				if !returnAlerts {
+					continue
+				}
+				rg.Rules = append(rg.Rules, &AlertingRuleDesc{
+					State:          rule.State().String(),
+					Name:           rule.Name(),
+					Query:          rule.Query(),
+					Duration:       rule.HoldDuration().Seconds(),
+					Labels:         rule.Labels().Map(),
+					Annotations:    rule.Annotations().Map(),
+					Alerts:         alertsToDesc(rule.ActiveAlerts()),
+					Health:         rule.Health(),
+					LastError:      lastError,
+					EvaluationTime: rule.EvaluationDuration().Seconds(),
+					LastEvaluation: rule.LastEvaluation(),
+					Type:           rule.Type(),
+				})
+			case *RecordingRule:
+				if !returnRecording {
+					continue
+				}
+				rg.Rules = append(rg.Rules, &RecordingRuleDesc{
+					Name:           rule.Name(),
+					Query:          rule.Query(),
+					Labels:         rule.Labels().Map(),
+					Health:         rule.Health(),
+					LastError:      lastError,
+					EvaluationTime: rule.EvaluationDuration().Seconds(),
+					LastEvaluation: rule.LastEvaluation(),
+					Type:           rule.Type(),
+				})
+			}
+		}
+		res = append(res, rg)
+	}
+	return res
+}
+
+// Alerts returns the pending and firing alerts of all alerting rules.
+func (s *Service) Alerts() []*AlertDesc {
+	res := make([]*AlertDesc, 0)
+	for _, g := range s.Groups() {
+		for _, r := range g.Rules() {
+			if rule, ok := r.(*AlertingRule); ok {
+				res = append(res, alertsToDesc(rule.ActiveAlerts())...)
+			}
+		}
+	}
+	return res
+}
+
+func alertsToDesc(alerts []*Alert) []*AlertDesc {
+	res := make([]*AlertDesc, 0, len(alerts))
+	for _, a := range alerts {
+		activeAt := a.ActiveAt
+		res = append(res, &AlertDesc{
+			Labels:      a.Labels.Map(),
+			Annotations: a.Annotations.Map(),
+			State:       a.State.String(),
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+		})
+	}
+	return res
+}
+
+// RuleDiscovery implements the httpd.RuleManager, and returns the data of /api/v1/rules.
+func (s *Service) RuleDiscovery(typ string) interface{} {
+	return map[string]interface{}{"groups": s.RuleGroups(typ)}
+}
+
+// AlertDiscovery implements the httpd.RuleManager, and returns the data of /api/v1/alerts.
+func (s *Service) AlertDiscovery() interface{} {
+	return map[string]interface{}{"alerts": s.Alerts()}
+}
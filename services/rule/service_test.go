@@ -0,0 +1,423 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockQuerier struct {
+	vectors map[string]promql.Vector
+}
+
+func (q *mockQuerier) Query(_ context.Context, qs string, _ time.Time) (promql.Vector, error) {
+	res := make(promql.Vector, 0, len(q.vectors[qs]))
+	for _, s := range q.vectors[qs] {
+		res = append(res, promql.Sample{Metric: s.Metric.Copy(), Point: s.Point})
+	}
+	return res, nil
+}
+
+type mockPointsWriter struct {
+	mu   sync.Mutex
+	rows []influx.Row
+}
+
+func (w *mockPointsWriter) RetryWritePointRows(_, _ string, points []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rows = append(w.rows, points...)
+	return nil
+}
+
+func newSample(v float64, lbs ...string) promql.Sample {
+	return promql.Sample{Metric: labels.FromStrings(lbs...), Point: promql.Point{V: v}}
+}
+
+func newGroupOptions(q Querier, w PointsWriter) *GroupOptions {
+	return &GroupOptions{
+		Querier:         q,
+		PointsWriter:    w,
+		Database:        config.DefaultRuleDatabase,
+		RetentionPolicy: config.DefaultRuleRetentionPolicy,
+		ResendDelay:     time.Minute,
+		Logger:          logger.NewLogger(errno.ModuleUnknown),
+	}
+}
+
+func TestRecordingRule(t *testing.T) {
+	querier := &mockQuerier{vectors: map[string]promql.Vector{
+		"sum by (job) (rate(http_requests_total[5m]))": {
+			newSample(1.5, "job", "api"),
+			newSample(2.5, "job", "web"),
+		},
+	}}
+	writer := &mockPointsWriter{}
+	r := NewRecordingRule("job:http_requests:rate5m", "sum by (job) (rate(http_requests_total[5m]))", labels.FromStrings("team", "infra"))
+	g := NewGroup("example", "rules.yml", time.Minute, []Rule{r}, newGroupOptions(querier, writer))
+
+	ts := time.Unix(1700000000, 0)
+	g.Eval(context.Background(), ts)
+
+	require.Equal(t, 2, len(writer.rows))
+	row := writer.rows[0]
+	assert.Equal(t, "job:http_requests:rate5m", row.Name)
+	assert.Equal(t, ts.UnixNano(), row.Timestamp)
+	assert.Equal(t, influx.PointTags{
+		{Key: "__name__", Value: "job:http_requests:rate5m"},
+		{Key: "job", Value: "api"},
+		{Key: "team", Value: "infra"},
+	}, row.Tags)
+	assert.Equal(t, "value", row.Fields[0].Key)
+	assert.Equal(t, 1.5, row.Fields[0].NumValue)
+
+	assert.Equal(t, HealthGood, r.Health())
+	assert.Equal(t, ts, r.LastEvaluation())
+	assert.Equal(t, ts, g.LastEvaluation())
+}
+
+func TestRecordingRule_DuplicateLabelset(t *testing.T) {
+	querier := &mockQuerier{vectors: map[string]promql.Vector{
+		"up": {
+			newSample(1, "__name__", "up", "job", "api"),
+			newSample(1, "__name__", "up", "job", "web"),
+		},
+	}}
+	writer := &mockPointsWriter{}
+	r := NewRecordingRule("up:job", "up", labels.FromStrings("job", "all"))
+	g := NewGroup("example", "rules.yml", time.Minute, []Rule{r}, newGroupOptions(querier, writer))
+	g.Eval(context.Background(), time.Now())
+
+	assert.Equal(t, 0, len(writer.rows))
+	assert.Equal(t, HealthBad, r.Health())
+	assert.Error(t, r.LastError())
+}
+
+func TestAlertingRule_StateTransition(t *testing.T) {
+	querier := &mockQuerier{vectors: map[string]promql.Vector{
+		"up == 0": {newSample(0, "__name__", "up", "instance", "a")},
+	}}
+	r := NewAlertingRule("InstanceDown", "up == 0", 2*time.Minute,
+		labels.FromStrings("severity", "page"),
+		labels.FromStrings("summary", "Instance {{ $labels.instance }} down, value {{ $value }}"))
+
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+
+	// pending
+	vector, err := r.Eval(ctx, start, querier)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vector))
+	assert.Equal(t, "pending", vector[0].Metric.Get(AlertStateLabel))
+	assert.Equal(t, AlertMetricName, vector[0].Metric.Get(labels.MetricName))
+	assert.Equal(t, "InstanceDown", vector[0].Metric.Get(labels.AlertName))
+	assert.Equal(t, StatePending, r.State())
+	assert.Equal(t, 0, len(r.alertsToSend(start, time.Minute, time.Minute)))
+
+	alerts := r.ActiveAlerts()
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, "Instance a down, value 0", alerts[0].Annotations.Get("summary"))
+	assert.Equal(t, "page", alerts[0].Labels.Get("severity"))
+	assert.Equal(t, "", alerts[0].Labels.Get(labels.MetricName))
+
+	// still pending before the hold duration
+	_, err = r.Eval(ctx, start.Add(time.Minute), querier)
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, r.State())
+
+	// firing
+	firedAt := start.Add(2 * time.Minute)
+	vector, err = r.Eval(ctx, firedAt, querier)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vector))
+	assert.Equal(t, "firing", vector[0].Metric.Get(AlertStateLabel))
+	assert.Equal(t, StateFiring, r.State())
+	assert.Equal(t, start, r.ActiveAlerts()[0].ActiveAt)
+
+	toSend := r.alertsToSend(firedAt, time.Minute, time.Minute)
+	require.Equal(t, 1, len(toSend))
+	assert.Equal(t, firedAt, toSend[0].FiredAt)
+	assert.Equal(t, firedAt.Add(4*time.Minute), toSend[0].ValidUntil)
+	// not resent before the resend delay
+	assert.Equal(t, 0, len(r.alertsToSend(firedAt.Add(30*time.Second), time.Minute, time.Minute)))
+
+	// resolved
+	querier.vectors["up == 0"] = nil
+	resolvedAt := start.Add(3 * time.Minute)
+	vector, err = r.Eval(ctx, resolvedAt, querier)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(vector))
+	assert.Equal(t, StateInactive, r.State())
+	assert.Equal(t, 0, len(r.ActiveAlerts()))
+
+	toSend = r.alertsToSend(resolvedAt, time.Minute, time.Minute)
+	require.Equal(t, 1, len(toSend))
+	assert.Equal(t, resolvedAt, toSend[0].ValidUntil)
+
+	// the resolved alert is dropped after the retention
+	_, err = r.Eval(ctx, resolvedAt.Add(resolvedRetention+time.Minute), querier)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(r.active))
+}
+
+func TestAlertingRule_PendingDropped(t *testing.T) {
+	querier := &mockQuerier{vectors: map[string]promql.Vector{
+		"up == 0": {newSample(0, "__name__", "up", "instance", "a")},
+	}}
+	r := NewAlertingRule("InstanceDown", "up == 0", time.Minute, nil, nil)
+	_, err := r.Eval(context.Background(), time.Unix(1700000000, 0), querier)
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, r.State())
+
+	querier.vectors["up == 0"] = nil
+	_, err = r.Eval(context.Background(), time.Unix(1700000060, 0), querier)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(r.active))
+}
+
+func TestGroup_SendAlerts(t *testing.T) {
+	var received []notifyAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(b, &received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	querier := &mockQuerier{vectors: map[string]promql.Vector{
+		"up == 0": {newSample(0, "__name__", "up", "instance", "a")},
+	}}
+	writer := &mockPointsWriter{}
+	opts := newGroupOptions(querier, writer)
+	opts.Notifier = NewNotifier(server.URL, time.Second)
+	r := NewAlertingRule("InstanceDown", "up == 0", 0, nil, labels.FromStrings("summary", "down"))
+	g := NewGroup("example", "rules.yml", time.Minute, []Rule{r}, opts)
+
+	g.Eval(context.Background(), time.Unix(1700000000, 0))
+	require.Equal(t, 1, len(received))
+	assert.Equal(t, "InstanceDown", received[0].Labels[labels.AlertName])
+	assert.Equal(t, "a", received[0].Labels["instance"])
+	assert.Equal(t, "down", received[0].Annotations["summary"])
+
+	require.Equal(t, 1, len(writer.rows))
+	assert.Equal(t, AlertMetricName, writer.rows[0].Name)
+}
+
+func TestNotifier_BadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(server.URL, time.Second)
+	err := n.Send(context.Background(), []*Alert{{State: StateFiring, Labels: labels.FromStrings("alertname", "a")}})
+	assert.Error(t, err)
+}
+
+func TestService_LoadRules(t *testing.T) {
+	dir := t.TempDir()
+	content := `
+groups:
+  - name: example
+    interval: 30s
+    rules:
+      - record: job:up:sum
+        expr: sum by (job) (up)
+      - alert: InstanceDown
+        expr: up == 0
+        for: 5m
+        labels:
+          severity: page
+        annotations:
+          summary: "Instance {{ $labels.instance }} down"
+  - name: default_interval
+    rules:
+      - record: up:count
+        expr: count(up)
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rules.yml"), []byte(content), 0600))
+
+	c := config.NewRuleConfig()
+	c.Enabled = true
+	c.RuleFiles = []string{filepath.Join(dir, "*.yml")}
+	s := NewService("127.0.0.1:8086", c)
+	s.Querier = &mockQuerier{}
+	s.PointsWriter = &mockPointsWriter{}
+	require.NoError(t, s.Open())
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	groups := s.RuleGroups("")
+	require.Equal(t, 2, len(groups))
+	assert.Equal(t, "example", groups[0].Name)
+	assert.Equal(t, float64(30), groups[0].Interval)
+	assert.Equal(t, float64(60), groups[1].Interval)
+	require.Equal(t, 2, len(groups[0].Rules))
+
+	recording, ok := groups[0].Rules[0].(*RecordingRuleDesc)
+	require.True(t, ok)
+	assert.Equal(t, "job:up:sum", recording.Name)
+	assert.Equal(t, TypeRecording, recording.Type)
+
+	alerting, ok := groups[0].Rules[1].(*AlertingRuleDesc)
+	require.True(t, ok)
+	assert.Equal(t, "InstanceDown", alerting.Name)
+	assert.Equal(t, float64(300), alerting.Duration)
+	assert.Equal(t, "page", alerting.Labels["severity"])
+	assert.Equal(t, "inactive", alerting.State)
+
+	assert.Equal(t, 1, len(s.RuleGroups("alert")[0].Rules))
+	assert.Equal(t, 1, len(s.RuleGroups("record")[0].Rules))
+	assert.Equal(t, 0, len(s.Alerts()))
+}
+
+func TestService_LoadRulesError(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "rules.yml")
+	require.NoError(t, os.WriteFile(file, []byte("groups:\n  - name: a\n    rules:\n      - record: a\n"), 0600))
+
+	c := config.NewRuleConfig()
+	c.RuleFiles = []string{file}
+	s := NewService("127.0.0.1:8086", c)
+	s.Querier = &mockQuerier{}
+	assert.Error(t, s.Open())
+}
+
+type mockRuleMetaClient struct {
+	mu    sync.Mutex
+	owner string
+}
+
+func (c *mockRuleMetaClient) GetRuleLease(host string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.owner == "" {
+		c.owner = host
+	}
+	return c.owner, nil
+}
+
+func TestService_RuleLease(t *testing.T) {
+	dir := t.TempDir()
+	content := `
+groups:
+  - name: example
+    interval: 1s
+    rules:
+      - record: job:up:sum
+        expr: sum by (job) (up)
+      - alert: InstanceDown
+        expr: up == 0
+        for: 1m
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rules.yml"), []byte(content), 0600))
+
+	c := config.NewRuleConfig()
+	c.Enabled = true
+	c.RuleFiles = []string{filepath.Join(dir, "*.yml")}
+	querier := &mockQuerier{vectors: map[string]promql.Vector{
+		"sum by (job) (up)": {newSample(1, "job", "a")},
+		"up == 0":           {newSample(0, "job", "b")},
+	}}
+	metaClient := &mockRuleMetaClient{}
+
+	// only the ts-sql holding the rule lease evaluates the groups
+	hosts := []string{"127.0.0.1:8086", "127.0.0.2:8086"}
+	var writers []*mockPointsWriter
+	var services []*Service
+	for _, host := range hosts {
+		s := NewService(host, c)
+		s.MetaClient = metaClient
+		s.Querier = querier
+		w := &mockPointsWriter{}
+		s.PointsWriter = w
+		require.NoError(t, s.Open())
+		defer s.Close()
+		require.Eventually(t, func() bool { return s.IsOwner() || s.RuleOwner() != "" }, time.Second, 10*time.Millisecond)
+		writers = append(writers, w)
+		services = append(services, s)
+	}
+	written := func(w *mockPointsWriter) int {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		return len(w.rows)
+	}
+	require.Eventually(t, func() bool { return written(writers[0]) > 0 }, 3*time.Second, 10*time.Millisecond)
+	assert.True(t, services[0].IsOwner())
+	assert.Equal(t, "", services[0].RuleOwner())
+	assert.Len(t, services[0].Alerts(), 1)
+	assert.False(t, services[1].IsOwner())
+	assert.Equal(t, hosts[0], services[1].RuleOwner())
+	assert.Equal(t, 0, written(writers[1]))
+
+	// the previous owner drops the state of the alerts when another ts-sql takes over the lease
+	metaClient.mu.Lock()
+	metaClient.owner = hosts[1]
+	metaClient.mu.Unlock()
+	require.Eventually(t, func() bool {
+		return services[0].RuleOwner() == hosts[1] && len(services[0].Alerts()) == 0
+	}, 3*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return services[1].IsOwner() && written(writers[1]) > 0 }, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "", services[1].RuleOwner())
+}
+
+type mockQueryExecutor struct {
+	results []*query.Result
+}
+
+func (e *mockQueryExecutor) ExecuteQuery(_ *influxql.Query, _ query.ExecutionOptions, _ chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	ch := make(chan *query.Result, len(e.results))
+	for _, r := range e.results {
+		ch <- r
+	}
+	close(ch)
+	return ch
+}
+
+func TestPromQuerier_JoinErrors(t *testing.T) {
+	err1 := errors.New("shard 1 failed")
+	err2 := errors.New("shard 2 failed")
+	executor := &mockQueryExecutor{results: []*query.Result{{Err: err1}, {}, {Err: err2}}}
+	q := NewPromQuerier(executor, "prom", "autogen")
+
+	_, err := q.Query(context.Background(), "up", time.Now())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/influxdata/influxdb/logger"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+// Service verifies the TSSP files of the shards periodically, so that the corruption is found before it shows up as a query error
+type Service struct {
+	services.Base
+
+	Config config.ScrubConfig
+
+	Engine interface {
+		ScrubShards(quarantineDir string) *immutable.VerifyReport
+	}
+}
+
+func NewService(c config.ScrubConfig) *Service {
+	s := &Service{Config: c}
+	s.Init("scrub", time.Duration(c.RunInterval), s.handle)
+	return s
+}
+
+func (s *Service) handle() {
+	logger, logEnd := log.NewOperation(s.Logger.GetZapLogger(), "scrub tssp files", "scrub")
+	defer logEnd()
+
+	report := s.Engine.ScrubShards(s.Config.QuarantineDir)
+	logger.Info("scrub tssp files done", zap.Int("files", report.Files),
+		zap.Int("corrupted", report.Corrupted), zap.Int("quarantined", report.Quarantined))
+	if s.Config.ReportDir == "" {
+		return
+	}
+
+	file, err := s.writeReport(report, time.Now())
+	if err != nil {
+		logger.Error("write scrub report failed", zap.Error(err))
+		return
+	}
+	logger.Info("write scrub report", zap.String("file", file))
+}
+
+func (s *Service) writeReport(report *immutable.VerifyReport, now time.Time) (string, error) {
+	if err := os.MkdirAll(s.Config.ReportDir, 0750); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Join(s.Config.ReportDir, fmt.Sprintf("scrub-%s.json", now.UTC().Format("20060102T150405Z")))
+	return file, os.WriteFile(file, data, 0640)
+}
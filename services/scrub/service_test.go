@@ -0,0 +1,71 @@
+// Copyright 2024 openGemini Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEngine struct {
+	quarantineDir string
+}
+
+func (e *mockEngine) ScrubShards(quarantineDir string) *immutable.VerifyReport {
+	e.quarantineDir = quarantineDir
+	report := &immutable.VerifyReport{}
+	report.Add(&immutable.ShardVerifyReport{
+		Path: "/data/db0/0/autogen/1_0_1_1/tssp",
+		Files: []*immutable.FileVerifyReport{
+			{Path: "/data/db0/0/autogen/1_0_1_1/tssp/cpu_0000/00000001-0000-00000000.tssp", Chunks: 1},
+			{Path: "/data/db0/0/autogen/1_0_1_1/tssp/cpu_0000/00000002-0000-00000000.tssp", Errors: []string{"crc mismatch"}},
+		},
+	})
+	return report
+}
+
+func TestService_Handle(t *testing.T) {
+	conf := config.NewScrubConfig()
+	conf.Enabled = true
+	conf.QuarantineDir = t.TempDir()
+	conf.ReportDir = filepath.Join(t.TempDir(), "report")
+
+	eng := &mockEngine{}
+	s := NewService(conf)
+	s.Engine = eng
+	require.NoError(t, s.Open())
+	defer s.Close()
+
+	s.handle()
+	require.Equal(t, conf.QuarantineDir, eng.quarantineDir)
+
+	files, err := filepath.Glob(filepath.Join(conf.ReportDir, "scrub-*.json"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	report := &immutable.VerifyReport{}
+	require.NoError(t, json.Unmarshal(data, report))
+	require.Equal(t, 2, report.Files)
+	require.Equal(t, 1, report.Corrupted)
+	require.Equal(t, []string{"crc mismatch"}, report.Shards[0].Files[1].Errors)
+}
@@ -532,3 +532,88 @@ func makeInt64Pointer(val int64) *int64 {
 	*valp = val
 	return valp
 }
+
+// upstreamRangeQuery evaluates the range query with the upstream promql engine over the samples of the load command,
+// the result is the reference of the conformance tests of /api/v1/query_range.
+func upstreamRangeQuery(t *testing.T, load, expr string, start, end time.Time, step time.Duration) promql.Matrix {
+	test, err := promql.NewTest(t, load)
+	if err != nil {
+		t.Fatalf("parse the load command failed: %s", err)
+	}
+	defer test.Close()
+	if err = test.Run(); err != nil {
+		t.Fatalf("load the samples failed: %s", err)
+	}
+	q, err := test.QueryEngine().NewRangeQuery(test.Queryable(), expr, start, end, step)
+	if err != nil {
+		t.Fatalf("upstream range query %s failed: %s", expr, err)
+	}
+	defer q.Close()
+	res := q.Exec(test.Context())
+	if res.Err != nil {
+		t.Fatalf("upstream range query %s failed: %s", expr, res.Err)
+	}
+	mat, err := res.Matrix()
+	if err != nil {
+		t.Fatalf("upstream range query %s failed: %s", expr, err)
+	}
+	return mat
+}
+
+// buildRangeExp returns the response of /api/v1/query_range for the matrix.
+func buildRangeExp(mat promql.Matrix) string {
+	res := &httpd.PromResponse{
+		Status: httpd.StatusSuccess,
+		Data: &promql2influxql.PromResult{
+			ResultType: string(parser.ValueTypeMatrix),
+			Result:     mat,
+		},
+	}
+	exp, _ := json.Marshal(res)
+	return string(exp)
+}
+
+// checkPromRangeMatrix checks that the response of /api/v1/query_range holds the series and the points of the matrix.
+func checkPromRangeMatrix(exp promql.Matrix, act string) bool {
+	var res struct {
+		Status string `json:"status"`
+		Data   struct {
+			ResultType string `json:"resultType"`
+			Result     []struct {
+				Metric map[string]string `json:"metric"`
+				Values [][2]interface{}  `json:"values"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(act), &res); err != nil {
+		return false
+	}
+	if res.Status != string(httpd.StatusSuccess) || res.Data.ResultType != string(parser.ValueTypeMatrix) || len(res.Data.Result) != len(exp) {
+		return false
+	}
+	series := make(map[uint64][]promql.Point, len(exp))
+	for _, s := range exp {
+		series[s.Metric.Hash()] = s.Points
+	}
+	for _, s := range res.Data.Result {
+		points, ok := series[labels.FromMap(s.Metric).Hash()]
+		if !ok || len(points) != len(s.Values) {
+			return false
+		}
+		for i, v := range s.Values {
+			ts, ok := v[0].(float64)
+			if !ok || int64(math.Round(ts*1000)) != points[i].T {
+				return false
+			}
+			str, ok := v[1].(string)
+			if !ok {
+				return false
+			}
+			val, err := strconv.ParseFloat(str, 64)
+			if err != nil || !almostEqual(val, points[i].V) {
+				return false
+			}
+		}
+	}
+	return true
+}
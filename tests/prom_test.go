@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -2371,6 +2372,96 @@ func TestServer_PromQuery_Subquery3(t *testing.T) {
 			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[80,"2.3666666666666667"]}]}}`,
 			path:    "/api/v1/query",
 		},
+		&Query{
+			name:    "instant query:  min_over_time(subquery)",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"20"}},
+			command: `min_over_time(metric[10s:10s])`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[20,"1"]}]}}`,
+			path:    "/api/v1/query",
+		},
+		&Query{
+			name:    "instant query:  min_over_time(rate(subquery))",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"1200"}},
+			command: `min_over_time(rate(metric[5m])[20m:1m])`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1200,"0.12119047619047618"]}]}}`,
+			path:    "/api/v1/query",
+		},
+	}...)
+
+	for i, query := range test.queries {
+		if i == 0 {
+			if err := test.init(s); err != nil {
+				t.Fatalf("test init failed: %s", err)
+			}
+		}
+		if query.skip {
+			t.Logf("SKIP:: %s", query.name)
+			continue
+		}
+		if err := query.ExecuteProm(s); err != nil {
+			t.Error(query.Error(err))
+		} else if !query.success() {
+			t.Error(query.failureMessage())
+		}
+	}
+}
+
+func TestServer_PromQuery_Subquery4(t *testing.T) {
+	t.Parallel()
+	s := OpenServer(NewConfig())
+	defer s.Close()
+
+	if err := s.CreateDatabaseAndRetentionPolicy("db0", NewRetentionPolicySpec("autogen", 1, 0), true); err != nil {
+		t.Fatal(err)
+	}
+	timeGap := 10 * 1000 * 1000 * 1000
+	startTime := 0
+	writes := make([]string, 0)
+	for i := 0; i <= 1000; i++ {
+		writes = append(writes, fmt.Sprintf(`metric1,__name__=metric1 value=%d %d`, i, startTime+i*timeGap))
+	}
+
+	test := NewTest("db0", "autogen")
+	test.writes = Writes{
+		&Write{data: strings.Join(writes, "\n")},
+	}
+
+	test.addQueries([]*Query{
+		&Query{
+			name:    "instant query:  sum_over_time(subquery)",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"1000"}},
+			command: `sum_over_time(metric1[30s:10s])`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1000,"394"]}]}}`,
+			path:    "/api/v1/query",
+		},
+		&Query{
+			name:    "instant query:  sum_over_time(subquery) with default step",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"1000"}},
+			command: `sum_over_time(metric1[2m:])`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1000,"186"]}]}}`,
+			path:    "/api/v1/query",
+		},
+		&Query{
+			name:    "instant query:  sum_over_time(subquery offset)",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"1010"}},
+			command: `sum_over_time(metric1[30s:10s] offset 3s)`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1010,"297"]}]}}`,
+			path:    "/api/v1/query",
+		},
+		&Query{
+			name:    "instant query:  rate(sum_over_time(subquery))",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"1000"}},
+			command: `rate(sum_over_time(metric1[30s:10s])[50s:10s])`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1000,"0.4"]}]}}`,
+			path:    "/api/v1/query",
+		},
+		&Query{
+			name:    "instant query:  sum(max_over_time(subquery)) + 1",
+			params:  url.Values{"db": []string{"db0"}, "time": []string{"1000"}},
+			command: `sum(max_over_time(metric1[30s:10s])) + 1`,
+			exp:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1000,"101"]}]}}`,
+			path:    "/api/v1/query",
+		},
 	}...)
 
 	for i, query := range test.queries {
@@ -2391,6 +2482,107 @@ func TestServer_PromQuery_Subquery3(t *testing.T) {
 	}
 }
 
+func TestServer_PromQuery_SubqueryRange(t *testing.T) {
+	t.Parallel()
+	s := OpenServer(NewConfig())
+	defer s.Close()
+
+	if err := s.CreateDatabaseAndRetentionPolicy("db0", NewRetentionPolicySpec("autogen", 1, 0), true); err != nil {
+		t.Fatal(err)
+	}
+
+	// counters changing their rate every 1000s, the one of api resets at 3030s,
+	// the expected results are evaluated by the upstream promql engine over the same samples
+	load := `load 10s
+		metric_range{job="api"} 0+10x100 1000+30x100 4000+5x100 0+50x100 5000+1x100 5100+20x100
+		metric_range{job="web"} 0+5x200 1000+40x200 9000+2x200`
+	_, writes, err := parseLoad(getLines(load), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := NewTest("db0", "autogen")
+	test.writes = Writes{
+		&Write{data: strings.Join(writes, "\n")},
+	}
+	if err := test.init(s); err != nil {
+		t.Fatalf("test init failed: %s", err)
+	}
+
+	cases := []struct {
+		name       string
+		command    string
+		start, end int64
+		step       time.Duration
+	}{
+		{
+			// the outer step is not a multiple of the subquery step
+			name:    "range query:  max_over_time(rate(subquery))",
+			command: `max_over_time(rate(metric_range[5m])[1h:1m])`,
+			start:   1800,
+			end:     6300,
+			step:    90 * time.Second,
+		},
+		{
+			name:    "range query:  sum_over_time(subquery offset)",
+			command: `sum_over_time(metric_range[10m:1m] offset 7m)`,
+			start:   1200,
+			end:     5400,
+			step:    150 * time.Second,
+		},
+		{
+			name:    "range query:  max_over_time(rate(subquery) @)",
+			command: `max_over_time(rate(metric_range[5m])[30m:1m] @ 3300)`,
+			start:   600,
+			end:     6000,
+			step:    5 * time.Minute,
+		},
+		{
+			name:    "range query:  rate(subquery @ offset)",
+			command: `rate(metric_range[10m:30s] @ 3050 offset 1m)`,
+			start:   1000,
+			end:     4000,
+			step:    10 * time.Minute,
+		},
+		{
+			// the aggregation is evaluated on the result of the range function, not on the rows of the subquery
+			name:    "range query:  sum(max_over_time(rate(subquery)))",
+			command: `sum(max_over_time(rate(metric_range[5m])[1h:1m]))`,
+			start:   1800,
+			end:     6300,
+			step:    90 * time.Second,
+		},
+		{
+			name:    "range query:  sum by (job) (sum_over_time(subquery offset))",
+			command: `sum by (job) (sum_over_time(metric_range[10m:1m] offset 7m))`,
+			start:   1200,
+			end:     5400,
+			step:    150 * time.Second,
+		},
+		{
+			name:    "range query:  min_over_time(rate(subquery) @ end())",
+			command: `min_over_time(rate(metric_range[5m])[20m:1m] @ end())`,
+			start:   2400,
+			end:     4200,
+			step:    2 * time.Minute,
+		},
+	}
+	for _, c := range cases {
+		exp := upstreamRangeQuery(t, load, c.command, time.Unix(c.start, 0), time.Unix(c.end, 0), c.step)
+		query := &Query{
+			name:    c.name,
+			params:  url.Values{"db": []string{"db0"}, "start": []string{strconv.FormatInt(c.start, 10)}, "end": []string{strconv.FormatInt(c.end, 10)}, "step": []string{strconv.FormatFloat(c.step.Seconds(), 'f', -1, 64)}},
+			command: c.command,
+			exp:     buildRangeExp(exp),
+			path:    "/api/v1/query_range",
+		}
+		if err := query.ExecuteProm(s); err != nil {
+			t.Error(query.Error(err))
+		} else if !checkPromRangeMatrix(exp, query.act) {
+			t.Error(query.failureMessage())
+		}
+	}
+}
+
 func TestServer_PromQuery_MultiAgg_HashAgg(t *testing.T) {
 	t.Parallel()
 	s := OpenServer(NewConfig())
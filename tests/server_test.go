@@ -11509,6 +11509,7 @@ func TestServer_FullJoin(t *testing.T) {
 	test := NewTest("db0", "rp0")
 	test.writes = Writes{
 		&Write{data: fmt.Sprintf("mst,tk1=tv1 f1=1i 1610380800000000000\n")},
+		&Write{data: fmt.Sprintf("state,tk1=tv1 s1=5i 1610380790000000000\n")},
 	}
 
 	test.addQueries([]*Query{
@@ -11518,6 +11519,30 @@ func TestServer_FullJoin(t *testing.T) {
 			command: `select m1.f1, m2.f1 from (select f1 from mst) as m1 full join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
 			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f1"],"values":[["2021-01-11T16:00:00Z",1,1]]}]}]}`,
 		},
+		{
+			name:    "inner join on one tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.f1 from (select f1 from mst) as m1 inner join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f1"],"values":[["2021-01-11T16:00:00Z",1,1]]}]}]}`,
+		},
+		{
+			name:    "inner join without the same time",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.s1 from (select f1 from mst) as m1 inner join (select s1 from state) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0}]}`,
+		},
+		{
+			name:    "asof join on one tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.s1 from (select f1 from mst) as m1 asof join (select s1 from state) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.s1"],"values":[["2021-01-11T16:00:00Z",1,5]]}]}]}`,
+		},
+		{
+			name:    "asof join out of tolerance",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.s1 from (select f1 from mst) as m1 asof join (select s1 from state) as m2 on (m1.tk1 = m2.tk1) tolerance 5s group by tk1`,
+			exp:     `{"results":[{"statement_id":0}]}`,
+		},
 	}...)
 
 	for i, query := range test.queries {